@@ -71,9 +71,11 @@ func putFloat64Slice(sl *[]float64) {
 // DownSamplingMultiSeriesInto merges field data from source time range => target time range,
 // data will be merged into DownSamplingResult
 // for example: source range[5,182]=>target range[0,6], ratio:30, source interval:10s, target interval:5min.
+// if skip is not nil, source value is skipped when skip returns true for target slot(base slot + source slot/ratio).
 func DownSamplingMultiSeriesInto(
 	target timeutil.SlotRange, ratio uint16, baseSlot uint16,
 	fieldType field.Type, decoders []*encoding.TSDDecoder,
+	skip func(targetSlot uint16) bool,
 	emitValue func(targetPos int, value float64),
 ) {
 	targetValues := make([]float64, infBlockSize)
@@ -100,7 +102,8 @@ func DownSamplingMultiSeriesInto(
 				continue
 			}
 			value := math.Float64frombits(decoder.Value())
-			targetPos := bs + int(movingSourceSlot/ratio) - int(target.Start)
+			targetSlot := bs + int(movingSourceSlot/ratio)
+			targetPos := targetSlot - int(target.Start)
 			if targetPos < 0 {
 				continue
 			}
@@ -108,6 +111,9 @@ func DownSamplingMultiSeriesInto(
 			if targetPos >= length {
 				break
 			}
+			if skip != nil && skip(uint16(targetSlot)) {
+				continue
+			}
 			// not set before
 			if math.IsInf(targetValues[targetPos], 1) {
 				targetValues[targetPos] = value
@@ -53,6 +53,7 @@ type expression struct {
 	selectItems []stmt.Expr
 
 	fieldStore map[field.Name]fields.Field
+	values     map[string]*collections.FloatArray // evaluated values referenced by field name(cross-metric query)
 	resultSet  map[string]*collections.FloatArray // field => series
 }
 
@@ -79,7 +80,11 @@ func (e *expression) Eval(timeSeries series.GroupedIterator) {
 	if len(e.fieldStore) == 0 {
 		return
 	}
+	e.evalSelectItems()
+}
 
+// evalSelectItems evaluates all select items, then puts values into result set.
+func (e *expression) evalSelectItems() {
 	for _, selectItem := range e.selectItems {
 		values := e.eval(nil, selectItem)
 		if len(values) != 0 {
@@ -121,6 +126,12 @@ func (e *expression) eval(parentFunc *stmt.CallExpr, expr stmt.Expr) []*collecti
 		switch ex.FuncType {
 		case function.Quantile:
 			return e.quantile(ex)
+		case function.TopK, function.BottomK:
+			// topk/bottomk selects series at root, just returns the ranked expr's values
+			if len(ex.Params) < 2 {
+				return nil
+			}
+			return e.eval(nil, ex.Params[1])
 		default:
 			return e.funcCall(ex)
 		}
@@ -145,6 +156,9 @@ func (e *expression) eval(parentFunc *stmt.CallExpr, expr stmt.Expr) []*collecti
 			// get field data by function type
 			return fieldValues.GetValues(parentFunc.FuncType)
 		}
+		if values, ok := e.values[fieldName]; ok && values != nil {
+			return []*collections.FloatArray{values}
+		}
 		return nil
 	default:
 		return nil
@@ -198,6 +212,10 @@ func (e *expression) funcCall(expr *stmt.CallExpr) []*collections.FloatArray {
 		result = function.AvgCall(params...)
 	case function.Rate:
 		result = function.RateCall(e.interval, params...)
+	case function.Irate:
+		result = function.IrateCall(e.interval, params...)
+	case function.Deriv:
+		result = function.DerivCall(e.interval, params...)
 	default:
 		result = function.FuncCall(expr.FuncType, params...)
 	}
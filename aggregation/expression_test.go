@@ -340,6 +340,79 @@ func TestExpression_FuncCall_Rate(t *testing.T) {
 	assert.Equal(t, 50.0/60, value.GetValue(50-10))
 }
 
+func TestExpression_FuncCall_Range_Math(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cases := []struct {
+		sql    string
+		name   string
+		expect map[int]float64
+	}{
+		{
+			sql:    "select increase(f1) from cpu",
+			name:   "increase(f1)",
+			expect: map[int]float64{100: 46},
+		},
+		{
+			sql:    "select irate(f1) from cpu",
+			name:   "irate(f1)",
+			expect: map[int]float64{100: 46.0 / (46 * 60)},
+		},
+		{
+			sql:    "select deriv(f1) from cpu",
+			name:   "deriv(f1)",
+			expect: map[int]float64{100: 46.0 / (46 * 60)},
+		},
+		{
+			sql:    "select clamp(f1, 5, 10) from cpu",
+			name:   "clamp(f1,5.00,10.00)",
+			expect: map[int]float64{54: 5, 100: 10},
+		},
+		{
+			sql:    "select abs(delta(f1)-100) from cpu",
+			name:   "abs(delta(f1)-100.00)",
+			expect: map[int]float64{100: 54},
+		},
+		{
+			sql:    "select topk(3, f1) from cpu group by host",
+			name:   "topk(3.00,f1)",
+			expect: map[int]float64{54: 4, 100: 50},
+		},
+		{
+			sql:    "select bottomk(3, increase(f1)) as b from cpu group by host",
+			name:   "b",
+			expect: map[int]float64{100: 46},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.sql, func(t *testing.T) {
+			series1 := mockTimeSeries(ctrl, familyTime+timeutil.OneHour, "f1", field.LastField, field.Last)
+			timeSeries := series.NewMockGroupedIterator(ctrl)
+			q, _ := sql.Parse(tt.sql)
+			query := q.(*stmt.Query)
+			expression := NewExpression(timeutil.TimeRange{
+				Start: now,
+				End:   now + timeutil.OneHour*2,
+			}, timeutil.OneMinute, query.SelectItems)
+			gomock.InOrder(
+				timeSeries.EXPECT().HasNext().Return(true),
+				timeSeries.EXPECT().Next().Return(series1),
+				timeSeries.EXPECT().HasNext().Return(false),
+			)
+			expression.Eval(timeSeries)
+			resultSet := expression.ResultSet()
+			value, ok := resultSet[tt.name]
+			assert.True(t, ok)
+			assert.Equal(t, len(tt.expect), value.Size())
+			for idx, v := range tt.expect {
+				assert.InDelta(t, v, value.GetValue(idx), 0.000001)
+			}
+		})
+	}
+}
+
 func TestExpression_NotSupport_Expr(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,62 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestFill(t *testing.T) {
+	newValues := func() *collections.FloatArray {
+		values := collections.NewFloatArray(5)
+		values.SetValue(1, 1.1)
+		values.SetValue(3, 3.3)
+		return values
+	}
+	// nil values
+	Fill(nil, stmt.FillValue, 10)
+
+	values := newValues()
+	Fill(values, stmt.FillNone, 10)
+	assert.Equal(t, 2, values.Size())
+	Fill(values, stmt.FillNull, 10)
+	assert.Equal(t, 2, values.Size())
+
+	values = newValues()
+	Fill(values, stmt.FillValue, 10)
+	assert.Equal(t, 5, values.Size())
+	assert.Equal(t, 10.0, values.GetValue(0))
+	assert.Equal(t, 1.1, values.GetValue(1))
+	assert.Equal(t, 10.0, values.GetValue(2))
+	assert.Equal(t, 3.3, values.GetValue(3))
+	assert.Equal(t, 10.0, values.GetValue(4))
+
+	values = newValues()
+	Fill(values, stmt.FillPrevious, 10)
+	assert.Equal(t, 4, values.Size())
+	assert.False(t, values.HasValue(0))
+	assert.Equal(t, 1.1, values.GetValue(1))
+	assert.Equal(t, 1.1, values.GetValue(2))
+	assert.Equal(t, 3.3, values.GetValue(3))
+	assert.Equal(t, 3.3, values.GetValue(4))
+}
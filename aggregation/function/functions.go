@@ -29,6 +29,20 @@ func FuncCall(funcType FuncType, params ...*collections.FloatArray) *collections
 			return nil
 		}
 		return params[0]
+	case Increase:
+		return IncreaseCall(params...)
+	case Delta:
+		return DeltaCall(params...)
+	case Abs:
+		return AbsCall(params...)
+	case Ceil:
+		return CeilCall(params...)
+	case Floor:
+		return FloorCall(params...)
+	case Round:
+		return RoundCall(params...)
+	case Clamp:
+		return ClampCall(params...)
 	default:
 		return nil
 	}
@@ -41,3 +41,14 @@ func TestFuncCall_Sum(t *testing.T) {
 	result = FuncCall(Sum, array1, array2)
 	assert.Equal(t, array1, result)
 }
+
+func TestFuncCall_Range_Math(t *testing.T) {
+	array := collections.NewFloatArray(10)
+	array.SetValue(1, 1.4)
+	array.SetValue(2, -2.6)
+	for _, funcType := range []FuncType{Increase, Delta, Abs, Ceil, Floor, Round} {
+		assert.NotNil(t, FuncCall(funcType, array))
+	}
+	assert.Nil(t, FuncCall(Clamp, array))
+	assert.Equal(t, -4.0, FuncCall(Delta, array).GetValue(2))
+}
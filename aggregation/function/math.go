@@ -0,0 +1,103 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"math"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+// AbsCall represents abs function call.
+func AbsCall(params ...*collections.FloatArray) *collections.FloatArray {
+	return mathCall(math.Abs, params...)
+}
+
+// CeilCall represents ceil function call.
+func CeilCall(params ...*collections.FloatArray) *collections.FloatArray {
+	return mathCall(math.Ceil, params...)
+}
+
+// FloorCall represents floor function call.
+func FloorCall(params ...*collections.FloatArray) *collections.FloatArray {
+	return mathCall(math.Floor, params...)
+}
+
+// RoundCall represents round function call, rounds the value to the nearest integer,
+// if the 2nd param(to nearest) exist, rounds the value to the nearest multiple of it.
+func RoundCall(params ...*collections.FloatArray) *collections.FloatArray {
+	if len(params) == 0 || params[0] == nil {
+		return nil
+	}
+	if len(params) == 1 {
+		return mathCall(math.Round, params...)
+	}
+	toNearest := params[1]
+	result := collections.NewFloatArray(params[0].Capacity())
+	itr := params[0].NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		if !toNearest.HasValue(idx) {
+			continue
+		}
+		nearest := toNearest.GetValue(idx)
+		if nearest == 0 {
+			continue
+		}
+		// same as prometheus, rounds half up
+		result.SetValue(idx, math.Floor(val/nearest+0.5)*nearest)
+	}
+	return result
+}
+
+// ClampCall represents clamp function call, clamps the value to have a lower limit of min and an upper limit of max.
+// params: 0=>value, 1=>min, 2=>max
+func ClampCall(params ...*collections.FloatArray) *collections.FloatArray {
+	if len(params) < 3 || params[0] == nil {
+		return nil
+	}
+	result := collections.NewFloatArray(params[0].Capacity())
+	itr := params[0].NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		if !params[1].HasValue(idx) || !params[2].HasValue(idx) {
+			continue
+		}
+		minVal, maxVal := params[1].GetValue(idx), params[2].GetValue(idx)
+		if minVal > maxVal {
+			// returns empty if min > max
+			continue
+		}
+		result.SetValue(idx, math.Max(minVal, math.Min(maxVal, val)))
+	}
+	return result
+}
+
+// mathCall calls the math function for each point.
+func mathCall(fn func(float64) float64, params ...*collections.FloatArray) *collections.FloatArray {
+	if len(params) == 0 || params[0] == nil {
+		return nil
+	}
+	result := collections.NewFloatArray(params[0].Capacity())
+	itr := params[0].NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		result.SetValue(idx, fn(val))
+	}
+	return result
+}
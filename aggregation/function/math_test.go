@@ -0,0 +1,97 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+func newMathArray() *collections.FloatArray {
+	array := collections.NewFloatArray(10)
+	array.SetValue(1, -1.5)
+	array.SetValue(2, 2.4)
+	array.SetValue(3, 12.6)
+	return array
+}
+
+func newSingleArray(val float64) *collections.FloatArray {
+	array := collections.NewFloatArray(10)
+	for i := 0; i < 10; i++ {
+		array.SetValue(i, val)
+	}
+	array.SetSingle(true)
+	return array
+}
+
+func TestAbsCall(t *testing.T) {
+	assert.Nil(t, AbsCall())
+	rs := AbsCall(newMathArray())
+	assert.Equal(t, 1.5, rs.GetValue(1))
+	assert.Equal(t, 2.4, rs.GetValue(2))
+	assert.False(t, rs.HasValue(0))
+}
+
+func TestCeilCall(t *testing.T) {
+	assert.Nil(t, CeilCall(nil))
+	rs := CeilCall(newMathArray())
+	assert.Equal(t, -1.0, rs.GetValue(1))
+	assert.Equal(t, 3.0, rs.GetValue(2))
+}
+
+func TestFloorCall(t *testing.T) {
+	rs := FloorCall(newMathArray())
+	assert.Equal(t, -2.0, rs.GetValue(1))
+	assert.Equal(t, 2.0, rs.GetValue(2))
+}
+
+func TestRoundCall(t *testing.T) {
+	assert.Nil(t, RoundCall())
+	rs := RoundCall(newMathArray())
+	assert.Equal(t, -2.0, rs.GetValue(1))
+	assert.Equal(t, 2.0, rs.GetValue(2))
+	assert.Equal(t, 13.0, rs.GetValue(3))
+	// round to nearest
+	rs = RoundCall(newMathArray(), newSingleArray(5))
+	assert.Equal(t, 0.0, rs.GetValue(1))
+	assert.Equal(t, 0.0, rs.GetValue(2))
+	assert.Equal(t, 15.0, rs.GetValue(3))
+	// to nearest is zero
+	rs = RoundCall(newMathArray(), newSingleArray(0))
+	assert.True(t, rs.IsEmpty())
+	// to nearest not exist
+	rs = RoundCall(newMathArray(), collections.NewFloatArray(10))
+	assert.True(t, rs.IsEmpty())
+}
+
+func TestClampCall(t *testing.T) {
+	assert.Nil(t, ClampCall(newMathArray()))
+	rs := ClampCall(newMathArray(), newSingleArray(0), newSingleArray(10))
+	assert.Equal(t, 0.0, rs.GetValue(1))
+	assert.Equal(t, 2.4, rs.GetValue(2))
+	assert.Equal(t, 10.0, rs.GetValue(3))
+	// min > max
+	rs = ClampCall(newMathArray(), newSingleArray(10), newSingleArray(0))
+	assert.True(t, rs.IsEmpty())
+	// min/max not exist
+	rs = ClampCall(newMathArray(), collections.NewFloatArray(10), newSingleArray(0))
+	assert.True(t, rs.IsEmpty())
+}
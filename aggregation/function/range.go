@@ -0,0 +1,75 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// IncreaseCall represents increase function call, returns the increase between each point and its previous point,
+// if value decreases, treats it as counter reset, the increase is current value.
+func IncreaseCall(params ...*collections.FloatArray) *collections.FloatArray {
+	return rangeCall(0, true, params...)
+}
+
+// DeltaCall represents delta function call, returns the difference between each point and its previous point.
+func DeltaCall(params ...*collections.FloatArray) *collections.FloatArray {
+	return rangeCall(0, false, params...)
+}
+
+// IrateCall represents irate function call, returns the per-second rate of increase between each point and its previous point,
+// handles counter reset like increase.
+func IrateCall(interval int64, params ...*collections.FloatArray) *collections.FloatArray {
+	return rangeCall(interval, true, params...)
+}
+
+// DerivCall represents deriv function call, returns the per-second derivative between each point and its previous point.
+func DerivCall(interval int64, params ...*collections.FloatArray) *collections.FloatArray {
+	return rangeCall(interval, false, params...)
+}
+
+// rangeCall evaluates each point with its previous point(skip empty point),
+// if interval > 0, returns the per-second value based on the elapsed time between two points.
+func rangeCall(interval int64, isCounter bool, params ...*collections.FloatArray) *collections.FloatArray {
+	if len(params) == 0 || params[0] == nil {
+		return nil
+	}
+	result := collections.NewFloatArray(params[0].Capacity())
+	itr := params[0].NewIterator()
+	prevIdx := -1
+	prevVal := 0.0
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		if prevIdx >= 0 {
+			diff := val - prevVal
+			if isCounter && diff < 0 {
+				// counter reset, counter restarts from zero
+				diff = val
+			}
+			if interval > 0 {
+				seconds := float64(int64(idx-prevIdx)*interval) / float64(timeutil.OneSecond)
+				diff /= seconds
+			}
+			result.SetValue(idx, diff)
+		}
+		prevIdx = idx
+		prevVal = val
+	}
+	return result
+}
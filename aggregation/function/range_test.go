@@ -0,0 +1,82 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func newCounterArray() *collections.FloatArray {
+	array := collections.NewFloatArray(10)
+	array.SetValue(1, 10.0)
+	array.SetValue(2, 30.0)
+	// gap at 3
+	array.SetValue(4, 50.0)
+	// counter reset
+	array.SetValue(5, 5.0)
+	return array
+}
+
+func TestIncreaseCall(t *testing.T) {
+	assert.Nil(t, IncreaseCall())
+	assert.Nil(t, IncreaseCall(nil))
+
+	rs := IncreaseCall(newCounterArray())
+	assert.False(t, rs.HasValue(1))
+	assert.Equal(t, 20.0, rs.GetValue(2))
+	assert.False(t, rs.HasValue(3))
+	assert.Equal(t, 20.0, rs.GetValue(4))
+	assert.Equal(t, 5.0, rs.GetValue(5))
+	assert.Equal(t, 3, rs.Size())
+}
+
+func TestDeltaCall(t *testing.T) {
+	assert.Nil(t, DeltaCall())
+
+	rs := DeltaCall(newCounterArray())
+	assert.False(t, rs.HasValue(1))
+	assert.Equal(t, 20.0, rs.GetValue(2))
+	assert.Equal(t, 20.0, rs.GetValue(4))
+	assert.Equal(t, -45.0, rs.GetValue(5))
+}
+
+func TestIrateCall(t *testing.T) {
+	assert.Nil(t, IrateCall(10*timeutil.OneSecond))
+
+	rs := IrateCall(10*timeutil.OneSecond, newCounterArray())
+	assert.False(t, rs.HasValue(1))
+	assert.Equal(t, 2.0, rs.GetValue(2))
+	// elapsed 20 seconds
+	assert.Equal(t, 1.0, rs.GetValue(4))
+	assert.Equal(t, 0.5, rs.GetValue(5))
+}
+
+func TestDerivCall(t *testing.T) {
+	assert.Nil(t, DerivCall(10*timeutil.OneSecond))
+
+	rs := DerivCall(10*timeutil.OneSecond, newCounterArray())
+	assert.False(t, rs.HasValue(1))
+	assert.Equal(t, 2.0, rs.GetValue(2))
+	assert.Equal(t, 1.0, rs.GetValue(4))
+	assert.Equal(t, -4.5, rs.GetValue(5))
+}
@@ -32,6 +32,17 @@ const (
 	Quantile
 	Stddev
 	Rate
+	Increase
+	Delta
+	Irate
+	Deriv
+	Abs
+	Ceil
+	Floor
+	Round
+	Clamp
+	TopK
+	BottomK
 )
 
 // String return the function's name
@@ -57,6 +68,28 @@ func (t FuncType) String() string {
 		return "stddev"
 	case Rate:
 		return "rate"
+	case Increase:
+		return "increase"
+	case Delta:
+		return "delta"
+	case Irate:
+		return "irate"
+	case Deriv:
+		return "deriv"
+	case Abs:
+		return "abs"
+	case Ceil:
+		return "ceil"
+	case Floor:
+		return "floor"
+	case Round:
+		return "round"
+	case Clamp:
+		return "clamp"
+	case TopK:
+		return "topk"
+	case BottomK:
+		return "bottomk"
 	default:
 		return "unknown"
 	}
@@ -66,3 +99,18 @@ func (t FuncType) String() string {
 func IsSupportOrderBy(t FuncType) bool {
 	return t == Sum || t == Min || t == Max || t == Count || t == Avg || t == Last || t == First || t == Stddev
 }
+
+// IsRangeFunc checks if function evaluates each point with its previous point, like increase/delta etc.
+func IsRangeFunc(t FuncType) bool {
+	return t == Increase || t == Delta || t == Irate || t == Deriv
+}
+
+// IsMathFunc checks if function is math helper which evaluates each point independently.
+func IsMathFunc(t FuncType) bool {
+	return t == Abs || t == Ceil || t == Floor || t == Round || t == Clamp
+}
+
+// IsSelectorFunc checks if function selects series across groups, like topk/bottomk.
+func IsSelectorFunc(t FuncType) bool {
+	return t == TopK || t == BottomK
+}
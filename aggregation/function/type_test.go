@@ -34,6 +34,17 @@ func TestFuncTypeString(t *testing.T) {
 	assert.Equal(t, "quantile", Quantile.String())
 	assert.Equal(t, "stddev", Stddev.String())
 	assert.Equal(t, "rate", Rate.String())
+	assert.Equal(t, "increase", Increase.String())
+	assert.Equal(t, "delta", Delta.String())
+	assert.Equal(t, "irate", Irate.String())
+	assert.Equal(t, "deriv", Deriv.String())
+	assert.Equal(t, "abs", Abs.String())
+	assert.Equal(t, "ceil", Ceil.String())
+	assert.Equal(t, "floor", Floor.String())
+	assert.Equal(t, "round", Round.String())
+	assert.Equal(t, "clamp", Clamp.String())
+	assert.Equal(t, "topk", TopK.String())
+	assert.Equal(t, "bottomk", BottomK.String())
 	assert.Equal(t, "unknown", Unknown.String())
 }
 
@@ -42,3 +53,23 @@ func TestIsSupportOrderBy(t *testing.T) {
 	assert.False(t, IsSupportOrderBy(Quantile))
 	assert.False(t, IsSupportOrderBy(Unknown))
 }
+
+func TestIsRangeFunc(t *testing.T) {
+	assert.True(t, IsRangeFunc(Increase))
+	assert.True(t, IsRangeFunc(Deriv))
+	assert.False(t, IsRangeFunc(Rate))
+	assert.False(t, IsRangeFunc(Abs))
+}
+
+func TestIsMathFunc(t *testing.T) {
+	assert.True(t, IsMathFunc(Abs))
+	assert.True(t, IsMathFunc(Clamp))
+	assert.False(t, IsMathFunc(Sum))
+	assert.False(t, IsMathFunc(Delta))
+}
+
+func TestIsSelectorFunc(t *testing.T) {
+	assert.True(t, IsSelectorFunc(TopK))
+	assert.True(t, IsSelectorFunc(BottomK))
+	assert.False(t, IsSelectorFunc(Max))
+}
@@ -20,6 +20,7 @@ package aggregation
 import (
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
 )
 
 //go:generate mockgen -source=./group_agg.go -destination=./group_agg_mock.go -package=aggregation
@@ -28,6 +29,8 @@ import (
 type GroupingAggregator interface {
 	// Aggregate aggregates the time series data
 	Aggregate(it series.GroupedIterator)
+	// AggregatePoint aggregates a single point of the field into the time series with given tags.
+	AggregatePoint(tags string, fieldName field.Name, timestamp int64, value float64)
 	// ResultSet returns the result set of aggregator
 	ResultSet() series.GroupedIterators
 	// TimeRange return the time range of aggregator.
@@ -97,6 +100,27 @@ func (ga *groupingAggregator) Aggregate(it series.GroupedIterator) {
 	}
 }
 
+// AggregatePoint aggregates a single point of the field into the time series with given tags,
+// drops the point if field not in aggregator specs or timestamp out of time range.
+func (ga *groupingAggregator) AggregatePoint(tags string, fieldName field.Name, timestamp int64, value float64) {
+	if timestamp < ga.timeRange.Start || timestamp > ga.timeRange.End {
+		return
+	}
+	seriesAgg := ga.getAggregator(tags)
+	for _, sAgg := range seriesAgg {
+		if sAgg.FieldName() != fieldName {
+			continue
+		}
+		calc := ga.interval.Calculator()
+		familyTime := calc.CalcFamilyTime(timestamp)
+		aggregator, ok := sAgg.GetAggregator(familyTime)
+		if ok {
+			aggregator.AggregateBySlot(calc.CalcSlot(timestamp, familyTime, ga.interval.Int64()), value)
+		}
+		return
+	}
+}
+
 // ResultSet returns the result set of aggregator.
 func (ga *groupingAggregator) ResultSet() series.GroupedIterators {
 	length := len(ga.aggregates)
@@ -23,6 +23,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
@@ -124,3 +125,48 @@ func TestGroupByAggregator_Aggregate(t *testing.T) {
 			End:   now + 3*timeutil.OneHour,
 		}, agg.TimeRange())
 }
+
+func TestGroupByAggregator_AggregatePoint(t *testing.T) {
+	now, _ := timeutil.ParseTimestamp("20190702 19:10:00", "20060102 15:04:05")
+	spec := NewAggregatorSpec("b", field.SumField)
+	spec.AddFunctionType(function.Sum)
+	agg := NewGroupingAggregator(
+		timeutil.Interval(10*timeutil.OneSecond),
+		1,
+		timeutil.TimeRange{
+			Start: now,
+			End:   now + timeutil.OneHour,
+		},
+		AggregatorSpecs{spec})
+	agg.AggregatePoint("tags", "b", now+10*timeutil.OneSecond, 1)
+	agg.AggregatePoint("tags", "b", now+10*timeutil.OneSecond, 2)
+	agg.AggregatePoint("tags", "b", now+30*timeutil.OneSecond, 3)
+	// field not found
+	agg.AggregatePoint("tags", "c", now+10*timeutil.OneSecond, 3)
+	// out of time range
+	agg.AggregatePoint("tags", "b", now-timeutil.OneHour, 3)
+	agg.AggregatePoint("tags", "b", now+2*timeutil.OneHour, 3)
+
+	rs := agg.ResultSet()
+	assert.Len(t, rs, 1)
+	assert.Equal(t, "tags", rs[0].Tags())
+	values := make(map[int64]float64)
+	for rs[0].HasNext() {
+		sIt := rs[0].Next()
+		assert.Equal(t, field.Name("b"), sIt.FieldName())
+		for sIt.HasNext() {
+			startTime, fIt := sIt.Next()
+			for fIt.HasNext() {
+				pIt := fIt.Next()
+				for pIt.HasNext() {
+					slot, value := pIt.Next()
+					values[startTime+int64(slot)*10*timeutil.OneSecond] = value
+				}
+			}
+		}
+	}
+	assert.Equal(t, map[int64]float64{
+		now + 10*timeutil.OneSecond: 3,
+		now + 30*timeutil.OneSecond: 3,
+	}, values)
+}
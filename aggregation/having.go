@@ -0,0 +1,167 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// HavingFilter represents the filter which drops the grouped time series not matching having condition.
+type HavingFilter struct {
+	condition stmt.Expr
+	operands  []stmt.Expr
+}
+
+// NewHavingFilter creates a having filter, returns nil if condition is nil.
+func NewHavingFilter(condition stmt.Expr) *HavingFilter {
+	if condition == nil {
+		return nil
+	}
+	f := &HavingFilter{condition: condition}
+	f.collectOperands(condition)
+	return f
+}
+
+// Operands returns the operands of comparison expression which need to be evaluated as select item,
+// the eval result key of operand is expr's rewrite value.
+func (f *HavingFilter) Operands() []stmt.Expr {
+	return f.operands
+}
+
+// Filter returns if the eval result set of grouped time series matches having condition.
+func (f *HavingFilter) Filter(resultSet map[string]*collections.FloatArray) bool {
+	return f.match(f.condition, resultSet)
+}
+
+// collectOperands collects the operands of comparison expression.
+func (f *HavingFilter) collectOperands(expr stmt.Expr) {
+	switch e := expr.(type) {
+	case *stmt.ParenExpr:
+		f.collectOperands(e.Expr)
+	case *stmt.BinaryExpr:
+		if e.Operator == stmt.AND || e.Operator == stmt.OR {
+			f.collectOperands(e.Left)
+			f.collectOperands(e.Right)
+			return
+		}
+		for _, operand := range []stmt.Expr{e.Left, e.Right} {
+			if _, ok := operand.(*stmt.NumberLiteral); !ok && operand != nil {
+				f.operands = append(f.operands, &stmt.SelectItem{Expr: operand})
+			}
+		}
+	}
+}
+
+// match evaluates the bool expression with eval result set.
+func (f *HavingFilter) match(expr stmt.Expr, resultSet map[string]*collections.FloatArray) bool {
+	switch e := expr.(type) {
+	case *stmt.ParenExpr:
+		return f.match(e.Expr, resultSet)
+	case *stmt.BinaryExpr:
+		switch e.Operator {
+		case stmt.AND:
+			return f.match(e.Left, resultSet) && f.match(e.Right, resultSet)
+		case stmt.OR:
+			return f.match(e.Left, resultSet) || f.match(e.Right, resultSet)
+		}
+		left := f.value(e.Left, resultSet)
+		right := f.value(e.Right, resultSet)
+		if math.IsNaN(left) || math.IsNaN(right) {
+			return false
+		}
+		return compare(e.Operator, left, right)
+	default:
+		return false
+	}
+}
+
+// value returns the value of operand, reduces the series data over query time range, returns NaN if no data.
+func (f *HavingFilter) value(expr stmt.Expr, resultSet map[string]*collections.FloatArray) float64 {
+	if expr == nil {
+		return math.NaN()
+	}
+	if number, ok := expr.(*stmt.NumberLiteral); ok {
+		return number.Val
+	}
+	values, ok := resultSet[expr.Rewrite()]
+	if !ok || values == nil || values.IsEmpty() {
+		return math.NaN()
+	}
+	funcType := function.Unknown
+	if call, ok := expr.(*stmt.CallExpr); ok {
+		funcType = call.FuncType
+	}
+	return reduce(funcType, values)
+}
+
+// reduce reduces the series data to single value based on function type:
+// sum/count => sum, min => min, max => max, first => first, last => last, others => avg.
+func reduce(funcType function.FuncType, values *collections.FloatArray) float64 {
+	var (
+		result float64
+		count  int
+	)
+	it := values.NewIterator()
+	for it.HasNext() {
+		_, val := it.Next()
+		count++
+		switch {
+		case count == 1:
+			result = val
+		case funcType == function.Min:
+			result = math.Min(result, val)
+		case funcType == function.Max:
+			result = math.Max(result, val)
+		case funcType == function.First:
+		case funcType == function.Last:
+			result = val
+		default:
+			result += val
+		}
+	}
+	switch funcType {
+	case function.Sum, function.Count, function.Min, function.Max, function.First, function.Last:
+		return result
+	default:
+		return result / float64(count)
+	}
+}
+
+// compare compares two values based on comparison operator.
+func compare(op stmt.BinaryOP, left, right float64) bool {
+	switch op {
+	case stmt.EQUAL:
+		return left == right
+	case stmt.NOTEQUAL:
+		return left != right
+	case stmt.LESS:
+		return left < right
+	case stmt.LESSEQUAL:
+		return left <= right
+	case stmt.GREATER:
+		return left > right
+	case stmt.GREATEREQUAL:
+		return left >= right
+	default:
+		return false
+	}
+}
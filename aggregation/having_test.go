@@ -0,0 +1,112 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestNewHavingFilter(t *testing.T) {
+	assert.Nil(t, NewHavingFilter(nil))
+
+	maxF := &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}}
+	filter := NewHavingFilter(&stmt.BinaryExpr{
+		Left: &stmt.ParenExpr{Expr: &stmt.BinaryExpr{
+			Left: maxF, Operator: stmt.GREATER, Right: &stmt.NumberLiteral{Val: 1},
+		}},
+		Operator: stmt.OR,
+		Right: &stmt.BinaryExpr{
+			Left: &stmt.FieldExpr{Name: "g"}, Operator: stmt.LESS, Right: maxF,
+		},
+	})
+	assert.Equal(t, []stmt.Expr{
+		&stmt.SelectItem{Expr: maxF},
+		&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "g"}},
+		&stmt.SelectItem{Expr: maxF},
+	}, filter.Operands())
+}
+
+func TestHavingFilter_Filter(t *testing.T) {
+	values := collections.NewFloatArray(5)
+	values.SetValue(1, 4)
+	values.SetValue(2, 1)
+	values.SetValue(4, 7)
+	call := func(funcType function.FuncType) stmt.Expr {
+		return &stmt.CallExpr{FuncType: funcType, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}}
+	}
+	resultSet := make(map[string]*collections.FloatArray)
+	for _, funcType := range []function.FuncType{function.Sum, function.Count, function.Min,
+		function.Max, function.First, function.Last, function.Avg} {
+		resultSet[call(funcType).Rewrite()] = values
+	}
+	resultSet["f"] = values
+	resultSet["empty"] = collections.NewFloatArray(5)
+	compareExpr := func(left stmt.Expr, op stmt.BinaryOP, val float64) stmt.Expr {
+		return &stmt.BinaryExpr{Left: left, Operator: op, Right: &stmt.NumberLiteral{Val: val}}
+	}
+	cases := []struct {
+		name      string
+		condition stmt.Expr
+		match     bool
+	}{
+		{name: "sum", condition: compareExpr(call(function.Sum), stmt.EQUAL, 12), match: true},
+		{name: "count", condition: compareExpr(call(function.Count), stmt.NOTEQUAL, 12), match: false},
+		{name: "min", condition: compareExpr(call(function.Min), stmt.LESS, 2), match: true},
+		{name: "max", condition: compareExpr(call(function.Max), stmt.LESSEQUAL, 7), match: true},
+		{name: "first", condition: compareExpr(call(function.First), stmt.GREATER, 4), match: false},
+		{name: "last", condition: compareExpr(call(function.Last), stmt.GREATEREQUAL, 7), match: true},
+		{name: "avg", condition: compareExpr(call(function.Avg), stmt.EQUAL, 4), match: true},
+		{name: "field use avg", condition: compareExpr(&stmt.FieldExpr{Name: "f"}, stmt.EQUAL, 4), match: true},
+		{name: "not found", condition: compareExpr(&stmt.FieldExpr{Name: "a"}, stmt.LESS, 100), match: false},
+		{name: "empty values", condition: compareExpr(&stmt.FieldExpr{Name: "empty"}, stmt.LESS, 100), match: false},
+		{name: "unknown operator", condition: compareExpr(&stmt.FieldExpr{Name: "f"}, stmt.UNKNOWN, 4), match: false},
+		{name: "nil operand", condition: &stmt.BinaryExpr{Left: &stmt.FieldExpr{Name: "f"}, Operator: stmt.EQUAL}, match: false},
+		{name: "not bool expr", condition: &stmt.FieldExpr{Name: "f"}, match: false},
+		{
+			name: "and",
+			condition: &stmt.BinaryExpr{
+				Left:     compareExpr(call(function.Sum), stmt.GREATER, 10),
+				Operator: stmt.AND,
+				Right:    &stmt.ParenExpr{Expr: compareExpr(call(function.Max), stmt.LESS, 5)},
+			},
+			match: false,
+		},
+		{
+			name: "or",
+			condition: &stmt.BinaryExpr{
+				Left:     compareExpr(call(function.Sum), stmt.GREATER, 10),
+				Operator: stmt.OR,
+				Right:    compareExpr(call(function.Max), stmt.LESS, 5),
+			},
+			match: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			filter := NewHavingFilter(tt.condition)
+			assert.Equal(t, tt.match, filter.Filter(resultSet))
+		})
+	}
+}
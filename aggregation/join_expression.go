@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// JoinExpression represents the expression eval across metrics(cross-metric query),
+// field exprs of select items reference the values which each metric's sub query evaluated.
+type JoinExpression interface {
+	// Eval evaluates the select item's expression based on the joined values, field name => series data.
+	Eval(values map[string]*collections.FloatArray)
+	// ResultSet returns the eval result, returns field name(alias) => series data.
+	ResultSet() map[string]*collections.FloatArray
+}
+
+// joinExpression implements JoinExpression interface.
+type joinExpression struct {
+	*expression
+}
+
+// NewJoinExpression creates a JoinExpression instance.
+func NewJoinExpression(timeRange timeutil.TimeRange, interval int64, selectItems []stmt.Expr) JoinExpression {
+	return &joinExpression{
+		expression: NewExpression(timeRange, interval, selectItems).(*expression),
+	}
+}
+
+// Eval evaluates the select item's expression based on the joined values.
+func (e *joinExpression) Eval(values map[string]*collections.FloatArray) {
+	if len(e.selectItems) == 0 || len(values) == 0 {
+		return
+	}
+	e.values = values
+	e.evalSelectItems()
+}
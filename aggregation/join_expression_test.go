@@ -0,0 +1,73 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestJoinExpression_Eval(t *testing.T) {
+	newValues := func(points ...float64) *collections.FloatArray {
+		values := collections.NewFloatArray(len(points))
+		for idx, point := range points {
+			values.SetValue(idx, point)
+		}
+		return values
+	}
+	timeRange := timeutil.TimeRange{Start: now, End: now + 2*timeutil.OneMinute}
+	selectItems := []stmt.Expr{
+		&stmt.SelectItem{
+			Expr: &stmt.BinaryExpr{
+				Left: &stmt.FieldExpr{Name: "e"}, Operator: stmt.DIV, Right: &stmt.FieldExpr{Name: "r"},
+			},
+			Alias: "ratio",
+		},
+		&stmt.SelectItem{
+			Expr: &stmt.CallExpr{
+				FuncType: function.Abs,
+				Params: []stmt.Expr{&stmt.BinaryExpr{
+					Left: &stmt.FieldExpr{Name: "e"}, Operator: stmt.SUB, Right: &stmt.FieldExpr{Name: "r"},
+				}},
+			},
+		},
+		&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "not_found"}},
+	}
+
+	expression := NewJoinExpression(timeRange, timeutil.OneMinute, nil)
+	expression.Eval(map[string]*collections.FloatArray{"e": newValues(1)})
+	assert.Empty(t, expression.ResultSet())
+	expression = NewJoinExpression(timeRange, timeutil.OneMinute, selectItems)
+	expression.Eval(nil)
+	assert.Empty(t, expression.ResultSet())
+
+	expression.Eval(map[string]*collections.FloatArray{
+		"e": newValues(1, 2, 3),
+		"r": newValues(10, 10, 10),
+	})
+	rs := expression.ResultSet()
+	assert.Len(t, rs, 2)
+	assert.InDelta(t, 0.2, rs["ratio"].GetValue(1), 0.0001)
+	assert.Equal(t, 7.0, rs["abs(e-r)"].GetValue(2))
+}
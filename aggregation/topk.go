@@ -0,0 +1,163 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/series/tag"
+)
+
+// TopKItem represents the topk/bottomk select item.
+type TopKItem struct {
+	Name      string                       // field name(alias) of ranked select item
+	FuncType  function.FuncType            // function for ranking series
+	K         int                          // num. of series kept for each partition
+	Bottom    bool                         // keeps the smallest series if bottom
+	Partition int                          // index of partition tag value, -1 means no partition
+	Others    string                       // label of others series, empty means dropping the remainder
+	Rollups   map[string]function.FuncType // function for rolling up each field into others series
+}
+
+// topKOrderBy implements OrderBy interface(topk/bottomk across groups).
+type topKOrderBy struct {
+	item       *TopKItem
+	partitions map[string][]Row
+	keys       []string // keep partition order
+}
+
+// NewTopKOrderBy creates a topk/bottomk container instance.
+func NewTopKOrderBy(item *TopKItem) OrderBy {
+	return &topKOrderBy{
+		item:       item,
+		partitions: make(map[string][]Row),
+	}
+}
+
+// Push pushes row into the partition which row belongs to.
+func (o *topKOrderBy) Push(row Row) {
+	var key string
+	if o.item.Partition >= 0 {
+		tags, _ := row.ResultSet()
+		tagValues := tag.SplitTagValues(tags)
+		if o.item.Partition < len(tagValues) {
+			key = tagValues[o.item.Partition]
+		}
+	}
+	rows, ok := o.partitions[key]
+	if !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.partitions[key] = append(rows, row)
+}
+
+// ResultSet returns K rows of each partition, rolls up the remainder into others row if need.
+func (o *topKOrderBy) ResultSet() []Row {
+	var result []Row
+	for _, key := range o.keys {
+		rows := o.partitions[key]
+		sort.SliceStable(rows, func(i, j int) bool {
+			left := rows[i].GetValue(o.item.Name, o.item.FuncType)
+			right := rows[j].GetValue(o.item.Name, o.item.FuncType)
+			if o.item.Bottom {
+				return left < right
+			}
+			return left > right
+		})
+		if len(rows) <= o.item.K {
+			result = append(result, rows...)
+			continue
+		}
+		result = append(result, rows[:o.item.K]...)
+		if o.item.Others != "" {
+			result = append(result, o.rollup(rows[o.item.K:]))
+		}
+	}
+	return result
+}
+
+// rollup rolls up the remainder rows into one others row.
+func (o *topKOrderBy) rollup(rows []Row) Row {
+	tags, _ := rows[0].ResultSet()
+	tagValues := tag.SplitTagValues(tags)
+	for idx := range tagValues {
+		if idx != o.item.Partition {
+			tagValues[idx] = o.item.Others
+		}
+	}
+	fields := make(map[string]*collections.FloatArray)
+	counts := make(map[string][]int)
+	for _, row := range rows {
+		_, rowFields := row.ResultSet()
+		for fieldName, values := range rowFields {
+			if values == nil {
+				continue
+			}
+			funcType := o.item.Rollups[fieldName]
+			target, ok := fields[fieldName]
+			if !ok {
+				target = collections.NewFloatArray(values.Capacity())
+				fields[fieldName] = target
+				counts[fieldName] = make([]int, values.Capacity())
+			}
+			count := counts[fieldName]
+			it := values.NewIterator()
+			for it.HasNext() {
+				slot, val := it.Next()
+				if math.IsNaN(val) || slot >= len(count) {
+					continue
+				}
+				if count[slot] == 0 {
+					target.SetValue(slot, val)
+				} else {
+					target.SetValue(slot, rollupValue(funcType, target.GetValue(slot), val))
+				}
+				count[slot]++
+			}
+		}
+	}
+	for fieldName, target := range fields {
+		if funcType := o.item.Rollups[fieldName]; funcType == function.Sum || funcType == function.Count ||
+			funcType == function.Min || funcType == function.Max {
+			continue
+		}
+		// others series of avg is the mean of the remainder series
+		count := counts[fieldName]
+		it := target.NewIterator()
+		for it.HasNext() {
+			slot, val := it.Next()
+			target.SetValue(slot, val/float64(count[slot]))
+		}
+	}
+	return NewOrderByRow(tag.ConcatTagValues(tagValues), fields)
+}
+
+// rollupValue merges the value of remainder series based on function type, sums value if not min/max.
+func rollupValue(funcType function.FuncType, left, right float64) float64 {
+	switch funcType {
+	case function.Min:
+		return math.Min(left, right)
+	case function.Max:
+		return math.Max(left, right)
+	default:
+		return left + right
+	}
+}
@@ -0,0 +1,113 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/series/tag"
+)
+
+func newTopKRow(tags []string, values map[string][]float64) Row {
+	fields := make(map[string]*collections.FloatArray)
+	for fieldName, points := range values {
+		array := collections.NewFloatArray(len(points))
+		for idx, point := range points {
+			array.SetValue(idx, point)
+		}
+		fields[fieldName] = array
+	}
+	return NewOrderByRow(tag.ConcatTagValues(tags), fields)
+}
+
+func TestTopKOrderBy_ResultSet(t *testing.T) {
+	rows := []Row{
+		newTopKRow([]string{"h1"}, map[string][]float64{"f": {1, 1}, "max": {1, 3}}),
+		newTopKRow([]string{"h2"}, map[string][]float64{"f": {5, 5}, "max": {5, 5}}),
+		newTopKRow([]string{"h3"}, map[string][]float64{"f": {2, 4}, "max": {2, math.NaN()}}),
+		newTopKRow([]string{"h4"}, map[string][]float64{"f": {3, 3}, "max": {3, 3}}),
+	}
+	tagsOf := func(rs []Row) (result []string) {
+		for _, row := range rs {
+			tags, _ := row.ResultSet()
+			result = append(result, tags)
+		}
+		return
+	}
+
+	t.Run("topk without others", func(t *testing.T) {
+		orderBy := NewTopKOrderBy(&TopKItem{Name: "f", FuncType: function.Sum, K: 2, Partition: -1})
+		for _, row := range rows {
+			orderBy.Push(row)
+		}
+		assert.Equal(t, []string{"h2", "h3"}, tagsOf(orderBy.ResultSet()))
+	})
+	t.Run("bottomk with others", func(t *testing.T) {
+		orderBy := NewTopKOrderBy(&TopKItem{
+			Name: "f", FuncType: function.Avg, K: 1, Bottom: true, Partition: -1, Others: "others",
+			Rollups: map[string]function.FuncType{"f": function.Sum, "max": function.Max},
+		})
+		for _, row := range rows {
+			orderBy.Push(row)
+		}
+		rs := orderBy.ResultSet()
+		assert.Equal(t, []string{"h1", "others"}, tagsOf(rs))
+		_, fields := rs[1].ResultSet()
+		assert.Equal(t, 10.0, fields["f"].GetValue(0))
+		assert.Equal(t, 12.0, fields["f"].GetValue(1))
+		assert.Equal(t, 5.0, fields["max"].GetValue(0))
+		assert.Equal(t, 5.0, fields["max"].GetValue(1))
+	})
+	t.Run("k larger than rows", func(t *testing.T) {
+		orderBy := NewTopKOrderBy(&TopKItem{Name: "f", FuncType: function.Sum, K: 10, Partition: -1, Others: "others"})
+		for _, row := range rows {
+			orderBy.Push(row)
+		}
+		assert.Len(t, orderBy.ResultSet(), 4)
+	})
+}
+
+func TestTopKOrderBy_Partition(t *testing.T) {
+	orderBy := NewTopKOrderBy(&TopKItem{
+		Name: "f", FuncType: function.Last, K: 1, Partition: 0, Others: "others",
+		Rollups: map[string]function.FuncType{"f": function.Min, "g": function.Avg},
+	})
+	orderBy.Push(newTopKRow([]string{"r1", "h1"}, map[string][]float64{"f": {1}, "g": {1}}))
+	orderBy.Push(newTopKRow([]string{"r1", "h2"}, map[string][]float64{"f": {2}, "g": {2}}))
+	orderBy.Push(newTopKRow([]string{"r1", "h3"}, map[string][]float64{"f": {3}, "g": {3}}))
+	orderBy.Push(newTopKRow([]string{"r2", "h1"}, map[string][]float64{"f": {4}, "g": {4}}))
+	orderBy.Push(NewOrderByRow("r3", map[string]*collections.FloatArray{"f": nil}))
+
+	rs := orderBy.ResultSet()
+	assert.Len(t, rs, 4)
+	tags, _ := rs[0].ResultSet()
+	assert.Equal(t, tag.ConcatTagValues([]string{"r1", "h3"}), tags)
+	tags, fields := rs[1].ResultSet()
+	assert.Equal(t, tag.ConcatTagValues([]string{"r1", "others"}), tags)
+	assert.Equal(t, 1.0, fields["f"].GetValue(0))
+	assert.Equal(t, 1.5, fields["g"].GetValue(0))
+	tags, _ = rs[2].ResultSet()
+	assert.Equal(t, tag.ConcatTagValues([]string{"r2", "h1"}), tags)
+	tags, _ = rs[3].ResultSet()
+	assert.Equal(t, "r3", tags)
+}
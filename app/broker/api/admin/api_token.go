@@ -0,0 +1,189 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"errors"
+	nethttp "net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/app/broker/auth"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+var (
+	// APITokenPath represents api token admin api path.
+	APITokenPath = "/auth/token"
+	// for testing
+	newAPITokenFn = models.NewAPIToken
+)
+
+// apiTokenResult represents the result of api token creation, token is only returned once.
+type apiTokenResult struct {
+	models.APIToken
+	Token string `json:"token"`
+}
+
+// APITokenAPI represents api token admin rest api, which issues/revokes api tokens for ingestion/query clients.
+type APITokenAPI struct {
+	deps   *depspkg.HTTPDeps
+	logger *logger.Logger
+}
+
+// NewAPITokenAPI creates api token api instance.
+func NewAPITokenAPI(deps *depspkg.HTTPDeps) *APITokenAPI {
+	return &APITokenAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "APITokenAPI"),
+	}
+}
+
+// Register adds api token admin url route.
+func (api *APITokenAPI) Register(route gin.IRoutes) {
+	route.POST(APITokenPath, api.Create)
+	route.GET(APITokenPath, api.List)
+	route.DELETE(APITokenPath, api.Revoke)
+}
+
+// Create issues a new api token scoped to one database with read or write privilege,
+// requires admin privilege on the database.
+//
+// @Summary create api token
+// @Description issue a long-lived api token scoped to one database with read or write privilege.
+// @Tags Auth
+// @Accept json
+// @Param param body object true "name/database/privilege(read|write)"
+// @Produce json
+// @Success 200 {object} object "api token with token string(only returned once)"
+// @Failure 403 {string} string "permission denied"
+// @Failure 500 {string} string "internal error"
+// @Router /auth/token [post]
+func (api *APITokenAPI) Create(c *gin.Context) {
+	var param struct {
+		Name      string `json:"name" binding:"required"`
+		Database  string `json:"database" binding:"required"`
+		Privilege string `json:"privilege" binding:"required"`
+	}
+	if err := c.ShouldBind(&param); err != nil {
+		http.Error(c, err)
+		return
+	}
+	if err := auth.CheckPrivilege(c, api.deps, param.Database, models.AdminPrivilege); err != nil {
+		http.ErrorWithCode(c, nethttp.StatusForbidden, err)
+		return
+	}
+	if _, ok := api.deps.StateMgr.GetDatabaseCfg(param.Database); !ok {
+		http.Error(c, constants.ErrDatabaseNotExist)
+		return
+	}
+	privilege, err := models.ParsePrivilege(param.Privilege)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	token, tokenString, err := newAPITokenFn(param.Name, param.Database, privilege)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	ctx, cancel := api.deps.WithTimeout()
+	defer cancel()
+	if err := api.deps.Repo.Put(ctx, constants.GetAPITokenPath(token.ID), encoding.JSONMarshal(token)); err != nil {
+		http.Error(c, err)
+		return
+	}
+	api.logger.Info("api token created", logger.String("id", token.ID),
+		logger.String("name", token.Name), logger.String("database", token.Database))
+	token.Secret = ""
+	http.OK(c, &apiTokenResult{APIToken: *token, Token: tokenString})
+}
+
+// List returns the api tokens(without secret) of databases which current user has admin privilege.
+//
+// @Summary list api tokens
+// @Description list api tokens of databases which current user has admin privilege.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} []models.APIToken
+// @Router /auth/token [get]
+func (api *APITokenAPI) List(c *gin.Context) {
+	rs := make([]models.APIToken, 0)
+	for _, token := range api.deps.StateMgr.GetAPITokens() {
+		if auth.CheckPrivilege(c, api.deps, token.Database, models.AdminPrivilege) != nil {
+			continue
+		}
+		token.Secret = ""
+		rs = append(rs, token)
+	}
+	http.OK(c, rs)
+}
+
+// Revoke revokes the api token by id, requires admin privilege on the database of token.
+//
+// @Summary revoke api token
+// @Description revoke api token by id.
+// @Tags Auth
+// @Param id query string true "api token id"
+// @Success 204 {string} string ""
+// @Failure 403 {string} string "permission denied"
+// @Failure 404 {string} string "not found"
+// @Failure 500 {string} string "internal error"
+// @Router /auth/token [delete]
+func (api *APITokenAPI) Revoke(c *gin.Context) {
+	var param struct {
+		ID string `form:"id" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		http.Error(c, err)
+		return
+	}
+	ctx, cancel := api.deps.WithTimeout()
+	defer cancel()
+	data, err := api.deps.Repo.Get(ctx, constants.GetAPITokenPath(param.ID))
+	if errors.Is(err, state.ErrNotExist) {
+		http.NotFound(c)
+		return
+	}
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	token := &models.APIToken{}
+	if err = encoding.JSONUnmarshal(data, token); err != nil {
+		http.Error(c, err)
+		return
+	}
+	if err = auth.CheckPrivilege(c, api.deps, token.Database, models.AdminPrivilege); err != nil {
+		http.ErrorWithCode(c, nethttp.StatusForbidden, err)
+		return
+	}
+	if err = api.deps.Repo.Delete(ctx, constants.GetAPITokenPath(param.ID)); err != nil {
+		http.Error(c, err)
+		return
+	}
+	api.logger.Info("api token revoked", logger.String("id", token.ID),
+		logger.String("name", token.Name), logger.String("database", token.Database))
+	http.NoContent(c)
+}
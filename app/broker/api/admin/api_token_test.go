@@ -0,0 +1,150 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func TestAPITokenAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newAPITokenFn = models.NewAPIToken
+		ctrl.Finish()
+	}()
+
+	repo := state.NewMockRepository(ctrl)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	brokerCfg := &config.Broker{BrokerBase: config.BrokerBase{
+		HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second)},
+		Auth: config.Auth{UserName: "admin"},
+	}}
+	api := NewAPITokenAPI(&deps.HTTPDeps{
+		Ctx:       context.Background(),
+		Repo:      repo,
+		StateMgr:  stateMgr,
+		BrokerCfg: brokerCfg,
+	})
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if user := c.GetHeader("user"); user != "" {
+			c.Set(constants.CurrentUser, user)
+		}
+	})
+	api.Register(r)
+	header := http.Header{}
+	header.Set("content-type", "application/json")
+	header.Set("user", "test")
+	stateMgr.EXPECT().GetUser("test").Return(models.User{Name: "test", Roles: []string{"dba"}}, true).AnyTimes()
+	stateMgr.EXPECT().GetRole("dba").Return(models.Role{Name: "dba", Grants: []models.Grant{
+		{Database: "db", Privilege: models.AdminPrivilege},
+	}}, true).AnyTimes()
+
+	// create
+	body := `{"name":"agent","database":"db","privilege":"write"}`
+	resp := mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	brokerCfg.BrokerBase.Auth.Enabled = true
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"agent","database":"db2","privilege":"write"}`, header)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{}, false)
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, body, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{}, true).AnyTimes()
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"agent","database":"db","privilege":"all"}`, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"agent","database":"db","privilege":"admin"}`, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, body, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	var saved []byte
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+		saved = data
+		return nil
+	})
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, body, header)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	rs := &apiTokenResult{}
+	assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), rs))
+	assert.Empty(t, rs.Secret)
+	token := &models.APIToken{}
+	assert.NoError(t, encoding.JSONUnmarshal(saved, token))
+	id, secret, ok := models.ParseAPIToken(rs.Token)
+	assert.True(t, ok)
+	assert.Equal(t, token.ID, id)
+	assert.True(t, token.CheckSecret(secret))
+
+	// list
+	stateMgr.EXPECT().GetAPITokens().Return([]models.APIToken{*token, {ID: "2", Database: "db2"}})
+	resp = mock.DoRequest(t, r, http.MethodGet, APITokenPath, "", header)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var tokens []models.APIToken
+	assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &tokens))
+	assert.Len(t, tokens, 1)
+	assert.Empty(t, tokens[0].Secret)
+
+	// revoke
+	revokePath := APITokenPath + "?id=" + token.ID
+	resp = mock.DoRequest(t, r, http.MethodDelete, APITokenPath, "", header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+	resp = mock.DoRequest(t, r, http.MethodDelete, revokePath, "", header)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodDelete, revokePath, "", header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("abc"), nil)
+	resp = mock.DoRequest(t, r, http.MethodDelete, revokePath, "", header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(`{"id":"2","database":"db2"}`), nil)
+	resp = mock.DoRequest(t, r, http.MethodDelete, APITokenPath+"?id=2", "", header)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(saved, nil).Times(2)
+	repo.EXPECT().Delete(gomock.Any(), constants.GetAPITokenPath(token.ID)).Return(fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodDelete, revokePath, "", header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	repo.EXPECT().Delete(gomock.Any(), constants.GetAPITokenPath(token.ID)).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodDelete, revokePath, "", header)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+
+	// create token failure
+	newAPITokenFn = func(_, _ string, _ models.Privilege) (*models.APIToken, string, error) {
+		return nil, "", fmt.Errorf("err")
+	}
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, body, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
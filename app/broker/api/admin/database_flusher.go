@@ -23,7 +23,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/lindb/lindb/app/broker/auth"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/models"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/logger"
 )
@@ -66,6 +68,10 @@ func (df *DatabaseFlusherAPI) SubmitFlushTask(c *gin.Context) {
 		httppkg.Error(c, err)
 		return
 	}
+	if err = auth.CheckPrivilege(c, df.deps, param.Database, models.AdminPrivilege); err != nil {
+		httppkg.ErrorWithCode(c, http.StatusForbidden, err)
+		return
+	}
 	if df.deps.Master.IsMaster() {
 		// if current node is master, submits the flush task
 		if err := df.deps.Master.FlushDatabase(param.Cluster, param.Database); err != nil {
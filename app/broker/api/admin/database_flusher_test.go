@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/internal/mock"
 	"github.com/lindb/lindb/models"
@@ -48,8 +49,10 @@ func TestNewDatabaseFlusherAPI(t *testing.T) {
 	defer ctrl.Finish()
 
 	master := coordinator.NewMockMasterController(ctrl)
+	brokerCfg := &config.Broker{}
 	flushAPI := NewDatabaseFlusherAPI(&deps.HTTPDeps{
-		Master: master,
+		Master:    master,
+		BrokerCfg: brokerCfg,
 	})
 	r := gin.New()
 	flushAPI.Register(r)
@@ -62,6 +65,12 @@ func TestNewDatabaseFlusherAPI(t *testing.T) {
 	resp = mock.DoRequest(t, r, http.MethodPut, FlushDatabasePath, ``)
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 
+	// permission denied
+	brokerCfg.BrokerBase.Auth.Enabled = true
+	resp = mock.DoRequest(t, r, http.MethodPut, FlushDatabasePath, `{"cluster":"test","database":"db"}`)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	brokerCfg.BrokerBase.Auth.Enabled = false
+
 	// submit err
 	master.EXPECT().IsMaster().Return(true)
 	master.EXPECT().FlushDatabase(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
@@ -18,11 +18,15 @@
 package admin
 
 import (
+	nethttp "net/http"
+
 	"github.com/gin-gonic/gin"
 
+	"github.com/lindb/lindb/app/broker/auth"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/logger"
@@ -88,6 +92,10 @@ func (s *StorageClusterAPI) DeleteByName(c *gin.Context) {
 		http.Error(c, err)
 		return
 	}
+	if err = auth.CheckPrivilege(c, s.deps, models.AllDatabases, models.AdminPrivilege); err != nil {
+		http.ErrorWithCode(c, nethttp.StatusForbidden, err)
+		return
+	}
 	ctx, cancel := s.deps.WithTimeout()
 	defer cancel()
 	if err = s.deps.Repo.Delete(ctx, constants.GetStorageClusterConfigPath(param.ClusterName)); err != nil {
@@ -128,6 +128,19 @@ func TestStorageClusterAPI(t *testing.T) {
 				assert.Equal(t, http.StatusInternalServerError, resp.Code)
 			},
 		},
+		{
+			"delete storage, permission denied",
+			http.MethodDelete,
+			StorageClusterPath + "?name=test1",
+			``,
+			func() {
+				api.deps.BrokerCfg.BrokerBase.Auth.Enabled = true
+			},
+			func(resp *httptest.ResponseRecorder) {
+				api.deps.BrokerCfg.BrokerBase.Auth.Enabled = false
+				assert.Equal(t, http.StatusForbidden, resp.Code)
+			},
+		},
 		{
 			"delete storage failure",
 			http.MethodDelete,
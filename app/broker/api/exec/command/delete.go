@@ -0,0 +1,57 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"strings"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// DeleteCommand executes delete series/drop metric statement,
+// saves the series deletion into state repo, then storage tombstones the matched series asynchronously.
+func DeleteCommand(ctx context.Context, deps *depspkg.HTTPDeps, param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	db := strings.TrimSpace(param.Database)
+	if db == "" {
+		return nil, constants.ErrDatabaseNameRequired
+	}
+	if _, ok := deps.StateMgr.GetDatabaseCfg(db); !ok {
+		return nil, constants.ErrDatabaseNotExist
+	}
+	deleteStmt := stmt.(*stmtpkg.Delete)
+	deletion := models.NewSeriesDeletion(deleteStmt)
+	if err := deps.Repo.Put(ctx, constants.GetDatabaseDeletionPath(db, deletion.ID), encoding.JSONMarshal(deletion)); err != nil {
+		return nil, err
+	}
+	log.Info("delete series",
+		logger.String("database", db),
+		logger.String("namespace", deletion.Namespace),
+		logger.String("metric", deletion.MetricName),
+		logger.Int64("id", deletion.ID))
+	rs := "delete series ok"
+	if deleteStmt.Type == stmtpkg.DropMetric {
+		rs = "drop metric ok"
+	}
+	return &rs, nil
+}
@@ -0,0 +1,116 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{
+		Repo:     repo,
+		StateMgr: stateMgr,
+	}
+	cases := []struct {
+		name      string
+		db        string
+		statement stmt.Statement
+		prepare   func()
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:    "database name not input",
+			wantErr: true,
+		},
+		{
+			name: "database not exist",
+			db:   "test",
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, false)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "save deletion failure",
+			db:        "test",
+			statement: &stmt.Delete{Type: stmt.DropMetric, MetricName: "cpu"},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, true)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "drop metric successfully",
+			db:        "test",
+			statement: &stmt.Delete{Type: stmt.DropMetric, MetricName: "cpu"},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, true)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			want: "drop metric ok",
+		},
+		{
+			name: "delete series successfully",
+			db:   "test",
+			statement: &stmt.Delete{
+				Type:       stmt.DeleteSeries,
+				MetricName: "cpu",
+				Condition:  &stmt.EqualsExpr{Key: "host", Value: "1.1.1.1"},
+			},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, true)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			want: "delete series ok",
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			rs, err := DeleteCommand(context.TODO(), deps, &models.ExecuteParam{Database: tt.db}, tt.statement)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteCommand() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.want != "" {
+				assert.Equal(t, tt.want, *(rs.(*string)))
+			}
+		})
+	}
+}
@@ -41,6 +41,7 @@ func MetricMetadataCommand(ctx context.Context, deps *depspkg.HTTPDeps,
 		statement,
 		&query.SearchMgr{
 			Timeout:      deps.BrokerCfg.Query.Timeout.Duration(),
+			Limits:       deps.StateMgr.GetDatabaseLimits(param.Database),
 			CurNode:      *deps.Node,
 			Choose:       deps.StateMgr,
 			TaskMgr:      deps.TaskMgr,
@@ -21,22 +21,30 @@ import (
 	"context"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/query"
 	"github.com/lindb/lindb/sql/stmt"
 )
 
 func TestMetricMetadataCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
 	defer func() {
 		metricMetadataSearchWithResultFn = query.MetricMetadataSearchWithResult
+		ctrl.Finish()
 	}()
 
+	limits := models.NewDefaultLimits()
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseLimits("test").Return(limits)
 	metricMetadataSearchWithResultFn = func(_ context.Context, _ *models.ExecuteParam,
-		_ *stmt.MetricMetadata, _ *query.SearchMgr) (any, error) {
+		_ *stmt.MetricMetadata, mgr *query.SearchMgr) (any, error) {
+		assert.Equal(t, limits, mgr.Limits)
 		return nil, nil
 	}
 
@@ -45,7 +53,8 @@ func TestMetricMetadataCommand(t *testing.T) {
 		BrokerCfg: &config.Broker{
 			Query: *config.NewDefaultQuery(),
 		},
-	}, nil, &stmt.MetricMetadata{})
+		StateMgr: stateMgr,
+	}, &models.ExecuteParam{Database: "test"}, &stmt.MetricMetadata{})
 	assert.NoError(t, err)
 	assert.Nil(t, rs)
 }
@@ -40,6 +40,7 @@ func QueryCommand(ctx context.Context, deps *depspkg.HTTPDeps,
 		stmt.(*stmtpkg.Query),
 		&query.SearchMgr{
 			Timeout:      deps.BrokerCfg.Query.Timeout.Duration(),
+			Limits:       deps.StateMgr.GetDatabaseLimits(param.Database),
 			CurNode:      *deps.Node,
 			Choose:       deps.StateMgr,
 			TaskMgr:      deps.TaskMgr,
@@ -21,21 +21,29 @@ import (
 	"context"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/query"
 	"github.com/lindb/lindb/sql/stmt"
 )
 
 func TestQueryCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
 	defer func() {
 		metricDataSearchFn = query.MetricDataSearch
+		ctrl.Finish()
 	}()
 
-	metricDataSearchFn = func(_ context.Context, _ *models.ExecuteParam, _ *stmt.Query, _ *query.SearchMgr) (any, error) {
+	limits := models.NewDefaultLimits()
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseLimits("test").Return(limits)
+	metricDataSearchFn = func(_ context.Context, _ *models.ExecuteParam, _ *stmt.Query, mgr *query.SearchMgr) (any, error) {
+		assert.Equal(t, limits, mgr.Limits)
 		return nil, nil
 	}
 
@@ -44,7 +52,8 @@ func TestQueryCommand(t *testing.T) {
 		BrokerCfg: &config.Broker{
 			Query: *config.NewDefaultQuery(),
 		},
-	}, nil, &stmt.Query{})
+		StateMgr: stateMgr,
+	}, &models.ExecuteParam{Database: "test"}, &stmt.Query{})
 	assert.NoError(t, err)
 	assert.Nil(t, rs)
 }
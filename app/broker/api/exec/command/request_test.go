@@ -19,6 +19,7 @@ package command
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -28,6 +29,7 @@ import (
 	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/sql/stmt"
 )
 
@@ -54,3 +56,31 @@ func TestRequst(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, rs)
 }
+
+func TestRequest_Kill(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	transportMgr := rpc.NewMockTransportManager(ctrl)
+	deps := &depspkg.HTTPDeps{
+		Node:         &models.StatelessNode{HostIP: "127.0.0.1", GRPCPort: 9000},
+		StateMgr:     stateMgr,
+		TransportMgr: transportMgr,
+	}
+	stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{
+		{HostIP: "127.0.0.1", GRPCPort: 9000},
+		{HostIP: "127.0.0.2", GRPCPort: 9000},
+	})
+	stateMgr.EXPECT().GetStorageList().Return([]*models.StorageState{{
+		LiveNodes: map[models.NodeID]models.StatefulNode{
+			1: {StatelessNode: models.StatelessNode{HostIP: "127.0.0.3", GRPCPort: 2891}, ID: 1},
+		},
+	}})
+	// send kill request to other broker and storage nodes, ignore send failure
+	transportMgr.EXPECT().SendRequest("127.0.0.2:9000", gomock.Any()).Return(nil)
+	transportMgr.EXPECT().SendRequest("127.0.0.3:2891", gomock.Any()).Return(fmt.Errorf("err"))
+	rs, err := RequestCommand(context.TODO(), deps, nil, &stmt.Request{Type: stmt.RequestOpKill, RequestID: "xxx"})
+	assert.NoError(t, err)
+	assert.Equal(t, "kill query ok", *(rs.(*string)))
+}
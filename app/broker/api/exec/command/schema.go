@@ -116,6 +116,16 @@ func saveDataBase(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Sch
 	// set default value
 	opt.Default()
 	database.Option = opt // reset option after set default value
+	// validate shard routing option
+	if err = database.Routing.Validate(); err != nil {
+		return nil, err
+	}
+	if err = database.WriteConsistency.Validate(); err != nil {
+		return nil, err
+	}
+	if err = database.LeaderPlacement.Validate(); err != nil {
+		return nil, err
+	}
 
 	// check storage cluster if exist
 	_, err = deps.Repo.Get(ctx, constants.GetStorageClusterConfigPath(database.Storage))
@@ -125,6 +135,21 @@ func saveDataBase(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Sch
 	if err != nil {
 		return nil, err
 	}
+	// check if shard routing changed, changing routing reshuffles all series
+	existData, err := deps.Repo.Get(ctx, constants.GetDatabaseConfigPath(database.Name))
+	if err != nil && !errors.Is(err, state.ErrNotExist) {
+		return nil, err
+	}
+	if err == nil {
+		existDatabase := &models.Database{}
+		if err = encoding.JSONUnmarshal(existData, existDatabase); err != nil {
+			return nil, err
+		}
+		if !existDatabase.Routing.Equal(database.Routing) {
+			return nil, fmt.Errorf("shard routing of database cannot be changed, current: %s",
+				existDatabase.Routing.String())
+		}
+	}
 
 	log.Info("Saving Database", logger.String("config", stmt.Value))
 	if err := deps.Repo.Put(ctx, constants.GetDatabaseConfigPath(database.Name), data); err != nil {
@@ -25,6 +25,7 @@ import (
 	"github.com/golang/mock/gomock"
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/option"
@@ -77,11 +78,94 @@ func TestSchema(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:      "create database, get database failure",
+			statement: &stmt.Schema{Type: stmt.CreateDatabaseSchemaType, Value: databaseCfg},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetStorageClusterConfigPath("cluster-test")).Return(nil, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "create database, unmarshal exist database failure",
+			statement: &stmt.Schema{Type: stmt.CreateDatabaseSchemaType, Value: databaseCfg},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetStorageClusterConfigPath("cluster-test")).Return(nil, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return([]byte("err"), nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "create database, routing changed",
+			statement: &stmt.Schema{Type: stmt.CreateDatabaseSchemaType, Value: databaseCfg},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetStorageClusterConfigPath("cluster-test")).Return(nil, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).
+					Return(encoding.JSONMarshal(&models.Database{
+						Name:    "test",
+						Routing: &models.Routing{Method: models.ConsistentHashRouting},
+					}), nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "create database, routing validation failure",
+			statement: &stmt.Schema{
+				Type: stmt.CreateDatabaseSchemaType,
+				Value: string(encoding.JSONMarshal(&models.Database{
+					Name:          "test",
+					Storage:       "cluster-test",
+					NumOfShard:    12,
+					ReplicaFactor: 3,
+					Option: &option.DatabaseOption{
+						Intervals: option.Intervals{{Interval: 10}},
+					},
+					Routing: &models.Routing{Method: models.TagKeysRouting},
+				})),
+			},
+			wantErr: true,
+		},
+		{
+			name: "create database, write consistency validation failure",
+			statement: &stmt.Schema{
+				Type: stmt.CreateDatabaseSchemaType,
+				Value: string(encoding.JSONMarshal(&models.Database{
+					Name:          "test",
+					Storage:       "cluster-test",
+					NumOfShard:    12,
+					ReplicaFactor: 3,
+					Option: &option.DatabaseOption{
+						Intervals: option.Intervals{{Interval: 10}},
+					},
+					WriteConsistency: "all",
+				})),
+			},
+			wantErr: true,
+		},
+		{
+			name: "create database, leader placement validation failure",
+			statement: &stmt.Schema{
+				Type: stmt.CreateDatabaseSchemaType,
+				Value: string(encoding.JSONMarshal(&models.Database{
+					Name:          "test",
+					Storage:       "cluster-test",
+					NumOfShard:    12,
+					ReplicaFactor: 3,
+					Option: &option.DatabaseOption{
+						Intervals: option.Intervals{{Interval: 10}},
+					},
+					LeaderPlacement: &models.LeaderPlacement{},
+				})),
+			},
+			wantErr: true,
+		},
 		{
 			name:      "create database, persist failure",
 			statement: &stmt.Schema{Type: stmt.CreateDatabaseSchemaType, Value: databaseCfg},
 			prepare: func() {
-				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetStorageClusterConfigPath("cluster-test")).Return(nil, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
 				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 			},
 			wantErr: true,
@@ -107,7 +191,18 @@ func TestSchema(t *testing.T) {
 			name:      "create database successfully",
 			statement: &stmt.Schema{Type: stmt.CreateDatabaseSchemaType, Value: databaseCfg},
 			prepare: func() {
-				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetStorageClusterConfigPath("cluster-test")).Return(nil, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:      "update database successfully",
+			statement: &stmt.Schema{Type: stmt.CreateDatabaseSchemaType, Value: databaseCfg},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetStorageClusterConfigPath("cluster-test")).Return(nil, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).
+					Return(encoding.JSONMarshal(&models.Database{Name: "test"}), nil)
 				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
@@ -19,6 +19,7 @@ package command
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"sync"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 )
@@ -37,7 +39,7 @@ var (
 )
 
 // StateCommand executes the state query.
-func StateCommand(_ context.Context, deps *depspkg.HTTPDeps,
+func StateCommand(ctx context.Context, deps *depspkg.HTTPDeps,
 	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
 	stateStmt := stmt.(*stmtpkg.State)
 	switch stateStmt.Type {
@@ -57,6 +59,13 @@ func StateCommand(_ context.Context, deps *depspkg.HTTPDeps,
 			var state []models.DataFamilyState
 			return &state
 		})
+	case stmtpkg.Rebalance:
+		return listReplicaMigrations(ctx, deps)
+	case stmtpkg.ReplicaConsistency:
+		return getStateFromStorage(deps, stateStmt, "/state/replica/consistency", func() interface{} {
+			var state []models.ReplicaConsistencyState
+			return &state
+		})
 	case stmtpkg.BrokerMetric:
 		liveNodes := deps.StateMgr.GetLiveNodes()
 		var nodes []models.Node
@@ -84,6 +93,28 @@ func StateCommand(_ context.Context, deps *depspkg.HTTPDeps,
 	}
 }
 
+// listReplicaMigrations returns the replica migration tasks of shard rebalancing, newest first.
+func listReplicaMigrations(ctx context.Context, deps *depspkg.HTTPDeps) (interface{}, error) {
+	data, err := deps.Repo.List(ctx, constants.ReplicaMigrationPath)
+	if err != nil {
+		return nil, err
+	}
+	var migrations []*models.ReplicaMigration
+	for _, val := range data {
+		migration := &models.ReplicaMigration{}
+		if err0 := encoding.JSONUnmarshal(val.Value, migration); err0 != nil {
+			log.Warn("unmarshal replica migration error",
+				logger.String("data", string(val.Value)))
+			continue
+		}
+		migrations = append(migrations, migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].CreateTime > migrations[j].CreateTime
+	})
+	return migrations, nil
+}
+
 // getStateFromStorage returns the state from storage cluster.
 func getStateFromStorage(deps *depspkg.HTTPDeps, stmt *stmtpkg.State, path string, newStateFn func() interface{}) (interface{}, error) {
 	if storage, ok := deps.StateMgr.GetStorage(stmt.StorageName); ok {
@@ -19,6 +19,7 @@ package command
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -32,6 +33,7 @@ import (
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/sql/stmt"
 )
 
@@ -41,9 +43,11 @@ func TestState(t *testing.T) {
 
 	stateMgr := broker.NewMockStateManager(ctrl)
 	master := coordinator.NewMockMasterController(ctrl)
+	repo := state.NewMockRepository(ctrl)
 	deps := &depspkg.HTTPDeps{
 		StateMgr: stateMgr,
 		Master:   master,
+		Repo:     repo,
 	}
 
 	cases := []struct {
@@ -165,6 +169,46 @@ func TestState(t *testing.T) {
 					}}}, true)
 			},
 		},
+		{
+			name:      "show replica consistency successfully",
+			statement: &stmt.State{Type: stmt.ReplicaConsistency, StorageName: "a", Database: "b"},
+			prepare: func() {
+				svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					_, _ = w.Write([]byte(`[{"shardId":1,"consistency":"Diverged","divergedMetrics":["ns:cpu"]}]`))
+				}))
+				u, err := url.Parse(svr.URL)
+				assert.NoError(t, err)
+				p, err := strconv.Atoi(u.Port())
+				assert.NoError(t, err)
+				stateMgr.EXPECT().GetStorage(gomock.Any()).Return(&models.StorageState{
+					LiveNodes: map[models.NodeID]models.StatefulNode{1: {
+						StatelessNode: models.StatelessNode{
+							HostIP:   u.Hostname(),
+							HTTPPort: uint16(p),
+						},
+						ID: 1,
+					}}}, true)
+			},
+		},
+		{
+			name:      "show rebalance, list migration failure",
+			statement: &stmt.State{Type: stmt.Rebalance},
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "show rebalance successfully",
+			statement: &stmt.State{Type: stmt.Rebalance},
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{
+					{Key: "a", Value: []byte("[]")},
+					{Key: "b", Value: []byte(`{"database":"db","shardId":1,"state":"Done","createTime":1}`)},
+					{Key: "c", Value: []byte(`{"database":"db","shardId":2,"state":"CatchingUp","createTime":2}`)},
+				}, nil)
+			},
+		},
 		{
 			name:      "show broker metric, no alive node",
 			statement: &stmt.State{Type: stmt.BrokerMetric, MetricNames: []string{"a", "b"}},
@@ -35,6 +35,7 @@ import (
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/pkg/validate"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 )
@@ -55,6 +56,9 @@ var storageCommands = map[stmtpkg.StorageOpType]storageCommandFn{
 	stmtpkg.StorageOpShow:    listStorages,
 	stmtpkg.StorageOpCreate:  createStorage,
 	stmtpkg.StorageOpRecover: recoverStorage,
+
+	stmtpkg.StorageOpDecommission:   decommissionStorageNode,
+	stmtpkg.StorageOpTransferLeader: transferLeader,
 }
 
 // StorageCommand executes lin query language for storage related.
@@ -226,3 +230,134 @@ func recoverStorage(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.S
 
 	return &databaseNames, nil
 }
+
+// decommissionStorageNode submits the decommission of storage node, master moves all shard leaders/replicas
+// away from the node, then the node can be shutdown safely.
+func decommissionStorageNode(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Storage) (interface{}, error) {
+	var storage *models.StorageState
+	if stmt.Value == "" {
+		storages := deps.StateMgr.GetStorageList()
+		if len(storages) != 1 {
+			return nil, constants.ErrStorageNameRequired
+		}
+		storage = storages[0]
+	} else {
+		s, ok := deps.StateMgr.GetStorage(stmt.Value)
+		if !ok {
+			return nil, constants.ErrNoStorageCluster
+		}
+		storage = s
+	}
+	nodeID := models.NodeID(stmt.NodeID)
+	if _, ok := storage.LiveNodes[nodeID]; !ok && len(storage.ReplicasOnNode(nodeID)) == 0 {
+		return nil, fmt.Errorf("storage node %d not found", nodeID)
+	}
+	available := 0
+	for id := range storage.ElectableNodes() {
+		if id != nodeID {
+			available++
+		}
+	}
+	if available == 0 {
+		return nil, fmt.Errorf("no other storage node can hold the replicas of node %d", nodeID)
+	}
+	now := timeutil.Now()
+	decommission := &models.NodeDecommission{
+		Storage:    storage.Name,
+		NodeID:     nodeID,
+		State:      models.DecommissionPending,
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	log.Info("Decommissioning storage node", logger.String("decommission", decommission.String()))
+	ok, err := deps.Repo.PutWithTX(ctx, constants.GetDecommissionPath(storage.Name, int(nodeID)), encoding.JSONMarshal(decommission),
+		func(oldVal []byte) error {
+			old := &models.NodeDecommission{}
+			if err0 := encoding.JSONUnmarshal(oldVal, old); err0 == nil && old.State == models.DecommissionFailed {
+				// resubmit failed decommission
+				return nil
+			}
+			return state.ErrNotExist
+		})
+	if errors.Is(err, state.ErrNotExist) {
+		rs := "Storage node is decommissioning"
+		return &rs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("decommission storage node failure")
+	}
+	rs := "Decommission storage node submitted"
+	return &rs, nil
+}
+
+// transferLeader submits the leader transfer of database's shard,
+// master moves the leadership to target replica after it catches up the write ahead log of current leader.
+func transferLeader(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Storage) (interface{}, error) {
+	if stmt.Database == "" {
+		return nil, constants.ErrDatabaseNameRequired
+	}
+	db, ok := deps.StateMgr.GetDatabaseCfg(stmt.Database)
+	if !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	storage, ok := deps.StateMgr.GetStorage(db.Storage)
+	if !ok {
+		return nil, constants.ErrNoStorageCluster
+	}
+	shardID := models.ShardID(stmt.ShardID)
+	shardState, ok := storage.ShardStates[db.Name][shardID]
+	if !ok {
+		return nil, fmt.Errorf("shard %d of database %s not found", shardID, db.Name)
+	}
+	target := models.NodeID(stmt.NodeID)
+	if target != models.NoLeader {
+		if target == shardState.Leader {
+			rs := "Storage node is the leader of shard already"
+			return &rs, nil
+		}
+		if !shardState.Replica.Contain(target) {
+			return nil, fmt.Errorf("storage node %d isn't the replica of shard %d", target, shardID)
+		}
+		if _, ok := storage.LiveNodes[target]; !ok {
+			return nil, fmt.Errorf("storage node %d is offline", target)
+		}
+		if storage.IsDecommissioning(target) {
+			return nil, fmt.Errorf("storage node %d is decommissioning", target)
+		}
+	}
+	now := timeutil.Now()
+	transfer := &models.LeaderTransfer{
+		Database:   db.Name,
+		ShardID:    shardID,
+		Source:     shardState.Leader,
+		Target:     target,
+		State:      models.LeaderTransferPending,
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	log.Info("Transferring shard leader", logger.String("transfer", transfer.String()))
+	ok, err := deps.Repo.PutWithTX(ctx, constants.GetLeaderTransferPath(db.Name, int(shardID)), encoding.JSONMarshal(transfer),
+		func(oldVal []byte) error {
+			old := &models.LeaderTransfer{}
+			if err0 := encoding.JSONUnmarshal(oldVal, old); err0 == nil && old.State.IsTerminal() {
+				// resubmit completed transfer
+				return nil
+			}
+			return state.ErrNotExist
+		})
+	if errors.Is(err, state.ErrNotExist) {
+		rs := "Shard leader is transferring"
+		return &rs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("transfer shard leader failure")
+	}
+	rs := "Transfer shard leader submitted"
+	return &rs, nil
+}
@@ -264,3 +264,320 @@ func TestStorage(t *testing.T) {
 		})
 	}
 }
+
+func TestStorage_Decommission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	deps := &depspkg.HTTPDeps{
+		StateMgr: stateMgr,
+		Repo:     repo,
+	}
+	newStorage := func(liveNodes ...models.NodeID) *models.StorageState {
+		storage := models.NewStorageState("test")
+		for _, nodeID := range liveNodes {
+			storage.NodeOnline(models.StatefulNode{ID: nodeID})
+		}
+		storage.ShardAssignments["db"] = &models.ShardAssignment{
+			Name:   "db",
+			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2}}},
+		}
+		return storage
+	}
+	putWithOld := func(old []byte) {
+		repo.EXPECT().PutWithTX(gomock.Any(), "/rebalance/decommission/test/1", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ []byte, check func([]byte) error) (bool, error) {
+				if err := check(old); err != nil {
+					return false, err
+				}
+				return true, nil
+			})
+	}
+	cases := []struct {
+		name      string
+		statement *stmt.Storage
+		prepare   func()
+		result    string
+		wantErr   bool
+	}{
+		{
+			name:      "storage name required",
+			statement: &stmt.Storage{Type: stmt.StorageOpDecommission, NodeID: 1},
+			prepare: func() {
+				stateMgr.EXPECT().GetStorageList().Return([]*models.StorageState{newStorage(1), newStorage(2)})
+			},
+			wantErr: true,
+		},
+		{
+			name:      "storage not found",
+			statement: &stmt.Storage{Type: stmt.StorageOpDecommission, Value: "test", NodeID: 1},
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("test").Return(nil, false)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "storage node not found",
+			statement: &stmt.Storage{Type: stmt.StorageOpDecommission, Value: "test", NodeID: 3},
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("test").Return(newStorage(1, 2), true)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "no other storage node",
+			statement: &stmt.Storage{Type: stmt.StorageOpDecommission, Value: "test", NodeID: 1},
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("test").Return(newStorage(1), true)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "put decommission failure",
+			statement: &stmt.Storage{Type: stmt.StorageOpDecommission, Value: "test", NodeID: 1},
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("test").Return(newStorage(1, 2), true)
+				repo.EXPECT().PutWithTX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "put decommission not succeeded",
+			statement: &stmt.Storage{Type: stmt.StorageOpDecommission, Value: "test", NodeID: 1},
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("test").Return(newStorage(1, 2), true)
+				repo.EXPECT().PutWithTX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "node is decommissioning",
+			statement: &stmt.Storage{Type: stmt.StorageOpDecommission, Value: "test", NodeID: 1},
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("test").Return(newStorage(1, 2), true)
+				putWithOld(encoding.JSONMarshal(&models.NodeDecommission{State: models.DecommissionMovingReplicas}))
+			},
+			result: "Storage node is decommissioning",
+		},
+		{
+			name:      "resubmit failed decommission",
+			statement: &stmt.Storage{Type: stmt.StorageOpDecommission, Value: "test", NodeID: 1},
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("test").Return(newStorage(1, 2), true)
+				putWithOld(encoding.JSONMarshal(&models.NodeDecommission{State: models.DecommissionFailed}))
+			},
+			result: "Decommission storage node submitted",
+		},
+		{
+			name:      "decommission offline node of the only storage",
+			statement: &stmt.Storage{Type: stmt.StorageOpDecommission, NodeID: 1},
+			prepare: func() {
+				stateMgr.EXPECT().GetStorageList().Return([]*models.StorageState{newStorage(2)})
+				repo.EXPECT().PutWithTX(gomock.Any(), "/rebalance/decommission/test/1", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte, _ func([]byte) error) (bool, error) {
+						decommission := &models.NodeDecommission{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, decommission))
+						assert.Equal(t, models.DecommissionPending, decommission.State)
+						assert.Equal(t, models.NodeID(1), decommission.NodeID)
+						return true, nil
+					})
+			},
+			result: "Decommission storage node submitted",
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			rs, err := StorageCommand(context.TODO(), deps, nil, tt.statement)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.result, *(rs.(*string)))
+		})
+	}
+}
+
+func TestStorage_TransferLeader(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	deps := &depspkg.HTTPDeps{
+		StateMgr: stateMgr,
+		Repo:     repo,
+	}
+	newStorage := func(liveNodes ...models.NodeID) *models.StorageState {
+		storage := models.NewStorageState("test")
+		for _, nodeID := range liveNodes {
+			storage.NodeOnline(models.StatefulNode{ID: nodeID})
+		}
+		storage.ShardStates["db"] = map[models.ShardID]models.ShardState{
+			1: {ID: 1, Leader: 1, Replica: models.Replica{Replicas: []models.NodeID{1, 2, 3}}},
+		}
+		return storage
+	}
+	prepare := func(storage *models.StorageState) {
+		stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Name: "db", Storage: "test"}, true)
+		stateMgr.EXPECT().GetStorage("test").Return(storage, true)
+	}
+	putWithOld := func(old []byte) {
+		repo.EXPECT().PutWithTX(gomock.Any(), "/rebalance/leader/db/1", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ []byte, check func([]byte) error) (bool, error) {
+				if err := check(old); err != nil {
+					return false, err
+				}
+				return true, nil
+			})
+	}
+	transferTo := func(nodeID int64) *stmt.Storage {
+		return &stmt.Storage{Type: stmt.StorageOpTransferLeader, Database: "db", ShardID: 1, NodeID: nodeID}
+	}
+	cases := []struct {
+		name      string
+		statement *stmt.Storage
+		prepare   func()
+		result    string
+		wantErr   bool
+	}{
+		{
+			name:      "database name required",
+			statement: &stmt.Storage{Type: stmt.StorageOpTransferLeader, NodeID: -1},
+			wantErr:   true,
+		},
+		{
+			name:      "database not found",
+			statement: transferTo(-1),
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{}, false)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "storage not found",
+			statement: transferTo(-1),
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("db").Return(models.Database{Name: "db", Storage: "test"}, true)
+				stateMgr.EXPECT().GetStorage("test").Return(nil, false)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "shard not found",
+			statement: &stmt.Storage{Type: stmt.StorageOpTransferLeader, Database: "db", ShardID: 2, NodeID: -1},
+			prepare: func() {
+				prepare(newStorage(1, 2))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "target is leader",
+			statement: transferTo(1),
+			prepare: func() {
+				prepare(newStorage(1, 2))
+			},
+			result: "Storage node is the leader of shard already",
+		},
+		{
+			name:      "target isn't replica",
+			statement: transferTo(4),
+			prepare: func() {
+				prepare(newStorage(1, 2, 4))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "target offline",
+			statement: transferTo(3),
+			prepare: func() {
+				prepare(newStorage(1, 2))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "target decommissioning",
+			statement: transferTo(2),
+			prepare: func() {
+				storage := newStorage(1, 2)
+				storage.Decommissions = map[models.NodeID]*models.NodeDecommission{2: {NodeID: 2}}
+				prepare(storage)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "put transfer failure",
+			statement: transferTo(2),
+			prepare: func() {
+				prepare(newStorage(1, 2))
+				repo.EXPECT().PutWithTX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "put transfer not succeeded",
+			statement: transferTo(2),
+			prepare: func() {
+				prepare(newStorage(1, 2))
+				repo.EXPECT().PutWithTX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "shard leader is transferring",
+			statement: transferTo(2),
+			prepare: func() {
+				prepare(newStorage(1, 2))
+				putWithOld(encoding.JSONMarshal(&models.LeaderTransfer{State: models.LeaderTransferCatchingUp}))
+			},
+			result: "Shard leader is transferring",
+		},
+		{
+			name:      "resubmit done transfer",
+			statement: transferTo(2),
+			prepare: func() {
+				prepare(newStorage(1, 2))
+				putWithOld(encoding.JSONMarshal(&models.LeaderTransfer{State: models.LeaderTransferDone}))
+			},
+			result: "Transfer shard leader submitted",
+		},
+		{
+			name:      "transfer to preferred replica",
+			statement: transferTo(-1),
+			prepare: func() {
+				prepare(newStorage(1, 2))
+				repo.EXPECT().PutWithTX(gomock.Any(), "/rebalance/leader/db/1", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte, _ func([]byte) error) (bool, error) {
+						transfer := &models.LeaderTransfer{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, transfer))
+						assert.Equal(t, models.LeaderTransferPending, transfer.State)
+						assert.Equal(t, models.NodeID(1), transfer.Source)
+						assert.Equal(t, models.NoLeader, transfer.Target)
+						return true, nil
+					})
+			},
+			result: "Transfer shard leader submitted",
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			rs, err := StorageCommand(context.TODO(), deps, nil, tt.statement)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.result, *(rs.(*string)))
+		})
+	}
+}
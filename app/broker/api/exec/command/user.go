@@ -0,0 +1,253 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// userCommandFn represents user/role command function define.
+type userCommandFn = func(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error)
+
+// userCommands registers all user/role related commands.
+var userCommands = map[stmtpkg.UserOpType]userCommandFn{
+	stmtpkg.UserOpCreate:     createUser,
+	stmtpkg.UserOpDrop:       dropUser,
+	stmtpkg.UserOpShow:       showUsers,
+	stmtpkg.UserOpGrantRole:  grantRole,
+	stmtpkg.UserOpRevokeRole: revokeRole,
+	stmtpkg.RoleOpDrop:       dropRole,
+	stmtpkg.RoleOpShow:       showRoles,
+	stmtpkg.RoleOpGrant:      grantPrivilege,
+	stmtpkg.RoleOpRevoke:     revokePrivilege,
+}
+
+// UserCommand executes lin query language for user/role related.
+func UserCommand(ctx context.Context, deps *depspkg.HTTPDeps, _ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	userStmt := stmt.(*stmtpkg.User)
+	if commandFn, ok := userCommands[userStmt.Type]; ok {
+		return commandFn(ctx, deps, userStmt)
+	}
+	return nil, nil
+}
+
+// createUser creates a new user with password.
+func createUser(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	if stmt.UserName == deps.BrokerCfg.BrokerBase.Auth.UserName {
+		return nil, fmt.Errorf("user %s already exists", stmt.UserName)
+	}
+	_, err := deps.Repo.Get(ctx, constants.GetUserPath(stmt.UserName))
+	if err == nil {
+		return nil, fmt.Errorf("user %s already exists", stmt.UserName)
+	}
+	if err != state.ErrNotExist {
+		return nil, err
+	}
+	user, err := models.NewUser(stmt.UserName, stmt.Password)
+	if err != nil {
+		return nil, err
+	}
+	if err := putUser(ctx, deps, user); err != nil {
+		return nil, err
+	}
+	rs := "create user ok"
+	return &rs, nil
+}
+
+// dropUser drops the user.
+func dropUser(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	if _, err := getUser(ctx, deps, stmt.UserName); err != nil {
+		return nil, err
+	}
+	if err := deps.Repo.Delete(ctx, constants.GetUserPath(stmt.UserName)); err != nil {
+		return nil, err
+	}
+	rs := "drop user ok"
+	return &rs, nil
+}
+
+// showUsers returns all users without password.
+func showUsers(_ context.Context, deps *depspkg.HTTPDeps, _ *stmtpkg.User) (interface{}, error) {
+	users := deps.StateMgr.GetUsers()
+	rs := make([]models.User, 0, len(users))
+	for _, user := range users {
+		rs = append(rs, models.User{Name: user.Name, Roles: user.Roles})
+	}
+	return rs, nil
+}
+
+// grantRole grants the role to user.
+func grantRole(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	if _, err := getExistRole(ctx, deps, stmt.RoleName); err != nil {
+		return nil, err
+	}
+	user, err := getUser(ctx, deps, stmt.UserName)
+	if err != nil {
+		return nil, err
+	}
+	if user.GrantRole(stmt.RoleName) {
+		if err := putUser(ctx, deps, user); err != nil {
+			return nil, err
+		}
+	}
+	rs := "grant role ok"
+	return &rs, nil
+}
+
+// revokeRole revokes the role from user.
+func revokeRole(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	user, err := getUser(ctx, deps, stmt.UserName)
+	if err != nil {
+		return nil, err
+	}
+	if user.RevokeRole(stmt.RoleName) {
+		if err := putUser(ctx, deps, user); err != nil {
+			return nil, err
+		}
+	}
+	rs := "revoke role ok"
+	return &rs, nil
+}
+
+// dropRole drops the role, and revokes it from all users.
+func dropRole(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	if _, err := getExistRole(ctx, deps, stmt.RoleName); err != nil {
+		return nil, err
+	}
+	kvs, err := deps.Repo.List(ctx, constants.UserPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range kvs {
+		user := &models.User{}
+		if err := encoding.JSONUnmarshal(kv.Value, user); err != nil {
+			log.Warn("unmarshal user failure, ignore it", logger.String("key", kv.Key), logger.Error(err))
+			continue
+		}
+		if user.RevokeRole(stmt.RoleName) {
+			if err := putUser(ctx, deps, user); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := deps.Repo.Delete(ctx, constants.GetRolePath(stmt.RoleName)); err != nil {
+		return nil, err
+	}
+	rs := "drop role ok"
+	return &rs, nil
+}
+
+// showRoles returns all roles.
+func showRoles(_ context.Context, deps *depspkg.HTTPDeps, _ *stmtpkg.User) (interface{}, error) {
+	roles := deps.StateMgr.GetRoles()
+	if roles == nil {
+		roles = []models.Role{}
+	}
+	return roles, nil
+}
+
+// grantPrivilege grants the privilege on database to role, creates the role if not exist.
+func grantPrivilege(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	privilege, err := models.ParsePrivilege(stmt.Privilege)
+	if err != nil {
+		return nil, err
+	}
+	role, err := getRole(ctx, deps, stmt.RoleName)
+	if err == state.ErrNotExist {
+		role = &models.Role{Name: stmt.RoleName}
+	} else if err != nil {
+		return nil, err
+	}
+	role.Grant(stmt.Database, privilege)
+	if err := deps.Repo.Put(ctx, constants.GetRolePath(role.Name), encoding.JSONMarshal(role)); err != nil {
+		return nil, err
+	}
+	rs := "grant privilege ok"
+	return &rs, nil
+}
+
+// revokePrivilege revokes the privilege on database from role.
+func revokePrivilege(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	privilege, err := models.ParsePrivilege(stmt.Privilege)
+	if err != nil {
+		return nil, err
+	}
+	role, err := getExistRole(ctx, deps, stmt.RoleName)
+	if err != nil {
+		return nil, err
+	}
+	if role.Revoke(stmt.Database, privilege) {
+		if err := deps.Repo.Put(ctx, constants.GetRolePath(role.Name), encoding.JSONMarshal(role)); err != nil {
+			return nil, err
+		}
+	}
+	rs := "revoke privilege ok"
+	return &rs, nil
+}
+
+// getUser returns the user from state repo.
+func getUser(ctx context.Context, deps *depspkg.HTTPDeps, name string) (*models.User, error) {
+	data, err := deps.Repo.Get(ctx, constants.GetUserPath(name))
+	if err == state.ErrNotExist {
+		return nil, fmt.Errorf("user %s not exist", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	user := &models.User{}
+	if err := encoding.JSONUnmarshal(data, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// putUser saves the user into state repo.
+func putUser(ctx context.Context, deps *depspkg.HTTPDeps, user *models.User) error {
+	return deps.Repo.Put(ctx, constants.GetUserPath(user.Name), encoding.JSONMarshal(user))
+}
+
+// getExistRole returns the role from state repo, returns error if role not exist.
+func getExistRole(ctx context.Context, deps *depspkg.HTTPDeps, name string) (*models.Role, error) {
+	role, err := getRole(ctx, deps, name)
+	if err == state.ErrNotExist {
+		return nil, fmt.Errorf("role %s not exist", name)
+	}
+	return role, err
+}
+
+// getRole returns the role from state repo, returns state.ErrNotExist if role not exist.
+func getRole(ctx context.Context, deps *depspkg.HTTPDeps, name string) (*models.Role, error) {
+	data, err := deps.Repo.Get(ctx, constants.GetRolePath(name))
+	if err != nil {
+		return nil, err
+	}
+	role := &models.Role{}
+	if err := encoding.JSONUnmarshal(data, role); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
@@ -0,0 +1,349 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	deps := &depspkg.HTTPDeps{
+		Repo:     repo,
+		StateMgr: stateMgr,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			Auth: config.Auth{UserName: "admin", Password: "admin123"},
+		}},
+	}
+	userData := encoding.JSONMarshal(&models.User{Name: "test", Password: "pwd", Roles: []string{"role1"}})
+	roleData := encoding.JSONMarshal(&models.Role{Name: "role1", Grants: []models.Grant{{Database: "db", Privilege: models.ReadPrivilege}}})
+	cases := []struct {
+		name      string
+		statement *stmt.User
+		prepare   func()
+		wantErr   bool
+	}{
+		{
+			name:      "unknown user op",
+			statement: &stmt.User{},
+		},
+		{
+			name:      "create root user",
+			statement: &stmt.User{Type: stmt.UserOpCreate, UserName: "admin", Password: "pwd"},
+			wantErr:   true,
+		},
+		{
+			name:      "create user, user exist",
+			statement: &stmt.User{Type: stmt.UserOpCreate, UserName: "test", Password: "pwd"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(userData, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "create user, get user failure",
+			statement: &stmt.User{Type: stmt.UserOpCreate, UserName: "test", Password: "pwd"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "create user, put user failure",
+			statement: &stmt.User{Type: stmt.UserOpCreate, UserName: "test", Password: "pwd"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "create user successfully",
+			statement: &stmt.User{Type: stmt.UserOpCreate, UserName: "test", Password: "pwd"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Put(gomock.Any(), "/auth/user/test", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, data []byte) error {
+						user := &models.User{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, user))
+						assert.True(t, user.CheckPassword("pwd"))
+						return nil
+					})
+			},
+		},
+		{
+			name:      "drop user, user not exist",
+			statement: &stmt.User{Type: stmt.UserOpDrop, UserName: "test"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "drop user, unmarshal user failure",
+			statement: &stmt.User{Type: stmt.UserOpDrop, UserName: "test"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("abc"), nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "drop user failure",
+			statement: &stmt.User{Type: stmt.UserOpDrop, UserName: "test"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(userData, nil)
+				repo.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "drop user successfully",
+			statement: &stmt.User{Type: stmt.UserOpDrop, UserName: "test"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(userData, nil)
+				repo.EXPECT().Delete(gomock.Any(), "/auth/user/test").Return(nil)
+			},
+		},
+		{
+			name:      "show users",
+			statement: &stmt.User{Type: stmt.UserOpShow},
+			prepare: func() {
+				stateMgr.EXPECT().GetUsers().Return([]models.User{{Name: "test", Password: "pwd"}})
+			},
+		},
+		{
+			name:      "grant role, role not exist",
+			statement: &stmt.User{Type: stmt.UserOpGrantRole, UserName: "test", RoleName: "role1"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), "/auth/role/role1").Return(nil, state.ErrNotExist)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "grant role, user not exist",
+			statement: &stmt.User{Type: stmt.UserOpGrantRole, UserName: "test", RoleName: "role1"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), "/auth/role/role1").Return(roleData, nil)
+				repo.EXPECT().Get(gomock.Any(), "/auth/user/test").Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "grant role, put user failure",
+			statement: &stmt.User{Type: stmt.UserOpGrantRole, UserName: "test", RoleName: "role2"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), "/auth/role/role2").Return(roleData, nil)
+				repo.EXPECT().Get(gomock.Any(), "/auth/user/test").Return(userData, nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "grant role successfully",
+			statement: &stmt.User{Type: stmt.UserOpGrantRole, UserName: "test", RoleName: "role1"},
+			prepare: func() {
+				// user has the role already
+				repo.EXPECT().Get(gomock.Any(), "/auth/role/role1").Return(roleData, nil)
+				repo.EXPECT().Get(gomock.Any(), "/auth/user/test").Return(userData, nil)
+			},
+		},
+		{
+			name:      "revoke role, user not exist",
+			statement: &stmt.User{Type: stmt.UserOpRevokeRole, UserName: "test", RoleName: "role1"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), "/auth/user/test").Return(nil, state.ErrNotExist)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "revoke role, put user failure",
+			statement: &stmt.User{Type: stmt.UserOpRevokeRole, UserName: "test", RoleName: "role1"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), "/auth/user/test").Return(userData, nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "revoke role successfully",
+			statement: &stmt.User{Type: stmt.UserOpRevokeRole, UserName: "test", RoleName: "role1"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), "/auth/user/test").Return(userData, nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:      "drop role, role not exist",
+			statement: &stmt.User{Type: stmt.RoleOpDrop, RoleName: "role1"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "drop role, list users failure",
+			statement: &stmt.User{Type: stmt.RoleOpDrop, RoleName: "role1"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(roleData, nil)
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "drop role, put user failure",
+			statement: &stmt.User{Type: stmt.RoleOpDrop, RoleName: "role1"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(roleData, nil)
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{{Key: "test", Value: userData}}, nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "drop role, delete role failure",
+			statement: &stmt.User{Type: stmt.RoleOpDrop, RoleName: "role1"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(roleData, nil)
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{{Key: "bad", Value: []byte("abc")}}, nil)
+				repo.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "drop role successfully",
+			statement: &stmt.User{Type: stmt.RoleOpDrop, RoleName: "role1"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(roleData, nil)
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{{Key: "test", Value: userData}}, nil)
+				repo.EXPECT().Put(gomock.Any(), "/auth/user/test", gomock.Any()).Return(nil)
+				repo.EXPECT().Delete(gomock.Any(), "/auth/role/role1").Return(nil)
+			},
+		},
+		{
+			name:      "show roles",
+			statement: &stmt.User{Type: stmt.RoleOpShow},
+			prepare: func() {
+				stateMgr.EXPECT().GetRoles().Return(nil)
+			},
+		},
+		{
+			name:      "grant privilege, unknown privilege",
+			statement: &stmt.User{Type: stmt.RoleOpGrant, RoleName: "role1", Database: "db", Privilege: "all"},
+			wantErr:   true,
+		},
+		{
+			name:      "grant privilege, get role failure",
+			statement: &stmt.User{Type: stmt.RoleOpGrant, RoleName: "role1", Database: "db", Privilege: "read"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "grant privilege, put role failure",
+			statement: &stmt.User{Type: stmt.RoleOpGrant, RoleName: "role1", Database: "db", Privilege: "read"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(roleData, nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "grant privilege, create role",
+			statement: &stmt.User{Type: stmt.RoleOpGrant, RoleName: "role2", Database: "*", Privilege: "admin"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Put(gomock.Any(), "/auth/role/role2", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, data []byte) error {
+						role := &models.Role{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, role))
+						assert.True(t, role.Allowed("db", models.WritePrivilege))
+						return nil
+					})
+			},
+		},
+		{
+			name:      "revoke privilege, unknown privilege",
+			statement: &stmt.User{Type: stmt.RoleOpRevoke, RoleName: "role1", Database: "db", Privilege: "all"},
+			wantErr:   true,
+		},
+		{
+			name:      "revoke privilege, role not exist",
+			statement: &stmt.User{Type: stmt.RoleOpRevoke, RoleName: "role1", Database: "db", Privilege: "read"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "revoke privilege, unmarshal role failure",
+			statement: &stmt.User{Type: stmt.RoleOpRevoke, RoleName: "role1", Database: "db", Privilege: "read"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("abc"), nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "revoke privilege, put role failure",
+			statement: &stmt.User{Type: stmt.RoleOpRevoke, RoleName: "role1", Database: "db", Privilege: "read"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(roleData, nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "revoke privilege successfully",
+			statement: &stmt.User{Type: stmt.RoleOpRevoke, RoleName: "role1", Database: "db", Privilege: "read"},
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(roleData, nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			_, err := UserCommand(context.TODO(), deps, &models.ExecuteParam{}, tt.statement)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UserCommand() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -20,11 +20,14 @@ package exec
 import (
 	"context"
 	"errors"
+	"net/http"
 	"reflect"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/lindb/lindb/app/broker/api/exec/command"
+	"github.com/lindb/lindb/app/broker/auth"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
@@ -59,6 +62,8 @@ var (
 		stmtpkg.QueryStatement:          command.QueryCommand,
 		stmtpkg.RequestStatement:        command.RequestCommand,
 		stmtpkg.LimitStatement:          command.LimitCommand,
+		stmtpkg.DeleteStatement:         command.DeleteCommand,
+		stmtpkg.UserStatement:           command.UserCommand,
 	}
 )
 
@@ -101,6 +106,8 @@ func (e *ExecuteAPI) Register(route gin.IRoutes) {
 // @Produce json
 // @Success 200 {object} models.ResultSet
 // @Success 200 {object} models.Metadata
+// @Failure 401 {string} string "authorization token invalid"
+// @Failure 403 {string} string "permission denied"
 // @Failure 404 {string} string "not found"
 // @Failure 500 {string} string "can't parse lin query language"
 // @Failure 500 {string} string "internal error"
@@ -111,7 +118,14 @@ func (e *ExecuteAPI) Execute(c *gin.Context) {
 	if err := e.deps.QueryLimiter.Do(func() error {
 		return e.execute(c)
 	}); err != nil {
-		httppkg.Error(c, err)
+		switch {
+		case errors.Is(err, constants.ErrUnauthorized):
+			httppkg.ErrorWithCode(c, http.StatusUnauthorized, err)
+		case errors.Is(err, constants.ErrPermissionDenied):
+			httppkg.ErrorWithCode(c, http.StatusForbidden, err)
+		default:
+			httppkg.Error(c, err)
+		}
 	}
 }
 
@@ -136,6 +150,9 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	}
 
 	if commandFn, ok := commands[stmt.StatementType()]; ok {
+		if err := e.checkPrivilege(c, &param, stmt); err != nil {
+			return err
+		}
 		result, err := commandFn(ctx, e.deps, &param, stmt)
 		if err != nil {
 			return err
@@ -149,3 +166,38 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	}
 	return errors.New("can't parse lin query language")
 }
+
+// checkPrivilege checks if current login user/api token has the privilege to execute the statement,
+// cluster/state query statements only require the user logged in.
+func (e *ExecuteAPI) checkPrivilege(c *gin.Context, param *models.ExecuteParam, stmt stmtpkg.Statement) error {
+	db := strings.TrimSpace(param.Database)
+	switch s := stmt.(type) {
+	case *stmtpkg.Query, *stmtpkg.MetricMetadata:
+		return auth.CheckPrivilege(c, e.deps, db, models.ReadPrivilege)
+	case *stmtpkg.Delete:
+		return auth.CheckPrivilege(c, e.deps, db, models.WritePrivilege)
+	case *stmtpkg.Limit:
+		if s.Type == stmtpkg.SetLimit {
+			return auth.CheckPrivilege(c, e.deps, db, models.AdminPrivilege)
+		}
+		return auth.CheckPrivilege(c, e.deps, db, models.ReadPrivilege)
+	case *stmtpkg.Schema:
+		switch s.Type {
+		case stmtpkg.CreateDatabaseSchemaType:
+			return auth.CheckPrivilege(c, e.deps, models.AllDatabases, models.AdminPrivilege)
+		case stmtpkg.DropDatabaseSchemaType:
+			return auth.CheckPrivilege(c, e.deps, s.Value, models.AdminPrivilege)
+		}
+	case *stmtpkg.Storage:
+		if s.Type != stmtpkg.StorageOpShow {
+			return auth.CheckPrivilege(c, e.deps, models.AllDatabases, models.AdminPrivilege)
+		}
+	case *stmtpkg.User:
+		return auth.CheckPrivilege(c, e.deps, models.AllDatabases, models.AdminPrivilege)
+	case *stmtpkg.Request:
+		if s.Type == stmtpkg.RequestOpKill {
+			return auth.CheckPrivilege(c, e.deps, models.AllDatabases, models.AdminPrivilege)
+		}
+	}
+	return auth.CheckUser(c, e.deps)
+}
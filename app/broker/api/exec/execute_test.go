@@ -31,6 +31,7 @@ import (
 
 	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
 	masterpkg "github.com/lindb/lindb/coordinator/master"
@@ -173,3 +174,79 @@ func TestExecuteAPI_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestExecuteAPI_checkPrivilege(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetUser("test").Return(models.User{Name: "test", Roles: []string{"role"}}, true).AnyTimes()
+	stateMgr.EXPECT().GetRole("role").Return(models.Role{Name: "role", Grants: []models.Grant{
+		{Database: "db", Privilege: models.ReadPrivilege},
+	}}, true).AnyTimes()
+	api := NewExecuteAPI(&deps.HTTPDeps{
+		Ctx:      context.Background(),
+		StateMgr: stateMgr,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+			Auth: config.Auth{Enabled: true, UserName: "admin"},
+		}},
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			2,
+			time.Second*5,
+			metrics.NewLimitStatistics("exec", linmetric.BrokerRegistry),
+		),
+	})
+	cases := []struct {
+		stmt    stmtpkg.Statement
+		allowed bool
+	}{
+		{stmt: &stmtpkg.Query{}, allowed: true},
+		{stmt: &stmtpkg.MetricMetadata{}, allowed: true},
+		{stmt: &stmtpkg.Limit{Type: stmtpkg.ShowLimit}, allowed: true},
+		{stmt: &stmtpkg.Limit{Type: stmtpkg.SetLimit}},
+		{stmt: &stmtpkg.Delete{}},
+		{stmt: &stmtpkg.Schema{Type: stmtpkg.DatabaseSchemaType}, allowed: true},
+		{stmt: &stmtpkg.Schema{Type: stmtpkg.CreateDatabaseSchemaType}},
+		{stmt: &stmtpkg.Schema{Type: stmtpkg.DropDatabaseSchemaType, Value: "db"}},
+		{stmt: &stmtpkg.Storage{Type: stmtpkg.StorageOpShow}, allowed: true},
+		{stmt: &stmtpkg.Storage{Type: stmtpkg.StorageOpCreate}},
+		{stmt: &stmtpkg.User{Type: stmtpkg.UserOpShow}},
+		{stmt: &stmtpkg.State{}, allowed: true},
+		{stmt: &stmtpkg.Request{}, allowed: true},
+		{stmt: &stmtpkg.Request{Type: stmtpkg.RequestOpKill}},
+	}
+	c, _ := gin.CreateTestContext(nil)
+	c.Set(constants.CurrentUser, "test")
+	for _, tt := range cases {
+		err := api.checkPrivilege(c, &models.ExecuteParam{Database: "db"}, tt.stmt)
+		assert.Equal(t, tt.allowed, err == nil, "%T", tt.stmt)
+	}
+	// root user has all privileges
+	c.Set(constants.CurrentUser, "admin")
+	assert.NoError(t, api.checkPrivilege(c, &models.ExecuteParam{}, &stmtpkg.User{}))
+	// read-only api token
+	c, _ = gin.CreateTestContext(nil)
+	c.Set(constants.CurrentAPIToken, &models.APIToken{Database: "db", Privilege: models.ReadPrivilege})
+	assert.NoError(t, api.checkPrivilege(c, &models.ExecuteParam{Database: "db"}, &stmtpkg.Query{}))
+	assert.Error(t, api.checkPrivilege(c, &models.ExecuteParam{Database: "db"}, &stmtpkg.Delete{}))
+	assert.Error(t, api.checkPrivilege(c, &models.ExecuteParam{Database: "db"}, &stmtpkg.State{}))
+
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if user := c.GetHeader("user"); user != "" {
+			c.Set(constants.CurrentUser, user)
+		}
+	})
+	api.Register(r)
+	// not login
+	resp := mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"show users"}`)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	// permission denied
+	header := http.Header{}
+	header.Set("content-type", "application/json")
+	header.Set("user", "test")
+	resp = mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"sql":"show users"}`, header)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
@@ -0,0 +1,181 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+)
+
+// rateLimitedError represents the write is rejected by write rate limits, retry after the duration.
+type rateLimitedError struct {
+	retryAfter time.Duration
+}
+
+// Error returns the error message of rate limited.
+func (e *rateLimitedError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", constants.ErrWriteRateLimited, e.retryAfter)
+}
+
+// Unwrap returns the write rate limited error.
+func (e *rateLimitedError) Unwrap() error {
+	return constants.ErrWriteRateLimited
+}
+
+// RetryAfterSeconds returns the seconds of Retry-After header, at least 1 second.
+func (e *rateLimitedError) RetryAfterSeconds() int {
+	seconds := int(math.Ceil(e.retryAfter.Seconds()))
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
+
+// writeQuota represents the token buckets which the write request takes rows/bytes from.
+type writeQuota struct {
+	db            string
+	rows          []*concurrent.TokenBucket
+	bytes         []*concurrent.TokenBucket
+	reservedBytes int64 // estimated bytes(content length) reserved from bytes buckets before parsing
+
+	statistics *metrics.IngestionRateLimitStatistics
+}
+
+// take takes the rows/bytes of write request after parsing, reconciles the bytes reserved before parsing.
+func (q *writeQuota) take(rows, bytes int64) {
+	if q == nil {
+		return
+	}
+	for _, b := range q.rows {
+		b.Take(rows)
+	}
+	for _, b := range q.bytes {
+		b.Take(bytes - q.reservedBytes)
+	}
+	q.statistics.Rows.WithTagValues(q.db).Add(float64(rows))
+	q.statistics.Bytes.WithTagValues(q.db).Add(float64(bytes))
+}
+
+// writeRateLimiter limits the write rate(rows/bytes per second) of database/namespace by token bucket,
+// the rates are based on database's limits.
+type writeRateLimiter struct {
+	buckets map[string]*concurrent.TokenBucket // key => token bucket
+	mutex   sync.Mutex
+
+	statistics *metrics.IngestionRateLimitStatistics
+}
+
+// newWriteRateLimiter creates a write rate limiter.
+func newWriteRateLimiter() *writeRateLimiter {
+	return &writeRateLimiter{
+		buckets:    make(map[string]*concurrent.TokenBucket),
+		statistics: metrics.NewIngestionRateLimitStatistics(),
+	}
+}
+
+// acquire checks if the database/namespace has available quota before parsing write request,
+// reserves the estimated bytes(content length, ignored if unknown) of request from bytes buckets,
+// returns the quota which takes rows/bytes after parsing, returns rateLimitedError if quota is exhausted.
+func (l *writeRateLimiter) acquire(db, ns string, estimatedBytes int64, limits *models.Limits) (*writeQuota, error) {
+	if !limits.EnableWriteRateLimit() {
+		return nil, nil
+	}
+	quota := &writeQuota{db: db, statistics: l.statistics}
+	rows := []*concurrent.TokenBucket{l.getBucket(db+"/rows", limits.MaxWriteRowsPerSecond)}
+	bytes := []*concurrent.TokenBucket{l.getBucket(db+"/bytes", limits.MaxWriteBytesPerSecond)}
+	nsLimits := limits.Namespaces[ns]
+	rows = append(rows, l.getBucket(db+"|"+ns+"/rows", nsLimits.MaxWriteRowsPerSecond))
+	bytes = append(bytes, l.getBucket(db+"|"+ns+"/bytes", nsLimits.MaxWriteBytesPerSecond))
+
+	var wait time.Duration
+	for _, b := range rows {
+		if b != nil {
+			quota.rows = append(quota.rows, b)
+			wait = maxDuration(wait, b.Wait())
+		}
+	}
+	if estimatedBytes < 0 {
+		estimatedBytes = 0
+	}
+	for _, b := range bytes {
+		if b != nil && wait == 0 {
+			// reserve the bytes of request, so that concurrent requests cannot pass the check with the same tokens
+			if wait = b.Reserve(estimatedBytes); wait == 0 {
+				quota.bytes = append(quota.bytes, b)
+			}
+		}
+	}
+	if wait > 0 {
+		// return the reserved bytes
+		for _, b := range quota.bytes {
+			b.Take(-estimatedBytes)
+		}
+		l.statistics.ThrottledRequests.WithTagValues(db, ns).Incr()
+		return nil, &rateLimitedError{retryAfter: wait}
+	}
+	quota.reservedBytes = estimatedBytes
+	return quota, nil
+}
+
+// getBucket returns the token bucket by key, creates it if not exist, updates the rate if changed.
+// Returns nil if rate limit is disabled.
+func (l *writeRateLimiter) getBucket(key string, rate int64) *concurrent.TokenBucket {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if rate <= 0 {
+		delete(l.buckets, key)
+		return nil
+	}
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = concurrent.NewTokenBucket(rate)
+		l.buckets[key] = bucket
+	} else if bucket.Rate() != rate {
+		bucket.SetRate(rate)
+	}
+	return bucket
+}
+
+// countingReader represents the reader which counts the bytes read from request body.
+type countingReader struct {
+	io.ReadCloser
+	n int64
+}
+
+// Read reads data from request body, then counts the bytes.
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,113 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+func TestWriteRateLimiter_acquire(t *testing.T) {
+	l := newWriteRateLimiter()
+	limits := models.NewDefaultLimits()
+	// rate limit disabled
+	quota, err := l.acquire("db", "ns", 0, limits)
+	assert.NoError(t, err)
+	assert.Nil(t, quota)
+	quota.take(100, 100)
+
+	// database rows limit
+	limits.MaxWriteRowsPerSecond = 10
+	quota, err = l.acquire("db", "ns", 0, limits)
+	assert.NoError(t, err)
+	assert.Len(t, quota.rows, 1)
+	assert.Empty(t, quota.bytes)
+	quota.take(100, 100)
+	_, err = l.acquire("db", "ns", 0, limits)
+	assert.True(t, errors.Is(err, constants.ErrWriteRateLimited))
+	// other database not limited
+	_, err = l.acquire("db2", "ns", 0, limits)
+	assert.NoError(t, err)
+
+	// namespace bytes limit
+	limits.MaxWriteRowsPerSecond = 0
+	limits.Namespaces["ns"] = models.WriteRateLimits{MaxWriteBytesPerSecond: 100}
+	quota, err = l.acquire("db", "ns", 0, limits)
+	assert.NoError(t, err)
+	assert.Empty(t, quota.rows)
+	assert.Len(t, quota.bytes, 1)
+	quota.take(10, 1000)
+	_, err = l.acquire("db", "ns", 0, limits)
+	assert.True(t, errors.Is(err, constants.ErrWriteRateLimited))
+	// other namespace not limited
+	_, err = l.acquire("db", "ns2", 0, limits)
+	assert.NoError(t, err)
+
+	// rate changed
+	limits.Namespaces["ns"] = models.WriteRateLimits{MaxWriteBytesPerSecond: 200}
+	_, _ = l.acquire("db", "ns", 0, limits)
+	assert.Equal(t, int64(200), l.buckets["db|ns/bytes"].Rate())
+}
+
+func TestWriteRateLimiter_acquire_reserveBytes(t *testing.T) {
+	l := newWriteRateLimiter()
+	limits := models.NewDefaultLimits()
+	limits.MaxWriteBytesPerSecond = 1000
+	limits.Namespaces["ns"] = models.WriteRateLimits{MaxWriteBytesPerSecond: 100}
+	// content length reserved before parsing, concurrent request is rejected
+	quota, err := l.acquire("db", "ns", 150, limits)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(150), quota.reservedBytes)
+	_, err = l.acquire("db", "ns", 10, limits)
+	assert.True(t, errors.Is(err, constants.ErrWriteRateLimited))
+	// bytes reserved from database's bucket returned if namespace's quota exhausted
+	l.buckets["db/bytes"].Take(850)
+	assert.LessOrEqual(t, l.buckets["db/bytes"].Wait(), time.Millisecond)
+
+	// reconcile after parsing, read bytes less than reserved
+	quota.take(1, 50)
+	assert.Equal(t, time.Duration(0), l.buckets["db|ns/bytes"].Wait())
+	// content length unknown
+	quota, err = l.acquire("db", "ns", -1, limits)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), quota.reservedBytes)
+}
+
+func TestRateLimitedError(t *testing.T) {
+	err := &rateLimitedError{retryAfter: 1500 * time.Millisecond}
+	assert.Equal(t, 2, err.RetryAfterSeconds())
+	assert.Equal(t, "write rate limited, retry after 1.5s", err.Error())
+	err = &rateLimitedError{retryAfter: time.Millisecond}
+	assert.Equal(t, 1, err.RetryAfterSeconds())
+}
+
+func TestCountingReader(t *testing.T) {
+	r := &countingReader{ReadCloser: io.NopCloser(bytes.NewBufferString("hello"))}
+	data, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+	assert.Equal(t, int64(5), r.n)
+}
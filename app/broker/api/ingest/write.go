@@ -19,7 +19,10 @@ package ingest
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	nethttp "net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -27,15 +30,19 @@ import (
 
 	commonconstants "github.com/lindb/common/constants"
 
+	"github.com/lindb/lindb/app/broker/auth"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
 	ingestCommon "github.com/lindb/lindb/ingestion/common"
 	"github.com/lindb/lindb/ingestion/flat"
 	"github.com/lindb/lindb/ingestion/influx"
+	"github.com/lindb/lindb/ingestion/prometheus"
 	"github.com/lindb/lindb/ingestion/proto"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/replica"
 	"github.com/lindb/lindb/series/metric"
 )
 
@@ -44,14 +51,16 @@ var (
 	WritePath = "/write"
 )
 
-// Write represents write api that processes flat/proto/influx protocol data.
+// Write represents write api that processes flat/proto/influx/prometheus protocol data.
 type Write struct {
-	deps *depspkg.HTTPDeps
+	deps        *depspkg.HTTPDeps
+	rateLimiter *writeRateLimiter
 
 	statistics struct {
-		flat   *linmetric.BoundHistogram
-		proto  *linmetric.BoundHistogram
-		influx *linmetric.BoundHistogram
+		flat       *linmetric.BoundHistogram
+		proto      *linmetric.BoundHistogram
+		influx     *linmetric.BoundHistogram
+		prometheus *linmetric.BoundHistogram
 	}
 }
 
@@ -59,15 +68,18 @@ type Write struct {
 func NewWrite(deps *depspkg.HTTPDeps) *Write {
 	ingestStatistics := metrics.NewCommonIngestionStatistics()
 	return &Write{
-		deps: deps,
+		deps:        deps,
+		rateLimiter: newWriteRateLimiter(),
 		statistics: struct {
-			flat   *linmetric.BoundHistogram
-			proto  *linmetric.BoundHistogram
-			influx *linmetric.BoundHistogram
+			flat       *linmetric.BoundHistogram
+			proto      *linmetric.BoundHistogram
+			influx     *linmetric.BoundHistogram
+			prometheus *linmetric.BoundHistogram
 		}{
-			flat:   ingestStatistics.Duration.WithTagValues("flat"),
-			proto:  ingestStatistics.Duration.WithTagValues("proto"),
-			influx: ingestStatistics.Duration.WithTagValues("influx"),
+			flat:       ingestStatistics.Duration.WithTagValues("flat"),
+			proto:      ingestStatistics.Duration.WithTagValues("proto"),
+			influx:     ingestStatistics.Duration.WithTagValues("influx"),
+			prometheus: ingestStatistics.Duration.WithTagValues("prometheus"),
 		},
 	}
 }
@@ -78,39 +90,61 @@ func (w *Write) Register(route gin.IRoutes) {
 	route.PUT(WritePath, w.Write)
 }
 
-// Write processes flat/proto/influx protocol data with ingest limit.
+// Write processes flat/proto/influx/prometheus protocol data with ingest limit.
 //
 // @BasePath /api/v1
 // @Summary write metric data
 // @Schemes
-// @Description receive metric data, then parse the data based on content type(flat buffer/proto buffer/influx).
+// @Description receive metric data, then parse the data based on content type(flat buffer/proto buffer/influx/prometheus).
 // @Description write data via database channel, support content-type as below:
 // @Description 1. application/flatbuffer
 // @Description 2. application/protobuf
 // @Description 3. application/influx
+// @Description 4. application/x-protobuf(prometheus remote write, snappy compressed)
 // @Tags Write
 // @Accept application/flatbuffer
 // @Accept application/protobuf
 // @Accept application/influx
+// @Accept application/x-protobuf
 // @Param db query string true "database name"
 // @Param ns query string false "namespace, default value: default-ns"
 // @Param string body string ture "metric data"
 // @Produce plain
 // @Success 204 {string} string ""
+// @Failure 401 {string} string "authorization token invalid"
+// @Failure 403 {string} string "permission denied"
+// @Failure 429 {string} string "write rate limited, retry after Retry-After header seconds"
 // @Failure 500 {string} string "internal error"
+// @Failure 503 {string} string "write not acknowledged by enough replicas"
+// @Failure 504 {string} string "wait for write acknowledgement timeout"
 // @Router /write [put]
 // @Router /write [post]
 func (w *Write) Write(c *gin.Context) {
 	if err := w.deps.IngestLimiter.Do(func() error {
 		return w.write(c)
 	}); err != nil {
-		http.Error(c, err)
+		var rateLimitedErr *rateLimitedError
+		switch {
+		case errors.As(err, &rateLimitedErr):
+			c.Header("Retry-After", strconv.Itoa(rateLimitedErr.RetryAfterSeconds()))
+			http.ErrorWithCode(c, nethttp.StatusTooManyRequests, err)
+		case errors.Is(err, constants.ErrUnauthorized):
+			http.ErrorWithCode(c, nethttp.StatusUnauthorized, err)
+		case errors.Is(err, constants.ErrPermissionDenied):
+			http.ErrorWithCode(c, nethttp.StatusForbidden, err)
+		case errors.Is(err, replica.ErrIngestTimeout):
+			http.ErrorWithCode(c, nethttp.StatusGatewayTimeout, err)
+		case errors.Is(err, constants.ErrWriteNotAcknowledged), errors.Is(err, constants.ErrWriteStreamClosed):
+			http.ErrorWithCode(c, nethttp.StatusServiceUnavailable, err)
+		default:
+			http.Error(c, err)
+		}
 	} else {
 		http.NoContent(c)
 	}
 }
 
-// parse flat/proto/influx protocol data, then write parsed data to database's write channel.
+// parse flat/proto/influx/prometheus protocol data, then write parsed data to database's write channel.
 func (w *Write) write(c *gin.Context) (err error) {
 	var param struct {
 		Database  string `form:"db" binding:"required"`
@@ -120,6 +154,9 @@ func (w *Write) write(c *gin.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	if err = auth.CheckPrivilege(c, w.deps, param.Database, models.WritePrivilege); err != nil {
+		return err
+	}
 	ctx, cancel := context.WithTimeout(context.Background(),
 		w.deps.BrokerCfg.BrokerBase.Ingestion.IngestTimeout.Duration())
 	defer cancel()
@@ -144,8 +181,19 @@ func (w *Write) write(c *gin.Context) (err error) {
 	if limits.EnableNamespaceLengthCheck() && len(param.Namespace) > limits.MaxNamespaceLength {
 		return constants.ErrNamespaceTooLong
 	}
+	// check write rate limits and reserve bytes(content length) before parsing,
+	// rows/bytes of this request are reconciled after parsing
+	quota, err := w.rateLimiter.acquire(param.Database, param.Namespace, c.Request.ContentLength, limits)
+	if err != nil {
+		return err
+	}
+	body := &countingReader{ReadCloser: c.Request.Body}
+	if c.Request.Body != nil {
+		c.Request.Body = body
+	}
 	contentType := strings.ToLower(strings.Trim(c.Request.Header.Get(headers.ContentType), " "))
 	var rows *metric.BrokerBatchRows
+	var counterUpdates *prometheus.CounterUpdates
 	switch {
 	case strings.HasPrefix(contentType, constants.ContentTypeFlat):
 		rows, err = flat.Parse(c.Request, enrichedTags, param.Namespace, limits)
@@ -153,15 +201,21 @@ func (w *Write) write(c *gin.Context) (err error) {
 		rows, err = influx.Parse(c.Request, enrichedTags, param.Namespace, limits)
 	case strings.HasPrefix(contentType, constants.ContentTypeProto):
 		rows, err = proto.Parse(c.Request, enrichedTags, param.Namespace, limits)
+	case strings.HasPrefix(contentType, constants.ContentTypePrometheus):
+		rows, counterUpdates, err = prometheus.Parse(c.Request, param.Database, enrichedTags, param.Namespace, limits)
 	default:
-		err = fmt.Errorf("not support content type: %s, only support %s/%s/%s", contentType,
-			constants.ContentTypeFlat, constants.ContentTypeProto, constants.ContentTypeInflux)
+		err = fmt.Errorf("not support content type: %s, only support %s/%s/%s/%s", contentType,
+			constants.ContentTypeFlat, constants.ContentTypeProto, constants.ContentTypeInflux, constants.ContentTypePrometheus)
 	}
 	if err != nil {
+		quota.take(0, body.n)
 		return err
 	}
+	quota.take(int64(rows.Len()), body.n)
 	if err := w.deps.CM.Write(ctx, param.Database, rows); err != nil {
 		return err
 	}
+	// commit counter base values after written, retry of failure request computes same delta values
+	counterUpdates.Commit()
 	return nil
 }
@@ -20,6 +20,7 @@ package ingest
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"testing"
@@ -28,14 +29,17 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/go-http-utils/headers"
 	"github.com/golang/mock/gomock"
+	"github.com/golang/snappy"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
 	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
 
 	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/ingestion/prometheus"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/mock"
@@ -104,6 +108,15 @@ func TestWrite_Flat(t *testing.T) {
 	cm.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(io.ErrClosedPipe)
 	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=test3&enrich_tag=a=b", body, header)
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// wait for acknowledgement timeout
+	cm.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(replica.ErrIngestTimeout)
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=test3&enrich_tag=a=b", body, header)
+	assert.Equal(t, http.StatusGatewayTimeout, resp.Code)
+	// not acknowledged by enough replicas
+	cm.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(fmt.Errorf("%w: %s", constants.ErrWriteNotAcknowledged, "err"))
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=test3&enrich_tag=a=b", body, header)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
 
 	// no content
 	cm.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
@@ -252,3 +265,180 @@ func TestWrite_Proto(t *testing.T) {
 	resp = mock.DoRequest(t, r, http.MethodPost, WritePath+"?db=test&ns=ns4&enrich_tag=a=b", string(data), header)
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 }
+
+func TestWrite_Prometheus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cm := replica.NewMockChannelManager(ctrl)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseLimits(gomock.Any()).Return(models.NewDefaultLimits()).AnyTimes()
+	api := NewWrite(&deps.HTTPDeps{
+		BrokerCfg: &config.Broker{
+			BrokerBase: config.BrokerBase{
+				Ingestion: config.Ingestion{
+					IngestTimeout: ltoml.Duration(time.Second * 2),
+				},
+			},
+		},
+		CM:       cm,
+		StateMgr: stateMgr,
+		IngestLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("test", linmetric.BrokerRegistry)),
+	})
+	r := gin.New()
+	api.Register(r)
+
+	header := make(http.Header)
+	header.Set(headers.ContentType, constants.ContentTypePrometheus)
+
+	// bad format
+	resp := mock.DoRequest(t, r, http.MethodPost, WritePath+"?db=test", `xxxx`, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	writeReq := &prometheus.WriteRequest{TimeSeries: []prometheus.TimeSeries{{
+		Labels:  []prometheus.Label{{Name: prometheus.MetricNameLabel, Value: "cpu"}, {Name: "host", Value: "a"}},
+		Samples: []prometheus.Sample{{Value: 1, Timestamp: timeutil.Now()}},
+	}}}
+	data := snappy.Encode(nil, writeReq.Marshal())
+	// no content
+	cm.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPost, WritePath+"?db=test", string(data), header)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+
+	// counter base value committed only after written successfully
+	counterData := func(value float64) string {
+		writeReq := &prometheus.WriteRequest{TimeSeries: []prometheus.TimeSeries{{
+			Labels:  []prometheus.Label{{Name: prometheus.MetricNameLabel, Value: "requests_total"}, {Name: "host", Value: "retry"}},
+			Samples: []prometheus.Sample{{Value: value, Timestamp: timeutil.Now()}},
+		}}}
+		return string(snappy.Encode(nil, writeReq.Marshal()))
+	}
+	cm.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPost, WritePath+"?db=test", counterData(10), header)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	cm.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(constants.ErrWriteNotAcknowledged)
+	resp = mock.DoRequest(t, r, http.MethodPost, WritePath+"?db=test", counterData(15), header)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	cm.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, rows *metric.BrokerBatchRows) error {
+			assert.Equal(t, 1, rows.Len())
+			m := rows.Rows()[0].Metric()
+			var f flatMetricsV1.SimpleField
+			m.SimpleFields(&f, 0)
+			assert.Equal(t, 5.0, f.Value())
+			return nil
+		})
+	resp = mock.DoRequest(t, r, http.MethodPost, WritePath+"?db=test", counterData(15), header)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+}
+
+func TestWrite_Privilege(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetUser("test").Return(models.User{Name: "test", Roles: []string{"writer"}}, true).AnyTimes()
+	stateMgr.EXPECT().GetRole("writer").Return(models.Role{Name: "writer", Grants: []models.Grant{
+		{Database: "db", Privilege: models.WritePrivilege},
+	}}, true).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseLimits(gomock.Any()).Return(models.NewDefaultLimits()).AnyTimes()
+	api := NewWrite(&deps.HTTPDeps{
+		BrokerCfg: &config.Broker{
+			BrokerBase: config.BrokerBase{
+				Ingestion: config.Ingestion{
+					IngestTimeout: ltoml.Duration(time.Second * 2),
+				},
+				Auth: config.Auth{Enabled: true, UserName: "admin"},
+			},
+		},
+		StateMgr: stateMgr,
+		IngestLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("privilege_write_test", linmetric.BrokerRegistry)),
+	})
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if user := c.GetHeader("user"); user != "" {
+			c.Set(constants.CurrentUser, user)
+		}
+	})
+	api.Register(r)
+
+	// not login
+	resp := mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=db", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	header := make(http.Header)
+	header.Set("user", "test")
+	// permission denied
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=db2", "", header)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	// has write privilege, but content type not support
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=db", "", header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	// write-only api token
+	r = gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set(constants.CurrentAPIToken, &models.APIToken{Database: "db", Privilege: models.WritePrivilege})
+	})
+	api.Register(r)
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=db2", "")
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=db", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
+
+func TestWrite_RateLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	limits := models.NewDefaultLimits()
+	limits.MaxWriteRowsPerSecond = 1
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseLimits(gomock.Any()).Return(limits).AnyTimes()
+	cm := replica.NewMockChannelManager(ctrl)
+	api := NewWrite(&deps.HTTPDeps{
+		BrokerCfg: &config.Broker{
+			BrokerBase: config.BrokerBase{
+				Ingestion: config.Ingestion{
+					IngestTimeout: ltoml.Duration(time.Second * 2),
+				},
+			},
+		},
+		StateMgr: stateMgr,
+		CM:       cm,
+		IngestLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("rate_limit_write_test", linmetric.BrokerRegistry)),
+	})
+	r := gin.New()
+	api.Register(r)
+
+	header := make(http.Header)
+	header.Set(headers.ContentType, constants.ContentTypeInflux)
+	body := "cpu,host=a f1=1\ncpu,host=b f1=1\ncpu,host=c f1=1"
+	cm.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	resp := mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=test", body, header)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	// quota exhausted by the rows of previous request
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=test", body, header)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "3", resp.Header().Get("Retry-After"))
+	// parse failure also takes the bytes
+	limits.MaxWriteRowsPerSecond = 0
+	limits.MaxWriteBytesPerSecond = 1
+	flatHeader := make(http.Header)
+	flatHeader.Set(headers.ContentType, constants.ContentTypeFlat)
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=test", "bad data", flatHeader)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=test", body, header)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
@@ -20,28 +20,29 @@ package api
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/lindb/lindb/app/broker/auth"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/pkg/http"
-	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/logger"
 )
 
 var (
-	createTokenFn = middleware.CreateToken
+	createTokenFn = auth.CreateToken
 	LoginPath     = "/login"
 )
 
 // LoginAPI represents login param
 type LoginAPI struct {
-	user config.User
+	deps *depspkg.HTTPDeps
 
 	logger *logger.Logger
 }
 
 // NewLoginAPI creates login api instance
-func NewLoginAPI(user config.User) *LoginAPI {
+func NewLoginAPI(deps *depspkg.HTTPDeps) *LoginAPI {
 	return &LoginAPI{
-		user:   user,
+		deps:   deps,
 		logger: logger.GetLogger("Broker", "LoginAPI"),
 	}
 }
@@ -62,19 +63,14 @@ func (l *LoginAPI) Login(c *gin.Context) {
 		http.OK(c, "")
 		return
 	}
-	// user name is error
-	if l.user.UserName != user.UserName {
-		l.logger.Error("username is invalid")
+	// user name or password is error
+	loginUser, ok := auth.Login(l.deps, user.UserName, user.Password)
+	if !ok {
+		l.logger.Error("username or password is invalid", logger.String("user", user.UserName))
 		http.OK(c, "")
 		return
 	}
-	// password is error
-	if l.user.Password != user.Password {
-		l.logger.Error("password is invalid")
-		http.OK(c, "")
-		return
-	}
-	token, err := createTokenFn(user)
+	token, err := createTokenFn(l.deps, loginUser)
 	if err != nil {
 		http.OK(c, "")
 		return
@@ -26,20 +26,29 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/app/broker/auth"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/internal/mock"
-	httppkg "github.com/lindb/lindb/pkg/http/middleware"
+	"github.com/lindb/lindb/models"
 )
 
 func TestLogin(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
-		createTokenFn = httppkg.CreateToken
+		createTokenFn = auth.CreateToken
 		ctrl.Finish()
 	}()
 
-	user := config.User{UserName: "admin", Password: "admin123"}
-	api := NewLoginAPI(user)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetUser(gomock.Any()).Return(models.User{}, false).AnyTimes()
+	api := NewLoginAPI(&depspkg.HTTPDeps{
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			Auth: config.Auth{UserName: "admin", Password: "admin123"},
+		}},
+		StateMgr: stateMgr,
+	})
 	r := gin.New()
 	api.Register(r)
 
@@ -67,14 +76,14 @@ func TestLogin(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.Code)
 
 	// token create fail
-	createTokenFn = func(user config.User) (string, error) {
+	createTokenFn = func(_ *depspkg.HTTPDeps, _ config.User) (string, error) {
 		return "", fmt.Errorf("err")
 	}
 	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "admin", "password": "admin123"}`)
 	assert.Equal(t, http.StatusOK, resp.Code)
 
 	// token create ok
-	createTokenFn = httppkg.CreateToken
+	createTokenFn = auth.CreateToken
 	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "admin", "password": "admin123"}`)
 	assert.Equal(t, http.StatusOK, resp.Code)
 }
@@ -0,0 +1,499 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	commonconstants "github.com/lindb/common/constants"
+
+	"github.com/lindb/lindb/app/broker/auth"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	ingestprom "github.com/lindb/lindb/ingestion/prometheus"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/query"
+	"github.com/lindb/lindb/sql/promql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var (
+	// QueryPath represents PromQL instant query path.
+	QueryPath = "/query"
+	// QueryRangePath represents PromQL range query path.
+	QueryRangePath = "/query_range"
+)
+
+// for testing
+var (
+	metricDataSearchFn     = query.MetricDataSearch
+	metricMetadataSearchFn = query.MetricMetadataSearch
+)
+
+const (
+	// defaultLookbackDelta represents the time range which instant query looks back for finding latest point.
+	defaultLookbackDelta = 5 * time.Minute
+	// maxPointsPerSeries represents the max points of each series which range query returns.
+	maxPointsPerSeries = 11000
+	// defaultMaxSeries represents the max series of query result if database limit not set.
+	defaultMaxSeries = 10000
+
+	statusSuccess = "success"
+	statusError   = "error"
+
+	errorBadData   = "bad_data"
+	errorExecution = "execution"
+
+	resultTypeMatrix = "matrix"
+	resultTypeVector = "vector"
+	resultTypeScalar = "scalar"
+)
+
+// Response represents the response of prometheus http api.
+type Response struct {
+	Status    string `json:"status"`
+	Data      any    `json:"data,omitempty"`
+	ErrorType string `json:"errorType,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// QueryData represents the data of query response.
+type QueryData struct {
+	ResultType string `json:"resultType"`
+	Result     any    `json:"result"`
+}
+
+// Point represents a sample point, encoded as [<unix_time_seconds>, "<value>"].
+type Point struct {
+	Timestamp int64 // millisecond
+	Value     float64
+}
+
+// MarshalJSON returns the json data of point as prometheus format.
+func (p Point) MarshalJSON() ([]byte, error) {
+	ts := strconv.FormatFloat(float64(p.Timestamp)/1000, 'f', -1, 64)
+	return []byte(fmt.Sprintf("[%s,%q]", ts, formatValue(p.Value))), nil
+}
+
+// Series represents a time series of range query result(matrix).
+type Series struct {
+	Metric map[string]string `json:"metric"`
+	Values []Point           `json:"values"`
+}
+
+// Sample represents a sample of instant query result(vector).
+type Sample struct {
+	Metric map[string]string `json:"metric"`
+	Value  Point             `json:"value"`
+}
+
+// queryParam represents the common param of instant/range query.
+type queryParam struct {
+	Database  string `form:"db" binding:"required"`
+	Namespace string `form:"ns"`
+	Query     string `form:"query" binding:"required"`
+}
+
+// QueryAPI represents prometheus query api, which executes PromQL expression via LinDB query engine.
+type QueryAPI struct {
+	deps *depspkg.HTTPDeps
+
+	logger *logger.Logger
+}
+
+// NewQueryAPI creates prometheus query api.
+func NewQueryAPI(deps *depspkg.HTTPDeps) *QueryAPI {
+	return &QueryAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "PromQLAPI"),
+	}
+}
+
+// Register adds prometheus query api's path.
+func (api *QueryAPI) Register(route gin.IRoutes) {
+	route.GET(QueryPath, api.Query)
+	route.POST(QueryPath, api.Query)
+	route.GET(QueryRangePath, api.QueryRange)
+	route.POST(QueryRangePath, api.QueryRange)
+}
+
+// Query evaluates PromQL expression at a single point in time.
+//
+// @Summary prometheus instant query
+// @Description Evaluates an instant query(PromQL) at a single point in time, response format same as prometheus.
+// @Tags PromQL
+// @Param db query string true "database name"
+// @Param ns query string false "namespace, default value: default-ns"
+// @Param query query string true "PromQL expression"
+// @Param time query string false "evaluation timestamp(rfc3339 or unix timestamp), default value: now"
+// @Produce json
+// @Success 200 {object} Response
+// @Failure 400 {object} Response
+// @Failure 401 {object} Response
+// @Failure 403 {object} Response
+// @Failure 422 {object} Response
+// @Router /query [get]
+// @Router /query [post]
+func (api *QueryAPI) Query(c *gin.Context) {
+	var param struct {
+		queryParam
+		Time string `form:"time"`
+	}
+	if err := c.ShouldBindWith(&param, binding.Form); err != nil {
+		api.errorResponse(c, http.StatusBadRequest, errorBadData, err)
+		return
+	}
+	ts, err := parseTime(param.Time, timeutil.Now())
+	if err != nil {
+		api.errorResponse(c, http.StatusBadRequest, errorBadData, err)
+		return
+	}
+	plan, err := parsePlan(param.Query)
+	if err != nil {
+		api.errorResponse(c, http.StatusBadRequest, errorBadData, err)
+		return
+	}
+	if plan.IsScalar() {
+		api.okResponse(c, resultTypeScalar, Point{Timestamp: ts, Value: plan.Scalar})
+		return
+	}
+	plan.Query.TimeRange = timeutil.TimeRange{Start: ts - defaultLookbackDelta.Milliseconds(), End: ts}
+	rs, err := api.execute(c, &param.queryParam, plan)
+	if err != nil {
+		api.executeErrorResponse(c, err)
+		return
+	}
+	api.okResponse(c, resultTypeVector, buildVector(rs, plan, ts))
+}
+
+// QueryRange evaluates PromQL expression over a range of time.
+//
+// @Summary prometheus range query
+// @Description Evaluates an expression query(PromQL) over a range of time, response format same as prometheus.
+// @Tags PromQL
+// @Param db query string true "database name"
+// @Param ns query string false "namespace, default value: default-ns"
+// @Param query query string true "PromQL expression"
+// @Param start query string true "start timestamp(rfc3339 or unix timestamp)"
+// @Param end query string true "end timestamp(rfc3339 or unix timestamp)"
+// @Param step query string true "query resolution step width(duration or float number of seconds)"
+// @Produce json
+// @Success 200 {object} Response
+// @Failure 400 {object} Response
+// @Failure 401 {object} Response
+// @Failure 403 {object} Response
+// @Failure 422 {object} Response
+// @Router /query_range [get]
+// @Router /query_range [post]
+func (api *QueryAPI) QueryRange(c *gin.Context) {
+	var param struct {
+		queryParam
+		Start string `form:"start" binding:"required"`
+		End   string `form:"end" binding:"required"`
+		Step  string `form:"step" binding:"required"`
+	}
+	if err := c.ShouldBindWith(&param, binding.Form); err != nil {
+		api.errorResponse(c, http.StatusBadRequest, errorBadData, err)
+		return
+	}
+	timeRange, step, err := parseRange(param.Start, param.End, param.Step)
+	if err != nil {
+		api.errorResponse(c, http.StatusBadRequest, errorBadData, err)
+		return
+	}
+	plan, err := parsePlan(param.Query)
+	if err != nil {
+		api.errorResponse(c, http.StatusBadRequest, errorBadData, err)
+		return
+	}
+	if plan.IsScalar() {
+		series := &Series{Metric: map[string]string{}}
+		for ts := timeRange.Start; ts <= timeRange.End; ts += step {
+			series.Values = append(series.Values, Point{Timestamp: ts, Value: plan.Scalar})
+		}
+		api.okResponse(c, resultTypeMatrix, []*Series{series})
+		return
+	}
+	plan.Query.TimeRange = timeRange
+	plan.Query.Interval = timeutil.Interval(step)
+	rs, err := api.execute(c, &param.queryParam, plan)
+	if err != nil {
+		api.executeErrorResponse(c, err)
+		return
+	}
+	api.okResponse(c, resultTypeMatrix, buildMatrix(rs, plan))
+}
+
+// execute executes the metric query which translated from PromQL with rate limit,
+// requires read privilege on the database.
+func (api *QueryAPI) execute(c *gin.Context, param *queryParam, plan *promql.Plan) (rs *models.ResultSet, err error) {
+	if err := auth.CheckPrivilege(c, api.deps, param.Database, models.ReadPrivilege); err != nil {
+		return nil, err
+	}
+	executeParam := &models.ExecuteParam{Database: param.Database, SQL: param.Query}
+	c.Set(constants.CurrentSQL, executeParam)
+
+	namespace := param.Namespace
+	if namespace == "" {
+		namespace = commonconstants.DefaultNamespace
+	}
+	statement := plan.Query
+	statement.Namespace = namespace
+	statement.Limit = defaultMaxSeries
+	limits := api.deps.StateMgr.GetDatabaseLimits(param.Database)
+	if limits != nil && limits.MaxSeriesPerQuery > 0 {
+		statement.Limit = limits.MaxSeriesPerQuery
+	}
+	err = api.deps.QueryLimiter.Do(func() error {
+		ctx, cancel := api.deps.WithTimeout()
+		defer cancel()
+
+		searchMgr := &query.SearchMgr{
+			Timeout:      api.deps.BrokerCfg.Query.Timeout.Duration(),
+			Limits:       limits,
+			CurNode:      *api.deps.Node,
+			Choose:       api.deps.StateMgr,
+			TaskMgr:      api.deps.TaskMgr,
+			TransportMgr: api.deps.TransportMgr,
+		}
+		if plan.GroupByAllTags {
+			// keep each series of metric, so group by all tag keys
+			tagKeys, err := metricMetadataSearchFn(ctx, executeParam, &stmtpkg.MetricMetadata{
+				Namespace:  namespace,
+				MetricName: statement.MetricName,
+				Type:       stmtpkg.TagKey,
+				Limit:      constants.MaxSuggestions,
+			}, searchMgr)
+			if err != nil {
+				return err
+			}
+			if keys, ok := tagKeys.([]string); ok {
+				keys = strutil.DeDupStringSlice(keys)
+				sort.Strings(keys)
+				statement.GroupBy = keys
+			}
+		}
+		result, err := metricDataSearchFn(ctx, executeParam, statement, searchMgr)
+		if err != nil {
+			return err
+		}
+		rs, _ = result.(*models.ResultSet)
+		return nil
+	})
+	if err != nil {
+		api.logger.Error("execute PromQL failure",
+			logger.String("db", param.Database), logger.String("query", param.Query), logger.Error(err))
+		return nil, err
+	}
+	return rs, nil
+}
+
+// okResponse responses the query result with prometheus format.
+func (api *QueryAPI) okResponse(c *gin.Context, resultType string, result any) {
+	c.JSON(http.StatusOK, &Response{
+		Status: statusSuccess,
+		Data: &QueryData{
+			ResultType: resultType,
+			Result:     result,
+		},
+	})
+}
+
+// errorResponse responses the error with prometheus format.
+func (api *QueryAPI) errorResponse(c *gin.Context, httpCode int, errorType string, err error) {
+	_ = c.Error(err)
+	c.JSON(httpCode, &Response{
+		Status:    statusError,
+		ErrorType: errorType,
+		Error:     err.Error(),
+	})
+}
+
+// executeErrorResponse responses the error of query execution with prometheus format.
+func (api *QueryAPI) executeErrorResponse(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrUnauthorized):
+		api.errorResponse(c, http.StatusUnauthorized, errorExecution, err)
+	case errors.Is(err, constants.ErrPermissionDenied):
+		api.errorResponse(c, http.StatusForbidden, errorExecution, err)
+	default:
+		api.errorResponse(c, http.StatusUnprocessableEntity, errorExecution, err)
+	}
+}
+
+// parsePlan parses PromQL expression, then translates it into LinDB query plan.
+func parsePlan(input string) (*promql.Plan, error) {
+	expr, err := promql.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return promql.Translate(expr)
+}
+
+// buildMatrix builds range query result from result set.
+func buildMatrix(rs *models.ResultSet, plan *promql.Plan) []*Series {
+	result := make([]*Series, 0)
+	if rs == nil {
+		return result
+	}
+	for _, s := range rs.Series {
+		points := sortedPoints(s)
+		if len(points) == 0 {
+			continue
+		}
+		result = append(result, &Series{
+			Metric: buildLabels(s, plan),
+			Values: points,
+		})
+	}
+	return result
+}
+
+// buildVector builds instant query result from result set, picks the latest point before evaluation time.
+func buildVector(rs *models.ResultSet, plan *promql.Plan, ts int64) []*Sample {
+	result := make([]*Sample, 0)
+	if rs == nil {
+		return result
+	}
+	for _, s := range rs.Series {
+		points := sortedPoints(s)
+		idx := sort.Search(len(points), func(i int) bool {
+			return points[i].Timestamp > ts
+		})
+		if idx == 0 {
+			continue
+		}
+		result = append(result, &Sample{
+			Metric: buildLabels(s, plan),
+			Value:  Point{Timestamp: ts, Value: points[idx-1].Value},
+		})
+	}
+	return result
+}
+
+// sortedPoints returns the points of value field which sorted by timestamp.
+func sortedPoints(s *models.Series) []Point {
+	values := s.Fields[ingestprom.ValueFieldName]
+	points := make([]Point, 0, len(values))
+	for timestamp, value := range values {
+		points = append(points, Point{Timestamp: timestamp, Value: value})
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Timestamp < points[j].Timestamp
+	})
+	return points
+}
+
+// buildLabels builds the labels of series, includes __name__ if keeps metric name.
+func buildLabels(s *models.Series, plan *promql.Plan) map[string]string {
+	labels := make(map[string]string, len(s.Tags)+1)
+	for k, v := range s.Tags {
+		labels[k] = v
+	}
+	if plan.KeepMetricName {
+		labels[ingestprom.MetricNameLabel] = plan.Query.MetricName
+	}
+	return labels
+}
+
+// parseRange parses the time range and step(millisecond) of range query.
+func parseRange(startStr, endStr, stepStr string) (timeRange timeutil.TimeRange, step int64, err error) {
+	start, err := parseTime(startStr, 0)
+	if err != nil {
+		return timeRange, 0, err
+	}
+	end, err := parseTime(endStr, 0)
+	if err != nil {
+		return timeRange, 0, err
+	}
+	if end < start {
+		return timeRange, 0, fmt.Errorf("end timestamp must not be before start time")
+	}
+	step, err = parseStep(stepStr)
+	if err != nil {
+		return timeRange, 0, err
+	}
+	if step <= 0 {
+		return timeRange, 0, fmt.Errorf("zero or negative query resolution step widths are not accepted")
+	}
+	if (end-start)/step > maxPointsPerSeries {
+		return timeRange, 0, fmt.Errorf("exceeded maximum resolution of %d points per timeseries", maxPointsPerSeries)
+	}
+	return timeutil.TimeRange{Start: start, End: end}, step, nil
+}
+
+// parseTime parses timestamp(millisecond) from rfc3339 or unix timestamp(seconds) string.
+func parseTime(s string, defaultValue int64) (int64, error) {
+	if s == "" {
+		if defaultValue > 0 {
+			return defaultValue, nil
+		}
+		return 0, fmt.Errorf("missing timestamp")
+	}
+	if seconds, err := strconv.ParseFloat(s, 64); err == nil {
+		if math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+			return 0, fmt.Errorf("cannot parse %q to a valid timestamp", s)
+		}
+		return int64(math.Round(seconds * 1000)), nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t.UnixMilli(), nil
+	}
+	return 0, fmt.Errorf("cannot parse %q to a valid timestamp", s)
+}
+
+// parseStep parses step(millisecond) from duration or float number of seconds string.
+func parseStep(s string) (int64, error) {
+	if seconds, err := strconv.ParseFloat(s, 64); err == nil {
+		if math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+			return 0, fmt.Errorf("cannot parse %q to a valid duration", s)
+		}
+		return int64(math.Round(seconds * 1000)), nil
+	}
+	d, err := promql.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse %q to a valid duration", s)
+	}
+	return d.Milliseconds(), nil
+}
+
+// formatValue formats sample value as prometheus format.
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+}
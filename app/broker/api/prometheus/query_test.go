@@ -0,0 +1,491 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/query"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func newTestAPI(t *testing.T) (*gin.Engine, *broker.MockStateManager) {
+	api, stateMgr := newTestQueryAPI(t)
+	r := gin.New()
+	api.Register(r)
+	return r, stateMgr
+}
+
+func newTestQueryAPI(t *testing.T) (*QueryAPI, *broker.MockStateManager) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseLimits(gomock.Any()).Return(models.NewDefaultLimits()).AnyTimes()
+	api := NewQueryAPI(&depspkg.HTTPDeps{
+		Ctx:      context.Background(),
+		Node:     &models.StatelessNode{},
+		StateMgr: stateMgr,
+		BrokerCfg: &config.Broker{
+			BrokerBase: config.BrokerBase{
+				HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+			},
+			Query: *config.NewDefaultQuery(),
+		},
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			2,
+			time.Second*5,
+			metrics.NewLimitStatistics("promql", linmetric.BrokerRegistry),
+		),
+	})
+	return api, stateMgr
+}
+
+func doGet(r *gin.Engine, path string) *httptest.ResponseRecorder {
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, path, http.NoBody))
+	return resp
+}
+
+func decodeResponse(t *testing.T, body []byte) *Response {
+	rs := &Response{}
+	assert.NoError(t, encoding.JSONUnmarshal(body, rs))
+	return rs
+}
+
+func TestQueryAPI_Query(t *testing.T) {
+	defer func() {
+		metricDataSearchFn = query.MetricDataSearch
+		metricMetadataSearchFn = query.MetricMetadataSearch
+	}()
+	r, _ := newTestAPI(t)
+
+	cases := []struct {
+		name    string
+		path    string
+		prepare func()
+		assert  func(code int, body string)
+	}{
+		{
+			name: "missing query",
+			path: QueryPath + "?db=test",
+			assert: func(code int, body string) {
+				assert.Equal(t, http.StatusBadRequest, code)
+				rs := decodeResponse(t, []byte(body))
+				assert.Equal(t, statusError, rs.Status)
+				assert.Equal(t, errorBadData, rs.ErrorType)
+			},
+		},
+		{
+			name: "bad time",
+			path: QueryPath + "?db=test&query=cpu&time=abc",
+			assert: func(code int, _ string) {
+				assert.Equal(t, http.StatusBadRequest, code)
+			},
+		},
+		{
+			name: "bad expression",
+			path: QueryPath + "?db=test&query=" + url.QueryEscape("sum(cpu"),
+			assert: func(code int, _ string) {
+				assert.Equal(t, http.StatusBadRequest, code)
+			},
+		},
+		{
+			name: "scalar",
+			path: QueryPath + "?db=test&time=100&query=" + url.QueryEscape("1+1"),
+			assert: func(code int, body string) {
+				assert.Equal(t, http.StatusOK, code)
+				assert.JSONEq(t, `{"status":"success","data":{"resultType":"scalar","result":[100,"2"]}}`, body)
+			},
+		},
+		{
+			name: "search tag keys failure",
+			path: QueryPath + "?db=test&query=cpu",
+			prepare: func() {
+				metricMetadataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+					_ *stmt.MetricMetadata, _ *query.SearchMgr) (any, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			assert: func(code int, body string) {
+				assert.Equal(t, http.StatusUnprocessableEntity, code)
+				rs := decodeResponse(t, []byte(body))
+				assert.Equal(t, errorExecution, rs.ErrorType)
+			},
+		},
+		{
+			name: "search data failure",
+			path: QueryPath + "?db=test&query=" + url.QueryEscape("sum(cpu)"),
+			prepare: func() {
+				metricDataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+					_ *stmt.Query, _ *query.SearchMgr) (any, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			assert: func(code int, _ string) {
+				assert.Equal(t, http.StatusUnprocessableEntity, code)
+			},
+		},
+		{
+			name: "instant vector",
+			path: QueryPath + "?db=test&ns=ns&time=2022-01-01T00:10:00Z&query=" + url.QueryEscape(`cpu{host="a"}`),
+			prepare: func() {
+				metricMetadataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+					statement *stmt.MetricMetadata, _ *query.SearchMgr) (any, error) {
+					assert.Equal(t, "cpu", statement.MetricName)
+					assert.Equal(t, "ns", statement.Namespace)
+					assert.Equal(t, stmt.TagKey, statement.Type)
+					return []string{"region", "host", "host"}, nil
+				}
+				metricDataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+					statement *stmt.Query, _ *query.SearchMgr) (any, error) {
+					assert.Equal(t, []string{"host", "region"}, statement.GroupBy)
+					assert.Equal(t, "ns", statement.Namespace)
+					end := time.Date(2022, 1, 1, 0, 10, 0, 0, time.UTC).UnixMilli()
+					assert.Equal(t, end, statement.TimeRange.End)
+					assert.Equal(t, end-defaultLookbackDelta.Milliseconds(), statement.TimeRange.Start)
+					return &models.ResultSet{Series: []*models.Series{
+						{
+							Tags: map[string]string{"host": "a", "region": "b"},
+							Fields: map[string]map[int64]float64{
+								"value": {end - 120000: 1, end - 60000: 2, end + 60000: 3},
+							},
+						},
+						{
+							Tags:   map[string]string{"host": "a", "region": "c"},
+							Fields: map[string]map[int64]float64{"value": {end + 60000: 3}},
+						},
+					}}, nil
+				}
+			},
+			assert: func(code int, body string) {
+				assert.Equal(t, http.StatusOK, code)
+				assert.JSONEq(t, `{"status":"success","data":{"resultType":"vector","result":[`+
+					`{"metric":{"__name__":"cpu","host":"a","region":"b"},"value":[1640995800,"2"]}]}}`, body)
+			},
+		},
+		{
+			name: "empty result",
+			path: QueryPath + "?db=test&query=" + url.QueryEscape(`sum(cpu)`),
+			prepare: func() {
+				metricDataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+					_ *stmt.Query, _ *query.SearchMgr) (any, error) {
+					return nil, nil
+				}
+			},
+			assert: func(code int, body string) {
+				assert.Equal(t, http.StatusOK, code)
+				assert.JSONEq(t, `{"status":"success","data":{"resultType":"vector","result":[]}}`, body)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				metricDataSearchFn = query.MetricDataSearch
+				metricMetadataSearchFn = query.MetricMetadataSearch
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := doGet(r, tt.path)
+			tt.assert(resp.Code, resp.Body.String())
+		})
+	}
+}
+
+func TestQueryAPI_QueryRange(t *testing.T) {
+	defer func() {
+		metricDataSearchFn = query.MetricDataSearch
+	}()
+	r, _ := newTestAPI(t)
+
+	cases := []struct {
+		name    string
+		path    string
+		prepare func()
+		assert  func(code int, body string)
+	}{
+		{
+			name: "missing step",
+			path: QueryRangePath + "?db=test&query=cpu&start=1&end=2",
+			assert: func(code int, _ string) {
+				assert.Equal(t, http.StatusBadRequest, code)
+			},
+		},
+		{
+			name: "bad range",
+			path: QueryRangePath + "?db=test&query=cpu&start=10&end=2&step=1",
+			assert: func(code int, _ string) {
+				assert.Equal(t, http.StatusBadRequest, code)
+			},
+		},
+		{
+			name: "bad expression",
+			path: QueryRangePath + "?db=test&query=" + url.QueryEscape("irate(cpu[5m])") + "&start=1&end=2&step=1",
+			assert: func(code int, body string) {
+				assert.Equal(t, http.StatusBadRequest, code)
+				rs := decodeResponse(t, []byte(body))
+				assert.Equal(t, "function irate is not supported", rs.Error)
+			},
+		},
+		{
+			name: "scalar",
+			path: QueryRangePath + "?db=test&query=" + url.QueryEscape("2*3") + "&start=10&end=20&step=5s",
+			assert: func(code int, body string) {
+				assert.Equal(t, http.StatusOK, code)
+				assert.JSONEq(t, `{"status":"success","data":{"resultType":"matrix","result":[`+
+					`{"metric":{},"values":[[10,"6"],[15,"6"],[20,"6"]]}]}}`, body)
+			},
+		},
+		{
+			name: "search data failure",
+			path: QueryRangePath + "?db=test&query=" + url.QueryEscape("sum(cpu)") + "&start=10&end=20&step=5",
+			prepare: func() {
+				metricDataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+					_ *stmt.Query, _ *query.SearchMgr) (any, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			assert: func(code int, _ string) {
+				assert.Equal(t, http.StatusUnprocessableEntity, code)
+			},
+		},
+		{
+			name: "matrix",
+			path: QueryRangePath + "?db=test&query=" +
+				url.QueryEscape("sum by (host) (rate(http_requests_total[5m]))") + "&start=10&end=130&step=1m",
+			prepare: func() {
+				metricDataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+					statement *stmt.Query, _ *query.SearchMgr) (any, error) {
+					assert.Equal(t, "http_requests_total", statement.MetricName)
+					assert.Equal(t, []string{"host"}, statement.GroupBy)
+					assert.Equal(t, int64(60000), statement.Interval.Int64())
+					assert.Equal(t, int64(10000), statement.TimeRange.Start)
+					assert.Equal(t, int64(130000), statement.TimeRange.End)
+					assert.Equal(t, models.NewDefaultLimits().MaxSeriesPerQuery, statement.Limit)
+					return &models.ResultSet{Series: []*models.Series{
+						{
+							Tags: map[string]string{"host": "a"},
+							Fields: map[string]map[int64]float64{
+								"value": {120000: 1.5, 60000: math.Inf(1)},
+							},
+						},
+						{
+							Tags:   map[string]string{"host": "b"},
+							Fields: map[string]map[int64]float64{},
+						},
+					}}, nil
+				}
+			},
+			assert: func(code int, body string) {
+				assert.Equal(t, http.StatusOK, code)
+				assert.JSONEq(t, `{"status":"success","data":{"resultType":"matrix","result":[`+
+					`{"metric":{"host":"a"},"values":[[60,"+Inf"],[120,"1.5"]]}]}}`, body)
+			},
+		},
+		{
+			name: "empty result",
+			path: QueryRangePath + "?db=test&query=cpu&start=10&end=20&step=5",
+			prepare: func() {
+				metricMetadataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+					_ *stmt.MetricMetadata, _ *query.SearchMgr) (any, error) {
+					return nil, nil
+				}
+				metricDataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+					_ *stmt.Query, _ *query.SearchMgr) (any, error) {
+					return nil, nil
+				}
+			},
+			assert: func(code int, body string) {
+				assert.Equal(t, http.StatusOK, code)
+				assert.JSONEq(t, `{"status":"success","data":{"resultType":"matrix","result":[]}}`, body)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				metricDataSearchFn = query.MetricDataSearch
+				metricMetadataSearchFn = query.MetricMetadataSearch
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := doGet(r, tt.path)
+			tt.assert(resp.Code, resp.Body.String())
+		})
+	}
+}
+
+func TestQueryAPI_PostForm(t *testing.T) {
+	r, _ := newTestAPI(t)
+	header := make(http.Header)
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp := mock.DoRequest(t, r, http.MethodPost, QueryPath+"?db=test", "query=1&time=1", header)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"status":"success","data":{"resultType":"scalar","result":[1,"1"]}}`, resp.Body.String())
+}
+
+func TestQueryAPI_CheckPrivilege(t *testing.T) {
+	defer func() {
+		metricDataSearchFn = query.MetricDataSearch
+		metricMetadataSearchFn = query.MetricMetadataSearch
+	}()
+	metricMetadataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+		_ *stmt.MetricMetadata, _ *query.SearchMgr) (any, error) {
+		return []string{"host"}, nil
+	}
+	metricDataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+		_ *stmt.Query, _ *query.SearchMgr) (any, error) {
+		return &models.ResultSet{}, nil
+	}
+	api, stateMgr := newTestQueryAPI(t)
+	api.deps.BrokerCfg.BrokerBase.Auth.Enabled = true
+	stateMgr.EXPECT().GetUser("test").Return(models.User{Name: "test", Roles: []string{"reader"}}, true).AnyTimes()
+	stateMgr.EXPECT().GetRole("reader").Return(models.Role{
+		Name:   "reader",
+		Grants: []models.Grant{{Database: "db", Privilege: models.ReadPrivilege}},
+	}, true).AnyTimes()
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if user := c.Query("user"); user != "" {
+			c.Set(constants.CurrentUser, user)
+		}
+	})
+	api.Register(r)
+
+	cases := []struct {
+		name string
+		path string
+		code int
+	}{
+		{
+			name: "instant query not login",
+			path: QueryPath + "?db=db&query=cpu",
+			code: http.StatusUnauthorized,
+		},
+		{
+			name: "range query not login",
+			path: QueryRangePath + "?db=db&start=1&end=2&step=1&query=cpu",
+			code: http.StatusUnauthorized,
+		},
+		{
+			name: "instant query permission denied",
+			path: QueryPath + "?user=test&db=other&query=cpu",
+			code: http.StatusForbidden,
+		},
+		{
+			name: "range query permission denied",
+			path: QueryRangePath + "?user=test&db=other&start=1&end=2&step=1&query=cpu",
+			code: http.StatusForbidden,
+		},
+		{
+			name: "instant query allowed",
+			path: QueryPath + "?user=test&db=db&query=cpu",
+			code: http.StatusOK,
+		},
+		{
+			name: "range query allowed",
+			path: QueryRangePath + "?user=test&db=db&start=1&end=2&step=1&query=cpu",
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			resp := doGet(r, tt.path)
+			assert.Equal(t, tt.code, resp.Code)
+			if tt.code != http.StatusOK {
+				rs := decodeResponse(t, resp.Body.Bytes())
+				assert.Equal(t, statusError, rs.Status)
+			}
+		})
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	ts, err := parseTime("", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), ts)
+	_, err = parseTime("", 0)
+	assert.Error(t, err)
+	ts, err = parseTime("1.5", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1500), ts)
+	ts, err = parseTime("1970-01-01T00:00:02Z", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2000), ts)
+	_, err = parseTime("NaN", 0)
+	assert.Error(t, err)
+	_, err = parseTime("abc", 0)
+	assert.Error(t, err)
+}
+
+func TestParseRange(t *testing.T) {
+	_, _, err := parseRange("a", "1", "1")
+	assert.Error(t, err)
+	_, _, err = parseRange("1", "a", "1")
+	assert.Error(t, err)
+	_, _, err = parseRange("1", "2", "a")
+	assert.Error(t, err)
+	_, _, err = parseRange("1", "2", "NaN")
+	assert.Error(t, err)
+	_, _, err = parseRange("1", "2", "0")
+	assert.Error(t, err)
+	_, _, err = parseRange("0", "100000", "1")
+	assert.Error(t, err)
+	timeRange, step, err := parseRange("1", "2", "0.5")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), timeRange.Start)
+	assert.Equal(t, int64(2000), timeRange.End)
+	assert.Equal(t, int64(500), step)
+}
+
+func TestFormatValue(t *testing.T) {
+	assert.Equal(t, "+Inf", formatValue(math.Inf(1)))
+	assert.Equal(t, "-Inf", formatValue(math.Inf(-1)))
+	assert.Equal(t, "NaN", formatValue(math.NaN()))
+	assert.Equal(t, "1.25", formatValue(1.25))
+	assert.Equal(t, "100", formatValue(100))
+}
@@ -23,7 +23,9 @@ import (
 	"github.com/lindb/lindb/app/broker/api/admin"
 	"github.com/lindb/lindb/app/broker/api/exec"
 	"github.com/lindb/lindb/app/broker/api/ingest"
+	"github.com/lindb/lindb/app/broker/api/prometheus"
 	"github.com/lindb/lindb/app/broker/api/state"
+	"github.com/lindb/lindb/app/broker/auth"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
 	apipkg "github.com/lindb/lindb/internal/api"
@@ -35,10 +37,13 @@ import (
 type API struct {
 	deps *depspkg.HTTPDeps
 
+	login              *LoginAPI
 	execute            *exec.ExecuteAPI
+	promQL             *prometheus.QueryAPI
 	database           *admin.DatabaseAPI
 	flusher            *admin.DatabaseFlusherAPI
 	storage            *admin.StorageClusterAPI
+	apiToken           *admin.APITokenAPI
 	brokerStateMachine *state.BrokerStateMachineAPI
 	request            *apipkg.RequestAPI
 	metricExplore      *apipkg.ExploreAPI
@@ -53,10 +58,13 @@ type API struct {
 func NewAPI(deps *depspkg.HTTPDeps) *API {
 	return &API{
 		deps:               deps,
+		login:              NewLoginAPI(deps),
 		execute:            exec.NewExecuteAPI(deps),
+		promQL:             prometheus.NewQueryAPI(deps),
 		database:           admin.NewDatabaseAPI(deps),
 		flusher:            admin.NewDatabaseFlusherAPI(deps),
 		storage:            admin.NewStorageClusterAPI(deps),
+		apiToken:           admin.NewAPITokenAPI(deps),
 		brokerStateMachine: state.NewBrokerStateMachineAPI(deps),
 		request:            apipkg.NewRequestAPI(),
 		metricExplore:      apipkg.NewExploreAPI(deps.GlobalKeyValues, linmetric.BrokerRegistry),
@@ -72,12 +80,19 @@ func NewAPI(deps *depspkg.HTTPDeps) *API {
 func (api *API) RegisterRouter(router *gin.RouterGroup) {
 	router.Use(SlowSQLLog(api.deps))
 	v1 := router.Group(constants.APIVersion1)
+	// login api need not authenticate, so registers it before authentication middleware
+	api.login.Register(v1)
+	// api token is only accepted by write/execute api
+	v1.Use(auth.Authenticate(api.deps, v1.BasePath()+ingest.WritePath, v1.BasePath()+exec.ExecutePath))
 	// execute lin query language statement
 	api.execute.Register(v1)
+	// execute PromQL(prometheus query api compatible)
+	api.promQL.Register(v1)
 
 	api.database.Register(v1)
 	api.flusher.Register(v1)
 	api.storage.Register(v1)
+	api.apiToken.Register(v1)
 
 	// state
 	api.brokerStateMachine.Register(v1)
@@ -0,0 +1,268 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+)
+
+// bearerPrefix represents the prefix of bearer authorization header.
+const bearerPrefix = "Bearer "
+
+var apiTokenStatistics = metrics.NewAPITokenStatistics()
+
+// randomSecret is the secret key for signing login token if token secret not configured.
+var randomSecret = newRandomSecret()
+
+// tokenClaims represents the claims of login token, password isn't carried in token.
+type tokenClaims struct {
+	jwt.StandardClaims
+	UserName string `json:"username"`
+}
+
+// CreateToken creates the login token of user with expiration time.
+func CreateToken(deps *depspkg.HTTPDeps, user config.User) (string, error) {
+	now := time.Now()
+	claims := &tokenClaims{
+		StandardClaims: jwt.StandardClaims{
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(deps.BrokerCfg.BrokerBase.Auth.TokenExpire.Duration()).Unix(),
+		},
+		UserName: user.UserName,
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(signingKey(deps, user))
+}
+
+// Authenticate returns the middleware which validates the authorization token of request,
+// then sets the login user into context. Skips validation if authentication is disabled.
+// Api token is only accepted by the apis of apiTokenPaths, then sets the api token into context.
+func Authenticate(deps *depspkg.HTTPDeps, apiTokenPaths ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !deps.BrokerCfg.BrokerBase.Auth.Enabled {
+			c.Next()
+			return
+		}
+		tokenString := strings.TrimSpace(strings.TrimPrefix(c.GetHeader("Authorization"), bearerPrefix))
+		if id, secret, ok := models.ParseAPIToken(tokenString); ok {
+			token, err := validateAPIToken(deps, id, secret)
+			if err != nil {
+				httppkg.ErrorWithCode(c, http.StatusUnauthorized, err)
+				c.Abort()
+				return
+			}
+			if !acceptAPIToken(c.FullPath(), apiTokenPaths) {
+				apiTokenStatistics.DeniedRequests.WithTagValues(token.ID, token.Name, token.Database).Incr()
+				httppkg.ErrorWithCode(c, http.StatusForbidden,
+					fmt.Errorf("%w: api token cannot access %s", constants.ErrPermissionDenied, c.FullPath()))
+				c.Abort()
+				return
+			}
+			apiTokenStatistics.Requests.WithTagValues(token.ID, token.Name, token.Database).Incr()
+			c.Set(constants.CurrentAPIToken, token)
+			c.Next()
+			return
+		}
+		userName, err := validate(deps, tokenString)
+		if err != nil {
+			httppkg.ErrorWithCode(c, http.StatusUnauthorized, err)
+			c.Abort()
+			return
+		}
+		c.Set(constants.CurrentUser, userName)
+		c.Next()
+	}
+}
+
+// Login checks user name and password, returns the user which is used to create token if matched.
+// Root user is configured in broker's config, others are managed by lin query language.
+func Login(deps *depspkg.HTTPDeps, userName, password string) (config.User, bool) {
+	root := deps.BrokerCfg.BrokerBase.Auth.RootUser()
+	if userName == root.UserName {
+		return root, subtle.ConstantTimeCompare([]byte(password), []byte(root.Password)) == 1
+	}
+	user, ok := deps.StateMgr.GetUser(userName)
+	if !ok || !user.CheckPassword(password) {
+		return config.User{}, false
+	}
+	return config.User{UserName: user.Name, Password: user.Password}, true
+}
+
+// CheckPrivilege checks if current login user has the required privilege on database,
+// cluster level operation uses models.AllDatabases as database.
+// Root user has all privileges, returns nil if authentication is disabled.
+func CheckPrivilege(c *gin.Context, deps *depspkg.HTTPDeps, database string, required models.Privilege) error {
+	authCfg := deps.BrokerCfg.BrokerBase.Auth
+	if !authCfg.Enabled {
+		return nil
+	}
+	if token, ok := getAPIToken(c); ok {
+		if token.Allowed(database, required) {
+			return nil
+		}
+		apiTokenStatistics.DeniedRequests.WithTagValues(token.ID, token.Name, token.Database).Incr()
+		return fmt.Errorf("%w: api token %s only has %s privilege on database %s",
+			constants.ErrPermissionDenied, token.Name, token.Privilege, token.Database)
+	}
+	userName := c.GetString(constants.CurrentUser)
+	if userName == "" {
+		return constants.ErrUnauthorized
+	}
+	if userName == authCfg.UserName {
+		return nil
+	}
+	user, ok := deps.StateMgr.GetUser(userName)
+	if !ok {
+		return constants.ErrUnauthorized
+	}
+	for _, roleName := range user.Roles {
+		role, ok := deps.StateMgr.GetRole(roleName)
+		if ok && role.Allowed(database, required) {
+			return nil
+		}
+	}
+	if database == models.AllDatabases {
+		return fmt.Errorf("%w: %s privilege on all databases required", constants.ErrPermissionDenied, required)
+	}
+	return fmt.Errorf("%w: %s privilege on database %s required", constants.ErrPermissionDenied, required, database)
+}
+
+// CheckUser checks if current request is authenticated by user, for the cluster level operations
+// which only require login. Api token is scoped to one database, so it cannot access these operations.
+func CheckUser(c *gin.Context, deps *depspkg.HTTPDeps) error {
+	if !deps.BrokerCfg.BrokerBase.Auth.Enabled {
+		return nil
+	}
+	if token, ok := getAPIToken(c); ok {
+		apiTokenStatistics.DeniedRequests.WithTagValues(token.ID, token.Name, token.Database).Incr()
+		return fmt.Errorf("%w: api token %s cannot execute cluster level operation", constants.ErrPermissionDenied, token.Name)
+	}
+	if c.GetString(constants.CurrentUser) == "" {
+		return constants.ErrUnauthorized
+	}
+	return nil
+}
+
+// getAPIToken returns the api token of current request if authenticated by api token.
+func getAPIToken(c *gin.Context) (*models.APIToken, bool) {
+	value, ok := c.Get(constants.CurrentAPIToken)
+	if !ok {
+		return nil, false
+	}
+	token, ok := value.(*models.APIToken)
+	return token, ok
+}
+
+// validateAPIToken validates the api token, returns api token if valid.
+func validateAPIToken(deps *depspkg.HTTPDeps, id, secret string) (*models.APIToken, error) {
+	token, ok := deps.StateMgr.GetAPIToken(id)
+	if !ok || !token.CheckSecret(secret) {
+		apiTokenStatistics.InvalidTokens.Incr()
+		return nil, constants.ErrUnauthorized
+	}
+	return &token, nil
+}
+
+// acceptAPIToken returns if the api of path accepts api token.
+func acceptAPIToken(path string, apiTokenPaths []string) bool {
+	for _, p := range apiTokenPaths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// validate validates the token and returns the user name of token.
+func validate(deps *depspkg.HTTPDeps, tokenString string) (string, error) {
+	if tokenString == "" {
+		return "", constants.ErrUnauthorized
+	}
+	// find the user of token, then verify token using the signing key of user
+	claims := &tokenClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(tokenString, claims); err != nil {
+		return "", constants.ErrUnauthorized
+	}
+	user, ok := getUser(deps, claims.UserName)
+	if !ok {
+		return "", constants.ErrUnauthorized
+	}
+	claims = &tokenClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, constants.ErrUnauthorized
+		}
+		return signingKey(deps, user), nil
+	})
+	// token without expiration time is invalid
+	if err != nil || !token.Valid || claims.UserName != user.UserName || !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return "", constants.ErrUnauthorized
+	}
+	return user.UserName, nil
+}
+
+// signingKey returns the key for signing login token of user, which is derived from server secret and user's password,
+// so token is invalid after password changed.
+func signingKey(deps *depspkg.HTTPDeps, user config.User) []byte {
+	secret := deps.BrokerCfg.BrokerBase.Auth.TokenSecret
+	if secret == "" {
+		secret = randomSecret
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(user.UserName + "/" + user.Password))
+	return mac.Sum(nil)
+}
+
+// newRandomSecret returns the random secret key.
+func newRandomSecret() string {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(err)
+	}
+	return string(secret)
+}
+
+// getUser returns the user which is used to create token by name.
+func getUser(deps *depspkg.HTTPDeps, userName string) (config.User, bool) {
+	root := deps.BrokerCfg.BrokerBase.Auth.RootUser()
+	if userName == root.UserName {
+		return root, true
+	}
+	user, ok := deps.StateMgr.GetUser(userName)
+	if !ok {
+		return config.User{}, false
+	}
+	return config.User{UserName: user.Name, Password: user.Password}, true
+}
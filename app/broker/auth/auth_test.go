@@ -0,0 +1,250 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/ltoml"
+)
+
+func newDeps(ctrl *gomock.Controller, enabled bool) (*depspkg.HTTPDeps, *broker.MockStateManager) {
+	stateMgr := broker.NewMockStateManager(ctrl)
+	return &depspkg.HTTPDeps{
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			Auth: config.Auth{Enabled: enabled, UserName: "admin", Password: "admin123",
+				TokenExpire: ltoml.Duration(time.Hour)},
+		}},
+		StateMgr: stateMgr,
+	}, stateMgr
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deps, stateMgr := newDeps(ctrl, true)
+	r := gin.New()
+	r.Use(Authenticate(deps))
+	r.GET("/test", func(c *gin.Context) {
+		httppkg.OK(c, c.GetString(constants.CurrentUser))
+	})
+	doRequest := func(token string) (int, string) {
+		header := http.Header{}
+		if token != "" {
+			header.Set("Authorization", "Bearer "+token)
+		}
+		resp := mock.DoRequest(t, r, http.MethodGet, "/test", "", header)
+		return resp.Code, resp.Body.String()
+	}
+	// without token
+	code, _ := doRequest("")
+	assert.Equal(t, http.StatusUnauthorized, code)
+	// bad token
+	code, _ = doRequest("bad-token")
+	assert.Equal(t, http.StatusUnauthorized, code)
+	// root user
+	token, _ := CreateToken(deps, config.User{UserName: "admin", Password: "admin123"})
+	code, body := doRequest(token)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, `"admin"`, body)
+	// password not carried in token
+	claims := &tokenClaims{}
+	_, _, err := new(jwt.Parser).ParseUnverified(token, claims)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(encoding.JSONMarshal(claims)), "admin123")
+	// root user with wrong password
+	token, _ = CreateToken(deps, config.User{UserName: "admin", Password: "admin"})
+	code, _ = doRequest(token)
+	assert.Equal(t, http.StatusUnauthorized, code)
+	// token signed by other secret
+	deps.BrokerCfg.BrokerBase.Auth.TokenSecret = "secret"
+	token, _ = CreateToken(deps, config.User{UserName: "admin", Password: "admin123"})
+	deps.BrokerCfg.BrokerBase.Auth.TokenSecret = "other-secret"
+	code, _ = doRequest(token)
+	assert.Equal(t, http.StatusUnauthorized, code)
+	deps.BrokerCfg.BrokerBase.Auth.TokenSecret = ""
+	// token expired
+	deps.BrokerCfg.BrokerBase.Auth.TokenExpire = ltoml.Duration(-time.Minute)
+	token, _ = CreateToken(deps, config.User{UserName: "admin", Password: "admin123"})
+	code, _ = doRequest(token)
+	assert.Equal(t, http.StatusUnauthorized, code)
+	deps.BrokerCfg.BrokerBase.Auth.TokenExpire = ltoml.Duration(time.Hour)
+	// token without expiration time
+	token, _ = jwt.NewWithClaims(jwt.SigningMethodHS256, &tokenClaims{UserName: "admin"}).
+		SignedString(signingKey(deps, config.User{UserName: "admin", Password: "admin123"}))
+	code, _ = doRequest(token)
+	assert.Equal(t, http.StatusUnauthorized, code)
+
+	user, _ := models.NewUser("test", "pwd")
+	token, _ = CreateToken(deps, config.User{UserName: user.Name, Password: user.Password})
+	// user not exist
+	stateMgr.EXPECT().GetUser("test").Return(models.User{}, false)
+	code, _ = doRequest(token)
+	assert.Equal(t, http.StatusUnauthorized, code)
+	// password changed
+	changed, _ := models.NewUser("test", "pwd2")
+	stateMgr.EXPECT().GetUser("test").Return(*changed, true)
+	code, _ = doRequest(token)
+	assert.Equal(t, http.StatusUnauthorized, code)
+	// user exist
+	stateMgr.EXPECT().GetUser("test").Return(*user, true)
+	code, body = doRequest(token)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, `"test"`, body)
+
+	// auth disabled
+	deps.BrokerCfg.BrokerBase.Auth.Enabled = false
+	code, _ = doRequest("")
+	assert.Equal(t, http.StatusOK, code)
+}
+
+func TestLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deps, stateMgr := newDeps(ctrl, true)
+	user, ok := Login(deps, "admin", "admin123")
+	assert.True(t, ok)
+	assert.Equal(t, config.User{UserName: "admin", Password: "admin123"}, user)
+	_, ok = Login(deps, "admin", "admin")
+	assert.False(t, ok)
+
+	stateMgr.EXPECT().GetUser("test").Return(models.User{}, false)
+	_, ok = Login(deps, "test", "pwd")
+	assert.False(t, ok)
+	u, _ := models.NewUser("test", "pwd")
+	stateMgr.EXPECT().GetUser("test").Return(*u, true).Times(2)
+	_, ok = Login(deps, "test", "pwd2")
+	assert.False(t, ok)
+	user, ok = Login(deps, "test", "pwd")
+	assert.True(t, ok)
+	assert.Equal(t, config.User{UserName: "test", Password: u.Password}, user)
+}
+
+func TestCheckPrivilege(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deps, stateMgr := newDeps(ctrl, false)
+	c, _ := gin.CreateTestContext(nil)
+	// auth disabled
+	assert.NoError(t, CheckPrivilege(c, deps, "db", models.AdminPrivilege))
+
+	deps.BrokerCfg.BrokerBase.Auth.Enabled = true
+	// not login
+	assert.Equal(t, constants.ErrUnauthorized, CheckPrivilege(c, deps, "db", models.ReadPrivilege))
+	// root user
+	c.Set(constants.CurrentUser, "admin")
+	assert.NoError(t, CheckPrivilege(c, deps, models.AllDatabases, models.AdminPrivilege))
+
+	c.Set(constants.CurrentUser, "test")
+	// user not exist
+	stateMgr.EXPECT().GetUser("test").Return(models.User{}, false)
+	assert.Equal(t, constants.ErrUnauthorized, CheckPrivilege(c, deps, "db", models.ReadPrivilege))
+
+	stateMgr.EXPECT().GetUser("test").Return(models.User{Name: "test", Roles: []string{"not-exist", "reader"}}, true).AnyTimes()
+	stateMgr.EXPECT().GetRole("not-exist").Return(models.Role{}, false).AnyTimes()
+	stateMgr.EXPECT().GetRole("reader").Return(models.Role{
+		Name:   "reader",
+		Grants: []models.Grant{{Database: "db", Privilege: models.ReadPrivilege}},
+	}, true).AnyTimes()
+	assert.NoError(t, CheckPrivilege(c, deps, "db", models.ReadPrivilege))
+	err := CheckPrivilege(c, deps, "db", models.WritePrivilege)
+	assert.True(t, errors.Is(err, constants.ErrPermissionDenied))
+	err = CheckPrivilege(c, deps, "db2", models.ReadPrivilege)
+	assert.True(t, errors.Is(err, constants.ErrPermissionDenied))
+	err = CheckPrivilege(c, deps, models.AllDatabases, models.AdminPrivilege)
+	assert.True(t, errors.Is(err, constants.ErrPermissionDenied))
+}
+
+func TestAuthenticate_APIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deps, stateMgr := newDeps(ctrl, true)
+	r := gin.New()
+	r.Use(Authenticate(deps, "/write"))
+	handler := func(c *gin.Context) {
+		token, ok := getAPIToken(c)
+		assert.True(t, ok)
+		httppkg.OK(c, token.Name)
+	}
+	r.PUT("/write", handler)
+	r.PUT("/other", handler)
+
+	token, tokenString, err := models.NewAPIToken("agent", "db", models.WritePrivilege)
+	assert.NoError(t, err)
+	doRequest := func(path, tokenString string) int {
+		header := http.Header{}
+		header.Set("Authorization", "Bearer "+tokenString)
+		return mock.DoRequest(t, r, http.MethodPut, path, "", header).Code
+	}
+	// token not exist(revoked)
+	stateMgr.EXPECT().GetAPIToken(token.ID).Return(models.APIToken{}, false)
+	assert.Equal(t, http.StatusUnauthorized, doRequest("/write", tokenString))
+	stateMgr.EXPECT().GetAPIToken(token.ID).Return(*token, true).AnyTimes()
+	// secret not match
+	assert.Equal(t, http.StatusUnauthorized, doRequest("/write", models.APITokenPrefix+token.ID+".secret"))
+	// api not accept api token
+	assert.Equal(t, http.StatusForbidden, doRequest("/other", tokenString))
+	// valid token
+	assert.Equal(t, http.StatusOK, doRequest("/write", tokenString))
+}
+
+func TestCheckPrivilege_APIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deps, _ := newDeps(ctrl, false)
+	c, _ := gin.CreateTestContext(nil)
+	assert.NoError(t, CheckUser(c, deps))
+
+	deps.BrokerCfg.BrokerBase.Auth.Enabled = true
+	// not login
+	assert.Equal(t, constants.ErrUnauthorized, CheckUser(c, deps))
+	c.Set(constants.CurrentUser, "test")
+	assert.NoError(t, CheckUser(c, deps))
+
+	c, _ = gin.CreateTestContext(nil)
+	c.Set(constants.CurrentAPIToken, &models.APIToken{ID: "1", Name: "grafana", Database: "db", Privilege: models.ReadPrivilege})
+	assert.NoError(t, CheckPrivilege(c, deps, "db", models.ReadPrivilege))
+	err := CheckPrivilege(c, deps, "db", models.WritePrivilege)
+	assert.True(t, errors.Is(err, constants.ErrPermissionDenied))
+	err = CheckPrivilege(c, deps, "db2", models.ReadPrivilege)
+	assert.True(t, errors.Is(err, constants.ErrPermissionDenied))
+	// api token cannot execute cluster level operation
+	err = CheckUser(c, deps)
+	assert.True(t, errors.Is(err, constants.ErrPermissionDenied))
+}
@@ -207,6 +207,7 @@ func (r *runtime) Run() error {
 		TTL:              int64(r.config.Coordinator.LeaseTTL.Duration().Seconds()),
 		DiscoveryFactory: discoveryFactory,
 		RepoFactory:      r.repoFactory,
+		Rebalance:        r.config.BrokerBase.Rebalance,
 	}
 	r.master = newMasterController(masterCfg)
 
@@ -356,7 +357,6 @@ func (r *runtime) Stop() {
 func (r *runtime) startHTTPServer() {
 	r.logger.Info("starting HTTP server")
 	r.httpServer = newHTTPServer(r.config.BrokerBase.HTTP, true, linmetric.BrokerRegistry)
-	// TODO login api is not registered
 	httpAPI := api.NewAPI(&deps.HTTPDeps{
 		Ctx:          r.ctx,
 		Node:         r.node,
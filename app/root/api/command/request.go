@@ -23,6 +23,9 @@ import (
 	depspkg "github.com/lindb/lindb/app/root/deps"
 	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/query"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 )
 
@@ -31,7 +34,10 @@ var (
 )
 
 // RequestCommand executes requests/request related statement.
-func RequestCommand(_ context.Context, deps *depspkg.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+func RequestCommand(_ context.Context, deps *depspkg.HTTPDeps, _ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	if requestStmt, ok := stmt.(*stmtpkg.Request); ok && requestStmt.Type == stmtpkg.RequestOpKill {
+		return killRequest(deps, requestStmt.RequestID)
+	}
 	liveNodes := deps.StateMgr.GetLiveNodes()
 	var nodes []models.Node
 	for idx := range liveNodes {
@@ -40,3 +46,27 @@ func RequestCommand(_ context.Context, deps *depspkg.HTTPDeps, _ *models.Execute
 	rs := requestCli.FetchRequestsByNodes(nodes)
 	return rs, nil
 }
+
+// killRequest kills the query request which executing on current root,
+// then notifies all live broker nodes to kill the tasks of this request.
+func killRequest(deps *depspkg.HTTPDeps, requestID string) (interface{}, error) {
+	query.GetRequestManager().KillRequest(requestID)
+
+	req := &protoCommonV1.TaskRequest{
+		RequestID:   requestID,
+		RequestType: protoCommonV1.RequestType_Kill,
+	}
+	for _, brokerState := range deps.StateMgr.GetBrokerStates() {
+		for nodeID := range brokerState.LiveNodes {
+			node := brokerState.LiveNodes[nodeID]
+			target := node.Indicator()
+			if err := deps.TransportMgr.SendRequest(target, req); err != nil {
+				// ignore send failure, tasks on target node will be aborted by timeout
+				log.Warn("send kill query request failure",
+					logger.String("requestID", requestID), logger.String("target", target), logger.Error(err))
+			}
+		}
+	}
+	rs := "kill query ok"
+	return &rs, nil
+}
@@ -0,0 +1,72 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/tsdb"
+)
+
+var (
+	// BackupPath represents online backup api path.
+	BackupPath = "/backup"
+)
+
+// BackupAPI represents online backup rest api of storage node.
+type BackupAPI struct {
+	engine tsdb.Engine
+
+	logger *logger.Logger
+}
+
+// NewBackupAPI creates a backup api instance.
+func NewBackupAPI(engine tsdb.Engine) *BackupAPI {
+	return &BackupAPI{
+		engine: engine,
+		logger: logger.GetLogger("Storage", "BackupAPI"),
+	}
+}
+
+// Register adds backup url route.
+func (b *BackupAPI) Register(route gin.IRoutes) {
+	route.POST(BackupPath, b.Backup)
+}
+
+// Backup backups the persisted data of databases into path of storage node(all databases if not specified),
+// returns backup manifest which is used to restore databases by lind command.
+func (b *BackupAPI) Backup(c *gin.Context) {
+	var param struct {
+		Path      string   `json:"path" binding:"required"`
+		Databases []string `json:"databases"`
+	}
+	if err := c.ShouldBind(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	manifest, err := b.engine.Backup(param.Path, param.Databases...)
+	if err != nil {
+		b.logger.Error("backup databases failure",
+			logger.String("path", param.Path), logger.Any("databases", param.Databases), logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, manifest)
+}
@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestBackupAPI_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	api := NewBackupAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// no path
+	resp := mock.DoRequest(t, r, http.MethodPost, BackupPath, `{}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// backup failure
+	engine.EXPECT().Backup("/tmp/backup", "db").Return(nil, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodPost, BackupPath, `{"path":"/tmp/backup","databases":["db"]}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// backup successfully
+	engine.EXPECT().Backup("/tmp/backup").Return(&models.BackupManifest{}, nil)
+	resp = mock.DoRequest(t, r, http.MethodPost, BackupPath, `{"path":"/tmp/backup"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
@@ -0,0 +1,530 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/snappy"
+	"golang.org/x/time/rate"
+
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/tsdb"
+)
+
+var (
+	// MigrationPreparePath represents the path which creates shard of target replica.
+	MigrationPreparePath = "/migration/prepare"
+	// MigrationImportPath represents the path which imports data into target replica.
+	MigrationImportPath = "/migration/import"
+	// MigrationFinishPath represents the path which finishes importing data into target replica.
+	MigrationFinishPath = "/migration/finish"
+	// MigrationExportPath represents the path of exporting job on leader.
+	MigrationExportPath = "/migration/export"
+	// MigrationCompletePath represents the path which completes migration on leader.
+	MigrationCompletePath = "/migration/complete"
+)
+
+// for testing
+var (
+	catchUpCheckInterval = time.Second
+)
+
+const (
+	// catchUpPendingThreshold represents the max pending write ahead log of target replica when catch up,
+	// the rest logs will be replicated by replicator continuously.
+	catchUpPendingThreshold = 100
+	// minThrottleBurst represents the min burst bytes of throttle.
+	minThrottleBurst = 1024 * 1024
+)
+
+var errExportJobNotFound = errors.New("export job not found")
+
+// MigrationAPI represents replica migration rest api of storage node,
+// leader exports data of shard to target replica, then target replica catches up write ahead log from leader.
+type MigrationAPI struct {
+	ctx         context.Context
+	currentNode models.NodeID
+	engine      tsdb.Engine
+	walMgr      replica.WriteAheadLogManager
+	cli         client.MigrationCli
+
+	jobs  map[string]*exportJob // db/shard/target => export job
+	mutex sync.Mutex
+
+	logger *logger.Logger
+}
+
+// NewMigrationAPI creates a replica migration api instance.
+func NewMigrationAPI(
+	ctx context.Context,
+	currentNode models.NodeID,
+	engine tsdb.Engine,
+	walMgr replica.WriteAheadLogManager,
+	cli client.MigrationCli,
+) *MigrationAPI {
+	return &MigrationAPI{
+		ctx:         ctx,
+		currentNode: currentNode,
+		engine:      engine,
+		walMgr:      walMgr,
+		cli:         cli,
+		jobs:        make(map[string]*exportJob),
+		logger:      logger.GetLogger("Storage", "MigrationAPI"),
+	}
+}
+
+// Register adds replica migration url route.
+func (m *MigrationAPI) Register(route gin.IRoutes) {
+	route.POST(MigrationPreparePath, m.Prepare)
+	route.PUT(MigrationImportPath, m.Import)
+	route.POST(MigrationFinishPath, m.Finish)
+	route.POST(MigrationExportPath, m.Export)
+	route.GET(MigrationExportPath, m.GetExportState)
+	route.DELETE(MigrationExportPath, m.CancelExport)
+	route.POST(MigrationCompletePath, m.Complete)
+}
+
+// Prepare creates the shard of target replica.
+func (m *MigrationAPI) Prepare(c *gin.Context) {
+	req := &models.MigrationPrepareRequest{}
+	if err := c.ShouldBind(req); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if err := m.engine.CreateShards(req.Database, req.Option, req.ShardID); err != nil {
+		m.logger.Error("create shard for replica migration failure",
+			logger.String("database", req.Database), logger.Any("shardID", req.ShardID), logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, "ok")
+}
+
+// Import writes the rows block(snappy compressed) exported by leader into target replica.
+func (m *MigrationAPI) Import(c *gin.Context) {
+	var param struct {
+		Database   string         `form:"database" binding:"required"`
+		ShardID    models.ShardID `form:"shardId"`
+		FamilyTime int64          `form:"familyTime" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	shard, err := m.getShard(param.Database, param.ShardID)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	data, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	block, err := snappy.Decode(nil, data)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	rows, err := shard.Import(param.FamilyTime, block)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, rows)
+}
+
+// Finish finishes importing data into target replica,
+// persists imported data, then resets write ahead log of target replica based on replica sequence of leader.
+func (m *MigrationAPI) Finish(c *gin.Context) {
+	req := &models.MigrationFinishRequest{}
+	if err := c.ShouldBind(req); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if err := m.finish(req); err != nil {
+		m.logger.Error("finish replica migration failure",
+			logger.String("database", req.Database), logger.Any("shardID", req.ShardID), logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, "ok")
+}
+
+// Export starts the exporting job which exports the data of shard from leader to target replica.
+func (m *MigrationAPI) Export(c *gin.Context) {
+	req := &models.MigrationExportRequest{}
+	if err := c.ShouldBind(req); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	shard, err := m.getShard(req.Database, req.ShardID)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	key := exportJobKey(req.Database, req.ShardID, req.Target)
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if job, ok := m.jobs[key]; ok && !job.getState().State.IsTerminal() {
+		// job is running
+		httppkg.OK(c, "ok")
+		return
+	}
+	ctx, cancel := context.WithCancel(m.ctx)
+	now := timeutil.Now()
+	job := &exportJob{
+		cancel: cancel,
+		state: models.ReplicaMigration{
+			Database:   req.Database,
+			ShardID:    req.ShardID,
+			Target:     req.Target,
+			Leader:     m.currentNode,
+			State:      models.MigrationPreparing,
+			CreateTime: now,
+			UpdateTime: now,
+		},
+	}
+	m.jobs[key] = job
+	go m.runExport(ctx, job, req, shard)
+	httppkg.OK(c, "ok")
+}
+
+// GetExportState returns the state of exporting job.
+func (m *MigrationAPI) GetExportState(c *gin.Context) {
+	var param struct {
+		Database string         `form:"database" binding:"required"`
+		ShardID  models.ShardID `form:"shardId"`
+		Target   models.NodeID  `form:"target"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	m.mutex.Lock()
+	job, ok := m.jobs[exportJobKey(param.Database, param.ShardID, param.Target)]
+	m.mutex.Unlock()
+	if !ok {
+		httppkg.Error(c, errExportJobNotFound)
+		return
+	}
+	state := job.getState()
+	httppkg.OK(c, &state)
+}
+
+// CancelExport cancels the exporting job, removes the replicator of target replica.
+func (m *MigrationAPI) CancelExport(c *gin.Context) {
+	var param struct {
+		Database string         `form:"database" binding:"required"`
+		ShardID  models.ShardID `form:"shardId"`
+		Target   models.NodeID  `form:"target"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	key := exportJobKey(param.Database, param.ShardID, param.Target)
+	m.mutex.Lock()
+	job, ok := m.jobs[key]
+	delete(m.jobs, key)
+	m.mutex.Unlock()
+
+	if ok && job.markCanceled() {
+		// job is running, replicator will be removed after job exited.
+		httppkg.OK(c, "ok")
+		return
+	}
+	if err := m.walMgr.GetOrCreateLog(param.Database).RemoveReplica(param.ShardID, param.Target); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, "ok")
+}
+
+// Complete completes the migration after replica assignment changed,
+// removes the replicator of source replica if source isn't current leader.
+func (m *MigrationAPI) Complete(c *gin.Context) {
+	req := &models.MigrationCompleteRequest{}
+	if err := c.ShouldBind(req); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	m.mutex.Lock()
+	delete(m.jobs, exportJobKey(req.Database, req.ShardID, req.Target))
+	m.mutex.Unlock()
+
+	wal := m.walMgr.GetOrCreateLog(req.Database)
+	wal.CompleteReplica(req.ShardID, req.Target)
+	if req.Source != m.currentNode {
+		if err := wal.RemoveReplica(req.ShardID, req.Source); err != nil {
+			httppkg.Error(c, err)
+			return
+		}
+	}
+	httppkg.OK(c, "ok")
+}
+
+// finish persists imported data, then resets write ahead log of target replica.
+func (m *MigrationAPI) finish(req *models.MigrationFinishRequest) error {
+	shard, err := m.getShard(req.Database, req.ShardID)
+	if err != nil {
+		return err
+	}
+	wal := m.walMgr.GetOrCreateLog(req.Database)
+	leader := int32(req.Leader)
+	for _, familyBackup := range req.Families {
+		family, err := shard.GetOrCrateDataFamily(familyBackup.FamilyTime)
+		if err != nil {
+			return err
+		}
+		seq, ok := familyBackup.Sequences[leader]
+		if ok {
+			// data before sequence is imported, replica data after sequence
+			family.CommitSequence(leader, seq)
+		}
+		// imported data hasn't write ahead log, need persist it
+		if err := tsdb.FlushDataFamily(family); err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		p, err := wal.GetOrCreatePartition(req.ShardID, familyBackup.FamilyTime, req.Leader)
+		if err != nil {
+			return err
+		}
+		p.ResetReplicaIndex(seq + 1)
+	}
+	return nil
+}
+
+// runExport runs the exporting job, removes the replicator of target replica if job failure/canceled.
+func (m *MigrationAPI) runExport(ctx context.Context, job *exportJob,
+	req *models.MigrationExportRequest, shard tsdb.Shard,
+) {
+	err := m.export(ctx, job, req, shard)
+	if job.complete(err) {
+		if err0 := m.walMgr.GetOrCreateLog(req.Database).RemoveReplica(req.ShardID, req.Target); err0 != nil {
+			m.logger.Warn("remove replica after export failure", logger.String("database", req.Database),
+				logger.Any("shardID", req.ShardID), logger.Any("target", req.Target), logger.Error(err0))
+		}
+	}
+	if err != nil {
+		m.logger.Error("export data for replica migration failure", logger.String("database", req.Database),
+			logger.Any("shardID", req.ShardID), logger.Any("target", req.Target), logger.Error(err))
+		return
+	}
+	m.logger.Info("export data for replica migration successfully", logger.String("database", req.Database),
+		logger.Any("shardID", req.ShardID), logger.Any("target", req.Target))
+}
+
+// export exports the data of shard to target replica:
+// 1. pins write ahead log for target replica;
+// 2. exports persisted data to target replica;
+// 3. resets write ahead log of target replica based on replica sequence of exported data;
+// 4. builds replicator of target replica, waits target replica catches up.
+func (m *MigrationAPI) export(ctx context.Context, job *exportJob,
+	req *models.MigrationExportRequest, shard tsdb.Shard,
+) error {
+	wal := m.walMgr.GetOrCreateLog(req.Database)
+	if err := wal.PrepareReplica(req.ShardID, req.Target); err != nil {
+		return err
+	}
+	job.update(func(state *models.ReplicaMigration) {
+		state.State = models.MigrationExporting
+	})
+	limiter := newThrottle(req.Throttle)
+	families, err := shard.Export(func(familyTime int64, rows []byte) error {
+		block := snappy.Encode(nil, rows)
+		if limiter != nil {
+			if err := waitThrottle(ctx, limiter, len(block)); err != nil {
+				return err
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		n, err := m.cli.Import(req.TargetAddress, req.Database, req.ShardID, familyTime, block)
+		if err != nil {
+			return err
+		}
+		job.update(func(state *models.ReplicaMigration) {
+			state.Rows += int64(n)
+			state.Bytes += int64(len(block))
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := m.cli.Finish(req.TargetAddress, &models.MigrationFinishRequest{
+		Database: req.Database,
+		ShardID:  req.ShardID,
+		Leader:   m.currentNode,
+		Families: families,
+	}); err != nil {
+		return err
+	}
+	if err := wal.BuildReplica(req.ShardID, req.Target); err != nil {
+		return err
+	}
+	job.update(func(state *models.ReplicaMigration) {
+		state.State = models.MigrationCatchingUp
+	})
+	ticker := time.NewTicker(catchUpCheckInterval)
+	defer ticker.Stop()
+	for {
+		pending, ready := m.getReplicaPending(req.Database, req.ShardID, req.Target)
+		job.update(func(state *models.ReplicaMigration) {
+			state.Pending = pending
+		})
+		if ready && pending <= catchUpPendingThreshold {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// getReplicaPending returns the pending write ahead log of replica for shard, and if all replicators are ready.
+func (m *MigrationAPI) getReplicaPending(database string, shardID models.ShardID, replica models.NodeID) (int64, bool) {
+	pending := int64(0)
+	ready := true
+	for _, state := range m.walMgr.GetReplicaState(database) {
+		if state.ShardID != shardID || state.Leader != m.currentNode {
+			continue
+		}
+		for _, replicator := range state.Replicators {
+			if replicator.Replicator != replica.String() {
+				continue
+			}
+			pending += replicator.Pending
+			if replicator.State != models.ReplicatorReadyState {
+				ready = false
+			}
+		}
+	}
+	return pending, ready
+}
+
+// getShard returns shard by database/shard id.
+func (m *MigrationAPI) getShard(database string, shardID models.ShardID) (tsdb.Shard, error) {
+	shard, ok := m.engine.GetShard(database, shardID)
+	if !ok {
+		return nil, fmt.Errorf("shard not found, database: %s, shard: %d", database, shardID)
+	}
+	return shard, nil
+}
+
+// exportJob represents the exporting job of replica migration.
+type exportJob struct {
+	state    models.ReplicaMigration
+	cancel   context.CancelFunc
+	canceled bool
+	mutex    sync.Mutex
+}
+
+// getState returns the state of job.
+func (j *exportJob) getState() models.ReplicaMigration {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	return j.state
+}
+
+// update updates the state of job.
+func (j *exportJob) update(fn func(state *models.ReplicaMigration)) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	fn(&j.state)
+	j.state.UpdateTime = timeutil.Now()
+}
+
+// markCanceled cancels the running job, returns false if job completed.
+func (j *exportJob) markCanceled() bool {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	if j.state.State.IsTerminal() {
+		return false
+	}
+	j.canceled = true
+	j.cancel()
+	return true
+}
+
+// complete marks job completed, returns true if replicator of target replica need to be removed(failure or canceled).
+func (j *exportJob) complete(err error) bool {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	j.cancel()
+	j.state.UpdateTime = timeutil.Now()
+	if err != nil {
+		j.state.State = models.MigrationFailed
+		j.state.ErrMsg = err.Error()
+		return true
+	}
+	j.state.State = models.MigrationDone
+	return j.canceled
+}
+
+// newThrottle creates the rate limiter of bytes, returns nil if no limit.
+func newThrottle(bytesPerSecond int64) *rate.Limiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	burst := int(bytesPerSecond)
+	if burst < minThrottleBurst {
+		burst = minThrottleBurst
+	}
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), burst)
+}
+
+// waitThrottle waits until n bytes can be sent.
+func waitThrottle(ctx context.Context, limiter *rate.Limiter, n int) error {
+	for n > 0 {
+		size := n
+		if size > limiter.Burst() {
+			size = limiter.Burst()
+		}
+		if err := limiter.WaitN(ctx, size); err != nil {
+			return err
+		}
+		n -= size
+	}
+	return nil
+}
+
+// exportJobKey returns the key of exporting job.
+func exportJobKey(database string, shardID models.ShardID, target models.NodeID) string {
+	return fmt.Sprintf("%s/%d/%d", database, shardID, target)
+}
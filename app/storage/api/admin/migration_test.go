@@ -0,0 +1,390 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/tsdb"
+)
+
+type migrationMocks struct {
+	engine *tsdb.MockEngine
+	shard  *tsdb.MockShard
+	family *tsdb.MockDataFamily
+	walMgr *replica.MockWriteAheadLogManager
+	wal    *replica.MockWriteAheadLog
+	cli    *client.MockMigrationCli
+	router *gin.Engine
+	api    *MigrationAPI
+}
+
+func newMigrationMocks(ctrl *gomock.Controller) *migrationMocks {
+	m := &migrationMocks{
+		engine: tsdb.NewMockEngine(ctrl),
+		shard:  tsdb.NewMockShard(ctrl),
+		family: tsdb.NewMockDataFamily(ctrl),
+		walMgr: replica.NewMockWriteAheadLogManager(ctrl),
+		wal:    replica.NewMockWriteAheadLog(ctrl),
+		cli:    client.NewMockMigrationCli(ctrl),
+		router: gin.New(),
+	}
+	m.walMgr.EXPECT().GetOrCreateLog("test").Return(m.wal).AnyTimes()
+	m.api = NewMigrationAPI(context.TODO(), 1, m.engine, m.walMgr, m.cli)
+	m.api.Register(m.router)
+	return m
+}
+
+func TestMigrationAPI_Prepare(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := newMigrationMocks(ctrl)
+
+	resp := mock.DoRequest(t, m.router, http.MethodPost, MigrationPreparePath, `{}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	m.engine.EXPECT().CreateShards("test", gomock.Any(), models.ShardID(1)).Return(fmt.Errorf("err"))
+	resp = mock.DoRequest(t, m.router, http.MethodPost, MigrationPreparePath, `{"database":"test","shardId":1,"option":{}}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	m.engine.EXPECT().CreateShards("test", gomock.Any(), models.ShardID(1)).Return(nil)
+	resp = mock.DoRequest(t, m.router, http.MethodPost, MigrationPreparePath, `{"database":"test","shardId":1,"option":{}}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestMigrationAPI_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := newMigrationMocks(ctrl)
+	path := MigrationImportPath + "?database=test&shardId=1&familyTime=10"
+	doImport := func(body []byte) int {
+		req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPut, path, bytes.NewReader(body))
+		resp := httptest.NewRecorder()
+		m.router.ServeHTTP(resp, req)
+		return resp.Code
+	}
+
+	resp := mock.DoRequest(t, m.router, http.MethodPut, MigrationImportPath, ``)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// shard not found
+	m.engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+	assert.Equal(t, http.StatusInternalServerError, doImport(nil))
+	m.engine.EXPECT().GetShard("test", models.ShardID(1)).Return(m.shard, true).AnyTimes()
+	// decode failure
+	assert.Equal(t, http.StatusInternalServerError, doImport([]byte{1, 2, 3}))
+	// import failure
+	block := snappy.Encode(nil, []byte{1, 2, 3})
+	m.shard.EXPECT().Import(int64(10), []byte{1, 2, 3}).Return(0, fmt.Errorf("err"))
+	assert.Equal(t, http.StatusInternalServerError, doImport(block))
+	// import successfully
+	m.shard.EXPECT().Import(int64(10), []byte{1, 2, 3}).Return(1, nil)
+	assert.Equal(t, http.StatusOK, doImport(block))
+}
+
+func TestMigrationAPI_Finish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := newMigrationMocks(ctrl)
+	p := replica.NewMockPartition(ctrl)
+	body := `{"database":"test","shardId":1,"leader":2,"families":[{"familyTime":10,"sequences":{"2":100}},{"familyTime":20}]}`
+	m.family.EXPECT().IsFlushing().Return(false).AnyTimes()
+
+	cases := []struct {
+		name    string
+		body    string
+		prepare func()
+		code    int
+	}{
+		{
+			name: "bad request",
+			body: `{}`,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "shard not found",
+			body: body,
+			prepare: func() {
+				m.engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "get family failure",
+			body: body,
+			prepare: func() {
+				m.engine.EXPECT().GetShard("test", models.ShardID(1)).Return(m.shard, true)
+				m.shard.EXPECT().GetOrCrateDataFamily(int64(10)).Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "flush family failure",
+			body: body,
+			prepare: func() {
+				m.engine.EXPECT().GetShard("test", models.ShardID(1)).Return(m.shard, true)
+				m.shard.EXPECT().GetOrCrateDataFamily(int64(10)).Return(m.family, nil)
+				m.family.EXPECT().CommitSequence(int32(2), int64(100))
+				m.family.EXPECT().Flush().Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "get partition failure",
+			body: body,
+			prepare: func() {
+				m.engine.EXPECT().GetShard("test", models.ShardID(1)).Return(m.shard, true)
+				m.shard.EXPECT().GetOrCrateDataFamily(int64(10)).Return(m.family, nil)
+				m.family.EXPECT().CommitSequence(int32(2), int64(100))
+				m.family.EXPECT().Flush().Return(nil)
+				m.wal.EXPECT().GetOrCreatePartition(models.ShardID(1), int64(10), models.NodeID(2)).Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "finish successfully",
+			body: body,
+			prepare: func() {
+				m.engine.EXPECT().GetShard("test", models.ShardID(1)).Return(m.shard, true)
+				m.shard.EXPECT().GetOrCrateDataFamily(int64(10)).Return(m.family, nil)
+				m.family.EXPECT().CommitSequence(int32(2), int64(100))
+				m.family.EXPECT().Flush().Return(nil)
+				m.wal.EXPECT().GetOrCreatePartition(models.ShardID(1), int64(10), models.NodeID(2)).Return(p, nil)
+				p.EXPECT().ResetReplicaIndex(int64(101))
+				// family without sequence of leader
+				m.shard.EXPECT().GetOrCrateDataFamily(int64(20)).Return(m.family, nil)
+				m.family.EXPECT().Flush().Return(nil)
+			},
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, m.router, http.MethodPost, MigrationFinishPath, tt.body)
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
+
+func TestMigrationAPI_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		catchUpCheckInterval = time.Second
+		ctrl.Finish()
+	}()
+	catchUpCheckInterval = time.Millisecond
+	m := newMigrationMocks(ctrl)
+	body := `{"database":"test","shardId":1,"target":3,"targetAddress":"http://target","throttle":1}`
+	statePath := MigrationExportPath + "?database=test&shardId=1&target=3"
+	waitState := func(state models.MigrationState) *models.ReplicaMigration {
+		for {
+			m.api.mutex.Lock()
+			job, ok := m.api.jobs["test/1/3"]
+			m.api.mutex.Unlock()
+			if ok && job.getState().State == state {
+				s := job.getState()
+				return &s
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	// bad request
+	resp := mock.DoRequest(t, m.router, http.MethodPost, MigrationExportPath, `{}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// shard not found
+	m.engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+	resp = mock.DoRequest(t, m.router, http.MethodPost, MigrationExportPath, body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// job not found
+	resp = mock.DoRequest(t, m.router, http.MethodGet, statePath, ``)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	resp = mock.DoRequest(t, m.router, http.MethodGet, MigrationExportPath, ``)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	m.engine.EXPECT().GetShard("test", models.ShardID(1)).Return(m.shard, true).AnyTimes()
+	// prepare replica failure
+	m.wal.EXPECT().PrepareReplica(models.ShardID(1), models.NodeID(3)).Return(fmt.Errorf("err"))
+	m.wal.EXPECT().RemoveReplica(models.ShardID(1), models.NodeID(3)).Return(fmt.Errorf("err"))
+	resp = mock.DoRequest(t, m.router, http.MethodPost, MigrationExportPath, body)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	state := waitState(models.MigrationFailed)
+	assert.Equal(t, "err", state.ErrMsg)
+
+	// export failure
+	m.wal.EXPECT().PrepareReplica(models.ShardID(1), models.NodeID(3)).Return(nil)
+	m.shard.EXPECT().Export(gomock.Any()).DoAndReturn(func(fn tsdb.ExportFunc) ([]*models.FamilyBackup, error) {
+		return nil, fn(10, []byte{1, 2, 3})
+	})
+	m.cli.EXPECT().Import("http://target", "test", models.ShardID(1), int64(10), gomock.Any()).Return(0, fmt.Errorf("err"))
+	m.wal.EXPECT().RemoveReplica(models.ShardID(1), models.NodeID(3)).Return(nil)
+	resp = mock.DoRequest(t, m.router, http.MethodPost, MigrationExportPath, body)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	waitState(models.MigrationFailed)
+
+	// finish failure
+	families := []*models.FamilyBackup{{FamilyTime: 10, Sequences: map[int32]int64{1: 10}}}
+	m.wal.EXPECT().PrepareReplica(models.ShardID(1), models.NodeID(3)).Return(nil)
+	m.shard.EXPECT().Export(gomock.Any()).Return(families, nil)
+	m.cli.EXPECT().Finish("http://target", gomock.Any()).Return(fmt.Errorf("err"))
+	m.wal.EXPECT().RemoveReplica(models.ShardID(1), models.NodeID(3)).Return(nil)
+	resp = mock.DoRequest(t, m.router, http.MethodPost, MigrationExportPath, body)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	waitState(models.MigrationFailed)
+
+	// build replica failure
+	m.wal.EXPECT().PrepareReplica(models.ShardID(1), models.NodeID(3)).Return(nil)
+	m.shard.EXPECT().Export(gomock.Any()).Return(families, nil)
+	m.cli.EXPECT().Finish("http://target", &models.MigrationFinishRequest{
+		Database: "test", ShardID: 1, Leader: 1, Families: families,
+	}).Return(nil)
+	m.wal.EXPECT().BuildReplica(models.ShardID(1), models.NodeID(3)).Return(fmt.Errorf("err"))
+	m.wal.EXPECT().RemoveReplica(models.ShardID(1), models.NodeID(3)).Return(nil)
+	resp = mock.DoRequest(t, m.router, http.MethodPost, MigrationExportPath, body)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	waitState(models.MigrationFailed)
+
+	// export successfully
+	m.wal.EXPECT().PrepareReplica(models.ShardID(1), models.NodeID(3)).Return(nil)
+	m.shard.EXPECT().Export(gomock.Any()).DoAndReturn(func(fn tsdb.ExportFunc) ([]*models.FamilyBackup, error) {
+		return families, fn(10, []byte{1, 2, 3})
+	})
+	m.cli.EXPECT().Import("http://target", "test", models.ShardID(1), int64(10), gomock.Any()).Return(2, nil)
+	m.cli.EXPECT().Finish("http://target", gomock.Any()).Return(nil)
+	m.wal.EXPECT().BuildReplica(models.ShardID(1), models.NodeID(3)).Return(nil)
+	m.walMgr.EXPECT().GetReplicaState("test").Return([]models.FamilyLogReplicaState{
+		{ShardID: 2, Leader: 1},
+		{ShardID: 1, Leader: 1, Replicators: []models.ReplicaPeerState{
+			{Replicator: "1", Pending: 10000},
+			{Replicator: "3", Pending: 1000, State: models.ReplicatorReadyState},
+		}},
+	})
+	m.walMgr.EXPECT().GetReplicaState("test").Return([]models.FamilyLogReplicaState{
+		{ShardID: 1, Leader: 1, Replicators: []models.ReplicaPeerState{
+			{Replicator: "3", Pending: 10, State: models.ReplicatorInitState},
+		}},
+	})
+	m.walMgr.EXPECT().GetReplicaState("test").Return([]models.FamilyLogReplicaState{
+		{ShardID: 1, Leader: 1, Replicators: []models.ReplicaPeerState{
+			{Replicator: "3", Pending: 10, State: models.ReplicatorReadyState},
+		}},
+	})
+	resp = mock.DoRequest(t, m.router, http.MethodPost, MigrationExportPath, body)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	state = waitState(models.MigrationDone)
+	assert.Equal(t, int64(2), state.Rows)
+	assert.Equal(t, int64(10), state.Pending)
+	resp = mock.DoRequest(t, m.router, http.MethodGet, statePath, ``)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	// cancel completed job
+	m.wal.EXPECT().RemoveReplica(models.ShardID(1), models.NodeID(3)).Return(fmt.Errorf("err"))
+	resp = mock.DoRequest(t, m.router, http.MethodDelete, statePath, ``)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	resp = mock.DoRequest(t, m.router, http.MethodDelete, MigrationExportPath, ``)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
+
+func TestMigrationAPI_CancelExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := newMigrationMocks(ctrl)
+	body := `{"database":"test","shardId":1,"target":3,"targetAddress":"http://target"}`
+	statePath := MigrationExportPath + "?database=test&shardId=1&target=3"
+
+	m.engine.EXPECT().GetShard("test", models.ShardID(1)).Return(m.shard, true).AnyTimes()
+	m.wal.EXPECT().PrepareReplica(models.ShardID(1), models.NodeID(3)).Return(nil)
+	exporting := make(chan struct{})
+	exited := make(chan struct{})
+	m.shard.EXPECT().Export(gomock.Any()).DoAndReturn(func(fn tsdb.ExportFunc) ([]*models.FamilyBackup, error) {
+		close(exporting)
+		// wait job canceled
+		for fn(10, []byte{1, 2, 3}) == nil {
+			time.Sleep(time.Millisecond)
+		}
+		return nil, context.Canceled
+	})
+	m.cli.EXPECT().Import("http://target", "test", models.ShardID(1), int64(10), gomock.Any()).Return(1, nil).AnyTimes()
+	m.wal.EXPECT().RemoveReplica(models.ShardID(1), models.NodeID(3)).DoAndReturn(func(_ models.ShardID, _ models.NodeID) error {
+		close(exited)
+		return nil
+	})
+	resp := mock.DoRequest(t, m.router, http.MethodPost, MigrationExportPath, body)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	<-exporting
+	// job is running
+	resp = mock.DoRequest(t, m.router, http.MethodPost, MigrationExportPath, body)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	// cancel running job
+	resp = mock.DoRequest(t, m.router, http.MethodDelete, statePath, ``)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	<-exited
+	// cancel not exist job
+	m.wal.EXPECT().RemoveReplica(models.ShardID(1), models.NodeID(3)).Return(nil)
+	resp = mock.DoRequest(t, m.router, http.MethodDelete, statePath, ``)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestMigrationAPI_Complete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := newMigrationMocks(ctrl)
+
+	resp := mock.DoRequest(t, m.router, http.MethodPost, MigrationCompletePath, `{}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// source is leader
+	m.wal.EXPECT().CompleteReplica(models.ShardID(1), models.NodeID(3))
+	resp = mock.DoRequest(t, m.router, http.MethodPost, MigrationCompletePath, `{"database":"test","shardId":1,"source":1,"target":3}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	// remove source replica failure
+	m.wal.EXPECT().CompleteReplica(models.ShardID(1), models.NodeID(3))
+	m.wal.EXPECT().RemoveReplica(models.ShardID(1), models.NodeID(2)).Return(fmt.Errorf("err"))
+	resp = mock.DoRequest(t, m.router, http.MethodPost, MigrationCompletePath, `{"database":"test","shardId":1,"source":2,"target":3}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// remove source replica successfully
+	m.wal.EXPECT().CompleteReplica(models.ShardID(1), models.NodeID(3))
+	m.wal.EXPECT().RemoveReplica(models.ShardID(1), models.NodeID(2)).Return(nil)
+	resp = mock.DoRequest(t, m.router, http.MethodPost, MigrationCompletePath, `{"database":"test","shardId":1,"source":2,"target":3}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestThrottle(t *testing.T) {
+	assert.Nil(t, newThrottle(0))
+	limiter := newThrottle(10)
+	assert.Equal(t, minThrottleBurst, limiter.Burst())
+	assert.NoError(t, waitThrottle(context.TODO(), limiter, minThrottleBurst))
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	assert.Error(t, waitThrottle(ctx, limiter, 2*minThrottleBurst))
+}
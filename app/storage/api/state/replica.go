@@ -18,34 +18,49 @@
 package state
 
 import (
+	"fmt"
+
 	"github.com/gin-gonic/gin"
 
+	"github.com/lindb/lindb/models"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/tsdb"
 )
 
 var (
 	ReplicaPath = "/state/replica"
+	// ReplicaDigestPath represents the path which returns the digest of data family.
+	ReplicaDigestPath = "/state/replica/digest"
+	// ReplicaConsistencyPath represents the path which returns the consistency check result of replicas.
+	ReplicaConsistencyPath = "/state/replica/consistency"
 )
 
 // ReplicaAPI represents internal replica state rest api.
 type ReplicaAPI struct {
-	walMgr replica.WriteAheadLogManager
-	logger *logger.Logger
+	walMgr  replica.WriteAheadLogManager
+	engine  tsdb.Engine
+	checker replica.ConsistencyChecker
+	logger  *logger.Logger
 }
 
 // NewReplicaAPI creates a replica state api instance.
-func NewReplicaAPI(walMgr replica.WriteAheadLogManager) *ReplicaAPI {
+func NewReplicaAPI(walMgr replica.WriteAheadLogManager, engine tsdb.Engine, checker replica.ConsistencyChecker) *ReplicaAPI {
 	return &ReplicaAPI{
-		walMgr: walMgr,
-		logger: logger.GetLogger("Storage", "ReplicaAPI"),
+		walMgr:  walMgr,
+		engine:  engine,
+		checker: checker,
+		logger:  logger.GetLogger("Storage", "ReplicaAPI"),
 	}
 }
 
 // Register adds explore url route.
 func (d *ReplicaAPI) Register(route gin.IRoutes) {
 	route.GET(ReplicaPath, d.GetReplicaState)
+	route.GET(ReplicaDigestPath, d.GetFamilyDigest)
+	route.GET(ReplicaConsistencyPath, d.GetConsistencyState)
 }
 
 // GetReplicaState returns replica state by given database's name.
@@ -61,3 +76,51 @@ func (d *ReplicaAPI) GetReplicaState(c *gin.Context) {
 	rs := d.walMgr.GetReplicaState(param.DB)
 	httppkg.OK(c, rs)
 }
+
+// GetFamilyDigest returns the digest of persisted data in data family,
+// returns empty digest if family not exist.
+func (d *ReplicaAPI) GetFamilyDigest(c *gin.Context) {
+	var param struct {
+		DB         string         `form:"db" binding:"required"`
+		ShardID    models.ShardID `form:"shardId"`
+		FamilyTime int64          `form:"familyTime" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	shard, ok := d.engine.GetShard(param.DB, param.ShardID)
+	if !ok {
+		httppkg.Error(c, fmt.Errorf("shard not found, database: %s, shard: %d", param.DB, param.ShardID))
+		return
+	}
+	families := shard.GetDataFamilies(shard.CurrentInterval().Type(),
+		timeutil.TimeRange{Start: param.FamilyTime, End: param.FamilyTime})
+	for _, family := range families {
+		if family.FamilyTime() != param.FamilyTime {
+			continue
+		}
+		digest, err := shard.DigestFamily(family)
+		if err != nil {
+			d.logger.Error("compute digest of family failure",
+				logger.String("family", family.Indicator()), logger.Error(err))
+			httppkg.Error(c, err)
+			return
+		}
+		httppkg.OK(c, digest)
+		return
+	}
+	httppkg.OK(c, &models.FamilyDigest{FamilyTime: param.FamilyTime, Sequences: map[int32]int64{}})
+}
+
+// GetConsistencyState returns the latest consistency check result of replicas led by current node.
+func (d *ReplicaAPI) GetConsistencyState(c *gin.Context) {
+	var param struct {
+		DB string `form:"db" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, d.checker.GetConsistencyState(param.DB))
+}
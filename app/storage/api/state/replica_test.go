@@ -18,15 +18,20 @@
 package state
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/tsdb"
 )
 
 func TestReplicaAPI_GetReplicaState(t *testing.T) {
@@ -36,7 +41,7 @@ func TestReplicaAPI_GetReplicaState(t *testing.T) {
 	}()
 
 	mgr := replica.NewMockWriteAheadLogManager(ctrl)
-	api := NewReplicaAPI(mgr)
+	api := NewReplicaAPI(mgr, nil, nil)
 	r := gin.New()
 	api.Register(r)
 
@@ -48,3 +53,66 @@ func TestReplicaAPI_GetReplicaState(t *testing.T) {
 	resp = mock.DoRequest(t, r, http.MethodGet, ReplicaPath+"?db=test", "")
 	assert.Equal(t, http.StatusOK, resp.Code)
 }
+
+func TestReplicaAPI_GetFamilyDigest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	family := tsdb.NewMockDataFamily(ctrl)
+	api := NewReplicaAPI(nil, engine, nil)
+	r := gin.New()
+	api.Register(r)
+
+	familyTime := timeutil.Now()
+	path := fmt.Sprintf("%s?db=test&shardId=1&familyTime=%d", ReplicaDigestPath, familyTime)
+	shard.EXPECT().CurrentInterval().Return(timeutil.Interval(10 * timeutil.OneSecond)).AnyTimes()
+	family.EXPECT().Indicator().Return("family").AnyTimes()
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodGet, ReplicaDigestPath+"?db=test", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: shard not found
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodGet, path, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true).AnyTimes()
+	// case 3: family not found
+	family.EXPECT().FamilyTime().Return(familyTime + 1)
+	shard.EXPECT().GetDataFamilies(gomock.Any(), gomock.Any()).Return([]tsdb.DataFamily{family})
+	resp = mock.DoRequest(t, r, http.MethodGet, path, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	family.EXPECT().FamilyTime().Return(familyTime).AnyTimes()
+	shard.EXPECT().GetDataFamilies(gomock.Any(), gomock.Any()).Return([]tsdb.DataFamily{family}).AnyTimes()
+	// case 4: compute digest failure
+	shard.EXPECT().DigestFamily(family).Return(nil, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodGet, path, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 5: compute digest ok
+	shard.EXPECT().DigestFamily(family).Return(&models.FamilyDigest{FamilyTime: familyTime}, nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, path, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestReplicaAPI_GetConsistencyState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checker := replica.NewMockConsistencyChecker(ctrl)
+	api := NewReplicaAPI(nil, nil, checker)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodGet, ReplicaConsistencyPath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: get consistency state ok
+	checker.EXPECT().GetConsistencyState("test").Return([]models.ReplicaConsistencyState{{
+		ShardID:     1,
+		Consistency: models.ReplicaConsistent,
+		CheckTime:   time.Now().UnixMilli(),
+	}})
+	resp = mock.DoRequest(t, r, http.MethodGet, ReplicaConsistencyPath+"?db=test", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
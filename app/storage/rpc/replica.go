@@ -102,6 +102,10 @@ func (r *ReplicaHandler) Replica(server protoReplicaV1.ReplicaService_ReplicaSer
 		r.logger.Error("get or create wal partition err, when do replica", logger.Error(err))
 		return status.Error(codes.Internal, err.Error())
 	}
+	if _, err0 := rpc.GetStringFromContext(server.Context(), constants.RPCMetaReplicaSnapshot); err0 == nil {
+		// leader's write ahead log truncated, install snapshot of family
+		return r.installSnapshot(server, p, &replicaState)
+	}
 	err = p.BuildReplicaForFollower(replicaState.Leader, replicaState.Follower)
 	if err != nil {
 		r.logger.Error("build replica replica err", logger.Error(err))
@@ -138,6 +142,50 @@ func (r *ReplicaHandler) Replica(server protoReplicaV1.ReplicaService_ReplicaSer
 	}
 }
 
+// installSnapshot installs the data snapshot of family transferred from leader,
+// rows block is received one by one, the last request with empty record carries the sequence of snapshot.
+func (r *ReplicaHandler) installSnapshot(server protoReplicaV1.ReplicaService_ReplicaServer,
+	p replica.Partition, replicaState *models.ReplicaState,
+) error {
+	r.logger.Info("begin install snapshot", logger.String("replica", replicaState.String()))
+	if err := p.BeginSnapshot(replicaState.Leader); err != nil {
+		r.logger.Error("begin install snapshot err", logger.String("replica", replicaState.String()), logger.Error(err))
+		return status.Error(codes.Internal, err.Error())
+	}
+	for {
+		req, err := server.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			r.logger.Error("receive snapshot request err", logger.Error(err))
+			return status.Error(codes.Internal, err.Error())
+		}
+		resp := &protoReplicaV1.ReplicaResponse{ReplicaIndex: req.ReplicaIndex}
+		if len(req.Record) == 0 {
+			// end of snapshot
+			err = p.CompleteSnapshot(replicaState.Leader, req.ReplicaIndex)
+			resp.AckIndex = req.ReplicaIndex
+			if err == nil {
+				r.logger.Info("install snapshot successfully",
+					logger.String("replica", replicaState.String()),
+					logger.Int64("sequence", req.ReplicaIndex))
+			}
+		} else {
+			var rows int
+			rows, err = p.InstallSnapshot(req.Record)
+			resp.AckIndex = int64(rows)
+		}
+		if err != nil {
+			r.logger.Error("install snapshot err", logger.String("replica", replicaState.String()), logger.Error(err))
+			resp.Err = err.Error()
+		}
+		if err := server.Send(resp); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+}
+
 // getReplicaStateFromCtx gets replica relationship metadata from rpc context.
 func (r *ReplicaHandler) getReplicaStateFromCtx(ctx context.Context) (replicatorState models.ReplicaState, err error) {
 	replicaStateData, err := rpc.GetStringFromContext(ctx, constants.RPCMetaReplicaState)
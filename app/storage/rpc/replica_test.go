@@ -28,6 +28,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
 	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
 	"github.com/lindb/lindb/replica"
 )
@@ -87,3 +88,48 @@ func TestReplicaHandler_Replica(t *testing.T) {
 	err = r.Replica(replicaServer)
 	assert.NoError(t, err)
 }
+
+func TestReplicaHandler_Replica_Snapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	walMgr := replica.NewMockWriteAheadLogManager(ctrl)
+	replicaServer := protoReplicaV1.NewMockReplicaService_ReplicaServer(ctrl)
+	r := NewReplicaHandler(walMgr)
+
+	ctx := metadata.NewIncomingContext(context.TODO(),
+		metadata.Pairs(
+			constants.RPCMetaReplicaState, `{"database":"test-db","shardId":1,"leader":2,"follower":3}`,
+			constants.RPCMetaReplicaSnapshot, "true",
+		))
+	replicaServer.EXPECT().Context().Return(ctx).AnyTimes()
+	wal := replica.NewMockWriteAheadLog(ctrl)
+	walMgr.EXPECT().GetOrCreateLog(gomock.Any()).Return(wal).AnyTimes()
+	p := replica.NewMockPartition(ctrl)
+	wal.EXPECT().GetOrCreatePartition(gomock.Any(), gomock.Any(), gomock.Any()).Return(p, nil).AnyTimes()
+
+	// begin snapshot err
+	p.EXPECT().BeginSnapshot(models.NodeID(2)).Return(fmt.Errorf("err"))
+	assert.Error(t, r.Replica(replicaServer))
+
+	p.EXPECT().BeginSnapshot(models.NodeID(2)).Return(nil).AnyTimes()
+	// recv req err
+	replicaServer.EXPECT().Recv().Return(nil, fmt.Errorf("err"))
+	assert.Error(t, r.Replica(replicaServer))
+
+	// install snapshot err
+	replicaServer.EXPECT().Recv().Return(&protoReplicaV1.ReplicaRequest{ReplicaIndex: 1, Record: []byte{1}}, nil)
+	p.EXPECT().InstallSnapshot([]byte{1}).Return(0, fmt.Errorf("err"))
+	replicaServer.EXPECT().Send(&protoReplicaV1.ReplicaResponse{ReplicaIndex: 1, Err: "err"}).Return(fmt.Errorf("err"))
+	assert.Error(t, r.Replica(replicaServer))
+
+	// install snapshot successfully
+	replicaServer.EXPECT().Recv().Return(&protoReplicaV1.ReplicaRequest{ReplicaIndex: 1, Record: []byte{1}}, nil)
+	p.EXPECT().InstallSnapshot([]byte{1}).Return(10, nil)
+	replicaServer.EXPECT().Send(&protoReplicaV1.ReplicaResponse{ReplicaIndex: 1, AckIndex: 10}).Return(nil)
+	replicaServer.EXPECT().Recv().Return(&protoReplicaV1.ReplicaRequest{ReplicaIndex: 20}, nil)
+	p.EXPECT().CompleteSnapshot(models.NodeID(2), int64(20)).Return(nil)
+	replicaServer.EXPECT().Send(&protoReplicaV1.ReplicaResponse{ReplicaIndex: 20, AckIndex: 20}).Return(nil)
+	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
+	assert.NoError(t, r.Replica(replicaServer))
+}
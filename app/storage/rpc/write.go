@@ -20,6 +20,7 @@ package rpc
 import (
 	"context"
 	"io"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -33,6 +34,19 @@ import (
 	"github.com/lindb/lindb/rpc"
 )
 
+const (
+	// maxPendingWrites is the max number of writes waiting for replica acknowledgement in a stream.
+	maxPendingWrites = 1024
+	// defaultReplicaAckTimeout is the timeout of waiting for replica acknowledgement if broker doesn't specify it.
+	defaultReplicaAckTimeout = 5 * time.Second
+)
+
+// pendingWrite represents a write which is waiting for replica acknowledgement.
+type pendingWrite struct {
+	seq int64
+	err error
+}
+
 // WriteHandler implements protoWriteV1.WriteServiceServer interface for handling write rpc request.
 type WriteHandler struct {
 	walMgr replica.WriteAheadLogManager
@@ -76,7 +90,45 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 		return status.Error(codes.Internal, err.Error())
 	}
 
-	// handle write request from stream
+	numOfAck := familyState.WriteConsistency.NumOfAck(len(familyState.Shard.Replica.Replicas))
+	if numOfAck <= 1 {
+		// leader acknowledges when log appended into write ahead log, responds directly
+		return r.handleWrite(server, p, func(_ int64, err error) error {
+			return r.sendResponse(server, err)
+		})
+	}
+
+	// responds after log acknowledged by enough replicas, keeps the order of requests
+	ackTimeout := time.Duration(familyState.AckTimeout) * time.Millisecond
+	if ackTimeout <= 0 {
+		ackTimeout = defaultReplicaAckTimeout
+	}
+	pending := make(chan pendingWrite, maxPendingWrites)
+	done := make(chan error, 1)
+	go func() {
+		done <- r.respond(server, p, pending, numOfAck, ackTimeout)
+	}()
+	err = r.handleWrite(server, p, func(seq int64, err error) error {
+		select {
+		case pending <- pendingWrite{seq: seq, err: err}:
+			return nil
+		case <-server.Context().Done():
+			return status.Error(codes.Canceled, server.Context().Err().Error())
+		}
+	})
+	close(pending)
+	if respondErr := <-done; err == nil {
+		err = respondErr
+	}
+	return err
+}
+
+// handleWrite handles write request from stream, writes the record into write ahead log, then responds it.
+func (r *WriteHandler) handleWrite(
+	server protoWriteV1.WriteService_WriteServer,
+	p replica.Partition,
+	respond func(seq int64, err error) error,
+) error {
 	for {
 		req, err := server.Recv()
 		if err == io.EOF {
@@ -87,18 +139,48 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 			return status.Error(codes.Internal, err.Error())
 		}
 
-		resp := &protoWriteV1.WriteResponse{}
 		// write wal log
-		err = p.WriteLog(req.Record)
+		seq, err := p.WriteLog(req.Record)
+		if err := respond(seq, err); err != nil {
+			return err
+		}
+	}
+}
 
+// respond waits the acknowledgement of pending writes in order, then responds them.
+func (r *WriteHandler) respond(
+	server protoWriteV1.WriteService_WriteServer,
+	p replica.Partition,
+	pending <-chan pendingWrite,
+	numOfAck int,
+	ackTimeout time.Duration,
+) (err error) {
+	for write := range pending {
 		if err != nil {
-			resp.Err = err.Error()
+			// stream is broken, drain pending writes
+			continue
 		}
-
-		if err := server.Send(resp); err != nil {
-			return status.Error(codes.Internal, err.Error())
+		writeErr := write.err
+		if writeErr == nil {
+			ctx, cancel := context.WithTimeout(server.Context(), ackTimeout)
+			writeErr = p.WaitReplicaAck(ctx, write.seq, numOfAck)
+			cancel()
 		}
+		err = r.sendResponse(server, writeErr)
+	}
+	return err
+}
+
+// sendResponse sends the write response to stream.
+func (r *WriteHandler) sendResponse(server protoWriteV1.WriteService_WriteServer, writeErr error) error {
+	resp := &protoWriteV1.WriteResponse{}
+	if writeErr != nil {
+		resp.Err = writeErr.Error()
+	}
+	if err := server.Send(resp); err != nil {
+		return status.Error(codes.Internal, err.Error())
 	}
+	return nil
 }
 
 // getFamilyInfoFromCtx returns family state metadata from rpc context.
@@ -90,15 +90,67 @@ func TestWriteHandler_Write(t *testing.T) {
 	assert.NoError(t, err)
 	// case 9: write wal err
 	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
-	p.EXPECT().WriteLog(gomock.Any()).Return(fmt.Errorf("err"))
+	p.EXPECT().WriteLog(gomock.Any()).Return(int64(-1), fmt.Errorf("err"))
 	replicaServer.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
 	err = r.Write(replicaServer)
 	assert.Error(t, err)
 	// case 10: write wal ok
 	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
-	p.EXPECT().WriteLog(gomock.Any()).Return(nil)
+	p.EXPECT().WriteLog(gomock.Any()).Return(int64(1), nil)
 	replicaServer.EXPECT().Send(gomock.Any()).Return(nil)
 	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
 	err = r.Write(replicaServer)
 	assert.NoError(t, err)
 }
+
+func TestWriteHandler_Write_Quorum(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	walMgr := replica.NewMockWriteAheadLogManager(ctrl)
+	replicaServer := protoWriteV1.NewMockWriteService_WriteServer(ctrl)
+	r := NewWriteHandler(walMgr)
+	ctx := metadata.NewIncomingContext(context.TODO(),
+		metadata.Pairs(constants.RPCMetaKeyFamilyState,
+			`{  "database":"test-db",
+				"shard":{
+					"id":1,
+					"leader":2,
+					"replica":{"replicas":[1,2,3]}
+				},
+				"familyTime":12321,
+				"writeConsistency":"quorum",
+				"ackTimeout":100
+			}`))
+	replicaServer.EXPECT().Context().Return(ctx).AnyTimes()
+	wal := replica.NewMockWriteAheadLog(ctrl)
+	walMgr.EXPECT().GetOrCreateLog(gomock.Any()).Return(wal).AnyTimes()
+	p := replica.NewMockPartition(ctrl)
+	wal.EXPECT().GetOrCreatePartition(gomock.Any(), gomock.Any(), gomock.Any()).Return(p, nil).AnyTimes()
+	p.EXPECT().BuildReplicaForLeader(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	// case 1: acknowledged, write wal err and not acknowledged, responds in order
+	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil).Times(3)
+	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
+	gomock.InOrder(
+		p.EXPECT().WriteLog(gomock.Any()).Return(int64(1), nil),
+		p.EXPECT().WriteLog(gomock.Any()).Return(int64(-1), fmt.Errorf("err")),
+		p.EXPECT().WriteLog(gomock.Any()).Return(int64(2), nil),
+	)
+	p.EXPECT().WaitReplicaAck(gomock.Any(), int64(1), 2).Return(nil)
+	p.EXPECT().WaitReplicaAck(gomock.Any(), int64(2), 2).Return(replica.ErrReplicaAckTimeout)
+	gomock.InOrder(
+		replicaServer.EXPECT().Send(&protoWriteV1.WriteResponse{}).Return(nil),
+		replicaServer.EXPECT().Send(&protoWriteV1.WriteResponse{Err: "err"}).Return(nil),
+		replicaServer.EXPECT().Send(&protoWriteV1.WriteResponse{Err: replica.ErrReplicaAckTimeout.Error()}).Return(nil),
+	)
+	assert.NoError(t, r.Write(replicaServer))
+
+	// case 2: send response err
+	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil).Times(2)
+	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
+	p.EXPECT().WriteLog(gomock.Any()).Return(int64(3), nil).Times(2)
+	p.EXPECT().WaitReplicaAck(gomock.Any(), int64(3), 2).Return(nil)
+	replicaServer.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, r.Write(replicaServer))
+}
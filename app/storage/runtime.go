@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/lindb/lindb/app"
+	adminapi "github.com/lindb/lindb/app/storage/api/admin"
 	stateapi "github.com/lindb/lindb/app/storage/api/state"
 	rpchandler "github.com/lindb/lindb/app/storage/rpc"
 	"github.com/lindb/lindb/config"
@@ -36,6 +37,7 @@ import (
 	"github.com/lindb/lindb/coordinator/storage"
 	"github.com/lindb/lindb/internal/api"
 	"github.com/lindb/lindb/internal/bootstrap"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/server"
@@ -106,6 +108,7 @@ type runtime struct {
 	stateMachineFactory discovery.StateMachineFactory
 	stateMgr            storage.StateManager
 	walMgr              replica.WriteAheadLogManager
+	consistencyChecker  replica.ConsistencyChecker
 	dbLifecycle         DatabaseLifecycle
 
 	node            *models.StatefulNode
@@ -193,7 +196,9 @@ func (r *runtime) Run() error {
 		hostName = "unknown"
 	}
 	r.node = &models.StatefulNode{
-		ID: models.NodeID(r.myID),
+		ID:   models.NodeID(r.myID),
+		Zone: r.config.StorageBase.Zone,
+		Rack: r.config.StorageBase.Rack,
 		StatelessNode: models.StatelessNode{
 			HostIP:     ip,
 			GRPCPort:   r.config.StorageBase.GRPC.Port,
@@ -225,6 +230,15 @@ func (r *runtime) Run() error {
 		return err
 	}
 	r.walMgr = walMgr
+	r.consistencyChecker = replica.NewConsistencyChecker(
+		r.ctx,
+		r.config.StorageBase.WAL,
+		r.node.ID,
+		r.walMgr,
+		r.stateMgr,
+		client.NewReplicaCli(time.Minute),
+	)
+	r.consistencyChecker.Start()
 
 	// start tcp server
 	r.startTCPServer()
@@ -367,6 +381,10 @@ func (r *runtime) Stop() {
 		}
 	}
 
+	if r.consistencyChecker != nil {
+		r.consistencyChecker.Stop()
+	}
+
 	if r.stateMgr != nil {
 		r.stateMgr.Close()
 	}
@@ -406,7 +424,7 @@ func (r *runtime) startHTTPServer() {
 	exploreAPI := api.NewExploreAPI(r.globalKeyValues, linmetric.StorageRegistry)
 	v1 := r.httpServer.GetAPIRouter().Group(constants.APIVersion1)
 	exploreAPI.Register(v1)
-	replicaAPI := stateapi.NewReplicaAPI(r.walMgr)
+	replicaAPI := stateapi.NewReplicaAPI(r.walMgr, r.engine, r.consistencyChecker)
 	replicaAPI.Register(v1)
 	tsdbStateAPI := stateapi.NewTSDBAPI()
 	tsdbStateAPI.Register(v1)
@@ -420,6 +438,10 @@ func (r *runtime) startHTTPServer() {
 	requestAPI.Register(v1)
 	metadataAPI := stateapi.NewMetadataAPI(r.engine)
 	metadataAPI.Register(v1)
+	backupAPI := adminapi.NewBackupAPI(r.engine)
+	backupAPI.Register(v1)
+	migrationAPI := adminapi.NewMigrationAPI(r.ctx, r.node.ID, r.engine, r.walMgr, client.NewMigrationCli(time.Minute))
+	migrationAPI.Register(v1)
 
 	go func() {
 		if err := r.httpServer.Run(); err != http.ErrServerClosed {
@@ -19,13 +19,21 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/lindb/lindb/app/storage"
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/tsdb"
 )
 
 const (
@@ -34,6 +42,13 @@ const (
 	defaultStorageCfgFile = "./" + storageCfgName
 )
 
+var (
+	// backup path for restoring storage
+	backupPath string
+	// databases need to restore, restore all databases in backup if empty
+	restoreDatabases []string
+)
+
 var runStorageCmd = &cobra.Command{
 	Use:   "run",
 	Short: "starts the storage",
@@ -55,9 +70,20 @@ func newStorageCmd() *cobra.Command {
 	runStorageCmd.PersistentFlags().IntVar(&myID, "myid", 1,
 		"unique server id for single storage cluster")
 
+	restoreStorageCmd.PersistentFlags().StringVar(&cfg, "config", "",
+		fmt.Sprintf("storage config file path, default is %s", defaultStorageCfgFile))
+	restoreStorageCmd.PersistentFlags().StringVar(&backupPath, "backup", "",
+		"backup path which created by storage backup api")
+	restoreStorageCmd.PersistentFlags().StringSliceVar(&restoreDatabases, "database", nil,
+		"databases need to restore, default restore all databases in backup")
+	restoreStorageCmd.PersistentFlags().IntVar(&myID, "myid", 1,
+		"unique server id for single storage cluster, used if myid file not exist")
+	_ = restoreStorageCmd.MarkPersistentFlagRequired("backup")
+
 	storageCmd.AddCommand(
 		runStorageCmd,
 		initializeStorageConfigCmd,
+		restoreStorageCmd,
 	)
 	return storageCmd
 }
@@ -95,3 +121,66 @@ func serveStorage(_ *cobra.Command, _ []string) error {
 		return config.LoadAndSetStorageConfig(cfg, defaultStorageCfgFile, &newStorageCfg)
 	})
 }
+
+var restoreStorageCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "restore databases from backup, storage node must be stopped",
+	RunE:  restoreStorage,
+}
+
+func restoreStorage(_ *cobra.Command, _ []string) error {
+	storageCfg := config.Storage{}
+	if err := config.LoadAndSetStorageConfig(cfg, defaultStorageCfgFile, &storageCfg); err != nil {
+		return err
+	}
+	if err := logger.InitLogger(storageCfg.Logging, storageLogFileName); err != nil {
+		return fmt.Errorf("init logger error: %s", err)
+	}
+	restoreLogger := logger.GetLogger("CMD", "Restore")
+	manifest, err := tsdb.ReadBackupManifest(backupPath)
+	if err != nil {
+		return err
+	}
+	nodeID, err := readStorageNodeID(storageCfg.StorageBase.TSDB.Dir)
+	if err != nil {
+		return err
+	}
+	databaseNames := restoreDatabases
+	if len(databaseNames) == 0 {
+		for _, db := range manifest.Databases {
+			databaseNames = append(databaseNames, db.Name)
+		}
+	}
+	for _, databaseName := range databaseNames {
+		db, ok := manifest.GetDatabase(databaseName)
+		if !ok {
+			return fmt.Errorf("database[%s] not found in backup", databaseName)
+		}
+		if err := tsdb.RestoreDatabase(backupPath, databaseName); err != nil {
+			return err
+		}
+		if err := replica.RestoreWriteAheadLog(storageCfg.StorageBase.WAL, nodeID, db); err != nil {
+			return err
+		}
+		restoreLogger.Info("restore database successfully",
+			logger.String("database", databaseName), logger.String("backup", backupPath))
+	}
+	return nil
+}
+
+// readStorageNodeID returns node id from myid file under data path, returns myid flag if file not exist.
+func readStorageNodeID(dataPath string) (models.NodeID, error) {
+	myIDPath := filepath.Join(dataPath, "myid")
+	if !fileutil.Exist(myIDPath) {
+		return models.NodeID(myID), nil
+	}
+	data, err := os.ReadFile(myIDPath)
+	if err != nil {
+		return 0, err
+	}
+	id, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, err
+	}
+	return models.NodeID(id), nil
+}
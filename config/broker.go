@@ -19,6 +19,8 @@ package config
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/lindb/lindb/pkg/ltoml"
@@ -112,6 +114,12 @@ type Write struct {
 	BatchTimeout   ltoml.Duration `env:"BATCH_TIMEOUT" toml:"batch-timeout"`
 	BatchBlockSize ltoml.Size     `env:"BLOCK_SIZE" toml:"batch-block-size"`
 	GCTaskInterval ltoml.Duration `env:"GC_INTERVAL" toml:"gc-task-interval"`
+	// SpillEnabled spills the write data to local disk when shard leader is unreachable.
+	SpillEnabled bool `env:"SPILL_ENABLED" toml:"spill-enabled"`
+	// SpillDir is the directory of spill queue.
+	SpillDir string `env:"SPILL_DIR" toml:"spill-dir"`
+	// SpillMaxSize is the max total size of all spill queues of broker.
+	SpillMaxSize ltoml.Size `env:"SPILL_MAX_SIZE" toml:"spill-max-size"`
 }
 
 func (rc *Write) TOML() string {
@@ -128,23 +136,206 @@ batch-block-size = "%s"
 ## interval for how often expired write write family garbage collect task execute
 ## Default: %s
 ## Env: LINDB_BROKER_WRITE_GC_INTERVAL
-gc-task-interval = "%s"`,
+gc-task-interval = "%s"
+## whether spill write data to local disk when shard leader is unreachable,
+## spilled data will be replayed after shard leader recovered.
+## Default: %v
+## Env: LINDB_BROKER_WRITE_SPILL_ENABLED
+spill-enabled = %v
+## directory of spill queue
+## Default: %s
+## Env: LINDB_BROKER_WRITE_SPILL_DIR
+spill-dir = "%s"
+## max total size of spilled data, new data will be dropped if exceeded
+## Default: %s
+## Env: LINDB_BROKER_WRITE_SPILL_MAX_SIZE
+spill-max-size = "%s"`,
 		rc.BatchTimeout.String(),
 		rc.BatchTimeout.String(),
 		rc.BatchBlockSize.String(),
 		rc.BatchBlockSize.String(),
 		rc.GCTaskInterval.String(),
 		rc.GCTaskInterval.String(),
+		rc.SpillEnabled,
+		rc.SpillEnabled,
+		strings.ReplaceAll(rc.SpillDir, "\\", "\\\\"),
+		strings.ReplaceAll(rc.SpillDir, "\\", "\\\\"),
+		rc.SpillMaxSize.String(),
+		rc.SpillMaxSize.String(),
+	)
+}
+
+// Auth represents authentication and authorization configuration of broker.
+type Auth struct {
+	// Enabled enables authentication of http api and the privilege check of users.
+	Enabled bool `env:"ENABLED" toml:"enabled"`
+	// UserName is the name of root user, who has all privileges.
+	UserName string `env:"USERNAME" toml:"username"`
+	// Password is the password of root user.
+	Password string `env:"PASSWORD" toml:"password"`
+	// TokenSecret is the secret key for signing login token, all brokers in cluster must use the same secret.
+	// If not set, random secret is generated when broker starting, token is only valid on the broker which creates it.
+	TokenSecret string `env:"TOKEN_SECRET" toml:"token-secret"`
+	// TokenExpire is the valid duration of login token.
+	TokenExpire ltoml.Duration `env:"TOKEN_EXPIRE" toml:"token-expire"`
+}
+
+// RootUser returns the root user who has all privileges.
+func (a *Auth) RootUser() User {
+	return User{UserName: a.UserName, Password: a.Password}
+}
+
+func (a *Auth) TOML() string {
+	return fmt.Sprintf(`
+## whether enable authentication of http api, users/roles are managed by lin query language,
+## e.g. create user/grant read on database xx to role.
+## Default: %v
+## Env: LINDB_BROKER_AUTH_ENABLED
+enabled = %v
+## root user who has all privileges
+## Default: %s
+## Env: LINDB_BROKER_AUTH_USERNAME
+username = "%s"
+## Env: LINDB_BROKER_AUTH_PASSWORD
+password = "%s"
+## secret key for signing login token, all brokers in cluster must use the same secret,
+## random secret is generated when broker starting if not set.
+## Env: LINDB_BROKER_AUTH_TOKEN_SECRET
+token-secret = "%s"
+## valid duration of login token
+## Default: %s
+## Env: LINDB_BROKER_AUTH_TOKEN_EXPIRE
+token-expire = "%s"`,
+		a.Enabled,
+		a.Enabled,
+		a.UserName,
+		a.UserName,
+		a.Password,
+		a.TokenSecret,
+		a.TokenExpire.Duration().String(),
+		a.TokenExpire.Duration().String(),
+	)
+}
+
+// Rebalance represents config for shard rebalancing which is driven by master.
+type Rebalance struct {
+	Enabled            bool           `env:"ENABLED" toml:"enabled"`
+	CheckInterval      ltoml.Duration `env:"CHECK_INTERVAL" toml:"check-interval"`
+	MaxConcurrency     int            `env:"MAX_CONCURRENCY" toml:"max-concurrency"`
+	Throttle           ltoml.Size     `env:"THROTTLE" toml:"throttle"`
+	NodeOfflineTimeout ltoml.Duration `env:"NODE_OFFLINE_TIMEOUT" toml:"node-offline-timeout"`
+	PreferredLeader    bool           `env:"PREFERRED_LEADER" toml:"preferred-leader"`
+	LeaderMaxLag       int64          `env:"LEADER_MAX_LAG" toml:"leader-max-lag"`
+}
+
+func (r *Rebalance) TOML() string {
+	return fmt.Sprintf(`
+## Enable master moves replicas between storage nodes automatically,
+## when storage nodes join or leave the cluster.
+## Replicas on decommissioning storage nodes are always moved.
+## Default: %v
+## Env: LINDB_BROKER_REBALANCE_ENABLED
+enabled = %v
+## interval for how often master checks if shard assignment need rebalancing
+## Default: %s
+## Env: LINDB_BROKER_REBALANCE_CHECK_INTERVAL
+check-interval = "%s"
+## max replica migrations running at the same time
+## Default: %d
+## Env: LINDB_BROKER_REBALANCE_MAX_CONCURRENCY
+max-concurrency = %d
+## max bytes per second which leader sends to target replica when migrating
+## Default: %s
+## Env: LINDB_BROKER_REBALANCE_THROTTLE
+throttle = "%s"
+## replicas on the storage node which is offline longer than this timeout will be moved to other live nodes
+## Default: %s
+## Env: LINDB_BROKER_REBALANCE_NODE_OFFLINE_TIMEOUT
+node-offline-timeout = "%s"
+## Enable master moves shard leadership back to the preferred replica(first live replica in preferred zones),
+## for example, leaders pile onto the nodes which came up first after rolling restart.
+## Default: %v
+## Env: LINDB_BROKER_REBALANCE_PREFERRED_LEADER
+preferred-leader = %v
+## max write ahead log messages which target replica hasn't acknowledged when transferring shard leader,
+## leadership is moved after target replica catches up the current leader.
+## Default: %d
+## Env: LINDB_BROKER_REBALANCE_LEADER_MAX_LAG
+leader-max-lag = %d`,
+		r.Enabled,
+		r.Enabled,
+		r.CheckInterval.String(),
+		r.CheckInterval.String(),
+		r.MaxConcurrency,
+		r.MaxConcurrency,
+		r.Throttle.String(),
+		r.Throttle.String(),
+		r.NodeOfflineTimeout.String(),
+		r.NodeOfflineTimeout.String(),
+		r.PreferredLeader,
+		r.PreferredLeader,
+		r.LeaderMaxLag,
+		r.LeaderMaxLag,
+	)
+}
+
+// QueryCache represents config for the query result cache of broker,
+// the result of completed families(no more data written) is cached, only the open tail is queried from storage.
+type QueryCache struct {
+	// Enabled enables caching the query result.
+	Enabled bool `env:"ENABLED" toml:"enabled"`
+	// MaxSize is the max memory size of cached query result, least recently used entries are evicted if exceeded.
+	MaxSize ltoml.Size `env:"MAX_SIZE" toml:"max-size"`
+	// TTL is the max duration which cached query result is used.
+	TTL ltoml.Duration `env:"TTL" toml:"ttl"`
+	// FamilyCloseDelay is the delay after family end time + database's write behind window,
+	// then the family is treated as completed, also the grace period of not caching result after series deleted.
+	FamilyCloseDelay ltoml.Duration `env:"FAMILY_CLOSE_DELAY" toml:"family-close-delay"`
+}
+
+func (qc *QueryCache) TOML() string {
+	return fmt.Sprintf(`
+## whether cache the query result of completed families(time slices which no more data written),
+## only the open tail of query time range is queried from storage if cache hit,
+## family is completed after family end time + database's write behind window + close delay,
+## cached result of database is invalidated after series deleted(delete series/drop metric).
+## Default: %v
+## Env: LINDB_BROKER_QUERY_CACHE_ENABLED
+enabled = %v
+## max memory size of cached query result, least recently used query is evicted if exceeded
+## Default: %s
+## Env: LINDB_BROKER_QUERY_CACHE_MAX_SIZE
+max-size = "%s"
+## max duration which cached query result is used
+## Default: %s
+## Env: LINDB_BROKER_QUERY_CACHE_TTL
+ttl = "%s"
+## family is treated as completed after this delay since family end time + database's write behind window,
+## also the result is not cached within this delay after series deleted(storage nodes apply deletion asynchronously)
+## Default: %s
+## Env: LINDB_BROKER_QUERY_CACHE_FAMILY_CLOSE_DELAY
+family-close-delay = "%s"`,
+		qc.Enabled,
+		qc.Enabled,
+		qc.MaxSize.String(),
+		qc.MaxSize.String(),
+		qc.TTL.String(),
+		qc.TTL.String(),
+		qc.FamilyCloseDelay.String(),
+		qc.FamilyCloseDelay.String(),
 	)
 }
 
 // BrokerBase represents a broker configuration
 type BrokerBase struct {
-	SlowSQL   ltoml.Duration `env:"SLOW_SQL" toml:"slow-sql"`
-	HTTP      HTTP           `envPrefix:"HTTP_" toml:"http"`
-	Ingestion Ingestion      `envPrefix:"INGESTION_" toml:"ingestion"`
-	Write     Write          `envPrefix:"WRITE_" toml:"write"`
-	GRPC      GRPC           `envPrefix:"GRPC_" toml:"grpc"`
+	SlowSQL    ltoml.Duration `env:"SLOW_SQL" toml:"slow-sql"`
+	HTTP       HTTP           `envPrefix:"HTTP_" toml:"http"`
+	Ingestion  Ingestion      `envPrefix:"INGESTION_" toml:"ingestion"`
+	Write      Write          `envPrefix:"WRITE_" toml:"write"`
+	Rebalance  Rebalance      `envPrefix:"REBALANCE_" toml:"rebalance"`
+	GRPC       GRPC           `envPrefix:"GRPC_" toml:"grpc"`
+	Auth       Auth           `envPrefix:"AUTH_" toml:"auth"`
+	QueryCache QueryCache     `envPrefix:"QUERY_CACHE_" toml:"query-cache"`
 }
 
 // TOML returns broker's base configuration string as toml format.
@@ -167,14 +358,26 @@ slow-sql = "%s"
 ## Write configuration for writing replication block.
 [broker.write]%s
 
+## Rebalance configuration for moving replicas between storage nodes.
+[broker.rebalance]%s
+
 ## Controls how GRPC Server are configured.
-[broker.grpc]%s`,
+[broker.grpc]%s
+
+## Authentication and authorization configuration of broker http api.
+[broker.auth]%s
+
+## Query result cache configuration.
+[broker.query-cache]%s`,
 		bb.SlowSQL.String(),
 		bb.SlowSQL.String(),
 		bb.HTTP.TOML(),
 		bb.Ingestion.TOML(),
 		bb.Write.TOML(),
+		bb.Rebalance.TOML(),
 		bb.GRPC.TOML(),
+		bb.Auth.TOML(),
+		bb.QueryCache.TOML(),
 	)
 }
 
@@ -195,12 +398,36 @@ func NewDefaultBrokerBase() *BrokerBase {
 			BatchTimeout:   ltoml.Duration(time.Second * 2),
 			BatchBlockSize: ltoml.Size(256 * 1024),
 			GCTaskInterval: ltoml.Duration(time.Minute),
+			SpillEnabled:   false,
+			SpillDir:       filepath.Join(defaultParentDir, "broker", "spill"),
+			SpillMaxSize:   ltoml.Size(1024 * 1024 * 1024),
+		},
+		Rebalance: Rebalance{
+			Enabled:            true,
+			CheckInterval:      ltoml.Duration(time.Minute),
+			MaxConcurrency:     1,
+			Throttle:           ltoml.Size(32 * 1024 * 1024),
+			NodeOfflineTimeout: ltoml.Duration(time.Minute * 30),
+			PreferredLeader:    true,
+			LeaderMaxLag:       8,
+		},
+		Auth: Auth{
+			Enabled:     false,
+			UserName:    "admin",
+			Password:    "admin123",
+			TokenExpire: ltoml.Duration(time.Hour * 24),
 		},
 		GRPC: GRPC{
 			Port:                 9001,
 			MaxConcurrentStreams: 1024,
 			ConnectTimeout:       ltoml.Duration(time.Second * 3),
 		},
+		QueryCache: QueryCache{
+			Enabled:          false,
+			MaxSize:          ltoml.Size(64 * 1024 * 1024),
+			TTL:              ltoml.Duration(time.Minute * 10),
+			FamilyCloseDelay: ltoml.Duration(time.Minute),
+		},
 	}
 }
 
@@ -286,6 +513,42 @@ func checkBrokerBaseCfg(brokerBaseCfg *BrokerBase) error {
 	if brokerBaseCfg.Write.GCTaskInterval <= 0 {
 		brokerBaseCfg.Write.GCTaskInterval = defaultBrokerCfg.Write.GCTaskInterval
 	}
+	if brokerBaseCfg.Write.SpillDir == "" {
+		brokerBaseCfg.Write.SpillDir = defaultBrokerCfg.Write.SpillDir
+	}
+	if brokerBaseCfg.Write.SpillMaxSize <= 0 {
+		brokerBaseCfg.Write.SpillMaxSize = defaultBrokerCfg.Write.SpillMaxSize
+	}
+	// auth check
+	if brokerBaseCfg.Auth.UserName == "" {
+		brokerBaseCfg.Auth.UserName = defaultBrokerCfg.Auth.UserName
+	}
+	if brokerBaseCfg.Auth.Password == "" {
+		brokerBaseCfg.Auth.Password = defaultBrokerCfg.Auth.Password
+	}
+	if brokerBaseCfg.Auth.TokenExpire <= 0 {
+		brokerBaseCfg.Auth.TokenExpire = defaultBrokerCfg.Auth.TokenExpire
+	}
+	// rebalance check
+	if brokerBaseCfg.Rebalance.CheckInterval <= 0 {
+		brokerBaseCfg.Rebalance.CheckInterval = defaultBrokerCfg.Rebalance.CheckInterval
+	}
+	if brokerBaseCfg.Rebalance.MaxConcurrency <= 0 {
+		brokerBaseCfg.Rebalance.MaxConcurrency = defaultBrokerCfg.Rebalance.MaxConcurrency
+	}
+	if brokerBaseCfg.Rebalance.NodeOfflineTimeout <= 0 {
+		brokerBaseCfg.Rebalance.NodeOfflineTimeout = defaultBrokerCfg.Rebalance.NodeOfflineTimeout
+	}
+	// query cache check
+	if brokerBaseCfg.QueryCache.MaxSize <= 0 {
+		brokerBaseCfg.QueryCache.MaxSize = defaultBrokerCfg.QueryCache.MaxSize
+	}
+	if brokerBaseCfg.QueryCache.TTL <= 0 {
+		brokerBaseCfg.QueryCache.TTL = defaultBrokerCfg.QueryCache.TTL
+	}
+	if brokerBaseCfg.QueryCache.FamilyCloseDelay < 0 {
+		brokerBaseCfg.QueryCache.FamilyCloseDelay = defaultBrokerCfg.QueryCache.FamilyCloseDelay
+	}
 
 	return nil
 }
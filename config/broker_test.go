@@ -68,9 +68,23 @@ func TestBroker_Env(t *testing.T) {
 		"LINDB_BROKER_WRITE_BATCH_TIMEOUT":         "2m",
 		"LINDB_BROKER_WRITE_BLOCK_SIZE":            "1Mib",
 		"LINDB_BROKER_WRITE_GC_INTERVAL":           "2m",
+		"LINDB_BROKER_WRITE_SPILL_ENABLED":         "true",
+		"LINDB_BROKER_AUTH_ENABLED":                "true",
+		"LINDB_BROKER_AUTH_USERNAME":               "root",
+		"LINDB_BROKER_AUTH_PASSWORD":               "root123",
+		"LINDB_BROKER_AUTH_TOKEN_SECRET":           "secret",
+		"LINDB_BROKER_AUTH_TOKEN_EXPIRE":           "120s",
+		"LINDB_BROKER_WRITE_SPILL_DIR":             "spill_dir",
+		"LINDB_BROKER_WRITE_SPILL_MAX_SIZE":        "2Mib",
+		"LINDB_BROKER_REBALANCE_ENABLED":           "false",
+		"LINDB_BROKER_REBALANCE_MAX_CONCURRENCY":   "3",
+		"LINDB_BROKER_REBALANCE_THROTTLE":          "1Mib",
 		"LINDB_BROKER_GRPC_PORT":                   "2899",
 		"LINDB_BROKER_GRPC_MAX_CONCURRENT_STREAMS": "10000",
 		"LINDB_BROKER_GRPC_CONNECT_TIMEOUT":        "2m",
+		"LINDB_BROKER_QUERY_CACHE_ENABLED":         "true",
+		"LINDB_BROKER_QUERY_CACHE_MAX_SIZE":        "1Mib",
+		"LINDB_BROKER_QUERY_CACHE_TTL":             "2m",
 		"LINDB_MONITOR_PUSH_TIMEOUT":               "2m",
 		"LINDB_MONITOR_REPORT_INTERVAL":            "2m",
 		"LINDB_MONITOR_URL":                        "monitor_url",
@@ -103,9 +117,22 @@ func TestBroker_Env(t *testing.T) {
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.Write.BatchTimeout)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.Write.GCTaskInterval)
 	assert.Equal(t, ltoml.Size(1024*1024), cfg.BrokerBase.Write.BatchBlockSize)
+	assert.True(t, cfg.BrokerBase.Write.SpillEnabled)
+	assert.Equal(t, "spill_dir", cfg.BrokerBase.Write.SpillDir)
+	assert.Equal(t, ltoml.Size(2*1024*1024), cfg.BrokerBase.Write.SpillMaxSize)
+	assert.True(t, cfg.BrokerBase.Auth.Enabled)
+	assert.Equal(t, User{UserName: "root", Password: "root123"}, cfg.BrokerBase.Auth.RootUser())
+	assert.Equal(t, "secret", cfg.BrokerBase.Auth.TokenSecret)
+	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.Auth.TokenExpire)
+	assert.False(t, cfg.BrokerBase.Rebalance.Enabled)
+	assert.Equal(t, 3, cfg.BrokerBase.Rebalance.MaxConcurrency)
+	assert.Equal(t, ltoml.Size(1024*1024), cfg.BrokerBase.Rebalance.Throttle)
 	assert.Equal(t, uint16(2899), cfg.BrokerBase.GRPC.Port)
 	assert.Equal(t, 10000, cfg.BrokerBase.GRPC.MaxConcurrentStreams)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.GRPC.ConnectTimeout)
+	assert.True(t, cfg.BrokerBase.QueryCache.Enabled)
+	assert.Equal(t, ltoml.Size(1024*1024), cfg.BrokerBase.QueryCache.MaxSize)
+	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.QueryCache.TTL)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.Monitor.PushTimeout)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.Monitor.ReportInterval)
 	assert.Equal(t, "monitor_url", cfg.Monitor.URL)
@@ -111,6 +111,9 @@ func Test_checkBrokerBaseCfg(t *testing.T) {
 	assert.NotZero(t, brokerCfg3.HTTP.IdleTimeout)
 	assert.NotZero(t, brokerCfg3.HTTP.WriteTimeout)
 	assert.NotZero(t, brokerCfg3.Ingestion.IngestTimeout)
+	assert.NotZero(t, brokerCfg3.Rebalance.CheckInterval)
+	assert.NotZero(t, brokerCfg3.Rebalance.MaxConcurrency)
+	assert.NotZero(t, brokerCfg3.Rebalance.NodeOfflineTimeout)
 }
 
 func Test_checkStorageBaseCfg(t *testing.T) {
@@ -94,6 +94,8 @@ type StorageBase struct {
 	// Broker http endpoint, auto register current storage cluster.
 	BrokerEndpoint  string         `env:"BROKER_ENDPOINT" toml:"broker-endpoint"`
 	TTLTaskInterval ltoml.Duration `env:"TTL_TASK_INTERVAL" toml:"ttl-task-interval"`
+	Zone            string         `env:"ZONE" toml:"zone"` // availability zone which storage node located in
+	Rack            string         `env:"RACK" toml:"rack"` // rack which storage node located in
 	HTTP            HTTP           `envPrefix:"HTTP_" toml:"http"`
 	GRPC            GRPC           `envPrefix:"GRPC_" toml:"grpc"`
 	TSDB            TSDB           `envPrefix:"TSDB_" toml:"tsdb"`
@@ -113,6 +115,14 @@ ttl-task-interval = "%s"
 ## Default: %s
 ## Env: LINDB_STORAGE_BROKER_ENDPOINT
 broker-endpoint = "%s"
+## availability zone which storage node located in, replicas of shard are spread over zones
+## Default: %s
+## Env: LINDB_STORAGE_ZONE
+zone = "%s"
+## rack which storage node located in, replicas of shard are spread over racks
+## Default: %s
+## Env: LINDB_STORAGE_RACK
+rack = "%s"
 
 ## Storage HTTP related configuration.
 [storage.http]%s
@@ -129,6 +139,10 @@ broker-endpoint = "%s"
 		s.TTLTaskInterval,
 		s.BrokerEndpoint,
 		s.BrokerEndpoint,
+		s.Zone,
+		s.Zone,
+		s.Rack,
+		s.Rack,
 		s.HTTP.TOML(),
 		s.GRPC.TOML(),
 		s.WAL.TOML(),
@@ -141,6 +155,10 @@ type WAL struct {
 	Dir                string         `env:"DIR" toml:"dir"`
 	DataSizeLimit      ltoml.Size     `env:"DATA_SIZE_LIMIT" toml:"data-size-limit"`
 	RemoveTaskInterval ltoml.Duration `env:"REMOVE_TASK_INTERVAL" toml:"remove-task-interval"`
+	// AntiEntropyInterval is the interval of checking the data consistency between replicas, 0 means disabled.
+	AntiEntropyInterval ltoml.Duration `env:"ANTI_ENTROPY_INTERVAL" toml:"anti-entropy-interval"`
+	// AntiEntropyRepair repairs the diverged replica by transferring snapshot from leader.
+	AntiEntropyRepair bool `env:"ANTI_ENTROPY_REPAIR" toml:"anti-entropy-repair"`
 }
 
 func (rc *WAL) GetDataSizeLimit() int64 {
@@ -167,13 +185,25 @@ data-size-limit = "%s"
 ## interval for how often remove expired write ahead log
 ## Default: %s
 ## Env: LINDB_STORAGE_WAL_REMOVE_TASK_INTERVAL
-remove-task-interval = "%s"`,
+remove-task-interval = "%s"
+## interval for how often check the data consistency between replicas(anti-entropy), 0 means disabled
+## Default: %s
+## Env: LINDB_STORAGE_WAL_ANTI_ENTROPY_INTERVAL
+anti-entropy-interval = "%s"
+## whether repair the diverged replica by transferring snapshot from leader
+## Default: %v
+## Env: LINDB_STORAGE_WAL_ANTI_ENTROPY_REPAIR
+anti-entropy-repair = %v`,
 		strings.ReplaceAll(rc.Dir, "\\", "\\\\"),
 		strings.ReplaceAll(rc.Dir, "\\", "\\\\"),
 		rc.DataSizeLimit.String(),
 		rc.DataSizeLimit.String(),
 		rc.RemoveTaskInterval.String(),
 		rc.RemoveTaskInterval.String(),
+		rc.AntiEntropyInterval.String(),
+		rc.AntiEntropyInterval.String(),
+		rc.AntiEntropyRepair,
+		rc.AntiEntropyRepair,
 	)
 }
 
@@ -221,9 +251,10 @@ func NewDefaultStorageBase() *StorageBase {
 			ConnectTimeout:       ltoml.Duration(time.Second * 3),
 		},
 		WAL: WAL{
-			Dir:                filepath.Join(defaultParentDir, "storage", "wal"),
-			DataSizeLimit:      ltoml.Size(128 * 1024 * 1024),
-			RemoveTaskInterval: ltoml.Duration(time.Minute),
+			Dir:                 filepath.Join(defaultParentDir, "storage", "wal"),
+			DataSizeLimit:       ltoml.Size(128 * 1024 * 1024),
+			RemoveTaskInterval:  ltoml.Duration(time.Minute),
+			AntiEntropyInterval: ltoml.Duration(time.Minute * 30),
 		},
 		TSDB: TSDB{
 			Dir:                      filepath.Join(defaultParentDir, "storage", "data"),
@@ -30,4 +30,6 @@ const (
 	ContentTypeProto = "application/protobuf"
 	// ContentTypeInflux represents influx content type.
 	ContentTypeInflux = "application/influx"
+	// ContentTypePrometheus represents prometheus remote write(snappy compressed proto buffer) content type.
+	ContentTypePrometheus = "application/x-protobuf"
 )
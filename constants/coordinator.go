@@ -19,6 +19,8 @@ package constants
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 // StatePathSeparator represents the separator of state store's path.
@@ -59,6 +61,8 @@ const (
 	DatabaseConfigPath = "/database/config"
 	// DatabaseLimitPath represents database limit path.
 	DatabaseLimitPath = "/database/limit"
+	// DatabaseDeletionPath represents database series deletion path.
+	DatabaseDeletionPath = "/database/deletion"
 	// ShardAssignmentPath represents database shard assignment.
 	ShardAssignmentPath = "/database/assign"
 	// StorageConfigPath represents storage cluster's config.
@@ -67,6 +71,18 @@ const (
 	StorageStatePath = "/storage/state"
 	// BrokerConfigPath represents broker cluster's config.
 	BrokerConfigPath = "/broker/config"
+	// ReplicaMigrationPath represents replica migration task path of shard rebalancing.
+	ReplicaMigrationPath = "/rebalance/migration"
+	// DecommissionPath represents storage node decommission path.
+	DecommissionPath = "/rebalance/decommission"
+	// LeaderTransferPath represents shard leader transfer path.
+	LeaderTransferPath = "/rebalance/leader"
+	// UserPath represents user path.
+	UserPath = "/auth/user"
+	// RolePath represents role path.
+	RolePath = "/auth/role"
+	// APITokenPath represents api token path.
+	APITokenPath = "/auth/token"
 )
 
 // GetBrokerClusterConfigPath returns path which storing config of broker cluster.
@@ -93,11 +109,60 @@ func GetDatabaseLimitPath(name string) string {
 	return fmt.Sprintf("%s/%s", DatabaseLimitPath, name)
 }
 
+// GetDatabaseDeletionPath returns path which storing series deletion of database
+func GetDatabaseDeletionPath(name string, id int64) string {
+	return fmt.Sprintf("%s/%s/%d", DatabaseDeletionPath, name, id)
+}
+
+// ParseDatabaseDeletionPath parses database name and deletion id from series deletion path.
+func ParseDatabaseDeletionPath(path string) (name string, id int64, err error) {
+	suffix := strings.TrimPrefix(path, DatabaseDeletionPath+StatePathSeparator)
+	idx := strings.LastIndex(suffix, StatePathSeparator)
+	if idx <= 0 || suffix == path {
+		return "", 0, fmt.Errorf("invalid series deletion path: %s", path)
+	}
+	id, err = strconv.ParseInt(suffix[idx+1:], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid series deletion path: %s", path)
+	}
+	return suffix[:idx], id, nil
+}
+
 // GetDatabaseAssignPath returns path which storing shard assignment of database
 func GetDatabaseAssignPath(name string) string {
 	return fmt.Sprintf("%s/%s", ShardAssignmentPath, name)
 }
 
+// GetReplicaMigrationPath returns path which storing replica migration task of database's shard.
+func GetReplicaMigrationPath(name string, shardID int) string {
+	return fmt.Sprintf("%s/%s/%d", ReplicaMigrationPath, name, shardID)
+}
+
+// GetDecommissionPath returns path which storing decommission of storage node.
+func GetDecommissionPath(storage string, nodeID int) string {
+	return fmt.Sprintf("%s/%s/%d", DecommissionPath, storage, nodeID)
+}
+
+// GetLeaderTransferPath returns path which storing leader transfer of database's shard.
+func GetLeaderTransferPath(name string, shardID int) string {
+	return fmt.Sprintf("%s/%s/%d", LeaderTransferPath, name, shardID)
+}
+
+// GetUserPath returns path which storing user.
+func GetUserPath(name string) string {
+	return fmt.Sprintf("%s/%s", UserPath, name)
+}
+
+// GetRolePath returns path which storing role.
+func GetRolePath(name string) string {
+	return fmt.Sprintf("%s/%s", RolePath, name)
+}
+
+// GetAPITokenPath returns path which storing api token.
+func GetAPITokenPath(id string) string {
+	return fmt.Sprintf("%s/%s", APITokenPath, id)
+}
+
 // GetLiveNodePath returns live node register path.
 func GetLiveNodePath(node string) string {
 	return fmt.Sprintf("%s/%s", LiveNodesPath, node)
@@ -35,6 +35,38 @@ func TestGetDatabaseLimitPath(t *testing.T) {
 	assert.Equal(t, DatabaseLimitPath+"/name", GetDatabaseLimitPath("name"))
 }
 
+func TestGetReplicaMigrationPath(t *testing.T) {
+	assert.Equal(t, ReplicaMigrationPath+"/name/1", GetReplicaMigrationPath("name", 1))
+}
+
+func TestGetDecommissionPath(t *testing.T) {
+	assert.Equal(t, DecommissionPath+"/name/1", GetDecommissionPath("name", 1))
+}
+
+func TestGetLeaderTransferPath(t *testing.T) {
+	assert.Equal(t, LeaderTransferPath+"/name/1", GetLeaderTransferPath("name", 1))
+}
+
+func TestGetUserPath(t *testing.T) {
+	assert.Equal(t, UserPath+"/name", GetUserPath("name"))
+	assert.Equal(t, RolePath+"/name", GetRolePath("name"))
+	assert.Equal(t, APITokenPath+"/id", GetAPITokenPath("id"))
+}
+
+func TestGetDatabaseDeletionPath(t *testing.T) {
+	path := GetDatabaseDeletionPath("name", 100)
+	assert.Equal(t, DatabaseDeletionPath+"/name/100", path)
+	name, id, err := ParseDatabaseDeletionPath(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "name", name)
+	assert.Equal(t, int64(100), id)
+
+	for _, path := range []string{"/name/100", DatabaseDeletionPath + "/100", DatabaseDeletionPath + "/name/id"} {
+		_, _, err = ParseDatabaseDeletionPath(path)
+		assert.Error(t, err)
+	}
+}
+
 func TestGetNodePath(t *testing.T) {
 	assert.Equal(t, LiveNodesPath+"/name", GetLiveNodePath("name"))
 }
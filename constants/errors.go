@@ -88,4 +88,18 @@ var (
 	ErrTooManyFields = errors.New("too many fields")
 	// ErrTooManySeriesFound is the error returned max series limit of data query.
 	ErrTooManySeriesFound = errors.New("found too many series")
+	// ErrWriteStreamClosed is the error returned when write stream is closed before written rows acknowledged.
+	ErrWriteStreamClosed = errors.New("write stream is closed before acknowledged")
+	// ErrWriteNotAcknowledged is the error returned when written rows are not acknowledged by enough replicas.
+	ErrWriteNotAcknowledged = errors.New("write not acknowledged by enough replicas")
+	// ErrUnauthorized is the error returned when request without valid authorization.
+	ErrUnauthorized = errors.New("authorization token invalid")
+	// ErrPermissionDenied is the error returned when user hasn't the privilege of operation.
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrWriteRateLimited is the error returned when write rate exceeds the limits of database/namespace.
+	ErrWriteRateLimited = errors.New("write rate limited")
+	// ErrQueryKilled is the error returned when query is killed by user.
+	ErrQueryKilled = errors.New("query killed")
+	// ErrQueryMemoryExceeded is the error returned when query uses memory exceeds the limit of database.
+	ErrQueryMemoryExceeded = errors.New("query memory exceeds limit")
 )
@@ -26,4 +26,8 @@ const (
 
 	// CurrentSQL represents the key of current sql context.
 	CurrentSQL = "LinDB_SQL"
+	// CurrentUser represents the key of current login user context.
+	CurrentUser = "LinDB_User"
+	// CurrentAPIToken represents the key of current api token context.
+	CurrentAPIToken = "LinDB_APIToken"
 )
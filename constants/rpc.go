@@ -22,4 +22,6 @@ const (
 	RPCMetaKeyDatabase    = "Database"
 	RPCMetaKeyFamilyState = "FamilyState"
 	RPCMetaReplicaState   = "ReplicaState"
+	// RPCMetaReplicaSnapshot marks the replica stream transfers the data snapshot of family instead of write ahead log.
+	RPCMetaReplicaSnapshot = "ReplicaSnapshot"
 )
@@ -20,6 +20,10 @@ package constants
 const (
 	// MaxSuggestions represents the max number of suggestions count
 	MaxSuggestions = 100
+	// MaxJoinSeries represents the max number of series which each metric returns in cross-metric query
+	MaxJoinSeries = 10000
+	// MaxSubQuerySeries represents the max number of series which sub query returns if limit not set
+	MaxSubQuerySeries = 10000
 
 	// MetricMaxAheadDuration controls the global max write ahead duration.
 	// If current timestamp is 2021-08-19 23:00:00, metric after 2021-08-20 23:00:00 will be dropped.
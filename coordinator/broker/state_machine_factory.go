@@ -103,6 +103,34 @@ func (f *stateMachineFactory) Start() (err error) {
 	}
 	f.stateMachines = append(f.stateMachines, sm)
 
+	f.logger.Debug("starting UserStateMachine")
+	sm, err = f.createUserStateMachine()
+	if err != nil {
+		return err
+	}
+	f.stateMachines = append(f.stateMachines, sm)
+
+	f.logger.Debug("starting RoleStateMachine")
+	sm, err = f.createRoleStateMachine()
+	if err != nil {
+		return err
+	}
+	f.stateMachines = append(f.stateMachines, sm)
+
+	f.logger.Debug("starting APITokenStateMachine")
+	sm, err = f.createAPITokenStateMachine()
+	if err != nil {
+		return err
+	}
+	f.stateMachines = append(f.stateMachines, sm)
+
+	f.logger.Debug("starting SeriesDeletionStateMachine")
+	sm, err = f.createSeriesDeletionStateMachine()
+	if err != nil {
+		return err
+	}
+	f.stateMachines = append(f.stateMachines, sm)
+
 	f.logger.Info("started BrokerStateMachines")
 	return nil
 }
@@ -175,6 +203,118 @@ func (f *stateMachineFactory) createDatabaseLimitsStateMachine() (discovery.Stat
 	)
 }
 
+// createUserStateMachine creates user state machine.
+func (f *stateMachineFactory) createUserStateMachine() (discovery.StateMachine, error) {
+	return discovery.NewStateMachineFn(
+		f.ctx,
+		discovery.UserStateMachine,
+		f.discoveryFactory,
+		constants.UserPath,
+		true,
+		f.onUserChanged,
+		f.onUserDeletion,
+	)
+}
+
+// createRoleStateMachine creates role state machine.
+func (f *stateMachineFactory) createRoleStateMachine() (discovery.StateMachine, error) {
+	return discovery.NewStateMachineFn(
+		f.ctx,
+		discovery.RoleStateMachine,
+		f.discoveryFactory,
+		constants.RolePath,
+		true,
+		f.onRoleChanged,
+		f.onRoleDeletion,
+	)
+}
+
+// createAPITokenStateMachine creates api token state machine.
+func (f *stateMachineFactory) createAPITokenStateMachine() (discovery.StateMachine, error) {
+	return discovery.NewStateMachineFn(
+		f.ctx,
+		discovery.APITokenStateMachine,
+		f.discoveryFactory,
+		constants.APITokenPath,
+		true,
+		f.onAPITokenChanged,
+		f.onAPITokenDeletion,
+	)
+}
+
+// createSeriesDeletionStateMachine creates database's series deletion state machine.
+func (f *stateMachineFactory) createSeriesDeletionStateMachine() (discovery.StateMachine, error) {
+	return discovery.NewStateMachineFn(
+		f.ctx,
+		discovery.SeriesDeletionStateMachine,
+		f.discoveryFactory,
+		constants.DatabaseDeletionPath,
+		true,
+		f.onSeriesDeletionChanged,
+		nil,
+	)
+}
+
+// onSeriesDeletionChanged triggers when series of database deleted(delete series/drop metric).
+func (f *stateMachineFactory) onSeriesDeletionChanged(key string, data []byte) {
+	f.stateMgr.EmitEvent(&discovery.Event{
+		Type:  discovery.SeriesDeletionChanged,
+		Key:   key,
+		Value: data,
+	})
+}
+
+// onUserChanged triggers when user modified(create/update).
+func (f *stateMachineFactory) onUserChanged(key string, data []byte) {
+	f.stateMgr.EmitEvent(&discovery.Event{
+		Type:  discovery.UserChanged,
+		Key:   key,
+		Value: data,
+	})
+}
+
+// onUserDeletion triggers when user is deletion.
+func (f *stateMachineFactory) onUserDeletion(key string) {
+	f.stateMgr.EmitEvent(&discovery.Event{
+		Type: discovery.UserDeletion,
+		Key:  key,
+	})
+}
+
+// onRoleChanged triggers when role modified(create/update).
+func (f *stateMachineFactory) onRoleChanged(key string, data []byte) {
+	f.stateMgr.EmitEvent(&discovery.Event{
+		Type:  discovery.RoleChanged,
+		Key:   key,
+		Value: data,
+	})
+}
+
+// onRoleDeletion triggers when role is deletion.
+func (f *stateMachineFactory) onRoleDeletion(key string) {
+	f.stateMgr.EmitEvent(&discovery.Event{
+		Type: discovery.RoleDeletion,
+		Key:  key,
+	})
+}
+
+// onAPITokenChanged triggers when api token modified(create/update).
+func (f *stateMachineFactory) onAPITokenChanged(key string, data []byte) {
+	f.stateMgr.EmitEvent(&discovery.Event{
+		Type:  discovery.APITokenChanged,
+		Key:   key,
+		Value: data,
+	})
+}
+
+// onAPITokenDeletion triggers when api token is deletion(revoked).
+func (f *stateMachineFactory) onAPITokenDeletion(key string) {
+	f.stateMgr.EmitEvent(&discovery.Event{
+		Type: discovery.APITokenDeletion,
+		Key:  key,
+	})
+}
+
 // onDatabaseConfigChanged triggers when database config modified(create/update)
 func (f *stateMachineFactory) onDatabaseConfigChanged(key string, data []byte) {
 	f.stateMgr.EmitEvent(&discovery.Event{
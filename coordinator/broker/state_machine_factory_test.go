@@ -58,8 +58,28 @@ func TestStateMachineFactory_Start(t *testing.T) {
 	discovery1.EXPECT().Discovery(gomock.Any()).Return(fmt.Errorf("err"))
 	err = fct.Start()
 	assert.Error(t, err)
-	// all state machines are ok
+	// user sm err
 	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).MaxTimes(4)
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(fmt.Errorf("err"))
+	err = fct.Start()
+	assert.Error(t, err)
+	// role sm err
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).MaxTimes(5)
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(fmt.Errorf("err"))
+	err = fct.Start()
+	assert.Error(t, err)
+	// api token sm err
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).MaxTimes(6)
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(fmt.Errorf("err"))
+	err = fct.Start()
+	assert.Error(t, err)
+	// series deletion sm err
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).MaxTimes(7)
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(fmt.Errorf("err"))
+	err = fct.Start()
+	assert.Error(t, err)
+	// all state machines are ok
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).MaxTimes(8)
 	err = fct.Start()
 	assert.NoError(t, err)
 }
@@ -169,3 +189,69 @@ func TestStateMachineFactory_DatabaseLimits(t *testing.T) {
 	sm.OnCreate("/test", []byte("value"))
 	sm.OnDelete("/test")
 }
+
+func TestStateMachineFactory_OnUserAndRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := NewMockStateManager(ctrl)
+	fct := NewStateMachineFactory(context.TODO(), nil, stateMgr)
+	fct1 := fct.(*stateMachineFactory)
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type: discovery.UserDeletion,
+		Key:  "/key",
+	})
+	fct1.onUserDeletion("/key")
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type:  discovery.UserChanged,
+		Key:   "/key",
+		Value: []byte("value"),
+	})
+	fct1.onUserChanged("/key", []byte("value"))
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type: discovery.RoleDeletion,
+		Key:  "/key",
+	})
+	fct1.onRoleDeletion("/key")
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type:  discovery.RoleChanged,
+		Key:   "/key",
+		Value: []byte("value"),
+	})
+	fct1.onRoleChanged("/key", []byte("value"))
+}
+
+func TestStateMachineFactory_OnAPIToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := NewMockStateManager(ctrl)
+	fct := NewStateMachineFactory(context.TODO(), nil, stateMgr)
+	fct1 := fct.(*stateMachineFactory)
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type: discovery.APITokenDeletion,
+		Key:  "/key",
+	})
+	fct1.onAPITokenDeletion("/key")
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type:  discovery.APITokenChanged,
+		Key:   "/key",
+		Value: []byte("value"),
+	})
+	fct1.onAPITokenChanged("/key", []byte("value"))
+}
+
+func TestStateMachineFactory_OnSeriesDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := NewMockStateManager(ctrl)
+	fct := NewStateMachineFactory(context.TODO(), nil, stateMgr)
+	fct1 := fct.(*stateMachineFactory)
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type:  discovery.SeriesDeletionChanged,
+		Key:   "/key",
+		Value: []byte("value"),
+	})
+	fct1.onSeriesDeletionChanged("/key", []byte("value"))
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/query/cache"
 	"github.com/lindb/lindb/rpc"
 )
 
@@ -41,6 +42,11 @@ import (
 
 var defaultDatabaseLimits = models.NewDefaultLimits()
 
+// for testing
+var (
+	getResultCacheFn = cache.GetResultCache
+)
+
 // StateManager represents broker state manager, maintains broker node/database/storage states in memory.
 type StateManager interface {
 	flow.NodeChoose
@@ -64,8 +70,23 @@ type StateManager interface {
 	GetStorageList() (rs []*models.StorageState)
 	// GetDatabaseLimits returns the database's limits.
 	GetDatabaseLimits(name string) *models.Limits
-
+	// GetUser returns the user by name.
+	GetUser(name string) (models.User, bool)
+	// GetUsers returns all users.
+	GetUsers() []models.User
+	// GetRole returns the role by name.
+	GetRole(name string) (models.Role, bool)
+	// GetRoles returns all roles.
+	GetRoles() []models.Role
+	// GetAPIToken returns the api token by id.
+	GetAPIToken(id string) (models.APIToken, bool)
+	// GetAPITokens returns all api tokens.
+	GetAPITokens() []models.APIToken
+
+	// WatchShardStateChangeEvent adds callback which is invoked after shard state changed,
+	// routings is the shard routing history of database after the number of shards changed.
 	WatchShardStateChangeEvent(fn func(databaseCfg models.Database,
+		routings models.ShardRoutings,
 		shards map[models.ShardID]models.ShardState,
 		liveNodes map[models.NodeID]models.StatefulNode,
 	))
@@ -81,8 +102,12 @@ type stateManager struct {
 	storages    map[string]*models.StorageState // storage state
 	databases   map[string]models.Database      // database config
 	nodes       map[string]models.StatelessNode // live nodes of broker cluster
+	users       map[string]models.User          // users of broker cluster
+	roles       map[string]models.Role          // roles of broker cluster
+	apiTokens   map[string]models.APIToken      // api tokens of broker cluster(id => token)
 
 	callbacks []func(databaseCfg models.Database,
+		routings models.ShardRoutings,
 		shards map[models.ShardID]models.ShardState,
 		liveNodes map[models.NodeID]models.StatefulNode,
 	)
@@ -116,6 +141,9 @@ func NewStateManager(
 		storages:          make(map[string]*models.StorageState),
 		databases:         make(map[string]models.Database),
 		nodes:             make(map[string]models.StatelessNode),
+		users:             make(map[string]models.User),
+		roles:             make(map[string]models.Role),
+		apiTokens:         make(map[string]models.APIToken),
 		events:            make(chan *discovery.Event, 10),
 		statistics:        metrics.NewStateManagerStatistics(linmetric.BrokerRegistry),
 		logger:            logger.GetLogger("Broker", "StateManager"),
@@ -158,6 +186,7 @@ func (m *stateManager) Choose(database string, numOfNodes int) ([]*models.Physic
 }
 
 func (m *stateManager) WatchShardStateChangeEvent(fn func(databaseCfg models.Database,
+	routings models.ShardRoutings,
 	shards map[models.ShardID]models.ShardState,
 	liveNodes map[models.NodeID]models.StatefulNode,
 )) {
@@ -221,6 +250,20 @@ func (m *stateManager) processEvent(event *discovery.Event) {
 		m.onStorageDelete(event.Key)
 	case discovery.DatabaseLimitsChanged:
 		err = m.onDatabaseLimitsChange(event.Key, event.Value)
+	case discovery.UserChanged:
+		err = m.onUserChange(event.Key, event.Value)
+	case discovery.UserDeletion:
+		m.onUserDelete(event.Key)
+	case discovery.RoleChanged:
+		err = m.onRoleChange(event.Key, event.Value)
+	case discovery.RoleDeletion:
+		m.onRoleDelete(event.Key)
+	case discovery.APITokenChanged:
+		err = m.onAPITokenChange(event.Key, event.Value)
+	case discovery.APITokenDeletion:
+		m.onAPITokenDelete(event.Key)
+	case discovery.SeriesDeletionChanged:
+		err = m.onSeriesDeletionChange(event.Key)
 	}
 	if err != nil {
 		m.statistics.HandleEventFailure.WithTagValues(eventType, constants.BrokerRole).Incr()
@@ -278,6 +321,95 @@ func (m *stateManager) onDatabaseCfgDelete(key string) {
 	delete(m.databases, databaseName)
 }
 
+// onUserChange triggers when user create/modify.
+func (m *stateManager) onUserChange(key string, data []byte) error {
+	m.logger.Info("user is modified", logger.String("key", key))
+
+	user := models.User{}
+	if err := encoding.JSONUnmarshal(data, &user); err != nil {
+		m.logger.Error("user modified but unmarshal error", logger.Error(err))
+		return err
+	}
+	if user.Name == "" {
+		m.logger.Error("user name cannot be empty")
+		return constants.ErrNameEmpty
+	}
+	m.users[user.Name] = user
+	return nil
+}
+
+// onUserDelete triggers when user is deletion.
+func (m *stateManager) onUserDelete(key string) {
+	m.logger.Info("user deleted", logger.String("key", key))
+
+	_, name := filepath.Split(key)
+	delete(m.users, name)
+}
+
+// onRoleChange triggers when role create/modify.
+func (m *stateManager) onRoleChange(key string, data []byte) error {
+	m.logger.Info("role is modified", logger.String("key", key))
+
+	role := models.Role{}
+	if err := encoding.JSONUnmarshal(data, &role); err != nil {
+		m.logger.Error("role modified but unmarshal error", logger.Error(err))
+		return err
+	}
+	if role.Name == "" {
+		m.logger.Error("role name cannot be empty")
+		return constants.ErrNameEmpty
+	}
+	m.roles[role.Name] = role
+	return nil
+}
+
+// onRoleDelete triggers when role is deletion.
+func (m *stateManager) onRoleDelete(key string) {
+	m.logger.Info("role deleted", logger.String("key", key))
+
+	_, name := filepath.Split(key)
+	delete(m.roles, name)
+}
+
+// onAPITokenChange triggers when api token create/modify.
+func (m *stateManager) onAPITokenChange(key string, data []byte) error {
+	m.logger.Info("api token is modified", logger.String("key", key))
+
+	token := models.APIToken{}
+	if err := encoding.JSONUnmarshal(data, &token); err != nil {
+		m.logger.Error("api token modified but unmarshal error", logger.Error(err))
+		return err
+	}
+	if token.ID == "" {
+		m.logger.Error("api token id cannot be empty")
+		return constants.ErrNameEmpty
+	}
+	m.apiTokens[token.ID] = token
+	return nil
+}
+
+// onSeriesDeletionChange triggers when series of database deleted(delete series/drop metric),
+// invalidates the query result cache of database.
+func (m *stateManager) onSeriesDeletionChange(key string) error {
+	name, _, err := constants.ParseDatabaseDeletionPath(key)
+	if err != nil {
+		m.logger.Error("parse series deletion path failure", logger.String("key", key), logger.Error(err))
+		return err
+	}
+	m.logger.Info("invalidate query result cache, because series of database deleted",
+		logger.String("database", name))
+	getResultCacheFn().Invalidate(name)
+	return nil
+}
+
+// onAPITokenDelete triggers when api token is deletion(revoked).
+func (m *stateManager) onAPITokenDelete(key string) {
+	m.logger.Info("api token deleted", logger.String("key", key))
+
+	_, id := filepath.Split(key)
+	delete(m.apiTokens, id)
+}
+
 // onNodeStartup triggers when broker node online.
 func (m *stateManager) onNodeStartup(key string, data []byte) error {
 	m.logger.Info("new broker node online",
@@ -458,6 +590,75 @@ func (m *stateManager) GetDatabaseLimits(name string) *models.Limits {
 	return val.(*models.Limits)
 }
 
+// GetUser returns the user by name.
+func (m *stateManager) GetUser(name string) (models.User, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	user, ok := m.users[name]
+	return user, ok
+}
+
+// GetUsers returns all users.
+func (m *stateManager) GetUsers() (rs []models.User) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	for name := range m.users {
+		rs = append(rs, m.users[name])
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Name < rs[j].Name
+	})
+	return
+}
+
+// GetRole returns the role by name.
+func (m *stateManager) GetRole(name string) (models.Role, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	role, ok := m.roles[name]
+	return role, ok
+}
+
+// GetRoles returns all roles.
+func (m *stateManager) GetRoles() (rs []models.Role) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	for name := range m.roles {
+		rs = append(rs, m.roles[name])
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Name < rs[j].Name
+	})
+	return
+}
+
+// GetAPIToken returns the api token by id.
+func (m *stateManager) GetAPIToken(id string) (models.APIToken, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	token, ok := m.apiTokens[id]
+	return token, ok
+}
+
+// GetAPITokens returns all api tokens.
+func (m *stateManager) GetAPITokens() (rs []models.APIToken) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	for id := range m.apiTokens {
+		rs = append(rs, m.apiTokens[id])
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].CreateTime < rs[j].CreateTime
+	})
+	return
+}
+
 // GetQueryableReplicas returns the queryable replicas, else return detail error msg.::x
 // returns storage node => shard id list
 func (m *stateManager) GetQueryableReplicas(databaseName string) (map[string][]models.ShardID, error) {
@@ -515,8 +716,12 @@ func (m *stateManager) notifyShardStateChange(storageState *models.StorageState)
 	liveNodes := storageState.LiveNodes
 	for db, shards := range storageState.ShardStates {
 		databaseCfg := m.databases[db]
+		var routings models.ShardRoutings
+		if shardAssignment, ok := storageState.ShardAssignments[db]; ok && shardAssignment != nil {
+			routings = shardAssignment.Routings
+		}
 		for _, fn := range m.callbacks {
-			fn(databaseCfg, shards, liveNodes)
+			fn(databaseCfg, routings, shards, liveNodes)
 		}
 	}
 }
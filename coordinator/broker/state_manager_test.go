@@ -19,6 +19,7 @@ package broker
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/query/cache"
 	"github.com/lindb/lindb/rpc"
 )
 
@@ -230,10 +232,15 @@ func TestStateManager_ShardState(t *testing.T) {
 	connectionMgr := rpc.NewMockConnectionManager(ctrl)
 	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, connectionMgr, nil)
 	c := 0
+	var routings models.ShardRoutings
 	mgr.WatchShardStateChangeEvent(func(_ models.Database,
+		rs models.ShardRoutings,
 		_ map[models.ShardID]models.ShardState,
 		_ map[models.NodeID]models.StatefulNode) {
 		c++
+		if len(rs) > 0 {
+			routings = rs
+		}
 	})
 	connectionMgr.EXPECT().CreateConnection(gomock.Any()).MaxTimes(2)
 
@@ -260,6 +267,9 @@ func TestStateManager_ShardState(t *testing.T) {
 			ShardStates: map[string]map[models.ShardID]models.ShardState{
 				"test_1": {1: models.ShardState{ID: 1, State: models.OnlineShard}, 2: models.ShardState{ID: 2}},
 			},
+			ShardAssignments: map[string]*models.ShardAssignment{
+				"test_1": {Name: "test_1", Routings: models.ShardRoutings{{NumOfShard: 3}, {NumOfShard: 2, CutOverTime: 10}}},
+			},
 		}),
 	})
 	connectionMgr.EXPECT().CreateConnection(gomock.Any()).MaxTimes(2)
@@ -282,6 +292,7 @@ func TestStateManager_ShardState(t *testing.T) {
 
 	mgr1 := mgr.(*stateManager)
 	mgr1.mutex.Lock()
+	assert.Equal(t, models.ShardRoutings{{NumOfShard: 3}, {NumOfShard: 2, CutOverTime: 10}}, routings)
 	mgr1.databases = map[string]models.Database{
 		"test_1": {Storage: "test_1"},
 		"test_2": {Storage: "test_2"},
@@ -419,3 +430,93 @@ func TestStateManager_onDatabaseLimits(t *testing.T) {
 	assert.Equal(t, limit2, mgr.GetDatabaseLimits("db2"))
 	assert.Equal(t, defaultDatabaseLimits, mgr.GetDatabaseLimits("test"))
 }
+
+func TestStateManager_onSeriesDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		getResultCacheFn = cache.GetResultCache
+		ctrl.Finish()
+	}()
+	resultCache := cache.NewMockResultCache(ctrl)
+	getResultCacheFn = func() cache.ResultCache {
+		return resultCache
+	}
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, nil, nil)
+	defer mgr.Close()
+
+	var wait sync.WaitGroup
+	wait.Add(1)
+	resultCache.EXPECT().Invalidate("db").Do(func(_ string) {
+		wait.Done()
+	})
+	// case 1: parse path failure
+	mgr.EmitEvent(&discovery.Event{Type: discovery.SeriesDeletionChanged, Key: "/database/deletion/db"})
+	// case 2: invalidate query result cache of database
+	mgr.EmitEvent(&discovery.Event{Type: discovery.SeriesDeletionChanged, Key: constants.GetDatabaseDeletionPath("db", 1)})
+	wait.Wait()
+}
+
+func TestStateManager_UserAndRole(t *testing.T) {
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, nil, nil)
+	defer mgr.Close()
+
+	// unmarshal failure
+	mgr.EmitEvent(&discovery.Event{Type: discovery.UserChanged, Key: "/auth/user/bob", Value: []byte("dd")})
+	mgr.EmitEvent(&discovery.Event{Type: discovery.RoleChanged, Key: "/auth/role/ops", Value: []byte("dd")})
+	// name empty
+	mgr.EmitEvent(&discovery.Event{Type: discovery.UserChanged, Key: "/auth/user/bob", Value: []byte("{}")})
+	mgr.EmitEvent(&discovery.Event{Type: discovery.RoleChanged, Key: "/auth/role/ops", Value: []byte("{}")})
+	// create user/role
+	bob, _ := models.NewUser("bob", "pwd")
+	alice, _ := models.NewUser("alice", "pwd")
+	ops := models.Role{Name: "ops", Grants: []models.Grant{{Database: "db", Privilege: models.ReadPrivilege}}}
+	admin := models.Role{Name: "admin"}
+	mgr.EmitEvent(&discovery.Event{Type: discovery.UserChanged, Key: "/auth/user/bob", Value: encoding.JSONMarshal(bob)})
+	mgr.EmitEvent(&discovery.Event{Type: discovery.UserChanged, Key: "/auth/user/alice", Value: encoding.JSONMarshal(alice)})
+	mgr.EmitEvent(&discovery.Event{Type: discovery.RoleChanged, Key: "/auth/role/ops", Value: encoding.JSONMarshal(&ops)})
+	mgr.EmitEvent(&discovery.Event{Type: discovery.RoleChanged, Key: "/auth/role/admin", Value: encoding.JSONMarshal(&admin)})
+	time.Sleep(100 * time.Millisecond)
+	user, ok := mgr.GetUser("bob")
+	assert.True(t, ok)
+	assert.Equal(t, *bob, user)
+	role, ok := mgr.GetRole("ops")
+	assert.True(t, ok)
+	assert.Equal(t, ops, role)
+	assert.Equal(t, []models.User{*alice, *bob}, mgr.GetUsers())
+	assert.Equal(t, []models.Role{admin, ops}, mgr.GetRoles())
+
+	// delete user/role
+	mgr.EmitEvent(&discovery.Event{Type: discovery.UserDeletion, Key: "/auth/user/bob"})
+	mgr.EmitEvent(&discovery.Event{Type: discovery.RoleDeletion, Key: "/auth/role/ops"})
+	time.Sleep(100 * time.Millisecond)
+	_, ok = mgr.GetUser("bob")
+	assert.False(t, ok)
+	_, ok = mgr.GetRole("ops")
+	assert.False(t, ok)
+}
+
+func TestStateManager_APIToken(t *testing.T) {
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, nil, nil)
+	defer mgr.Close()
+
+	// unmarshal failure
+	mgr.EmitEvent(&discovery.Event{Type: discovery.APITokenChanged, Key: "/auth/token/1", Value: []byte("dd")})
+	// id empty
+	mgr.EmitEvent(&discovery.Event{Type: discovery.APITokenChanged, Key: "/auth/token/1", Value: []byte("{}")})
+	// create token
+	t1 := models.APIToken{ID: "1", Name: "agent", Database: "db", Privilege: models.WritePrivilege, CreateTime: 2}
+	t2 := models.APIToken{ID: "2", Name: "grafana", Database: "db", Privilege: models.ReadPrivilege, CreateTime: 1}
+	mgr.EmitEvent(&discovery.Event{Type: discovery.APITokenChanged, Key: "/auth/token/1", Value: encoding.JSONMarshal(&t1)})
+	mgr.EmitEvent(&discovery.Event{Type: discovery.APITokenChanged, Key: "/auth/token/2", Value: encoding.JSONMarshal(&t2)})
+	time.Sleep(100 * time.Millisecond)
+	token, ok := mgr.GetAPIToken("1")
+	assert.True(t, ok)
+	assert.Equal(t, t1, token)
+	assert.Equal(t, []models.APIToken{t2, t1}, mgr.GetAPITokens())
+
+	// revoke token
+	mgr.EmitEvent(&discovery.Event{Type: discovery.APITokenDeletion, Key: "/auth/token/1"})
+	time.Sleep(100 * time.Millisecond)
+	_, ok = mgr.GetAPIToken("1")
+	assert.False(t, ok)
+}
@@ -34,6 +34,14 @@ const (
 	BrokerConfigChanged
 	BrokerConfigDeletion
 	DatabaseLimitsChanged
+	SeriesDeletionChanged
+	UserChanged
+	UserDeletion
+	RoleChanged
+	RoleDeletion
+	APITokenChanged
+	APITokenDeletion
+	SeriesDeletionDeletion
 )
 
 // String returns string value of EventType.
@@ -65,6 +73,22 @@ func (e EventType) String() string {
 		return "BrokerConfigDeletion"
 	case DatabaseLimitsChanged:
 		return "DatabaseLimitsChanged"
+	case SeriesDeletionChanged:
+		return "SeriesDeletionChanged"
+	case UserChanged:
+		return "UserChanged"
+	case UserDeletion:
+		return "UserDeletion"
+	case RoleChanged:
+		return "RoleChanged"
+	case RoleDeletion:
+		return "RoleDeletion"
+	case APITokenChanged:
+		return "APITokenChanged"
+	case APITokenDeletion:
+		return "APITokenDeletion"
+	case SeriesDeletionDeletion:
+		return "SeriesDeletionDeletion"
 	default:
 		return "unknown"
 	}
@@ -39,4 +39,12 @@ func TestEventType_String(t *testing.T) {
 	assert.Equal(t, "BrokerConfigDeletion", BrokerConfigDeletion.String())
 	assert.Equal(t, "BrokerConfigChanged", BrokerConfigChanged.String())
 	assert.Equal(t, "DatabaseLimitsChanged", DatabaseLimitsChanged.String())
+	assert.Equal(t, "SeriesDeletionChanged", SeriesDeletionChanged.String())
+	assert.Equal(t, "UserChanged", UserChanged.String())
+	assert.Equal(t, "UserDeletion", UserDeletion.String())
+	assert.Equal(t, "RoleChanged", RoleChanged.String())
+	assert.Equal(t, "RoleDeletion", RoleDeletion.String())
+	assert.Equal(t, "APITokenChanged", APITokenChanged.String())
+	assert.Equal(t, "APITokenDeletion", APITokenDeletion.String())
+	assert.Equal(t, "SeriesDeletionDeletion", SeriesDeletionDeletion.String())
 }
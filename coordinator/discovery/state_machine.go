@@ -49,6 +49,10 @@ const (
 	BrokerConfigStateMachine
 	BrokerNodeStateMachine
 	DatabaseLimitsStateMachine
+	SeriesDeletionStateMachine
+	UserStateMachine
+	RoleStateMachine
+	APITokenStateMachine
 )
 
 // String returns state machine type desc.
@@ -72,6 +76,14 @@ func (st StateMachineType) String() string {
 		return "BrokerNodeStateMachine"
 	case DatabaseLimitsStateMachine:
 		return "DatabaseLimitsStateMachine"
+	case SeriesDeletionStateMachine:
+		return "SeriesDeletionStateMachine"
+	case UserStateMachine:
+		return "UserStateMachine"
+	case RoleStateMachine:
+		return "RoleStateMachine"
+	case APITokenStateMachine:
+		return "APITokenStateMachine"
 	default:
 		return "Unknown"
 	}
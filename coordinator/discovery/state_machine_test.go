@@ -41,6 +41,10 @@ func TestStateMachineType_String(t *testing.T) {
 	assert.Equal(t, BrokerConfigStateMachine.String(), "BrokerConfigStateMachine")
 	assert.Equal(t, BrokerNodeStateMachine.String(), "BrokerNodeStateMachine")
 	assert.Equal(t, DatabaseLimitsStateMachine.String(), "DatabaseLimitsStateMachine")
+	assert.Equal(t, SeriesDeletionStateMachine.String(), "SeriesDeletionStateMachine")
+	assert.Equal(t, UserStateMachine.String(), "UserStateMachine")
+	assert.Equal(t, RoleStateMachine.String(), "RoleStateMachine")
+	assert.Equal(t, APITokenStateMachine.String(), "APITokenStateMachine")
 }
 
 func TestNewMockStateMachine(t *testing.T) {
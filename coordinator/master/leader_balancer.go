@@ -0,0 +1,350 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+//go:generate mockgen -source=./leader_balancer.go -destination=./leader_balancer_mock.go -package=master
+
+// for testing
+var (
+	leaderTransferTimeout = 10 * time.Minute
+)
+
+var (
+	errLeaderChanged         = errors.New("leader of shard changed")
+	errTargetDecommissioning = errors.New("target replica node is decommissioning")
+	errReplicaNotInSync      = errors.New("target replica not in sync with leader")
+	errLeaderTransferTimeout = errors.New("wait target replica catching up timeout")
+)
+
+// LeaderBalancer represents the shard leader balancer which transfers the leadership of shard to target replica,
+// after target replica catches up the write ahead log of current leader. It executes the leader transfers submitted
+// by user, and moves the leadership back to the preferred replica if enabled, for example, after rolling restart.
+type LeaderBalancer interface {
+	// Start starts the leader balancer, checks the shard leaders periodically.
+	Start()
+	// Stop stops the leader balancer.
+	Stop()
+}
+
+// leaderBalancer implements LeaderBalancer interface.
+type leaderBalancer struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	cfg      config.Rebalance
+	stateMgr StateManager
+	repo     state.Repository
+	cli      client.ReplicaCli
+
+	wait   sync.WaitGroup
+	logger *logger.Logger
+}
+
+// NewLeaderBalancer creates a shard leader balancer instance.
+func NewLeaderBalancer(
+	ctx context.Context,
+	cfg config.Rebalance,
+	stateMgr StateManager,
+	repo state.Repository,
+	cli client.ReplicaCli,
+) LeaderBalancer {
+	c, cancel := context.WithCancel(ctx)
+	return &leaderBalancer{
+		ctx:      c,
+		cancel:   cancel,
+		cfg:      cfg,
+		stateMgr: stateMgr,
+		repo:     repo,
+		cli:      cli,
+		logger:   logger.GetLogger("Master", "LeaderBalancer"),
+	}
+}
+
+// Start starts the leader balancer, checks the shard leaders periodically.
+func (b *leaderBalancer) Start() {
+	b.wait.Add(1)
+	go func() {
+		defer b.wait.Done()
+
+		ticker := time.NewTicker(b.cfg.CheckInterval.Duration())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-b.ctx.Done():
+				return
+			case <-ticker.C:
+				b.check()
+			}
+		}
+	}()
+	b.logger.Info("shard leader balancer started")
+}
+
+// Stop stops the leader balancer.
+func (b *leaderBalancer) Stop() {
+	b.cancel()
+	b.wait.Wait()
+	b.logger.Info("shard leader balancer stopped")
+}
+
+// check transfers the leaders of shards which have running leader transfer,
+// or whose leader isn't the preferred replica if preferred leader enabled.
+func (b *leaderBalancer) check() {
+	now := timeutil.Now()
+	transfers := b.listTransfers()
+	storages := b.stateMgr.SnapshotStorageStates()
+	for _, storage := range storages {
+		// cache replica state of database on leader node, key: leader/database
+		replicaStates := make(map[string][]models.FamilyLogReplicaState)
+		databases := make([]string, 0, len(storage.ShardStates))
+		for db := range storage.ShardStates {
+			databases = append(databases, db)
+		}
+		sort.Strings(databases)
+		for _, db := range databases {
+			shardStates := storage.ShardStates[db]
+			shardIDs := make([]models.ShardID, 0, len(shardStates))
+			for shardID := range shardStates {
+				shardIDs = append(shardIDs, shardID)
+			}
+			sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+			for _, shardID := range shardIDs {
+				key := models.ReplicaMigrationKey(db, shardID)
+				transfer := transfers[key]
+				delete(transfers, key)
+				if transfer == nil && !b.cfg.PreferredLeader {
+					continue
+				}
+				b.transferLeader(storage, db, shardStates[shardID], transfer, replicaStates, now)
+			}
+		}
+	}
+	for _, transfer := range transfers {
+		// shard of transfer not found, maybe database is dropped
+		b.waitOrFail(transfer, constants.ErrShardNotFound, now)
+	}
+}
+
+// transferLeader transfers the leader of shard to target replica if target replica is in sync with current leader,
+// the target is the preferred replica if no transfer submitted by user.
+func (b *leaderBalancer) transferLeader(storage *models.StorageState, database string, shardState models.ShardState,
+	transfer *models.LeaderTransfer, replicaStates map[string][]models.FamilyLogReplicaState, now int64,
+) {
+	if transfer != nil {
+		transfer.Source = shardState.Leader
+	}
+	target, err := b.getTarget(storage, database, shardState, transfer)
+	if err != nil {
+		if transfer != nil {
+			b.fail(transfer, err)
+		}
+		return
+	}
+	if target == shardState.Leader {
+		if transfer != nil {
+			transfer.Target = target
+			b.complete(transfer)
+		}
+		return
+	}
+	lag, err := b.getLag(storage, database, shardState, target, replicaStates)
+	if err == nil && lag > b.cfg.LeaderMaxLag {
+		err = fmt.Errorf("%w, lag: %d", errReplicaNotInSync, lag)
+	}
+	if err == nil {
+		err = b.stateMgr.TransferLeader(database, shardState.ID, shardState.Leader, target)
+		if err == nil {
+			b.logger.Info("shard leader transferred",
+				logger.String("db", database),
+				logger.Any("shard", shardState.ID),
+				logger.Any("source", shardState.Leader),
+				logger.Any("target", target))
+			if transfer != nil {
+				transfer.Target = target
+				b.complete(transfer)
+			}
+			return
+		}
+	}
+	if transfer == nil {
+		b.logger.Debug("shard leader isn't preferred replica, wait replica catching up",
+			logger.String("db", database),
+			logger.Any("shard", shardState.ID),
+			logger.Any("leader", shardState.Leader),
+			logger.Any("preferred", target),
+			logger.Error(err))
+		return
+	}
+	transfer.Lag = lag
+	b.waitOrFail(transfer, err, now)
+}
+
+// getTarget returns the target replica of leader transfer, returns the preferred replica if target not set.
+func (b *leaderBalancer) getTarget(storage *models.StorageState, database string,
+	shardState models.ShardState, transfer *models.LeaderTransfer,
+) (models.NodeID, error) {
+	if shardState.State != models.OnlineShard {
+		return models.NoLeader, errLeaderNotAlive
+	}
+	if transfer == nil || transfer.Target == models.NoLeader {
+		return b.stateMgr.PreferredLeader(database, shardState.ID)
+	}
+	target := transfer.Target
+	if !shardState.Replica.Contain(target) {
+		return models.NoLeader, constants.ErrReplicaNotFound
+	}
+	if _, ok := storage.LiveNodes[target]; !ok {
+		return models.NoLeader, errTargetNotAlive
+	}
+	if storage.IsDecommissioning(target) {
+		return models.NoLeader, errTargetDecommissioning
+	}
+	return target, nil
+}
+
+// getLag returns the max number of write ahead log messages which target replica hasn't acknowledged,
+// in the families written by current leader of shard.
+func (b *leaderBalancer) getLag(storage *models.StorageState, database string, shardState models.ShardState,
+	target models.NodeID, replicaStates map[string][]models.FamilyLogReplicaState,
+) (int64, error) {
+	leader, ok := storage.LiveNodes[shardState.Leader]
+	if !ok {
+		return 0, errLeaderNotAlive
+	}
+	key := fmt.Sprintf("%d/%s", leader.ID, database)
+	families, ok := replicaStates[key]
+	if !ok {
+		rs, err := b.cli.GetReplicaState(leader.HTTPAddress(), database)
+		if err != nil {
+			return 0, err
+		}
+		families = rs
+		replicaStates[key] = families
+	}
+	var lag int64
+	for _, family := range families {
+		if family.ShardID != shardState.ID || family.Leader != leader.ID {
+			continue
+		}
+		found := false
+		for _, replicator := range family.Replicators {
+			if replicator.Replicator != target.String() {
+				continue
+			}
+			if replicator.State != models.ReplicatorReadyState {
+				return 0, fmt.Errorf("%w, family: %s, replicator state: %s",
+					errReplicaNotInSync, family.FamilyTime, replicator.State)
+			}
+			if l := family.Append - replicator.ACK; l > lag {
+				lag = l
+			}
+			found = true
+		}
+		if !found {
+			return 0, fmt.Errorf("%w, family: %s, replicator not found", errReplicaNotInSync, family.FamilyTime)
+		}
+	}
+	return lag, nil
+}
+
+// listTransfers returns the running leader transfers, key: database/shard.
+func (b *leaderBalancer) listTransfers() map[string]*models.LeaderTransfer {
+	transfers := make(map[string]*models.LeaderTransfer)
+	data, err := b.repo.List(b.ctx, constants.LeaderTransferPath)
+	if err != nil {
+		b.logger.Warn("list leader transfers failure", logger.Error(err))
+		return transfers
+	}
+	for _, val := range data {
+		transfer := &models.LeaderTransfer{}
+		if err := encoding.JSONUnmarshal(val.Value, transfer); err != nil {
+			b.logger.Warn("unmarshal leader transfer failure",
+				logger.String("key", val.Key), logger.Error(err))
+			continue
+		}
+		if transfer.State.IsTerminal() {
+			continue
+		}
+		transfers[transfer.Key()] = transfer
+	}
+	return transfers
+}
+
+// waitOrFail marks the leader transfer catching up, or fails it after timeout.
+func (b *leaderBalancer) waitOrFail(transfer *models.LeaderTransfer, err error, now int64) {
+	if now-transfer.CreateTime > leaderTransferTimeout.Milliseconds() {
+		if err == nil {
+			err = errLeaderTransferTimeout
+		} else {
+			err = fmt.Errorf("%w: %s", errLeaderTransferTimeout, err)
+		}
+		b.fail(transfer, err)
+		return
+	}
+	transfer.State = models.LeaderTransferCatchingUp
+	transfer.ErrMsg = ""
+	if err != nil {
+		transfer.ErrMsg = err.Error()
+	}
+	b.save(transfer)
+}
+
+// complete marks the leader transfer done.
+func (b *leaderBalancer) complete(transfer *models.LeaderTransfer) {
+	transfer.State = models.LeaderTransferDone
+	transfer.Lag = 0
+	transfer.ErrMsg = ""
+	b.save(transfer)
+}
+
+// fail marks the leader transfer failure.
+func (b *leaderBalancer) fail(transfer *models.LeaderTransfer, err error) {
+	b.logger.Warn("shard leader transfer failure",
+		logger.String("transfer", transfer.String()), logger.Error(err))
+	transfer.State = models.LeaderTransferFailed
+	transfer.ErrMsg = err.Error()
+	b.save(transfer)
+}
+
+// save persists the leader transfer into state repo.
+func (b *leaderBalancer) save(transfer *models.LeaderTransfer) {
+	transfer.UpdateTime = timeutil.Now()
+	if err := b.repo.Put(b.ctx, constants.GetLeaderTransferPath(transfer.Database, int(transfer.ShardID)),
+		encoding.JSONMarshal(transfer)); err != nil {
+		b.logger.Warn("save leader transfer failure",
+			logger.String("transfer", transfer.String()), logger.Error(err))
+	}
+}
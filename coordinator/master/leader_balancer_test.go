@@ -0,0 +1,296 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func newLeaderBalanceState() *models.StorageState {
+	storageState := models.NewStorageState("test")
+	for _, id := range []models.NodeID{1, 2, 3} {
+		storageState.NodeOnline(models.StatefulNode{
+			ID:            id,
+			StatelessNode: models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: uint16(9000 + id)},
+		})
+	}
+	storageState.ShardStates["db"] = map[models.ShardID]models.ShardState{
+		1: {ID: 1, State: models.OnlineShard, Leader: 2, Replica: models.Replica{Replicas: []models.NodeID{1, 2, 3}}},
+		2: {ID: 2, State: models.OnlineShard, Leader: 2, Replica: models.Replica{Replicas: []models.NodeID{2, 1}}},
+	}
+	return storageState
+}
+
+func newFamilyReplicaState(shardID models.ShardID, leader models.NodeID, appendSeq int64,
+	replicators ...models.ReplicaPeerState,
+) models.FamilyLogReplicaState {
+	return models.FamilyLogReplicaState{
+		ShardID:     shardID,
+		FamilyTime:  "20221018",
+		Leader:      leader,
+		Append:      appendSeq,
+		Replicators: replicators,
+	}
+}
+
+func TestLeaderBalancer_Start_Stop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := NewMockStateManager(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	b := NewLeaderBalancer(context.TODO(), config.Rebalance{
+		CheckInterval: ltoml.Duration(time.Millisecond),
+	}, stateMgr, repo, nil)
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err")).AnyTimes()
+	stateMgr.EXPECT().SnapshotStorageStates().Return(nil).AnyTimes()
+	b.Start()
+	time.Sleep(10 * time.Millisecond)
+	b.Stop()
+}
+
+func TestLeaderBalancer_listTransfers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	b := NewLeaderBalancer(context.TODO(), config.Rebalance{}, nil, repo, nil).(*leaderBalancer)
+	repo.EXPECT().List(gomock.Any(), constants.LeaderTransferPath).Return([]state.KeyValue{
+		{Key: "a", Value: []byte("abc")},
+		{Key: "b", Value: encoding.JSONMarshal(&models.LeaderTransfer{Database: "db", ShardID: 1, State: models.LeaderTransferDone})},
+		{Key: "c", Value: encoding.JSONMarshal(&models.LeaderTransfer{Database: "db", ShardID: 2, State: models.LeaderTransferPending})},
+	}, nil)
+	transfers := b.listTransfers()
+	assert.Len(t, transfers, 1)
+	assert.Equal(t, models.ShardID(2), transfers["db/2"].ShardID)
+}
+
+func TestLeaderBalancer_check(t *testing.T) {
+	defer func() {
+		leaderTransferTimeout = 10 * time.Minute
+	}()
+	now := timeutil.Now()
+	inSync := func(nodeID string) models.ReplicaPeerState {
+		return models.ReplicaPeerState{Replicator: nodeID, ACK: 100, State: models.ReplicatorReadyState}
+	}
+	newTransfer := func(shardID models.ShardID, target models.NodeID) *models.LeaderTransfer {
+		return &models.LeaderTransfer{Database: "db", ShardID: shardID, Source: 2, Target: target,
+			State: models.LeaderTransferPending, CreateTime: now}
+	}
+
+	cases := []struct {
+		name      string
+		preferred bool
+		transfers []*models.LeaderTransfer
+		prepare   func(stateMgr *MockStateManager, cli *client.MockReplicaCli)
+		states    map[string]models.LeaderTransferState
+	}{
+		{
+			name: "preferred leader disabled",
+		},
+		{
+			name:      "preferred leader in sync",
+			preferred: true,
+			prepare: func(stateMgr *MockStateManager, cli *client.MockReplicaCli) {
+				stateMgr.EXPECT().PreferredLeader("db", models.ShardID(1)).Return(models.NodeID(1), nil)
+				stateMgr.EXPECT().PreferredLeader("db", models.ShardID(2)).Return(models.NodeID(2), nil)
+				cli.EXPECT().GetReplicaState("http://127.0.0.1:9002", "db").Return([]models.FamilyLogReplicaState{
+					newFamilyReplicaState(1, 2, 105, inSync("1"), inSync("3")),
+					newFamilyReplicaState(1, 3, 200), // written by previous leader
+					newFamilyReplicaState(2, 2, 200),
+				}, nil)
+				stateMgr.EXPECT().TransferLeader("db", models.ShardID(1), models.NodeID(2), models.NodeID(1)).Return(nil)
+			},
+		},
+		{
+			name:      "preferred leader not in sync",
+			preferred: true,
+			prepare: func(stateMgr *MockStateManager, cli *client.MockReplicaCli) {
+				stateMgr.EXPECT().PreferredLeader("db", models.ShardID(1)).Return(models.NodeID(1), nil)
+				stateMgr.EXPECT().PreferredLeader("db", models.ShardID(2)).Return(models.NodeID(1), nil)
+				cli.EXPECT().GetReplicaState("http://127.0.0.1:9002", "db").Return([]models.FamilyLogReplicaState{
+					newFamilyReplicaState(1, 2, 200, inSync("1")),
+					newFamilyReplicaState(2, 2, 100, models.ReplicaPeerState{Replicator: "1", State: models.ReplicatorFailureState}),
+				}, nil)
+			},
+		},
+		{
+			name:      "elect preferred leader failure",
+			preferred: true,
+			prepare: func(stateMgr *MockStateManager, cli *client.MockReplicaCli) {
+				stateMgr.EXPECT().PreferredLeader("db", gomock.Any()).Return(models.NoLeader, constants.ErrNoLiveReplica).Times(2)
+			},
+		},
+		{
+			name:      "get replica state failure",
+			transfers: []*models.LeaderTransfer{newTransfer(1, 1)},
+			prepare: func(stateMgr *MockStateManager, cli *client.MockReplicaCli) {
+				cli.EXPECT().GetReplicaState("http://127.0.0.1:9002", "db").Return(nil, fmt.Errorf("err"))
+			},
+			states: map[string]models.LeaderTransferState{"db/1": models.LeaderTransferCatchingUp},
+		},
+		{
+			name:      "replicator not found",
+			transfers: []*models.LeaderTransfer{newTransfer(1, 1)},
+			prepare: func(stateMgr *MockStateManager, cli *client.MockReplicaCli) {
+				cli.EXPECT().GetReplicaState("http://127.0.0.1:9002", "db").Return([]models.FamilyLogReplicaState{
+					newFamilyReplicaState(1, 2, 100, inSync("3")),
+				}, nil)
+			},
+			states: map[string]models.LeaderTransferState{"db/1": models.LeaderTransferCatchingUp},
+		},
+		{
+			name:      "transfer to target replica",
+			transfers: []*models.LeaderTransfer{newTransfer(1, 3), newTransfer(2, 2)},
+			prepare: func(stateMgr *MockStateManager, cli *client.MockReplicaCli) {
+				cli.EXPECT().GetReplicaState("http://127.0.0.1:9002", "db").Return([]models.FamilyLogReplicaState{
+					newFamilyReplicaState(1, 2, 100, inSync("1"), inSync("3")),
+				}, nil)
+				stateMgr.EXPECT().TransferLeader("db", models.ShardID(1), models.NodeID(2), models.NodeID(3)).Return(nil)
+			},
+			states: map[string]models.LeaderTransferState{
+				"db/1": models.LeaderTransferDone,
+				"db/2": models.LeaderTransferDone, // target is leader already
+			},
+		},
+		{
+			name:      "transfer to preferred replica",
+			transfers: []*models.LeaderTransfer{newTransfer(1, models.NoLeader)},
+			prepare: func(stateMgr *MockStateManager, cli *client.MockReplicaCli) {
+				stateMgr.EXPECT().PreferredLeader("db", models.ShardID(1)).Return(models.NodeID(1), nil)
+				cli.EXPECT().GetReplicaState("http://127.0.0.1:9002", "db").Return(nil, nil)
+				stateMgr.EXPECT().TransferLeader("db", models.ShardID(1), models.NodeID(2), models.NodeID(1)).Return(nil)
+			},
+			states: map[string]models.LeaderTransferState{"db/1": models.LeaderTransferDone},
+		},
+		{
+			name:      "transfer leader failure",
+			transfers: []*models.LeaderTransfer{newTransfer(1, 1)},
+			prepare: func(stateMgr *MockStateManager, cli *client.MockReplicaCli) {
+				cli.EXPECT().GetReplicaState("http://127.0.0.1:9002", "db").Return(nil, nil)
+				stateMgr.EXPECT().TransferLeader("db", models.ShardID(1), models.NodeID(2), models.NodeID(1)).Return(errLeaderChanged)
+			},
+			states: map[string]models.LeaderTransferState{"db/1": models.LeaderTransferCatchingUp},
+		},
+		{
+			name:      "invalid target",
+			transfers: []*models.LeaderTransfer{newTransfer(1, 4), newTransfer(2, 3)},
+			states: map[string]models.LeaderTransferState{
+				"db/1": models.LeaderTransferFailed,
+				"db/2": models.LeaderTransferFailed,
+			},
+		},
+		{
+			name: "shard not found",
+			transfers: []*models.LeaderTransfer{
+				{Database: "db2", ShardID: 1, State: models.LeaderTransferCatchingUp, CreateTime: now},
+				{Database: "db3", ShardID: 1, State: models.LeaderTransferCatchingUp, CreateTime: now - time.Hour.Milliseconds()},
+			},
+			states: map[string]models.LeaderTransferState{
+				"db2/1": models.LeaderTransferCatchingUp,
+				"db3/1": models.LeaderTransferFailed,
+			},
+		},
+		{
+			name: "catching up timeout",
+			transfers: []*models.LeaderTransfer{
+				{Database: "db", ShardID: 1, Target: 1, State: models.LeaderTransferCatchingUp, CreateTime: now - time.Hour.Milliseconds()},
+			},
+			prepare: func(stateMgr *MockStateManager, cli *client.MockReplicaCli) {
+				cli.EXPECT().GetReplicaState("http://127.0.0.1:9002", "db").Return([]models.FamilyLogReplicaState{
+					newFamilyReplicaState(1, 2, 200, inSync("1")),
+				}, nil)
+			},
+			states: map[string]models.LeaderTransferState{"db/1": models.LeaderTransferFailed},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stateMgr := NewMockStateManager(ctrl)
+			repo := state.NewMockRepository(ctrl)
+			cli := client.NewMockReplicaCli(ctrl)
+			b := NewLeaderBalancer(context.TODO(), config.Rebalance{
+				PreferredLeader: tt.preferred,
+				LeaderMaxLag:    8,
+			}, stateMgr, repo, cli).(*leaderBalancer)
+
+			var kvs []state.KeyValue
+			for _, transfer := range tt.transfers {
+				kvs = append(kvs, state.KeyValue{Key: transfer.Key(), Value: encoding.JSONMarshal(transfer)})
+			}
+			repo.EXPECT().List(gomock.Any(), constants.LeaderTransferPath).Return(kvs, nil)
+			stateMgr.EXPECT().SnapshotStorageStates().Return([]*models.StorageState{newLeaderBalanceState()})
+			states := make(map[string]models.LeaderTransferState)
+			repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, key string, data []byte) error {
+					transfer := &models.LeaderTransfer{}
+					assert.NoError(t, encoding.JSONUnmarshal(data, transfer))
+					assert.Equal(t, constants.GetLeaderTransferPath(transfer.Database, int(transfer.ShardID)), key)
+					if transfer.State == models.LeaderTransferCatchingUp {
+						assert.NotEmpty(t, transfer.ErrMsg)
+					}
+					states[transfer.Key()] = transfer.State
+					return fmt.Errorf("err")
+				}).AnyTimes()
+			if tt.prepare != nil {
+				tt.prepare(stateMgr, cli)
+			}
+			b.check()
+			if len(tt.states) == 0 {
+				assert.Empty(t, states)
+			} else {
+				assert.Equal(t, tt.states, states)
+			}
+		})
+	}
+}
+
+func TestLeaderBalancer_getTarget(t *testing.T) {
+	b := NewLeaderBalancer(context.TODO(), config.Rebalance{}, nil, nil, nil).(*leaderBalancer)
+	storageState := newLeaderBalanceState()
+	shardState := storageState.ShardStates["db"][1]
+	storageState.Decommissions = map[models.NodeID]*models.NodeDecommission{3: {NodeID: 3}}
+	_, err := b.getTarget(storageState, "db", shardState, &models.LeaderTransfer{Target: 3})
+	assert.Equal(t, errTargetDecommissioning, err)
+	storageState.NodeOffline(3)
+	_, err = b.getTarget(storageState, "db", shardState, &models.LeaderTransfer{Target: 3})
+	assert.Equal(t, errTargetNotAlive, err)
+	_, err = b.getTarget(storageState, "db", models.ShardState{State: models.OfflineShard}, nil)
+	assert.Equal(t, errLeaderNotAlive, err)
+	_, err = b.getLag(storageState, "db", models.ShardState{Leader: 4}, 1, nil)
+	assert.Equal(t, errLeaderNotAlive, err)
+}
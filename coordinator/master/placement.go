@@ -0,0 +1,182 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"sort"
+
+	"github.com/lindb/lindb/models"
+)
+
+// Replica placement reference kafka rack aware assignment,
+// storage nodes advertise their failure domain(zone/rack), replicas of shard are spread over zones first, then racks.
+
+// hasLocation returns if any storage node advertises its location.
+func hasLocation(storageNodes []models.StatefulNode) bool {
+	for idx := range storageNodes {
+		if !storageNodes[idx].Location().IsEmpty() {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeLocations returns the location of each storage node.
+func nodeLocations(storageNodes []models.StatefulNode) map[models.NodeID]models.NodeLocation {
+	locations := make(map[models.NodeID]models.NodeLocation, len(storageNodes))
+	for idx := range storageNodes {
+		node := storageNodes[idx]
+		locations[node.ID] = node.Location()
+	}
+	return locations
+}
+
+// rackAlternatedNodes returns the node list which zones/racks are alternated,
+// e.g. zone1(rack1:1,2;rack2:3) zone2(rack3:4,5) => 1,4,3,5,2
+func rackAlternatedNodes(storageNodes []models.StatefulNode) []models.NodeID {
+	// zone => rack => node list
+	zones := make(map[string]map[string][]models.NodeID)
+	for idx := range storageNodes {
+		node := storageNodes[idx]
+		racks, ok := zones[node.Zone]
+		if !ok {
+			racks = make(map[string][]models.NodeID)
+			zones[node.Zone] = racks
+		}
+		racks[node.Rack] = append(racks[node.Rack], node.ID)
+	}
+	zoneNames := make([]string, 0, len(zones))
+	for zone := range zones {
+		zoneNames = append(zoneNames, zone)
+	}
+	sort.Strings(zoneNames)
+	zoneNodes := make([][]models.NodeID, 0, len(zones))
+	for _, zone := range zoneNames {
+		racks := zones[zone]
+		rackNames := make([]string, 0, len(racks))
+		for rack := range racks {
+			rackNames = append(rackNames, rack)
+		}
+		sort.Strings(rackNames)
+		rackNodes := make([][]models.NodeID, 0, len(racks))
+		for _, rack := range rackNames {
+			nodes := racks[rack]
+			sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+			rackNodes = append(rackNodes, nodes)
+		}
+		zoneNodes = append(zoneNodes, interleave(rackNodes))
+	}
+	return interleave(zoneNodes)
+}
+
+// interleave picks node from each list by turns.
+func interleave(lists [][]models.NodeID) (rs []models.NodeID) {
+	for i := 0; ; i++ {
+		picked := false
+		for _, list := range lists {
+			if i < len(list) {
+				rs = append(rs, list[i])
+				picked = true
+			}
+		}
+		if !picked {
+			return rs
+		}
+	}
+}
+
+// replicaSpread tracks the zones/racks which replicas of shard spread over.
+type replicaSpread struct {
+	locations map[models.NodeID]models.NodeLocation
+	numOfZone int
+	numOfRack int
+
+	zones map[string]struct{}
+	racks map[string]struct{}
+	nodes map[models.NodeID]struct{}
+}
+
+// newReplicaSpread creates a replica spread tracker based on the location of storage nodes.
+func newReplicaSpread(locations map[models.NodeID]models.NodeLocation) *replicaSpread {
+	zones := make(map[string]struct{})
+	racks := make(map[string]struct{})
+	for _, location := range locations {
+		zones[location.Zone] = struct{}{}
+		racks[location.RackKey()] = struct{}{}
+	}
+	return &replicaSpread{
+		locations: locations,
+		numOfZone: len(zones),
+		numOfRack: len(racks),
+		zones:     make(map[string]struct{}),
+		racks:     make(map[string]struct{}),
+		nodes:     make(map[models.NodeID]struct{}),
+	}
+}
+
+// add adds the replica on node.
+func (s *replicaSpread) add(nodeID models.NodeID) {
+	location := s.locations[nodeID]
+	s.zones[location.Zone] = struct{}{}
+	s.racks[location.RackKey()] = struct{}{}
+	s.nodes[nodeID] = struct{}{}
+}
+
+// accept returns if the replica can be placed on node, node is skipped if
+// 1. node already has replica;
+// 2. there is already a replica in the same zone AND there is one or more zones that do not have any replica;
+// 3. there is already a replica in the same rack AND there is one or more racks that do not have any replica.
+func (s *replicaSpread) accept(nodeID models.NodeID) bool {
+	if _, ok := s.nodes[nodeID]; ok {
+		return false
+	}
+	location := s.locations[nodeID]
+	if _, ok := s.zones[location.Zone]; ok && len(s.zones) < s.numOfZone {
+		return false
+	}
+	if _, ok := s.racks[location.RackKey()]; ok && len(s.racks) < s.numOfRack {
+		return false
+	}
+	return true
+}
+
+// spreadScore returns the num. of zones/racks which replicas spread over, unknown location(offline node) is ignored.
+func spreadScore(replicas []models.NodeID, liveNodes map[models.NodeID]models.StatefulNode) (numOfZone, numOfRack int) {
+	zones := make(map[string]struct{})
+	racks := make(map[string]struct{})
+	for _, nodeID := range replicas {
+		node, ok := liveNodes[nodeID]
+		if !ok {
+			continue
+		}
+		location := node.Location()
+		zones[location.Zone] = struct{}{}
+		racks[location.RackKey()] = struct{}{}
+	}
+	return len(zones), len(racks)
+}
+
+// lessSpread returns if the spread(zones/racks) of replicas a is less than b.
+func lessSpread(a, b []models.NodeID, liveNodes map[models.NodeID]models.StatefulNode) bool {
+	zonesA, racksA := spreadScore(a, liveNodes)
+	zonesB, racksB := spreadScore(b, liveNodes)
+	if zonesA != zonesB {
+		return zonesA < zonesB
+	}
+	return racksA < racksB
+}
@@ -0,0 +1,81 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+)
+
+func TestPlacement_hasLocation(t *testing.T) {
+	assert.False(t, hasLocation(newStatefulNodes(1, 2)))
+	assert.True(t, hasLocation([]models.StatefulNode{{ID: 1}, {ID: 2, Rack: "r1"}}))
+}
+
+func TestPlacement_rackAlternatedNodes(t *testing.T) {
+	nodes := []models.StatefulNode{
+		{ID: 5, Zone: "z2", Rack: "r3"},
+		{ID: 2, Zone: "z1", Rack: "r1"},
+		{ID: 3, Zone: "z1", Rack: "r2"},
+		{ID: 1, Zone: "z1", Rack: "r1"},
+		{ID: 4, Zone: "z2", Rack: "r3"},
+	}
+	assert.Equal(t, []models.NodeID{1, 4, 3, 5, 2}, rackAlternatedNodes(nodes))
+	// node without location
+	assert.Equal(t, []models.NodeID{1, 3, 2}, rackAlternatedNodes([]models.StatefulNode{
+		{ID: 2}, {ID: 1}, {ID: 3, Zone: "z1"},
+	}))
+	assert.Empty(t, rackAlternatedNodes(nil))
+}
+
+func TestPlacement_replicaSpread(t *testing.T) {
+	spread := newReplicaSpread(nodeLocations([]models.StatefulNode{
+		{ID: 1, Zone: "z1", Rack: "r1"},
+		{ID: 2, Zone: "z1", Rack: "r1"},
+		{ID: 3, Zone: "z1", Rack: "r2"},
+		{ID: 4, Zone: "z2", Rack: "r1"},
+	}))
+	spread.add(1)
+	assert.False(t, spread.accept(1))
+	assert.False(t, spread.accept(2))
+	assert.False(t, spread.accept(3)) // zone z2 has no replica
+	assert.True(t, spread.accept(4))
+	spread.add(4)
+	assert.False(t, spread.accept(2)) // rack z1/r2 has no replica
+	assert.True(t, spread.accept(3))
+	spread.add(3)
+	assert.True(t, spread.accept(2))
+}
+
+func TestPlacement_lessSpread(t *testing.T) {
+	liveNodes := map[models.NodeID]models.StatefulNode{
+		1: {ID: 1, Zone: "z1", Rack: "r1"},
+		2: {ID: 2, Zone: "z1", Rack: "r2"},
+		3: {ID: 3, Zone: "z2", Rack: "r1"},
+	}
+	zones, racks := spreadScore([]models.NodeID{1, 2, 4}, liveNodes)
+	assert.Equal(t, 1, zones)
+	assert.Equal(t, 2, racks)
+	assert.True(t, lessSpread([]models.NodeID{1, 2}, []models.NodeID{1, 3}, liveNodes))
+	assert.True(t, lessSpread([]models.NodeID{1, 4}, []models.NodeID{1, 2}, liveNodes))
+	assert.False(t, lessSpread([]models.NodeID{1, 3}, []models.NodeID{2, 3}, liveNodes))
+	assert.Equal(t, []models.NodeID{1, 4, 3}, replaceReplica([]models.NodeID{1, 2, 3}, 2, 4))
+}
@@ -0,0 +1,216 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"sort"
+
+	"github.com/lindb/lindb/models"
+)
+
+const (
+	moveReasonNodeOffline  = "node offline"
+	moveReasonDecommission = "decommission"
+	moveReasonBalance      = "balance"
+)
+
+// ReplicaMove represents a replica move of database's shard from source node to target node.
+type ReplicaMove struct {
+	Database string
+	ShardID  models.ShardID
+	Source   models.NodeID
+	Target   models.NodeID
+	Reason   string
+}
+
+// planReplicaMoves plans the replica moves of storage cluster, returns limit moves at most.
+// 1. replicas on decommissioning/offline nodes are moved to the live node which keeps replicas spread over
+// the most zones/racks, then has the least replicas;
+// 2. if balance, replicas are moved from the node which has the most replicas to the node which has the least replicas,
+// until the difference of replica count between live nodes is not greater than 1,
+// the move which reduces the spread(zones/racks) of replicas is skipped.
+// Decommissioning nodes are never the target of moves.
+// Only one move is planned for each shard, shards in migrating or without leader are skipped.
+func planReplicaMoves(state *models.StorageState,
+	offlineNodes map[models.NodeID]struct{},
+	migrating []*models.ReplicaMigration,
+	limit int,
+	balance bool,
+) (moves []ReplicaMove) {
+	if limit <= 0 {
+		return nil
+	}
+	counts := make(map[models.NodeID]int)
+	var liveNodes []models.NodeID
+	for nodeID := range state.ElectableNodes() {
+		counts[nodeID] = 0
+		liveNodes = append(liveNodes, nodeID)
+	}
+	if len(liveNodes) == 0 {
+		return nil
+	}
+	sort.Slice(liveNodes, func(i, j int) bool { return liveNodes[i] < liveNodes[j] })
+
+	type shard struct {
+		database string
+		shardID  models.ShardID
+		replica  *models.Replica
+	}
+	var shards []shard
+	for name, shardAssignment := range state.ShardAssignments {
+		for shardID, replica := range shardAssignment.Shards {
+			shards = append(shards, shard{database: name, shardID: shardID, replica: replica})
+			for _, nodeID := range replica.Replicas {
+				if _, ok := counts[nodeID]; ok {
+					counts[nodeID]++
+				}
+			}
+		}
+	}
+	sort.Slice(shards, func(i, j int) bool {
+		if shards[i].database == shards[j].database {
+			return shards[i].shardID < shards[j].shardID
+		}
+		return shards[i].database < shards[j].database
+	})
+
+	busy := make(map[string]struct{})
+	move := func(s shard, source, target models.NodeID, reason string) {
+		moves = append(moves, ReplicaMove{
+			Database: s.database,
+			ShardID:  s.shardID,
+			Source:   source,
+			Target:   target,
+			Reason:   reason,
+		})
+		busy[models.ReplicaMigrationKey(s.database, s.shardID)] = struct{}{}
+		if _, ok := counts[source]; ok {
+			counts[source]--
+		}
+		counts[target]++
+	}
+	// replicas of migrating shards are counted as moved
+	for _, m := range migrating {
+		busy[m.Key()] = struct{}{}
+		if _, ok := counts[m.Source]; ok {
+			counts[m.Source]--
+		}
+		if _, ok := counts[m.Target]; ok {
+			counts[m.Target]++
+		}
+	}
+	movable := func(s shard) bool {
+		if _, ok := busy[models.ReplicaMigrationKey(s.database, s.shardID)]; ok {
+			return false
+		}
+		// leader exports the data of shard to target replica
+		shardState, ok := state.ShardStates[s.database][s.shardID]
+		if !ok || shardState.State != models.OnlineShard {
+			return false
+		}
+		_, ok = state.LiveNodes[shardState.Leader]
+		return ok
+	}
+	// bestTargetNode returns the live node which doesn't hold the replica of shard,
+	// keeps replicas spread over the most zones/racks after moved, then has the least replicas.
+	bestTargetNode := func(replica *models.Replica, source models.NodeID) (target models.NodeID, ok bool) {
+		var targetReplicas []models.NodeID
+		for _, nodeID := range liveNodes {
+			if replica.Contain(nodeID) {
+				continue
+			}
+			replicas := replaceReplica(replica.Replicas, source, nodeID)
+			if !ok || lessSpread(targetReplicas, replicas, state.LiveNodes) ||
+				(!lessSpread(replicas, targetReplicas, state.LiveNodes) && counts[nodeID] < counts[target]) {
+				target = nodeID
+				targetReplicas = replicas
+				ok = true
+			}
+		}
+		return
+	}
+
+	// 1. re-home replicas on decommissioning/offline nodes
+	for _, s := range shards {
+		if len(moves) >= limit {
+			return moves
+		}
+		if !movable(s) {
+			continue
+		}
+		for _, nodeID := range s.replica.Replicas {
+			reason := moveReasonDecommission
+			if !state.IsDecommissioning(nodeID) {
+				if _, offline := offlineNodes[nodeID]; !offline {
+					continue
+				}
+				reason = moveReasonNodeOffline
+			}
+			if target, ok := bestTargetNode(s.replica, nodeID); ok {
+				move(s, nodeID, target, reason)
+			}
+			break
+		}
+	}
+
+	// 2. balance replicas between live nodes
+	for balance && len(moves) < limit {
+		maxNode, minNode := liveNodes[0], liveNodes[0]
+		for _, nodeID := range liveNodes {
+			if counts[nodeID] > counts[maxNode] {
+				maxNode = nodeID
+			}
+			if counts[nodeID] < counts[minNode] {
+				minNode = nodeID
+			}
+		}
+		if counts[maxNode]-counts[minNode] <= 1 {
+			return moves
+		}
+		found := false
+		for _, s := range shards {
+			if !s.replica.Contain(maxNode) || s.replica.Contain(minNode) || !movable(s) {
+				continue
+			}
+			if lessSpread(replaceReplica(s.replica.Replicas, maxNode, minNode), s.replica.Replicas, state.LiveNodes) {
+				// keep replicas spread over zones/racks
+				continue
+			}
+			move(s, maxNode, minNode, moveReasonBalance)
+			found = true
+			break
+		}
+		if !found {
+			return moves
+		}
+	}
+	return moves
+}
+
+// replaceReplica returns the new replica list which source replaced by target.
+func replaceReplica(replicas []models.NodeID, source, target models.NodeID) []models.NodeID {
+	rs := make([]models.NodeID, 0, len(replicas))
+	for _, nodeID := range replicas {
+		if nodeID == source {
+			rs = append(rs, target)
+		} else {
+			rs = append(rs, nodeID)
+		}
+	}
+	return rs
+}
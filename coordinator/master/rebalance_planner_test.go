@@ -0,0 +1,209 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+)
+
+func newPlanState(liveNodes []models.NodeID, shards map[models.ShardID][]models.NodeID) *models.StorageState {
+	state := models.NewStorageState("test")
+	for _, nodeID := range liveNodes {
+		state.NodeOnline(models.StatefulNode{ID: nodeID})
+	}
+	shardAssignment := models.NewShardAssignment("db")
+	shardStates := make(map[models.ShardID]models.ShardState)
+	for shardID, replicas := range shards {
+		shardAssignment.Shards[shardID] = &models.Replica{Replicas: replicas}
+		shardState := models.ShardState{ID: shardID, State: models.OfflineShard, Leader: models.NoLeader}
+		for _, nodeID := range replicas {
+			if _, ok := state.LiveNodes[nodeID]; ok {
+				shardState.State = models.OnlineShard
+				shardState.Leader = nodeID
+				break
+			}
+		}
+		shardStates[shardID] = shardState
+	}
+	state.ShardAssignments["db"] = shardAssignment
+	state.ShardStates["db"] = shardStates
+	return state
+}
+
+func withZones(state *models.StorageState, zones map[models.NodeID]string) *models.StorageState {
+	for nodeID, zone := range zones {
+		node := state.LiveNodes[nodeID]
+		node.Zone = zone
+		state.LiveNodes[nodeID] = node
+	}
+	return state
+}
+
+func withDecommissions(state *models.StorageState, nodes ...models.NodeID) *models.StorageState {
+	state.Decommissions = make(map[models.NodeID]*models.NodeDecommission)
+	for _, nodeID := range nodes {
+		state.Decommissions[nodeID] = &models.NodeDecommission{Storage: state.Name, NodeID: nodeID}
+	}
+	return state
+}
+
+func TestPlanReplicaMoves(t *testing.T) {
+	cases := []struct {
+		name      string
+		state     *models.StorageState
+		offline   map[models.NodeID]struct{}
+		migrating []*models.ReplicaMigration
+		limit     int
+		noBalance bool
+		moves     []ReplicaMove
+	}{
+		{
+			name:  "no limit",
+			state: newPlanState([]models.NodeID{1}, nil),
+		},
+		{
+			name:  "no live nodes",
+			state: newPlanState(nil, map[models.ShardID][]models.NodeID{1: {1}}),
+			limit: 1,
+		},
+		{
+			name:  "balanced",
+			state: newPlanState([]models.NodeID{1, 2, 3}, map[models.ShardID][]models.NodeID{1: {1, 2}, 2: {2, 3}, 3: {3, 1}}),
+			limit: 10,
+		},
+		{
+			name:  "new node joined",
+			state: newPlanState([]models.NodeID{1, 2, 3}, map[models.ShardID][]models.NodeID{1: {1, 2}, 2: {2, 1}, 3: {1, 2}}),
+			limit: 10,
+			moves: []ReplicaMove{
+				{Database: "db", ShardID: 1, Source: 1, Target: 3, Reason: moveReasonBalance},
+				{Database: "db", ShardID: 2, Source: 2, Target: 3, Reason: moveReasonBalance},
+			},
+		},
+		{
+			name:  "new node joined, limit moves",
+			state: newPlanState([]models.NodeID{1, 2, 3}, map[models.ShardID][]models.NodeID{1: {1, 2}, 2: {2, 1}, 3: {1, 2}}),
+			limit: 1,
+			moves: []ReplicaMove{
+				{Database: "db", ShardID: 1, Source: 1, Target: 3, Reason: moveReasonBalance},
+			},
+		},
+		{
+			name:  "new node joined, shard in migrating",
+			state: newPlanState([]models.NodeID{1, 2, 3}, map[models.ShardID][]models.NodeID{1: {1, 2}, 2: {2, 1}, 3: {1, 2}}),
+			migrating: []*models.ReplicaMigration{
+				{Database: "db", ShardID: 1, Source: 1, Target: 3},
+			},
+			limit: 10,
+			moves: []ReplicaMove{
+				{Database: "db", ShardID: 2, Source: 2, Target: 3, Reason: moveReasonBalance},
+			},
+		},
+		{
+			name:    "offline node, replicas re-homed",
+			state:   newPlanState([]models.NodeID{1, 2, 4}, map[models.ShardID][]models.NodeID{1: {1, 3}, 2: {3, 2}, 3: {1, 2}}),
+			offline: map[models.NodeID]struct{}{3: {}},
+			limit:   10,
+			moves: []ReplicaMove{
+				{Database: "db", ShardID: 1, Source: 3, Target: 4, Reason: moveReasonNodeOffline},
+				{Database: "db", ShardID: 2, Source: 3, Target: 4, Reason: moveReasonNodeOffline},
+			},
+		},
+		{
+			name:    "offline node, no available target",
+			state:   newPlanState([]models.NodeID{1, 2}, map[models.ShardID][]models.NodeID{1: {1, 2, 3}}),
+			offline: map[models.NodeID]struct{}{3: {}},
+			limit:   10,
+		},
+		{
+			name:    "offline shard, no leader",
+			state:   newPlanState([]models.NodeID{1}, map[models.ShardID][]models.NodeID{1: {3}}),
+			offline: map[models.NodeID]struct{}{3: {}},
+			limit:   10,
+		},
+		{
+			name: "unbalanced, but no movable shard",
+			state: newPlanState([]models.NodeID{1, 2, 3},
+				map[models.ShardID][]models.NodeID{1: {1, 2, 3}, 2: {1, 2, 3}, 3: {1, 2}, 4: {1, 2}}),
+			limit: 10,
+			moves: []ReplicaMove{
+				{Database: "db", ShardID: 3, Source: 1, Target: 3, Reason: moveReasonBalance},
+			},
+		},
+		{
+			name: "offline node, replicas re-homed to other zone",
+			state: withZones(newPlanState([]models.NodeID{1, 2, 4, 5},
+				map[models.ShardID][]models.NodeID{1: {1, 3}, 2: {2, 5}, 3: {5, 2}}),
+				map[models.NodeID]string{1: "a", 2: "b", 4: "a", 5: "c"}),
+			offline: map[models.NodeID]struct{}{3: {}},
+			limit:   10,
+			moves: []ReplicaMove{
+				{Database: "db", ShardID: 1, Source: 3, Target: 2, Reason: moveReasonNodeOffline},
+				{Database: "db", ShardID: 2, Source: 2, Target: 4, Reason: moveReasonBalance},
+			},
+		},
+		{
+			name: "unbalanced, but move reduces zone spread",
+			state: withZones(newPlanState([]models.NodeID{1, 2, 3},
+				map[models.ShardID][]models.NodeID{1: {1, 2}, 2: {2, 1}, 3: {1, 2}}),
+				map[models.NodeID]string{1: "a", 2: "b", 3: "b"}),
+			limit: 10,
+		},
+		{
+			name: "decommissioning node, replicas re-homed",
+			state: withDecommissions(newPlanState([]models.NodeID{1, 2, 3},
+				map[models.ShardID][]models.NodeID{1: {1, 2}, 2: {2, 1}, 3: {1, 3}}), 1),
+			limit:     10,
+			noBalance: true,
+			moves: []ReplicaMove{
+				{Database: "db", ShardID: 1, Source: 1, Target: 3, Reason: moveReasonDecommission},
+				{Database: "db", ShardID: 2, Source: 1, Target: 3, Reason: moveReasonDecommission},
+				{Database: "db", ShardID: 3, Source: 1, Target: 2, Reason: moveReasonDecommission},
+			},
+		},
+		{
+			name: "decommissioning node is not target",
+			state: withDecommissions(newPlanState([]models.NodeID{1, 2, 3},
+				map[models.ShardID][]models.NodeID{1: {1, 2}, 2: {2, 1}, 3: {1, 2}}), 3),
+			limit: 10,
+		},
+		{
+			name: "all live nodes are decommissioning",
+			state: withDecommissions(newPlanState([]models.NodeID{1, 2},
+				map[models.ShardID][]models.NodeID{1: {1, 2}}), 1, 2),
+			limit: 10,
+		},
+		{
+			name:      "unbalanced, but balance disabled",
+			state:     newPlanState([]models.NodeID{1, 2, 3}, map[models.ShardID][]models.NodeID{1: {1, 2}, 2: {2, 1}, 3: {1, 2}}),
+			limit:     10,
+			noBalance: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			moves := planReplicaMoves(tt.state, tt.offline, tt.migrating, tt.limit, !tt.noBalance)
+			assert.Equal(t, tt.moves, moves)
+		})
+	}
+}
@@ -0,0 +1,533 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+//go:generate mockgen -source=./rebalancer.go -destination=./rebalancer_mock.go -package=master
+
+// for testing
+var (
+	exportCheckInterval = 5 * time.Second
+)
+
+var (
+	errMigrationInterrupted = errors.New("migration interrupted by master failover")
+	errTargetNotAlive       = errors.New("target replica node not alive")
+	errLeaderNotAlive       = errors.New("leader of shard not alive")
+)
+
+// Rebalancer represents the shard rebalancer which moves replicas between storage nodes,
+// when storage nodes join or leave the cluster, or storage nodes are decommissioned.
+type Rebalancer interface {
+	// Start starts the rebalancer, checks the shard assignment periodically.
+	Start()
+	// Stop stops the rebalancer, running migrations will be interrupted.
+	Stop()
+}
+
+// migration represents the running replica migration.
+type migration struct {
+	storage       string
+	leaderAddress string
+	task          *models.ReplicaMigration
+}
+
+// rebalancer implements Rebalancer interface.
+type rebalancer struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	cfg      config.Rebalance
+	stateMgr StateManager
+	repo     state.Repository
+	cli      client.MigrationCli
+
+	offlineNodes map[string]map[models.NodeID]int64 // storage => node => offline time(found by master)
+	migrations   map[string]*migration              // database/shard => running migration
+
+	wait   sync.WaitGroup
+	mutex  sync.Mutex
+	logger *logger.Logger
+}
+
+// NewRebalancer creates a shard rebalancer instance.
+func NewRebalancer(
+	ctx context.Context,
+	cfg config.Rebalance,
+	stateMgr StateManager,
+	repo state.Repository,
+	cli client.MigrationCli,
+) Rebalancer {
+	c, cancel := context.WithCancel(ctx)
+	return &rebalancer{
+		ctx:          c,
+		cancel:       cancel,
+		cfg:          cfg,
+		stateMgr:     stateMgr,
+		repo:         repo,
+		cli:          cli,
+		offlineNodes: make(map[string]map[models.NodeID]int64),
+		migrations:   make(map[string]*migration),
+		logger:       logger.GetLogger("Master", "Rebalancer"),
+	}
+}
+
+// Start starts the rebalancer, checks the shard assignment periodically.
+func (r *rebalancer) Start() {
+	r.recover()
+
+	r.wait.Add(1)
+	go func() {
+		defer r.wait.Done()
+
+		ticker := time.NewTicker(r.cfg.CheckInterval.Duration())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-ticker.C:
+				r.check()
+			}
+		}
+	}()
+	r.logger.Info("shard rebalancer started")
+}
+
+// Stop stops the rebalancer, running migrations will be interrupted.
+func (r *rebalancer) Stop() {
+	r.cancel()
+	r.wait.Wait()
+	r.logger.Info("shard rebalancer stopped")
+}
+
+// recover marks the migrations which are interrupted by previous master as failure.
+func (r *rebalancer) recover() {
+	data, err := r.repo.List(r.ctx, constants.ReplicaMigrationPath)
+	if err != nil {
+		r.logger.Warn("list replica migrations failure", logger.Error(err))
+		return
+	}
+	storages := r.stateMgr.SnapshotStorageStates()
+	for _, val := range data {
+		task := &models.ReplicaMigration{}
+		if err := encoding.JSONUnmarshal(val.Value, task); err != nil {
+			r.logger.Warn("unmarshal replica migration failure",
+				logger.String("key", val.Key), logger.Error(err))
+			continue
+		}
+		if task.State.IsTerminal() {
+			continue
+		}
+		for _, storage := range storages {
+			if _, ok := storage.ShardAssignments[task.Database]; !ok {
+				continue
+			}
+			if leader, ok := storage.LiveNodes[task.Leader]; ok {
+				r.cancelExport(&migration{leaderAddress: leader.HTTPAddress(), task: task})
+			}
+		}
+		r.fail(task, errMigrationInterrupted)
+	}
+}
+
+// check plans replica moves for each storage cluster, then starts the migrations.
+// Replicas on decommissioning nodes are always moved, other moves are planned only if rebalance enabled.
+func (r *rebalancer) check() {
+	now := timeutil.Now()
+	decommissions := r.applyDecommissions()
+	storages := r.stateMgr.SnapshotStorageStates()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, storage := range storages {
+		var offlineNodes map[models.NodeID]struct{}
+		if r.cfg.Enabled {
+			offlineNodes = r.getOfflineNodes(storage, now)
+		}
+		limit := r.cfg.MaxConcurrency - len(r.migrations)
+		var migrating []*models.ReplicaMigration
+		for _, m := range r.migrations {
+			if m.storage == storage.Name {
+				migrating = append(migrating, m.task)
+			}
+		}
+		moves := planReplicaMoves(storage, offlineNodes, migrating, limit, r.cfg.Enabled)
+		for _, move := range moves {
+			r.startMigration(storage.Name, move, now)
+		}
+		r.updateDecommissions(storage, decommissions[storage.Name])
+	}
+}
+
+// applyDecommissions loads the decommissions of storage nodes, then sets them into the state of storage cluster,
+// the shard leaders on decommissioning nodes are moved away, returns the decommissions which are not failed.
+// The decommission done is removed after the node offline.
+func (r *rebalancer) applyDecommissions() map[string]map[models.NodeID]*models.NodeDecommission {
+	data, err := r.repo.List(r.ctx, constants.DecommissionPath)
+	if err != nil {
+		r.logger.Warn("list storage node decommissions failure", logger.Error(err))
+		return nil
+	}
+	storages := make(map[string]*models.StorageState)
+	for _, storage := range r.stateMgr.SnapshotStorageStates() {
+		storages[storage.Name] = storage
+	}
+	result := make(map[string]map[models.NodeID]*models.NodeDecommission)
+	for _, val := range data {
+		d := &models.NodeDecommission{}
+		if err0 := encoding.JSONUnmarshal(val.Value, d); err0 != nil {
+			r.logger.Warn("unmarshal storage node decommission failure",
+				logger.String("key", val.Key), logger.Error(err0))
+			continue
+		}
+		if d.State == models.DecommissionFailed {
+			continue
+		}
+		storage, ok := storages[d.Storage]
+		if !ok {
+			r.updateDecommission(d, func() {
+				d.State = models.DecommissionFailed
+				d.ErrMsg = constants.ErrNoStorageCluster.Error()
+			})
+			continue
+		}
+		if _, live := storage.LiveNodes[d.NodeID]; !live && d.State == models.DecommissionDone {
+			r.logger.Info("remove storage node decommission after node offline",
+				logger.String("decommission", d.String()))
+			if err0 := r.repo.Delete(r.ctx, constants.GetDecommissionPath(d.Storage, int(d.NodeID))); err0 != nil {
+				r.logger.Warn("remove storage node decommission failure",
+					logger.String("decommission", d.String()), logger.Error(err0))
+			}
+			continue
+		}
+		if d.State == models.DecommissionPending {
+			r.logger.Info("start storage node decommission", logger.String("decommission", d.String()))
+			r.updateDecommission(d, func() { d.State = models.DecommissionTransferringLeader })
+		}
+		decommissions, ok := result[d.Storage]
+		if !ok {
+			decommissions = make(map[models.NodeID]*models.NodeDecommission)
+			result[d.Storage] = decommissions
+		}
+		decommissions[d.NodeID] = d
+	}
+	for name := range storages {
+		if err0 := r.stateMgr.SetDecommissions(name, result[name]); err0 != nil {
+			r.logger.Warn("set storage node decommissions failure",
+				logger.String("storage", name), logger.Error(err0))
+		}
+	}
+	return result
+}
+
+// updateDecommissions updates the progress of decommissions based on the state of storage cluster,
+// decommission is done after all replicas moved away from the node.
+func (r *rebalancer) updateDecommissions(storage *models.StorageState, decommissions map[models.NodeID]*models.NodeDecommission) {
+	for nodeID, d := range decommissions {
+		if d.State.IsTerminal() {
+			continue
+		}
+		leaders, replicas := 0, 0
+		for _, shards := range storage.LeadersOnNode(nodeID) {
+			leaders += len(shards)
+		}
+		for _, shards := range storage.ReplicasOnNode(nodeID) {
+			replicas += len(shards)
+		}
+		state := models.DecommissionMovingReplicas
+		if replicas == 0 {
+			state = models.DecommissionDone
+		}
+		if d.State == state && d.Leaders == leaders && d.Replicas == replicas {
+			continue
+		}
+		r.updateDecommission(d, func() {
+			d.State = state
+			d.Leaders = leaders
+			d.Replicas = replicas
+		})
+		if state == models.DecommissionDone {
+			r.logger.Info("storage node decommission completed, the node can be shutdown",
+				logger.String("decommission", d.String()))
+		}
+	}
+}
+
+// updateDecommission updates the decommission, then persists it.
+func (r *rebalancer) updateDecommission(d *models.NodeDecommission, fn func()) {
+	fn()
+	d.UpdateTime = timeutil.Now()
+	if err := r.repo.Put(r.ctx, constants.GetDecommissionPath(d.Storage, int(d.NodeID)), encoding.JSONMarshal(d)); err != nil {
+		r.logger.Warn("save storage node decommission failure",
+			logger.String("decommission", d.String()), logger.Error(err))
+	}
+}
+
+// getOfflineNodes returns the nodes which hold replicas and are offline longer than timeout.
+func (r *rebalancer) getOfflineNodes(storage *models.StorageState, now int64) map[models.NodeID]struct{} {
+	offlineTimes, ok := r.offlineNodes[storage.Name]
+	if !ok {
+		offlineTimes = make(map[models.NodeID]int64)
+		r.offlineNodes[storage.Name] = offlineTimes
+	}
+	replicaNodes := make(map[models.NodeID]struct{})
+	for _, shardAssignment := range storage.ShardAssignments {
+		for _, replica := range shardAssignment.Shards {
+			for _, nodeID := range replica.Replicas {
+				replicaNodes[nodeID] = struct{}{}
+			}
+		}
+	}
+	for nodeID := range offlineTimes {
+		_, live := storage.LiveNodes[nodeID]
+		if _, ok := replicaNodes[nodeID]; !ok || live {
+			delete(offlineTimes, nodeID)
+		}
+	}
+	timeout := r.cfg.NodeOfflineTimeout.Duration().Milliseconds()
+	offlineNodes := make(map[models.NodeID]struct{})
+	for nodeID := range replicaNodes {
+		if _, live := storage.LiveNodes[nodeID]; live {
+			continue
+		}
+		offlineTime, ok := offlineTimes[nodeID]
+		if !ok {
+			offlineTimes[nodeID] = now
+			offlineTime = now
+		}
+		if now-offlineTime >= timeout {
+			offlineNodes[nodeID] = struct{}{}
+		}
+	}
+	return offlineNodes
+}
+
+// startMigration persists the migration task, then runs it in background.
+func (r *rebalancer) startMigration(storage string, move ReplicaMove, now int64) {
+	task := &models.ReplicaMigration{
+		Database:   move.Database,
+		ShardID:    move.ShardID,
+		Source:     move.Source,
+		Target:     move.Target,
+		Reason:     move.Reason,
+		State:      models.MigrationPending,
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	if err := r.save(task); err != nil {
+		r.logger.Warn("save replica migration failure",
+			logger.String("migration", task.String()), logger.Error(err))
+		return
+	}
+	r.logger.Info("start replica migration",
+		logger.String("migration", task.String()), logger.String("reason", task.Reason))
+	m := &migration{storage: storage, task: task}
+	r.migrations[task.Key()] = m
+
+	r.wait.Add(1)
+	go func() {
+		defer r.wait.Done()
+
+		err := r.migrate(m)
+
+		r.mutex.Lock()
+		delete(r.migrations, task.Key())
+		r.mutex.Unlock()
+
+		if err == nil {
+			task.State = models.MigrationDone
+			if err0 := r.save(task); err0 != nil {
+				r.logger.Warn("save replica migration failure",
+					logger.String("migration", task.String()), logger.Error(err0))
+			}
+			r.logger.Info("replica migration completed", logger.String("migration", task.String()))
+			return
+		}
+		if r.ctx.Err() != nil {
+			// master resigned, new master will mark it as failure.
+			return
+		}
+		r.cancelExport(m)
+		r.fail(task, err)
+	}()
+}
+
+// migrate moves the replica of shard from source node to target node:
+// 1. creates the shard on target node;
+// 2. leader exports the data of shard to target node, then target catches up write ahead log;
+// 3. changes the shard assignment, then completes the migration on leader.
+func (r *rebalancer) migrate(m *migration) error {
+	task := m.task
+	var database *models.Database
+	databases := r.stateMgr.GetDatabases()
+	for idx := range databases {
+		if databases[idx].Name == task.Database {
+			database = &databases[idx]
+			break
+		}
+	}
+	if database == nil {
+		return constants.ErrDatabaseNotFound
+	}
+	storage := r.getStorageState(m.storage)
+	if storage == nil {
+		return constants.ErrNoStorageCluster
+	}
+	target, ok := storage.LiveNodes[task.Target]
+	if !ok {
+		return errTargetNotAlive
+	}
+	shardState := storage.ShardStates[task.Database][task.ShardID]
+	leader, ok := storage.LiveNodes[shardState.Leader]
+	if !ok {
+		return errLeaderNotAlive
+	}
+	task.Leader = leader.ID
+	r.update(task, func() { task.State = models.MigrationPreparing })
+	if err := r.cli.Prepare(target.HTTPAddress(), &models.MigrationPrepareRequest{
+		Database: task.Database,
+		ShardID:  task.ShardID,
+		Option:   database.Option,
+	}); err != nil {
+		return err
+	}
+	m.leaderAddress = leader.HTTPAddress()
+	if err := r.cli.Export(m.leaderAddress, &models.MigrationExportRequest{
+		Database:      task.Database,
+		ShardID:       task.ShardID,
+		Target:        task.Target,
+		TargetAddress: target.HTTPAddress(),
+		Throttle:      int64(r.cfg.Throttle),
+	}); err != nil {
+		return err
+	}
+	if err := r.waitExport(m); err != nil {
+		return err
+	}
+	if err := r.stateMgr.MoveReplica(task.Database, task.ShardID, task.Source, task.Target); err != nil {
+		return err
+	}
+	if err := r.cli.Complete(m.leaderAddress, &models.MigrationCompleteRequest{
+		Database: task.Database,
+		ShardID:  task.ShardID,
+		Source:   task.Source,
+		Target:   task.Target,
+	}); err != nil {
+		// shard assignment changed, only log the failure
+		r.logger.Warn("complete replica migration on leader failure",
+			logger.String("migration", task.String()), logger.Error(err))
+	}
+	return nil
+}
+
+// waitExport waits the exporting job on leader completed.
+func (r *rebalancer) waitExport(m *migration) error {
+	task := m.task
+	ticker := time.NewTicker(exportCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return r.ctx.Err()
+		case <-ticker.C:
+		}
+		exportState, err := r.cli.GetExportState(m.leaderAddress, task.Database, task.ShardID, task.Target)
+		if err != nil {
+			return err
+		}
+		switch exportState.State {
+		case models.MigrationDone:
+			return nil
+		case models.MigrationFailed:
+			return errors.New(exportState.ErrMsg)
+		default:
+			r.update(task, func() {
+				task.State = exportState.State
+				task.Rows = exportState.Rows
+				task.Bytes = exportState.Bytes
+				task.Pending = exportState.Pending
+			})
+		}
+	}
+}
+
+// cancelExport cancels the exporting job on leader.
+func (r *rebalancer) cancelExport(m *migration) {
+	if m.leaderAddress == "" {
+		return
+	}
+	task := m.task
+	if err := r.cli.CancelExport(m.leaderAddress, task.Database, task.ShardID, task.Target); err != nil {
+		r.logger.Warn("cancel exporting job on leader failure",
+			logger.String("migration", task.String()), logger.Error(err))
+	}
+}
+
+// fail marks the migration as failure.
+func (r *rebalancer) fail(task *models.ReplicaMigration, err error) {
+	r.logger.Warn("replica migration failure",
+		logger.String("migration", task.String()), logger.Error(err))
+	task.State = models.MigrationFailed
+	task.ErrMsg = err.Error()
+	if err0 := r.save(task); err0 != nil {
+		r.logger.Warn("save replica migration failure",
+			logger.String("migration", task.String()), logger.Error(err0))
+	}
+}
+
+// update updates the migration, then persists it.
+func (r *rebalancer) update(task *models.ReplicaMigration, fn func()) {
+	fn()
+	if err := r.save(task); err != nil {
+		r.logger.Warn("save replica migration failure",
+			logger.String("migration", task.String()), logger.Error(err))
+	}
+}
+
+// save persists the migration into repo.
+func (r *rebalancer) save(task *models.ReplicaMigration) error {
+	task.UpdateTime = timeutil.Now()
+	return r.repo.Put(r.ctx, constants.GetReplicaMigrationPath(task.Database, int(task.ShardID)), encoding.JSONMarshal(task))
+}
+
+// getStorageState returns the snapshot of storage state by name.
+func (r *rebalancer) getStorageState(name string) *models.StorageState {
+	for _, storage := range r.stateMgr.SnapshotStorageStates() {
+		if storage.Name == name {
+			return storage
+		}
+	}
+	return nil
+}
@@ -0,0 +1,389 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func newRebalanceState() *models.StorageState {
+	storageState := newPlanState([]models.NodeID{1, 2, 3},
+		map[models.ShardID][]models.NodeID{1: {1, 2}, 2: {2, 1}, 3: {1, 2}})
+	for id := range storageState.LiveNodes {
+		storageState.LiveNodes[id] = models.StatefulNode{
+			ID:            id,
+			StatelessNode: models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: uint16(9000 + id)},
+		}
+	}
+	return storageState
+}
+
+func TestRebalancer_Start_Stop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := NewMockStateManager(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	r := NewRebalancer(context.TODO(), config.Rebalance{
+		CheckInterval:  ltoml.Duration(time.Millisecond),
+		MaxConcurrency: 1,
+	}, stateMgr, repo, nil)
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err")).AnyTimes()
+	stateMgr.EXPECT().SnapshotStorageStates().Return(nil).AnyTimes()
+	r.Start()
+	time.Sleep(10 * time.Millisecond)
+	r.Stop()
+}
+
+func TestRebalancer_recover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := NewMockStateManager(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	cli := client.NewMockMigrationCli(ctrl)
+	r := NewRebalancer(context.TODO(), config.Rebalance{}, stateMgr, repo, cli).(*rebalancer)
+
+	stateMgr.EXPECT().SnapshotStorageStates().Return([]*models.StorageState{newRebalanceState()})
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{
+		{Key: "a", Value: []byte("abc")},
+		{Key: "b", Value: encoding.JSONMarshal(&models.ReplicaMigration{Database: "db", State: models.MigrationDone})},
+		{Key: "c", Value: encoding.JSONMarshal(&models.ReplicaMigration{
+			Database: "db", ShardID: 1, Leader: 1, Target: 3, State: models.MigrationExporting})},
+		{Key: "d", Value: encoding.JSONMarshal(&models.ReplicaMigration{
+			Database: "db", ShardID: 2, Leader: 10, Target: 3, State: models.MigrationExporting})},
+	}, nil)
+	cli.EXPECT().CancelExport("http://127.0.0.1:9001", "db", models.ShardID(1), models.NodeID(3)).Return(fmt.Errorf("err"))
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+			task := &models.ReplicaMigration{}
+			assert.NoError(t, encoding.JSONUnmarshal(data, task))
+			assert.Equal(t, models.MigrationFailed, task.State)
+			assert.Equal(t, errMigrationInterrupted.Error(), task.ErrMsg)
+			return nil
+		}).Times(2)
+	r.recover()
+}
+
+func TestRebalancer_getOfflineNodes(t *testing.T) {
+	r := NewRebalancer(context.TODO(), config.Rebalance{
+		NodeOfflineTimeout: ltoml.Duration(time.Minute),
+	}, nil, nil, nil).(*rebalancer)
+	storageState := newRebalanceState()
+	storageState.NodeOffline(2)
+	now := time.Now().UnixMilli()
+	assert.Empty(t, r.getOfflineNodes(storageState, now))
+	assert.Empty(t, r.getOfflineNodes(storageState, now+time.Second.Milliseconds()))
+	assert.Equal(t, map[models.NodeID]struct{}{2: {}}, r.getOfflineNodes(storageState, now+time.Minute.Milliseconds()))
+	// node online again
+	storageState.NodeOnline(models.StatefulNode{ID: 2})
+	assert.Empty(t, r.getOfflineNodes(storageState, now+time.Minute.Milliseconds()))
+	assert.Empty(t, r.offlineNodes[storageState.Name])
+}
+
+func TestRebalancer_check(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		exportCheckInterval = 5 * time.Second
+		ctrl.Finish()
+	}()
+	exportCheckInterval = time.Millisecond
+
+	stateMgr := NewMockStateManager(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	cli := client.NewMockMigrationCli(ctrl)
+	r := NewRebalancer(context.TODO(), config.Rebalance{
+		Enabled:            true,
+		MaxConcurrency:     1,
+		NodeOfflineTimeout: ltoml.Duration(time.Minute),
+		Throttle:           ltoml.Size(1024),
+	}, stateMgr, repo, cli).(*rebalancer)
+
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	stateMgr.EXPECT().SetDecommissions(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	stateMgr.EXPECT().SnapshotStorageStates().Return([]*models.StorageState{newRebalanceState()}).AnyTimes()
+	stateMgr.EXPECT().GetDatabases().Return([]models.Database{{Name: "db", Option: &option.DatabaseOption{}}}).AnyTimes()
+	done := make(chan struct{})
+	cli.EXPECT().Prepare("http://127.0.0.1:9003", gomock.Any()).Return(nil)
+	cli.EXPECT().Export("http://127.0.0.1:9001", gomock.Any()).
+		DoAndReturn(func(_ string, req *models.MigrationExportRequest) error {
+			assert.Equal(t, models.NodeID(3), req.Target)
+			assert.Equal(t, "http://127.0.0.1:9003", req.TargetAddress)
+			assert.Equal(t, int64(1024), req.Throttle)
+			return nil
+		})
+	gomock.InOrder(
+		cli.EXPECT().GetExportState(gomock.Any(), "db", models.ShardID(1), models.NodeID(3)).
+			Return(&models.ReplicaMigration{State: models.MigrationCatchingUp, Rows: 10}, nil),
+		cli.EXPECT().GetExportState(gomock.Any(), "db", models.ShardID(1), models.NodeID(3)).
+			Return(&models.ReplicaMigration{State: models.MigrationDone}, nil),
+	)
+	stateMgr.EXPECT().MoveReplica("db", models.ShardID(1), models.NodeID(1), models.NodeID(3)).Return(nil)
+	cli.EXPECT().Complete(gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, _ *models.MigrationCompleteRequest) error {
+		close(done)
+		return nil
+	})
+	r.check()
+	// max concurrency limit
+	r.check()
+	<-done
+	r.Stop()
+	assert.Empty(t, r.migrations)
+}
+
+func TestRebalancer_decommission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := NewMockStateManager(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	r := NewRebalancer(context.TODO(), config.Rebalance{}, stateMgr, repo, nil).(*rebalancer)
+
+	// case 1: list decommissions failure
+	repo.EXPECT().List(gomock.Any(), constants.DecommissionPath).Return(nil, fmt.Errorf("err"))
+	assert.Nil(t, r.applyDecommissions())
+
+	// case 2: apply decommissions, then update progress
+	storageState := newRebalanceState()
+	stateMgr.EXPECT().SnapshotStorageStates().Return([]*models.StorageState{storageState}).AnyTimes()
+	repo.EXPECT().List(gomock.Any(), constants.DecommissionPath).Return([]state.KeyValue{
+		{Key: "a", Value: []byte("abc")},
+		{Key: "b", Value: encoding.JSONMarshal(&models.NodeDecommission{Storage: "test", NodeID: 2, State: models.DecommissionFailed})},
+		{Key: "c", Value: encoding.JSONMarshal(&models.NodeDecommission{Storage: "unknown", NodeID: 1, State: models.DecommissionPending})},
+		{Key: "d", Value: encoding.JSONMarshal(&models.NodeDecommission{Storage: "test", NodeID: 4, State: models.DecommissionDone})},
+		{Key: "e", Value: encoding.JSONMarshal(&models.NodeDecommission{Storage: "test", NodeID: 1, State: models.DecommissionPending})},
+		{Key: "f", Value: encoding.JSONMarshal(&models.NodeDecommission{Storage: "test", NodeID: 3, State: models.DecommissionPending})},
+	}, nil)
+	repo.EXPECT().Delete(gomock.Any(), "/rebalance/decommission/test/4").Return(fmt.Errorf("err"))
+	decommissions := make(map[string]*models.NodeDecommission)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key string, data []byte) error {
+		d := &models.NodeDecommission{}
+		assert.NoError(t, encoding.JSONUnmarshal(data, d))
+		decommissions[key] = d
+		return fmt.Errorf("err")
+	}).AnyTimes()
+	stateMgr.EXPECT().SetDecommissions("test", gomock.Any()).
+		DoAndReturn(func(_ string, decommissions map[models.NodeID]*models.NodeDecommission) error {
+			assert.Len(t, decommissions, 2)
+			assert.Equal(t, models.DecommissionTransferringLeader, decommissions[1].State)
+			assert.Equal(t, models.DecommissionTransferringLeader, decommissions[3].State)
+			storageState.Decommissions = decommissions
+			return fmt.Errorf("err")
+		})
+	r.check()
+	assert.Equal(t, models.DecommissionFailed, decommissions["/rebalance/decommission/unknown/1"].State)
+	assert.Equal(t, &models.NodeDecommission{
+		Storage:    "test",
+		NodeID:     1,
+		State:      models.DecommissionMovingReplicas,
+		Leaders:    2,
+		Replicas:   3,
+		UpdateTime: decommissions["/rebalance/decommission/test/1"].UpdateTime,
+	}, decommissions["/rebalance/decommission/test/1"])
+	assert.Equal(t, models.DecommissionDone, decommissions["/rebalance/decommission/test/3"].State)
+	assert.Empty(t, r.migrations)
+}
+
+func TestRebalancer_migrate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		exportCheckInterval = 5 * time.Second
+		ctrl.Finish()
+	}()
+	exportCheckInterval = time.Millisecond
+
+	stateMgr := NewMockStateManager(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	cli := client.NewMockMigrationCli(ctrl)
+	r := NewRebalancer(context.TODO(), config.Rebalance{}, stateMgr, repo, cli).(*rebalancer)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err")).AnyTimes()
+	databases := []models.Database{{Name: "db"}}
+
+	cases := []struct {
+		name    string
+		task    *models.ReplicaMigration
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "database not found",
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabases().Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "storage not found",
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabases().Return(databases)
+				stateMgr.EXPECT().SnapshotStorageStates().Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "target not alive",
+			task: &models.ReplicaMigration{Database: "db", ShardID: 1, Source: 1, Target: 4},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabases().Return(databases)
+				stateMgr.EXPECT().SnapshotStorageStates().Return([]*models.StorageState{newRebalanceState()})
+			},
+			wantErr: true,
+		},
+		{
+			name: "leader not alive",
+			task: &models.ReplicaMigration{Database: "db", ShardID: 4, Source: 1, Target: 3},
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabases().Return(databases)
+				stateMgr.EXPECT().SnapshotStorageStates().Return([]*models.StorageState{newRebalanceState()})
+			},
+			wantErr: true,
+		},
+		{
+			name: "prepare failure",
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabases().Return(databases)
+				stateMgr.EXPECT().SnapshotStorageStates().Return([]*models.StorageState{newRebalanceState()})
+				cli.EXPECT().Prepare(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "export failure",
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabases().Return(databases)
+				stateMgr.EXPECT().SnapshotStorageStates().Return([]*models.StorageState{newRebalanceState()})
+				cli.EXPECT().Prepare(gomock.Any(), gomock.Any()).Return(nil)
+				cli.EXPECT().Export(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "get export state failure",
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabases().Return(databases)
+				stateMgr.EXPECT().SnapshotStorageStates().Return([]*models.StorageState{newRebalanceState()})
+				cli.EXPECT().Prepare(gomock.Any(), gomock.Any()).Return(nil)
+				cli.EXPECT().Export(gomock.Any(), gomock.Any()).Return(nil)
+				cli.EXPECT().GetExportState(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "export job failure",
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabases().Return(databases)
+				stateMgr.EXPECT().SnapshotStorageStates().Return([]*models.StorageState{newRebalanceState()})
+				cli.EXPECT().Prepare(gomock.Any(), gomock.Any()).Return(nil)
+				cli.EXPECT().Export(gomock.Any(), gomock.Any()).Return(nil)
+				cli.EXPECT().GetExportState(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&models.ReplicaMigration{State: models.MigrationFailed, ErrMsg: "err"}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "move replica failure",
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabases().Return(databases)
+				stateMgr.EXPECT().SnapshotStorageStates().Return([]*models.StorageState{newRebalanceState()})
+				cli.EXPECT().Prepare(gomock.Any(), gomock.Any()).Return(nil)
+				cli.EXPECT().Export(gomock.Any(), gomock.Any()).Return(nil)
+				cli.EXPECT().GetExportState(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&models.ReplicaMigration{State: models.MigrationDone}, nil)
+				stateMgr.EXPECT().MoveReplica(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "complete failure",
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabases().Return(databases)
+				stateMgr.EXPECT().SnapshotStorageStates().Return([]*models.StorageState{newRebalanceState()})
+				cli.EXPECT().Prepare(gomock.Any(), gomock.Any()).Return(nil)
+				cli.EXPECT().Export(gomock.Any(), gomock.Any()).Return(nil)
+				cli.EXPECT().GetExportState(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&models.ReplicaMigration{State: models.MigrationDone}, nil)
+				stateMgr.EXPECT().MoveReplica(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				cli.EXPECT().Complete(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			task := tt.task
+			if task == nil {
+				task = &models.ReplicaMigration{Database: "db", ShardID: 1, Source: 2, Target: 3}
+			}
+			err := r.migrate(&migration{storage: "test", task: task})
+			if (err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+		})
+	}
+}
+
+func TestRebalancer_migrate_failure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := NewMockStateManager(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	cli := client.NewMockMigrationCli(ctrl)
+	r := NewRebalancer(context.TODO(), config.Rebalance{MaxConcurrency: 1}, stateMgr, repo, cli).(*rebalancer)
+
+	// save task failure
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	r.startMigration("test", ReplicaMove{Database: "db", ShardID: 1, Source: 1, Target: 3}, 1)
+	assert.Empty(t, r.migrations)
+
+	// migrate failure, cancel exporting job
+	done := make(chan struct{})
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	stateMgr.EXPECT().GetDatabases().Return([]models.Database{{Name: "db"}})
+	stateMgr.EXPECT().SnapshotStorageStates().Return([]*models.StorageState{newRebalanceState()})
+	cli.EXPECT().Prepare(gomock.Any(), gomock.Any()).Return(nil)
+	cli.EXPECT().Export(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	cli.EXPECT().CancelExport(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_, _ string, _ models.ShardID, _ models.NodeID) error {
+			close(done)
+			return nil
+		})
+	r.mutex.Lock()
+	r.startMigration("test", ReplicaMove{Database: "db", ShardID: 1, Source: 1, Target: 3}, 1)
+	r.mutex.Unlock()
+	<-done
+	r.Stop()
+	assert.Empty(t, r.migrations)
+}
@@ -26,10 +26,12 @@ import (
 
 // ReplicaLeaderElector represents replica leader elector for shard.
 type ReplicaLeaderElector interface {
-	// ElectLeader elects the replica's leader based on shard assignment.
+	// ElectLeader elects the replica's leader based on shard assignment,
+	// the live replica in preferred zone of leader placement is elected first if placement set.
 	ElectLeader(shardAssignment *models.ShardAssignment,
 		liveNodes map[models.NodeID]models.StatefulNode,
 		shardID models.ShardID,
+		placement *models.LeaderPlacement,
 	) (leader models.NodeID, err error)
 }
 
@@ -42,10 +44,12 @@ func newReplicaLeaderElector() ReplicaLeaderElector {
 	return &replicaLeaderElector{}
 }
 
-// ElectLeader elects the replica's leader based on shard assignment.
+// ElectLeader elects the replica's leader based on shard assignment,
+// the live replica in preferred zone of leader placement is elected first if placement set.
 func (r *replicaLeaderElector) ElectLeader(shardAssignment *models.ShardAssignment,
 	liveNodes map[models.NodeID]models.StatefulNode,
 	shardID models.ShardID,
+	placement *models.LeaderPlacement,
 ) (leader models.NodeID, err error) {
 	replicas, ok := shardAssignment.Shards[shardID]
 	if !ok {
@@ -65,7 +69,14 @@ func (r *replicaLeaderElector) ElectLeader(shardAssignment *models.ShardAssignme
 		err = constants.ErrNoLiveReplica
 		return
 	}
-	// elect leader from live replicas
+	// elect leader from live replicas, keep the order of replicas if zones have same priority
 	leader = liveReplicaNodes.Replicas[0]
+	priority := placement.ZonePriority(liveNodes[leader].Zone)
+	for _, replica := range liveReplicaNodes.Replicas[1:] {
+		if p := placement.ZonePriority(liveNodes[replica].Zone); p < priority {
+			leader = replica
+			priority = p
+		}
+	}
 	return
 }
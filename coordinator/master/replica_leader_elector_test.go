@@ -28,18 +28,38 @@ import (
 
 func TestReplicaLeaderElector_ElectLeader(t *testing.T) {
 	elect := newReplicaLeaderElector()
-	_, err := elect.ElectLeader(models.NewShardAssignment("test"), nil, models.ShardID(1))
+	_, err := elect.ElectLeader(models.NewShardAssignment("test"), nil, models.ShardID(1), nil)
 	assert.Equal(t, constants.ErrShardNotFound, err)
 
 	shardAssignment := models.NewShardAssignment("test")
 	shardAssignment.AddReplica(models.ShardID(1), models.NodeID(1))
 	liveNodes := make(map[models.NodeID]models.StatefulNode)
 
-	_, err = elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1))
+	_, err = elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1), nil)
 	assert.Equal(t, constants.ErrNoLiveReplica, err)
 	liveNodes[models.NodeID(1)] = models.StatefulNode{}
 
-	leader, err := elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1))
+	leader, err := elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, models.NodeID(1), leader)
+
+	// elect leader by leader placement
+	shardAssignment.AddReplica(models.ShardID(1), models.NodeID(2))
+	shardAssignment.AddReplica(models.ShardID(1), models.NodeID(3))
+	liveNodes[models.NodeID(1)] = models.StatefulNode{ID: 1, Zone: "a"}
+	liveNodes[models.NodeID(2)] = models.StatefulNode{ID: 2, Zone: "b"}
+	liveNodes[models.NodeID(3)] = models.StatefulNode{ID: 3, Zone: "c"}
+	placement := &models.LeaderPlacement{PreferredZones: []string{"c", "b"}}
+	leader, err = elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1), placement)
+	assert.NoError(t, err)
+	assert.Equal(t, models.NodeID(3), leader)
+	delete(liveNodes, models.NodeID(3))
+	leader, err = elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1), placement)
+	assert.NoError(t, err)
+	assert.Equal(t, models.NodeID(2), leader)
+	// no live replica in preferred zones
+	leader, err = elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1),
+		&models.LeaderPlacement{PreferredZones: []string{"d"}})
 	assert.NoError(t, err)
 	assert.Equal(t, models.NodeID(1), leader)
 }
@@ -20,8 +20,16 @@ package master
 import (
 	"fmt"
 	"math/rand"
+	"time"
 
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/option"
+)
+
+// for testing
+var (
+	// cutOverDelay is the min duration for brokers receiving the new routing before it takes effect.
+	cutOverDelay = time.Minute
 )
 
 // Shard assigment reference kafka partition assigment
@@ -45,7 +53,10 @@ import (
 // s8		s9		s5		s6		s7		(2st replica)
 // s3		s4		s0		s1		s2		(3st replica)
 // s7		s8		s9		s5		s6		(3st replica)
-func ShardAssignment(storageNodeIDs []models.NodeID, cfg *models.Database,
+//
+// If storage nodes advertise their location(zone/rack), nodes are ordered by zone/rack alternated,
+// and the remaining replicas of each shard are spread over zones first, then racks(rack aware).
+func ShardAssignment(storageNodes []models.StatefulNode, cfg *models.Database,
 	fixedStartIndex int, startShardID models.ShardID) (*models.ShardAssignment, error) {
 	numOfShard := cfg.NumOfShard
 	replicaFactor := cfg.ReplicaFactor
@@ -55,19 +66,19 @@ func ShardAssignment(storageNodeIDs []models.NodeID, cfg *models.Database,
 	if replicaFactor <= 0 {
 		return nil, fmt.Errorf("shard assign error for databaes[%s], bacause replica factor <=0", cfg.Name)
 	}
-	if replicaFactor > len(storageNodeIDs) {
+	if replicaFactor > len(storageNodes) {
 		return nil,
 			fmt.Errorf("shard assign error for databaes[%s], bacause replica factor > num. of storage nodes",
 				cfg.Name)
 	}
 
 	shardAssignment := models.NewShardAssignment(cfg.Name)
-	assignReplicasToStorageNodes(storageNodeIDs, numOfShard, replicaFactor, fixedStartIndex, startShardID, shardAssignment)
+	assignReplicasToStorageNodes(storageNodes, numOfShard, replicaFactor, fixedStartIndex, startShardID, shardAssignment)
 
 	return shardAssignment, nil
 }
 
-func ModifyShardAssignment(storageNodeIDs []models.NodeID, cfg *models.Database, shardAssignment *models.ShardAssignment,
+func ModifyShardAssignment(storageNodes []models.StatefulNode, cfg *models.Database, shardAssignment *models.ShardAssignment,
 	fixedStartIndex int, startShardID models.ShardID) error {
 	numOfShard := cfg.NumOfShard - len(shardAssignment.Shards)
 	replicaFactor := cfg.ReplicaFactor
@@ -77,34 +88,33 @@ func ModifyShardAssignment(storageNodeIDs []models.NodeID, cfg *models.Database,
 	if replicaFactor <= 0 {
 		return fmt.Errorf("shard assign error for databaes[%s], bacause replica factor <=0", cfg.Name)
 	}
-	if replicaFactor > len(storageNodeIDs) {
+	if replicaFactor > len(storageNodes) {
 		return fmt.Errorf("shard assign error for databaes[%s], bacause replica factor > num. of storage nodes",
 			cfg.Name)
 	}
 
-	assignReplicasToStorageNodes(storageNodeIDs, numOfShard, replicaFactor, fixedStartIndex, startShardID, shardAssignment)
+	assignReplicasToStorageNodes(storageNodes, numOfShard, replicaFactor, fixedStartIndex, startShardID, shardAssignment)
 
 	return nil
 }
 
 // assignReplicasToStorageNodes assigns replica list for storage storageCluster
 // which database's each shard based on selected node list in storageCluster.
-func assignReplicasToStorageNodes(storageNodeIDs []models.NodeID,
+func assignReplicasToStorageNodes(storageNodes []models.StatefulNode,
 	numOfShard, replicaFactor, fixedStartIndex int, startShardID models.ShardID,
 	shardAssignment *models.ShardAssignment) {
+	if hasLocation(storageNodes) {
+		assignReplicasRackAware(storageNodes, numOfShard, replicaFactor, fixedStartIndex, startShardID, shardAssignment)
+		return
+	}
+	storageNodeIDs := make([]models.NodeID, len(storageNodes))
+	for idx := range storageNodes {
+		storageNodeIDs[idx] = storageNodes[idx].ID
+	}
 	numOfNode := len(storageNodeIDs)
 
 	// init start index/shift/current shard
-	startIndex := fixedStartIndex
-	nextReplicaShift := fixedStartIndex
-	if fixedStartIndex < 0 {
-		startIndex = rand.Intn(numOfNode)
-		nextReplicaShift = rand.Intn(numOfNode)
-	}
-	currentShardID := models.ShardID(0)
-	if startShardID >= 0 {
-		currentShardID = startShardID
-	}
+	startIndex, nextReplicaShift, currentShardID := initAssignment(numOfNode, fixedStartIndex, startShardID)
 
 	// assign replica list for each shard
 	for i := 0; i < numOfShard; i++ {
@@ -128,8 +138,94 @@ func assignReplicasToStorageNodes(storageNodeIDs []models.NodeID,
 	}
 }
 
+// assignReplicasRackAware assigns replica list based on the location of storage nodes,
+// the remaining replicas of each shard are assigned with an increasing shift like assignReplicasToStorageNodes,
+// but the node is skipped if the replicas don't spread over as many zones/racks as possible.
+func assignReplicasRackAware(storageNodes []models.StatefulNode,
+	numOfShard, replicaFactor, fixedStartIndex int, startShardID models.ShardID,
+	shardAssignment *models.ShardAssignment) {
+	storageNodeIDs := rackAlternatedNodes(storageNodes)
+	locations := nodeLocations(storageNodes)
+	numOfNode := len(storageNodeIDs)
+	numOfRack := newReplicaSpread(locations).numOfRack
+
+	// init start index/shift/current shard
+	startIndex, nextReplicaShift, currentShardID := initAssignment(numOfNode, fixedStartIndex, startShardID)
+
+	// assign replica list for each shard
+	for i := 0; i < numOfShard; i++ {
+		if currentShardID > 0 && (int(currentShardID)%numOfNode == 0) {
+			nextReplicaShift++
+		}
+		firstReplicaIndex := (int(currentShardID) + startIndex) % numOfNode
+
+		// elect first replica as leader
+		leader := storageNodeIDs[firstReplicaIndex]
+		shardAssignment.AddReplica(currentShardID, leader)
+		spread := newReplicaSpread(locations)
+		spread.add(leader)
+
+		// assign other replica, there is always a node accepted in numOfNode-1 shifts.
+		k := 0
+		for j := 0; j < replicaFactor-1; j++ {
+			for {
+				nodeID := storageNodeIDs[replicaIndex(firstReplicaIndex, nextReplicaShift*numOfRack, k, numOfNode)]
+				k++
+				if spread.accept(nodeID) {
+					shardAssignment.AddReplica(currentShardID, nodeID)
+					spread.add(nodeID)
+					break
+				}
+			}
+		}
+
+		// do next shard assign
+		currentShardID++
+	}
+}
+
+// initAssignment returns the start index/shift/shard id of assignment, random start index/shift if fixed index < 0.
+func initAssignment(numOfNode, fixedStartIndex int,
+	startShardID models.ShardID) (startIndex, nextReplicaShift int, currentShardID models.ShardID) {
+	startIndex = fixedStartIndex
+	nextReplicaShift = fixedStartIndex
+	if fixedStartIndex < 0 {
+		startIndex = rand.Intn(numOfNode)
+		nextReplicaShift = rand.Intn(numOfNode)
+	}
+	if startShardID >= 0 {
+		currentShardID = startShardID
+	}
+	return
+}
+
 // replicaIndex calculates replica index based on first replica index and shift
 func replicaIndex(firstReplicaIndex, secondReplicaShift, replicaIndex, numOfNode int) int {
 	shift := 1 + (secondReplicaShift+replicaIndex)%(numOfNode-1)
 	return (firstReplicaIndex + shift) % numOfNode
 }
+
+// calcCutOverTime returns the start time of next family after cut-over delay,
+// rows are routed by new number of shards since cut-over time.
+func calcCutOverTime(opt *option.DatabaseOption, now int64) int64 {
+	timestamp := now + cutOverDelay.Milliseconds()
+	if opt == nil || len(opt.Intervals) == 0 {
+		return timestamp
+	}
+	interval := opt.Intervals[0].Interval
+	for _, i := range opt.Intervals {
+		if i.Interval < interval {
+			interval = i.Interval
+		}
+	}
+	calc := interval.Calculator()
+	return calc.CalcFamilyEndTime(calc.CalcFamilyTime(timestamp)) + 1
+}
+
+// maxRetention returns the max retention of database's intervals, the data of database expires after it.
+func maxRetention(opt *option.DatabaseOption) int64 {
+	if opt == nil {
+		return 0
+	}
+	return opt.MaxRetention()
+}
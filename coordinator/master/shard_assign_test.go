@@ -26,9 +26,9 @@ import (
 )
 
 func TestShardAssign(t *testing.T) {
-	storageNodeIDs := []models.NodeID{0, 1, 2, 3, 4}
+	storageNodes := newStatefulNodes(0, 1, 2, 3, 4)
 
-	_, err1 := ShardAssignment(storageNodeIDs,
+	_, err1 := ShardAssignment(storageNodes,
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    0,
@@ -36,7 +36,7 @@ func TestShardAssign(t *testing.T) {
 		}, -1, -1)
 	assert.NotNil(t, err1)
 
-	_, err1 = ShardAssignment(storageNodeIDs,
+	_, err1 = ShardAssignment(storageNodes,
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    3,
@@ -44,7 +44,7 @@ func TestShardAssign(t *testing.T) {
 		}, -1, -1)
 	assert.NotNil(t, err1)
 
-	_, err2 := ShardAssignment(storageNodeIDs,
+	_, err2 := ShardAssignment(storageNodes,
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    10,
@@ -52,7 +52,7 @@ func TestShardAssign(t *testing.T) {
 		}, -1, -1)
 	assert.NotNil(t, err2)
 
-	shardAssignment, _ := ShardAssignment(storageNodeIDs,
+	shardAssignment, _ := ShardAssignment(storageNodes,
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    10,
@@ -81,7 +81,7 @@ func checkShardAssignResult(shardAssignment *models.ShardAssignment, t *testing.
 }
 
 func TestModifyShardAssignment(t *testing.T) {
-	err := ModifyShardAssignment([]models.NodeID{0, 1, 2, 3, 4},
+	err := ModifyShardAssignment(newStatefulNodes(0, 1, 2, 3, 4),
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    0,
@@ -89,7 +89,7 @@ func TestModifyShardAssignment(t *testing.T) {
 		}, models.NewShardAssignment("test"), -1, models.ShardID(1))
 	assert.Error(t, err)
 
-	err = ModifyShardAssignment([]models.NodeID{0},
+	err = ModifyShardAssignment(newStatefulNodes(0),
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    1,
@@ -97,7 +97,7 @@ func TestModifyShardAssignment(t *testing.T) {
 		}, models.NewShardAssignment("test"), -1, models.ShardID(1))
 	assert.Error(t, err)
 
-	err = ModifyShardAssignment([]models.NodeID{0},
+	err = ModifyShardAssignment(newStatefulNodes(0),
 		&models.Database{
 			Name:          "test",
 			NumOfShard:    1,
@@ -105,3 +105,66 @@ func TestModifyShardAssignment(t *testing.T) {
 		}, models.NewShardAssignment("test"), -1, models.ShardID(1))
 	assert.Error(t, err)
 }
+
+func TestShardAssign_RackAware(t *testing.T) {
+	// 3 zones, zone a has 2 racks
+	storageNodes := []models.StatefulNode{
+		{ID: 1, Zone: "a", Rack: "r1"},
+		{ID: 2, Zone: "a", Rack: "r1"},
+		{ID: 3, Zone: "a", Rack: "r2"},
+		{ID: 4, Zone: "b", Rack: "r1"},
+		{ID: 5, Zone: "b", Rack: "r1"},
+		{ID: 6, Zone: "c", Rack: "r1"},
+	}
+	locations := nodeLocations(storageNodes)
+	for _, fixedStartIndex := range []int{-1, 0, 1, 2, 3, 4, 5} {
+		shardAssignment, err := ShardAssignment(storageNodes,
+			&models.Database{
+				Name:          "test",
+				NumOfShard:    12,
+				ReplicaFactor: 3,
+			}, fixedStartIndex, -1)
+		assert.NoError(t, err)
+		assert.Len(t, shardAssignment.Shards, 12)
+		for _, replica := range shardAssignment.Shards {
+			assert.Len(t, replica.Replicas, 3)
+			zones := make(map[string]struct{})
+			for _, nodeID := range replica.Replicas {
+				zones[locations[nodeID].Zone] = struct{}{}
+			}
+			// replicas spread over all zones
+			assert.Len(t, zones, 3)
+		}
+	}
+	// replica factor > num. of zones, replicas spread over all racks
+	shardAssignment, err := ShardAssignment(storageNodes,
+		&models.Database{
+			Name:          "test",
+			NumOfShard:    12,
+			ReplicaFactor: 4,
+		}, -1, -1)
+	assert.NoError(t, err)
+	for _, replica := range shardAssignment.Shards {
+		assert.Len(t, replica.Replicas, 4)
+		racks := make(map[string]struct{})
+		for _, nodeID := range replica.Replicas {
+			racks[locations[nodeID].RackKey()] = struct{}{}
+		}
+		assert.Len(t, racks, 4)
+	}
+	// modify shard assignment
+	err = ModifyShardAssignment(storageNodes, &models.Database{
+		Name:          "test",
+		NumOfShard:    14,
+		ReplicaFactor: 4,
+	}, shardAssignment, -1, models.ShardID(12))
+	assert.NoError(t, err)
+	assert.Len(t, shardAssignment.Shards, 14)
+}
+
+func newStatefulNodes(ids ...models.NodeID) (rs []models.StatefulNode) {
+	for _, id := range ids {
+		rs = append(rs, models.StatefulNode{ID: id})
+	}
+	return rs
+}
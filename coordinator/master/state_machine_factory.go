@@ -118,6 +118,13 @@ func (f *StateMachineFactory) Start() (err error) {
 	}
 	f.stateMachines = append(f.stateMachines, sm)
 
+	f.logger.Debug("starting SeriesDeletionStateMachine")
+	sm, err = f.createSeriesDeletionStateMachine()
+	if err != nil {
+		return err
+	}
+	f.stateMachines = append(f.stateMachines, sm)
+
 	f.logger.Info("started MasterStateMachines")
 	return nil
 }
@@ -247,3 +254,22 @@ func (f *StateMachineFactory) createDatabaseLimitsStateMachine() (discovery.Stat
 		nil,
 	)
 }
+
+// createSeriesDeletionStateMachine creates database's series deletion state machine.
+func (f *StateMachineFactory) createSeriesDeletionStateMachine() (discovery.StateMachine, error) {
+	return discovery.NewStateMachine(
+		f.ctx,
+		discovery.SeriesDeletionStateMachine,
+		f.discoveryFactory,
+		constants.DatabaseDeletionPath,
+		true,
+		func(key string, data []byte) {
+			f.stateMgr.EmitEvent(&discovery.Event{
+				Type:  discovery.SeriesDeletionChanged,
+				Key:   key,
+				Value: data,
+			})
+		},
+		nil,
+	)
+}
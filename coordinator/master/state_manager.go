@@ -18,8 +18,10 @@
 package master
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -38,6 +40,12 @@ import (
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/ltoml"
 	statepkg "github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// for testing
+var (
+	retiredShardsCheckInterval = 10 * time.Minute
 )
 
 //go:generate mockgen -source=./state_manager.go -destination=./state_manager_mock.go -package=master
@@ -60,6 +68,20 @@ type StateManager interface {
 	GetShardAssignments() []models.ShardAssignment
 	// GetStorageStates returns current storage state list.
 	GetStorageStates() []*models.StorageState
+	// SnapshotStorageStates returns the copy of current storage state list, it's safe to read concurrently.
+	SnapshotStorageStates() []*models.StorageState
+	// MoveReplica moves the replica of database's shard from source node to target node,
+	// then persists the shard assignment, shard state will be re-initialized after assignment changed.
+	MoveReplica(database string, shardID models.ShardID, source, target models.NodeID) error
+	// SetDecommissions sets the decommissions of storage cluster, decommissioning nodes are excluded from
+	// leader election and new shard assignment, shard leaders on them are moved to other live replicas.
+	SetDecommissions(storageName string, decommissions map[models.NodeID]*models.NodeDecommission) error
+	// PreferredLeader returns the preferred leader of database's shard, which is elected from
+	// the live replicas which are not decommissioning, based on replica order and leader placement.
+	PreferredLeader(database string, shardID models.ShardID) (models.NodeID, error)
+	// TransferLeader transfers the leader of database's shard from source node to target replica,
+	// then syncs the storage state, brokers switch write streams to new leader after state changed.
+	TransferLeader(database string, shardID models.ShardID, source, target models.NodeID) error
 }
 
 // stateManager implements StateManager.
@@ -117,6 +139,8 @@ func NewStateManager(
 
 	// start consume event then do coordinate
 	go mgr.consumeEvent()
+	// start check retired shards of databases
+	go mgr.checkRetiredShards(retiredShardsCheckInterval)
 
 	return mgr
 }
@@ -167,6 +191,8 @@ func (m *stateManager) processEvent(event *discovery.Event) {
 		err = m.onDatabaseCfgChange(event.Key, event.Value)
 	case discovery.DatabaseLimitsChanged:
 		err = m.onDatabaseLimitsChange(event.Key, event.Value)
+	case discovery.SeriesDeletionChanged:
+		err = m.onSeriesDeletionChange(event.Key, event.Value)
 	case discovery.DatabaseConfigDeletion:
 		err = m.onDatabaseCfgDelete(event.Key)
 	case discovery.ShardAssignmentChanged:
@@ -183,6 +209,114 @@ func (m *stateManager) processEvent(event *discovery.Event) {
 	}
 }
 
+// checkRetiredShards checks the retired shards and expired series deletions of databases periodically.
+func (m *stateManager) checkRetiredShards(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			now := timeutil.Now()
+			m.retireShards(now)
+			m.removeExpiredSeriesDeletions(now)
+		case <-m.ctx.Done():
+			m.logger.Info("check retired shards task is stopped")
+			return
+		}
+	}
+}
+
+// retireShards removes the retired shards whose data has expired from shard assignment,
+// after the number of shards of database reduced.
+func (m *stateManager) retireShards(now int64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for name, databaseCfg := range m.databases {
+		if shardAssign, ok := m.shardAssignments[name]; !ok || len(shardAssign.Routings) == 0 {
+			continue
+		}
+		cluster, ok := m.storages[databaseCfg.Storage]
+		if !ok {
+			continue
+		}
+		// get shard assignment from repo, maybe mem state is not sync.
+		shardAssign, err := m.GetShardAssign(name)
+		if err != nil {
+			m.logger.Warn("get shard assign error", logger.String("database", name), logger.Error(err))
+			continue
+		}
+		numOfRoutings := len(shardAssign.Routings)
+		retired := shardAssign.RetireShards(now - maxRetention(databaseCfg.Option))
+		if len(retired) == 0 && numOfRoutings == len(shardAssign.Routings) {
+			continue
+		}
+		m.logger.Info("retire shards",
+			logger.String("database", name),
+			logger.Any("shards", retired),
+			logger.Any("shardAssign", shardAssign))
+		if err := m.masterRepo.Put(m.ctx, constants.GetDatabaseAssignPath(name), encoding.JSONMarshal(shardAssign)); err != nil {
+			m.logger.Warn("save shard assign error", logger.String("database", name), logger.Error(err))
+			continue
+		}
+		if err := cluster.SaveDatabaseAssignment(shardAssign, databaseCfg.Option); err != nil {
+			m.logger.Warn("save database assignment error", logger.String("database", name), logger.Error(err))
+		}
+	}
+}
+
+// removeExpiredSeriesDeletions removes the series deletions whose deleted data has expired(dropped by data retention),
+// drops them from storage cluster first, then from broker repo, storage nodes remove the tombstones in data ttl task.
+func (m *stateManager) removeExpiredSeriesDeletions(now int64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for name, databaseCfg := range m.databases {
+		retention := maxRetention(databaseCfg.Option)
+		if retention <= 0 {
+			continue
+		}
+		cluster, ok := m.storages[databaseCfg.Storage]
+		if !ok {
+			continue
+		}
+		// series deletions are not kept in mem state, list them from repo.
+		prefix := constants.DatabaseDeletionPath + constants.StatePathSeparator + name + constants.StatePathSeparator
+		kvs, err := m.masterRepo.List(m.ctx, prefix)
+		if err != nil {
+			m.logger.Warn("list series deletions error", logger.String("database", name), logger.Error(err))
+			continue
+		}
+		expireTime := models.GetSeriesDeletionExpireTime(retention, now)
+		for _, kv := range kvs {
+			_, id, err := constants.ParseDatabaseDeletionPath(kv.Key)
+			if err != nil {
+				m.logger.Warn("parse series deletion path error", logger.String("key", kv.Key), logger.Error(err))
+				continue
+			}
+			deletion := &models.SeriesDeletion{}
+			if err := encoding.JSONUnmarshal(kv.Value, deletion); err != nil {
+				m.logger.Warn("unmarshal series deletion error", logger.String("key", kv.Key), logger.Error(err))
+				continue
+			}
+			if !deletion.IsExpired(expireTime) {
+				continue
+			}
+			if err := cluster.DropSeriesDeletion(name, id); err != nil {
+				m.logger.Warn("drop series deletion error", logger.String("database", name), logger.Error(err))
+				continue
+			}
+			if err := m.masterRepo.Delete(m.ctx, kv.Key); err != nil {
+				m.logger.Warn("delete series deletion error", logger.String("key", kv.Key), logger.Error(err))
+				continue
+			}
+			m.logger.Info("remove expired series deletion",
+				logger.String("database", name),
+				logger.Int64("id", id))
+		}
+	}
+}
+
 // SetStateMachineFactory sets state machine factory.
 func (m *stateManager) SetStateMachineFactory(stateMachineFct *StateMachineFactory) {
 	m.stateMachineFct = stateMachineFct
@@ -234,6 +368,33 @@ func (m *stateManager) onDatabaseLimitsChange(key string, data []byte) error {
 	return nil
 }
 
+// onSeriesDeletionChange triggers when delete series/drop metric submitted.
+func (m *stateManager) onSeriesDeletionChange(key string, data []byte) error {
+	m.logger.Info("sync series deletion, because series deletion is submitted",
+		logger.String("key", key))
+
+	name, id, err := constants.ParseDatabaseDeletionPath(key)
+	if err != nil {
+		return err
+	}
+	databaseCfg, ok := m.databases[name]
+	if !ok {
+		return constants.ErrDatabaseNotFound
+	}
+	storage, ok := m.storages[databaseCfg.Storage]
+	if !ok {
+		return nil
+	}
+	if err := storage.SetSeriesDeletion(name, id, data); err != nil {
+		m.logger.Error("sync series deletion failure",
+			logger.String("storage", databaseCfg.Storage),
+			logger.String("database", name),
+			logger.Error(err))
+		return err
+	}
+	return nil
+}
+
 // onDatabaseCfgDelete triggers when database config is deletion.
 func (m *stateManager) onDatabaseCfgDelete(key string) error {
 	m.logger.Info("database config deleted",
@@ -471,7 +632,7 @@ func (m *stateManager) shardAssignment(databaseCfg *models.Database) {
 				logger.Error(err))
 			return
 		}
-	case len(shardAssign.Shards) != databaseCfg.NumOfShard:
+	case shardAssign.NumOfShard() != databaseCfg.NumOfShard:
 		m.logger.Info("modify shard assignment starting....",
 			logger.String("storage", databaseCfg.Storage),
 			logger.Any("database", databaseCfg.Name))
@@ -521,12 +682,11 @@ func (m *stateManager) onNodeFailure(state *models.StorageState, nodeID models.N
 	m.logger.Debug("leader node is offline need elect new leader for shard",
 		logger.Any("shards", leadersOnOfflineNode))
 
-	liveNodes := state.LiveNodes
 	for db, shards := range leadersOnOfflineNode {
 		shardAssignment := state.ShardAssignments[db]
 		shardStates := state.ShardStates[db]
 		for _, shardID := range shards {
-			leader, err := m.elector.ElectLeader(shardAssignment, liveNodes, shardID)
+			leader, err := m.electLeader(state, shardAssignment, shardID)
 			shardState := shardStates[shardID]
 			m.shardLeaderStatistics.LeaderElections.Incr()
 			if err != nil {
@@ -572,27 +732,15 @@ func (m *stateManager) createShardAssignment(
 	cluster StorageCluster, cfg *models.Database,
 	startShardID models.ShardID, fixedStartIndex int,
 ) (*models.ShardAssignment, error) {
-	liveNodes, err := cluster.GetLiveNodes()
+	liveNodes, err := m.getAssignableNodes(cluster)
 	if err != nil {
 		return nil, err
 	}
-
-	if len(liveNodes) == 0 {
-		return nil, constants.ErrNoLiveNode
-	}
 	databaseName := cfg.Name
 	// TODO need calc resource and pick related node for store data
 
-	var nodeIDs []models.NodeID
-	nodes := make(map[models.NodeID]*models.StatefulNode)
-	for idx := range liveNodes {
-		node := liveNodes[idx]
-		nodeIDs = append(nodeIDs, node.ID)
-		nodes[node.ID] = &node
-	}
-
-	// generate shard assignment based on node ids and config
-	shardAssign, err := ShardAssignment(nodeIDs, cfg, fixedStartIndex, startShardID)
+	// generate shard assignment based on live nodes(id/location) and config
+	shardAssign, err := ShardAssignment(liveNodes, cfg, fixedStartIndex, startShardID)
 	if err != nil {
 		return nil, err
 	}
@@ -613,34 +761,50 @@ func (m *stateManager) createShardAssignment(
 	return shardAssign, nil
 }
 
+// getAssignableNodes returns the live nodes of storage cluster which can hold new replicas,
+// the decommissioning nodes are excluded.
+func (m *stateManager) getAssignableNodes(cluster StorageCluster) ([]models.StatefulNode, error) {
+	liveNodes, err := cluster.GetLiveNodes()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []models.StatefulNode
+	if len(liveNodes) > 0 {
+		state := cluster.GetState()
+		for _, node := range liveNodes {
+			if !state.IsDecommissioning(node.ID) {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, constants.ErrNoLiveNode
+	}
+	return nodes, nil
+}
+
 func (m *stateManager) modifyShardAssignment(
 	cluster StorageCluster, cfg *models.Database,
 	shardAssign *models.ShardAssignment,
 ) error {
-	nodes := make(map[models.NodeID]*models.StatefulNode)
-	if len(shardAssign.Shards) > cfg.NumOfShard { // reduce shardAssign's shards
-		// TODO implement the reduce shards, is needed?
-		panic("not implemented")
-	} else if len(shardAssign.Shards) < cfg.NumOfShard { // add shardAssign's shards
-		liveNodes, err := cluster.GetLiveNodes()
+	numOfShard := len(shardAssign.Shards)
+	if numOfShard > cfg.NumOfShard || len(shardAssign.Routings) > 0 ||
+		(cfg.Routing != nil && numOfShard > 0 && numOfShard < cfg.NumOfShard) {
+		// rows are routed by new number of shards since cut-over time, when reducing shards, retired shards are
+		// still queryable until their data expired; when adding shards of database with routing option, series
+		// stay in their shards before cut-over time, so that queries can prune shards by routing.
+		shardAssign.ChangeNumOfShard(cfg.NumOfShard, calcCutOverTime(cfg.Option, timeutil.Now()))
+	}
+	if len(shardAssign.Shards) < cfg.NumOfShard { // add shardAssign's shards
+		liveNodes, err := m.getAssignableNodes(cluster)
 		if err != nil {
 			return err
 		}
-		if len(liveNodes) == 0 {
-			return constants.ErrNoLiveNode
-		}
 		// TODO need calc resource and pick related node for store data
 
-		var nodeIDs []models.NodeID
-		for idx := range liveNodes {
-			node := liveNodes[idx]
-			nodeIDs = append(nodeIDs, node.ID)
-			nodes[node.ID] = &node
-		}
-
-		// generate shard assignment based on node ids and config
+		// generate shard assignment based on live nodes(id/location) and config
 		// TODO check start shard id
-		err = ModifyShardAssignment(nodeIDs, cfg, shardAssign, -1, models.ShardID(len(shardAssign.Shards)))
+		err = ModifyShardAssignment(liveNodes, cfg, shardAssign, -1, models.ShardID(len(shardAssign.Shards)))
 		if err != nil {
 			return err
 		}
@@ -728,13 +892,253 @@ func (m *stateManager) GetStorageStates() (rs []*models.StorageState) {
 	return
 }
 
+// SnapshotStorageStates returns the copy of current storage state list, it's safe to read concurrently.
+func (m *stateManager) SnapshotStorageStates() (rs []*models.StorageState) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	for _, storage := range m.storages {
+		state := &models.StorageState{}
+		if err := encoding.JSONUnmarshal(encoding.JSONMarshal(storage.GetState()), state); err != nil {
+			m.logger.Warn("copy storage state failure", logger.Error(err))
+			continue
+		}
+		rs = append(rs, state)
+	}
+	return
+}
+
+// MoveReplica moves the replica of database's shard from source node to target node,
+// then persists the shard assignment, shard state will be re-initialized after assignment changed.
+func (m *stateManager) MoveReplica(database string, shardID models.ShardID, source, target models.NodeID) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	databaseCfg, ok := m.databases[database]
+	if !ok {
+		return constants.ErrDatabaseNotFound
+	}
+	cluster, ok := m.storages[databaseCfg.Storage]
+	if !ok {
+		return constants.ErrNoStorageCluster
+	}
+	shardAssign, err := m.GetShardAssign(database)
+	if err != nil {
+		return err
+	}
+	replica, ok := shardAssign.Shards[shardID]
+	if !ok {
+		return constants.ErrShardNotFound
+	}
+	if !replica.Contain(source) {
+		if replica.Contain(target) {
+			// replica already moved
+			return nil
+		}
+		return constants.ErrReplicaNotFound
+	}
+	// keep the order of other replicas, make sure the leader of shard not changed if source node isn't leader.
+	replicas := make([]models.NodeID, 0, len(replica.Replicas))
+	for _, nodeID := range replica.Replicas {
+		if nodeID != source && nodeID != target {
+			replicas = append(replicas, nodeID)
+		}
+	}
+	replica.Replicas = append(replicas, target)
+
+	m.logger.Info("move replica",
+		logger.String("database", database),
+		logger.Any("shard", shardID),
+		logger.Any("source", source),
+		logger.Any("target", target),
+		logger.Any("replicas", replica.Replicas))
+
+	data := encoding.JSONMarshal(shardAssign)
+	if err := m.masterRepo.Put(m.ctx, constants.GetDatabaseAssignPath(database), data); err != nil {
+		return err
+	}
+	// save shard assignment into related storage repo.
+	return cluster.SaveDatabaseAssignment(shardAssign, databaseCfg.Option)
+}
+
+// SetDecommissions sets the decommissions of storage cluster, decommissioning nodes are excluded from
+// leader election and new shard assignment, shard leaders on them are moved to other live replicas.
+func (m *stateManager) SetDecommissions(storageName string, decommissions map[models.NodeID]*models.NodeDecommission) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	cluster, ok := m.storages[storageName]
+	if !ok {
+		return constants.ErrNoStorageCluster
+	}
+	// copy decommissions, caller may modify them
+	var copied map[models.NodeID]*models.NodeDecommission
+	for nodeID, d := range decommissions {
+		if copied == nil {
+			copied = make(map[models.NodeID]*models.NodeDecommission)
+		}
+		decommission := *d
+		copied[nodeID] = &decommission
+	}
+	state := cluster.GetState()
+	changed := !bytes.Equal(encoding.JSONMarshal(state.Decommissions), encoding.JSONMarshal(copied))
+	state.Decommissions = copied
+	for nodeID := range copied {
+		if m.transferLeaders(state, nodeID) {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return m.syncState(state)
+}
+
+// PreferredLeader returns the preferred leader of database's shard, which is elected from
+// the live replicas which are not decommissioning, based on replica order and leader placement.
+func (m *stateManager) PreferredLeader(database string, shardID models.ShardID) (models.NodeID, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	state, err := m.getStorageStateOfDatabase(database)
+	if err != nil {
+		return models.NoLeader, err
+	}
+	shardAssignment, ok := state.ShardAssignments[database]
+	if !ok {
+		return models.NoLeader, constants.ErrShardNotFound
+	}
+	if _, ok := shardAssignment.Shards[shardID]; !ok {
+		return models.NoLeader, constants.ErrShardNotFound
+	}
+	return m.electLeader(state, shardAssignment, shardID)
+}
+
+// TransferLeader transfers the leader of database's shard from source node to target replica,
+// then syncs the storage state, brokers switch write streams to new leader after state changed.
+func (m *stateManager) TransferLeader(database string, shardID models.ShardID, source, target models.NodeID) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	state, err := m.getStorageStateOfDatabase(database)
+	if err != nil {
+		return err
+	}
+	shardStates := state.ShardStates[database]
+	shardState, ok := shardStates[shardID]
+	if !ok {
+		return constants.ErrShardNotFound
+	}
+	if shardState.Leader == target {
+		return nil
+	}
+	_, alive := state.LiveNodes[target]
+	switch {
+	case shardState.Leader != source || shardState.State != models.OnlineShard:
+		err = errLeaderChanged
+	case !shardState.Replica.Contain(target):
+		err = constants.ErrReplicaNotFound
+	case !alive:
+		err = errTargetNotAlive
+	case state.IsDecommissioning(target):
+		err = errTargetDecommissioning
+	}
+	if err != nil {
+		m.shardLeaderStatistics.TransferFailures.Incr()
+		return err
+	}
+	shardState.Leader = target
+	shardStates[shardID] = shardState
+	if err := m.syncState(state); err != nil {
+		// rollback leader if sync state failure
+		shardState.Leader = source
+		shardStates[shardID] = shardState
+		m.shardLeaderStatistics.TransferFailures.Incr()
+		return err
+	}
+	m.shardLeaderStatistics.LeaderTransfers.Incr()
+	m.logger.Info("transfer shard leader",
+		logger.String("db", database),
+		logger.Any("shard", shardID),
+		logger.Any("source", source),
+		logger.Any("target", target))
+	return nil
+}
+
+// getStorageStateOfDatabase returns the state of storage cluster which database belongs to.
+func (m *stateManager) getStorageStateOfDatabase(database string) (*models.StorageState, error) {
+	databaseCfg, ok := m.databases[database]
+	if !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	cluster, ok := m.storages[databaseCfg.Storage]
+	if !ok {
+		return nil, constants.ErrNoStorageCluster
+	}
+	return cluster.GetState(), nil
+}
+
+// transferLeaders moves the shard leaders on decommissioning node to other live replicas,
+// returns if any leader changed.
+func (m *stateManager) transferLeaders(state *models.StorageState, nodeID models.NodeID) (changed bool) {
+	for db, shards := range state.LeadersOnNode(nodeID) {
+		shardAssignment := state.ShardAssignments[db]
+		shardStates := state.ShardStates[db]
+		for _, shardID := range shards {
+			leader, err := m.electLeader(state, shardAssignment, shardID)
+			m.shardLeaderStatistics.LeaderElections.Incr()
+			if err != nil {
+				m.shardLeaderStatistics.LeaderElectFailures.Incr()
+				m.logger.Warn("elect shard leader for decommissioning node err",
+					logger.String("db", db),
+					logger.Any("shard", shardID), logger.Error(err))
+				continue
+			}
+			if leader == nodeID {
+				// no other live replica, keep leader until replica moved
+				continue
+			}
+			shardState := shardStates[shardID]
+			shardState.Leader = leader
+			shardStates[shardID] = shardState
+			changed = true
+			m.logger.Info("move shard leader away from decommissioning node",
+				logger.String("db", db),
+				logger.Any("shard", shardID),
+				logger.Any("node", nodeID),
+				logger.Any("leader", leader))
+		}
+	}
+	return changed
+}
+
+// electLeader elects the leader of shard from the live replicas which are not decommissioning,
+// falls back to all live replicas if only decommissioning replicas are live.
+func (m *stateManager) electLeader(state *models.StorageState,
+	shardAssignment *models.ShardAssignment, shardID models.ShardID,
+) (models.NodeID, error) {
+	placement := m.getLeaderPlacement(shardAssignment.Name)
+	leader, err := m.elector.ElectLeader(shardAssignment, state.ElectableNodes(), shardID, placement)
+	if errors.Is(err, constants.ErrNoLiveReplica) && len(state.Decommissions) > 0 {
+		return m.elector.ElectLeader(shardAssignment, state.LiveNodes, shardID, placement)
+	}
+	return leader, err
+}
+
+// getLeaderPlacement returns the leader placement of database, returns nil if not set.
+func (m *stateManager) getLeaderPlacement(database string) *models.LeaderPlacement {
+	if databaseCfg, ok := m.databases[database]; ok {
+		return databaseCfg.LeaderPlacement
+	}
+	return nil
+}
+
 // initializeShardState initializes the shard state based on shard assignment for storage cluster.
 func (m *stateManager) initializeShardState(storage StorageCluster, shardAssignment *models.ShardAssignment) {
 	storageState := storage.GetState()
-	liveNodes := storageState.LiveNodes
 	shardStates := make(map[models.ShardID]models.ShardState)
 	for shardID, replicas := range shardAssignment.Shards {
-		leader, err := m.elector.ElectLeader(shardAssignment, liveNodes, shardID)
+		leader, err := m.electLeader(storageState, shardAssignment, shardID)
 		shardState := models.ShardState{ID: shardID, Replica: *replicas}
 		m.shardLeaderStatistics.LeaderElections.Incr()
 		if err != nil {
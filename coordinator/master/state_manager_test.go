@@ -27,11 +27,13 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/discovery"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 func TestStateManager_Close(t *testing.T) {
@@ -336,8 +338,8 @@ func TestStateManager_ShardAssignment(t *testing.T) {
 		Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{2, 3}}, 2: {Replicas: []models.NodeID{2, 3}}},
 	})
 	storage.EXPECT().GetState().Return(models.NewStorageState("test")).AnyTimes()
-	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(2), nil)
-	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(0), fmt.Errorf("err"))
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(2), nil)
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(0), fmt.Errorf("err"))
 	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 	mgr.EmitEvent(&discovery.Event{
 		Type:  discovery.ShardAssignmentChanged,
@@ -345,8 +347,8 @@ func TestStateManager_ShardAssignment(t *testing.T) {
 		Value: data,
 	})
 	// case 2: put state err
-	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(2), nil)
-	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(0), fmt.Errorf("err"))
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(2), nil)
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(0), fmt.Errorf("err"))
 	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 	mgr.EmitEvent(&discovery.Event{
 		Type:  discovery.ShardAssignmentChanged,
@@ -379,6 +381,8 @@ func TestStateManager_createShardAssign(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, shardAssign)
 	// case 3: assign shard err
+	storageState := models.NewStorageState("test")
+	storage.EXPECT().GetState().Return(storageState).AnyTimes()
 	storage.EXPECT().GetLiveNodes().Return([]models.StatefulNode{{ID: 1}, {ID: 2}, {ID: 3}}, nil).AnyTimes()
 	shardAssign, err = mgr1.createShardAssignment(storage, &models.Database{Name: "test"}, -1, -1)
 	assert.Error(t, err)
@@ -405,6 +409,24 @@ func TestStateManager_createShardAssign(t *testing.T) {
 		-1, -1)
 	assert.NoError(t, err)
 	assert.NotNil(t, shardAssign)
+	// case 6: decommissioning node is excluded
+	storageState.Decommissions = map[models.NodeID]*models.NodeDecommission{3: {NodeID: 3}}
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	storage.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).Return(nil)
+	shardAssign, err = mgr1.createShardAssignment(storage,
+		&models.Database{Name: "test", NumOfShard: 3, ReplicaFactor: 2},
+		-1, -1)
+	assert.NoError(t, err)
+	for _, replica := range shardAssign.Shards {
+		assert.False(t, replica.Contain(3))
+	}
+	// case 7: all live nodes are decommissioning
+	storageState.Decommissions = map[models.NodeID]*models.NodeDecommission{1: {NodeID: 1}, 2: {NodeID: 2}, 3: {NodeID: 3}}
+	shardAssign, err = mgr1.createShardAssignment(storage,
+		&models.Database{Name: "test", NumOfShard: 3, ReplicaFactor: 2},
+		-1, -1)
+	assert.Equal(t, constants.ErrNoLiveNode, err)
+	assert.Nil(t, shardAssign)
 }
 
 func TestStateManager_modifyShardAssign(t *testing.T) {
@@ -417,15 +439,25 @@ func TestStateManager_modifyShardAssign(t *testing.T) {
 	storage.EXPECT().Close().AnyTimes()
 	mgr := NewStateManager(context.TODO(), repo, nil)
 	mgr1 := mgr.(*stateManager)
-	// case 1: no impl
-	assert.Panics(t, func() {
-		_ = mgr1.modifyShardAssignment(storage,
-			&models.Database{Name: "test"},
-			&models.ShardAssignment{Shards: map[models.ShardID]*models.Replica{1: {}, 2: {}}})
-	})
+	// case 1: reduce shards
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	storage.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).Return(nil)
+	shardAssign := &models.ShardAssignment{Shards: map[models.ShardID]*models.Replica{0: {}, 1: {}}}
+	err := mgr1.modifyShardAssignment(storage, &models.Database{Name: "test", NumOfShard: 1}, shardAssign)
+	assert.NoError(t, err)
+	assert.Len(t, shardAssign.Shards, 2)
+	assert.Equal(t, 1, shardAssign.NumOfShard())
+	assert.Len(t, shardAssign.Routings, 2)
+	// case 2: increase shards after reduced, reuse retired shards
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	storage.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).Return(nil)
+	err = mgr1.modifyShardAssignment(storage, &models.Database{Name: "test", NumOfShard: 2}, shardAssign)
+	assert.NoError(t, err)
+	assert.Len(t, shardAssign.Shards, 2)
+	assert.Equal(t, 2, shardAssign.NumOfShard())
 	// case 2: get live nodes err
 	storage.EXPECT().GetLiveNodes().Return(nil, fmt.Errorf("err"))
-	err := mgr1.modifyShardAssignment(storage,
+	err = mgr1.modifyShardAssignment(storage,
 		&models.Database{Name: "test", NumOfShard: 3},
 		&models.ShardAssignment{Shards: map[models.ShardID]*models.Replica{1: {}, 2: {}}})
 	assert.Error(t, err)
@@ -436,6 +468,7 @@ func TestStateManager_modifyShardAssign(t *testing.T) {
 		&models.ShardAssignment{Shards: map[models.ShardID]*models.Replica{1: {}, 2: {}}})
 	assert.Error(t, err)
 	// case 4: modify err
+	storage.EXPECT().GetState().Return(models.NewStorageState("test")).AnyTimes()
 	storage.EXPECT().GetLiveNodes().Return([]models.StatefulNode{{ID: 1}, {ID: 2}, {ID: 3}}, nil).AnyTimes()
 	err = mgr1.modifyShardAssignment(storage,
 		&models.Database{Name: "test", NumOfShard: 3},
@@ -460,6 +493,19 @@ func TestStateManager_modifyShardAssign(t *testing.T) {
 		&models.Database{Name: "test", NumOfShard: 3, ReplicaFactor: 2},
 		&models.ShardAssignment{Shards: map[models.ShardID]*models.Replica{1: {}, 2: {}}})
 	assert.NoError(t, err)
+	// case 7: increase shards of database with routing option, keep routing history
+	storage.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).Return(nil)
+	shardAssign = &models.ShardAssignment{Shards: map[models.ShardID]*models.Replica{0: {}, 1: {}}}
+	err = mgr1.modifyShardAssignment(storage,
+		&models.Database{
+			Name: "test", NumOfShard: 3, ReplicaFactor: 2,
+			Routing: &models.Routing{Method: models.ConsistentHashRouting},
+		}, shardAssign)
+	assert.NoError(t, err)
+	assert.Len(t, shardAssign.Shards, 3)
+	assert.Len(t, shardAssign.Routings, 2)
+	assert.Equal(t, 2, shardAssign.Routings[0].NumOfShard)
+	assert.Equal(t, 3, shardAssign.NumOfShard())
 }
 
 func TestStateManager_StorageNodeStartup(t *testing.T) {
@@ -641,3 +687,376 @@ func TestStateManager_onDatabaseLimits(t *testing.T) {
 	})
 	time.Sleep(100 * time.Millisecond)
 }
+
+func TestStateManager_onSeriesDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := state.NewMockRepository(ctrl)
+	storage := NewMockStorageCluster(ctrl)
+	mgr := NewStateManager(context.TODO(), repo, nil)
+	mgr1 := mgr.(*stateManager)
+	mgr1.mutex.Lock()
+	mgr1.storages["test"] = storage
+	mgr1.databases["db"] = &models.Database{Storage: "test"}
+	mgr1.databases["db1"] = &models.Database{Storage: "test1"}
+	mgr1.mutex.Unlock()
+
+	// case 1: invalid key
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.SeriesDeletionChanged,
+		Key:   "/database/deletion/db",
+		Value: []byte("dd"),
+	})
+	// case 2: database not exist
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.SeriesDeletionChanged,
+		Key:   "/database/deletion/db2/1",
+		Value: []byte("dd"),
+	})
+	// case 3: storage not exist
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.SeriesDeletionChanged,
+		Key:   "/database/deletion/db1/1",
+		Value: []byte("dd"),
+	})
+	// case 4: set deletion failure
+	storage.EXPECT().SetSeriesDeletion("db", int64(1), []byte("dd")).Return(fmt.Errorf("err"))
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.SeriesDeletionChanged,
+		Key:   "/database/deletion/db/1",
+		Value: []byte("dd"),
+	})
+	// case 5: set deletion successfully
+	storage.EXPECT().SetSeriesDeletion("db", int64(2), []byte("dd")).Return(nil)
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.SeriesDeletionChanged,
+		Key:   "/database/deletion/db/2",
+		Value: []byte("dd"),
+	})
+	time.Sleep(100 * time.Millisecond)
+}
+
+func TestStateManager_SnapshotStorageStates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := NewMockStorageCluster(ctrl)
+	mgr := NewStateManager(context.TODO(), nil, nil)
+	mgr1 := mgr.(*stateManager)
+	mgr1.storages["test"] = storage
+	storageState := models.NewStorageState("test")
+	storageState.NodeOnline(models.StatefulNode{ID: 1})
+	storage.EXPECT().GetState().Return(storageState)
+	states := mgr.SnapshotStorageStates()
+	assert.Len(t, states, 1)
+	assert.Equal(t, storageState, states[0])
+	// modify snapshot not affect storage state
+	states[0].NodeOffline(1)
+	assert.Len(t, storageState.LiveNodes, 1)
+}
+
+func TestStateManager_MoveReplica(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	storage := NewMockStorageCluster(ctrl)
+	mgr := NewStateManager(context.TODO(), repo, nil)
+	mgr1 := mgr.(*stateManager)
+	shardAssign := func() []byte {
+		return encoding.JSONMarshal(&models.ShardAssignment{
+			Name:   "test",
+			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2, 3}}},
+		})
+	}
+	// case 1: database not found
+	assert.Equal(t, constants.ErrDatabaseNotFound, mgr.MoveReplica("test", 1, 1, 4))
+	// case 2: storage not found
+	mgr1.databases["test"] = &models.Database{Name: "test", Storage: "s"}
+	assert.Equal(t, constants.ErrNoStorageCluster, mgr.MoveReplica("test", 1, 1, 4))
+	mgr1.storages["s"] = storage
+	// case 3: get shard assignment failure
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, mgr.MoveReplica("test", 1, 1, 4))
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("abc"), nil)
+	assert.Error(t, mgr.MoveReplica("test", 1, 1, 4))
+	// case 4: shard not found
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(shardAssign(), nil)
+	assert.Equal(t, constants.ErrShardNotFound, mgr.MoveReplica("test", 2, 1, 4))
+	// case 5: replica not found
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(shardAssign(), nil)
+	assert.Equal(t, constants.ErrReplicaNotFound, mgr.MoveReplica("test", 1, 5, 4))
+	// case 6: replica already moved
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(shardAssign(), nil)
+	assert.NoError(t, mgr.MoveReplica("test", 1, 5, 3))
+	// case 7: put shard assignment failure
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(shardAssign(), nil)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, mgr.MoveReplica("test", 1, 1, 4))
+	// case 8: move replica successfully, leader is moved to the end
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(shardAssign(), nil)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	storage.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(assign *models.ShardAssignment, _ *option.DatabaseOption) error {
+			assert.Equal(t, []models.NodeID{2, 3, 4}, assign.Shards[1].Replicas)
+			return nil
+		})
+	assert.NoError(t, mgr.MoveReplica("test", 1, 1, 4))
+}
+
+func TestStateManager_retireShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	storage := NewMockStorageCluster(ctrl)
+	storage.EXPECT().Close().AnyTimes()
+	mgr := NewStateManager(context.TODO(), repo, nil)
+	mgr1 := mgr.(*stateManager)
+	now := time.Now().UnixMilli()
+	opt := &option.DatabaseOption{Intervals: option.Intervals{{Interval: timeutil.Interval(timeutil.OneSecond),
+		Retention: timeutil.Interval(timeutil.OneHour)}}}
+	newShardAssign := func() *models.ShardAssignment {
+		shardAssign := models.NewShardAssignment("test")
+		for i := 0; i < 4; i++ {
+			shardAssign.AddReplica(models.ShardID(i), 1)
+		}
+		shardAssign.ChangeNumOfShard(2, now-2*timeutil.OneHour)
+		return shardAssign
+	}
+	mgr1.mutex.Lock()
+	mgr1.databases["test"] = &models.Database{Name: "test", Storage: "s", Option: opt}
+	mgr1.databases["no-routing"] = &models.Database{Name: "no-routing", Storage: "s"}
+	mgr1.databases["no-storage"] = &models.Database{Name: "no-storage", Storage: "s2"}
+	mgr1.shardAssignments["test"] = newShardAssign()
+	mgr1.shardAssignments["no-routing"] = models.NewShardAssignment("no-routing")
+	mgr1.shardAssignments["no-storage"] = newShardAssign()
+	mgr1.storages["s"] = storage
+	mgr1.mutex.Unlock()
+
+	// get shard assign failure
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	mgr1.retireShards(now)
+	// save shard assign failure
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(encoding.JSONMarshal(newShardAssign()), nil)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	mgr1.retireShards(now)
+	// save database assignment failure
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(encoding.JSONMarshal(newShardAssign()), nil)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	storage.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	mgr1.retireShards(now)
+	// data not expired
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(encoding.JSONMarshal(newShardAssign()), nil)
+	mgr1.retireShards(now - 2*timeutil.OneHour)
+	// retire shards successfully
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(encoding.JSONMarshal(newShardAssign()), nil)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	storage.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(shardAssign *models.ShardAssignment, _ *option.DatabaseOption) error {
+			assert.Len(t, shardAssign.Shards, 2)
+			assert.Empty(t, shardAssign.Routings)
+			return nil
+		})
+	mgr1.retireShards(now)
+	mgr.Close()
+}
+
+func TestStateManager_removeExpiredSeriesDeletions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	storage := NewMockStorageCluster(ctrl)
+	storage.EXPECT().Close().AnyTimes()
+	mgr := NewStateManager(context.TODO(), repo, nil)
+	mgr1 := mgr.(*stateManager)
+	now := time.Now().UnixMilli()
+	opt := &option.DatabaseOption{Intervals: option.Intervals{{Interval: timeutil.Interval(timeutil.OneSecond),
+		Retention: timeutil.Interval(timeutil.OneDay)}}}
+	mgr1.mutex.Lock()
+	mgr1.databases["test"] = &models.Database{Name: "test", Storage: "s", Option: opt}
+	mgr1.databases["no-option"] = &models.Database{Name: "no-option", Storage: "s"}
+	mgr1.databases["no-storage"] = &models.Database{Name: "no-storage", Storage: "s2", Option: opt}
+	mgr1.storages["s"] = storage
+	mgr1.mutex.Unlock()
+
+	expired := &models.SeriesDeletion{ID: 1, TimeRange: timeutil.TimeRange{Start: 10, End: now - 2*timeutil.OneDay}}
+	notExpired := &models.SeriesDeletion{ID: 2, TimeRange: timeutil.TimeRange{Start: 10, End: now - timeutil.OneDay}}
+	kvs := []state.KeyValue{
+		{Key: "/database/deletion/test", Value: encoding.JSONMarshal(expired)},
+		{Key: "/database/deletion/test/3", Value: []byte("err")},
+		{Key: "/database/deletion/test/2", Value: encoding.JSONMarshal(notExpired)},
+		{Key: "/database/deletion/test/1", Value: encoding.JSONMarshal(expired)},
+	}
+	// list deletions failure
+	repo.EXPECT().List(gomock.Any(), "/database/deletion/test/").Return(nil, fmt.Errorf("err"))
+	mgr1.removeExpiredSeriesDeletions(now)
+	// drop deletion from storage failure
+	repo.EXPECT().List(gomock.Any(), "/database/deletion/test/").Return(kvs, nil)
+	storage.EXPECT().DropSeriesDeletion("test", int64(1)).Return(fmt.Errorf("err"))
+	mgr1.removeExpiredSeriesDeletions(now)
+	// delete deletion from repo failure
+	repo.EXPECT().List(gomock.Any(), "/database/deletion/test/").Return(kvs, nil)
+	storage.EXPECT().DropSeriesDeletion("test", int64(1)).Return(nil)
+	repo.EXPECT().Delete(gomock.Any(), "/database/deletion/test/1").Return(fmt.Errorf("err"))
+	mgr1.removeExpiredSeriesDeletions(now)
+	// remove expired deletion successfully
+	repo.EXPECT().List(gomock.Any(), "/database/deletion/test/").Return(kvs, nil)
+	storage.EXPECT().DropSeriesDeletion("test", int64(1)).Return(nil)
+	repo.EXPECT().Delete(gomock.Any(), "/database/deletion/test/1").Return(nil)
+	mgr1.removeExpiredSeriesDeletions(now)
+	mgr.Close()
+}
+
+func TestStateManager_checkRetiredShards(t *testing.T) {
+	defer func() {
+		retiredShardsCheckInterval = 10 * time.Minute
+	}()
+	retiredShardsCheckInterval = time.Millisecond
+	mgr := NewStateManager(context.TODO(), nil, nil)
+	time.Sleep(10 * time.Millisecond)
+	mgr.Close()
+}
+
+func TestCalcCutOverTime(t *testing.T) {
+	now := time.Now().UnixMilli()
+	assert.Equal(t, now+cutOverDelay.Milliseconds(), calcCutOverTime(nil, now))
+	opt := &option.DatabaseOption{Intervals: option.Intervals{
+		{Interval: timeutil.Interval(5 * timeutil.OneMinute), Retention: timeutil.Interval(timeutil.OneMonth)},
+		{Interval: timeutil.Interval(10 * timeutil.OneSecond), Retention: timeutil.Interval(timeutil.OneDay)},
+	}}
+	cutOverTime := calcCutOverTime(opt, now)
+	assert.True(t, cutOverTime > now+cutOverDelay.Milliseconds())
+	calc := timeutil.Interval(10 * timeutil.OneSecond).Calculator()
+	assert.Equal(t, cutOverTime, calc.CalcFamilyTime(cutOverTime))
+	assert.Equal(t, timeutil.OneMonth, maxRetention(opt))
+	assert.Zero(t, maxRetention(nil))
+}
+
+func TestStateManager_onNodeFailure_LeaderPlacement(t *testing.T) {
+	mgr := NewStateManager(context.TODO(), nil, nil)
+	mgr1 := mgr.(*stateManager)
+	assert.Nil(t, mgr1.getLeaderPlacement("db"))
+	placement := &models.LeaderPlacement{PreferredZones: []string{"c"}}
+	mgr1.databases["db"] = &models.Database{Name: "db", LeaderPlacement: placement}
+	assert.Equal(t, placement, mgr1.getLeaderPlacement("db"))
+
+	storageState := models.NewStorageState("test")
+	storageState.NodeOnline(models.StatefulNode{ID: 1, Zone: "a"})
+	storageState.NodeOnline(models.StatefulNode{ID: 2, Zone: "b"})
+	storageState.NodeOnline(models.StatefulNode{ID: 3, Zone: "c"})
+	shardAssignment := models.NewShardAssignment("db")
+	shardAssignment.AddReplica(1, 1)
+	shardAssignment.AddReplica(1, 2)
+	shardAssignment.AddReplica(1, 3)
+	storageState.ShardAssignments["db"] = shardAssignment
+	storageState.ShardStates["db"] = map[models.ShardID]models.ShardState{
+		1: {ID: 1, State: models.OnlineShard, Leader: 1, Replica: *shardAssignment.Shards[1]},
+	}
+	storageState.NodeOffline(1)
+	mgr1.onNodeFailure(storageState, 1)
+	assert.Equal(t, models.NodeID(3), storageState.ShardStates["db"][1].Leader)
+}
+
+func TestStateManager_SetDecommissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	storage := NewMockStorageCluster(ctrl)
+	mgr := NewStateManager(context.TODO(), repo, nil)
+	mgr1 := mgr.(*stateManager)
+	decommissions := map[models.NodeID]*models.NodeDecommission{1: {Storage: "test", NodeID: 1}}
+	// case 1: storage not found
+	assert.Equal(t, constants.ErrNoStorageCluster, mgr.SetDecommissions("test", decommissions))
+
+	storageState := models.NewStorageState("test")
+	storageState.NodeOnline(models.StatefulNode{ID: 1})
+	storageState.NodeOnline(models.StatefulNode{ID: 2})
+	shardAssignment := models.NewShardAssignment("db")
+	shardAssignment.AddReplica(1, 1)
+	shardAssignment.AddReplica(1, 2)
+	shardAssignment.AddReplica(2, 1)
+	storageState.ShardAssignments["db"] = shardAssignment
+	storageState.ShardStates["db"] = map[models.ShardID]models.ShardState{
+		1: {ID: 1, State: models.OnlineShard, Leader: 1},
+		2: {ID: 2, State: models.OnlineShard, Leader: 1},
+	}
+	storage.EXPECT().GetState().Return(storageState).AnyTimes()
+	mgr1.storages["test"] = storage
+	// case 2: sync state failure
+	repo.EXPECT().Put(gomock.Any(), "/storage/state/test", gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, mgr.SetDecommissions("test", decommissions))
+	// leader of shard 1 moved, leader of shard 2 kept because no other live replica
+	assert.True(t, storageState.IsDecommissioning(1))
+	assert.Equal(t, models.NodeID(2), storageState.ShardStates["db"][1].Leader)
+	assert.Equal(t, models.NodeID(1), storageState.ShardStates["db"][2].Leader)
+	// case 3: nothing changed
+	assert.NoError(t, mgr.SetDecommissions("test", decommissions))
+	// case 4: decommission node not elected after shard assignment changed
+	mgr1.initializeShardState(storage, shardAssignment)
+	assert.Equal(t, models.NodeID(2), storageState.ShardStates["db"][1].Leader)
+	assert.Equal(t, models.NodeID(1), storageState.ShardStates["db"][2].Leader)
+	// case 5: remove decommissions
+	repo.EXPECT().Put(gomock.Any(), "/storage/state/test", gomock.Any()).Return(nil)
+	assert.NoError(t, mgr.SetDecommissions("test", map[models.NodeID]*models.NodeDecommission{}))
+	assert.Nil(t, storageState.Decommissions)
+	assert.False(t, storageState.IsDecommissioning(1))
+}
+
+func TestStateManager_TransferLeader(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	storage := NewMockStorageCluster(ctrl)
+	mgr := NewStateManager(context.TODO(), repo, nil)
+	mgr1 := mgr.(*stateManager)
+	// case 1: database not found
+	assert.Equal(t, constants.ErrDatabaseNotFound, mgr.TransferLeader("db", 1, 1, 2))
+	_, err := mgr.PreferredLeader("db", 1)
+	assert.Equal(t, constants.ErrDatabaseNotFound, err)
+	// case 2: storage not found
+	mgr1.databases["db"] = &models.Database{Name: "db", Storage: "test"}
+	assert.Equal(t, constants.ErrNoStorageCluster, mgr.TransferLeader("db", 1, 1, 2))
+
+	storageState := models.NewStorageState("test")
+	storageState.NodeOnline(models.StatefulNode{ID: 1})
+	storageState.NodeOnline(models.StatefulNode{ID: 2})
+	storageState.NodeOnline(models.StatefulNode{ID: 4})
+	shardAssignment := models.NewShardAssignment("db")
+	shardAssignment.AddReplica(1, 1)
+	shardAssignment.AddReplica(1, 2)
+	shardAssignment.AddReplica(1, 3)
+	storageState.ShardAssignments["db"] = shardAssignment
+	storageState.ShardStates["db"] = map[models.ShardID]models.ShardState{
+		1: {ID: 1, State: models.OnlineShard, Leader: 2, Replica: *shardAssignment.Shards[1]},
+	}
+	storage.EXPECT().GetState().Return(storageState).AnyTimes()
+	mgr1.storages["test"] = storage
+	// case 3: preferred leader
+	_, err = mgr.PreferredLeader("db", 2)
+	assert.Equal(t, constants.ErrShardNotFound, err)
+	leader, err := mgr.PreferredLeader("db", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, models.NodeID(1), leader)
+	// case 4: invalid transfer
+	assert.Equal(t, constants.ErrShardNotFound, mgr.TransferLeader("db", 2, 2, 1))
+	assert.Equal(t, errLeaderChanged, mgr.TransferLeader("db", 1, 4, 1))
+	assert.Equal(t, constants.ErrReplicaNotFound, mgr.TransferLeader("db", 1, 2, 4))
+	assert.Equal(t, errTargetNotAlive, mgr.TransferLeader("db", 1, 2, 3))
+	storageState.Decommissions = map[models.NodeID]*models.NodeDecommission{1: {NodeID: 1}}
+	assert.Equal(t, errTargetDecommissioning, mgr.TransferLeader("db", 1, 2, 1))
+	storageState.Decommissions = nil
+	// case 5: sync state failure, rollback leader
+	repo.EXPECT().Put(gomock.Any(), "/storage/state/test", gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, mgr.TransferLeader("db", 1, 2, 1))
+	assert.Equal(t, models.NodeID(2), storageState.ShardStates["db"][1].Leader)
+	// case 6: transfer successfully
+	repo.EXPECT().Put(gomock.Any(), "/storage/state/test", gomock.Any()).Return(nil)
+	assert.NoError(t, mgr.TransferLeader("db", 1, 2, 1))
+	assert.Equal(t, models.NodeID(1), storageState.ShardStates["db"][1].Leader)
+	// case 7: target is leader already
+	assert.NoError(t, mgr.TransferLeader("db", 1, 2, 1))
+}
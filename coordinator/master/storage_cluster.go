@@ -55,6 +55,10 @@ type StorageCluster interface {
 	) error
 	// SetDatabaseLimits sets the database's limits.
 	SetDatabaseLimits(database string, limits []byte) error
+	// SetSeriesDeletion sets the database's series deletion(delete series/drop metric).
+	SetSeriesDeletion(database string, id int64, deletion []byte) error
+	// DropSeriesDeletion drops the database's series deletion after all deleted data expired.
+	DropSeriesDeletion(database string, id int64) error
 	// DropDatabaseAssignment drops database assignment from storage state repo.
 	DropDatabaseAssignment(databaseName string) error
 	// GetRepo returns current storage cluster's state repo
@@ -162,6 +166,30 @@ func (c *storageCluster) SetDatabaseLimits(database string, limits []byte) error
 	return nil
 }
 
+// SetSeriesDeletion sets the database's series deletion(delete series/drop metric).
+func (c *storageCluster) SetSeriesDeletion(database string, id int64, deletion []byte) error {
+	if err := c.storageRepo.Put(c.ctx, constants.GetDatabaseDeletionPath(database, id), deletion); err != nil {
+		return err
+	}
+	c.logger.Info("set database's series deletion successfully",
+		logger.String("storage", c.cfg.Config.Namespace),
+		logger.String("database", database),
+		logger.Int64("id", id))
+	return nil
+}
+
+// DropSeriesDeletion drops the database's series deletion after all deleted data expired.
+func (c *storageCluster) DropSeriesDeletion(database string, id int64) error {
+	if err := c.storageRepo.Delete(c.ctx, constants.GetDatabaseDeletionPath(database, id)); err != nil {
+		return err
+	}
+	c.logger.Info("drop database's series deletion successfully",
+		logger.String("storage", c.cfg.Config.Namespace),
+		logger.String("database", database),
+		logger.Int64("id", id))
+	return nil
+}
+
 // SaveDatabaseAssignment saves database assignment in storage state repo.
 func (c *storageCluster) SaveDatabaseAssignment(
 	shardAssign *models.ShardAssignment,
@@ -214,6 +214,44 @@ func TestStorageCluster_SetLimits(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestStorageCluster_SetSeriesDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	sc := &storageCluster{
+		cfg:         &config.StorageCluster{Config: &config.RepoState{Namespace: "test"}},
+		storageRepo: repo,
+		logger:      logger.GetLogger("Master", "Test"),
+	}
+	repo.EXPECT().Put(gomock.Any(), "/database/deletion/test/10", gomock.Any()).Return(fmt.Errorf("err"))
+	err := sc.SetSeriesDeletion("test", 10, []byte{})
+	assert.Error(t, err)
+
+	repo.EXPECT().Put(gomock.Any(), "/database/deletion/test/10", gomock.Any()).Return(nil)
+	err = sc.SetSeriesDeletion("test", 10, []byte{})
+	assert.NoError(t, err)
+}
+
+func TestStorageCluster_DropSeriesDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	sc := &storageCluster{
+		cfg:         &config.StorageCluster{Config: &config.RepoState{Namespace: "test"}},
+		storageRepo: repo,
+		logger:      logger.GetLogger("Master", "Test"),
+	}
+	repo.EXPECT().Delete(gomock.Any(), "/database/deletion/test/10").Return(fmt.Errorf("err"))
+	err := sc.DropSeriesDeletion("test", 10)
+	assert.Error(t, err)
+
+	repo.EXPECT().Delete(gomock.Any(), "/database/deletion/test/10").Return(nil)
+	err = sc.DropSeriesDeletion("test", 10)
+	assert.NoError(t, err)
+}
+
 func TestStorageCluster_SaveDatabaseAssignment(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
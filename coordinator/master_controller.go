@@ -23,10 +23,12 @@ import (
 	"sync"
 	"time"
 
+	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/discovery"
 	"github.com/lindb/lindb/coordinator/elect"
 	masterpkg "github.com/lindb/lindb/coordinator/master"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
@@ -42,6 +44,8 @@ var (
 	newRegistryFn        = discovery.NewRegistry
 	newStateMgrFn        = masterpkg.NewStateManager
 	newStateMachineFctFn = masterpkg.NewStateMachineFactory
+	newRebalancerFn      = masterpkg.NewRebalancer
+	newLeaderBalancerFn  = masterpkg.NewLeaderBalancer
 )
 
 var log = logger.GetLogger("Master", "MasterController")
@@ -57,6 +61,9 @@ type MasterCfg struct {
 	// factory
 	DiscoveryFactory discovery.Factory
 	RepoFactory      state.RepositoryFactory
+
+	// shard rebalance
+	Rebalance config.Rebalance
 }
 
 // MasterController represents all metadata/state controller, only has one active master in broker cluster.
@@ -89,6 +96,8 @@ type masterController struct {
 
 	// create by runtime
 	stateMachineFct *masterpkg.StateMachineFactory
+	rebalancer      masterpkg.Rebalancer
+	leaderBalancer  masterpkg.LeaderBalancer
 	elect           elect.Election
 	registry        discovery.Registry
 
@@ -147,6 +156,16 @@ func (m *masterController) OnFailOver() error {
 		m.statistics.FailOverFailures.Incr()
 		return fmt.Errorf("register elected master node error:%s", err)
 	}
+	// start shard rebalancer after master state machine started,
+	// it always runs for storage node decommission, automatic rebalancing is controlled by config.
+	m.rebalancer = newRebalancerFn(m.ctx, m.cfg.Rebalance, stateMgr, m.cfg.Repo,
+		client.NewMigrationCli(time.Minute))
+	m.rebalancer.Start()
+	// start shard leader balancer, it always runs for leader transfer submitted by user,
+	// moving leadership back to preferred replica is controlled by config.
+	m.leaderBalancer = newLeaderBalancerFn(m.ctx, m.cfg.Rebalance, stateMgr, m.cfg.Repo,
+		client.NewReplicaCli(10*time.Second))
+	m.leaderBalancer.Start()
 	m.statistics.FailOvers.Incr()
 	return nil
 }
@@ -157,6 +176,14 @@ func (m *masterController) OnResignation() {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	if m.rebalancer != nil {
+		m.rebalancer.Stop()
+		m.rebalancer = nil
+	}
+	if m.leaderBalancer != nil {
+		m.leaderBalancer.Stop()
+		m.leaderBalancer = nil
+	}
 	if m.stateMachineFct != nil {
 		m.stateMachineFct.Stop()
 		m.stateMachineFct = nil
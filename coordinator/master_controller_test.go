@@ -25,9 +25,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/coordinator/discovery"
 	"github.com/lindb/lindb/coordinator/elect"
 	masterpkg "github.com/lindb/lindb/coordinator/master"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
@@ -52,6 +54,8 @@ func TestMasterController_OnFailOver(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
 		newStateMgrFn = masterpkg.NewStateManager
+		newRebalancerFn = masterpkg.NewRebalancer
+		newLeaderBalancerFn = masterpkg.NewLeaderBalancer
 		ctrl.Finish()
 	}()
 
@@ -67,6 +71,16 @@ func TestMasterController_OnFailOver(t *testing.T) {
 		return stateMgr
 	}
 	registry := discovery.NewMockRegistry(ctrl)
+	rebalancer := masterpkg.NewMockRebalancer(ctrl)
+	newRebalancerFn = func(_ context.Context, _ config.Rebalance, _ masterpkg.StateManager,
+		_ state.Repository, _ client.MigrationCli) masterpkg.Rebalancer {
+		return rebalancer
+	}
+	leaderBalancer := masterpkg.NewMockLeaderBalancer(ctrl)
+	newLeaderBalancerFn = func(_ context.Context, _ config.Rebalance, _ masterpkg.StateManager,
+		_ state.Repository, _ client.ReplicaCli) masterpkg.LeaderBalancer {
+		return leaderBalancer
+	}
 
 	cases := []struct {
 		name    string
@@ -83,16 +97,18 @@ func TestMasterController_OnFailOver(t *testing.T) {
 		{
 			name: "register master done failure",
 			prepare: func() {
-				discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).MaxTimes(4)
+				discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).MaxTimes(5)
 				registry.EXPECT().Register(gomock.Any()).Return(fmt.Errorf("err"))
 			},
 			wantErr: true,
 		},
 		{
-			name: "elect master successfully",
+			name: "elect master successfully, start rebalancer",
 			prepare: func() {
-				discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).MaxTimes(4)
+				discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).MaxTimes(5)
 				registry.EXPECT().Register(gomock.Any()).Return(nil)
+				rebalancer.EXPECT().Start()
+				leaderBalancer.EXPECT().Start()
 			},
 			wantErr: false,
 		},
@@ -147,6 +163,17 @@ func TestMasterController_OnResignation(t *testing.T) {
 	// resign successfully
 	registry.EXPECT().Deregister(gomock.Any()).Return(nil)
 	mc.OnResignation()
+	// stop rebalancer
+	rebalancer := masterpkg.NewMockRebalancer(ctrl)
+	rebalancer.EXPECT().Stop()
+	mc.rebalancer = rebalancer
+	leaderBalancer := masterpkg.NewMockLeaderBalancer(ctrl)
+	leaderBalancer.EXPECT().Stop()
+	mc.leaderBalancer = leaderBalancer
+	registry.EXPECT().Deregister(gomock.Any()).Return(nil)
+	mc.OnResignation()
+	assert.Nil(t, mc.rebalancer)
+	assert.Nil(t, mc.leaderBalancer)
 }
 
 func TestMasterController_Start_Stop(t *testing.T) {
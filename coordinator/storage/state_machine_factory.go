@@ -89,6 +89,14 @@ func (f *StateMachineFactory) Start() (err error) {
 		return err
 	}
 	f.stateMachines = append(f.stateMachines, sm)
+	// NOTICE: series deletion state machine need start after shard assignment state machine,
+	// because deletion applies on the shards of database.
+	f.logger.Debug("starting SeriesDeletionStateMachine")
+	sm, err = f.createSeriesDeletionStateMachine()
+	if err != nil {
+		return err
+	}
+	f.stateMachines = append(f.stateMachines, sm)
 
 	f.logger.Info("started StorageStateMachines")
 	return nil
@@ -173,3 +181,27 @@ func (f *StateMachineFactory) createDatabaseLimitsStateMachine() (discovery.Stat
 		nil,
 	)
 }
+
+// createSeriesDeletionStateMachine creates database's series deletion state machine.
+func (f *StateMachineFactory) createSeriesDeletionStateMachine() (discovery.StateMachine, error) {
+	return discovery.NewStateMachine(
+		f.ctx,
+		discovery.SeriesDeletionStateMachine,
+		f.discoveryFactory,
+		constants.DatabaseDeletionPath,
+		true,
+		func(key string, data []byte) {
+			f.stateMgr.EmitEvent(&discovery.Event{
+				Type:  discovery.SeriesDeletionChanged,
+				Key:   key,
+				Value: data,
+			})
+		},
+		func(key string) {
+			f.stateMgr.EmitEvent(&discovery.Event{
+				Type: discovery.SeriesDeletionDeletion,
+				Key:  key,
+			})
+		},
+	)
+}
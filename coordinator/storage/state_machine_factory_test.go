@@ -53,8 +53,13 @@ func TestStateMachineFactory_Start(t *testing.T) {
 	discovery1.EXPECT().Discovery(gomock.Any()).Return(fmt.Errorf("err"))
 	err = fct.Start()
 	assert.Error(t, err)
-	// all state machines are ok
+	// series deletion sm err
 	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).MaxTimes(3)
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(fmt.Errorf("err"))
+	err = fct.Start()
+	assert.Error(t, err)
+	// all state machines are ok
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).MaxTimes(4)
 	err = fct.Start()
 	assert.NoError(t, err)
 }
@@ -111,6 +116,34 @@ func TestStateMachineFactory_CreateState(t *testing.T) {
 	assert.NotNil(t, StateMachinePaths[constants.ShardAssignment].CreateState())
 }
 
+func TestStateMachineFactory_SeriesDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := NewMockStateManager(ctrl)
+	discoveryFct := discovery.NewMockFactory(ctrl)
+	discovery1 := discovery.NewMockDiscovery(ctrl)
+	discoveryFct.EXPECT().CreateDiscovery(gomock.Any(), gomock.Any()).Return(discovery1)
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil)
+	fct := NewStateMachineFactory(context.TODO(), discoveryFct, stateMgr)
+
+	sm, err := fct.createSeriesDeletionStateMachine()
+	assert.NoError(t, err)
+	assert.NotNil(t, sm)
+
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type:  discovery.SeriesDeletionChanged,
+		Key:   "/test",
+		Value: []byte("value"),
+	})
+	sm.OnCreate("/test", []byte("value"))
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type: discovery.SeriesDeletionDeletion,
+		Key:  "/test",
+	})
+	sm.OnDelete("/test")
+}
+
 func TestStateMachineFactory_DatabaseLimits(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -69,6 +70,7 @@ type stateManager struct {
 	nodes               map[models.NodeID]models.StatefulNode // storage live nodes
 	watches             map[models.NodeID][]func(state models.NodeStateType)
 	databaseAssignments map[string]*models.DatabaseAssignment
+	seriesDeletions     map[string]map[int64]*models.SeriesDeletion // database => series deletions
 
 	events chan *discovery.Event
 
@@ -93,6 +95,7 @@ func NewStateManager(
 		engine:              engine,
 		nodes:               make(map[models.NodeID]models.StatefulNode),
 		databaseAssignments: make(map[string]*models.DatabaseAssignment),
+		seriesDeletions:     make(map[string]map[int64]*models.SeriesDeletion),
 		events:              make(chan *discovery.Event, 10),
 		watches:             make(map[models.NodeID][]func(state models.NodeStateType)),
 		statistics:          metrics.NewStateManagerStatistics(linmetric.StorageRegistry),
@@ -153,6 +156,10 @@ func (m *stateManager) processEvent(event *discovery.Event) {
 		err = m.onShardAssignmentChange(event.Key, event.Value)
 	case discovery.DatabaseLimitsChanged:
 		err = m.onDatabaseLimitsChange(event.Key, event.Value)
+	case discovery.SeriesDeletionChanged:
+		err = m.onSeriesDeletionChange(event.Key, event.Value)
+	case discovery.SeriesDeletionDeletion:
+		err = m.onSeriesDeletionDelete(event.Key)
 	}
 	if err != nil {
 		m.statistics.HandleEventFailure.WithTagValues(eventType, constants.StorageRole).Incr()
@@ -179,6 +186,70 @@ func (m *stateManager) onDatabaseLimitsChange(key string, data []byte) error {
 	return nil
 }
 
+// onSeriesDeletionChange triggers when delete series/drop metric submitted.
+func (m *stateManager) onSeriesDeletionChange(key string, data []byte) error {
+	m.logger.Info("delete series, because series deletion is submitted",
+		logger.String("key", key),
+		logger.String("data", string(data)))
+
+	name, _, err := constants.ParseDatabaseDeletionPath(key)
+	if err != nil {
+		return err
+	}
+	deletion := &models.SeriesDeletion{}
+	if err := encoding.JSONUnmarshal(data, deletion); err != nil {
+		return err
+	}
+	deletions, ok := m.seriesDeletions[name]
+	if !ok {
+		deletions = make(map[int64]*models.SeriesDeletion)
+		m.seriesDeletions[name] = deletions
+	}
+	// cache deletion, because new shard maybe created after deletion applied.
+	deletions[deletion.ID] = deletion
+	return m.applySeriesDeletions(name, deletion)
+}
+
+// onSeriesDeletionDelete triggers when series deletion removed after all deleted data expired,
+// the tombstones of it are removed by data ttl task.
+func (m *stateManager) onSeriesDeletionDelete(key string) error {
+	m.logger.Info("remove series deletion, because deleted data is expired",
+		logger.String("key", key))
+
+	name, id, err := constants.ParseDatabaseDeletionPath(key)
+	if err != nil {
+		return err
+	}
+	if deletions, ok := m.seriesDeletions[name]; ok {
+		delete(deletions, id)
+		if len(deletions) == 0 {
+			delete(m.seriesDeletions, name)
+		}
+	}
+	return nil
+}
+
+// applySeriesDeletions applies the series deletions for database if database exist.
+func (m *stateManager) applySeriesDeletions(name string, deletions ...*models.SeriesDeletion) error {
+	if len(deletions) == 0 {
+		return nil
+	}
+	db, ok := m.engine.GetDatabase(name)
+	if !ok {
+		return nil
+	}
+	for _, deletion := range deletions {
+		if err := db.DeleteSeries(deletion); err != nil {
+			m.logger.Error("delete series failure",
+				logger.String("database", name),
+				logger.Int64("id", deletion.ID),
+				logger.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
 // onShardAssignmentChange triggers when shard assignment changed after database config modified.
 func (m *stateManager) onShardAssignmentChange(key string, data []byte) error {
 	m.logger.Info("shard assignment is changed",
@@ -214,7 +285,15 @@ func (m *stateManager) onShardAssignmentChange(key string, data []byte) error {
 			logger.Error(err))
 		return err
 	}
-	return nil
+	// apply series deletions for new shards
+	var deletions []*models.SeriesDeletion
+	for _, deletion := range m.seriesDeletions[param.ShardAssignment.Name] {
+		deletions = append(deletions, deletion)
+	}
+	sort.Slice(deletions, func(i, j int) bool {
+		return deletions[i].ID < deletions[j].ID
+	})
+	return m.applySeriesDeletions(param.ShardAssignment.Name, deletions...)
 }
 
 // onNodeStartup triggers when storage node online.
@@ -185,6 +185,85 @@ func TestStateManager_OnShardAssignment(t *testing.T) {
 	mgr.Close()
 }
 
+func TestStateManager_onSeriesDeletion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	mgr := NewStateManager(context.TODO(), &models.StatefulNode{ID: 1}, engine)
+	deletion := &models.SeriesDeletion{ID: 10, MetricName: "cpu"}
+
+	// case 1: invalid key
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.SeriesDeletionChanged,
+		Key:   "/database/deletion/db",
+		Value: encoding.JSONMarshal(deletion),
+	})
+	// case 2: unmarshal deletion failure
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.SeriesDeletionChanged,
+		Key:   "/database/deletion/db/10",
+		Value: []byte("dd"),
+	})
+	// case 3: database not exist
+	engine.EXPECT().GetDatabase("db").Return(nil, false)
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.SeriesDeletionChanged,
+		Key:   "/database/deletion/db/10",
+		Value: encoding.JSONMarshal(deletion),
+	})
+	// case 4: delete series failure
+	engine.EXPECT().GetDatabase("db").Return(db, true)
+	db.EXPECT().DeleteSeries(deletion).Return(fmt.Errorf("err"))
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.SeriesDeletionChanged,
+		Key:   "/database/deletion/db/10",
+		Value: encoding.JSONMarshal(deletion),
+	})
+	// case 5: delete series successfully
+	engine.EXPECT().GetDatabase("db").Return(db, true)
+	db.EXPECT().DeleteSeries(deletion).Return(nil)
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.SeriesDeletionChanged,
+		Key:   "/database/deletion/db/10",
+		Value: encoding.JSONMarshal(deletion),
+	})
+	// case 6: apply cached deletion after shards created
+	engine.EXPECT().CreateShards(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	engine.EXPECT().GetDatabase("db").Return(db, true)
+	db.EXPECT().DeleteSeries(deletion).Return(nil)
+	mgr.EmitEvent(&discovery.Event{
+		Type: discovery.ShardAssignmentChanged,
+		Key:  "/shard/assign/db",
+		Value: encoding.JSONMarshal(&models.DatabaseAssignment{ShardAssignment: &models.ShardAssignment{
+			Name:   "db",
+			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2, 3}}},
+		}}),
+	})
+	// case 7: invalid key when remove deletion
+	mgr.EmitEvent(&discovery.Event{
+		Type: discovery.SeriesDeletionDeletion,
+		Key:  "/database/deletion/db",
+	})
+	// case 8: remove expired deletion, not apply it after shards created
+	mgr.EmitEvent(&discovery.Event{
+		Type: discovery.SeriesDeletionDeletion,
+		Key:  "/database/deletion/db/10",
+	})
+	engine.EXPECT().CreateShards(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mgr.EmitEvent(&discovery.Event{
+		Type: discovery.ShardAssignmentChanged,
+		Key:  "/shard/assign/db",
+		Value: encoding.JSONMarshal(&models.DatabaseAssignment{ShardAssignment: &models.ShardAssignment{
+			Name:   "db",
+			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2, 3}}},
+		}}),
+	})
+	time.Sleep(100 * time.Millisecond)
+	mgr.Close()
+}
+
 func TestStateManager_onDatabaseLimits(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -20,6 +20,8 @@ package flow
 import (
 	"context"
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"sort"
 	"sync"
 	"time"
@@ -29,6 +31,7 @@ import (
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
@@ -45,6 +48,15 @@ type TaskContext struct {
 	Cancel context.CancelFunc
 
 	Start time.Time
+
+	// max memory(bytes) can be used by task, 0 means no limit.
+	MaxMemory int64
+	memory    atomic.Int64
+
+	timeoutTimer *time.Timer  // aborts task if database's query timeout exceeded
+	abortErr     error        // the reason why task aborted
+	parent       *TaskContext // parent task which limits(timeout/max memory/kill) apply to current task
+	mutex        sync.Mutex
 }
 
 // NewTaskContextWithTimeout creates a task context with timeout.
@@ -57,9 +69,112 @@ func NewTaskContextWithTimeout(ctx context.Context, timeout time.Duration) *Task
 	}
 }
 
+// NewChildTaskContext creates the task context of sub task(e.g. sub query of cross-metric query),
+// child task is aborted if parent task aborted(killed/timeout/memory limit),
+// memory used by child task is also counted into parent task.
+func NewChildTaskContext(parent *TaskContext) *TaskContext {
+	c, cancel := context.WithCancel(parent.Ctx)
+	return &TaskContext{
+		Ctx:    c,
+		Cancel: cancel,
+		Start:  time.Now(),
+		parent: parent,
+	}
+}
+
+// ApplyLimits applies the query limits(timeout/max memory) of database,
+// must be invoked before task executing.
+// NOTICE: Ctx is immutable because it may be watched by other goroutines(e.g. request manager),
+// so database's timeout aborts the task by timer instead of replacing Ctx.
+func (ctx *TaskContext) ApplyLimits(limits *models.Limits) {
+	if limits == nil {
+		return
+	}
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	if limits.EnableQueryTimeout() && ctx.timeoutTimer == nil {
+		// database's timeout cannot exceed the timeout of parent context
+		ctx.timeoutTimer = time.AfterFunc(limits.QueryTimeout.Duration()-time.Since(ctx.Start), func() {
+			ctx.Abort(constants.ErrTimeout)
+		})
+	}
+	if limits.EnableQueryMemoryCheck() {
+		ctx.MaxMemory = int64(limits.MaxMemoryPerQuery)
+	}
+}
+
+// Abort aborts the task with the reason, cancels the context of task.
+func (ctx *TaskContext) Abort(err error) {
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	if ctx.abortErr == nil {
+		ctx.abortErr = err
+	}
+	ctx.cancel()
+}
+
+// Err returns the reason why task's context done, returns nil if context not done.
+func (ctx *TaskContext) Err() error {
+	ctx.mutex.Lock()
+	abortErr := ctx.abortErr
+	ctx.mutex.Unlock()
+
+	if abortErr != nil {
+		return abortErr
+	}
+	if ctx.parent != nil {
+		// child task's context done if parent task aborted
+		if err := ctx.parent.Err(); err != nil {
+			return err
+		}
+	}
+	err := ctx.Ctx.Err()
+	if errors.Is(err, context.DeadlineExceeded) {
+		return constants.ErrTimeout
+	}
+	return err
+}
+
+// AllocMemory records the memory(bytes) allocated by task,
+// if memory usage exceeds the limit, aborts the task and returns err.
+func (ctx *TaskContext) AllocMemory(size int64) error {
+	used := ctx.memory.Add(size)
+	if ctx.parent != nil {
+		if err := ctx.parent.AllocMemory(size); err != nil {
+			return err
+		}
+	}
+	if ctx.MaxMemory > 0 && used > ctx.MaxMemory {
+		err := fmt.Errorf("%w, used: %d bytes, limit: %d bytes", constants.ErrQueryMemoryExceeded, used, ctx.MaxMemory)
+		ctx.Abort(err)
+		return err
+	}
+	return nil
+}
+
+// MemoryUsage returns the memory(bytes) allocated by task.
+func (ctx *TaskContext) MemoryUsage() int64 {
+	return ctx.memory.Load()
+}
+
 // Release releases context's resource after query.
 func (ctx *TaskContext) Release() {
-	ctx.Cancel()
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	ctx.cancel()
+}
+
+// cancel stops the timeout timer and cancels the context of task, must be invoked with lock.
+func (ctx *TaskContext) cancel() {
+	if ctx.timeoutTimer != nil {
+		ctx.timeoutTimer.Stop()
+	}
+	if ctx.Cancel != nil {
+		ctx.Cancel()
+	}
 }
 
 // StorageExecuteContext represents storage level query execute context.
@@ -359,6 +474,27 @@ func (ctx *DataLoadContext) newSeriesAggregator(fieldIdx int) aggregation.Series
 		ctx.ShardExecuteCtx.StorageExecuteCtx.DownSamplingSpecs[fieldIdx])
 }
 
+// EstimateAggregatorMemory returns the estimated memory(bytes) of series aggregators which prepared for data load,
+// each aggregator keeps a float64 value for every point of query time range.
+func (ctx *DataLoadContext) EstimateAggregatorMemory() int64 {
+	numOfAggregators := len(ctx.GroupingSeriesAgg)
+	if ctx.WithoutGroupingSeriesAgg != nil {
+		numOfAggregators++
+	}
+	storageExecuteCtx := ctx.ShardExecuteCtx.StorageExecuteCtx
+	numOfPoints := 1
+	if interval := storageExecuteCtx.Query.Interval.Int64(); interval > 0 {
+		numOfPoints = timeutil.CalPointCount(storageExecuteCtx.Query.TimeRange.Start, storageExecuteCtx.Query.TimeRange.End, interval)
+	}
+	numOfFields := len(storageExecuteCtx.Fields)
+	if numOfFields == 0 {
+		numOfFields = 1
+	}
+	aggregatorMemory := int64(numOfAggregators) * int64(numOfFields) * int64(numOfPoints) * 8
+	// low series ids and grouping refs are uint16 slices
+	return aggregatorMemory + int64(len(ctx.LowSeriesIDs)+len(ctx.GroupingSeriesAggRefs))*2
+}
+
 // HasGroupingData returns if it is grouping data.
 func (ctx *DataLoadContext) HasGroupingData() bool {
 	if ctx.IsGrouping {
@@ -29,6 +29,9 @@ import (
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
@@ -36,6 +39,84 @@ import (
 	"github.com/lindb/lindb/sql/stmt"
 )
 
+func TestTaskContext_Abort(t *testing.T) {
+	ctx := NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	assert.NoError(t, ctx.Err())
+	ctx.Abort(constants.ErrQueryKilled)
+	ctx.Abort(constants.ErrTimeout)
+	<-ctx.Ctx.Done()
+	assert.Equal(t, constants.ErrQueryKilled, ctx.Err())
+
+	ctx = NewTaskContextWithTimeout(context.TODO(), time.Millisecond)
+	<-ctx.Ctx.Done()
+	assert.Equal(t, constants.ErrTimeout, ctx.Err())
+	ctx = NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	ctx.Release()
+	assert.Equal(t, context.Canceled, ctx.Err())
+}
+
+func TestTaskContext_ApplyLimits(t *testing.T) {
+	ctx := NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	ctx.ApplyLimits(nil)
+	ctx.ApplyLimits(models.NewDefaultLimits())
+	assert.Equal(t, int64(0), ctx.MaxMemory)
+	assert.NoError(t, ctx.AllocMemory(1024*1024))
+	assert.Equal(t, int64(1024*1024), ctx.MemoryUsage())
+
+	limits := models.NewDefaultLimits()
+	limits.QueryTimeout = ltoml.Duration(time.Millisecond)
+	limits.MaxMemoryPerQuery = 1024
+	ctx = NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	ctx.ApplyLimits(limits)
+	assert.Equal(t, int64(1024), ctx.MaxMemory)
+	<-ctx.Ctx.Done()
+	assert.Equal(t, constants.ErrTimeout, ctx.Err())
+	ctx.Release()
+
+	ctx = NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	ctx.ApplyLimits(&models.Limits{MaxMemoryPerQuery: 1024})
+	assert.NoError(t, ctx.AllocMemory(1000))
+	err := ctx.AllocMemory(100)
+	assert.ErrorIs(t, err, constants.ErrQueryMemoryExceeded)
+	<-ctx.Ctx.Done()
+	assert.Equal(t, err, ctx.Err())
+
+	// timeout timer stopped after released
+	ctx = NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	ctx.ApplyLimits(limits)
+	ctx.Release()
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, context.Canceled, ctx.Err())
+}
+
+func TestTaskContext_Child(t *testing.T) {
+	parent := NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	parent.ApplyLimits(&models.Limits{MaxMemoryPerQuery: 1024})
+	child := NewChildTaskContext(parent)
+	assert.NoError(t, child.Err())
+	assert.NoError(t, child.AllocMemory(1000))
+	assert.Equal(t, int64(1000), parent.MemoryUsage())
+	// memory limit of parent
+	err := child.AllocMemory(100)
+	assert.ErrorIs(t, err, constants.ErrQueryMemoryExceeded)
+	<-child.Ctx.Done()
+	assert.Equal(t, err, child.Err())
+
+	parent = NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	child = NewChildTaskContext(parent)
+	parent.Abort(constants.ErrQueryKilled)
+	<-child.Ctx.Done()
+	assert.Equal(t, constants.ErrQueryKilled, child.Err())
+
+	// release child not affects parent
+	parent = NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	child = NewChildTaskContext(parent)
+	child.Release()
+	assert.Equal(t, context.Canceled, child.Err())
+	assert.NoError(t, parent.Err())
+	parent.Release()
+}
+
 func TestStorageExecuteContext_collectGroupingTagValueIDs(t *testing.T) {
 	ctx := &StorageExecuteContext{
 		GroupingTagValueIDs: make([]*roaring.Bitmap, 2),
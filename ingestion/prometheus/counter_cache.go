@@ -0,0 +1,126 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"container/list"
+	"sync"
+)
+
+// defaultCounterCacheCapacity represents the max series which counter cache keeps.
+const defaultCounterCacheCapacity = 1024 * 1024
+
+// counterValue represents the last cumulative value of counter series.
+type counterValue struct {
+	key   uint64
+	value float64
+}
+
+// counterCache converts prometheus cumulative counter into LinDB delta sum,
+// keeps the last cumulative value of each counter series, evicts the least recently used series if full.
+//
+// NOTE: base values are kept in the memory of current broker, so the series of one prometheus server should be
+// written into the same broker(sticky load balancing), the first sample of series after broker restarted,
+// series evicted or written into another broker only sets the base value.
+type counterCache struct {
+	values   map[uint64]*list.Element
+	lru      *list.List
+	capacity int
+
+	lock sync.Mutex
+}
+
+// newCounterCache creates a counter cache with max capacity.
+func newCounterCache(capacity int) *counterCache {
+	return &counterCache{
+		values:   make(map[uint64]*list.Element),
+		lru:      list.New(),
+		capacity: capacity,
+	}
+}
+
+// last returns the last committed cumulative value of counter series, returns false if series not found.
+func (c *counterCache) last(key uint64) (float64, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if elem, ok := c.values[key]; ok {
+		return elem.Value.(*counterValue).value, true
+	}
+	return 0, false
+}
+
+// commit commits the last cumulative values of counter series as the base values,
+// evicts the least recently used series if full.
+func (c *counterCache) commit(values map[uint64]float64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for key, value := range values {
+		if elem, ok := c.values[key]; ok {
+			c.lru.MoveToFront(elem)
+			elem.Value.(*counterValue).value = value
+			continue
+		}
+		for c.lru.Len() > 0 && c.lru.Len() >= c.capacity {
+			oldest := c.lru.Back()
+			c.lru.Remove(oldest)
+			delete(c.values, oldest.Value.(*counterValue).key)
+		}
+		c.values[key] = c.lru.PushFront(&counterValue{key: key, value: value})
+	}
+}
+
+// CounterUpdates represents the last cumulative values of counter series in one write request,
+// which are committed as the base values after the rows of request written successfully,
+// so that the retry of failure request computes the same delta values.
+type CounterUpdates struct {
+	values map[uint64]float64
+}
+
+// newCounterUpdates creates the counter updates of write request.
+func newCounterUpdates() *CounterUpdates {
+	return &CounterUpdates{values: make(map[uint64]float64)}
+}
+
+// delta returns the delta value between current cumulative value and last value(uncommitted value of
+// current request first, then committed value), returns current value if counter reset,
+// returns false if series is first seen(no base value).
+func (u *CounterUpdates) delta(key uint64, value float64) (float64, bool) {
+	last, ok := u.values[key]
+	if !ok {
+		last, ok = counters.last(key)
+	}
+	u.values[key] = value
+	if !ok {
+		return 0, false
+	}
+	if value < last {
+		// counter reset
+		return value, true
+	}
+	return value - last, true
+}
+
+// Commit commits the last cumulative values of counter series into counter cache.
+func (u *CounterUpdates) Commit() {
+	if u == nil || len(u.values) == 0 {
+		return
+	}
+	counters.commit(u.values)
+}
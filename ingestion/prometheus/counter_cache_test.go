@@ -0,0 +1,75 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounterCache_commit(t *testing.T) {
+	cache := newCounterCache(2)
+	assertLast := func(key uint64, expect float64, expectOK bool) {
+		value, ok := cache.last(key)
+		assert.Equal(t, expectOK, ok)
+		assert.Equal(t, expect, value)
+	}
+	assertLast(1, 0, false)
+	cache.commit(map[uint64]float64{1: 10})
+	assertLast(1, 10, true)
+	cache.commit(map[uint64]float64{1: 15})
+	assertLast(1, 15, true)
+	cache.commit(map[uint64]float64{2: 10})
+	// exceed capacity, evict least recently used series(1)
+	cache.commit(map[uint64]float64{3: 10})
+	assert.Len(t, cache.values, 2)
+	assert.Equal(t, 2, cache.lru.Len())
+	assertLast(1, 0, false)
+	assertLast(2, 10, true)
+	assertLast(3, 10, true)
+}
+
+func TestCounterUpdates_delta(t *testing.T) {
+	defer func() {
+		counters = newCounterCache(defaultCounterCacheCapacity)
+	}()
+	counters = newCounterCache(2)
+	updates := newCounterUpdates()
+	assertDelta := func(key uint64, value, expect float64, expectOK bool) {
+		delta, ok := updates.delta(key, value)
+		assert.Equal(t, expectOK, ok)
+		assert.Equal(t, expect, delta)
+	}
+	assertDelta(1, 10, 0, false)
+	assertDelta(1, 15, 5, true)
+	// counter reset
+	assertDelta(1, 2, 2, true)
+	// not committed
+	_, ok := counters.last(1)
+	assert.False(t, ok)
+	updates.Commit()
+	value, ok := counters.last(1)
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, value)
+
+	updates = newCounterUpdates()
+	assertDelta(1, 4, 2, true)
+	var nilUpdates *CounterUpdates
+	nilUpdates.Commit()
+}
@@ -0,0 +1,486 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/golang/snappy"
+
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	commonseries "github.com/lindb/common/series"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+)
+
+const (
+	// MetricNameLabel represents the reserved label name of metric name.
+	MetricNameLabel = "__name__"
+	// BucketLabel represents the reserved label name of histogram bucket upper bound.
+	BucketLabel = "le"
+	// ValueFieldName represents the field name which prometheus sample value stores.
+	ValueFieldName = "value"
+
+	bucketSuffix = "_bucket"
+	sumSuffix    = "_sum"
+	countSuffix  = "_count"
+	totalSuffix  = "_total"
+)
+
+var (
+	promLogger                    = logger.GetLogger("Ingestion", "Prometheus")
+	prometheusIngestionStatistics = metrics.NewPrometheusIngestionStatistics()
+	counters                      = newCounterCache(defaultCounterCacheCapacity)
+)
+
+// Parse parses prometheus remote write request(snappy compressed prompb.WriteRequest) to LinDB rows.
+// https://prometheus.io/docs/concepts/remote_write_spec/
+//
+// metric name => __name__, labels => tags, sample value => value field:
+// 1. counter => sum field(cumulative value converted to delta, first sample of series only sets the base value)
+// 2. gauge/unknown => last field
+// 3. histogram(_bucket/_sum/_count with le label) => histogram field
+//
+// The base values of counter are kept in current broker(see counterCache), returned counter updates
+// must be committed after rows written successfully.
+func Parse(
+	req *http.Request,
+	database string,
+	enrichedTags tag.Tags,
+	namespace string,
+	limits *models.Limits,
+) (*metric.BrokerBatchRows, *CounterUpdates, error) {
+	compressed, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	prometheusIngestionStatistics.ReadBytes.Add(float64(len(compressed)))
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		prometheusIngestionStatistics.CorruptedData.Incr()
+		return nil, nil, fmt.Errorf("ingestion corrupted snappy data: %w", err)
+	}
+	var writeReq WriteRequest
+	if err := writeReq.Unmarshal(data); err != nil {
+		prometheusIngestionStatistics.CorruptedData.Incr()
+		return nil, nil, err
+	}
+	return parseWriteRequest(&writeReq, database, enrichedTags, namespace, limits)
+}
+
+// histogramPoint represents the histogram data of one series at the timestamp.
+type histogramPoint struct {
+	timestamp int64
+	bounds    []float64
+	values    []float64
+	sum       float64
+	count     float64
+	// noBase represents some counter of point has no base value(first seen), skip it
+	noBase bool
+}
+
+// histogramSeries represents the histogram series which groups _bucket/_sum/_count series.
+type histogramSeries struct {
+	metricName string
+	labels     []Label
+	points     map[int64]*histogramPoint
+}
+
+// getPoint returns the histogram point of timestamp, creates it if not exist.
+func (hs *histogramSeries) getPoint(timestamp int64) *histogramPoint {
+	point, ok := hs.points[timestamp]
+	if !ok {
+		point = &histogramPoint{timestamp: timestamp}
+		hs.points[timestamp] = point
+	}
+	return point
+}
+
+// parser parses prometheus write request into broker rows.
+type parser struct {
+	namespace    string
+	enrichedTags tag.Tags
+	limits       *models.Limits
+	// counterPrefix represents the counter cache key prefix(database/namespace/sorted enriched tags)
+	counterPrefix string
+	// baselines represents the number of counter samples which only set the base value
+	baselines int
+	updates   *CounterUpdates
+
+	types           map[string]MetricType
+	histograms      map[string]*histogramSeries
+	histogramSeries []*histogramSeries
+
+	builder *commonseries.RowBuilder
+	batch   *metric.BrokerBatchRows
+}
+
+func parseWriteRequest(
+	writeReq *WriteRequest,
+	database string,
+	enrichedTags tag.Tags,
+	namespace string,
+	limits *models.Limits,
+) (*metric.BrokerBatchRows, *CounterUpdates, error) {
+	rowBuilder, releaseFunc := commonseries.NewRowBuilder()
+	defer releaseFunc(rowBuilder)
+
+	p := &parser{
+		namespace:     namespace,
+		enrichedTags:  enrichedTags,
+		limits:        limits,
+		counterPrefix: counterPrefixOf(database, namespace, enrichedTags),
+		updates:       newCounterUpdates(),
+		types:         make(map[string]MetricType),
+		histograms:    make(map[string]*histogramSeries),
+		builder:       rowBuilder,
+		batch:         metric.NewBrokerBatchRows(),
+	}
+	for _, md := range writeReq.Metadata {
+		p.types[md.MetricFamilyName] = md.Type
+	}
+	// find histogram families which metadata not reported
+	for idx := range writeReq.TimeSeries {
+		name, le := metricNameAndBound(writeReq.TimeSeries[idx].Labels)
+		if le != "" && strings.HasSuffix(name, bucketSuffix) {
+			baseName := strings.TrimSuffix(name, bucketSuffix)
+			if _, ok := p.types[baseName]; !ok {
+				p.types[baseName] = MetricTypeHistogram
+			}
+		}
+	}
+	for idx := range writeReq.TimeSeries {
+		if err := p.parseTimeSeries(&writeReq.TimeSeries[idx]); err != nil {
+			promLogger.Warn("ingest error", logger.Error(err))
+			prometheusIngestionStatistics.DroppedMetrics.Incr()
+		}
+	}
+	p.flushHistograms()
+	prometheusIngestionStatistics.CounterBaselines.Add(float64(p.baselines))
+	// batch is empty but not error if all samples are the first samples of counter series
+	if p.batch.Len() == 0 && p.baselines == 0 {
+		return nil, nil, fmt.Errorf("empty metrics")
+	}
+	return p.batch, p.updates, nil
+}
+
+// parseTimeSeries parses the samples of time series.
+func (p *parser) parseTimeSeries(ts *TimeSeries) error {
+	name, le := metricNameAndBound(ts.Labels)
+	if name == "" {
+		return ErrMissingMetricName
+	}
+	if baseName, ok := p.histogramFamily(name); ok {
+		return p.collectHistogram(baseName, name, le, ts)
+	}
+	fieldType := flatMetricsV1.SimpleFieldTypeLast
+	isCounter := p.isCounter(name)
+	if isCounter {
+		fieldType = flatMetricsV1.SimpleFieldTypeDeltaSum
+	}
+	var seriesKey uint64
+	if isCounter {
+		seriesKey = p.counterKey(name, ts.Labels, "")
+	}
+	for _, sample := range ts.Samples {
+		// skip stale marker
+		if math.IsNaN(sample.Value) || math.IsInf(sample.Value, 0) {
+			continue
+		}
+		value := sample.Value
+		if isCounter {
+			delta, ok := p.updates.delta(seriesKey, value)
+			if !ok {
+				p.baselines++
+				continue
+			}
+			value = delta
+		}
+		if err := p.appendRow(name, ts.Labels, sample.Timestamp, func(builder *commonseries.RowBuilder) error {
+			return builder.AddSimpleField([]byte(ValueFieldName), fieldType, value)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// histogramFamily returns the histogram base name if metric name belongs to histogram family.
+func (p *parser) histogramFamily(name string) (string, bool) {
+	for _, suffix := range []string{bucketSuffix, sumSuffix, countSuffix} {
+		if !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		baseName := strings.TrimSuffix(name, suffix)
+		if p.types[baseName] == MetricTypeHistogram {
+			return baseName, true
+		}
+	}
+	return "", false
+}
+
+// isCounter checks if metric is counter based on metadata or metric name suffix.
+func (p *parser) isCounter(name string) bool {
+	if typ, ok := p.types[name]; ok && typ != MetricTypeUnknown {
+		return typ == MetricTypeCounter
+	}
+	for _, suffix := range []string{totalSuffix, sumSuffix, countSuffix} {
+		if !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		switch p.types[strings.TrimSuffix(name, suffix)] {
+		case MetricTypeGauge, MetricTypeGaugeHistogram:
+			return false
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// collectHistogram collects _bucket/_sum/_count samples into histogram series.
+func (p *parser) collectHistogram(baseName, name, le string, ts *TimeSeries) error {
+	var upperBound float64
+	isBucket := strings.HasSuffix(name, bucketSuffix)
+	if isBucket {
+		if le == "" {
+			return ErrMissingBucketBound
+		}
+		bound, err := strconv.ParseFloat(le, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrMissingBucketBound, le)
+		}
+		upperBound = bound
+	}
+	labels := make([]Label, 0, len(ts.Labels))
+	for _, l := range ts.Labels {
+		if l.Name == MetricNameLabel || l.Name == BucketLabel {
+			continue
+		}
+		labels = append(labels, l)
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i].Name < labels[j].Name })
+	key := seriesKeyOf(baseName, labels)
+	series, ok := p.histograms[key]
+	if !ok {
+		series = &histogramSeries{
+			metricName: baseName,
+			labels:     labels,
+			points:     make(map[int64]*histogramPoint),
+		}
+		p.histograms[key] = series
+		p.histogramSeries = append(p.histogramSeries, series)
+	}
+	counterKey := p.counterKey(name, labels, le)
+	for _, sample := range ts.Samples {
+		if math.IsNaN(sample.Value) || math.IsInf(sample.Value, 0) {
+			continue
+		}
+		value, ok := p.updates.delta(counterKey, sample.Value)
+		point := series.getPoint(sample.Timestamp)
+		if !ok {
+			p.baselines++
+			point.noBase = true
+			continue
+		}
+		switch {
+		case isBucket:
+			point.bounds = append(point.bounds, upperBound)
+			point.values = append(point.values, value)
+		case strings.HasSuffix(name, sumSuffix):
+			point.sum = value
+		default:
+			point.count = value
+		}
+	}
+	return nil
+}
+
+// flushHistograms builds all collected histogram points into rows.
+func (p *parser) flushHistograms() {
+	for _, series := range p.histogramSeries {
+		timestamps := make([]int64, 0, len(series.points))
+		for timestamp := range series.points {
+			timestamps = append(timestamps, timestamp)
+		}
+		sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+		for _, timestamp := range timestamps {
+			point := series.points[timestamp]
+			if point.noBase {
+				continue
+			}
+			values, bounds := point.buckets()
+			if err := p.appendRow(series.metricName, series.labels, timestamp, func(builder *commonseries.RowBuilder) error {
+				if err := builder.AddCompoundFieldData(values, bounds); err != nil {
+					return err
+				}
+				return builder.AddCompoundFieldMMSC(0, 0, math.Max(point.sum, 0), point.count)
+			}); err != nil {
+				promLogger.Warn("ingest histogram error",
+					logger.String("metric", series.metricName), logger.Error(err))
+				prometheusIngestionStatistics.DroppedMetrics.Incr()
+			}
+		}
+	}
+}
+
+// buckets returns the bucket values(not cumulative) and bounds sorted by upper bound.
+func (hp *histogramPoint) buckets() (values, bounds []float64) {
+	idx := make([]int, len(hp.bounds))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return hp.bounds[idx[i]] < hp.bounds[idx[j]] })
+	values = make([]float64, len(idx))
+	bounds = make([]float64, len(idx))
+	var last float64
+	for i, pos := range idx {
+		bounds[i] = hp.bounds[pos]
+		// prometheus bucket is cumulative, LinDB bucket is not
+		values[i] = math.Max(hp.values[pos]-last, 0)
+		last = hp.values[pos]
+	}
+	return values, bounds
+}
+
+// appendRow builds a row with metric name/labels/timestamp and fields, then appends it into batch.
+func (p *parser) appendRow(
+	name string,
+	labels []Label,
+	timestamp int64,
+	addFields func(builder *commonseries.RowBuilder) error,
+) error {
+	limits := p.limits
+	if limits.EnableMetricNameLengthCheck() && len(name) > limits.MaxMetricNameLength {
+		return constants.ErrMetricNameTooLong
+	}
+	p.builder.Reset()
+	p.builder.AddNameSpace(strutil.String2ByteSlice(p.namespace))
+	p.builder.AddMetricName(strutil.String2ByteSlice(name))
+	p.builder.AddTimestamp(timestamp)
+	tags := 0
+	for _, l := range labels {
+		if l.Name == MetricNameLabel || l.Value == "" {
+			continue
+		}
+		if limits.EnableTagNameLengthCheck() && len(l.Name) > limits.MaxTagNameLength {
+			return constants.ErrTagKeyTooLong
+		}
+		if limits.EnableTagValueLengthCheck() && len(l.Value) > limits.MaxTagValueLength {
+			return constants.ErrTagValueTooLong
+		}
+		if err := p.builder.AddTag(strutil.String2ByteSlice(l.Name), strutil.String2ByteSlice(l.Value)); err != nil {
+			return err
+		}
+		tags++
+	}
+	for _, enrichedTag := range p.enrichedTags {
+		if err := p.builder.AddTag(enrichedTag.Key, enrichedTag.Value); err != nil {
+			return err
+		}
+		tags++
+	}
+	if limits.EnableTagsCheck() && tags > limits.MaxTagsPerMetric {
+		return constants.ErrTooManyTagKeys
+	}
+	if err := addFields(p.builder); err != nil {
+		return err
+	}
+	if err := p.batch.TryAppend(func(row *metric.BrokerRow) error {
+		data, err := p.builder.Build()
+		if err != nil {
+			return err
+		}
+		row.FromBlock(data)
+		return nil
+	}); err != nil {
+		return err
+	}
+	prometheusIngestionStatistics.IngestedMetrics.Incr()
+	return nil
+}
+
+// metricNameAndBound returns the metric name and histogram bucket bound from labels.
+func metricNameAndBound(labels []Label) (name, le string) {
+	for _, l := range labels {
+		switch l.Name {
+		case MetricNameLabel:
+			name = l.Value
+		case BucketLabel:
+			le = l.Value
+		}
+	}
+	return name, le
+}
+
+// seriesKeyOf returns the unique key of series(metric name + sorted labels).
+func seriesKeyOf(name string, sortedLabels []Label) string {
+	var sb strings.Builder
+	sb.WriteString(name)
+	for _, l := range sortedLabels {
+		sb.WriteByte(0xff)
+		sb.WriteString(l.Name)
+		sb.WriteByte('=')
+		sb.WriteString(l.Value)
+	}
+	return sb.String()
+}
+
+// counterPrefixOf returns the counter cache key prefix, same series written into different database/namespace,
+// or enriched with different tags are different counters.
+func counterPrefixOf(database, namespace string, enrichedTags tag.Tags) string {
+	tags := make([]string, 0, len(enrichedTags))
+	for _, enrichedTag := range enrichedTags {
+		tags = append(tags, string(enrichedTag.Key)+"="+string(enrichedTag.Value))
+	}
+	sort.Strings(tags)
+	return database + "\xff" + namespace + "\xff" + strings.Join(tags, "\xff")
+}
+
+// counterKey returns the hash of series(counter prefix/metric name/labels), which is used as counter cache key.
+func (p *parser) counterKey(name string, labels []Label, le string) uint64 {
+	sortedLabels := make([]Label, 0, len(labels))
+	for _, l := range labels {
+		if l.Name == MetricNameLabel || l.Name == BucketLabel {
+			continue
+		}
+		sortedLabels = append(sortedLabels, l)
+	}
+	sort.Slice(sortedLabels, func(i, j int) bool { return sortedLabels[i].Name < sortedLabels[j].Name })
+	var sb strings.Builder
+	sb.WriteString(p.counterPrefix)
+	sb.WriteByte(0xff)
+	sb.WriteString(seriesKeyOf(name, sortedLabels))
+	if le != "" {
+		sb.WriteString("\xff" + BucketLabel + "=" + le)
+	}
+	return xxhash.Sum64String(sb.String())
+}
@@ -0,0 +1,226 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+)
+
+func newRequest(t *testing.T, writeReq *WriteRequest) *http.Request {
+	data := snappy.Encode(nil, writeReq.Marshal())
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPost, "", bytes.NewReader(data))
+	assert.NoError(t, err)
+	return req
+}
+
+func newSeries(labels []Label, samples ...Sample) TimeSeries {
+	return TimeSeries{Labels: labels, Samples: samples}
+}
+
+func Test_Parse_badData(t *testing.T) {
+	req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, "", strings.NewReader("bad-data"))
+	_, _, err := Parse(req, "db", nil, "ns", models.NewDefaultLimits())
+	assert.Error(t, err)
+
+	// snappy ok, but bad proto
+	req, _ = http.NewRequestWithContext(context.TODO(), http.MethodPost, "",
+		bytes.NewReader(snappy.Encode(nil, []byte{0xff, 0xff, 0xff})))
+	_, _, err = Parse(req, "db", nil, "ns", models.NewDefaultLimits())
+	assert.Error(t, err)
+}
+
+func Test_Parse_empty(t *testing.T) {
+	_, _, err := Parse(newRequest(t, &WriteRequest{}), "db", nil, "ns", models.NewDefaultLimits())
+	assert.Error(t, err)
+
+	// no metric name
+	_, _, err = Parse(newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{
+		newSeries([]Label{{Name: "host", Value: "a"}}, Sample{Value: 1, Timestamp: 1}),
+	}}), "db", nil, "ns", models.NewDefaultLimits())
+	assert.Error(t, err)
+}
+
+func Test_Parse_gauge(t *testing.T) {
+	req := newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{
+		newSeries([]Label{{Name: MetricNameLabel, Value: "cpu_usage"}, {Name: "host", Value: "a"}},
+			Sample{Value: 1, Timestamp: 1000}, Sample{Value: math.NaN(), Timestamp: 2000}, Sample{Value: 3, Timestamp: 3000}),
+	}})
+	enrichedTags := tag.Tags{tag.NewTag([]byte("region"), []byte("nj"))}
+	batch, _, err := Parse(req, "db", enrichedTags, "ns", models.NewDefaultLimits())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, batch.Len())
+	m := batch.Rows()[0].Metric()
+	assert.Equal(t, "cpu_usage", string(m.Name()))
+	assert.Equal(t, "ns", string(m.Namespace()))
+	assert.Equal(t, int64(1000), m.Timestamp())
+	assert.Equal(t, 2, m.KeyValuesLength())
+	assert.Equal(t, 1, m.SimpleFieldsLength())
+	assert.Equal(t, 3.0, simpleFieldValue(batch.Rows()[1]))
+}
+
+func Test_Parse_counter(t *testing.T) {
+	labels := []Label{{Name: MetricNameLabel, Value: "http_requests_total"}, {Name: "method", Value: "counter-test"}}
+	req := newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{
+		newSeries(labels, Sample{Value: 10, Timestamp: 1000}, Sample{Value: 15, Timestamp: 2000}, Sample{Value: 3, Timestamp: 3000}),
+	}})
+	batch, updates, err := Parse(req, "db", nil, "ns", models.NewDefaultLimits())
+	assert.NoError(t, err)
+	// first seen, only sets base value
+	assert.Equal(t, 2, batch.Len())
+	assert.Equal(t, 5.0, simpleFieldValue(batch.Rows()[0]))
+	// counter reset
+	assert.Equal(t, 3.0, simpleFieldValue(batch.Rows()[1]))
+	updates.Commit()
+
+	// same series written into other database/namespace or enriched with other tags is another counter
+	enrichedTags := tag.Tags{tag.NewTag([]byte("region"), []byte("nj"))}
+	for _, write := range []func() (*metric.BrokerBatchRows, *CounterUpdates, error){
+		func() (*metric.BrokerBatchRows, *CounterUpdates, error) {
+			return Parse(newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{newSeries(labels, Sample{Value: 20, Timestamp: 4000})}}),
+				"other-db", nil, "ns", models.NewDefaultLimits())
+		},
+		func() (*metric.BrokerBatchRows, *CounterUpdates, error) {
+			return Parse(newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{newSeries(labels, Sample{Value: 20, Timestamp: 4000})}}),
+				"db", nil, "other-ns", models.NewDefaultLimits())
+		},
+		func() (*metric.BrokerBatchRows, *CounterUpdates, error) {
+			return Parse(newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{newSeries(labels, Sample{Value: 20, Timestamp: 4000})}}),
+				"db", enrichedTags, "ns", models.NewDefaultLimits())
+		},
+	} {
+		batch, updates, err = write()
+		assert.NoError(t, err)
+		assert.Equal(t, 0, batch.Len())
+		updates.Commit()
+	}
+	batch, updates, err = Parse(newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{newSeries(labels, Sample{Value: 23, Timestamp: 4000})}}),
+		"db", enrichedTags, "ns", models.NewDefaultLimits())
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, simpleFieldValue(batch.Rows()[0]))
+	updates.Commit()
+
+	// base value not changed if rows not written(updates not committed), retry computes same delta value
+	for i := 0; i < 2; i++ {
+		batch, updates, err = Parse(newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{newSeries(labels, Sample{Value: 5, Timestamp: 4000})}}),
+			"db", nil, "ns", models.NewDefaultLimits())
+		assert.NoError(t, err)
+		assert.Equal(t, 2.0, simpleFieldValue(batch.Rows()[0]))
+	}
+	updates.Commit()
+	batch, _, err = Parse(newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{newSeries(labels, Sample{Value: 6, Timestamp: 5000})}}),
+		"db", nil, "ns", models.NewDefaultLimits())
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, simpleFieldValue(batch.Rows()[0]))
+
+	// metadata gauge overrides suffix
+	req = newRequest(t, &WriteRequest{
+		TimeSeries: []TimeSeries{newSeries([]Label{{Name: MetricNameLabel, Value: "queue_total"}}, Sample{Value: 10, Timestamp: 1000})},
+		Metadata:   []MetricMetadata{{Type: MetricTypeGauge, MetricFamilyName: "queue_total"}},
+	})
+	batch, _, err = Parse(req, "db", nil, "ns", models.NewDefaultLimits())
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, simpleFieldValue(batch.Rows()[0]))
+}
+
+func Test_Parse_histogram(t *testing.T) {
+	bucket := func(le string, value float64, ts int64) TimeSeries {
+		return newSeries([]Label{
+			{Name: MetricNameLabel, Value: "latency_bucket"}, {Name: "host", Value: "histogram-test"}, {Name: BucketLabel, Value: le}},
+			Sample{Value: value, Timestamp: ts})
+	}
+	series := func(name string, value float64, ts int64) TimeSeries {
+		return newSeries([]Label{{Name: MetricNameLabel, Value: name}, {Name: "host", Value: "histogram-test"}},
+			Sample{Value: value, Timestamp: ts})
+	}
+	// first request initializes cumulative values
+	req := newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{
+		bucket("0.1", 1, 1000), bucket("1", 2, 1000), bucket("+Inf", 3, 1000),
+		series("latency_sum", 1, 1000), series("latency_count", 3, 1000),
+	}})
+	batch, updates, err := Parse(req, "db", nil, "ns", models.NewDefaultLimits())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, batch.Len())
+	updates.Commit()
+
+	req = newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{
+		bucket("+Inf", 13, 2000), bucket("0.1", 5, 2000), bucket("1", 10, 2000),
+		series("latency_sum", 5, 2000), series("latency_count", 13, 2000),
+	}})
+	batch, _, err = Parse(req, "db", nil, "ns", models.NewDefaultLimits())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, batch.Len())
+	m := batch.Rows()[0].Metric()
+	assert.Equal(t, "latency", string(m.Name()))
+	assert.Equal(t, 1, m.KeyValuesLength())
+	compound := m.CompoundField(&flatMetricsV1.CompoundField{})
+	assert.NotNil(t, compound)
+	assert.Equal(t, 3, compound.ValuesLength())
+	assert.Equal(t, []float64{4, 4, 2}, []float64{compound.Values(0), compound.Values(1), compound.Values(2)})
+	assert.True(t, math.IsInf(compound.ExplicitBounds(2), 1))
+	assert.Equal(t, 4.0, compound.Sum())
+	assert.Equal(t, 10.0, compound.Count())
+
+	// bad bucket bound
+	req = newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{bucket("abc", 1, 1000)}})
+	_, _, err = Parse(req, "db", nil, "ns", models.NewDefaultLimits())
+	assert.Error(t, err)
+	// missing +Inf bucket
+	req = newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{bucket("0.1", 1, 1000), bucket("1", 2, 1000)}})
+	_, _, err = Parse(req, "db", nil, "ns", models.NewDefaultLimits())
+	assert.Error(t, err)
+}
+
+func Test_Parse_limits(t *testing.T) {
+	limits := models.NewDefaultLimits()
+	limits.MaxMetricNameLength = 3
+	limits.MaxTagNameLength = 3
+	limits.MaxTagValueLength = 3
+	limits.MaxTagsPerMetric = 1
+	cases := [][]Label{
+		{{Name: MetricNameLabel, Value: "long_name"}},
+		{{Name: MetricNameLabel, Value: "cpu"}, {Name: "long_key", Value: "a"}},
+		{{Name: MetricNameLabel, Value: "cpu"}, {Name: "key", Value: "long_value"}},
+		{{Name: MetricNameLabel, Value: "cpu"}, {Name: "a", Value: "a"}, {Name: "b", Value: "b"}},
+	}
+	for _, labels := range cases {
+		req := newRequest(t, &WriteRequest{TimeSeries: []TimeSeries{newSeries(labels, Sample{Value: 1, Timestamp: 1})}})
+		_, _, err := Parse(req, "db", nil, "ns", limits)
+		assert.Error(t, err)
+	}
+}
+
+func simpleFieldValue(row metric.BrokerRow) float64 {
+	m := row.Metric()
+	var f flatMetricsV1.SimpleField
+	m.SimpleFields(&f, 0)
+	return f.Value()
+}
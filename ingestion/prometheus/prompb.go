@@ -0,0 +1,288 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"errors"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+var (
+	// ErrBadWriteRequest represents the remote write request cannot be decoded.
+	ErrBadWriteRequest = errors.New("bad prometheus remote write request")
+	// ErrMissingMetricName represents the time series has no __name__ label.
+	ErrMissingMetricName = errors.New("missing metric name label")
+	// ErrMissingBucketBound represents the histogram bucket has no valid le label.
+	ErrMissingBucketBound = errors.New("missing or bad histogram bucket bound")
+)
+
+// MetricType represents the metric type in prometheus metadata.
+type MetricType int32
+
+// Defines all metric types of prometheus metadata(prompb.MetricMetadata_MetricType).
+const (
+	MetricTypeUnknown MetricType = iota
+	MetricTypeCounter
+	MetricTypeGauge
+	MetricTypeHistogram
+	MetricTypeGaugeHistogram
+	MetricTypeSummary
+	MetricTypeInfo
+	MetricTypeStateSet
+)
+
+// Label represents a label pair of prometheus time series.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample represents a sample of prometheus time series.
+type Sample struct {
+	Value     float64
+	Timestamp int64
+}
+
+// TimeSeries represents a prometheus time series with labels and samples.
+type TimeSeries struct {
+	Labels  []Label
+	Samples []Sample
+}
+
+// MetricMetadata represents the metadata of metric family.
+type MetricMetadata struct {
+	Type             MetricType
+	MetricFamilyName string
+}
+
+// WriteRequest represents prometheus remote write request(prompb.WriteRequest),
+// only decodes the fields which LinDB needs, ignores others.
+type WriteRequest struct {
+	TimeSeries []TimeSeries
+	Metadata   []MetricMetadata
+}
+
+// Unmarshal decodes the remote write request from protobuf binary.
+func (m *WriteRequest) Unmarshal(data []byte) error {
+	return walkFields(data, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case 1:
+			var ts TimeSeries
+			if err := ts.Unmarshal(value); err != nil {
+				return err
+			}
+			m.TimeSeries = append(m.TimeSeries, ts)
+		case 3:
+			var md MetricMetadata
+			if err := md.Unmarshal(value); err != nil {
+				return err
+			}
+			m.Metadata = append(m.Metadata, md)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the time series from protobuf binary.
+func (m *TimeSeries) Unmarshal(data []byte) error {
+	return walkFields(data, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case 1:
+			var l Label
+			if err := l.Unmarshal(value); err != nil {
+				return err
+			}
+			m.Labels = append(m.Labels, l)
+		case 2:
+			var s Sample
+			if err := s.Unmarshal(value); err != nil {
+				return err
+			}
+			m.Samples = append(m.Samples, s)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the label from protobuf binary.
+func (m *Label) Unmarshal(data []byte) error {
+	return walkFields(data, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case 1:
+			m.Name = string(value)
+		case 2:
+			m.Value = string(value)
+		}
+		return nil
+	})
+}
+
+// Unmarshal decodes the sample from protobuf binary.
+func (m *Sample) Unmarshal(data []byte) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return ErrBadWriteRequest
+		}
+		data = data[n:]
+		switch {
+		case num == 1 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(data)
+			if n < 0 {
+				return ErrBadWriteRequest
+			}
+			m.Value = math.Float64frombits(v)
+			data = data[n:]
+		case num == 2 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(data)
+			if n < 0 {
+				return ErrBadWriteRequest
+			}
+			m.Timestamp = int64(v)
+			data = data[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, data)
+			if n < 0 {
+				return ErrBadWriteRequest
+			}
+			data = data[n:]
+		}
+	}
+	return nil
+}
+
+// Unmarshal decodes the metric metadata from protobuf binary.
+func (m *MetricMetadata) Unmarshal(data []byte) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return ErrBadWriteRequest
+		}
+		data = data[n:]
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(data)
+			if n < 0 {
+				return ErrBadWriteRequest
+			}
+			m.Type = MetricType(v)
+			data = data[n:]
+		case num == 2 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				return ErrBadWriteRequest
+			}
+			m.MetricFamilyName = string(v)
+			data = data[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, data)
+			if n < 0 {
+				return ErrBadWriteRequest
+			}
+			data = data[n:]
+		}
+	}
+	return nil
+}
+
+// Marshal encodes the remote write request into protobuf binary.
+func (m *WriteRequest) Marshal() []byte {
+	var buf []byte
+	for idx := range m.TimeSeries {
+		buf = protowire.AppendTag(buf, 1, protowire.BytesType)
+		buf = protowire.AppendBytes(buf, m.TimeSeries[idx].marshal())
+	}
+	for idx := range m.Metadata {
+		md := m.Metadata[idx]
+		var mdBuf []byte
+		mdBuf = protowire.AppendTag(mdBuf, 1, protowire.VarintType)
+		mdBuf = protowire.AppendVarint(mdBuf, uint64(md.Type))
+		mdBuf = protowire.AppendTag(mdBuf, 2, protowire.BytesType)
+		mdBuf = protowire.AppendString(mdBuf, md.MetricFamilyName)
+		buf = protowire.AppendTag(buf, 3, protowire.BytesType)
+		buf = protowire.AppendBytes(buf, mdBuf)
+	}
+	return buf
+}
+
+// marshal encodes the time series into protobuf binary.
+func (m *TimeSeries) marshal() []byte {
+	var buf []byte
+	for _, l := range m.Labels {
+		var labelBuf []byte
+		labelBuf = protowire.AppendTag(labelBuf, 1, protowire.BytesType)
+		labelBuf = protowire.AppendString(labelBuf, l.Name)
+		labelBuf = protowire.AppendTag(labelBuf, 2, protowire.BytesType)
+		labelBuf = protowire.AppendString(labelBuf, l.Value)
+		buf = protowire.AppendTag(buf, 1, protowire.BytesType)
+		buf = protowire.AppendBytes(buf, labelBuf)
+	}
+	for _, s := range m.Samples {
+		var sampleBuf []byte
+		sampleBuf = protowire.AppendTag(sampleBuf, 1, protowire.Fixed64Type)
+		sampleBuf = protowire.AppendFixed64(sampleBuf, math.Float64bits(s.Value))
+		sampleBuf = protowire.AppendTag(sampleBuf, 2, protowire.VarintType)
+		sampleBuf = protowire.AppendVarint(sampleBuf, uint64(s.Timestamp))
+		buf = protowire.AppendTag(buf, 2, protowire.BytesType)
+		buf = protowire.AppendBytes(buf, sampleBuf)
+	}
+	return buf
+}
+
+// walkFields walks all fields of message, invokes fn for each field,
+// value is the raw bytes for length-delimited field, else nil.
+func walkFields(data []byte, fn func(num protowire.Number, typ protowire.Type, value []byte) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return ErrBadWriteRequest
+		}
+		data = data[n:]
+		if typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				return ErrBadWriteRequest
+			}
+			data = data[n:]
+			if err := fn(num, typ, v); err != nil {
+				return err
+			}
+			continue
+		}
+		n = protowire.ConsumeFieldValue(num, typ, data)
+		if n < 0 {
+			return ErrBadWriteRequest
+		}
+		data = data[n:]
+		if err := fn(num, typ, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	resty "github.com/go-resty/resty/v2"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+//go:generate mockgen -source=./migration.go -destination=./migration_mock.go -package=client
+
+// define the api path of replica migration.
+const (
+	migrationPreparePath  = "/migration/prepare"
+	migrationImportPath   = "/migration/import"
+	migrationFinishPath   = "/migration/finish"
+	migrationExportPath   = "/migration/export"
+	migrationCompletePath = "/migration/complete"
+)
+
+// MigrationCli represents replica migration client of storage node.
+type MigrationCli interface {
+	// Prepare creates the shard of target replica.
+	Prepare(address string, req *models.MigrationPrepareRequest) error
+	// Import writes the rows block(snappy compressed) exported by leader into target replica, returns rows written.
+	Import(address, database string, shardID models.ShardID, familyTime int64, block []byte) (int, error)
+	// Finish finishes importing data into target replica, resets write ahead log of target replica.
+	Finish(address string, req *models.MigrationFinishRequest) error
+	// Export starts exporting the data of shard from leader to target replica.
+	Export(address string, req *models.MigrationExportRequest) error
+	// GetExportState returns the state of exporting job on leader.
+	GetExportState(address, database string, shardID models.ShardID, target models.NodeID) (*models.ReplicaMigration, error)
+	// CancelExport cancels exporting job on leader, removes the replicator of target replica.
+	CancelExport(address, database string, shardID models.ShardID, target models.NodeID) error
+	// Complete completes the migration after replica assignment changed.
+	Complete(address string, req *models.MigrationCompleteRequest) error
+}
+
+// migrationCli implements MigrationCli interface.
+type migrationCli struct {
+	Base
+}
+
+// NewMigrationCli creates a replica migration client instance.
+func NewMigrationCli(timeout time.Duration) MigrationCli {
+	cli := resty.New()
+	cli.SetTimeout(timeout)
+	return &migrationCli{
+		Base{
+			cli: cli,
+		}}
+}
+
+// Prepare creates the shard of target replica.
+func (cli *migrationCli) Prepare(address string, req *models.MigrationPrepareRequest) error {
+	return cli.do(cli.cli.R().SetBody(req), http.MethodPost, address, migrationPreparePath, nil)
+}
+
+// Import writes the rows block(snappy compressed) exported by leader into target replica, returns rows written.
+func (cli *migrationCli) Import(address, database string,
+	shardID models.ShardID, familyTime int64, block []byte,
+) (int, error) {
+	rows := 0
+	if err := cli.do(cli.cli.R().
+		SetQueryParams(map[string]string{
+			"database":   database,
+			"shardId":    shardID.String(),
+			"familyTime": strconv.FormatInt(familyTime, 10),
+		}).
+		SetHeader("Content-Type", "application/octet-stream").
+		SetBody(block), http.MethodPut, address, migrationImportPath, &rows); err != nil {
+		return 0, err
+	}
+	return rows, nil
+}
+
+// Finish finishes importing data into target replica, resets write ahead log of target replica.
+func (cli *migrationCli) Finish(address string, req *models.MigrationFinishRequest) error {
+	return cli.do(cli.cli.R().SetBody(req), http.MethodPost, address, migrationFinishPath, nil)
+}
+
+// Export starts exporting the data of shard from leader to target replica.
+func (cli *migrationCli) Export(address string, req *models.MigrationExportRequest) error {
+	return cli.do(cli.cli.R().SetBody(req), http.MethodPost, address, migrationExportPath, nil)
+}
+
+// GetExportState returns the state of exporting job on leader.
+func (cli *migrationCli) GetExportState(address, database string,
+	shardID models.ShardID, target models.NodeID,
+) (*models.ReplicaMigration, error) {
+	state := &models.ReplicaMigration{}
+	if err := cli.do(cli.cli.R().SetQueryParams(exportJobParams(database, shardID, target)),
+		http.MethodGet, address, migrationExportPath, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// CancelExport cancels exporting job on leader, removes the replicator of target replica.
+func (cli *migrationCli) CancelExport(address, database string, shardID models.ShardID, target models.NodeID) error {
+	return cli.do(cli.cli.R().SetQueryParams(exportJobParams(database, shardID, target)),
+		http.MethodDelete, address, migrationExportPath, nil)
+}
+
+// Complete completes the migration after replica assignment changed.
+func (cli *migrationCli) Complete(address string, req *models.MigrationCompleteRequest) error {
+	return cli.do(cli.cli.R().SetBody(req), http.MethodPost, address, migrationCompletePath, nil)
+}
+
+// do sends the request to storage node, unmarshal response if success.
+func (cli *Base) do(req *resty.Request, method, address, path string, rs interface{}) error {
+	resp, err := req.
+		SetHeader("Accept", "application/json").
+		Execute(method, address+constants.APIVersion1CliPath+path)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return errors.New(string(resp.Body()))
+	}
+	if data := resp.Body(); rs != nil && len(data) > 0 {
+		return encoding.JSONUnmarshal(data, rs)
+	}
+	return nil
+}
+
+// exportJobParams returns the query params of exporting job.
+func exportJobParams(database string, shardID models.ShardID, target models.NodeID) map[string]string {
+	return map[string]string{
+		"database": database,
+		"shardId":  shardID.String(),
+		"target":   target.String(),
+	}
+}
@@ -0,0 +1,94 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+func TestMigrationCli(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		paths = append(paths, req.Method+" "+req.URL.Path)
+		switch req.Method {
+		case http.MethodGet:
+			_, _ = rw.Write(encoding.JSONMarshal(&models.ReplicaMigration{State: models.MigrationExporting, Rows: 10}))
+		case http.MethodPut:
+			_, _ = rw.Write([]byte("10"))
+		default:
+			rw.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	cli := NewMigrationCli(time.Second)
+	assert.NoError(t, cli.Prepare(server.URL, &models.MigrationPrepareRequest{Database: "test"}))
+	_, err := cli.Import(server.URL, "test", 1, 10, []byte{1, 2, 3})
+	assert.NoError(t, err)
+	assert.NoError(t, cli.Finish(server.URL, &models.MigrationFinishRequest{Database: "test"}))
+	assert.NoError(t, cli.Export(server.URL, &models.MigrationExportRequest{Database: "test"}))
+	state, err := cli.GetExportState(server.URL, "test", 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, models.MigrationExporting, state.State)
+	assert.Equal(t, int64(10), state.Rows)
+	assert.NoError(t, cli.CancelExport(server.URL, "test", 1, 2))
+	assert.NoError(t, cli.Complete(server.URL, &models.MigrationCompleteRequest{Database: "test"}))
+	prefix := constants.APIVersion1CliPath
+	assert.Equal(t, []string{
+		"POST " + prefix + "/migration/prepare",
+		"PUT " + prefix + "/migration/import",
+		"POST " + prefix + "/migration/finish",
+		"POST " + prefix + "/migration/export",
+		"GET " + prefix + "/migration/export",
+		"DELETE " + prefix + "/migration/export",
+		"POST " + prefix + "/migration/complete",
+	}, paths)
+}
+
+func TestMigrationCli_Failure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodGet {
+			_, _ = rw.Write([]byte("abc"))
+			return
+		}
+		rw.WriteHeader(http.StatusInternalServerError)
+		_, _ = rw.Write([]byte("err"))
+	}))
+	defer server.Close()
+
+	cli := NewMigrationCli(time.Second)
+	err := cli.Prepare(server.URL, &models.MigrationPrepareRequest{Database: "test"})
+	assert.EqualError(t, err, "err")
+	_, err = cli.Import(server.URL, "test", 1, 10, []byte{1, 2, 3})
+	assert.Error(t, err)
+	// unmarshal failure
+	_, err = cli.GetExportState(server.URL, "test", 1, 2)
+	assert.Error(t, err)
+	// url wrong
+	err = cli.Export("http://127.0.0.1:30001", &models.MigrationExportRequest{Database: "test"})
+	assert.Error(t, err)
+}
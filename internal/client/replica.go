@@ -0,0 +1,83 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	resty "github.com/go-resty/resty/v2"
+
+	"github.com/lindb/lindb/models"
+)
+
+//go:generate mockgen -source=./replica.go -destination=./replica_mock.go -package=client
+
+// define the api path of replica state/consistency check.
+const (
+	replicaStatePath  = "/state/replica"
+	replicaDigestPath = "/state/replica/digest"
+)
+
+// ReplicaCli represents replica state/consistency check client of storage node.
+type ReplicaCli interface {
+	// GetReplicaState returns the write ahead log replica state of database's families on storage node.
+	GetReplicaState(address, database string) ([]models.FamilyLogReplicaState, error)
+	// GetFamilyDigest returns the digest of persisted data in data family of replica.
+	GetFamilyDigest(address, database string, shardID models.ShardID, familyTime int64) (*models.FamilyDigest, error)
+}
+
+// replicaCli implements ReplicaCli interface.
+type replicaCli struct {
+	Base
+}
+
+// NewReplicaCli creates a replica state/consistency check client instance.
+func NewReplicaCli(timeout time.Duration) ReplicaCli {
+	cli := resty.New()
+	cli.SetTimeout(timeout)
+	return &replicaCli{
+		Base{
+			cli: cli,
+		}}
+}
+
+// GetReplicaState returns the write ahead log replica state of database's families on storage node.
+func (cli *replicaCli) GetReplicaState(address, database string) ([]models.FamilyLogReplicaState, error) {
+	var rs []models.FamilyLogReplicaState
+	if err := cli.do(cli.cli.R().SetQueryParam("db", database), http.MethodGet, address, replicaStatePath, &rs); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// GetFamilyDigest returns the digest of persisted data in data family of replica.
+func (cli *replicaCli) GetFamilyDigest(address, database string,
+	shardID models.ShardID, familyTime int64,
+) (*models.FamilyDigest, error) {
+	digest := &models.FamilyDigest{}
+	if err := cli.do(cli.cli.R().SetQueryParams(map[string]string{
+		"db":         database,
+		"shardId":    shardID.String(),
+		"familyTime": strconv.FormatInt(familyTime, 10),
+	}), http.MethodGet, address, replicaDigestPath, digest); err != nil {
+		return nil, err
+	}
+	return digest, nil
+}
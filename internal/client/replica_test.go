@@ -0,0 +1,73 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+func TestReplicaCli_GetFamilyDigest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, constants.APIVersion1CliPath+"/state/replica/digest", req.URL.Path)
+		assert.Equal(t, "test", req.URL.Query().Get("db"))
+		assert.Equal(t, "1", req.URL.Query().Get("shardId"))
+		assert.Equal(t, "10", req.URL.Query().Get("familyTime"))
+		_, _ = rw.Write(encoding.JSONMarshal(&models.FamilyDigest{FamilyTime: 10, Sequences: map[int32]int64{1: 100}}))
+	}))
+	defer server.Close()
+
+	cli := NewReplicaCli(time.Second)
+	digest, err := cli.GetFamilyDigest(server.URL, "test", 1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.FamilyDigest{FamilyTime: 10, Sequences: map[int32]int64{1: 100}}, digest)
+
+	server.Close()
+	digest, err = cli.GetFamilyDigest(server.URL, "test", 1, 10)
+	assert.Error(t, err)
+	assert.Nil(t, digest)
+}
+
+func TestReplicaCli_GetReplicaState(t *testing.T) {
+	state := []models.FamilyLogReplicaState{{ShardID: 1, Leader: 1, Append: 10,
+		Replicators: []models.ReplicaPeerState{{Replicator: "2", ACK: 10, State: models.ReplicatorReadyState}}}}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, constants.APIVersion1CliPath+"/state/replica", req.URL.Path)
+		assert.Equal(t, "test", req.URL.Query().Get("db"))
+		_, _ = rw.Write(encoding.JSONMarshal(state))
+	}))
+	defer server.Close()
+
+	cli := NewReplicaCli(time.Second)
+	rs, err := cli.GetReplicaState(server.URL, "test")
+	assert.NoError(t, err)
+	assert.Equal(t, state, rs)
+
+	server.Close()
+	rs, err = cli.GetReplicaState(server.URL, "test")
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
@@ -0,0 +1,132 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package concurrent
+
+import (
+	"sync"
+	"time"
+)
+
+// for testing
+var (
+	nowFn = time.Now
+)
+
+// TokenBucket represents a token bucket rate limiter, tokens are refilled at rate per second,
+// and the capacity of bucket is one second of tokens.
+// It allows taking more tokens than available(in debt), so that the size of request need not be
+// known in advance, the following requests are throttled until the debt is paid off.
+type TokenBucket struct {
+	rate   float64 // tokens per second
+	tokens float64
+	last   time.Time
+
+	mutex sync.Mutex
+}
+
+// NewTokenBucket creates a full token bucket with rate per second.
+func NewTokenBucket(rate int64) *TokenBucket {
+	return &TokenBucket{
+		rate:   float64(rate),
+		tokens: float64(rate),
+		last:   nowFn(),
+	}
+}
+
+// Rate returns the rate(tokens per second) of bucket.
+func (b *TokenBucket) Rate() int64 {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return int64(b.rate)
+}
+
+// SetRate sets the rate(tokens per second) of bucket.
+func (b *TokenBucket) SetRate(rate int64) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.refill()
+	b.rate = float64(rate)
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+}
+
+// Wait returns the duration to wait until tokens are available, returns 0 if tokens are available now.
+func (b *TokenBucket) Wait() time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.refill()
+	return b.wait()
+}
+
+// Reserve takes n tokens from bucket if tokens are available now(the bucket may be in debt after taking),
+// returns 0 if reserved, else returns the duration to wait until tokens are available.
+// Checking and taking are atomic, so that concurrent requests cannot pass the check with the same tokens.
+func (b *TokenBucket) Reserve(n int64) time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.refill()
+	if wait := b.wait(); wait > 0 {
+		return wait
+	}
+	b.tokens -= float64(n)
+	return 0
+}
+
+// Take takes n tokens from bucket, the bucket may be in debt after taking,
+// negative n returns tokens into bucket(e.g. reserved more than used).
+func (b *TokenBucket) Take(n int64) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.refill()
+	b.tokens -= float64(n)
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+}
+
+// wait returns the duration to wait until tokens are available, must hold lock.
+func (b *TokenBucket) wait() time.Duration {
+	if b.tokens > 0 {
+		return 0
+	}
+	if b.rate <= 0 {
+		return time.Second
+	}
+	// wait until the debt is paid off and at least one token is available
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// refill refills tokens based on the elapsed time since last refill, must hold lock.
+func (b *TokenBucket) refill() {
+	now := nowFn()
+	elapsed := now.Sub(b.last)
+	b.last = now
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens += elapsed.Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+}
@@ -0,0 +1,86 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package concurrent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	nowFn = func() time.Time {
+		return now
+	}
+	defer func() {
+		nowFn = time.Now
+	}()
+
+	b := NewTokenBucket(100)
+	assert.Equal(t, int64(100), b.Rate())
+	assert.Equal(t, time.Duration(0), b.Wait())
+	// in debt
+	b.Take(250)
+	assert.Equal(t, 1510*time.Millisecond, b.Wait())
+	now = now.Add(time.Second)
+	assert.Equal(t, 510*time.Millisecond, b.Wait())
+	now = now.Add(600 * time.Millisecond)
+	assert.Equal(t, time.Duration(0), b.Wait())
+	// bucket is full after long time
+	now = now.Add(time.Hour)
+	b.Take(100)
+	assert.Equal(t, 10*time.Millisecond, b.Wait())
+
+	// change rate
+	now = now.Add(time.Hour)
+	b.SetRate(10)
+	assert.Equal(t, int64(10), b.Rate())
+	b.Take(10)
+	assert.Equal(t, 100*time.Millisecond, b.Wait())
+	// clock goes back
+	now = now.Add(-time.Second)
+	assert.Equal(t, 100*time.Millisecond, b.Wait())
+
+	b.SetRate(0)
+	assert.Equal(t, time.Second, b.Wait())
+}
+
+func TestTokenBucket_Reserve(t *testing.T) {
+	now := time.Now()
+	nowFn = func() time.Time {
+		return now
+	}
+	defer func() {
+		nowFn = time.Now
+	}()
+
+	b := NewTokenBucket(100)
+	assert.Equal(t, time.Duration(0), b.Reserve(150))
+	// reserved by previous request, in debt
+	assert.Equal(t, 510*time.Millisecond, b.Reserve(10))
+	assert.Equal(t, 510*time.Millisecond, b.Wait())
+	// return reserved tokens
+	b.Take(-100)
+	assert.Equal(t, time.Duration(0), b.Reserve(10))
+	// tokens not exceed capacity after returned
+	b.Take(-1000)
+	b.Take(100)
+	assert.Equal(t, 10*time.Millisecond, b.Wait())
+}
@@ -71,8 +71,9 @@ func (c *compactJob) Run() error {
 	}()
 	compaction := c.state.compaction
 	switch {
-	case c.rollup == nil && compaction.IsTrivialMove():
-		// compact job can move file
+	case c.rollup == nil && compaction.IsTrivialMove() && c.getTombstone() == nil && c.isSameCompression():
+		// compact job can move file, if family has deleted data, need merge file for purging deleted data,
+		// if compression codec of family changed, need merge file for rewriting it with new codec.
 		c.moveCompaction()
 	default:
 		if err := c.mergeCompaction(); err != nil {
@@ -83,6 +84,17 @@ func (c *compactJob) Run() error {
 	return nil
 }
 
+// isSameCompression checks if the compression codec of level file is same as family's codec.
+func (c *compactJob) isSameCompression() bool {
+	fileMeta := c.state.compaction.GetLevelFiles()[0]
+	reader, err := c.state.snapshot.GetReader(fileMeta.GetFileNumber())
+	if err != nil || reader == nil {
+		// cannot check codec, merge file
+		return false
+	}
+	return reader.Compression() == c.family.getCompression()
+}
+
 // moveCompaction moves low level file to up level, just does metadata change
 func (c *compactJob) moveCompaction() {
 	startTime := time.Now()
@@ -130,8 +142,15 @@ func (c *compactJob) doMerge() error {
 	if err != nil {
 		return err
 	}
+	params := make(map[string]interface{})
 	if c.rollup != nil {
-		merger.Init(map[string]interface{}{RollupContext: c.rollup})
+		params[RollupContext] = c.rollup
+	}
+	if tombstone := c.getTombstone(); tombstone != nil {
+		params[TombstoneContext] = tombstone
+	}
+	if len(params) > 0 {
+		merger.Init(params)
 	}
 
 	var needMerge [][]byte
@@ -177,6 +196,15 @@ func (c *compactJob) doMerge() error {
 	return nil
 }
 
+// getTombstone returns the tombstone of family if it has deleted data, else returns nil.
+func (c *compactJob) getTombstone() Tombstone {
+	tombstone := c.family.getTombstone()
+	if tombstone == nil || tombstone.IsEmpty() {
+		return nil
+	}
+	return tombstone
+}
+
 // installCompactionResults installs compactions results.
 // 1. mark input files is deletion which compaction job picked.
 // 2. add output files to up level.
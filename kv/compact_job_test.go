@@ -57,6 +57,10 @@ func TestCompactJob_move_compact(t *testing.T) {
 		return merge, nil
 	})
 	family.EXPECT().familyInfo().Return("family").AnyTimes()
+	family.EXPECT().getCompression().Return(table.SnappyCompression).AnyTimes()
+	reader := table.NewMockReader(ctrl)
+	reader.EXPECT().Compression().Return(table.SnappyCompression)
+	snapshot.EXPECT().GetReader(gomock.Any()).Return(reader, nil)
 	f1 := version.NewFileMeta(1, 1, 100, 100)
 	compaction := version.NewCompaction(1, 0, []*version.FileMeta{f1}, nil)
 	state := newCompactionState(1000, snapshot, compaction)
@@ -73,6 +77,100 @@ func TestCompactJob_move_compact(t *testing.T) {
 	assert.Equal(t, version.CreateNewFile(1, f1), logs[1])
 }
 
+func TestCompactJob_compression_changed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snapshot := version.NewMockSnapshot(ctrl)
+	merge := NewMockMerger(ctrl)
+	family := generateMockFamily(ctrl, func(flusher Flusher) (Merger, error) {
+		return merge, nil
+	})
+	family.EXPECT().familyInfo().Return("family").AnyTimes()
+	family.EXPECT().getCompression().Return(table.ZstdCompression).AnyTimes()
+	f1 := version.NewFileMeta(1, 1, 100, 100)
+	compaction := version.NewCompaction(1, 0, []*version.FileMeta{f1}, nil)
+	cases := []struct {
+		name    string
+		prepare func()
+	}{
+		{
+			name: "get reader failure",
+			prepare: func() {
+				snapshot.EXPECT().GetReader(gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name: "codec changed",
+			prepare: func() {
+				reader := table.NewMockReader(ctrl)
+				reader.EXPECT().Compression().Return(table.NoCompression)
+				snapshot.EXPECT().GetReader(gomock.Any()).Return(reader, nil)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(_ *testing.T) {
+			tt.prepare()
+			// cannot move file, need rewrite file with new codec
+			snapshot.EXPECT().GetReader(gomock.Any()).Return(nil, fmt.Errorf("err"))
+			state := newCompactionState(1000, snapshot, compaction)
+			compact := newCompactJob(family, state, nil)
+			err := compact.Run()
+			assert.Error(t, err)
+		})
+	}
+}
+
+type mockTombstone struct {
+	empty bool
+}
+
+func (t *mockTombstone) IsEmpty() bool {
+	return t.empty
+}
+
+func TestCompactJob_merge_with_tombstone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snapshot := version.NewMockSnapshot(ctrl)
+	reader := table.NewMockReader(ctrl)
+	reader.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+		1: []byte("value1"),
+	}))
+	snapshot.EXPECT().GetReader(gomock.Any()).Return(reader, nil)
+	tombstone := &mockTombstone{}
+	merge := NewMockMerger(ctrl)
+	// tombstone not empty, cannot move file, need purge deleted data
+	merge.EXPECT().Init(map[string]interface{}{TombstoneContext: tombstone})
+	merge.EXPECT().Merge(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	family := NewMockFamily(ctrl)
+	family.EXPECT().getNewMerger().Return(func(flusher Flusher) (Merger, error) {
+		return merge, nil
+	}).AnyTimes()
+	family.EXPECT().familyInfo().Return("family").AnyTimes()
+	family.EXPECT().getTombstone().Return(tombstone).AnyTimes()
+	f1 := version.NewFileMeta(1, 1, 100, 100)
+	compaction := version.NewCompaction(1, 0, []*version.FileMeta{f1}, nil)
+	state := newCompactionState(1000, snapshot, compaction)
+	compact := newCompactJob(family, state, nil)
+	err := compact.Run()
+	assert.Error(t, err)
+
+	// tombstone is empty, just move file
+	tombstone.empty = true
+	family.EXPECT().getCompression().Return(table.NoCompression)
+	reader.EXPECT().Compression().Return(table.NoCompression)
+	snapshot.EXPECT().GetReader(gomock.Any()).Return(reader, nil)
+	family.EXPECT().commitEditLog(gomock.Any()).Return(true)
+	state = newCompactionState(1000, snapshot, compaction)
+	compact = newCompactJob(family, state, nil)
+	err = compact.Run()
+	assert.NoError(t, err)
+}
+
 func TestCompactJob_merge_compact_get_read_fail(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -359,6 +457,7 @@ func generateMockFamily(ctrl *gomock.Controller, merger NewMerger) *MockFamily {
 	family.EXPECT().getNewMerger().Return(merger).AnyTimes()
 	family.EXPECT().Name().Return("test-family").AnyTimes()
 	family.EXPECT().commitEditLog(gomock.Any()).Return(true).AnyTimes()
+	family.EXPECT().getTombstone().Return(nil).AnyTimes()
 	return family
 }
 
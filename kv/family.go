@@ -18,6 +18,7 @@
 package kv
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"sync"
@@ -39,6 +40,9 @@ var (
 	removeDirFunc     = fileutil.RemoveDir
 )
 
+// ErrFamilyCompacting represents the error when the family is doing compaction job.
+var ErrFamilyCompacting = errors.New("family is compacting")
+
 // Family implements column family for data isolation each family.
 type Family interface {
 	// ID return family's id.
@@ -51,6 +55,10 @@ type Family interface {
 	GetSnapshot() version.Snapshot
 	// Compact compacts all files of level0.
 	Compact()
+	// SetTombstone sets the tombstone of family, merger purges deleted data when doing compaction.
+	SetTombstone(tombstone Tombstone)
+	// Truncate removes all files of family, returns ErrFamilyCompacting if compaction job is running.
+	Truncate() error
 
 	getStore() Store
 	// familyInfo return family info
@@ -62,12 +70,16 @@ type Family interface {
 	commitEditLog(editLog version.EditLog) bool
 	// newTableBuilder creates table builder instance for storing kv data.
 	newTableBuilder() (table.Builder, error)
+	// getCompression returns the block compression codec of family.
+	getCompression() table.Compression
 	// needCompact returns level0 files if it needs to do compact job.
 	needCompact() bool
 	// compact does compaction job.
 	compact()
 	// getNewMerger returns new merger function, merger need implement Merger interface
 	getNewMerger() NewMerger
+	// getTombstone returns the tombstone of family, returns nil if not set.
+	getTombstone() Tombstone
 	// addPendingOutput add a file which current writing file number
 	addPendingOutput(fileNumber table.FileNumber)
 	// removePendingOutput removes pending output file after compact or flush
@@ -93,6 +105,7 @@ type family struct {
 	merger        NewMerger
 	familyVersion version.FamilyVersion
 	maxFileSize   uint32
+	compression   table.Compression
 
 	pendingOutputs    sync.Map // keep all pending output files, includes flush/compact/rollup.
 	newCompactJobFunc func(family Family, state *compactionState, rollup Rollup) CompactJob
@@ -101,6 +114,9 @@ type family struct {
 	lastRollupTime *atomic.Int64
 	compacting     atomic.Bool
 
+	tombstone     Tombstone
+	tombstoneLock sync.RWMutex
+
 	condition sync.WaitGroup // compact/rollup job if it's doing
 }
 
@@ -123,6 +139,10 @@ func newFamily(store Store, option FamilyOption) (Family, error) {
 	if option.MaxFileSize > 0 {
 		maxFileSize = option.MaxFileSize
 	}
+	compression, err := table.ParseCompression(option.Compression)
+	if err != nil {
+		return nil, err
+	}
 
 	f := &family{
 		familyPath:        familyPath,
@@ -131,6 +151,7 @@ func newFamily(store Store, option FamilyOption) (Family, error) {
 		option:            option,
 		merger:            merger,
 		maxFileSize:       maxFileSize,
+		compression:       compression,
 		newCompactJobFunc: newCompactJobFunc,
 		familyVersion:     store.createFamilyVersion(name, version.FamilyID(option.ID)),
 		lastRollupTime:    atomic.NewInt64(timeutil.Now()),
@@ -176,7 +197,12 @@ func (f *family) familyInfo() string {
 func (f *family) newTableBuilder() (table.Builder, error) {
 	fileNumber := f.store.nextFileNumber()
 	fileName := filepath.Join(f.familyPath, version.Table(fileNumber))
-	return table.NewStoreBuilder(fileNumber, fileName)
+	return table.NewStoreBuilder(fileNumber, fileName, f.compression)
+}
+
+// getCompression returns the block compression codec of family.
+func (f *family) getCompression() table.Compression {
+	return f.compression
 }
 
 // commitEditLog persists edit logs into manifest file.
@@ -209,6 +235,31 @@ func (f *family) Compact() {
 	}
 }
 
+// Truncate removes all files of family, returns ErrFamilyCompacting if compaction job is running.
+func (f *family) Truncate() error {
+	if !f.compacting.CAS(false, true) {
+		return ErrFamilyCompacting
+	}
+	defer f.compacting.Store(false)
+
+	snapshot := f.GetSnapshot()
+	current := snapshot.GetCurrent()
+	editLog := version.NewEditLog(f.ID())
+	for lv := range current.Levels() {
+		for _, file := range current.GetFiles(lv) {
+			editLog.Add(version.NewDeleteFile(int32(lv), file.GetFileNumber()))
+		}
+	}
+	snapshot.Close()
+
+	if !f.commitEditLog(editLog) {
+		return fmt.Errorf("commit edit log failure when truncate family: %s", f.familyInfo())
+	}
+	f.deleteObsoleteFiles()
+	kvLogger.Info("truncate family successfully", logger.String("family", f.familyInfo()))
+	return nil
+}
+
 // needCompact returns level0 files if it needs to do compact job
 func (f *family) needCompact() bool {
 	// has compaction job doing
@@ -300,6 +351,20 @@ func (f *family) getNewMerger() NewMerger {
 	return f.merger
 }
 
+// SetTombstone sets the tombstone of family, merger purges deleted data when doing compaction.
+func (f *family) SetTombstone(tombstone Tombstone) {
+	f.tombstoneLock.Lock()
+	defer f.tombstoneLock.Unlock()
+	f.tombstone = tombstone
+}
+
+// getTombstone returns the tombstone of family, returns nil if not set.
+func (f *family) getTombstone() Tombstone {
+	f.tombstoneLock.RLock()
+	defer f.tombstoneLock.RUnlock()
+	return f.tombstone
+}
+
 // deleteObsoleteFiles deletes obsolete files
 func (f *family) deleteObsoleteFiles() {
 	sstFiles, err := listDirFunc(f.familyPath)
@@ -58,7 +58,11 @@ func TestFamily_New(t *testing.T) {
 	f, err = newFamily(store, FamilyOption{Merger: "mockMerger_not_exist"})
 	assert.Error(t, err)
 	assert.Nil(t, f)
-	// case 3: create family success
+	// case 3: create family err, compression not exist
+	f, err = newFamily(store, FamilyOption{Merger: "mockMerger", Compression: "compression_not_exist"})
+	assert.Error(t, err)
+	assert.Nil(t, f)
+	// case 4: create family success
 	vs := version.NewMockFamilyVersion(ctrl)
 	store.EXPECT().createFamilyVersion(gomock.Any(), gomock.Any()).Return(vs)
 	f, err = newFamily(store, FamilyOption{Merger: "mockMerger", ID: 10, Name: "f", MaxFileSize: 10})
@@ -105,6 +109,65 @@ func TestFamily_Data_Write_Read(t *testing.T) {
 	snapshot.Close()
 }
 
+func TestFamily_Truncate(t *testing.T) {
+	testKVPath := filepath.Join(t.TempDir(), "test_data")
+	kv, err := newStore("test_kv", testKVPath, DefaultStoreOption())
+	assert.NoError(t, err)
+	defer func() {
+		_ = kv.close()
+	}()
+
+	f, err := kv.CreateFamily("f", FamilyOption{Merger: "mockMerger"})
+	assert.NoError(t, err)
+	flusher := f.NewFlusher()
+	defer flusher.Release()
+	assert.NoError(t, flusher.Add(1, []byte("test")))
+	assert.NoError(t, flusher.Commit())
+
+	// case 1: compaction job is running
+	f1 := f.(*family)
+	f1.compacting.Store(true)
+	assert.Equal(t, ErrFamilyCompacting, f.Truncate())
+	f1.compacting.Store(false)
+	// case 2: truncate all files
+	assert.NoError(t, f.Truncate())
+	snapshot := f.GetSnapshot()
+	assert.Empty(t, snapshot.GetCurrent().GetAllFiles())
+	snapshot.Close()
+	files, err := listDirFunc(f1.familyPath)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+	// case 3: family is empty
+	assert.NoError(t, f.Truncate())
+}
+
+func TestFamily_Compression(t *testing.T) {
+	testKVPath := filepath.Join(t.TempDir(), "test_data")
+	kv, err := newStore("test_kv", testKVPath, DefaultStoreOption())
+	assert.NoError(t, err)
+	defer func() {
+		_ = kv.close()
+	}()
+
+	f, err := kv.CreateFamily("f", FamilyOption{Merger: "mockMerger", Compression: "zstd"})
+	assert.NoError(t, err)
+	assert.Equal(t, table.ZstdCompression, f.getCompression())
+	flusher := f.NewFlusher()
+	defer flusher.Release()
+	assert.NoError(t, flusher.Add(1, []byte("test")))
+	assert.NoError(t, flusher.Commit())
+
+	snapshot := f.GetSnapshot()
+	defer snapshot.Close()
+	readers, err := snapshot.FindReaders(1)
+	assert.NoError(t, err)
+	assert.Len(t, readers, 1)
+	assert.Equal(t, table.ZstdCompression, readers[0].Compression())
+	value, err := readers[0].Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test"), value)
+}
+
 func TestFamily_commitEditLog(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -132,6 +195,22 @@ func TestFamily_commitEditLog(t *testing.T) {
 	assert.True(t, f.commitEditLog(editLog))
 }
 
+func TestFamily_Tombstone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := NewMockStore(ctrl)
+	store.EXPECT().Option().Return(DefaultStoreOption()).AnyTimes()
+	store.EXPECT().Path().Return(t.TempDir()).AnyTimes()
+	store.EXPECT().createFamilyVersion(gomock.Any(), gomock.Any()).Return(version.NewMockFamilyVersion(ctrl))
+	f, err := newFamily(store, FamilyOption{Merger: "mockMerger", Name: "tombstone"})
+	assert.NoError(t, err)
+	assert.Nil(t, f.getTombstone())
+	tombstone := &mockTombstone{}
+	f.SetTombstone(tombstone)
+	assert.Equal(t, tombstone, f.getTombstone())
+}
+
 func TestFamily_needCompact(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
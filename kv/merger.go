@@ -45,3 +45,9 @@ type Merger interface {
 	// return err if failure
 	Merge(key uint32, values [][]byte) error
 }
+
+// Tombstone represents the deleted data of family, merger purges deleted data when doing compaction.
+type Tombstone interface {
+	// IsEmpty returns if family has not any deleted data.
+	IsEmpty() bool
+}
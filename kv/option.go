@@ -32,6 +32,7 @@ type FamilyOption struct {
 	RollupThreshold  int    `toml:"rollupThreshold"`  // level 0 rollup threshold
 	Merger           string `toml:"merger"`           // merger which need implement Merger interface
 	MaxFileSize      uint32 `toml:"maxFileSize"`      // max file size
+	Compression      string `toml:"compression"`      // block compression codec(none/snappy/zstd/lz4), default none
 }
 
 // StoreOption defines config item for store level
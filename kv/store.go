@@ -39,15 +39,16 @@ import (
 
 // for testing
 var (
-	encodeTomlFunc    = ltoml.EncodeToml
-	decodeTomlFunc    = ltoml.DecodeToml
-	newFamilyFunc     = newFamily
-	newVersionSetFunc = version.NewStoreVersionSet
-	listDirFunc       = fileutil.ListDir
-	mkDirFunc         = fileutil.MkDirIfNotExist
-	removeFunc        = os.Remove
-	newFileLockFunc   = lockers.NewFileLock
-	newStoreFunc      = newStore
+	encodeTomlFunc     = ltoml.EncodeToml
+	decodeTomlFunc     = ltoml.DecodeToml
+	newFamilyFunc      = newFamily
+	newVersionSetFunc  = version.NewStoreVersionSet
+	listDirFunc        = fileutil.ListDir
+	mkDirFunc          = fileutil.MkDirIfNotExist
+	removeFunc         = os.Remove
+	newFileLockFunc    = lockers.NewFileLock
+	newStoreFunc       = newStore
+	linkOrCopyFileFunc = fileutil.LinkOrCopyFile
 )
 
 // Store is kv store, supporting column family, but is different from other LSM implementation.
@@ -67,6 +68,10 @@ type Store interface {
 	Option() StoreOption
 	// ForceRollup does rollup job manual.
 	ForceRollup()
+	// Backup pins the snapshot of all families, then links(copies if link not supported)
+	// the files of snapshot into target path with options/manifest, so that target path can be opened as a store,
+	// returns the replica sequences of each family in the snapshot.
+	Backup(path string) (sequences map[string]map[int32]int64, err error)
 
 	// compact the families under store.
 	compact()
@@ -275,6 +280,64 @@ func (s *store) ForceRollup() {
 	}
 }
 
+// Backup pins the snapshot of all families, then links(copies if link not supported)
+// the files of snapshot into target path with options/manifest, so that target path can be opened as a store,
+// returns the replica sequences of each family in the snapshot.
+func (s *store) Backup(path string) (sequences map[string]map[int32]int64, err error) {
+	if err = mkDirFunc(path); err != nil {
+		return nil, err
+	}
+	s.rwMutex.RLock()
+	info := newStoreInfo(s.storeInfo.StoreOption)
+	for name, option := range s.storeInfo.Families {
+		info.Families[name] = option
+	}
+	families := make([]Family, 0, len(s.families))
+	for _, family := range s.families {
+		families = append(families, family)
+	}
+	s.rwMutex.RUnlock()
+
+	snapshots := make(map[version.FamilyID]version.Snapshot)
+	defer func() {
+		for _, snapshot := range snapshots {
+			snapshot.Close()
+		}
+	}()
+	sequences = make(map[string]map[int32]int64)
+	for _, family := range families {
+		snapshot := family.GetSnapshot()
+		snapshots[family.ID()] = snapshot
+		familyName := family.Name()
+		familyPath := filepath.Join(path, familyName)
+		if err = mkDirFunc(familyPath); err != nil {
+			return nil, err
+		}
+		current := snapshot.GetCurrent()
+		for _, file := range current.GetAllFiles() {
+			fileName := version.Table(file.GetFileNumber())
+			if err = linkOrCopyFileFunc(filepath.Join(s.path, familyName, fileName), filepath.Join(familyPath, fileName)); err != nil {
+				return nil, err
+			}
+		}
+		familySequences := make(map[int32]int64)
+		for leader, seq := range current.GetSequences() {
+			familySequences[leader] = seq
+		}
+		sequences[familyName] = familySequences
+	}
+	optionsFile := filepath.Join(path, version.Options)
+	if err = encodeTomlFunc(optionsFile, info); err != nil {
+		return nil, fmt.Errorf("write store info to file[%s] error:%s", optionsFile, err)
+	}
+	if err = version.WriteSnapshotManifest(path, s.nextFileNumber(), snapshots); err != nil {
+		return nil, err
+	}
+	kvLogger.Info("backup store successfully",
+		logger.String("store", s.path), logger.String("backup", path))
+	return sequences, nil
+}
+
 // close the store, then release some resource
 func (s *store) close() error {
 	// close each family in kv store.
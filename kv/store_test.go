@@ -333,3 +333,122 @@ func TestStore_Close(t *testing.T) {
 	kv1.versions = vs
 	assert.NoError(t, kv.close())
 }
+
+func TestStore_Backup(t *testing.T) {
+	defer func() {
+		mkDirFunc = fileutil.MkDirIfNotExist
+		encodeTomlFunc = ltoml.EncodeToml
+		linkOrCopyFileFunc = fileutil.LinkOrCopyFile
+	}()
+	dir := t.TempDir()
+	kv, err := newStore("test_kv", filepath.Join(dir, "store"), DefaultStoreOption())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, kv.close())
+	}()
+	f, err := kv.CreateFamily("f", FamilyOption{Merger: mergerStr})
+	assert.NoError(t, err)
+	flusher := f.NewFlusher()
+	flusher.Sequence(1, 100)
+	assert.NoError(t, flusher.Add(1, []byte("test")))
+	assert.NoError(t, flusher.Commit())
+	flusher.Release()
+
+	backupPath := filepath.Join(dir, "backup")
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "create backup path failure",
+			prepare: func() {
+				mkDirFunc = func(path string) error {
+					return fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "create family path failure",
+			prepare: func() {
+				mkDirFunc = func(path string) error {
+					if path == backupPath {
+						return nil
+					}
+					return fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "link file failure",
+			prepare: func() {
+				linkOrCopyFileFunc = func(src, dst string) error {
+					return fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "write options failure",
+			prepare: func() {
+				encodeTomlFunc = func(fileName string, v interface{}) error {
+					return fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "backup successfully",
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(_ *testing.T) {
+			defer func() {
+				mkDirFunc = fileutil.MkDirIfNotExist
+				encodeTomlFunc = ltoml.EncodeToml
+				linkOrCopyFileFunc = fileutil.LinkOrCopyFile
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			sequences, err := kv.Backup(backupPath)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]map[int32]int64{"f": {1: 100}}, sequences)
+		})
+	}
+	// write data after backup, backup cannot see it
+	flusher = f.NewFlusher()
+	assert.NoError(t, flusher.Add(10, []byte("test10")))
+	assert.NoError(t, flusher.Commit())
+	flusher.Release()
+
+	// open backup as store
+	backup, err := newStore("backup_kv", backupPath, DefaultStoreOption())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, backup.close())
+	}()
+	backupFamily := backup.GetFamily("f")
+	assert.NotNil(t, backupFamily)
+	snapshot := backupFamily.GetSnapshot()
+	defer snapshot.Close()
+	assert.Equal(t, map[int32]int64{1: 100}, snapshot.GetCurrent().GetSequences())
+	readers, err := snapshot.FindReaders(1)
+	assert.NoError(t, err)
+	assert.Len(t, readers, 1)
+	value, err := readers[0].Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test"), value)
+	readers, err = snapshot.FindReaders(10)
+	assert.NoError(t, err)
+	assert.Empty(t, readers)
+	// new file number of backup store must be greater than backup files
+	assert.True(t, backup.nextFileNumber() > f.GetSnapshot().GetCurrent().GetAllFiles()[0].GetFileNumber())
+}
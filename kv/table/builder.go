@@ -93,10 +93,11 @@ type StreamWriter interface {
 
 // storeBuilder builds store file
 type storeBuilder struct {
-	fileNumber FileNumber
-	fileName   string
-	writer     bufioutil.BufioWriter
-	offset     *encoding.FixedOffsetEncoder
+	fileNumber  FileNumber
+	fileName    string
+	writer      bufioutil.BufioWriter
+	offset      *encoding.FixedOffsetEncoder
+	compression Compression // block compression codec of value
 
 	// see paper of roaring bitmap: https://arxiv.org/pdf/1603.06549.pdf
 	keys   *roaring.Bitmap
@@ -106,19 +107,21 @@ type storeBuilder struct {
 	first bool
 }
 
-// NewStoreBuilder creates store builder instance for building store file
-func NewStoreBuilder(fileNumber FileNumber, fileName string) (Builder, error) {
+// NewStoreBuilder creates store builder instance for building store file,
+// each value is compressed by the compression codec.
+func NewStoreBuilder(fileNumber FileNumber, fileName string, compression Compression) (Builder, error) {
 	writer, err := newBufioWriterFunc(fileName)
 	if err != nil {
 		return nil, fmt.Errorf("create file write for store builder error:%s", err)
 	}
 	return &storeBuilder{
-		fileNumber: fileNumber,
-		fileName:   fileName,
-		keys:       roaring.New(),
-		writer:     writer,
-		first:      true,
-		offset:     encoding.NewFixedOffsetEncoder(true),
+		fileNumber:  fileNumber,
+		fileName:    fileName,
+		keys:        roaring.New(),
+		writer:      writer,
+		first:       true,
+		offset:      encoding.NewFixedOffsetEncoder(true),
+		compression: compression,
 	}, nil
 }
 
@@ -160,13 +163,17 @@ func (b *storeBuilder) Add(key uint32, value []byte) error {
 		return nil
 	}
 
+	block, err := b.compression.compress(value)
+	if err != nil {
+		return fmt.Errorf("compress data with %s error:%s", b.compression, err)
+	}
 	// get write offset
 	offset := b.writer.Size()
-	if _, err := b.writer.Write(value); err != nil {
+	if _, err := b.writer.Write(block); err != nil {
 		return fmt.Errorf("write data into store file error:%s", err)
 	}
 	metrics.TableWriteStatistics.AddKeys.Incr()
-	metrics.TableWriteStatistics.WriteBytes.Add(float64(len(value)))
+	metrics.TableWriteStatistics.WriteBytes.Add(float64(len(block)))
 	b.afterWrite(key, int(offset))
 	return nil
 }
@@ -222,11 +229,20 @@ func (b *storeBuilder) Close() (err error) {
 		return err
 	}
 
+	fileVersion := byte(version0)
+	if b.compression != NoCompression {
+		// write compression codec before footer, keeps file layout of version0 if no compression
+		if _, err = b.writer.Write([]byte{byte(b.compression)}); err != nil {
+			return err
+		}
+		fileVersion = version1
+	}
+
 	// for file footer for offsets/keys index, length=1+4+4+8
 	var buf [17]byte
 	binary.LittleEndian.PutUint32(buf[:4], uint32(posOfOffset))
 	binary.LittleEndian.PutUint32(buf[4:8], uint32(posOfKeys))
-	buf[8] = version0
+	buf[versionAtFooter] = fileVersion
 	binary.LittleEndian.PutUint64(buf[9:], magicNumberOffsetFile)
 	if _, err = b.writer.Write(buf[:]); err != nil {
 		return err
@@ -253,6 +269,7 @@ type streamWriter struct {
 	offset  int64
 	badKey  bool
 	crc32   hash.Hash32
+	buf     []byte // buffers the value if block compression enabled
 }
 
 func (sw *streamWriter) Prepare(key uint32) {
@@ -261,12 +278,20 @@ func (sw *streamWriter) Prepare(key uint32) {
 	sw.key = key
 	sw.size = 0
 	sw.crc32.Reset()
+	sw.buf = sw.buf[:0]
 }
 
 func (sw *streamWriter) Write(data []byte) (int, error) {
 	if sw.badKey {
 		return 0, nil
 	}
+	if sw.builder.compression != NoCompression {
+		// value need be compressed as a whole block, so buffers it until commit
+		sw.buf = append(sw.buf, data...)
+		_, _ = sw.crc32.Write(data)
+		sw.size += uint32(len(data))
+		return len(data), nil
+	}
 	n, err := sw.builder.writer.Write(data)
 	_, _ = sw.crc32.Write(data)
 	if err == nil {
@@ -288,6 +313,16 @@ func (sw *streamWriter) Commit() error {
 	if sw.badKey {
 		return nil
 	}
+	if sw.builder.compression != NoCompression {
+		block, err := sw.builder.compression.compress(sw.buf)
+		if err != nil {
+			return fmt.Errorf("compress data with %s error:%s", sw.builder.compression, err)
+		}
+		if _, err := sw.builder.writer.Write(block); err != nil {
+			return err
+		}
+		metrics.TableWriteStatistics.WriteBytes.Add(float64(len(block)))
+	}
 	sw.builder.afterWrite(sw.key, int(sw.offset))
 	// preventing committing twice
 	sw.badKey = true
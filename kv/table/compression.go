@@ -0,0 +1,135 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// Compression represents the block compression codec of sst file.
+type Compression uint8
+
+// Defines all block compression codecs.
+const (
+	NoCompression Compression = iota
+	SnappyCompression
+	ZstdCompression
+	LZ4Compression
+)
+
+var (
+	// zstd encoder/decoder are safe for concurrent EncodeAll/DecodeAll.
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// ParseCompression returns the compression codec by name, returns NoCompression if name is empty.
+func ParseCompression(name string) (Compression, error) {
+	switch strings.ToLower(name) {
+	case "", "none":
+		return NoCompression, nil
+	case "snappy":
+		return SnappyCompression, nil
+	case "zstd":
+		return ZstdCompression, nil
+	case "lz4":
+		return LZ4Compression, nil
+	default:
+		return NoCompression, fmt.Errorf("unknown compression codec: %s", name)
+	}
+}
+
+// String returns the name of compression codec.
+func (c Compression) String() string {
+	switch c {
+	case NoCompression:
+		return "none"
+	case SnappyCompression:
+		return "snappy"
+	case ZstdCompression:
+		return "zstd"
+	case LZ4Compression:
+		return "lz4"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(c))
+	}
+}
+
+// compress compresses the block, returns src if no compression.
+func (c Compression) compress(src []byte) ([]byte, error) {
+	switch c {
+	case NoCompression:
+		return src, nil
+	case SnappyCompression:
+		return snappy.Encode(nil, src), nil
+	case ZstdCompression:
+		return zstdEncoder.EncodeAll(src, nil), nil
+	case LZ4Compression:
+		// lz4 block format not includes the length of source, so writes it as the prefix of block
+		dst := make([]byte, binary.MaxVarintLen32+lz4.CompressBlockBound(len(src)))
+		n := binary.PutUvarint(dst, uint64(len(src)))
+		if len(src) == 0 {
+			return dst[:n], nil
+		}
+		var compressor lz4.Compressor
+		size, err := compressor.CompressBlock(src, dst[n:])
+		if err != nil {
+			return nil, err
+		}
+		return dst[:n+size], nil
+	default:
+		return nil, fmt.Errorf("unknown compression codec: %d", uint8(c))
+	}
+}
+
+// decompress decompresses the block, returns src if no compression.
+func (c Compression) decompress(src []byte) ([]byte, error) {
+	switch c {
+	case NoCompression:
+		return src, nil
+	case SnappyCompression:
+		return snappy.Decode(nil, src)
+	case ZstdCompression:
+		return zstdDecoder.DecodeAll(src, nil)
+	case LZ4Compression:
+		size, n := binary.Uvarint(src)
+		if n <= 0 {
+			return nil, fmt.Errorf("bad lz4 block, cannot read length of source")
+		}
+		dst := make([]byte, size)
+		if size == 0 {
+			return dst, nil
+		}
+		read, err := lz4.UncompressBlock(src[n:], dst)
+		if err != nil {
+			return nil, err
+		}
+		if read != int(size) {
+			return nil, fmt.Errorf("bad lz4 block, expect length: %d, actual: %d", size, read)
+		}
+		return dst, nil
+	default:
+		return nil, fmt.Errorf("unknown compression codec: %d", uint8(c))
+	}
+}
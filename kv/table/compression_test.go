@@ -0,0 +1,92 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCompression(t *testing.T) {
+	cases := []struct {
+		name        string
+		compression Compression
+		wantErr     bool
+	}{
+		{name: "", compression: NoCompression},
+		{name: "none", compression: NoCompression},
+		{name: "snappy", compression: SnappyCompression},
+		{name: "ZSTD", compression: ZstdCompression},
+		{name: "lz4", compression: LZ4Compression},
+		{name: "gzip", wantErr: true},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			compression, err := ParseCompression(tt.name)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.compression, compression)
+			if tt.name != "" {
+				c, err := ParseCompression(compression.String())
+				assert.NoError(t, err)
+				assert.Equal(t, compression, c)
+			}
+		})
+	}
+	assert.Equal(t, "unknown(10)", Compression(10).String())
+}
+
+func TestCompression_compress(t *testing.T) {
+	values := [][]byte{nil, []byte("a"), bytes.Repeat([]byte("lindb-compression"), 1000)}
+	for _, compression := range []Compression{NoCompression, SnappyCompression, ZstdCompression, LZ4Compression} {
+		compression := compression
+		t.Run(compression.String(), func(t *testing.T) {
+			for _, value := range values {
+				block, err := compression.compress(value)
+				assert.NoError(t, err)
+				if compression != NoCompression && len(value) > 100 {
+					assert.Less(t, len(block), len(value))
+				}
+				data, err := compression.decompress(block)
+				assert.NoError(t, err)
+				assert.Equal(t, len(value), len(data))
+				assert.True(t, bytes.Equal(value, data))
+			}
+		})
+	}
+	// unknown codec
+	_, err := Compression(10).compress([]byte("a"))
+	assert.Error(t, err)
+	_, err = Compression(10).decompress([]byte("a"))
+	assert.Error(t, err)
+	// bad block
+	_, err = SnappyCompression.decompress([]byte("bad block"))
+	assert.Error(t, err)
+	_, err = ZstdCompression.decompress([]byte("bad block"))
+	assert.Error(t, err)
+	_, err = LZ4Compression.decompress(nil)
+	assert.Error(t, err)
+	_, err = LZ4Compression.decompress([]byte{100, 1, 2, 3})
+	assert.Error(t, err)
+}
@@ -30,14 +30,17 @@ var (
 const (
 	// magic-number in the footer of sst file
 	magicNumberOffsetFile uint64 = 0x69632d656d656c65
-	// current file layout version
+	// file layout version without block compression
 	version0 = 0
+	// file layout version with block compression, compression codec is written before the footer
+	version1 = 1
 
 	sstFileFooterSize = 4 + // posOfOffset(4)
 		4 + // posOfKeys(4)
 		1 + // version(1)
 		8 // magicNumber(8)
 	magicNumberAtFooter = 9
+	versionAtFooter     = 8
 )
 
 var tableLogger = logger.GetLogger("KV", "Table")
@@ -56,6 +56,8 @@ type Reader interface {
 	Get(key uint32) ([]byte, error)
 	// Iterator iterates over a store's key/value pairs in key order.
 	Iterator() Iterator
+	// Compression returns the block compression codec of store file.
+	Compression() Compression
 	// Close closes reader, release related resources.
 	Close() error
 }
@@ -69,6 +71,7 @@ type storeMMapReader struct {
 	entriesBlock []byte                       // mmaped file content without footer
 	keys         *roaring.Bitmap              // bitmap of keys
 	offsets      *encoding.FixedOffsetDecoder // offset of values
+	compression  Compression                  // block compression codec of values
 }
 
 // newMMapStoreReader creates mmap store file reader.
@@ -128,8 +131,25 @@ func (r *storeMMapReader) initialize() error {
 	}
 	posOfOffset := int(binary.LittleEndian.Uint32(r.fullBlock[footerStart : footerStart+4]))
 	posOfKeys := int(binary.LittleEndian.Uint32(r.fullBlock[footerStart+4 : footerStart+8]))
+	endOfKeys := footerStart
+	switch r.fullBlock[footerStart+versionAtFooter] {
+	case version0:
+		r.compression = NoCompression
+	case version1:
+		// compression codec is written before footer
+		endOfKeys--
+		if endOfKeys < 0 {
+			return fmt.Errorf("bad footer data, compression not found in sstfile:%s", r.path)
+		}
+		r.compression = Compression(r.fullBlock[endOfKeys])
+		if r.compression > LZ4Compression {
+			return fmt.Errorf("unknown compression codec:%d in sstfile:%s", r.compression, r.path)
+		}
+	default:
+		return fmt.Errorf("unknown version:%d of sstfile:%s", r.fullBlock[footerStart+versionAtFooter], r.path)
+	}
 	if !intsAreSortedFunc([]int{
-		0, posOfOffset, posOfKeys, footerStart}) {
+		0, posOfOffset, posOfKeys, endOfKeys}) {
 		return fmt.Errorf("bad footer data, posOfOffsets: %d posOfKeys: %d,"+
 			" footerStart: %d", posOfOffset, posOfKeys, footerStart)
 	}
@@ -140,7 +160,7 @@ func (r *storeMMapReader) initialize() error {
 		return fmt.Errorf("unmarshal fixed-offsets decoder with error: %s", err)
 	}
 	// decode keys
-	if err := encoding.BitmapUnmarshal(r.keys, r.fullBlock[posOfKeys:]); err != nil {
+	if err := encoding.BitmapUnmarshal(r.keys, r.fullBlock[posOfKeys:endOfKeys]); err != nil {
 		return fmt.Errorf("unmarshal keys data from file[%s] error:%s", r.path, err)
 	}
 	// validate keys and offsets
@@ -167,6 +187,11 @@ func (r *storeMMapReader) FileName() string {
 	return r.fileName
 }
 
+// Compression returns the block compression codec of store file.
+func (r *storeMMapReader) Compression() Compression {
+	return r.compression
+}
+
 // Get return value for key, if not exist return nil, false.
 func (r *storeMMapReader) Get(key uint32) ([]byte, error) {
 	if !r.keys.Contains(key) {
@@ -179,6 +204,10 @@ func (r *storeMMapReader) Get(key uint32) ([]byte, error) {
 
 func (r *storeMMapReader) getBlock(idx int) ([]byte, error) {
 	block, err := r.offsets.GetBlock(idx, r.entriesBlock)
+	if err == nil {
+		// decompress value if block compression enabled, returns mmaped block directly if no compression
+		block, err = r.compression.decompress(block)
+	}
 	if err == nil {
 		metrics.TableReadStatistics.Gets.Incr()
 		metrics.TableReadStatistics.ReadBytes.Add(float64(len(block)))
@@ -85,7 +85,7 @@ func TestStoreMMapReader_readBytes_Err(t *testing.T) {
 		unmarshalFixedOffsetFunc = unmarshalFixedOffset
 		assert.NoError(t, os.RemoveAll(testKVPath))
 	}()
-	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000010.sst"))
+	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000010.sst"), NoCompression)
 	assert.NoError(t, err)
 
 	_ = builder.Add(1, []byte("test"))
@@ -157,7 +157,7 @@ func TestReader(t *testing.T) {
 		_ = os.RemoveAll(testKVPath)
 	}()
 
-	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000010.sst"))
+	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000010.sst"), NoCompression)
 	assert.NoError(t, err)
 
 	_ = builder.Add(1, []byte("test"))
@@ -200,7 +200,7 @@ func TestStoreIterator(t *testing.T) {
 	defer func() {
 		_ = os.RemoveAll(testKVPath)
 	}()
-	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000010.sst"))
+	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000010.sst"), NoCompression)
 	assert.NoError(t, err)
 
 	_ = builder.Add(1, []byte("test"))
@@ -227,3 +227,80 @@ func TestStoreIterator(t *testing.T) {
 
 	assert.False(t, it.HasNext())
 }
+
+func TestReader_Compression(t *testing.T) {
+	for _, compression := range []Compression{NoCompression, SnappyCompression, ZstdCompression, LZ4Compression} {
+		compression := compression
+		t.Run(compression.String(), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "000010.sst")
+			builder, err := NewStoreBuilder(10, path, compression)
+			assert.NoError(t, err)
+			assert.NoError(t, builder.Add(1, []byte("test")))
+			// stream writer
+			sw := builder.StreamWriter()
+			sw.Prepare(10)
+			_, _ = sw.Write([]byte("test"))
+			_, _ = sw.Write([]byte("10"))
+			assert.Equal(t, uint32(6), sw.Size())
+			assert.NoError(t, sw.Commit())
+			assert.NoError(t, builder.Add(20, nil))
+			assert.NoError(t, builder.Close())
+
+			r, err := newMMapStoreReader(path, "000010.sst")
+			assert.NoError(t, err)
+			assert.Equal(t, compression, r.Compression())
+			value, err := r.Get(1)
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("test"), value)
+			value, err = r.Get(10)
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("test10"), value)
+			value, err = r.Get(20)
+			assert.NoError(t, err)
+			assert.Empty(t, value)
+
+			it := r.Iterator()
+			assert.True(t, it.HasNext())
+			assert.Equal(t, uint32(1), it.Key())
+			assert.Equal(t, []byte("test"), it.Value())
+			assert.True(t, it.HasNext())
+			assert.Equal(t, uint32(10), it.Key())
+			assert.Equal(t, []byte("test10"), it.Value())
+			assert.NoError(t, r.Close())
+		})
+	}
+}
+
+func TestReader_Compression_Footer_Err(t *testing.T) {
+	defer func() {
+		mapFunc = fileutil.Map
+		unmapFunc = fileutil.Unmap
+	}()
+	unmapFunc = func(_ *os.File, _ []byte) error {
+		return nil
+	}
+	path := filepath.Join(t.TempDir(), "000010.sst")
+	builder, err := NewStoreBuilder(10, path, SnappyCompression)
+	assert.NoError(t, err)
+	assert.NoError(t, builder.Add(1, []byte("test")))
+	assert.NoError(t, builder.Close())
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	footerStart := len(data) - sstFileFooterSize
+
+	// unknown compression codec
+	badCompression := append([]byte{}, data...)
+	badCompression[footerStart-1] = 100
+	// unknown file version
+	badVersion := append([]byte{}, data...)
+	badVersion[footerStart+versionAtFooter] = 100
+	for _, bad := range [][]byte{badCompression, badVersion} {
+		bad := bad
+		mapFunc = func(_ *os.File) ([]byte, error) {
+			return bad, nil
+		}
+		r, err := newMMapStoreReader(path, "000010.sst")
+		assert.Error(t, err)
+		assert.Nil(t, r)
+	}
+}
@@ -378,12 +378,15 @@ func (vs *storeVersionSet) createSnapshot() (editLogs []EditLog) {
 // createFamilySnapshot creates snapshot of edit log for family level.
 // NOTICE: IMPORTANT!!!!!, need write edit logs for all data of version.
 func (vs *storeVersionSet) createFamilySnapshot(familyID FamilyID, familyVersion FamilyVersion) EditLog {
-	editLog := NewEditLog(familyID)
 	// save current version all active files
 	snapshot := familyVersion.GetSnapshot()
 	defer snapshot.Close()
-	current := snapshot.GetCurrent()
+	return newFamilySnapshotEditLog(familyID, snapshot.GetCurrent())
+}
 
+// newFamilySnapshotEditLog creates edit log which includes all data of family version.
+func newFamilySnapshotEditLog(familyID FamilyID, current Version) EditLog {
+	editLog := NewEditLog(familyID)
 	// write log for current file list under this family.
 	levels := current.Levels()
 	for numOfLevel, level := range levels {
@@ -444,3 +447,31 @@ func (vs *storeVersionSet) persistEditLogs(writer bufioutil.BufioWriter, editLog
 	}
 	return nil
 }
+
+// WriteSnapshotManifest writes the edit logs of families' snapshot into a new manifest file under store path,
+// then sets current file, so that the store path can be recovered as a store.
+func WriteSnapshotManifest(storePath string, manifestFileNumber table.FileNumber, snapshots map[FamilyID]Snapshot) (err error) {
+	vs := &storeVersionSet{storePath: storePath}
+	manifestFileName := ManifestFileName(manifestFileNumber)
+	writer, err := newBufferWriterFunc(vs.getManifestFilePath(manifestFileName))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := writer.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+	var editLogs []EditLog
+	for familyID, snapshot := range snapshots {
+		editLogs = append(editLogs, newFamilySnapshotEditLog(familyID, snapshot.GetCurrent()))
+	}
+	// next file number must be greater than manifest file number
+	storeEditLog := NewEditLog(StoreFamilyID)
+	storeEditLog.Add(NewNextFileNumber(manifestFileNumber + 1))
+	editLogs = append(editLogs, storeEditLog)
+	if err := vs.persistEditLogs(writer, editLogs); err != nil {
+		return err
+	}
+	return vs.setCurrent(manifestFileName)
+}
@@ -0,0 +1,39 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metrics
+
+import (
+	"github.com/lindb/lindb/internal/linmetric"
+)
+
+// APITokenStatistics represents api token usage statistics.
+type APITokenStatistics struct {
+	Requests       *linmetric.DeltaCounterVec // requests authenticated by api token
+	DeniedRequests *linmetric.DeltaCounterVec // requests denied because token hasn't the privilege
+	InvalidTokens  *linmetric.BoundCounter    // requests with invalid/revoked api token
+}
+
+// NewAPITokenStatistics creates an api token usage statistics.
+func NewAPITokenStatistics() *APITokenStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.api_token")
+	return &APITokenStatistics{
+		Requests:       scope.NewCounterVec("requests", "token", "name", "db"),
+		DeniedRequests: scope.NewCounterVec("denied_requests", "token", "name", "db"),
+		InvalidTokens:  scope.NewCounter("invalid_tokens"),
+	}
+}
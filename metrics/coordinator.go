@@ -32,6 +32,8 @@ type StateManagerStatistics struct {
 type ShardLeaderStatistics struct {
 	LeaderElections     *linmetric.BoundCounter // shard leader elect successfully
 	LeaderElectFailures *linmetric.BoundCounter // shard leader elect failure
+	LeaderTransfers     *linmetric.BoundCounter // shard leader transfer successfully
+	TransferFailures    *linmetric.BoundCounter // shard leader transfer failure
 }
 
 // MasterStatistics represents master statistics.
@@ -58,6 +60,8 @@ func NewShardLeaderStatistics() *ShardLeaderStatistics {
 	return &ShardLeaderStatistics{
 		LeaderElections:     scope.NewCounter("elections"),
 		LeaderElectFailures: scope.NewCounter("elect_failures"),
+		LeaderTransfers:     scope.NewCounter("transfers"),
+		TransferFailures:    scope.NewCounter("transfer_failures"),
 	}
 }
 
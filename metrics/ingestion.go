@@ -54,11 +54,34 @@ type NativeIngestionStatistics struct {
 	DroppedMetrics  *linmetric.BoundCounter // drop metric when append
 }
 
+// PrometheusIngestionStatistics represents prometheus remote write ingestion statistics.
+type PrometheusIngestionStatistics struct {
+	NativeIngestionStatistics
+	CounterBaselines *linmetric.BoundCounter // counter samples which only set the base value(not written)
+}
+
 // CommonIngestionStatistics represents ingestion common statistics.
 type CommonIngestionStatistics struct {
 	Duration *linmetric.DeltaHistogramVec // ingest duration(include count)
 }
 
+// IngestionRateLimitStatistics represents write rate limit statistics of database/namespace.
+type IngestionRateLimitStatistics struct {
+	ThrottledRequests *linmetric.DeltaCounterVec // requests rejected by write rate limits
+	Rows              *linmetric.DeltaCounterVec // rows taken from write rate limits
+	Bytes             *linmetric.DeltaCounterVec // bytes taken from write rate limits
+}
+
+// NewIngestionRateLimitStatistics creates a write rate limit statistics.
+func NewIngestionRateLimitStatistics() *IngestionRateLimitStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.rate_limit")
+	return &IngestionRateLimitStatistics{
+		ThrottledRequests: scope.NewCounterVec("throttled_requests", "db", "ns"),
+		Rows:              scope.NewCounterVec("rows", "db"),
+		Bytes:             scope.NewCounterVec("bytes", "db"),
+	}
+}
+
 // NewNativeIngestionStatistics creates a native ingestion statistics.
 func NewNativeIngestionStatistics() *NativeIngestionStatistics {
 	influxIngestionScope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.proto")
@@ -111,3 +134,17 @@ func NewFlatIngestionStatistics() *FlatIngestionStatistics {
 		GT10MiBCounter:  flatIngestionBlockScope.WithTagValues(">=10MiB"),
 	}
 }
+
+// NewPrometheusIngestionStatistics creates a prometheus remote write ingestion statistics.
+func NewPrometheusIngestionStatistics() *PrometheusIngestionStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.prometheus")
+	return &PrometheusIngestionStatistics{
+		NativeIngestionStatistics: NativeIngestionStatistics{
+			CorruptedData:   scope.NewCounter("data_corrupted"),
+			IngestedMetrics: scope.NewCounter("ingested_metrics"),
+			ReadBytes:       scope.NewCounter("read_bytes"),
+			DroppedMetrics:  scope.NewCounter("dropped_metrics"),
+		},
+		CounterBaselines: scope.NewCounter("counter_baselines"),
+	}
+}
@@ -26,6 +26,8 @@ import (
 func TestIngestionStatistics_New(t *testing.T) {
 	assert.NotNil(t, NewFlatIngestionStatistics())
 	assert.NotNil(t, NewCommonIngestionStatistics())
+	assert.NotNil(t, NewIngestionRateLimitStatistics())
 	assert.NotNil(t, NewInfluxIngestionStatistics())
 	assert.NotNil(t, NewNativeIngestionStatistics())
+	assert.NotNil(t, NewPrometheusIngestionStatistics())
 }
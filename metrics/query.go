@@ -47,6 +47,15 @@ type StorageQueryStatistics struct {
 	OmitRequest         *linmetric.BoundCounter // omit request(task no belong to current node, wrong stream etc.)
 }
 
+// QueryCacheStatistics represents broker query result cache statistics.
+type QueryCacheStatistics struct {
+	Hits          *linmetric.BoundCounter // families served from cache
+	Misses        *linmetric.BoundCounter // families queried from storage
+	Evictions     *linmetric.BoundCounter // cached queries evicted because of max size
+	Invalidations *linmetric.BoundCounter // cached queries invalidated by series deletion
+	Size          *linmetric.BoundGauge   // memory size of cached query result
+}
+
 // NewTransportStatistics creates a transport statistics.
 func NewTransportStatistics(registry *linmetric.Registry) *TransportStatistics {
 	scope := registry.NewScope("lindb.task.transport")
@@ -81,3 +90,15 @@ func NewStorageQueryStatistics() *StorageQueryStatistics {
 		OmitRequest:         scope.NewCounter("omitted_requests"),
 	}
 }
+
+// NewQueryCacheStatistics creates a broker query result cache statistics.
+func NewQueryCacheStatistics() *QueryCacheStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.query.cache")
+	return &QueryCacheStatistics{
+		Hits:          scope.NewCounter("hits"),
+		Misses:        scope.NewCounter("misses"),
+		Evictions:     scope.NewCounter("evictions"),
+		Invalidations: scope.NewCounter("invalidations"),
+		Size:          scope.NewGauge("size"),
+	}
+}
@@ -41,6 +41,12 @@ type BrokerFamilyWriteStatistics struct {
 	CloseStream          *linmetric.BoundCounter // close replica stream success count
 	CloseStreamFailures  *linmetric.BoundCounter // close replica stream failure count
 	LeaderChanged        *linmetric.BoundCounter // shard leader changed
+	AckFailures          *linmetric.BoundCounter // write acknowledgement failure count
+	Spill                *linmetric.BoundCounter // spill message to local disk success count
+	SpillFailures        *linmetric.BoundCounter // spill message to local disk failure count
+	SpillReplay          *linmetric.BoundCounter // replay spilled message success count
+	SpillPending         *linmetric.BoundGauge   // number of pending spilled message
+	SpillSize            *linmetric.BoundGauge   // bytes of pending spilled message
 }
 
 // StorageLocalReplicatorStatistics represents local replicator statistics.
@@ -74,6 +80,9 @@ type StorageRemoteReplicatorStatistics struct {
 	ReceiveMsgFailures             *linmetric.BoundCounter // receive replica resp failure
 	AckSequence                    *linmetric.BoundCounter // ack replica successfully sequence count
 	InvalidAckSequence             *linmetric.BoundCounter // get wrong replica ack sequence from follower
+	RewindReplicaIdx               *linmetric.BoundCounter // rewind replica index when follower log lost but leader keeps it
+	Snapshot                       *linmetric.BoundCounter // transfer snapshot to follower successfully
+	SnapshotFailures               *linmetric.BoundCounter // transfer snapshot to follower failure
 }
 
 // StorageReplicatorRunnerStatistics represents storage replicator runner statistics.
@@ -97,6 +106,17 @@ type StorageWriteAheadLogStatistics struct {
 	ReplicaWALFailures *linmetric.BoundCounter // replica wal failure(storage leader->follower)
 }
 
+// StorageReplicaConsistencyStatistics represents the consistency check(anti-entropy) statistics of replicas.
+type StorageReplicaConsistencyStatistics struct {
+	Checks         *linmetric.BoundCounter // number of family replica checked
+	CheckFailures  *linmetric.BoundCounter // check failure(digest computing/fetching failure)
+	Consistent     *linmetric.BoundCounter // follower's data is same as leader
+	Diverged       *linmetric.BoundCounter // follower's data is different from leader
+	Syncing        *linmetric.BoundCounter // follower's persisted sequence is different from leader, skip comparing
+	Repairs        *linmetric.BoundCounter // repair diverged follower by snapshot
+	RepairFailures *linmetric.BoundCounter // repair diverged follower failure
+}
+
 // NewBrokerDatabaseWriteStatistics creates a database channel write statistics.
 func NewBrokerDatabaseWriteStatistics(database string) *BrokerDatabaseWriteStatistics {
 	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.database.write")
@@ -124,6 +144,12 @@ func NewBrokerFamilyWriteStatistics(database string) *BrokerFamilyWriteStatistic
 		CloseStream:          scope.NewCounterVec("close_stream", "db").WithTagValues(database),
 		CloseStreamFailures:  scope.NewCounterVec("close_stream_failures", "db").WithTagValues(database),
 		LeaderChanged:        scope.NewCounterVec("leader_changed", "db").WithTagValues(database),
+		AckFailures:          scope.NewCounterVec("ack_failures", "db").WithTagValues(database),
+		Spill:                scope.NewCounterVec("spill", "db").WithTagValues(database),
+		SpillFailures:        scope.NewCounterVec("spill_failures", "db").WithTagValues(database),
+		SpillReplay:          scope.NewCounterVec("spill_replay", "db").WithTagValues(database),
+		SpillPending:         scope.NewGaugeVec("spill_pending", "db").WithTagValues(database),
+		SpillSize:            scope.NewGaugeVec("spill_size", "db").WithTagValues(database),
 	}
 }
 
@@ -184,6 +210,12 @@ func NewStorageRemoteReplicatorStatistics(database, shard string) *StorageRemote
 			WithTagValues(database, shard),
 		ResetFollowerAppendIdx: scope.NewCounterVec("reset_follower_append_idx", "db", "shard").
 			WithTagValues(database, shard),
+		RewindReplicaIdx: scope.NewCounterVec("rewind_replica_idx", "db", "shard").
+			WithTagValues(database, shard),
+		Snapshot: scope.NewCounterVec("snapshot", "db", "shard").
+			WithTagValues(database, shard),
+		SnapshotFailures: scope.NewCounterVec("snapshot_failures", "db", "shard").
+			WithTagValues(database, shard),
 		ResetFollowerAppendIdxFailures: scope.NewCounterVec("reset_follower_append_idx_failures", "db", "shard").
 			WithTagValues(database, shard),
 		ResetAppendIdx: scope.NewCounterVec("reset_append_idx", "db", "shard").
@@ -225,3 +257,17 @@ func NewStorageWriteAheadLogStatistics(database, shard string) *StorageWriteAhea
 			WithTagValues(database, shard),
 	}
 }
+
+// NewStorageReplicaConsistencyStatistics creates a replica consistency check statistics.
+func NewStorageReplicaConsistencyStatistics(database string) *StorageReplicaConsistencyStatistics {
+	scope := linmetric.StorageRegistry.NewScope("lindb.storage.replica.consistency")
+	return &StorageReplicaConsistencyStatistics{
+		Checks:         scope.NewCounterVec("checks", "db").WithTagValues(database),
+		CheckFailures:  scope.NewCounterVec("check_failures", "db").WithTagValues(database),
+		Consistent:     scope.NewCounterVec("consistent", "db").WithTagValues(database),
+		Diverged:       scope.NewCounterVec("diverged", "db").WithTagValues(database),
+		Syncing:        scope.NewCounterVec("syncing", "db").WithTagValues(database),
+		Repairs:        scope.NewCounterVec("repairs", "db").WithTagValues(database),
+		RepairFailures: scope.NewCounterVec("repair_failures", "db").WithTagValues(database),
+	}
+}
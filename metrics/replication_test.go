@@ -30,4 +30,5 @@ func TestReplication_New(t *testing.T) {
 	assert.NotNil(t, NewStorageLocalReplicatorStatistics("db", "shard"))
 	assert.NotNil(t, NewStorageRemoteReplicatorStatistics("db", "shard"))
 	assert.NotNil(t, NewStorageWriteAheadLogStatistics("db", "shard"))
+	assert.NotNil(t, NewStorageReplicaConsistencyStatistics("db"))
 }
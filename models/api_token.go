@@ -0,0 +1,108 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// APITokenPrefix represents the prefix of api token string, used to distinguish from login token.
+const APITokenPrefix = "lindb_"
+
+// for testing
+var (
+	randReadFn = rand.Read
+)
+
+// APIToken represents the long-lived token for ingestion/query clients,
+// which is scoped to one database with read-only or write-only privilege.
+type APIToken struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Database   string    `json:"database"`
+	Privilege  Privilege `json:"privilege"`
+	Secret     string    `json:"secret,omitempty"` // hash of token's secret
+	CreateTime int64     `json:"createTime"`
+}
+
+// NewAPIToken creates an api token, returns the token and token string(only returned when created).
+func NewAPIToken(name, database string, privilege Privilege) (*APIToken, string, error) {
+	if privilege != ReadPrivilege && privilege != WritePrivilege {
+		return nil, "", fmt.Errorf("api token only support read/write privilege, but: %s", privilege)
+	}
+	id, err := randomHex(8)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomHex(24)
+	if err != nil {
+		return nil, "", err
+	}
+	token := &APIToken{
+		ID:         id,
+		Name:       name,
+		Database:   database,
+		Privilege:  privilege,
+		Secret:     hashSecret(secret),
+		CreateTime: time.Now().UnixMilli(),
+	}
+	return token, fmt.Sprintf("%s%s.%s", APITokenPrefix, id, secret), nil
+}
+
+// ParseAPIToken parses the token string, returns the id and secret of token.
+func ParseAPIToken(token string) (id, secret string, ok bool) {
+	if !strings.HasPrefix(token, APITokenPrefix) {
+		return "", "", false
+	}
+	id, secret, ok = strings.Cut(strings.TrimPrefix(token, APITokenPrefix), ".")
+	if !ok || id == "" || secret == "" {
+		return "", "", false
+	}
+	return id, secret, true
+}
+
+// CheckSecret checks if secret matches the token's secret.
+func (t *APIToken) CheckSecret(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(t.Secret), []byte(hashSecret(secret))) == 1
+}
+
+// Allowed returns if the token has the required privilege on database.
+func (t *APIToken) Allowed(database string, required Privilege) bool {
+	return t.Database == database && t.Privilege == required
+}
+
+// randomHex returns the hex string of n random bytes.
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := randReadFn(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// hashSecret returns the hash of token's secret.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,60 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIToken(t *testing.T) {
+	defer func() {
+		randReadFn = rand.Read
+	}()
+	_, _, err := NewAPIToken("agent", "db", AdminPrivilege)
+	assert.Error(t, err)
+
+	token, tokenStr, err := NewAPIToken("agent", "db", WritePrivilege)
+	assert.NoError(t, err)
+	assert.Equal(t, "agent", token.Name)
+	assert.NotEmpty(t, token.ID)
+	id, secret, ok := ParseAPIToken(tokenStr)
+	assert.True(t, ok)
+	assert.Equal(t, token.ID, id)
+	assert.NotEqual(t, secret, token.Secret)
+	assert.True(t, token.CheckSecret(secret))
+	assert.False(t, token.CheckSecret("secret"))
+
+	assert.True(t, token.Allowed("db", WritePrivilege))
+	assert.False(t, token.Allowed("db", ReadPrivilege))
+	assert.False(t, token.Allowed("db2", WritePrivilege))
+
+	for _, str := range []string{"token", APITokenPrefix + "id", APITokenPrefix + ".secret", APITokenPrefix + "id."} {
+		_, _, ok = ParseAPIToken(str)
+		assert.False(t, ok, str)
+	}
+
+	randReadFn = func(_ []byte) (int, error) {
+		return 0, fmt.Errorf("err")
+	}
+	_, _, err = NewAPIToken("agent", "db", ReadPrivilege)
+	assert.Error(t, err)
+}
@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// BackupManifest represents the manifest of storage node's online backup,
+// records the replica sequence of each data family, which is used to rebuild replica state when restoring.
+type BackupManifest struct {
+	Timestamp int64             `json:"timestamp"`
+	Databases []*DatabaseBackup `json:"databases"`
+}
+
+// DatabaseBackup represents the backup of database.
+type DatabaseBackup struct {
+	Name   string         `json:"name"`
+	Shards []*ShardBackup `json:"shards"`
+}
+
+// ShardBackup represents the backup of shard.
+type ShardBackup struct {
+	ShardID ShardID `json:"shardId"`
+	// families of writable interval, only these families have replica sequence.
+	Families []*FamilyBackup `json:"families"`
+}
+
+// FamilyBackup represents the backup of data family.
+type FamilyBackup struct {
+	FamilyTime int64           `json:"familyTime"`
+	Sequences  map[int32]int64 `json:"sequences"` // leader => replica sequence
+}
+
+// GetDatabase returns the backup of database by name.
+func (m *BackupManifest) GetDatabase(name string) (*DatabaseBackup, bool) {
+	for _, db := range m.Databases {
+		if db.Name == name {
+			return db, true
+		}
+	}
+	return nil, false
+}
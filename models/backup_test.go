@@ -0,0 +1,34 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupManifest_GetDatabase(t *testing.T) {
+	manifest := &BackupManifest{Databases: []*DatabaseBackup{{Name: "db"}}}
+	db, ok := manifest.GetDatabase("db")
+	assert.True(t, ok)
+	assert.Equal(t, "db", db.Name)
+	db, ok = manifest.GetDatabase("not_exist")
+	assert.False(t, ok)
+	assert.Nil(t, db)
+}
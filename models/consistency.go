@@ -0,0 +1,99 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import "sort"
+
+// ReplicaConsistency represents the result of consistency check between leader and follower of family.
+type ReplicaConsistency string
+
+const (
+	// ReplicaConsistent represents the data of follower is same as leader.
+	ReplicaConsistent ReplicaConsistency = "Consistent"
+	// ReplicaDiverged represents the data of follower is different from leader.
+	ReplicaDiverged ReplicaConsistency = "Diverged"
+	// ReplicaSyncing represents the persisted replica sequence of follower is different from leader,
+	// the data is in flight, cannot be compared now.
+	ReplicaSyncing ReplicaConsistency = "Syncing"
+	// ReplicaCheckFailure represents the consistency check failure(e.g. follower is offline).
+	ReplicaCheckFailure ReplicaConsistency = "Failure"
+)
+
+// MetricDigest represents the digest of metric's data in data family,
+// digest is computed based on metric name/tags/field name, so it can be compared between replicas.
+type MetricDigest struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Series    int    `json:"series"`
+	Points    int    `json:"points"`
+	Digest    uint64 `json:"digest"`
+}
+
+// FamilyDigest represents the digest of persisted data in data family.
+type FamilyDigest struct {
+	FamilyTime int64           `json:"familyTime"`
+	Sequences  map[int32]int64 `json:"sequences"` // leader => replica sequence
+	Metrics    []MetricDigest  `json:"metrics"`
+}
+
+// SameSequences returns if the persisted replica sequences of two digests are same,
+// only the digests of same sequences can be compared.
+func (d *FamilyDigest) SameSequences(other *FamilyDigest) bool {
+	if len(d.Sequences) != len(other.Sequences) {
+		return false
+	}
+	for leader, seq := range d.Sequences {
+		if otherSeq, ok := other.Sequences[leader]; !ok || otherSeq != seq {
+			return false
+		}
+	}
+	return true
+}
+
+// DivergedMetrics returns the metrics(namespace:name) whose digest is different, returns nil if consistent.
+func (d *FamilyDigest) DivergedMetrics(other *FamilyDigest) (diverged []string) {
+	metrics := make(map[string]MetricDigest, len(d.Metrics))
+	for _, m := range d.Metrics {
+		metrics[m.Namespace+":"+m.Name] = m
+	}
+	for _, m := range other.Metrics {
+		key := m.Namespace + ":" + m.Name
+		if expect, ok := metrics[key]; !ok || expect != m {
+			diverged = append(diverged, key)
+		}
+		delete(metrics, key)
+	}
+	for key := range metrics {
+		diverged = append(diverged, key)
+	}
+	sort.Strings(diverged)
+	return diverged
+}
+
+// ReplicaConsistencyState represents the consistency check state of family's replica.
+type ReplicaConsistencyState struct {
+	ShardID         ShardID            `json:"shardId"`
+	FamilyTime      string             `json:"familyTime"`
+	Leader          NodeID             `json:"leader"`
+	Follower        NodeID             `json:"follower"`
+	Consistency     ReplicaConsistency `json:"consistency"`
+	DivergedMetrics []string           `json:"divergedMetrics,omitempty"`
+	ErrMsg          string             `json:"errMsg,omitempty"`
+	Repairing       bool               `json:"repairing"`
+	CheckTime       int64              `json:"checkTime"`
+}
@@ -0,0 +1,47 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFamilyDigest_SameSequences(t *testing.T) {
+	d := &FamilyDigest{Sequences: map[int32]int64{1: 10, 2: 20}}
+	assert.True(t, d.SameSequences(&FamilyDigest{Sequences: map[int32]int64{1: 10, 2: 20}}))
+	assert.False(t, d.SameSequences(&FamilyDigest{Sequences: map[int32]int64{1: 10}}))
+	assert.False(t, d.SameSequences(&FamilyDigest{Sequences: map[int32]int64{1: 10, 2: 21}}))
+	assert.False(t, d.SameSequences(&FamilyDigest{Sequences: map[int32]int64{1: 10, 3: 20}}))
+}
+
+func TestFamilyDigest_DivergedMetrics(t *testing.T) {
+	d := &FamilyDigest{Metrics: []MetricDigest{
+		{Namespace: "ns", Name: "cpu", Series: 1, Points: 10, Digest: 100},
+		{Namespace: "ns", Name: "mem", Series: 1, Points: 10, Digest: 100},
+		{Namespace: "ns", Name: "disk", Series: 1, Points: 10, Digest: 100},
+	}}
+	assert.Nil(t, d.DivergedMetrics(d))
+	other := &FamilyDigest{Metrics: []MetricDigest{
+		{Namespace: "ns", Name: "cpu", Series: 1, Points: 10, Digest: 100},
+		{Namespace: "ns", Name: "mem", Series: 1, Points: 10, Digest: 101},
+		{Namespace: "ns", Name: "net", Series: 1, Points: 10, Digest: 100},
+	}}
+	assert.Equal(t, []string{"ns:disk", "ns:mem", "ns:net"}, d.DivergedMetrics(other))
+}
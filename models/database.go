@@ -19,6 +19,7 @@ package models
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/jedib0t/go-pretty/v6/table"
@@ -96,12 +97,15 @@ type LogicDatabase struct {
 
 // Database defines database config.
 type Database struct {
-	Name          string                 `json:"name" validate:"required"`      // database's name
-	Storage       string                 `json:"storage" validate:"required"`   // storage cluster's name
-	NumOfShard    int                    `json:"numOfShard" validate:"gt=0"`    // num. of shard
-	ReplicaFactor int                    `json:"replicaFactor" validate:"gt=0"` // replica refactor
-	Option        *option.DatabaseOption `json:"option"`                        // time series database option
-	Desc          string                 `json:"desc,omitempty"`
+	Name             string                 `json:"name" validate:"required"`      // database's name
+	Storage          string                 `json:"storage" validate:"required"`   // storage cluster's name
+	NumOfShard       int                    `json:"numOfShard" validate:"gt=0"`    // num. of shard
+	ReplicaFactor    int                    `json:"replicaFactor" validate:"gt=0"` // replica refactor
+	Option           *option.DatabaseOption `json:"option"`                        // time series database option
+	Routing          *Routing               `json:"routing,omitempty"`             // shard routing option, default modulo
+	WriteConsistency WriteConsistency       `json:"writeConsistency,omitempty"`    // write consistency, default any
+	LeaderPlacement  *LeaderPlacement       `json:"leaderPlacement,omitempty"`     // preferred placement of shard's leader
+	Desc             string                 `json:"desc,omitempty"`
 }
 
 // String returns the database's description.
@@ -109,6 +113,15 @@ func (db *Database) String() string {
 	result := "create database " + db.Name + " with "
 	result += "shard " + fmt.Sprintf("%d", db.NumOfShard) + ", replica " + fmt.Sprintf("%d", db.ReplicaFactor)
 	result += ", intervals " + db.Option.Intervals.String()
+	if db.Routing != nil {
+		result += ", routing " + db.Routing.String()
+	}
+	if db.WriteConsistency != "" {
+		result += ", write consistency " + string(db.WriteConsistency)
+	}
+	if db.LeaderPlacement != nil {
+		result += ", leader placement " + db.LeaderPlacement.String()
+	}
 	return result
 }
 
@@ -132,10 +145,35 @@ func (r Replica) Contain(nodeID NodeID) bool {
 	return false
 }
 
+// ShardRouting represents the number of shards which routes the written rows since cut-over time.
+type ShardRouting struct {
+	NumOfShard  int   `json:"numOfShard"`
+	CutOverTime int64 `json:"cutOverTime"` // family start time(ms) since which the routing takes effect
+}
+
+// ShardRoutings represents the routing history of database, ordered by cut-over time.
+type ShardRoutings []ShardRouting
+
+// NumOfShardAt returns the number of shards which routes the row of given timestamp,
+// returns 0 if routing history is empty.
+func (rs ShardRoutings) NumOfShardAt(timestamp int64) int {
+	numOfShard := 0
+	for idx := range rs {
+		if idx > 0 && rs[idx].CutOverTime > timestamp {
+			break
+		}
+		numOfShard = rs[idx].NumOfShard
+	}
+	return numOfShard
+}
+
 // ShardAssignment defines shard assignment for database.
 type ShardAssignment struct {
 	Name   string               `json:"name"` // database's name
 	Shards map[ShardID]*Replica `json:"shards"`
+	// Routings is set after the number of shards changed, rows are routed by new number of shards since cut-over time,
+	// the shards which are not in current routing are retired, they can be queried until their data expired.
+	Routings ShardRoutings `json:"routings,omitempty"`
 
 	replicaFactor int // for storage recover
 }
@@ -164,6 +202,58 @@ func (s *ShardAssignment) AddReplica(shardID ShardID, replicaID NodeID) {
 	}
 }
 
+// NumOfShard returns the number of shards which routes the rows written now.
+func (s *ShardAssignment) NumOfShard() int {
+	if len(s.Routings) == 0 {
+		return len(s.Shards)
+	}
+	return s.Routings[len(s.Routings)-1].NumOfShard
+}
+
+// ChangeNumOfShard changes the number of shards which routes the rows since cut-over time.
+func (s *ShardAssignment) ChangeNumOfShard(numOfShard int, cutOverTime int64) {
+	if len(s.Routings) == 0 {
+		s.Routings = append(s.Routings, ShardRouting{NumOfShard: len(s.Shards)})
+	}
+	last := len(s.Routings) - 1
+	if last > 0 && s.Routings[last].CutOverTime >= cutOverTime {
+		// routing not take effect, replace it
+		s.Routings = s.Routings[:last]
+	}
+	s.Routings = append(s.Routings, ShardRouting{NumOfShard: numOfShard, CutOverTime: cutOverTime})
+}
+
+// RetireShards removes the retired shards whose data has expired before expire time, returns the removed shard ids.
+func (s *ShardAssignment) RetireShards(expireTime int64) (retired []ShardID) {
+	if len(s.Routings) == 0 {
+		return nil
+	}
+	// remove the routings which take effect before expire time, except the last one
+	idx := 0
+	for idx < len(s.Routings)-1 && s.Routings[idx+1].CutOverTime <= expireTime {
+		idx++
+	}
+	s.Routings = s.Routings[idx:]
+	maxNumOfShard := 0
+	for _, routing := range s.Routings {
+		if routing.NumOfShard > maxNumOfShard {
+			maxNumOfShard = routing.NumOfShard
+		}
+	}
+	for shardID := range s.Shards {
+		if shardID.Int() >= maxNumOfShard {
+			delete(s.Shards, shardID)
+			retired = append(retired, shardID)
+		}
+	}
+	if len(s.Routings) == 1 && len(s.Shards) == s.Routings[0].NumOfShard {
+		// no retired shard, clear routing history
+		s.Routings = nil
+	}
+	sort.Slice(retired, func(i, j int) bool { return retired[i] < retired[j] })
+	return retired
+}
+
 // GetReplicaFactor returns the factor of replica.
 func (s *ShardAssignment) GetReplicaFactor() int {
 	return s.replicaFactor
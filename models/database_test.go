@@ -51,6 +51,17 @@ func TestDatabase_String(t *testing.T) {
 			}},
 	}
 	assert.Equal(t, "create database test with shard 10, replica 1, intervals [10s->1M,10m->1M]", database.String())
+	database.Routing = &Routing{Method: TagKeysRouting, TagKeys: []string{"tenant", "app"}}
+	assert.Equal(t, "create database test with shard 10, replica 1, intervals [10s->1M,10m->1M], "+
+		"routing tag-keys(tenant,app)", database.String())
+	database.Routing = nil
+	database.WriteConsistency = WriteConsistencyQuorum
+	assert.Equal(t, "create database test with shard 10, replica 1, intervals [10s->1M,10m->1M], "+
+		"write consistency quorum", database.String())
+	database.WriteConsistency = ""
+	database.LeaderPlacement = &LeaderPlacement{PreferredZones: []string{"az1", "az2"}}
+	assert.Equal(t, "create database test with shard 10, replica 1, intervals [10s->1M,10m->1M], "+
+		"leader placement zones(az1,az2)", database.String())
 }
 
 func TestParseShardID(t *testing.T) {
@@ -73,3 +84,55 @@ func TestReplica_Contain(t *testing.T) {
 	assert.True(t, replica.Contain(2))
 	assert.False(t, replica.Contain(4))
 }
+
+func TestShardRoutings_NumOfShardAt(t *testing.T) {
+	assert.Zero(t, ShardRoutings{}.NumOfShardAt(10))
+	routings := ShardRoutings{{NumOfShard: 8}, {NumOfShard: 4, CutOverTime: 100}, {NumOfShard: 2, CutOverTime: 200}}
+	assert.Equal(t, 8, routings.NumOfShardAt(10))
+	assert.Equal(t, 4, routings.NumOfShardAt(100))
+	assert.Equal(t, 4, routings.NumOfShardAt(199))
+	assert.Equal(t, 2, routings.NumOfShardAt(200))
+	// routing history is retired
+	routings = ShardRoutings{{NumOfShard: 4, CutOverTime: 100}}
+	assert.Equal(t, 4, routings.NumOfShardAt(10))
+}
+
+func TestShardAssignment_ChangeNumOfShard(t *testing.T) {
+	shardAssign := NewShardAssignment("test")
+	for i := 0; i < 8; i++ {
+		shardAssign.AddReplica(ShardID(i), 1)
+	}
+	assert.Equal(t, 8, shardAssign.NumOfShard())
+	shardAssign.ChangeNumOfShard(4, 100)
+	assert.Equal(t, 4, shardAssign.NumOfShard())
+	assert.Equal(t, ShardRoutings{{NumOfShard: 8}, {NumOfShard: 4, CutOverTime: 100}}, shardAssign.Routings)
+	// replace the routing which not take effect
+	shardAssign.ChangeNumOfShard(2, 100)
+	assert.Equal(t, ShardRoutings{{NumOfShard: 8}, {NumOfShard: 2, CutOverTime: 100}}, shardAssign.Routings)
+	shardAssign.ChangeNumOfShard(6, 200)
+	assert.Equal(t, 6, shardAssign.NumOfShard())
+	assert.Len(t, shardAssign.Routings, 3)
+}
+
+func TestShardAssignment_RetireShards(t *testing.T) {
+	shardAssign := NewShardAssignment("test")
+	for i := 0; i < 8; i++ {
+		shardAssign.AddReplica(ShardID(i), 1)
+	}
+	assert.Empty(t, shardAssign.RetireShards(1000))
+
+	shardAssign.ChangeNumOfShard(4, 100)
+	shardAssign.ChangeNumOfShard(2, 200)
+	// data of all routings not expired
+	assert.Empty(t, shardAssign.RetireShards(50))
+	assert.Len(t, shardAssign.Routings, 3)
+	// data of first routing expired
+	assert.Equal(t, []ShardID{4, 5, 6, 7}, shardAssign.RetireShards(150))
+	assert.Len(t, shardAssign.Shards, 4)
+	assert.Equal(t, ShardRoutings{{NumOfShard: 4, CutOverTime: 100}, {NumOfShard: 2, CutOverTime: 200}}, shardAssign.Routings)
+	// all retired shards expired
+	assert.Equal(t, []ShardID{2, 3}, shardAssign.RetireShards(250))
+	assert.Len(t, shardAssign.Shards, 2)
+	assert.Nil(t, shardAssign.Routings)
+	assert.Equal(t, 2, shardAssign.NumOfShard())
+}
@@ -0,0 +1,102 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DecommissionState represents the state of storage node decommission.
+type DecommissionState int
+
+const (
+	DecommissionUnknown DecommissionState = iota
+	DecommissionPending
+	DecommissionTransferringLeader
+	DecommissionMovingReplicas
+	DecommissionDone
+	DecommissionFailed
+)
+
+// String returns the string value of DecommissionState.
+func (s DecommissionState) String() string {
+	switch s {
+	case DecommissionPending:
+		return "Pending"
+	case DecommissionTransferringLeader:
+		return "TransferringLeader"
+	case DecommissionMovingReplicas:
+		return "MovingReplicas"
+	case DecommissionDone:
+		return "Done"
+	case DecommissionFailed:
+		return "Failed"
+	default:
+		return "Unknown"
+	}
+}
+
+// IsTerminal returns if decommission is completed(done or failed).
+func (s DecommissionState) IsTerminal() bool {
+	return s == DecommissionDone || s == DecommissionFailed
+}
+
+// MarshalJSON encodes decommission state.
+func (s DecommissionState) MarshalJSON() ([]byte, error) {
+	val := s.String()
+	return json.Marshal(&val)
+}
+
+// UnmarshalJSON decodes decommission state.
+func (s *DecommissionState) UnmarshalJSON(value []byte) error {
+	var val string
+	if err := json.Unmarshal(value, &val); err != nil {
+		return err
+	}
+	for _, state := range []DecommissionState{
+		DecommissionPending, DecommissionTransferringLeader,
+		DecommissionMovingReplicas, DecommissionDone, DecommissionFailed,
+	} {
+		if state.String() == val {
+			*s = state
+			return nil
+		}
+	}
+	*s = DecommissionUnknown
+	return nil
+}
+
+// NodeDecommission represents the decommission which drains all shard leaders/replicas from storage node,
+// the node can be shutdown safely after decommission done.
+type NodeDecommission struct {
+	Storage  string            `json:"storage"`
+	NodeID   NodeID            `json:"nodeId"`
+	State    DecommissionState `json:"state"`
+	Leaders  int               `json:"leaders"`  // shard leaders remaining on node
+	Replicas int               `json:"replicas"` // shard replicas remaining on node
+	ErrMsg   string            `json:"errMsg,omitempty"`
+
+	CreateTime int64 `json:"createTime"`
+	UpdateTime int64 `json:"updateTime"`
+}
+
+// String returns the string value of decommission.
+func (d *NodeDecommission) String() string {
+	return fmt.Sprintf("storage: %s, node: %d", d.Storage, d.NodeID)
+}
@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+func TestDecommissionState_String(t *testing.T) {
+	assert.Equal(t, "Pending", DecommissionPending.String())
+	assert.Equal(t, "TransferringLeader", DecommissionTransferringLeader.String())
+	assert.Equal(t, "MovingReplicas", DecommissionMovingReplicas.String())
+	assert.Equal(t, "Done", DecommissionDone.String())
+	assert.Equal(t, "Failed", DecommissionFailed.String())
+	assert.Equal(t, "Unknown", DecommissionUnknown.String())
+
+	assert.True(t, DecommissionDone.IsTerminal())
+	assert.True(t, DecommissionFailed.IsTerminal())
+	assert.False(t, DecommissionMovingReplicas.IsTerminal())
+}
+
+func TestDecommissionState_JSON(t *testing.T) {
+	d := &NodeDecommission{Storage: "test", NodeID: 1, State: DecommissionMovingReplicas, Replicas: 2}
+	data := encoding.JSONMarshal(d)
+	assert.Contains(t, string(data), `"state":"MovingReplicas"`)
+	d2 := &NodeDecommission{}
+	assert.NoError(t, encoding.JSONUnmarshal(data, d2))
+	assert.Equal(t, d, d2)
+	assert.Equal(t, "storage: test, node: 1", d2.String())
+
+	var s DecommissionState
+	assert.NoError(t, s.UnmarshalJSON([]byte(`"abc"`)))
+	assert.Equal(t, DecommissionUnknown, s)
+	assert.Error(t, s.UnmarshalJSON([]byte(`abc`)))
+}
@@ -0,0 +1,80 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// seriesDeletionExpireBuffer is the buffer after data retention before series deletion expired,
+// same as the buffer of dropping expired data segment.
+const seriesDeletionExpireBuffer = 2 * timeutil.OneHour
+
+// SeriesDeletion represents the delete series/drop metric request of database,
+// storage node tombstones the series matched by condition based on it.
+// NOTICE: data written after deletion created is never deleted.
+type SeriesDeletion struct {
+	ID         int64              `json:"id"` // unique id(created nano timestamp), storage applies deletion once by id
+	Type       stmt.DeleteType    `json:"type"`
+	Namespace  string             `json:"namespace"`
+	MetricName string             `json:"metricName"`
+	Condition  []byte             `json:"condition,omitempty"` // marshaled tag filter expr
+	TimeRange  timeutil.TimeRange `json:"timeRange"`
+	CreatedAt  int64              `json:"createdAt"`
+}
+
+// NewSeriesDeletion creates a series deletion based on delete statement,
+// if end time not set or after created time, uses created time as end time.
+func NewSeriesDeletion(deleteStmt *stmt.Delete) *SeriesDeletion {
+	deletion := &SeriesDeletion{
+		ID:         timeutil.NowNano(),
+		Type:       deleteStmt.Type,
+		Namespace:  deleteStmt.Namespace,
+		MetricName: deleteStmt.MetricName,
+		TimeRange:  deleteStmt.TimeRange,
+		CreatedAt:  timeutil.Now(),
+	}
+	if deletion.TimeRange.End <= 0 || deletion.TimeRange.End > deletion.CreatedAt {
+		deletion.TimeRange.End = deletion.CreatedAt
+	}
+	if deleteStmt.Condition != nil {
+		deletion.Condition = stmt.Marshal(deleteStmt.Condition)
+	}
+	return deletion
+}
+
+// GetSeriesDeletionExpireTime returns the expire time of series deletion based on max data retention of database,
+// the data before expire time has been dropped by data segment ttl.
+func GetSeriesDeletionExpireTime(maxRetention, now int64) int64 {
+	return now - maxRetention - seriesDeletionExpireBuffer
+}
+
+// IsExpired returns if all data deleted by deletion is before expire time(dropped by data retention),
+// the deletion and its tombstones are useless after expired.
+func (d *SeriesDeletion) IsExpired(expireTime int64) bool {
+	return d.TimeRange.End < expireTime
+}
+
+// GetCondition returns the tag filter expr of deletion, returns nil if drop metric.
+func (d *SeriesDeletion) GetCondition() (stmt.Expr, error) {
+	if len(d.Condition) == 0 {
+		return nil, nil
+	}
+	return stmt.Unmarshal(d.Condition)
+}
@@ -0,0 +1,73 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestSeriesDeletion(t *testing.T) {
+	condition := &stmt.BinaryExpr{
+		Left:     &stmt.EqualsExpr{Key: "host", Value: "1.1.1.1"},
+		Operator: stmt.OR,
+		Right:    &stmt.InExpr{Key: "region", Values: []string{"sh", "bj"}},
+	}
+	deletion := NewSeriesDeletion(&stmt.Delete{
+		Type:       stmt.DeleteSeries,
+		Namespace:  "ns",
+		MetricName: "cpu",
+		Condition:  condition,
+		TimeRange:  timeutil.TimeRange{Start: 10, End: 100},
+	})
+	assert.True(t, deletion.ID > 0)
+	assert.True(t, deletion.CreatedAt > 0)
+	assert.Equal(t, timeutil.TimeRange{Start: 10, End: 100}, deletion.TimeRange)
+	var deletions []*SeriesDeletion
+	err := encoding.JSONUnmarshal(encoding.JSONMarshal([]*SeriesDeletion{deletion}), &deletions)
+	assert.NoError(t, err)
+	assert.Equal(t, []*SeriesDeletion{deletion}, deletions)
+	expr, err := deletions[0].GetCondition()
+	assert.NoError(t, err)
+	assert.Equal(t, condition, expr)
+
+	deletion = NewSeriesDeletion(&stmt.Delete{Type: stmt.DropMetric, MetricName: "cpu"})
+	// end time not set or after created time, uses created time
+	assert.Equal(t, deletion.CreatedAt, deletion.TimeRange.End)
+	deletion = NewSeriesDeletion(&stmt.Delete{Type: stmt.DropMetric, MetricName: "cpu", TimeRange: timeutil.TimeRange{End: math.MaxInt64}})
+	assert.Equal(t, deletion.CreatedAt, deletion.TimeRange.End)
+	expr, err = deletion.GetCondition()
+	assert.NoError(t, err)
+	assert.Nil(t, expr)
+}
+
+func TestSeriesDeletion_IsExpired(t *testing.T) {
+	now := timeutil.Now()
+	expireTime := GetSeriesDeletionExpireTime(timeutil.OneDay, now)
+	assert.Equal(t, now-timeutil.OneDay-2*timeutil.OneHour, expireTime)
+	deletion := &SeriesDeletion{TimeRange: timeutil.TimeRange{Start: 10, End: expireTime}}
+	assert.False(t, deletion.IsExpired(expireTime))
+	deletion.TimeRange.End = expireTime - 1
+	assert.True(t, deletion.IsExpired(expireTime))
+}
@@ -0,0 +1,104 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// LeaderTransferState represents the state of shard leader transfer.
+type LeaderTransferState int
+
+const (
+	LeaderTransferUnknown LeaderTransferState = iota
+	LeaderTransferPending
+	LeaderTransferCatchingUp
+	LeaderTransferDone
+	LeaderTransferFailed
+)
+
+// String returns the string value of LeaderTransferState.
+func (s LeaderTransferState) String() string {
+	switch s {
+	case LeaderTransferPending:
+		return "Pending"
+	case LeaderTransferCatchingUp:
+		return "CatchingUp"
+	case LeaderTransferDone:
+		return "Done"
+	case LeaderTransferFailed:
+		return "Failed"
+	default:
+		return "Unknown"
+	}
+}
+
+// IsTerminal returns if leader transfer is completed(done or failed).
+func (s LeaderTransferState) IsTerminal() bool {
+	return s == LeaderTransferDone || s == LeaderTransferFailed
+}
+
+// MarshalJSON encodes leader transfer state.
+func (s LeaderTransferState) MarshalJSON() ([]byte, error) {
+	val := s.String()
+	return json.Marshal(&val)
+}
+
+// UnmarshalJSON decodes leader transfer state.
+func (s *LeaderTransferState) UnmarshalJSON(value []byte) error {
+	var val string
+	if err := json.Unmarshal(value, &val); err != nil {
+		return err
+	}
+	for _, state := range []LeaderTransferState{
+		LeaderTransferPending, LeaderTransferCatchingUp, LeaderTransferDone, LeaderTransferFailed,
+	} {
+		if state.String() == val {
+			*s = state
+			return nil
+		}
+	}
+	*s = LeaderTransferUnknown
+	return nil
+}
+
+// LeaderTransfer represents the transfer which moves the leadership of shard to target replica,
+// leadership is moved after target replica catches up the write ahead log of current leader.
+type LeaderTransfer struct {
+	Database string              `json:"database"`
+	ShardID  ShardID             `json:"shardId"`
+	Source   NodeID              `json:"source"` // leader before transferred
+	Target   NodeID              `json:"target"` // NoLeader means the preferred replica
+	State    LeaderTransferState `json:"state"`
+	Lag      int64               `json:"lag"` // write ahead log of current leader not acknowledged by target
+	ErrMsg   string              `json:"errMsg,omitempty"`
+
+	CreateTime int64 `json:"createTime"`
+	UpdateTime int64 `json:"updateTime"`
+}
+
+// Key returns the unique key of leader transfer, only one transfer can run for a shard at the same time.
+func (t *LeaderTransfer) Key() string {
+	return ReplicaMigrationKey(t.Database, t.ShardID)
+}
+
+// String returns the string value of leader transfer.
+func (t *LeaderTransfer) String() string {
+	return fmt.Sprintf("database: %s, shard: %d, %d => %d", t.Database, t.ShardID, t.Source, t.Target)
+}
@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+func TestLeaderTransferState_String(t *testing.T) {
+	assert.Equal(t, "Pending", LeaderTransferPending.String())
+	assert.Equal(t, "CatchingUp", LeaderTransferCatchingUp.String())
+	assert.Equal(t, "Done", LeaderTransferDone.String())
+	assert.Equal(t, "Failed", LeaderTransferFailed.String())
+	assert.Equal(t, "Unknown", LeaderTransferUnknown.String())
+
+	assert.True(t, LeaderTransferDone.IsTerminal())
+	assert.True(t, LeaderTransferFailed.IsTerminal())
+	assert.False(t, LeaderTransferCatchingUp.IsTerminal())
+}
+
+func TestLeaderTransferState_JSON(t *testing.T) {
+	lt := &LeaderTransfer{Database: "test", ShardID: 1, Source: 1, Target: 2, State: LeaderTransferCatchingUp, Lag: 10}
+	data := encoding.JSONMarshal(lt)
+	assert.Contains(t, string(data), `"state":"CatchingUp"`)
+	lt2 := &LeaderTransfer{}
+	assert.NoError(t, encoding.JSONUnmarshal(data, lt2))
+	assert.Equal(t, lt, lt2)
+	assert.Equal(t, "test/1", lt2.Key())
+	assert.Equal(t, "database: test, shard: 1, 1 => 2", lt2.String())
+
+	var s LeaderTransferState
+	assert.NoError(t, s.UnmarshalJSON([]byte(`"abc"`)))
+	assert.Equal(t, LeaderTransferUnknown, s)
+	assert.Error(t, s.UnmarshalJSON([]byte(`abc`)))
+}
@@ -22,8 +22,16 @@ import (
 
 	commonconstants "github.com/lindb/common/constants"
 	commonseries "github.com/lindb/common/series"
+
+	"github.com/lindb/lindb/pkg/ltoml"
 )
 
+// WriteRateLimits represents the write rate limits(rows/bytes per second) of namespace.
+type WriteRateLimits struct {
+	MaxWriteRowsPerSecond  int64 `toml:"max-write-rows-per-second"`
+	MaxWriteBytesPerSecond int64 `toml:"max-write-bytes-per-second"`
+}
+
 // Limits represents all the limit for database level; can be used to describe global
 // default limits, or per-database limits vis toml config.
 type Limits struct {
@@ -40,9 +48,21 @@ type Limits struct {
 	MaxSeriesPerMetric  uint32 `toml:"max-series-per-metric"`
 	// max series limit for metric
 	Metrics map[string]uint32 `toml:"metrics"`
+	// Write rate limits(token bucket), 0 to disable.
+	// Bytes limit reserves the content length of request before parsing. Rows limit is debt-based,
+	// rows of request are known after parsing, so in-flight requests may exceed the rate,
+	// then following requests are rejected until the debt is paid off.
+	MaxWriteRowsPerSecond  int64 `toml:"max-write-rows-per-second"`
+	MaxWriteBytesPerSecond int64 `toml:"max-write-bytes-per-second"`
+	// write rate limits for namespace, in addition to database's write rate limits
+	Namespaces map[string]WriteRateLimits `toml:"namespaces"`
 
 	// Read Limits
 	MaxSeriesPerQuery int `toml:"max-series-per-query"`
+	// query timeout of database, 0 to use the query timeout of node
+	QueryTimeout ltoml.Duration `toml:"query-timeout"`
+	// max memory can be used by one query on each node, 0 to disable
+	MaxMemoryPerQuery ltoml.Size `toml:"max-memory-per-query"`
 }
 
 // NewDefaultLimits creates a default limits.
@@ -60,8 +80,14 @@ func NewDefaultLimits() *Limits {
 		MaxTagsPerMetric:    32,
 		MaxSeriesPerMetric:  200000,
 		Metrics:             make(map[string]uint32),
+		// Write rate limits
+		MaxWriteRowsPerSecond:  0,
+		MaxWriteBytesPerSecond: 0,
+		Namespaces:             make(map[string]WriteRateLimits),
 		// Read limits
 		MaxSeriesPerQuery: 200000,
+		QueryTimeout:      0,
+		MaxMemoryPerQuery: 0,
 	}
 }
 
@@ -115,6 +141,16 @@ func (l *Limits) EnableSeriesCheckForQuery() bool {
 	return l.MaxSeriesPerQuery != 0
 }
 
+// EnableQueryTimeout returns if query timeout of database is set.
+func (l *Limits) EnableQueryTimeout() bool {
+	return l.QueryTimeout > 0
+}
+
+// EnableQueryMemoryCheck returns if need check memory usage of query.
+func (l *Limits) EnableQueryMemoryCheck() bool {
+	return l.MaxMemoryPerQuery > 0
+}
+
 // TOML returns limits' configuration string as toml format.
 func (l *Limits) TOML() string {
 	return fmt.Sprintf(`
@@ -151,16 +187,37 @@ max-tag-name-length = %d
 ## Maximum length accepted for tag value.
 ## Default: %d
 max-tag-value-length = %d
+## Maximum number of rows per second written into database(per broker),
+## requests exceeded are rejected with http status 429(Too Many Requests).
+## Rows are taken after parsing request, in-flight requests may exceed the rate(in debt),
+## then following requests are rejected until the debt is paid off.
+## Default: %d
+max-write-rows-per-second = %d
+## Maximum number of bytes per second written into database(per broker),
+## content length of request is reserved before parsing.
+## Default: %d
+max-write-bytes-per-second = %d
 
 ## Maximum number of series for which a query can fetch.
 ## Default: %d
 max-series-per-query = %d
+## Query timeout of database, cannot exceed the query timeout of node.
+## Default: %s
+query-timeout = "%s"
+## Maximum memory can be used by one query on each node,
+## query exceeded is aborted.
+## Default: %s
+max-memory-per-query = "%s"
 
 ## Maximum number of active series for special metric.
 ## Must be the last limit configure item.
 ## Example: "system.cpu" = 100000
 ## Example: "namespace|system.cpu" = 100000
 [metrics]
+%s
+## Write rate limits for special namespace, in addition to the write rate limits of database.
+## Example: "ns" = { max-write-rows-per-second = 10000, max-write-bytes-per-second = 10485760 }
+[namespaces]
 %s
 		`,
 		l.MaxNamespaces,
@@ -183,9 +240,18 @@ max-series-per-query = %d
 		l.MaxTagNameLength,
 		l.MaxTagValueLength,
 		l.MaxTagValueLength,
+		l.MaxWriteRowsPerSecond,
+		l.MaxWriteRowsPerSecond,
+		l.MaxWriteBytesPerSecond,
+		l.MaxWriteBytesPerSecond,
 		l.MaxSeriesPerQuery,
 		l.MaxSeriesPerQuery,
+		l.QueryTimeout,
+		l.QueryTimeout,
+		l.MaxMemoryPerQuery,
+		l.MaxMemoryPerQuery,
 		l.metricsTOML(),
+		l.namespacesTOML(),
 	)
 }
 
@@ -198,6 +264,21 @@ func (l *Limits) metricsTOML() string {
 	return rs
 }
 
+// namespacesTOML returns limits' configuration for namespace level.
+func (l *Limits) namespacesTOML() string {
+	rs := ""
+	for ns, v := range l.Namespaces {
+		rs += fmt.Sprintf("%q = { max-write-rows-per-second = %d, max-write-bytes-per-second = %d }\n",
+			ns, v.MaxWriteRowsPerSecond, v.MaxWriteBytesPerSecond)
+	}
+	return rs
+}
+
+// EnableWriteRateLimit returns if need limit write rate of database/namespace.
+func (l *Limits) EnableWriteRateLimit() bool {
+	return l.MaxWriteRowsPerSecond != 0 || l.MaxWriteBytesPerSecond != 0 || len(l.Namespaces) != 0
+}
+
 // GetSeriesLimit returns the limit by given namespace/metric name.
 func (l *Limits) GetSeriesLimit(namespace, metricName string) uint32 {
 	if len(l.Metrics) == 0 {
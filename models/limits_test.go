@@ -19,9 +19,12 @@ package models
 
 import (
 	"testing"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/ltoml"
 )
 
 func TestDefaultLimits(t *testing.T) {
@@ -36,6 +39,38 @@ func TestDefaultLimits(t *testing.T) {
 	assert.NotEqual(t, l.TOML(), NewDefaultLimits().TOML())
 }
 
+func TestLimits_WriteRate(t *testing.T) {
+	l := NewDefaultLimits()
+	assert.False(t, l.EnableWriteRateLimit())
+	l.Metrics["system.cpu"] = 1000
+	l.MaxWriteRowsPerSecond = 100
+	l.MaxWriteBytesPerSecond = 1024
+	l.Namespaces["ns"] = WriteRateLimits{MaxWriteRowsPerSecond: 10, MaxWriteBytesPerSecond: 128}
+	assert.True(t, l.EnableWriteRateLimit())
+	cfg := &Limits{}
+	_, err := toml.Decode(l.TOML(), cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, l, cfg)
+
+	l = NewDefaultLimits()
+	l.Namespaces["ns"] = WriteRateLimits{MaxWriteRowsPerSecond: 10}
+	assert.True(t, l.EnableWriteRateLimit())
+}
+
+func TestLimits_Query(t *testing.T) {
+	l := NewDefaultLimits()
+	assert.False(t, l.EnableQueryTimeout())
+	assert.False(t, l.EnableQueryMemoryCheck())
+	l.QueryTimeout = ltoml.Duration(10 * time.Second)
+	l.MaxMemoryPerQuery = ltoml.Size(64 * 1024 * 1024)
+	assert.True(t, l.EnableQueryTimeout())
+	assert.True(t, l.EnableQueryMemoryCheck())
+	cfg := &Limits{}
+	_, err := toml.Decode(l.TOML(), cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, l, cfg)
+}
+
 func TestLimits_GetSeriesLimits(t *testing.T) {
 	l := NewDefaultLimits()
 	ns := "ns"
@@ -0,0 +1,155 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/lindb/lindb/pkg/option"
+)
+
+// MigrationState represents the state of replica migration.
+type MigrationState int
+
+const (
+	MigrationUnknown MigrationState = iota
+	MigrationPending
+	MigrationPreparing
+	MigrationExporting
+	MigrationCatchingUp
+	MigrationDone
+	MigrationFailed
+)
+
+// String returns the string value of MigrationState.
+func (s MigrationState) String() string {
+	switch s {
+	case MigrationPending:
+		return "Pending"
+	case MigrationPreparing:
+		return "Preparing"
+	case MigrationExporting:
+		return "Exporting"
+	case MigrationCatchingUp:
+		return "CatchingUp"
+	case MigrationDone:
+		return "Done"
+	case MigrationFailed:
+		return "Failed"
+	default:
+		return "Unknown"
+	}
+}
+
+// IsTerminal returns if migration is completed(done or failed).
+func (s MigrationState) IsTerminal() bool {
+	return s == MigrationDone || s == MigrationFailed
+}
+
+// MarshalJSON encodes migration state.
+func (s MigrationState) MarshalJSON() ([]byte, error) {
+	val := s.String()
+	return json.Marshal(&val)
+}
+
+// UnmarshalJSON decodes migration state.
+func (s *MigrationState) UnmarshalJSON(value []byte) error {
+	var val string
+	if err := json.Unmarshal(value, &val); err != nil {
+		return err
+	}
+	for _, state := range []MigrationState{
+		MigrationPending, MigrationPreparing, MigrationExporting,
+		MigrationCatchingUp, MigrationDone, MigrationFailed,
+	} {
+		if state.String() == val {
+			*s = state
+			return nil
+		}
+	}
+	*s = MigrationUnknown
+	return nil
+}
+
+// ReplicaMigration represents the migration which moves the replica of shard from source node to target node.
+type ReplicaMigration struct {
+	Database string         `json:"database"`
+	ShardID  ShardID        `json:"shardId"`
+	Source   NodeID         `json:"source"`
+	Target   NodeID         `json:"target"`
+	Leader   NodeID         `json:"leader"` // leader which exports data to target
+	Reason   string         `json:"reason,omitempty"`
+	State    MigrationState `json:"state"`
+	Rows     int64          `json:"rows"`    // rows exported
+	Bytes    int64          `json:"bytes"`   // bytes exported(compressed)
+	Pending  int64          `json:"pending"` // pending write ahead log of target when catching up
+	ErrMsg   string         `json:"errMsg,omitempty"`
+
+	CreateTime int64 `json:"createTime"`
+	UpdateTime int64 `json:"updateTime"`
+}
+
+// Key returns the unique key of migration, only one migration can run for a shard at the same time.
+func (m *ReplicaMigration) Key() string {
+	return ReplicaMigrationKey(m.Database, m.ShardID)
+}
+
+// ReplicaMigrationKey returns the unique key of migration for database's shard.
+func ReplicaMigrationKey(database string, shardID ShardID) string {
+	return fmt.Sprintf("%s/%d", database, shardID)
+}
+
+// String returns the string value of migration.
+func (m *ReplicaMigration) String() string {
+	return fmt.Sprintf("database: %s, shard: %d, %d => %d", m.Database, m.ShardID, m.Source, m.Target)
+}
+
+// MigrationPrepareRequest represents the request which creates the shard of target replica.
+type MigrationPrepareRequest struct {
+	Database string                 `json:"database" binding:"required"`
+	ShardID  ShardID                `json:"shardId"`
+	Option   *option.DatabaseOption `json:"option" binding:"required"`
+}
+
+// MigrationExportRequest represents the request which starts exporting the data of shard from leader to target replica.
+type MigrationExportRequest struct {
+	Database      string  `json:"database" binding:"required"`
+	ShardID       ShardID `json:"shardId"`
+	Target        NodeID  `json:"target"`
+	TargetAddress string  `json:"targetAddress" binding:"required"` // http address of target node
+	Throttle      int64   `json:"throttle"`                         // bytes per second, no limit if <= 0
+}
+
+// MigrationFinishRequest represents the request which finishes importing data into target replica,
+// the replica sequence of families is used to reset write ahead log of target replica.
+type MigrationFinishRequest struct {
+	Database string          `json:"database" binding:"required"`
+	ShardID  ShardID         `json:"shardId"`
+	Leader   NodeID          `json:"leader"`
+	Families []*FamilyBackup `json:"families"`
+}
+
+// MigrationCompleteRequest represents the request which completes the migration after replica assignment changed,
+// removes the replicator of source replica from leader.
+type MigrationCompleteRequest struct {
+	Database string  `json:"database" binding:"required"`
+	ShardID  ShardID `json:"shardId"`
+	Source   NodeID  `json:"source"`
+	Target   NodeID  `json:"target"`
+}
@@ -0,0 +1,56 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+func TestMigrationState_String(t *testing.T) {
+	assert.Equal(t, "Pending", MigrationPending.String())
+	assert.Equal(t, "Preparing", MigrationPreparing.String())
+	assert.Equal(t, "Exporting", MigrationExporting.String())
+	assert.Equal(t, "CatchingUp", MigrationCatchingUp.String())
+	assert.Equal(t, "Done", MigrationDone.String())
+	assert.Equal(t, "Failed", MigrationFailed.String())
+	assert.Equal(t, "Unknown", MigrationUnknown.String())
+
+	assert.True(t, MigrationDone.IsTerminal())
+	assert.True(t, MigrationFailed.IsTerminal())
+	assert.False(t, MigrationExporting.IsTerminal())
+}
+
+func TestMigrationState_JSON(t *testing.T) {
+	m := &ReplicaMigration{Database: "test", ShardID: 1, Source: 1, Target: 2, State: MigrationCatchingUp}
+	data := encoding.JSONMarshal(m)
+	assert.Contains(t, string(data), `"state":"CatchingUp"`)
+	m2 := &ReplicaMigration{}
+	assert.NoError(t, encoding.JSONUnmarshal(data, m2))
+	assert.Equal(t, m, m2)
+	assert.Equal(t, "test/1", m2.Key())
+	assert.Equal(t, "database: test, shard: 1, 1 => 2", m2.String())
+
+	var s MigrationState
+	assert.NoError(t, s.UnmarshalJSON([]byte(`"abc"`)))
+	assert.Equal(t, MigrationUnknown, s)
+	assert.Error(t, s.UnmarshalJSON([]byte(`abc`)))
+}
@@ -32,6 +32,7 @@ const (
 	ReplicatorInitState
 	ReplicatorReadyState
 	ReplicatorFailureState
+	ReplicatorSnapshotState
 )
 
 // String returns the string value of ReplicatorState.
@@ -43,6 +44,8 @@ func (s ReplicatorState) String() string {
 		return "Ready"
 	case ReplicatorFailureState:
 		return "Failure"
+	case ReplicatorSnapshotState:
+		return "Snapshot"
 	default:
 		return "Unknown"
 	}
@@ -66,6 +69,9 @@ func (s *ReplicatorState) UnmarshalJSON(value []byte) error {
 	case `"Failure"`:
 		*s = ReplicatorFailureState
 		return nil
+	case `"Snapshot"`:
+		*s = ReplicatorSnapshotState
+		return nil
 	default:
 		*s = ReplicatorUnknownState
 		return nil
@@ -92,6 +98,19 @@ type ReplicaPeerState struct {
 	Pending        int64           `json:"pending"`
 	State          ReplicatorState `json:"state"`
 	StateErrMsg    string          `json:"stateErrMsg"`
+
+	Snapshot *SnapshotProgress `json:"snapshot,omitempty"`
+}
+
+// SnapshotProgress represents the progress of snapshot transfer,
+// which catches up the replica whose needed write ahead log is truncated on leader.
+type SnapshotProgress struct {
+	Blocks    int64 `json:"blocks"`   // number of transferred rows block
+	Rows      int64 `json:"rows"`     // number of rows installed by replica
+	Bytes     int64 `json:"bytes"`    // bytes of transferred rows block(compressed)
+	Sequence  int64 `json:"sequence"` // replica sequence of snapshot, replica resumes from write ahead log after it
+	StartTime int64 `json:"startTime"`
+	EndTime   int64 `json:"endTime,omitempty"`
 }
 
 // SystemStat represents the system statistics
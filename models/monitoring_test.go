@@ -27,6 +27,7 @@ func TestReplicatorState_String(t *testing.T) {
 	assert.Equal(t, "Init", ReplicatorInitState.String())
 	assert.Equal(t, "Ready", ReplicatorReadyState.String())
 	assert.Equal(t, "Failure", ReplicatorFailureState.String())
+	assert.Equal(t, "Snapshot", ReplicatorSnapshotState.String())
 	assert.Equal(t, "Unknown", ReplicatorUnknownState.String())
 }
 
@@ -47,6 +48,9 @@ func TestReplicatorState_UnmarshalJSON(t *testing.T) {
 	err = rs.UnmarshalJSON([]byte(`"Failure"`))
 	assert.NoError(t, err)
 	assert.Equal(t, ReplicatorFailureState, rs)
+	err = rs.UnmarshalJSON([]byte(`"Snapshot"`))
+	assert.NoError(t, err)
+	assert.Equal(t, ReplicatorSnapshotState, rs)
 	err = rs.UnmarshalJSON([]byte(`"jjj"`))
 	assert.NoError(t, err)
 	assert.Equal(t, ReplicatorUnknownState, rs)
@@ -55,7 +55,30 @@ type Node interface {
 type StatefulNode struct {
 	StatelessNode
 
-	ID NodeID `json:"id"`
+	ID   NodeID `json:"id"`
+	Zone string `json:"zone,omitempty"` // availability zone which node located in
+	Rack string `json:"rack,omitempty"` // rack which node located in
+}
+
+// Location returns the failure domain(zone/rack) of node.
+func (n *StatefulNode) Location() NodeLocation {
+	return NodeLocation{Zone: n.Zone, Rack: n.Rack}
+}
+
+// NodeLocation represents the failure domain of node, replicas of shard are spread over zones/racks.
+type NodeLocation struct {
+	Zone string
+	Rack string
+}
+
+// IsEmpty returns if node's location not set.
+func (l NodeLocation) IsEmpty() bool {
+	return l.Zone == "" && l.Rack == ""
+}
+
+// RackKey returns the unique key of rack, the same rack name in different zones is different rack.
+func (l NodeLocation) RackKey() string {
+	return l.Zone + "/" + l.Rack
 }
 
 // StatelessNodes represents stateless node list.
@@ -86,3 +86,13 @@ func TestNodeID(t *testing.T) {
 	assert.Equal(t, "1", NodeID(1).String())
 	assert.Equal(t, NodeID(1), ParseNodeID("1"))
 }
+
+func TestStatefulNode_Location(t *testing.T) {
+	node := &StatefulNode{ID: 1}
+	assert.True(t, node.Location().IsEmpty())
+	node.Zone = "az1"
+	node.Rack = "r1"
+	assert.False(t, node.Location().IsEmpty())
+	assert.Equal(t, NodeLocation{Zone: "az1", Rack: "r1"}, node.Location())
+	assert.Equal(t, "az1/r1", node.Location().RackKey())
+}
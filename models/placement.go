@@ -0,0 +1,70 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// LeaderPlacement represents the preferred placement of shard's leader for database,
+// leader is elected from the live replicas in preferred zones by order, then other live replicas.
+type LeaderPlacement struct {
+	PreferredZones []string `json:"preferredZones,omitempty"`
+}
+
+// Validate checks if the leader placement is valid.
+func (p *LeaderPlacement) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if len(p.PreferredZones) == 0 {
+		return errors.New("preferred zones cannot be empty for leader placement")
+	}
+	zones := make(map[string]struct{})
+	for _, zone := range p.PreferredZones {
+		if strings.TrimSpace(zone) == "" {
+			return errors.New("preferred zone cannot be empty")
+		}
+		if _, ok := zones[zone]; ok {
+			return fmt.Errorf("duplicate preferred zone: %s", zone)
+		}
+		zones[zone] = struct{}{}
+	}
+	return nil
+}
+
+// ZonePriority returns the priority of zone, the lower value the higher priority,
+// returns the num. of preferred zones if zone is not preferred.
+func (p *LeaderPlacement) ZonePriority(zone string) int {
+	if p == nil {
+		return 0
+	}
+	for idx, preferred := range p.PreferredZones {
+		if preferred == zone {
+			return idx
+		}
+	}
+	return len(p.PreferredZones)
+}
+
+// String returns the description of leader placement.
+func (p *LeaderPlacement) String() string {
+	return "zones(" + strings.Join(p.PreferredZones, ",") + ")"
+}
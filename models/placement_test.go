@@ -0,0 +1,44 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaderPlacement_Validate(t *testing.T) {
+	var p *LeaderPlacement
+	assert.NoError(t, p.Validate())
+	assert.Error(t, (&LeaderPlacement{}).Validate())
+	assert.Error(t, (&LeaderPlacement{PreferredZones: []string{"a", " "}}).Validate())
+	assert.Error(t, (&LeaderPlacement{PreferredZones: []string{"a", "b", "a"}}).Validate())
+	assert.NoError(t, (&LeaderPlacement{PreferredZones: []string{"a", "b"}}).Validate())
+}
+
+func TestLeaderPlacement_ZonePriority(t *testing.T) {
+	var p *LeaderPlacement
+	assert.Equal(t, 0, p.ZonePriority("a"))
+	p = &LeaderPlacement{PreferredZones: []string{"a", "b"}}
+	assert.Equal(t, 0, p.ZonePriority("a"))
+	assert.Equal(t, 1, p.ZonePriority("b"))
+	assert.Equal(t, 2, p.ZonePriority("c"))
+	assert.Equal(t, 2, p.ZonePriority(""))
+	assert.Equal(t, "zones(a,b)", p.String())
+}
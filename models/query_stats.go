@@ -68,10 +68,17 @@ type NodeStats struct {
 	Start      int64         `json:"start"`
 	End        int64         `json:"end"`
 	Stages     []*StageStats `json:"stages,omitempty"`
+	Cache      *CacheStats   `json:"cache,omitempty"` // query result cache stats of broker
 
 	Children []*NodeStats `json:"children,omitempty"`
 }
 
+// CacheStats represents the stats of query result cache.
+type CacheStats struct {
+	Hits   int `json:"hits"`   // num. of families served from cache
+	Misses int `json:"misses"` // num. of families queried from storage
+}
+
 // Stats represents the time stats
 type Stats struct {
 	TotalCost int64 `json:"totalCost"`
@@ -130,6 +137,9 @@ func nodeTitle(node *NodeStats) string {
 	if node.NetPayload > 0 {
 		costs = append(costs, fmt.Sprintf("Network: %s", ltoml.Size(node.NetPayload)))
 	}
+	if node.Cache != nil {
+		costs = append(costs, fmt.Sprintf("Cache: %d hits/%d misses", node.Cache.Hits, node.Cache.Misses))
+	}
 	return fmt.Sprintf("%s: [%s]",
 		node.Node, strings.Join(costs, ", "),
 	)
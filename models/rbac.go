@@ -0,0 +1,169 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// maxPasswordLen represents the max length of password, bcrypt only uses the first 72 bytes of password.
+const maxPasswordLen = 72
+
+// AllDatabases represents the privilege granted on all databases(cluster level).
+const AllDatabases = "*"
+
+// Privilege represents the privilege of database.
+type Privilege string
+
+const (
+	// ReadPrivilege represents read data/metadata of database.
+	ReadPrivilege Privilege = "read"
+	// WritePrivilege represents write/delete data of database.
+	WritePrivilege Privilege = "write"
+	// AdminPrivilege represents manage database, includes read/write privilege.
+	AdminPrivilege Privilege = "admin"
+)
+
+// ParsePrivilege returns the privilege by name.
+func ParsePrivilege(name string) (Privilege, error) {
+	privilege := Privilege(strings.ToLower(name))
+	switch privilege {
+	case ReadPrivilege, WritePrivilege, AdminPrivilege:
+		return privilege, nil
+	default:
+		return "", fmt.Errorf("unknown privilege: %s", name)
+	}
+}
+
+// Covers returns if the privilege covers the required privilege, admin covers all privileges.
+func (p Privilege) Covers(required Privilege) bool {
+	return p == AdminPrivilege || p == required
+}
+
+// User represents the user who can access broker.
+type User struct {
+	Name     string   `json:"name"`
+	Password string   `json:"password"` // bcrypt hash of password
+	Roles    []string `json:"roles,omitempty"`
+}
+
+// NewUser creates a user with the hash of password.
+func NewUser(name, password string) (*User, error) {
+	hash, err := HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	return &User{
+		Name:     name,
+		Password: hash,
+	}, nil
+}
+
+// CheckPassword checks if password matches the user's password.
+func (u *User) CheckPassword(password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)) == nil
+}
+
+// HasRole returns if the user has the role.
+func (u *User) HasRole(role string) bool {
+	for _, r := range u.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// GrantRole grants the role to user, returns false if user has the role already.
+func (u *User) GrantRole(role string) bool {
+	if u.HasRole(role) {
+		return false
+	}
+	u.Roles = append(u.Roles, role)
+	return true
+}
+
+// RevokeRole revokes the role from user, returns false if user hasn't the role.
+func (u *User) RevokeRole(role string) bool {
+	for idx, r := range u.Roles {
+		if r == role {
+			u.Roles = append(u.Roles[:idx], u.Roles[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Grant represents the privilege granted on database.
+type Grant struct {
+	Database  string    `json:"database"`
+	Privilege Privilege `json:"privilege"`
+}
+
+// Role represents a set of privileges which can be granted to users.
+type Role struct {
+	Name   string  `json:"name"`
+	Grants []Grant `json:"grants,omitempty"`
+}
+
+// Grant grants the privilege on database to role, returns false if granted already.
+func (r *Role) Grant(database string, privilege Privilege) bool {
+	for _, g := range r.Grants {
+		if g.Database == database && g.Privilege == privilege {
+			return false
+		}
+	}
+	r.Grants = append(r.Grants, Grant{Database: database, Privilege: privilege})
+	return true
+}
+
+// Revoke revokes the privilege on database from role, returns false if not granted.
+func (r *Role) Revoke(database string, privilege Privilege) bool {
+	for idx, g := range r.Grants {
+		if g.Database == database && g.Privilege == privilege {
+			r.Grants = append(r.Grants[:idx], r.Grants[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Allowed returns if the role has the required privilege on database.
+func (r *Role) Allowed(database string, required Privilege) bool {
+	for _, g := range r.Grants {
+		if (g.Database == AllDatabases || g.Database == database) && g.Privilege.Covers(required) {
+			return true
+		}
+	}
+	return false
+}
+
+// HashPassword returns the salted bcrypt hash of user's password.
+func HashPassword(password string) (string, error) {
+	if len(password) > maxPasswordLen {
+		return "", fmt.Errorf("password is too long, max length is %d", maxPasswordLen)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
@@ -0,0 +1,88 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+func TestParsePrivilege(t *testing.T) {
+	for _, name := range []string{"read", "WRITE", "Admin"} {
+		p, err := ParsePrivilege(name)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, p)
+	}
+	_, err := ParsePrivilege("delete")
+	assert.Error(t, err)
+
+	assert.True(t, AdminPrivilege.Covers(ReadPrivilege))
+	assert.True(t, AdminPrivilege.Covers(WritePrivilege))
+	assert.True(t, WritePrivilege.Covers(WritePrivilege))
+	assert.False(t, WritePrivilege.Covers(ReadPrivilege))
+	assert.False(t, ReadPrivilege.Covers(AdminPrivilege))
+}
+
+func TestUser(t *testing.T) {
+	u, err := NewUser("bob", "pwd")
+	assert.NoError(t, err)
+	assert.NotEqual(t, "pwd", u.Password)
+	assert.True(t, u.CheckPassword("pwd"))
+	assert.False(t, u.CheckPassword("pwd1"))
+	// same password is salted
+	alice, err := NewUser("alice", "pwd")
+	assert.NoError(t, err)
+	assert.NotEqual(t, u.Password, alice.Password)
+	assert.True(t, alice.CheckPassword("pwd"))
+	// password too long
+	_, err = NewUser("alice", strings.Repeat("p", maxPasswordLen+1))
+	assert.Error(t, err)
+
+	assert.True(t, u.GrantRole("ops"))
+	assert.False(t, u.GrantRole("ops"))
+	assert.True(t, u.HasRole("ops"))
+	assert.True(t, u.RevokeRole("ops"))
+	assert.False(t, u.RevokeRole("ops"))
+	assert.False(t, u.HasRole("ops"))
+
+	u1 := &User{}
+	assert.NoError(t, encoding.JSONUnmarshal(encoding.JSONMarshal(u), u1))
+	assert.Equal(t, u.Name, u1.Name)
+	assert.True(t, u1.CheckPassword("pwd"))
+}
+
+func TestRole(t *testing.T) {
+	r := &Role{Name: "ops"}
+	assert.True(t, r.Grant("db", ReadPrivilege))
+	assert.False(t, r.Grant("db", ReadPrivilege))
+	assert.True(t, r.Allowed("db", ReadPrivilege))
+	assert.False(t, r.Allowed("db", WritePrivilege))
+	assert.False(t, r.Allowed("db2", ReadPrivilege))
+
+	assert.True(t, r.Grant(AllDatabases, AdminPrivilege))
+	assert.True(t, r.Allowed("db2", WritePrivilege))
+	assert.True(t, r.Allowed(AllDatabases, AdminPrivilege))
+
+	assert.True(t, r.Revoke(AllDatabases, AdminPrivilege))
+	assert.False(t, r.Revoke(AllDatabases, AdminPrivilege))
+	assert.False(t, r.Allowed("db2", WritePrivilege))
+}
@@ -77,4 +77,9 @@ func TestResultSet_Stats_ToTable(t *testing.T) {
 	rows, table := rs.ToTable()
 	fmt.Println(rows)
 	fmt.Println(table)
+
+	// query result cache stats
+	rs.Stats.Cache = &CacheStats{Hits: 2, Misses: 1}
+	_, table = rs.ToTable()
+	assert.Contains(t, table, "Cache: 2 hits/1 misses")
 }
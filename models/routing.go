@@ -0,0 +1,208 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cespare/xxhash/v2"
+	jump "github.com/lithammer/go-jump-consistent-hash"
+)
+
+// virtualNodesOfShard represents the number of virtual nodes of each shard on the consistent-hash ring.
+const virtualNodesOfShard = 128
+
+// hashRings caches the consistent-hash ring, num. of shard => *hashRing.
+var hashRings sync.Map
+
+// RoutingMethod represents the method which routes the series of database into shards.
+type RoutingMethod string
+
+const (
+	// ModuloRouting routes series by jump hash of series hash modulo num. of shard(default).
+	ModuloRouting RoutingMethod = "modulo"
+	// ConsistentHashRouting routes series by series hash on the consistent-hash ring of shards.
+	ConsistentHashRouting RoutingMethod = "consistent-hash"
+	// TagKeysRouting routes series by the hash of tag values of configured tag keys,
+	// so that the series with same tag values(like tenant) are colocated in one shard.
+	TagKeysRouting RoutingMethod = "tag-keys"
+)
+
+// Routing represents the shard routing option of database.
+type Routing struct {
+	Method  RoutingMethod `json:"method,omitempty"`
+	TagKeys []string      `json:"tagKeys,omitempty"` // only for tag-keys routing
+}
+
+// Validate checks if the routing option is valid.
+func (r *Routing) Validate() error {
+	if r == nil {
+		return nil
+	}
+	switch r.Method {
+	case "", ModuloRouting, ConsistentHashRouting:
+		if len(r.TagKeys) > 0 {
+			return fmt.Errorf("tag keys only supported by %s routing", TagKeysRouting)
+		}
+	case TagKeysRouting:
+		if len(r.TagKeys) == 0 {
+			return errors.New("tag keys cannot be empty for tag-keys routing")
+		}
+		keys := make(map[string]struct{})
+		for _, key := range r.TagKeys {
+			if key == "" {
+				return errors.New("tag key cannot be empty for tag-keys routing")
+			}
+			if _, ok := keys[key]; ok {
+				return fmt.Errorf("duplicate tag key: %s", key)
+			}
+			keys[key] = struct{}{}
+		}
+	default:
+		return fmt.Errorf("unknown routing method: %s", r.Method)
+	}
+	return nil
+}
+
+// Equal returns if the routing option is same as other.
+func (r *Routing) Equal(other *Routing) bool {
+	return r.method() == other.method() && strings.Join(r.tagKeys(), ",") == strings.Join(other.tagKeys(), ",")
+}
+
+// IsTagKeys returns if series are routed by the tag values of tag keys.
+func (r *Routing) IsTagKeys() bool {
+	return r.method() == TagKeysRouting && len(r.TagKeys) > 0
+}
+
+// String returns the description of routing option.
+func (r *Routing) String() string {
+	if r.IsTagKeys() {
+		return string(TagKeysRouting) + "(" + strings.Join(r.TagKeys, ",") + ")"
+	}
+	return string(r.method())
+}
+
+// TagValuesHash returns the hash of tag values of routing tag keys, tagValue returns the tag value by tag key,
+// returns false if the series has none of routing tag keys.
+func (r *Routing) TagValuesHash(tagValue func(tagKey string) []byte) (uint64, bool) {
+	if !r.IsTagKeys() {
+		return 0, false
+	}
+	var (
+		digest xxhash.Digest
+		found  bool
+	)
+	digest.Reset()
+	for _, tagKey := range r.TagKeys {
+		value := tagValue(tagKey)
+		if value != nil {
+			found = true
+			_, _ = digest.Write(value)
+		}
+		// write separator, avoid (ab,c)/(a,bc) conflicts
+		_, _ = digest.Write([]byte{0xff})
+	}
+	return digest.Sum64(), found
+}
+
+// ShardOf returns the shard index of the hash by given num. of shard.
+func (r *Routing) ShardOf(hash uint64, numOfShard int32) int32 {
+	if numOfShard <= 1 {
+		return 0
+	}
+	if r.method() == ConsistentHashRouting {
+		return getHashRing(numOfShard).locate(hash)
+	}
+	return jump.Hash(hash, numOfShard)
+}
+
+// method returns the routing method, default modulo routing.
+func (r *Routing) method() RoutingMethod {
+	if r == nil || r.Method == "" {
+		return ModuloRouting
+	}
+	return r.Method
+}
+
+// tagKeys returns the routing tag keys.
+func (r *Routing) tagKeys() []string {
+	if r == nil {
+		return nil
+	}
+	return r.TagKeys
+}
+
+// hashRing represents the consistent-hash ring of shards.
+type hashRing struct {
+	hashes []uint64 // sorted hashes of virtual nodes
+	shards []int32  // shard index of virtual nodes
+}
+
+// getHashRing returns the consistent-hash ring by given num. of shard.
+func getHashRing(numOfShard int32) *hashRing {
+	if ring, ok := hashRings.Load(numOfShard); ok {
+		return ring.(*hashRing)
+	}
+	ring, _ := hashRings.LoadOrStore(numOfShard, newHashRing(numOfShard))
+	return ring.(*hashRing)
+}
+
+// newHashRing creates the consistent-hash ring which places virtual nodes of each shard.
+func newHashRing(numOfShard int32) *hashRing {
+	type virtualNode struct {
+		hash  uint64
+		shard int32
+	}
+	nodes := make([]virtualNode, 0, int(numOfShard)*virtualNodesOfShard)
+	for shard := int32(0); shard < numOfShard; shard++ {
+		for i := 0; i < virtualNodesOfShard; i++ {
+			nodes = append(nodes, virtualNode{
+				hash:  xxhash.Sum64String(strconv.Itoa(int(shard)) + "#" + strconv.Itoa(i)),
+				shard: shard,
+			})
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].hash < nodes[j].hash
+	})
+	ring := &hashRing{
+		hashes: make([]uint64, len(nodes)),
+		shards: make([]int32, len(nodes)),
+	}
+	for i, node := range nodes {
+		ring.hashes[i] = node.hash
+		ring.shards[i] = node.shard
+	}
+	return ring
+}
+
+// locate returns the shard index of first virtual node whose hash is greater or equal than the hash.
+func (r *hashRing) locate(hash uint64) int32 {
+	idx := sort.Search(len(r.hashes), func(i int) bool {
+		return r.hashes[i] >= hash
+	})
+	if idx == len(r.hashes) {
+		idx = 0
+	}
+	return r.shards[idx]
+}
@@ -0,0 +1,125 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/cespare/xxhash/v2"
+	jump "github.com/lithammer/go-jump-consistent-hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouting_Validate(t *testing.T) {
+	var r *Routing
+	assert.NoError(t, r.Validate())
+	assert.NoError(t, (&Routing{}).Validate())
+	assert.NoError(t, (&Routing{Method: ModuloRouting}).Validate())
+	assert.NoError(t, (&Routing{Method: ConsistentHashRouting}).Validate())
+	assert.NoError(t, (&Routing{Method: TagKeysRouting, TagKeys: []string{"tenant"}}).Validate())
+	assert.Error(t, (&Routing{Method: "range"}).Validate())
+	assert.Error(t, (&Routing{Method: ModuloRouting, TagKeys: []string{"tenant"}}).Validate())
+	assert.Error(t, (&Routing{Method: TagKeysRouting}).Validate())
+	assert.Error(t, (&Routing{Method: TagKeysRouting, TagKeys: []string{""}}).Validate())
+	assert.Error(t, (&Routing{Method: TagKeysRouting, TagKeys: []string{"tenant", "tenant"}}).Validate())
+}
+
+func TestRouting_Equal(t *testing.T) {
+	var r *Routing
+	assert.True(t, r.Equal(&Routing{}))
+	assert.True(t, r.Equal(&Routing{Method: ModuloRouting}))
+	assert.False(t, r.Equal(&Routing{Method: ConsistentHashRouting}))
+	assert.True(t, (&Routing{Method: TagKeysRouting, TagKeys: []string{"a", "b"}}).
+		Equal(&Routing{Method: TagKeysRouting, TagKeys: []string{"a", "b"}}))
+	assert.False(t, (&Routing{Method: TagKeysRouting, TagKeys: []string{"a", "b"}}).
+		Equal(&Routing{Method: TagKeysRouting, TagKeys: []string{"b", "a"}}))
+}
+
+func TestRouting_String(t *testing.T) {
+	var r *Routing
+	assert.Equal(t, "modulo", r.String())
+	assert.Equal(t, "consistent-hash", (&Routing{Method: ConsistentHashRouting}).String())
+	assert.Equal(t, "tag-keys(tenant)", (&Routing{Method: TagKeysRouting, TagKeys: []string{"tenant"}}).String())
+}
+
+func TestRouting_TagValuesHash(t *testing.T) {
+	tags := map[string]string{"tenant": "t1", "host": "h1", "app": "a1"}
+	tagValue := func(tagKey string) []byte {
+		if v, ok := tags[tagKey]; ok {
+			return []byte(v)
+		}
+		return nil
+	}
+	var r *Routing
+	_, ok := r.TagValuesHash(tagValue)
+	assert.False(t, ok)
+
+	r = &Routing{Method: TagKeysRouting, TagKeys: []string{"tenant", "app"}}
+	h1, ok := r.TagValuesHash(tagValue)
+	assert.True(t, ok)
+	// hash is independent of other tags
+	tags["host"] = "h2"
+	h2, ok := r.TagValuesHash(tagValue)
+	assert.True(t, ok)
+	assert.Equal(t, h1, h2)
+	// separator avoids conflicts
+	tags["tenant"] = "t1a"
+	tags["app"] = "1"
+	h3, _ := r.TagValuesHash(tagValue)
+	assert.NotEqual(t, h1, h3)
+	// partial tag keys
+	delete(tags, "app")
+	_, ok = r.TagValuesHash(tagValue)
+	assert.True(t, ok)
+	// none of tag keys
+	delete(tags, "tenant")
+	_, ok = r.TagValuesHash(tagValue)
+	assert.False(t, ok)
+}
+
+func TestRouting_ShardOf(t *testing.T) {
+	var r *Routing
+	hash := xxhash.Sum64String("cpu,host=1.1.1.1")
+	assert.Equal(t, int32(0), r.ShardOf(hash, 1))
+	assert.Equal(t, jump.Hash(hash, 10), r.ShardOf(hash, 10))
+	assert.Equal(t, jump.Hash(hash, 10), (&Routing{Method: TagKeysRouting, TagKeys: []string{"a"}}).ShardOf(hash, 10))
+
+	r = &Routing{Method: ConsistentHashRouting}
+	moved := 0
+	counts := make(map[int32]int)
+	for i := 0; i < 10000; i++ {
+		h := xxhash.Sum64String(string(rune(i)) + "series")
+		s1 := r.ShardOf(h, 10)
+		s2 := r.ShardOf(h, 11)
+		assert.True(t, s1 >= 0 && s1 < 10)
+		assert.True(t, s2 >= 0 && s2 < 11)
+		counts[s1]++
+		if s1 != s2 {
+			// only moves series to the new shard
+			assert.Equal(t, int32(10), s2)
+			moved++
+		}
+	}
+	assert.Len(t, counts, 10)
+	assert.Less(t, moved, 2000)
+	// ring cached
+	assert.Equal(t, getHashRing(10), getHashRing(10))
+	// wrap around
+	ring := getHashRing(10)
+	assert.Equal(t, ring.shards[0], ring.locate(ring.hashes[len(ring.hashes)-1]+1))
+}
@@ -178,6 +178,9 @@ type FamilyState struct {
 	Database   string     `json:"database"`
 	Shard      ShardState `json:"shard"`
 	FamilyTime int64      `json:"familyTime"`
+	// write consistency of write stream, storage responds after replicas acknowledged if quorum
+	WriteConsistency WriteConsistency `json:"writeConsistency,omitempty"`
+	AckTimeout       int64            `json:"ackTimeout,omitempty"` // timeout(ms) of waiting for acknowledgement
 }
 
 // BrokerState represents broker cluster state.
@@ -224,6 +227,8 @@ type StorageState struct {
 	// TODO remove??
 	ShardAssignments map[string]*ShardAssignment       `json:"shardAssignments"` // database's name => shard assignment
 	ShardStates      map[string]map[ShardID]ShardState `json:"shardStates"`      // database's name => shard state
+
+	Decommissions map[NodeID]*NodeDecommission `json:"decommissions,omitempty"` // node => decommission
 }
 
 // NewStorageState creates storage cluster state
@@ -249,6 +254,27 @@ func (s *StorageState) LeadersOnNode(nodeID NodeID) map[string][]ShardID {
 	return result
 }
 
+// IsDecommissioning returns if the node is being decommissioned(or decommissioned).
+func (s *StorageState) IsDecommissioning(nodeID NodeID) bool {
+	_, ok := s.Decommissions[nodeID]
+	return ok
+}
+
+// ElectableNodes returns the live nodes which can be elected as shard leader or assigned new replicas,
+// excludes the decommissioning nodes.
+func (s *StorageState) ElectableNodes() map[NodeID]StatefulNode {
+	if len(s.Decommissions) == 0 {
+		return s.LiveNodes
+	}
+	nodes := make(map[NodeID]StatefulNode)
+	for nodeID, node := range s.LiveNodes {
+		if !s.IsDecommissioning(nodeID) {
+			nodes[nodeID] = node
+		}
+	}
+	return nodes
+}
+
 // ReplicasOnNode returns replicas on this node.
 func (s *StorageState) ReplicasOnNode(nodeID NodeID) map[string][]ShardID {
 	result := make(map[string][]ShardID)
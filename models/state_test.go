@@ -92,6 +92,14 @@ func TestStorageState(t *testing.T) {
 
 	assert.NotEmpty(t, storageState.String())
 
+	assert.Equal(t, storageState.LiveNodes, storageState.ElectableNodes())
+	storageState.Decommissions = map[NodeID]*NodeDecommission{3: {Storage: "test", NodeID: 3}}
+	assert.True(t, storageState.IsDecommissioning(3))
+	assert.False(t, storageState.IsDecommissioning(1))
+	nodes := storageState.ElectableNodes()
+	assert.Len(t, nodes, 1)
+	assert.Contains(t, nodes, NodeID(1))
+
 	storageState.DropDatabase("test")
 	_, ok := storageState.ShardAssignments["test"]
 	assert.False(t, ok)
@@ -0,0 +1,59 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import "fmt"
+
+// WriteConsistency represents how many replicas acknowledge the written rows before ingestion api returns.
+type WriteConsistency string
+
+const (
+	// WriteConsistencyAny returns after rows are buffered on broker(default).
+	WriteConsistencyAny WriteConsistency = "any"
+	// WriteConsistencyLeader returns after rows are appended into write ahead log of shard's leader.
+	WriteConsistencyLeader WriteConsistency = "leader"
+	// WriteConsistencyQuorum returns after quorum of shard's replicas have acknowledged rows.
+	WriteConsistencyQuorum WriteConsistency = "quorum"
+)
+
+// Validate checks if the write consistency is valid.
+func (c WriteConsistency) Validate() error {
+	switch c {
+	case "", WriteConsistencyAny, WriteConsistencyLeader, WriteConsistencyQuorum:
+		return nil
+	default:
+		return fmt.Errorf("unknown write consistency: %s", c)
+	}
+}
+
+// RequireAck returns if ingestion api needs to wait for the acknowledgement of storage.
+func (c WriteConsistency) RequireAck() bool {
+	return c == WriteConsistencyLeader || c == WriteConsistencyQuorum
+}
+
+// NumOfAck returns the number of replicas which need to acknowledge the written rows.
+func (c WriteConsistency) NumOfAck(numOfReplicas int) int {
+	switch c {
+	case WriteConsistencyQuorum:
+		return numOfReplicas/2 + 1
+	case WriteConsistencyLeader:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,48 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteConsistency_Validate(t *testing.T) {
+	assert.NoError(t, WriteConsistency("").Validate())
+	assert.NoError(t, WriteConsistencyAny.Validate())
+	assert.NoError(t, WriteConsistencyLeader.Validate())
+	assert.NoError(t, WriteConsistencyQuorum.Validate())
+	assert.Error(t, WriteConsistency("all").Validate())
+}
+
+func TestWriteConsistency_RequireAck(t *testing.T) {
+	assert.False(t, WriteConsistency("").RequireAck())
+	assert.False(t, WriteConsistencyAny.RequireAck())
+	assert.True(t, WriteConsistencyLeader.RequireAck())
+	assert.True(t, WriteConsistencyQuorum.RequireAck())
+}
+
+func TestWriteConsistency_NumOfAck(t *testing.T) {
+	assert.Equal(t, 0, WriteConsistencyAny.NumOfAck(3))
+	assert.Equal(t, 1, WriteConsistencyLeader.NumOfAck(3))
+	assert.Equal(t, 1, WriteConsistencyQuorum.NumOfAck(1))
+	assert.Equal(t, 2, WriteConsistencyQuorum.NumOfAck(2))
+	assert.Equal(t, 2, WriteConsistencyQuorum.NumOfAck(3))
+	assert.Equal(t, 3, WriteConsistencyQuorum.NumOfAck(5))
+}
@@ -18,6 +18,7 @@
 package fileutil
 
 import (
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -27,6 +28,7 @@ var (
 	mkdirAllFunc  = os.MkdirAll
 	removeAllFunc = os.RemoveAll
 	removeFunc    = os.Remove
+	linkFunc      = os.Link
 )
 
 // MkDirIfNotExist creates given dir if it's not exist.
@@ -115,6 +117,61 @@ func GetExistPath(path string) string {
 	return GetExistPath(dir)
 }
 
+// LinkOrCopyFile creates hard link of source file, if hard link not supported(cross device etc.), copies it.
+// NOTICE: existed target file will be replaced, because it may be a hard link of source file, copy into it will
+// truncate the source file.
+func LinkOrCopyFile(src, dst string) error {
+	if err := removeFunc(dst); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := linkFunc(src, dst); err == nil {
+		return nil
+	}
+	return CopyFile(src, dst)
+}
+
+// CopyFile copies the source file to target file, then syncs it.
+func CopyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err = out.Sync(); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// CopyDir copies all files under source dir into target dir recursively.
+func CopyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return MkDirIfNotExist(target)
+		}
+		return CopyFile(path, target)
+	})
+}
+
 // readDir lists all files/directories.
 func readDir(path string, fn func(f fs.DirEntry)) error {
 	files, err := os.ReadDir(path)
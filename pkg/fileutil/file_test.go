@@ -136,3 +136,58 @@ func TestRemoveFile(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, files, 1)
 }
+
+func TestLinkOrCopyFile(t *testing.T) {
+	defer func() {
+		linkFunc = os.Link
+		removeFunc = os.Remove
+	}()
+	dir := t.TempDir()
+	src := filepath.Join(dir, "1.txt")
+	assert.NoError(t, os.WriteFile(src, []byte("1"), 0644))
+	check := func(dst string) {
+		data, err := os.ReadFile(dst)
+		assert.NoError(t, err)
+		assert.Equal(t, "1", string(data))
+	}
+	// hard link
+	dst := filepath.Join(dir, "link.txt")
+	assert.NoError(t, LinkOrCopyFile(src, dst))
+	check(dst)
+	// link again, target file replaced
+	assert.NoError(t, LinkOrCopyFile(src, dst))
+	check(dst)
+	// hard link not supported, copy into existed hard link, cannot truncate source file
+	linkFunc = func(oldname, newname string) error {
+		return fmt.Errorf("err")
+	}
+	assert.NoError(t, LinkOrCopyFile(src, dst))
+	check(dst)
+	check(src)
+	// remove existed target file failure
+	removeFunc = func(name string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, LinkOrCopyFile(src, dst))
+}
+
+func TestCopyDir(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "src")
+	assert.NoError(t, MkDir(filepath.Join(src, "a", "b")))
+	assert.NoError(t, os.WriteFile(filepath.Join(src, "1.txt"), []byte("1"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(src, "a", "b", "2.txt"), []byte("2"), 0644))
+
+	dst := filepath.Join(t.TempDir(), "copy")
+	assert.NoError(t, CopyDir(src, dst))
+	data, err := os.ReadFile(filepath.Join(dst, "1.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "1", string(data))
+	data, err = os.ReadFile(filepath.Join(dst, "a", "b", "2.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "2", string(data))
+	// source not exist
+	assert.Error(t, CopyDir(filepath.Join(src, "not_exist"), dst))
+	assert.Error(t, CopyFile(filepath.Join(src, "not_exist"), dst))
+	// target dir not exist
+	assert.Error(t, CopyFile(filepath.Join(src, "1.txt"), filepath.Join(dst, "not_exist", "1.txt")))
+}
@@ -46,6 +46,12 @@ func Error(c *gin.Context, err error) {
 	response(c, http.StatusInternalServerError, err.Error())
 }
 
+// ErrorWithCode responses error message and set the given http status code.
+func ErrorWithCode(c *gin.Context, httpCode int, err error) {
+	_ = c.Error(err)
+	response(c, httpCode, err.Error())
+}
+
 // response responses json body for http restful api
 func response(c *gin.Context, httpCode int, content interface{}) {
 	c.JSON(httpCode, content)
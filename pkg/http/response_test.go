@@ -58,3 +58,11 @@ func TestError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 	assert.Equal(t, `"err"`, resp.Body.String())
 }
+
+func TestErrorWithCode(t *testing.T) {
+	resp := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(resp)
+	ErrorWithCode(c, http.StatusGatewayTimeout, fmt.Errorf("err"))
+	assert.Equal(t, http.StatusGatewayTimeout, resp.Code)
+	assert.Equal(t, `"err"`, resp.Body.String())
+}
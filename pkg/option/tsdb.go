@@ -103,6 +103,17 @@ func (e *DatabaseOption) FindMatchSmallestInterval(interval timeutil.Interval) t
 	return storageInterval
 }
 
+// MaxRetention returns the max data retention of all intervals(include rollup intervals).
+func (e *DatabaseOption) MaxRetention() int64 {
+	var retention int64
+	for _, i := range e.Intervals {
+		if i.Retention.Int64() > retention {
+			retention = i.Retention.Int64()
+		}
+	}
+	return retention
+}
+
 // Validate validates engine option if valid
 func (e *DatabaseOption) Validate() error {
 	if len(e.Intervals) == 0 {
@@ -156,3 +156,12 @@ func TestDatabaseOption_FindMatchSmallestInterval(t *testing.T) {
 	interval := opt.FindMatchSmallestInterval(timeutil.Interval(timeutil.OneMinute * 3))
 	assert.Equal(t, timeutil.Interval(timeutil.OneMinute), interval)
 }
+
+func TestDatabaseOption_MaxRetention(t *testing.T) {
+	opt := DatabaseOption{Intervals: Intervals{
+		{timeutil.Interval(timeutil.OneSecond), timeutil.Interval(timeutil.OneDay)},
+		{timeutil.Interval(timeutil.OneMinute), timeutil.Interval(timeutil.OneMonth)},
+	}}
+	assert.Equal(t, timeutil.OneMonth, opt.MaxRetention())
+	assert.Zero(t, (&DatabaseOption{}).MaxRetention())
+}
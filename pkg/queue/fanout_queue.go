@@ -33,6 +33,7 @@ var (
 	newQueueFunc         = NewQueue
 	listDirFunc          = fileutil.ListDir
 	newConsumerGroupFunc = NewConsumerGroup
+	removeDirFunc        = fileutil.RemoveDir
 )
 
 // FanOutQueue represents a queue "produce once, consume multiple times".
@@ -49,6 +50,8 @@ type FanOutQueue interface {
 	ConsumerGroupNames() []string
 	// StopConsumerGroup stops consumer group by name.
 	StopConsumerGroup(name string)
+	// DropConsumerGroup stops consumer group by name, then removes the persisted sequence of it.
+	DropConsumerGroup(name string) error
 	// Sync checks the acknowledged sequence of each ConsumerGroup, update the acknowledged sequence as the smallest one.
 	// Then syncs metadata to storage.
 	Sync()
@@ -151,6 +154,12 @@ func (fq *fanOutQueue) StopConsumerGroup(name string) {
 	}
 }
 
+// DropConsumerGroup stops consumer group by name, then removes the persisted sequence of it.
+func (fq *fanOutQueue) DropConsumerGroup(name string) error {
+	fq.StopConsumerGroup(name)
+	return removeDirFunc(path.Join(fq.consumerGroupDir, name))
+}
+
 // SetAppendedSeq sets appended sequence underlying queue, then set consumed/acknowledged sequence for each ConsumerGroup.
 func (fq *fanOutQueue) SetAppendedSeq(seq int64) {
 	fq.lock4map.RLock()
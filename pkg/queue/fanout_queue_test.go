@@ -194,6 +194,36 @@ func TestFanoutQueue_StopConsumerGroup(t *testing.T) {
 	fq.Close()
 }
 
+func TestFanoutQueue_DropConsumerGroup(t *testing.T) {
+	dir := path.Join(t.TempDir(), t.Name())
+	fq, err := NewFanOutQueue(dir, 1024)
+	assert.NoError(t, err)
+	assert.NotNil(t, fq)
+
+	cgName := "group-1"
+
+	fo, err := fq.GetOrCreateConsumerGroup(cgName)
+	assert.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		_ = fq.Queue().Put([]byte(fmt.Sprintf("test-%d", i)))
+	}
+	seq := fo.Consume()
+	assert.Equal(t, int64(0), seq)
+	fo.Ack(seq)
+
+	// drop consumer group
+	assert.NoError(t, fq.DropConsumerGroup(cgName))
+	assert.Empty(t, fq.ConsumerGroupNames())
+
+	// recreate consumer group, consume from queue's acknowledged sequence
+	fo, err = fq.GetOrCreateConsumerGroup(cgName)
+	assert.NoError(t, err)
+	assert.Equal(t, fq.Queue().AcknowledgedSeq(), fo.AcknowledgedSeq())
+
+	fq.Close()
+}
+
 func TestFanOutQueue_Sync(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	dir := path.Join(t.TempDir(), t.Name())
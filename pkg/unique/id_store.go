@@ -49,6 +49,9 @@ type IDStore interface {
 	IterKeys(prefix []byte, limit int) (rs [][]byte, err error)
 	// Flush flushes the memory table data under pebble db.
 	Flush() error
+	// Checkpoint flushes the memory table, then creates a consistent snapshot of pebble db
+	// into target path(must not exist).
+	Checkpoint(path string) error
 }
 
 // idStore implements IDStore interface.
@@ -151,6 +154,16 @@ func (s *idStore) Flush() error {
 	return s.db.Flush()
 }
 
+// Checkpoint flushes the memory table, then creates a consistent snapshot of pebble db
+// into target path(must not exist).
+// NOTICE: wal is disabled, so need flush memory table first.
+func (s *idStore) Checkpoint(path string) error {
+	if err := s.db.Flush(); err != nil {
+		return err
+	}
+	return s.db.Checkpoint(path)
+}
+
 // Close closes backend pebble db.
 // NOTICE: need flush first
 func (s *idStore) Close() error {
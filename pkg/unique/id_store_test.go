@@ -19,6 +19,7 @@ package unique
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/cockroachdb/pebble"
@@ -241,3 +242,29 @@ func TestPebble_Reopen(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, db2)
 }
+
+func TestIDStore_Checkpoint(t *testing.T) {
+	store, err := NewIDStore(t.TempDir())
+	assert.NoError(t, err)
+	assert.NoError(t, store.Put([]byte("k"), []byte("v")))
+
+	p := filepath.Join(t.TempDir(), "checkpoint")
+	assert.NoError(t, store.Checkpoint(p))
+	// target path exist
+	assert.Error(t, store.Checkpoint(p))
+	assert.NoError(t, store.Put([]byte("k2"), []byte("v2")))
+	assert.NoError(t, store.Close())
+
+	checkpoint, err := NewIDStore(p)
+	assert.NoError(t, err)
+	defer func() {
+		_ = checkpoint.Close()
+	}()
+	val, ok, err := checkpoint.Get([]byte("k"))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("v"), val)
+	_, ok, err = checkpoint.Get([]byte("k2"))
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -48,16 +48,19 @@ type RequestType int32
 const (
 	RequestType_Data     RequestType = 0
 	RequestType_Metadata RequestType = 1
+	RequestType_Kill     RequestType = 2
 )
 
 var RequestType_name = map[int32]string{
 	0: "Data",
 	1: "Metadata",
+	2: "Kill",
 }
 
 var RequestType_value = map[string]int32{
 	"Data":     0,
 	"Metadata": 1,
+	"Kill":     2,
 }
 
 func (x RequestType) String() string {
@@ -444,41 +447,41 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x8e, 0xd3, 0x30,
-	0x10, 0xae, 0x9b, 0xb6, 0xdb, 0x4e, 0xd3, 0xaa, 0xb2, 0x10, 0x0a, 0x65, 0xa9, 0xaa, 0x48, 0x2b,
-	0x45, 0x1c, 0x2a, 0x28, 0x17, 0x40, 0x70, 0x28, 0xbb, 0xfc, 0x49, 0x2c, 0x42, 0x6e, 0xb5, 0x77,
-	0x93, 0xcc, 0x86, 0x68, 0xd3, 0x24, 0xd8, 0x6e, 0xa5, 0xbe, 0x09, 0xe2, 0x05, 0x78, 0x15, 0x8e,
-	0x3c, 0x00, 0x07, 0x54, 0xae, 0x3c, 0x04, 0xb2, 0x93, 0x6d, 0x9a, 0x0a, 0x0e, 0x7b, 0xca, 0x7c,
-	0x9f, 0x67, 0xc6, 0xdf, 0x4c, 0x3e, 0x83, 0xed, 0xa7, 0xcb, 0x65, 0x9a, 0x4c, 0x32, 0x91, 0xaa,
-	0x94, 0xf6, 0xcc, 0xe7, 0xd4, 0x50, 0x17, 0x0f, 0xdd, 0x6f, 0x04, 0xba, 0x0b, 0x2e, 0xaf, 0x18,
-	0x7e, 0x5e, 0xa1, 0x54, 0xf4, 0x18, 0x3a, 0x22, 0x0f, 0xdf, 0x9e, 0x39, 0x64, 0x4c, 0xbc, 0x0e,
-	0x2b, 0x09, 0xfa, 0x0c, 0xba, 0x05, 0x58, 0x6c, 0x32, 0x74, 0xac, 0x31, 0xf1, 0xfa, 0xd3, 0xe1,
-	0xa4, 0xd2, 0x72, 0xc2, 0xca, 0x0c, 0xb6, 0x9f, 0x4e, 0x5d, 0xb0, 0xb3, 0x4f, 0x1b, 0x19, 0xf9,
-	0x3c, 0xfe, 0x10, 0xf3, 0xc4, 0x69, 0x8c, 0x89, 0x67, 0xb3, 0x0a, 0x47, 0x1d, 0x38, 0xca, 0xf8,
-	0x26, 0x4e, 0x79, 0xe0, 0x34, 0xcd, 0xf1, 0x35, 0x74, 0xff, 0x10, 0xb0, 0x73, 0xa5, 0x32, 0x4b,
-	0x13, 0x89, 0x37, 0x93, 0x5a, 0xbf, 0x99, 0xd4, 0x63, 0xe8, 0xf8, 0xe9, 0x32, 0x8b, 0x51, 0x61,
-	0x60, 0xc6, 0x6c, 0xb3, 0x92, 0xa0, 0xb7, 0xa1, 0x85, 0x42, 0x9c, 0xcb, 0xd0, 0x8c, 0xd0, 0x61,
-	0x05, 0xa2, 0x43, 0x68, 0x4b, 0x4c, 0x82, 0x45, 0xb4, 0x44, 0xa3, 0xde, 0x62, 0x3b, 0xbc, 0x3f,
-	0x58, 0xab, 0x32, 0x18, 0xbd, 0x05, 0x4d, 0xa9, 0xb8, 0x92, 0xce, 0x91, 0xe1, 0x73, 0xe0, 0xfe,
-	0x24, 0xd0, 0xd7, 0x85, 0x73, 0x14, 0x11, 0xca, 0x77, 0x91, 0x54, 0x45, 0xa2, 0x50, 0x66, 0x58,
-	0x8b, 0xe5, 0x80, 0x0e, 0xc0, 0xc2, 0x24, 0x30, 0x03, 0x5a, 0x4c, 0x87, 0x5a, 0x46, 0x94, 0x28,
-	0x14, 0x6b, 0x1e, 0x1b, 0xed, 0x16, 0xdb, 0x61, 0x3a, 0x83, 0xbe, 0xaa, 0x74, 0x75, 0x1a, 0x63,
-	0xcb, 0xeb, 0x4e, 0xef, 0x1c, 0x6c, 0xa6, 0xbc, 0x9a, 0x1d, 0x14, 0xd0, 0x53, 0xe8, 0x5d, 0x46,
-	0x18, 0x07, 0xb3, 0x30, 0x9c, 0x67, 0xe8, 0x4b, 0xa7, 0x69, 0x3a, 0xdc, 0x3b, 0xe8, 0x30, 0x0b,
-	0x43, 0x81, 0x21, 0x57, 0xa9, 0xd0, 0x59, 0xac, 0x5a, 0xe3, 0x7e, 0x25, 0x00, 0xe5, 0x1d, 0x94,
-	0x42, 0x43, 0xf1, 0x50, 0x16, 0xbf, 0xd1, 0xc4, 0xf4, 0x39, 0xb4, 0x4c, 0x8d, 0x74, 0xea, 0xe6,
-	0x82, 0x93, 0xff, 0x4a, 0x9c, 0xbc, 0x32, 0x79, 0x2f, 0x13, 0x25, 0x36, 0xac, 0x28, 0x1a, 0x3e,
-	0x81, 0xee, 0x1e, 0xad, 0xd7, 0x74, 0x85, 0x9b, 0xe2, 0x02, 0x1d, 0xea, 0x75, 0xae, 0x79, 0xbc,
-	0xca, 0xbd, 0x61, 0xb3, 0x1c, 0x3c, 0xad, 0x3f, 0x26, 0x6e, 0x06, 0xfd, 0xaa, 0x7a, 0xed, 0x07,
-	0xd3, 0xf6, 0x3d, 0x5f, 0xe2, 0xb5, 0xd7, 0x76, 0xc4, 0xee, 0x74, 0xe7, 0xb4, 0x1e, 0x2b, 0x09,
-	0x6d, 0xfb, 0xcb, 0x55, 0xe2, 0xeb, 0xd8, 0x2c, 0xdc, 0x1a, 0x5b, 0x5e, 0x8f, 0x55, 0xb8, 0xfb,
-	0x27, 0xd0, 0xdd, 0xf3, 0x22, 0x6d, 0x43, 0xe3, 0x8c, 0x2b, 0x3e, 0xa8, 0x51, 0x1b, 0xda, 0xe7,
-	0xa8, 0x78, 0xa0, 0x11, 0x99, 0x5e, 0xe4, 0x8f, 0x75, 0x8e, 0x62, 0x1d, 0xf9, 0x48, 0x5f, 0x43,
-	0xeb, 0x0d, 0x4f, 0x82, 0x18, 0xe9, 0xa1, 0xb1, 0xf7, 0x9e, 0xf4, 0xf0, 0xee, 0x3f, 0xcf, 0xf2,
-	0x47, 0xe4, 0xd6, 0x3c, 0xf2, 0x80, 0xbc, 0x18, 0x7c, 0xdf, 0x8e, 0xc8, 0x8f, 0xed, 0x88, 0xfc,
-	0xda, 0x8e, 0xc8, 0x97, 0xdf, 0xa3, 0xda, 0xc7, 0x96, 0xa9, 0x79, 0xf4, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x8d, 0x33, 0xad, 0xda, 0x3d, 0x04, 0x00, 0x00,
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x8e, 0xd3, 0x3e,
+	0x10, 0xae, 0x9b, 0xb6, 0xdb, 0x4e, 0xd3, 0xaa, 0xb2, 0x7e, 0xfa, 0x29, 0x94, 0xa5, 0xaa, 0x22,
+	0x21, 0x45, 0x1c, 0x0a, 0x94, 0x0b, 0x20, 0x38, 0x94, 0x5d, 0xfe, 0x09, 0x16, 0x21, 0xb7, 0xda,
+	0xbb, 0x49, 0x66, 0x43, 0xb4, 0x69, 0x12, 0x6c, 0xb7, 0x52, 0xdf, 0x04, 0xf1, 0x02, 0xbc, 0x0a,
+	0x47, 0x1e, 0x80, 0x03, 0x2a, 0x57, 0x1e, 0x02, 0xd9, 0xc9, 0x36, 0x4d, 0x05, 0x87, 0x3d, 0x65,
+	0xbe, 0xcf, 0x33, 0xe3, 0x6f, 0x26, 0x9f, 0xc1, 0xf6, 0xd3, 0xe5, 0x32, 0x4d, 0x26, 0x99, 0x48,
+	0x55, 0x4a, 0x7b, 0xe6, 0x73, 0x62, 0xa8, 0xf3, 0xfb, 0xee, 0x57, 0x02, 0xdd, 0x05, 0x97, 0x97,
+	0x0c, 0x3f, 0xad, 0x50, 0x2a, 0x7a, 0x0c, 0x1d, 0x91, 0x87, 0xaf, 0x4f, 0x1d, 0x32, 0x26, 0x5e,
+	0x87, 0x95, 0x04, 0x7d, 0x02, 0xdd, 0x02, 0x2c, 0x36, 0x19, 0x3a, 0xd6, 0x98, 0x78, 0xfd, 0xe9,
+	0x70, 0x52, 0x69, 0x39, 0x61, 0x65, 0x06, 0xdb, 0x4f, 0xa7, 0x2e, 0xd8, 0xd9, 0xc7, 0x8d, 0x8c,
+	0x7c, 0x1e, 0xbf, 0x8f, 0x79, 0xe2, 0x34, 0xc6, 0xc4, 0xb3, 0x59, 0x85, 0xa3, 0x0e, 0x1c, 0x65,
+	0x7c, 0x13, 0xa7, 0x3c, 0x70, 0x9a, 0xe6, 0xf8, 0x0a, 0xba, 0xbf, 0x09, 0xd8, 0xb9, 0x52, 0x99,
+	0xa5, 0x89, 0xc4, 0xeb, 0x49, 0xad, 0x5f, 0x4f, 0xea, 0x31, 0x74, 0xfc, 0x74, 0x99, 0xc5, 0xa8,
+	0x30, 0x30, 0x63, 0xb6, 0x59, 0x49, 0xd0, 0xff, 0xa1, 0x85, 0x42, 0x9c, 0xc9, 0xd0, 0x8c, 0xd0,
+	0x61, 0x05, 0xa2, 0x43, 0x68, 0x4b, 0x4c, 0x82, 0x45, 0xb4, 0x44, 0xa3, 0xde, 0x62, 0x3b, 0xbc,
+	0x3f, 0x58, 0xab, 0x32, 0x18, 0xfd, 0x0f, 0x9a, 0x52, 0x71, 0x25, 0x9d, 0x23, 0xc3, 0xe7, 0xc0,
+	0xfd, 0x41, 0xa0, 0xaf, 0x0b, 0xe7, 0x28, 0x22, 0x94, 0x6f, 0x23, 0xa9, 0x8a, 0x44, 0xa1, 0xcc,
+	0xb0, 0x16, 0xcb, 0x01, 0x1d, 0x80, 0x85, 0x49, 0x60, 0x06, 0xb4, 0x98, 0x0e, 0xb5, 0x8c, 0x28,
+	0x51, 0x28, 0xd6, 0x3c, 0x36, 0xda, 0x2d, 0xb6, 0xc3, 0x74, 0x06, 0x7d, 0x55, 0xe9, 0xea, 0x34,
+	0xc6, 0x96, 0xd7, 0x9d, 0xde, 0x38, 0xd8, 0x4c, 0x79, 0x35, 0x3b, 0x28, 0xa0, 0x27, 0xd0, 0xbb,
+	0x88, 0x30, 0x0e, 0x66, 0x61, 0x38, 0xcf, 0xd0, 0x97, 0x4e, 0xd3, 0x74, 0xb8, 0x75, 0xd0, 0x61,
+	0x16, 0x86, 0x02, 0x43, 0xae, 0x52, 0xa1, 0xb3, 0x58, 0xb5, 0xc6, 0xfd, 0x42, 0x00, 0xca, 0x3b,
+	0x28, 0x85, 0x86, 0xe2, 0xa1, 0x2c, 0x7e, 0xa3, 0x89, 0xe9, 0x53, 0x68, 0x99, 0x1a, 0xe9, 0xd4,
+	0xcd, 0x05, 0xb7, 0xff, 0x29, 0x71, 0xf2, 0xc2, 0xe4, 0x3d, 0x4f, 0x94, 0xd8, 0xb0, 0xa2, 0x68,
+	0xf8, 0x08, 0xba, 0x7b, 0xb4, 0x5e, 0xd3, 0x25, 0x6e, 0x8a, 0x0b, 0x74, 0xa8, 0xd7, 0xb9, 0xe6,
+	0xf1, 0x2a, 0xf7, 0x86, 0xcd, 0x72, 0xf0, 0xb8, 0xfe, 0x90, 0xb8, 0x19, 0xf4, 0xab, 0xea, 0xb5,
+	0x1f, 0x4c, 0xdb, 0x77, 0x7c, 0x89, 0x57, 0x5e, 0xdb, 0x11, 0xbb, 0xd3, 0x9d, 0xd3, 0x7a, 0xac,
+	0x24, 0xb4, 0xed, 0x2f, 0x56, 0x89, 0xaf, 0x63, 0xb3, 0x70, 0x6b, 0x6c, 0x79, 0x3d, 0x56, 0xe1,
+	0xee, 0xdc, 0x85, 0xee, 0x9e, 0x17, 0x69, 0x1b, 0x1a, 0xa7, 0x5c, 0xf1, 0x41, 0x8d, 0xda, 0xd0,
+	0x3e, 0x43, 0xc5, 0x03, 0x8d, 0x88, 0xe6, 0xdf, 0x44, 0x71, 0x3c, 0xa8, 0x4f, 0xcf, 0xf3, 0x67,
+	0x3b, 0x47, 0xb1, 0x8e, 0x7c, 0xa4, 0x2f, 0xa1, 0xf5, 0x8a, 0x27, 0x41, 0x8c, 0xf4, 0xd0, 0xe2,
+	0x7b, 0x8f, 0x7b, 0x78, 0xf3, 0xaf, 0x67, 0xf9, 0x73, 0x72, 0x6b, 0x1e, 0xb9, 0x47, 0x9e, 0x0d,
+	0xbe, 0x6d, 0x47, 0xe4, 0xfb, 0x76, 0x44, 0x7e, 0x6e, 0x47, 0xe4, 0xf3, 0xaf, 0x51, 0xed, 0x43,
+	0xcb, 0xd4, 0x3c, 0xf8, 0x33, 0x00, 0xbe, 0xae, 0x95, 0xef, 0x47, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
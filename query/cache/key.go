@@ -0,0 +1,76 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cache
+
+import (
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// NewKey returns the cache key of query, the query is normalized without time range and explain flag,
+// so that the same query with different time range(like time > now()-1h) shares the cached result.
+func NewKey(database string, statement *stmt.Query) string {
+	normalized := *statement
+	normalized.Explain = false
+	normalized.TimeRange = timeutil.TimeRange{}
+	data, _ := normalized.MarshalJSON()
+	return database + ":" + string(data)
+}
+
+// IsCacheable checks if the result of query can be cached by time slices,
+// each point of result must be calculated only by the data of its own time slot,
+// and the series of result cannot be selected/filtered by the data of whole time range.
+func IsCacheable(statement *stmt.Query) bool {
+	if statement == nil || statement.IsSubQuery() || statement.IsJoin() ||
+		statement.Interval <= 0 || statement.TimeRange.IsEmpty() ||
+		statement.Having != nil || len(statement.OrderByItems) > 0 || statement.Others != "" {
+		return false
+	}
+	if statement.Fill != stmt.FillNone && statement.Fill != stmt.FillNull {
+		return false
+	}
+	for _, item := range statement.SelectItems {
+		if !isCacheableExpr(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// isCacheableExpr checks if the expression evaluates each point independently.
+func isCacheableExpr(expr stmt.Expr) bool {
+	switch e := expr.(type) {
+	case *stmt.SelectItem:
+		return isCacheableExpr(e.Expr)
+	case *stmt.ParenExpr:
+		return isCacheableExpr(e.Expr)
+	case *stmt.BinaryExpr:
+		return isCacheableExpr(e.Left) && isCacheableExpr(e.Right)
+	case *stmt.CallExpr:
+		if function.IsRangeFunc(e.FuncType) || function.IsSelectorFunc(e.FuncType) {
+			return false
+		}
+		for _, param := range e.Params {
+			if !isCacheableExpr(param) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestNewKey(t *testing.T) {
+	q1 := newQuery(timeutil.TimeRange{Start: 10, End: 20})
+	q2 := newQuery(timeutil.TimeRange{Start: 30, End: 40})
+	q2.Explain = true
+	assert.Equal(t, NewKey("db", q1), NewKey("db", q2))
+	assert.NotEqual(t, NewKey("db", q1), NewKey("db2", q1))
+	q2.GroupBy = []string{"host"}
+	assert.NotEqual(t, NewKey("db", q1), NewKey("db", q2))
+	// time range not changed
+	assert.Equal(t, timeutil.TimeRange{Start: 10, End: 20}, q1.TimeRange)
+}
+
+func TestIsCacheable(t *testing.T) {
+	callExpr := func(funcType function.FuncType) stmt.Expr {
+		return &stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: funcType, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}}}
+	}
+	cases := []struct {
+		name      string
+		prepare   func(q *stmt.Query)
+		cacheable bool
+	}{
+		{name: "simple query", cacheable: true},
+		{
+			name: "math expression",
+			prepare: func(q *stmt.Query) {
+				q.SelectItems = []stmt.Expr{&stmt.SelectItem{Expr: &stmt.BinaryExpr{
+					Left:  &stmt.ParenExpr{Expr: &stmt.CallExpr{FuncType: function.Abs, Params: []stmt.Expr{callExpr(function.Sum)}}},
+					Right: &stmt.NumberLiteral{Val: 100},
+				}}}
+			},
+			cacheable: true,
+		},
+		{name: "no interval", prepare: func(q *stmt.Query) { q.Interval = 0 }},
+		{name: "empty time range", prepare: func(q *stmt.Query) { q.TimeRange = timeutil.TimeRange{} }},
+		{name: "sub query", prepare: func(q *stmt.Query) { q.SubQuery = &stmt.Query{} }},
+		{name: "having", prepare: func(q *stmt.Query) { q.Having = &stmt.BinaryExpr{} }},
+		{name: "order by", prepare: func(q *stmt.Query) { q.OrderByItems = []stmt.Expr{&stmt.OrderByExpr{}} }},
+		{name: "fill previous", prepare: func(q *stmt.Query) { q.Fill = stmt.FillPrevious }},
+		{name: "fill null", prepare: func(q *stmt.Query) { q.Fill = stmt.FillNull }, cacheable: true},
+		{name: "range function", prepare: func(q *stmt.Query) { q.SelectItems = []stmt.Expr{callExpr(function.Increase)} }},
+		{name: "topk", prepare: func(q *stmt.Query) { q.SelectItems = []stmt.Expr{callExpr(function.TopK)} }},
+		{
+			name: "range function in expression",
+			prepare: func(q *stmt.Query) {
+				q.SelectItems = []stmt.Expr{&stmt.BinaryExpr{Left: callExpr(function.Sum), Right: callExpr(function.Delta)}}
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			q := newQuery(timeutil.TimeRange{Start: 10, End: 20})
+			if tt.prepare != nil {
+				tt.prepare(q)
+			}
+			assert.Equal(t, tt.cacheable, IsCacheable(q))
+		})
+	}
+	assert.False(t, IsCacheable(nil))
+}
+
+func newQuery(timeRange timeutil.TimeRange) *stmt.Query {
+	return &stmt.Query{
+		MetricName: "cpu",
+		SelectItems: []stmt.Expr{&stmt.SelectItem{
+			Expr: &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+		}},
+		TimeRange: timeRange,
+		Interval:  timeutil.Interval(10 * timeutil.OneSecond),
+		Limit:     100,
+	}
+}
@@ -0,0 +1,441 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cache
+
+import (
+	"container/list"
+	"sort"
+	"sync"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+//go:generate mockgen -source=./result_cache.go -destination=./result_cache_mock.go -package=cache
+
+// for testing
+var (
+	nowFn = timeutil.Now
+)
+
+var (
+	rCache           ResultCache
+	once4ResultCache sync.Once
+)
+
+const (
+	seriesOverhead = 64 // estimated memory size of series/field struct
+	pointSize      = 16 // timestamp(int64) + value(float64)
+)
+
+// ResultCache represents the query result cache of broker.
+// The time range of query is split by the families of database, the result of completed families is cached,
+// so that only the open tail of query time range need to be queried from storage.
+// Family is completed after family end time + write behind window of database + close delay,
+// because all brokers drop the rows older than write behind window, no more data can be written into it.
+type ResultCache interface {
+	// Lookup looks up the cached result of the leading completed families in the time range of query,
+	// returns nil if cache disabled or the result of query cannot be cached.
+	Lookup(database *models.Database, statement *stmt.Query) *Lookup
+	// Invalidate removes all cached results of database, invoked after series of database deleted(delete series/drop metric).
+	// Storage nodes apply the deletion asynchronously, so the result queried within family close delay after invalidated
+	// is not cached either.
+	Invalidate(database string)
+}
+
+// GetResultCache returns a singleton ResultCache instance.
+func GetResultCache() ResultCache {
+	if rCache != nil {
+		return rCache
+	}
+	once4ResultCache.Do(func() {
+		rCache = newResultCache()
+	})
+	return rCache
+}
+
+// familySlice represents the cached result of a completed family.
+type familySlice struct {
+	start    int64 // start time of cached points, may be greater than family start time
+	end      int64 // end time of family
+	cachedAt int64 // query time when the result queried from storage
+	series   []*models.Series
+	size     int
+}
+
+// entry represents the cached result of a normalized query.
+type entry struct {
+	key      string
+	database string
+	families map[int64]*familySlice // family start time => cached result of family
+	size     int
+	elem     *list.Element
+}
+
+// resultCache implements ResultCache interface.
+type resultCache struct {
+	entries     map[string]*entry
+	lru         *list.List // front is the most recently used entry
+	size        int
+	invalidated map[string]int64 // database => last invalidated time
+
+	statistics *metrics.QueryCacheStatistics
+
+	mutex sync.Mutex
+}
+
+// newResultCache creates a ResultCache instance.
+func newResultCache() ResultCache {
+	return &resultCache{
+		entries:     make(map[string]*entry),
+		lru:         list.New(),
+		invalidated: make(map[string]int64),
+		statistics:  metrics.NewQueryCacheStatistics(),
+	}
+}
+
+// Lookup looks up the cached result of the leading completed families in the time range of query,
+// returns nil if cache disabled or the result of query cannot be cached.
+func (c *resultCache) Lookup(database *models.Database, statement *stmt.Query) *Lookup {
+	cfg := config.GlobalBrokerConfig().QueryCache
+	if !cfg.Enabled || database == nil || database.Option == nil || len(database.Option.Intervals) == 0 ||
+		!IsCacheable(statement) {
+		return nil
+	}
+	var behind timeutil.Interval
+	if err := behind.ValueOf(database.Option.Behind); err != nil || behind <= 0 {
+		// rows of any family can be written if write behind window not limited, family never completed
+		return nil
+	}
+	// families are split by the smallest interval(write interval) of database
+	calculator := database.Option.Intervals[0].Interval.Calculator()
+	lookup := &Lookup{
+		cache:      c,
+		key:        NewKey(database.Name, statement),
+		database:   database.Name,
+		calculator: calculator,
+		timeRange:  statement.TimeRange,
+		interval:   statement.Interval.Int64(),
+		behind:     behind.Int64(),
+		limit:      statement.Limit,
+		fetchStart: statement.TimeRange.Start,
+		now:        nowFn(),
+		series:     make(map[string]*models.Series),
+	}
+	lookup.lookup(cfg.TTL.Duration().Milliseconds())
+	return lookup
+}
+
+// Invalidate removes all cached results of database, invoked after series of database deleted(delete series/drop metric).
+func (c *resultCache) Invalidate(database string) {
+	if !config.GlobalBrokerConfig().QueryCache.Enabled {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// the result queried before invalidated cannot be cached
+	c.invalidated[database] = nowFn()
+	for _, e := range c.entries {
+		if e.database == database {
+			c.removeEntry(e)
+			c.statistics.Invalidations.Incr()
+		}
+	}
+	c.statistics.Size.Update(float64(c.size))
+}
+
+// touch returns the entry of key, creates it if not exist, then moves it to the front of lru list.
+func (c *resultCache) touch(key, database string) *entry {
+	e, ok := c.entries[key]
+	if !ok {
+		e = &entry{
+			key:      key,
+			database: database,
+			families: make(map[int64]*familySlice),
+		}
+		e.elem = c.lru.PushFront(e)
+		c.entries[key] = e
+		return e
+	}
+	c.lru.MoveToFront(e.elem)
+	return e
+}
+
+// removeFamily removes the cached result of family from entry.
+func (c *resultCache) removeFamily(e *entry, familyTime int64) {
+	slice, ok := e.families[familyTime]
+	if !ok {
+		return
+	}
+	delete(e.families, familyTime)
+	e.size -= slice.size
+	c.size -= slice.size
+}
+
+// removeEntry removes the entry from cache.
+func (c *resultCache) removeEntry(e *entry) {
+	c.lru.Remove(e.elem)
+	delete(c.entries, e.key)
+	c.size -= e.size
+}
+
+// evict removes the least recently used entries until cache size not exceeds max size.
+func (c *resultCache) evict(maxSize int) {
+	for c.size > maxSize && c.lru.Len() > 0 {
+		e := c.lru.Back().Value.(*entry)
+		c.removeEntry(e)
+		c.statistics.Evictions.Incr()
+	}
+	c.statistics.Size.Update(float64(c.size))
+}
+
+// Lookup represents the lookup result of query result cache for a query.
+type Lookup struct {
+	cache      *resultCache
+	key        string
+	database   string
+	calculator timeutil.IntervalCalculator
+	timeRange  timeutil.TimeRange // origin time range of query
+	interval   int64
+	behind     int64 // write behind window of database
+	limit      int
+	fetchStart int64 // start time of the open tail which need to be queried from storage
+	now        int64 // lookup time, only the families completed before it can be cached
+	series     map[string]*models.Series
+	hits       int
+	misses     int
+}
+
+// FetchStart returns the start time of the open tail which need to be queried from storage.
+func (l *Lookup) FetchStart() int64 {
+	return l.fetchStart
+}
+
+// Stats returns the hit/miss stats of query result cache.
+func (l *Lookup) Stats() *models.CacheStats {
+	return &models.CacheStats{Hits: l.hits, Misses: l.misses}
+}
+
+// lookup collects the cached result of the leading families, stops at the first family not cached.
+func (l *Lookup) lookup(ttl int64) {
+	c := l.cache
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if e, ok := c.entries[l.key]; ok {
+		c.lru.MoveToFront(e.elem)
+		cursor := l.timeRange.Start
+		for cursor <= l.timeRange.End {
+			familyTime := l.calculator.CalcFamilyTime(cursor)
+			slice, ok := e.families[familyTime]
+			if !ok || slice.start > cursor {
+				break
+			}
+			if l.now-slice.cachedAt >= ttl {
+				// cached result expired
+				c.removeFamily(e, familyTime)
+				break
+			}
+			next := slice.end + 1
+			// keep the family which includes query end time as open tail,
+			// and the tail must be aligned with query interval.
+			if next > l.timeRange.End || next%l.interval != 0 {
+				break
+			}
+			l.collect(slice, cursor)
+			l.hits++
+			cursor = next
+		}
+		l.fetchStart = cursor
+	}
+	l.misses = l.countFamilies(l.fetchStart, l.timeRange.End)
+	c.statistics.Hits.Add(float64(l.hits))
+	c.statistics.Misses.Add(float64(l.misses))
+}
+
+// collect copies the points of cached family which timestamp >= start.
+func (l *Lookup) collect(slice *familySlice, start int64) {
+	for _, cached := range slice.series {
+		series, ok := l.series[cached.TagValues]
+		if !ok {
+			series = models.NewSeries(cached.Tags, cached.TagValues)
+			l.series[cached.TagValues] = series
+		}
+		for fieldName, points := range cached.Fields {
+			dst, ok := series.Fields[fieldName]
+			if !ok {
+				dst = make(map[int64]float64, len(points))
+				series.Fields[fieldName] = dst
+			}
+			for timestamp, value := range points {
+				if timestamp >= start {
+					dst[timestamp] = value
+				}
+			}
+		}
+	}
+}
+
+// countFamilies returns the num. of families in time range.
+func (l *Lookup) countFamilies(start, end int64) (count int) {
+	for cursor := start; cursor <= end; count++ {
+		cursor = l.calculator.CalcFamilyEndTime(l.calculator.CalcFamilyTime(cursor)) + 1
+	}
+	return
+}
+
+// Merge caches the completed families of result set queried from storage(time range: [fetch start, end]),
+// then merges the cached result into the result set.
+func (l *Lookup) Merge(rs *models.ResultSet) {
+	if rs == nil {
+		return
+	}
+	l.store(rs)
+	if l.hits == 0 {
+		return
+	}
+	index := make(map[string]*models.Series, len(rs.Series))
+	for _, series := range rs.Series {
+		index[series.TagValues] = series
+	}
+	fields := make(map[string]struct{})
+	for _, fieldName := range rs.Fields {
+		fields[fieldName] = struct{}{}
+	}
+	for tagValues, cached := range l.series {
+		series, ok := index[tagValues]
+		if !ok {
+			series = models.NewSeries(cached.Tags, tagValues)
+			rs.AddSeries(series)
+		}
+		for fieldName, points := range cached.Fields {
+			series.AddField(fieldName, &models.Points{Points: points})
+			fields[fieldName] = struct{}{}
+		}
+	}
+	sort.Slice(rs.Series, func(i, j int) bool {
+		return rs.Series[i].TagValues < rs.Series[j].TagValues
+	})
+	if l.limit > 0 && len(rs.Series) > l.limit {
+		rs.Series = rs.Series[:l.limit]
+	}
+	rs.Fields = rs.Fields[:0]
+	for fieldName := range fields {
+		rs.Fields = append(rs.Fields, fieldName)
+	}
+	sort.Strings(rs.Fields)
+	rs.StartTime = l.timeRange.Start
+	rs.EndTime = l.timeRange.End
+	rs.Interval = l.interval
+}
+
+// store caches the result of completed families which are queried from storage.
+func (l *Lookup) store(rs *models.ResultSet) {
+	if l.limit > 0 && len(rs.Series) >= l.limit {
+		// series may be truncated by limit
+		return
+	}
+	cfg := config.GlobalBrokerConfig().QueryCache
+	closeDelay := cfg.FamilyCloseDelay.Duration().Milliseconds()
+	var slices []*familySlice
+	cursor := l.fetchStart
+	for cursor <= l.timeRange.End {
+		familyTime := l.calculator.CalcFamilyTime(cursor)
+		familyEnd := l.calculator.CalcFamilyEndTime(familyTime)
+		if familyEnd >= l.timeRange.End || familyEnd+l.behind+closeDelay >= l.now {
+			// family not included in result set completely or family not completed(rows still can be written)
+			break
+		}
+		slices = append(slices, newFamilySlice(rs, cursor, familyEnd, l.now))
+		cursor = familyEnd + 1
+	}
+	if len(slices) == 0 {
+		return
+	}
+
+	c := l.cache
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if invalidated, ok := c.invalidated[l.database]; ok && invalidated+closeDelay >= l.now {
+		// series deleted during query or deletion may not be applied by storage nodes yet, cannot cache the result
+		return
+	}
+	ttl := cfg.TTL.Duration().Milliseconds()
+	e := c.touch(l.key, l.database)
+	for familyTime, slice := range e.families {
+		if l.now-slice.cachedAt >= ttl {
+			c.removeFamily(e, familyTime)
+		}
+	}
+	for _, slice := range slices {
+		familyTime := l.calculator.CalcFamilyTime(slice.start)
+		c.removeFamily(e, familyTime)
+		e.families[familyTime] = slice
+		e.size += slice.size
+		c.size += slice.size
+	}
+	if len(e.families) == 0 {
+		c.removeEntry(e)
+	}
+	c.evict(int(cfg.MaxSize))
+}
+
+// newFamilySlice creates the cached result of family with the points in time range [start, end].
+func newFamilySlice(rs *models.ResultSet, start, end, cachedAt int64) *familySlice {
+	slice := &familySlice{
+		start:    start,
+		end:      end,
+		cachedAt: cachedAt,
+	}
+	for _, series := range rs.Series {
+		var cached *models.Series
+		for fieldName, points := range series.Fields {
+			var cachedPoints map[int64]float64
+			for timestamp, value := range points {
+				if timestamp < start || timestamp > end {
+					continue
+				}
+				if cachedPoints == nil {
+					cachedPoints = make(map[int64]float64)
+				}
+				cachedPoints[timestamp] = value
+			}
+			if cachedPoints == nil {
+				continue
+			}
+			if cached == nil {
+				cached = models.NewSeries(series.Tags, series.TagValues)
+				slice.size += seriesOverhead + len(series.TagValues)
+				for k, v := range series.Tags {
+					slice.size += len(k) + len(v)
+				}
+			}
+			cached.Fields[fieldName] = cachedPoints
+			slice.size += seriesOverhead + len(fieldName) + len(cachedPoints)*pointSize
+		}
+		if cached != nil {
+			slice.series = append(slice.series, cached)
+		}
+	}
+	return slice
+}
@@ -0,0 +1,254 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+var testDatabase = &models.Database{
+	Name: "db",
+	Option: &option.DatabaseOption{
+		Intervals: option.Intervals{{Interval: timeutil.Interval(10 * timeutil.OneSecond)}},
+		Behind:    "10m",
+	},
+}
+
+// prepareCache enables query result cache, returns the start time of current family(hour).
+func prepareCache(t *testing.T, prepare func(cfg *config.QueryCache)) (cache *resultCache, family int64) {
+	cfg := config.NewDefaultBrokerBase()
+	cfg.QueryCache.Enabled = true
+	if prepare != nil {
+		prepare(&cfg.QueryCache)
+	}
+	config.SetGlobalBrokerConfig(cfg)
+	family = timeutil.Interval(10 * timeutil.OneSecond).Calculator().CalcFamilyTime(timeutil.Now())
+	now := family + 30*timeutil.OneMinute
+	nowFn = func() int64 {
+		return now
+	}
+	t.Cleanup(func() {
+		config.SetGlobalBrokerConfig(config.NewDefaultBrokerBase())
+		nowFn = timeutil.Now
+	})
+	return newResultCache().(*resultCache), family
+}
+
+func newResultSet(points map[string][]int64) *models.ResultSet {
+	rs := &models.ResultSet{}
+	for tagValues, timestamps := range points {
+		series := models.NewSeries(map[string]string{"host": tagValues}, tagValues)
+		p := models.NewPoints()
+		for _, timestamp := range timestamps {
+			p.AddPoint(timestamp, 1)
+		}
+		series.AddField("f", p)
+		rs.AddSeries(series)
+	}
+	return rs
+}
+
+func TestGetResultCache(t *testing.T) {
+	assert.NotNil(t, GetResultCache())
+	assert.Equal(t, GetResultCache(), GetResultCache())
+}
+
+func TestResultCache_Lookup_Merge(t *testing.T) {
+	c, family := prepareCache(t, nil)
+	f1 := family - timeutil.OneHour
+	f0 := f1 - timeutil.OneHour
+	now := nowFn()
+	start := f0 + 30*timeutil.OneMinute
+
+	// cache miss, query all families from storage
+	l := c.Lookup(testDatabase, newQuery(timeutil.TimeRange{Start: start, End: now}))
+	assert.NotNil(t, l)
+	assert.Equal(t, start, l.FetchStart())
+	assert.Equal(t, &models.CacheStats{Hits: 0, Misses: 3}, l.Stats())
+	l.Merge(newResultSet(map[string][]int64{
+		"a": {start, f1 + 10*timeutil.OneSecond, family + 10*timeutil.OneSecond},
+		"b": {family + 10*timeutil.OneSecond},
+	}))
+	// completed families(f0/f1) cached, current family not completed
+	assert.Len(t, c.entries, 1)
+	for _, e := range c.entries {
+		assert.Len(t, e.families, 2)
+		assert.Equal(t, start, e.families[f0].start)
+		assert.Equal(t, f1, e.families[f1].start)
+		assert.Equal(t, c.size, e.size)
+	}
+
+	// dashboard refreshes 10s later, f0/f1 hit, only query current family
+	start2 := start + 10*timeutil.OneSecond
+	q := newQuery(timeutil.TimeRange{Start: start2, End: now + 10*timeutil.OneSecond})
+	q.Explain = true
+	l = c.Lookup(testDatabase, q)
+	assert.Equal(t, family, l.FetchStart())
+	assert.Equal(t, &models.CacheStats{Hits: 2, Misses: 1}, l.Stats())
+	rs := newResultSet(map[string][]int64{
+		"b": {family + 20*timeutil.OneSecond},
+		"c": {family + 30*timeutil.OneSecond},
+	})
+	l.Merge(rs)
+	assert.Equal(t, start2, rs.StartTime)
+	assert.Equal(t, now+10*timeutil.OneSecond, rs.EndTime)
+	assert.Equal(t, int64(10*timeutil.OneSecond), rs.Interval)
+	assert.Equal(t, []string{"f"}, rs.Fields)
+	assert.Len(t, rs.Series, 3)
+	// points before query start time are dropped
+	assert.Equal(t, "a", rs.Series[0].TagValues)
+	assert.Equal(t, map[int64]float64{f1 + 10*timeutil.OneSecond: 1}, rs.Series[0].Fields["f"])
+	assert.Equal(t, map[int64]float64{family + 20*timeutil.OneSecond: 1}, rs.Series[1].Fields["f"])
+
+	// query start time before cached range, cache miss
+	l = c.Lookup(testDatabase, newQuery(timeutil.TimeRange{Start: f0, End: now}))
+	assert.Equal(t, f0, l.FetchStart())
+	// query end time in cached family, keep it as tail
+	l = c.Lookup(testDatabase, newQuery(timeutil.TimeRange{Start: start2, End: f1 + 10*timeutil.OneSecond}))
+	assert.Equal(t, f1, l.FetchStart())
+	assert.Equal(t, &models.CacheStats{Hits: 1, Misses: 1}, l.Stats())
+}
+
+func TestResultCache_Invalidate(t *testing.T) {
+	c, family := prepareCache(t, nil)
+	f1 := family - timeutil.OneHour
+	f0 := f1 - timeutil.OneHour
+	now := nowFn()
+	q := newQuery(timeutil.TimeRange{Start: f0, End: now})
+	rs := newResultSet(map[string][]int64{"a": {f0, f1}})
+	c.Lookup(testDatabase, q).Merge(rs)
+	other := &models.Database{Name: "other", Option: testDatabase.Option}
+	c.Lookup(other, q).Merge(rs)
+	assert.Len(t, c.entries, 2)
+
+	// series of database deleted
+	c.Invalidate("db")
+	assert.Len(t, c.entries, 1)
+	l := c.Lookup(testDatabase, q)
+	assert.Equal(t, f0, l.FetchStart())
+	assert.Equal(t, &models.CacheStats{Hits: 0, Misses: 3}, l.Stats())
+	// series deleted during query, cannot cache the result
+	l.Merge(rs)
+	assert.Len(t, c.entries, 1)
+	// query after invalidated, but deletion may not be applied by storage nodes yet, cannot cache the result
+	closeDelay := config.GlobalBrokerConfig().QueryCache.FamilyCloseDelay.Duration().Milliseconds()
+	nowFn = func() int64 {
+		return now + timeutil.OneSecond
+	}
+	c.Lookup(testDatabase, q).Merge(rs)
+	assert.Len(t, c.entries, 1)
+	nowFn = func() int64 {
+		return now + closeDelay
+	}
+	c.Lookup(testDatabase, q).Merge(rs)
+	assert.Len(t, c.entries, 1)
+	// query after grace period of invalidation
+	nowFn = func() int64 {
+		return now + closeDelay + 1
+	}
+	c.Lookup(testDatabase, q).Merge(rs)
+	assert.Len(t, c.entries, 2)
+
+	// cache disabled
+	config.GlobalBrokerConfig().QueryCache.Enabled = false
+	c.Invalidate("db")
+	assert.Len(t, c.entries, 2)
+	assert.Nil(t, c.Lookup(testDatabase, q))
+}
+
+func TestResultCache_Store(t *testing.T) {
+	t.Run("not cacheable", func(t *testing.T) {
+		c, _ := prepareCache(t, nil)
+		q := newQuery(timeutil.TimeRange{Start: 10, End: 20})
+		q.Having = &stmt.BinaryExpr{}
+		assert.Nil(t, c.Lookup(testDatabase, q))
+		assert.Nil(t, c.Lookup(nil, newQuery(timeutil.TimeRange{Start: 10, End: 20})))
+		assert.Nil(t, c.Lookup(&models.Database{Name: "db"}, newQuery(timeutil.TimeRange{Start: 10, End: 20})))
+	})
+	t.Run("series truncated by limit", func(t *testing.T) {
+		c, family := prepareCache(t, nil)
+		q := newQuery(timeutil.TimeRange{Start: family - timeutil.OneHour, End: nowFn()})
+		q.Limit = 1
+		c.Lookup(testDatabase, q).Merge(newResultSet(map[string][]int64{"a": {family - timeutil.OneHour}}))
+		assert.Empty(t, c.entries)
+		c.Lookup(testDatabase, q).Merge(nil)
+		assert.Empty(t, c.entries)
+	})
+	t.Run("family not completed", func(t *testing.T) {
+		c, family := prepareCache(t, func(cfg *config.QueryCache) {
+			cfg.FamilyCloseDelay = ltoml.Duration(time.Hour)
+		})
+		q := newQuery(timeutil.TimeRange{Start: family - timeutil.OneHour, End: nowFn()})
+		c.Lookup(testDatabase, q).Merge(newResultSet(map[string][]int64{"a": {family - timeutil.OneHour}}))
+		assert.Empty(t, c.entries)
+	})
+	t.Run("family in write behind window", func(t *testing.T) {
+		c, family := prepareCache(t, nil)
+		db := &models.Database{Name: "db", Option: &option.DatabaseOption{
+			Intervals: testDatabase.Option.Intervals,
+			Behind:    "1h",
+		}}
+		q := newQuery(timeutil.TimeRange{Start: family - timeutil.OneHour, End: nowFn()})
+		c.Lookup(db, q).Merge(newResultSet(map[string][]int64{"a": {family - timeutil.OneHour}}))
+		assert.Empty(t, c.entries)
+		// write behind window not limited, family never completed
+		db.Option = &option.DatabaseOption{Intervals: testDatabase.Option.Intervals}
+		assert.Nil(t, c.Lookup(db, q))
+	})
+	t.Run("cache expired", func(t *testing.T) {
+		c, family := prepareCache(t, nil)
+		now := nowFn()
+		q := newQuery(timeutil.TimeRange{Start: family - timeutil.OneHour, End: now})
+		c.Lookup(testDatabase, q).Merge(newResultSet(map[string][]int64{"a": {family - timeutil.OneHour}}))
+		assert.Len(t, c.entries, 1)
+		nowFn = func() int64 {
+			return now + time.Hour.Milliseconds()
+		}
+		l := c.Lookup(testDatabase, q)
+		assert.Equal(t, q.TimeRange.Start, l.FetchStart())
+		assert.Empty(t, c.entries[l.key].families)
+		// remove expired families when storing
+		l = c.Lookup(testDatabase, newQuery(timeutil.TimeRange{Start: family, End: now + time.Hour.Milliseconds()}))
+		l.Merge(newResultSet(map[string][]int64{"a": {family}}))
+		assert.Len(t, c.entries[l.key].families, 1)
+	})
+	t.Run("evict least recently used query", func(t *testing.T) {
+		c, family := prepareCache(t, func(cfg *config.QueryCache) {
+			cfg.MaxSize = 200
+		})
+		q1 := newQuery(timeutil.TimeRange{Start: family - timeutil.OneHour, End: nowFn()})
+		c.Lookup(testDatabase, q1).Merge(newResultSet(map[string][]int64{"a": {family - timeutil.OneHour}}))
+		assert.Len(t, c.entries, 1)
+		q2 := newQuery(timeutil.TimeRange{Start: family - timeutil.OneHour, End: nowFn()})
+		q2.GroupBy = []string{"host"}
+		c.Lookup(testDatabase, q2).Merge(newResultSet(map[string][]int64{"a": {family - timeutil.OneHour}}))
+		assert.Len(t, c.entries, 1)
+		_, ok := c.entries[NewKey("db", q2)]
+		assert.True(t, ok)
+	})
+}
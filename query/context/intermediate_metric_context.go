@@ -21,6 +21,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/models"
@@ -88,6 +89,7 @@ func (ctx *IntermediateMetricContext) MakePlan() error {
 	}
 
 	calcTimeRangeAndInterval(ctx.statement, databaseCfg)
+	pruneShardsByRouting(ctx.stateMgr, databaseCfg, ctx.statement.Condition, physicalPlans)
 
 	payload, _ := ctx.statement.MarshalJSON()
 	for _, physicalPlan := range physicalPlans {
@@ -119,8 +121,13 @@ func (ctx *IntermediateMetricContext) makeTaskResponse() *protoCommonV1.TaskResp
 	}
 	var timeSeriesList []*protoCommonV1.TimeSeries
 	if ctx.groupAgg != nil {
+		droppedTags := ctx.filterByHaving()
 		groupIts := ctx.groupAgg.ResultSet()
 		for _, itr := range groupIts {
+			tags := itr.Tags()
+			if _, ok := droppedTags[tags]; ok {
+				continue
+			}
 			fields := make(map[string][]byte)
 			for itr.HasNext() {
 				fieldItr := itr.Next()
@@ -133,7 +140,7 @@ func (ctx *IntermediateMetricContext) makeTaskResponse() *protoCommonV1.TaskResp
 			if len(fields) > 0 {
 				// always have group by
 				timeSeriesList = append(timeSeriesList, &protoCommonV1.TimeSeries{
-					Tags:   itr.Tags(),
+					Tags:   tags,
 					Fields: fields,
 				})
 			}
@@ -161,3 +168,22 @@ func (ctx *IntermediateMetricContext) makeTaskResponse() *protoCommonV1.TaskResp
 		Payload:     data,
 	}
 }
+
+// filterByHaving evaluates having condition for each grouped time series,
+// returns the tags of time series which not match having condition.
+func (ctx *IntermediateMetricContext) filterByHaving() map[string]struct{} {
+	having := aggregation.NewHavingFilter(ctx.statement.Having)
+	if having == nil {
+		return nil
+	}
+	droppedTags := make(map[string]struct{})
+	groupIts := ctx.groupAgg.ResultSet()
+	for _, itr := range groupIts {
+		expression := newExpressionFn(ctx.timeRange, ctx.interval, having.Operands())
+		expression.Eval(itr)
+		if !having.Filter(expression.ResultSet()) {
+			droppedTags[itr.Tags()] = struct{}{}
+		}
+	}
+	return droppedTags
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
@@ -160,3 +161,52 @@ func TestIntermediateMetricContext_makeTaskResponse(t *testing.T) {
 	resp := metricCtx.makeTaskResponse()
 	assert.NotNil(t, resp)
 }
+
+func TestIntermediateMetricContext_makeTaskResponse_having(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newExpressionFn = aggregation.NewExpression
+		ctrl.Finish()
+	}()
+	expr := aggregation.NewMockExpression(ctrl)
+	newExpressionFn = func(_ timeutil.TimeRange, _ int64, _ []stmt.Expr) aggregation.Expression {
+		return expr
+	}
+	metricCtx := NewIntermediateMetricContext(context.TODO(), nil, nil,
+		&protoCommonV1.TaskRequest{}, models.StatelessNode{}, &models.PhysicalPlan{},
+		&stmt.Query{
+			Having: &stmt.BinaryExpr{
+				Left:     &stmt.FieldExpr{Name: "f"},
+				Operator: stmt.GREATER,
+				Right:    &stmt.NumberLiteral{Val: 5},
+			},
+		}, []string{"root"})
+	groupAgg := aggregation.NewMockGroupingAggregator(ctrl)
+	groupIt1 := series.NewMockGroupedIterator(ctrl)
+	groupIt2 := series.NewMockGroupedIterator(ctrl)
+	it := series.NewMockIterator(ctrl)
+	groupAgg.EXPECT().ResultSet().Return(series.GroupedIterators{groupIt1, groupIt2}).Times(2)
+	groupIt1.EXPECT().Tags().Return("a").AnyTimes()
+	groupIt2.EXPECT().Tags().Return("b").AnyTimes()
+	newValues := func(val float64) *collections.FloatArray {
+		values := collections.NewFloatArray(3)
+		values.SetValue(1, val)
+		return values
+	}
+	expr.EXPECT().Eval(gomock.Any()).Times(2)
+	expr.EXPECT().ResultSet().Return(map[string]*collections.FloatArray{"f": newValues(10)})
+	expr.EXPECT().ResultSet().Return(map[string]*collections.FloatArray{"f": newValues(1)})
+	// only group a matches having condition
+	groupIt1.EXPECT().HasNext().Return(true)
+	groupIt1.EXPECT().Next().Return(it)
+	it.EXPECT().MarshalBinary().Return([]byte{1, 2, 2}, nil)
+	it.EXPECT().FieldName().Return(field.Name("f"))
+	groupIt1.EXPECT().HasNext().Return(false)
+	metricCtx.groupAgg = groupAgg
+	resp := metricCtx.makeTaskResponse()
+	assert.NotNil(t, resp)
+	seriesList := &protoCommonV1.TimeSeriesList{}
+	assert.NoError(t, seriesList.Unmarshal(resp.Payload))
+	assert.Len(t, seriesList.TimeSeriesList, 1)
+	assert.Equal(t, "a", seriesList.TimeSeriesList[0].Tags)
+}
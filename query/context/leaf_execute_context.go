@@ -107,7 +107,7 @@ func (ctx *LeafExecuteContext) waitCollectGroupingTagsCompleted() (err error) {
 		// if it has grouping tag value ids, need wait collect group by tag values completed
 		select {
 		case <-ctx.TaskCtx.Ctx.Done():
-			err = ctx.TaskCtx.Ctx.Err()
+			err = ctx.TaskCtx.Err()
 			return
 		case <-ctx.GroupingCtx.collectGroupingTagsCompleted:
 		}
@@ -68,8 +68,8 @@ func (ctx *MetadataContext) WaitResponse() (any, error) {
 	case <-ctx.doneCh:
 		// received all data, break for loop
 		return ctx.results, ctx.err
-	case <-ctx.Deps.Ctx.Done():
-		return nil, constants.ErrTimeout
+	case <-ctx.done():
+		return nil, ctx.abortErr()
 	}
 }
 
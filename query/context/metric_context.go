@@ -25,7 +25,6 @@ import (
 
 	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/aggregation/function"
-	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
@@ -72,8 +71,8 @@ func (ctx *MetricContext) waitResponse() error {
 			return ctx.err
 		}
 		return nil
-	case <-ctx.ctx.Done():
-		return constants.ErrTimeout
+	case <-ctx.done():
+		return ctx.abortErr()
 	}
 }
 
@@ -96,6 +95,10 @@ func (ctx *MetricContext) handleResponse(resp *protoCommonV1.TaskResponse, fromN
 	if ignoreResponse {
 		return
 	}
+	if err := ctx.allocMemory(len(resp.Payload)); err != nil {
+		ctx.err = err
+		return
+	}
 
 	tsList := &protoCommonV1.TimeSeriesList{}
 	if err := tsList.Unmarshal(resp.Payload); err != nil {
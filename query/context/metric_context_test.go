@@ -89,6 +89,14 @@ func TestMetricContext_HandleResponse(t *testing.T) {
 			name: "handle task response without field data",
 			resp: &protoCommonV1.TaskResponse{Payload: payload},
 		},
+		{
+			name: "memory exceeds limit",
+			prepare: func(metricCtx *MetricContext) {
+				metricCtx.taskCtx.MaxMemory = 1
+			},
+			resp:    &protoCommonV1.TaskResponse{Payload: payloadWithField},
+			wantErr: true,
+		},
 		{
 			name: "handle task response with field data",
 			resp: &protoCommonV1.TaskResponse{Payload: payloadWithField, Stats: stats},
@@ -121,6 +129,16 @@ func TestMetricContext_waitResponse(t *testing.T) {
 		err := metricCtx.waitResponse()
 		assert.Equal(t, constants.ErrTimeout, err)
 	})
+	t.Run("killed", func(t *testing.T) {
+		metricCtx := newMetricContext(context.TODO(), nil)
+		taskCtx := flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)
+		metricCtx.SetTracker(tracker.NewStageTracker(taskCtx))
+		go func() {
+			taskCtx.Abort(constants.ErrQueryKilled)
+		}()
+		err := metricCtx.waitResponse()
+		assert.Equal(t, constants.ErrQueryKilled, err)
+	})
 	t.Run("completed", func(t *testing.T) {
 		metricCtx := newMetricContext(context.TODO(), nil)
 		go func() {
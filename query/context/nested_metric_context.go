@@ -0,0 +1,200 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// countFieldSuffix represents the suffix of field which counts points of sub query's field for avg/count.
+const countFieldSuffix = "#count"
+
+// NestedMetricContext represents the context which aggregates the result set of sub query by outer query at root,
+// the outer query's aggregation doesn't need to go back to storage.
+type NestedMetricContext struct {
+	*RootMetricContext
+
+	specs      map[string]aggregation.AggregatorSpec // field name of sub query => aggregator spec
+	specNames  []string
+	countNames map[string]string // field name of sub query => count field name
+}
+
+// NewNestedMetricContext creates the nested metric context.
+func NewNestedMetricContext(deps *RootMetricContextDeps) *NestedMetricContext {
+	return &NestedMetricContext{
+		RootMetricContext: NewRootMetricContext(deps),
+		specs:             make(map[string]aggregation.AggregatorSpec),
+		countNames:        make(map[string]string),
+	}
+}
+
+// Aggregate aggregates the result set of sub query by outer query's group by and select items,
+// then returns the final result set.
+func (ctx *NestedMetricContext) Aggregate(rs *models.ResultSet) (*models.ResultSet, error) {
+	statement := *ctx.Deps.Statement
+	statement.SelectItems = make([]stmt.Expr, len(ctx.Deps.Statement.SelectItems))
+	for idx, item := range ctx.Deps.Statement.SelectItems {
+		statement.SelectItems[idx] = ctx.rewrite(item)
+	}
+	if statement.Having != nil {
+		statement.Having = ctx.rewrite(statement.Having)
+	}
+	if rs != nil && statement.MetricName == "" {
+		statement.MetricName = rs.MetricName
+	}
+	deps := *ctx.Deps
+	deps.Statement = &statement
+	ctx.Deps = &deps
+
+	if rs != nil && rs.Interval > 0 {
+		ctx.stats = rs.Stats
+		ctx.aggregate(rs)
+	}
+	return ctx.makeResultSet()
+}
+
+// aggregate aggregates the points of sub query's series into grouping aggregator.
+func (ctx *NestedMetricContext) aggregate(rs *models.ResultSet) {
+	interval := rs.Interval
+	if outerInterval := ctx.Deps.Statement.Interval.Int64(); outerInterval > interval {
+		interval *= int64(timeutil.CalIntervalRatio(outerInterval, interval))
+	}
+	ctx.interval = interval
+	ctx.timeRange = timeutil.TimeRange{
+		Start: timeutil.Truncate(rs.StartTime, interval),
+		End:   timeutil.Truncate(rs.EndTime, interval),
+	}
+	aggSpecs := make(aggregation.AggregatorSpecs, len(ctx.specNames))
+	for idx, name := range ctx.specNames {
+		aggSpec := ctx.specs[name]
+		aggSpecs[idx] = aggSpec
+		funcTypes := make([]uint32, 0, len(aggSpec.Functions()))
+		for funcType := range aggSpec.Functions() {
+			funcTypes = append(funcTypes, uint32(funcType))
+		}
+		ctx.aggregatorSpecs[name] = &protoCommonV1.AggregatorSpec{
+			FieldName:    name,
+			FieldType:    uint32(aggSpec.GetFieldType()),
+			FuncTypeList: funcTypes,
+		}
+	}
+	ctx.groupAgg = newGroupingAgg(timeutil.Interval(interval), 1, ctx.timeRange, aggSpecs)
+
+	groupBy := ctx.Deps.Statement.GroupBy
+	tagValues := make([]string, len(groupBy))
+	for _, series := range rs.Series {
+		for idx, tagKey := range groupBy {
+			tagValues[idx] = series.Tags[tagKey]
+		}
+		tags := tag.ConcatTagValues(tagValues)
+		for fieldName, points := range series.Fields {
+			_, hasSpec := ctx.specs[fieldName]
+			countName, hasCount := ctx.countNames[fieldName]
+			if !hasSpec && !hasCount {
+				continue
+			}
+			for timestamp, value := range points {
+				if hasSpec {
+					ctx.groupAgg.AggregatePoint(tags, field.Name(fieldName), timestamp, value)
+				}
+				if hasCount {
+					ctx.groupAgg.AggregatePoint(tags, field.Name(countName), timestamp, 1)
+				}
+			}
+		}
+	}
+}
+
+// rewrite rewrites the expr which aggregates sub query's fields,
+// avg(f) => (sum(f)/sum(f#count)), count(f) => sum(f#count), then collects aggregator specs of fields.
+func (ctx *NestedMetricContext) rewrite(expr stmt.Expr) stmt.Expr {
+	switch e := expr.(type) {
+	case *stmt.SelectItem:
+		item := &stmt.SelectItem{Expr: ctx.rewrite(e.Expr), Alias: e.Alias}
+		if item.Alias == "" && item.Expr.Rewrite() != e.Expr.Rewrite() {
+			// keep the field name of result set same as select item
+			item.Alias = e.Expr.Rewrite()
+		}
+		return item
+	case *stmt.CallExpr:
+		if fieldExpr, ok := singleFieldParam(e); ok {
+			switch e.FuncType {
+			case function.Avg:
+				ctx.addSpec(fieldExpr.Name, field.LastField, function.Sum)
+				countName := ctx.addCountSpec(fieldExpr.Name)
+				return &stmt.ParenExpr{Expr: &stmt.BinaryExpr{
+					Left:     &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{fieldExpr}},
+					Operator: stmt.DIV,
+					Right:    &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: countName}}},
+				}}
+			case function.Count:
+				countName := ctx.addCountSpec(fieldExpr.Name)
+				return &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: countName}}}
+			default:
+				ctx.addSpec(fieldExpr.Name, field.LastField, e.FuncType)
+				return e
+			}
+		}
+		call := &stmt.CallExpr{FuncType: e.FuncType, Params: make([]stmt.Expr, len(e.Params))}
+		for idx, param := range e.Params {
+			call.Params[idx] = ctx.rewrite(param)
+		}
+		return call
+	case *stmt.ParenExpr:
+		return &stmt.ParenExpr{Expr: ctx.rewrite(e.Expr)}
+	case *stmt.BinaryExpr:
+		return &stmt.BinaryExpr{Left: ctx.rewrite(e.Left), Operator: e.Operator, Right: ctx.rewrite(e.Right)}
+	default:
+		return expr
+	}
+}
+
+// addSpec adds the function into aggregator spec of field, creates spec if not exist.
+func (ctx *NestedMetricContext) addSpec(fieldName string, fieldType field.Type, funcType function.FuncType) {
+	aggSpec, ok := ctx.specs[fieldName]
+	if !ok {
+		aggSpec = aggregation.NewAggregatorSpec(field.Name(fieldName), fieldType)
+		ctx.specs[fieldName] = aggSpec
+		ctx.specNames = append(ctx.specNames, fieldName)
+	}
+	aggSpec.AddFunctionType(funcType)
+}
+
+// addCountSpec adds the spec of field which counts points of sub query's field, returns the count field name.
+func (ctx *NestedMetricContext) addCountSpec(fieldName string) string {
+	countName := fieldName + countFieldSuffix
+	ctx.countNames[fieldName] = countName
+	ctx.addSpec(countName, field.SumField, function.Sum)
+	return countName
+}
+
+// singleFieldParam returns the field expr if function's only param is field.
+func singleFieldParam(call *stmt.CallExpr) (*stmt.FieldExpr, bool) {
+	if len(call.Params) != 1 {
+		return nil, false
+	}
+	fieldExpr, ok := call.Params[0].(*stmt.FieldExpr)
+	return fieldExpr, ok
+}
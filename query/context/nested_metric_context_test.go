@@ -0,0 +1,162 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestNestedMetricContext_Aggregate(t *testing.T) {
+	now, _ := timeutil.ParseTimestamp("20190702 19:00:00", "20060102 15:04:05")
+	step := 10 * timeutil.OneSecond
+	newSubQueryResultSet := func() *models.ResultSet {
+		rs := &models.ResultSet{
+			MetricName: "http",
+			GroupBy:    []string{"host", "dc"},
+			Fields:     []string{"r"},
+			StartTime:  now,
+			EndTime:    now + timeutil.OneMinute,
+			Interval:   step,
+		}
+		for _, s := range []struct {
+			host, dc string
+			points   map[int64]float64
+		}{
+			{host: "a", dc: "x", points: map[int64]float64{now: 1, now + step: 3}},
+			{host: "b", dc: "x", points: map[int64]float64{now: 5}},
+			{host: "c", dc: "y", points: map[int64]float64{now: 2}},
+		} {
+			series := models.NewSeries(map[string]string{"host": s.host, "dc": s.dc}, s.host+","+s.dc)
+			points := models.NewPoints()
+			for timestamp, value := range s.points {
+				points.AddPoint(timestamp, value)
+			}
+			series.AddField("r", points)
+			rs.AddSeries(series)
+		}
+		return rs
+	}
+	call := func(funcType function.FuncType) stmt.Expr {
+		return &stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: funcType, Params: []stmt.Expr{&stmt.FieldExpr{Name: "r"}}}}
+	}
+	newStatement := func() *stmt.Query {
+		return &stmt.Query{
+			SelectItems: []stmt.Expr{call(function.Max), call(function.Avg), call(function.Count)},
+			GroupBy:     []string{"dc"},
+			Limit:       10,
+		}
+	}
+
+	cases := []struct {
+		name    string
+		prepare func(statement *stmt.Query)
+		rs      *models.ResultSet
+		expect  map[string]map[string]map[int64]float64
+	}{
+		{
+			name:   "empty result set",
+			expect: map[string]map[string]map[int64]float64{},
+		},
+		{
+			name: "group by tag",
+			rs:   newSubQueryResultSet(),
+			expect: map[string]map[string]map[int64]float64{
+				"x": {
+					"max(r)":   {now: 5, now + step: 3},
+					"avg(r)":   {now: 3, now + step: 3},
+					"count(r)": {now: 2, now + step: 1},
+				},
+				"y": {
+					"max(r)":   {now: 2},
+					"avg(r)":   {now: 2},
+					"count(r)": {now: 1},
+				},
+			},
+		},
+		{
+			name: "having",
+			prepare: func(statement *stmt.Query) {
+				statement.Having = &stmt.BinaryExpr{
+					Left:     &stmt.CallExpr{FuncType: function.Avg, Params: []stmt.Expr{&stmt.FieldExpr{Name: "r"}}},
+					Operator: stmt.GREATER,
+					Right:    &stmt.NumberLiteral{Val: 2},
+				}
+			},
+			rs: newSubQueryResultSet(),
+			expect: map[string]map[string]map[int64]float64{
+				"x": {
+					"max(r)":   {now: 5, now + step: 3},
+					"avg(r)":   {now: 3, now + step: 3},
+					"count(r)": {now: 2, now + step: 1},
+				},
+			},
+		},
+		{
+			name: "outer interval larger than sub query",
+			prepare: func(statement *stmt.Query) {
+				statement.Interval = timeutil.Interval(2 * step)
+			},
+			rs: newSubQueryResultSet(),
+			expect: map[string]map[string]map[int64]float64{
+				"x": {
+					"max(r)":   {now: 5},
+					"avg(r)":   {now: 3},
+					"count(r)": {now: 3},
+				},
+				"y": {
+					"max(r)":   {now: 2},
+					"avg(r)":   {now: 2},
+					"count(r)": {now: 1},
+				},
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			statement := newStatement()
+			if tt.prepare != nil {
+				tt.prepare(statement)
+			}
+			ctx := NewNestedMetricContext(&RootMetricContextDeps{
+				Ctx:       context.TODO(),
+				Statement: statement,
+			})
+			rs, err := ctx.Aggregate(tt.rs)
+			assert.NoError(t, err)
+			result := make(map[string]map[string]map[int64]float64)
+			for _, series := range rs.Series {
+				result[series.Tags["dc"]] = series.Fields
+			}
+			assert.Equal(t, tt.expect, result)
+			// statement of outer query not changed
+			assert.Equal(t, "avg(r)", statement.SelectItems[1].Rewrite())
+			if tt.rs != nil {
+				assert.Equal(t, "http", rs.MetricName)
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/query/cache"
 	"github.com/lindb/lindb/query/tracker"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series/field"
@@ -44,6 +45,7 @@ var (
 	newExpressionFn    = aggregation.NewExpression
 	newGroupingAgg     = aggregation.NewGroupingAggregator
 	newResultLimiterFn = aggregation.NewResultLimiter
+	getResultCacheFn   = cache.GetResultCache
 )
 
 // RootMetricContextDeps represents root metric data search dependency.
@@ -61,7 +63,8 @@ type RootMetricContextDeps struct {
 type RootMetricContext struct {
 	MetricContext
 
-	Deps *RootMetricContextDeps
+	Deps        *RootMetricContextDeps
+	cacheLookup *cache.Lookup // lookup result of query result cache, nil if not cacheable
 }
 
 // NewRootMetricContext creates the root metric data search context.
@@ -95,6 +98,12 @@ func (ctx *RootMetricContext) MakePlan() error {
 			return constants.ErrDatabaseNotExist
 		}
 		calcTimeRangeAndInterval(ctx.Deps.Statement, databaseCfg)
+		pruneShardsByRouting(stateMgr, databaseCfg, ctx.Deps.Statement.Condition, physicalPlans)
+		// completed families are served from query result cache, only query the open tail from storage
+		ctx.cacheLookup = getResultCacheFn().Lookup(&databaseCfg, ctx.Deps.Statement)
+		if ctx.cacheLookup != nil {
+			ctx.Deps.Statement.TimeRange.Start = ctx.cacheLookup.FetchStart()
+		}
 	}
 	payload, _ := ctx.Deps.Statement.MarshalJSON()
 	for _, physicalPlan := range physicalPlans {
@@ -142,19 +151,48 @@ func (ctx *RootMetricContext) makeResultSet() (resultSet *models.ResultSet, err
 	timeRange := ctx.timeRange
 	interval := ctx.interval
 	if ctx.groupAgg != nil {
+		selectItems := statement.SelectItems
+		having := aggregation.NewHavingFilter(statement.Having)
+		var operandKeys map[string]struct{}
+		if having != nil {
+			// eval having operands with select items, then remove operands not in select list after filtering
+			operandKeys = make(map[string]struct{})
+			selectItems = append(append([]stmt.Expr{}, statement.SelectItems...), having.Operands()...)
+			for _, operand := range having.Operands() {
+				operandKeys[operand.Rewrite()] = struct{}{}
+			}
+			for _, item := range statement.SelectItems {
+				if selectItem, ok := item.(*stmt.SelectItem); ok && selectItem.Alias == "" {
+					delete(operandKeys, selectItem.Rewrite())
+				}
+			}
+		}
 		groupIts := ctx.groupAgg.ResultSet()
 		for _, it := range groupIts {
 			// TODO: reuse expression??
 			expression := newExpressionFn(
 				timeRange,
 				interval,
-				statement.SelectItems,
+				selectItems,
 			)
 			// do expression eval
 			expression.Eval(it)
+			fields := expression.ResultSet()
+			if having != nil {
+				if !having.Filter(fields) {
+					// drop the time series not matching having condition
+					continue
+				}
+				for key := range operandKeys {
+					delete(fields, key)
+				}
+			}
+			for _, values := range fields {
+				aggregation.Fill(values, statement.Fill, statement.FillValue)
+			}
 
 			// result order by/limit
-			orderBy.Push(aggregation.NewOrderByRow(it.Tags(), expression.ResultSet()))
+			orderBy.Push(aggregation.NewOrderByRow(it.Tags(), fields))
 		}
 
 		rows := orderBy.ResultSet()
@@ -209,6 +247,11 @@ func (ctx *RootMetricContext) makeResultSet() (resultSet *models.ResultSet, err
 	resultSet.EndTime = timeRange.End
 	resultSet.Interval = interval
 
+	if ctx.cacheLookup != nil {
+		// cache the result of completed families, then merge the cached result
+		ctx.cacheLookup.Merge(resultSet)
+	}
+
 	if ctx.stats != nil {
 		now := time.Now()
 		ctx.stats.Node = ctx.Deps.CurrentNode.Indicator()
@@ -223,6 +266,9 @@ func (ctx *RootMetricContext) makeResultSet() (resultSet *models.ResultSet, err
 			State:      tracker.CompleteState.String(),
 			Async:      false,
 		})
+		if ctx.cacheLookup != nil {
+			ctx.stats.Cache = ctx.cacheLookup.Stats()
+		}
 		resultSet.Stats = ctx.stats
 	}
 	return resultSet, nil
@@ -231,6 +277,10 @@ func (ctx *RootMetricContext) makeResultSet() (resultSet *models.ResultSet, err
 // buildOrderBy builds order by container.
 func (ctx *RootMetricContext) buildOrderBy() (aggregation.OrderBy, error) {
 	statement := ctx.Deps.Statement
+	if topK := ctx.buildTopK(); topK != nil {
+		// topk/bottomk selects K series for each partition, limit not applied
+		return aggregation.NewTopKOrderBy(topK), nil
+	}
 	// build order by items if need do order by query
 	orderByExprs := statement.OrderByItems
 	if len(orderByExprs) == 0 {
@@ -266,3 +316,76 @@ func (ctx *RootMetricContext) buildOrderBy() (aggregation.OrderBy, error) {
 	}
 	return aggregation.NewTopNOrderBy(orderByItems, statement.Limit), nil
 }
+
+// buildTopK builds topk/bottomk item if select list has topk/bottomk function, else returns nil.
+func (ctx *RootMetricContext) buildTopK() *aggregation.TopKItem {
+	statement := ctx.Deps.Statement
+	var topK *aggregation.TopKItem
+	rollups := make(map[string]function.FuncType)
+	for _, expr := range statement.SelectItems {
+		selectItem, ok := expr.(*stmt.SelectItem)
+		if !ok {
+			continue
+		}
+		name := selectItem.Alias
+		if name == "" {
+			name = selectItem.Expr.Rewrite()
+		}
+		valueExpr := selectItem.Expr
+		callExpr, ok := valueExpr.(*stmt.CallExpr)
+		if ok && function.IsSelectorFunc(callExpr.FuncType) && len(callExpr.Params) > 1 {
+			k, ok := callExpr.Params[0].(*stmt.NumberLiteral)
+			if !ok {
+				continue
+			}
+			valueExpr = callExpr.Params[1]
+			topK = &aggregation.TopKItem{
+				Name:      name,
+				FuncType:  ctx.getRankFunc(valueExpr),
+				K:         int(k.Val),
+				Bottom:    callExpr.FuncType == function.BottomK,
+				Partition: -1,
+				Others:    statement.Others,
+				Rollups:   rollups,
+			}
+			if len(callExpr.Params) > 2 {
+				partitionKey := callExpr.Params[2].Rewrite()
+				for idx, tagKey := range statement.GroupBy {
+					if tagKey == partitionKey {
+						topK.Partition = idx
+					}
+				}
+			}
+		}
+		rollups[name] = ctx.getRollupFunc(valueExpr)
+	}
+	return topK
+}
+
+// getRankFunc returns the function which aggregates series points for ranking series, avg if not found.
+func (ctx *RootMetricContext) getRankFunc(expr stmt.Expr) function.FuncType {
+	switch e := expr.(type) {
+	case *stmt.FieldExpr:
+		if aggSpec, ok := ctx.aggregatorSpecs[e.Name]; ok {
+			return field.Type(aggSpec.FieldType).GetOrderByFunc()
+		}
+	case *stmt.CallExpr:
+		if function.IsSupportOrderBy(e.FuncType) {
+			return e.FuncType
+		}
+	}
+	return function.Avg
+}
+
+// getRollupFunc returns the function which rolls up series into others series, avg if not found.
+func (ctx *RootMetricContext) getRollupFunc(expr stmt.Expr) function.FuncType {
+	switch e := expr.(type) {
+	case *stmt.FieldExpr:
+		if aggSpec, ok := ctx.aggregatorSpecs[e.Name]; ok {
+			return field.Type(aggSpec.FieldType).DownSamplingFunc()
+		}
+	case *stmt.CallExpr:
+		return e.FuncType
+	}
+	return function.Avg
+}
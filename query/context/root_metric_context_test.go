@@ -37,6 +37,7 @@ import (
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/sql/stmt"
 )
 
@@ -227,6 +228,93 @@ func TestRootMetricDataContext_makeResultSet(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "build result set with having and fill",
+			prepare: func(ctx *RootMetricContext) {
+				maxF := &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}}
+				ctx.Deps.Statement.SelectItems = []stmt.Expr{
+					&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "f"}, Alias: "ff"},
+					&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "g"}},
+				}
+				ctx.Deps.Statement.Having = &stmt.BinaryExpr{
+					Left:     &stmt.BinaryExpr{Left: maxF, Operator: stmt.GREATER, Right: &stmt.NumberLiteral{Val: 5}},
+					Operator: stmt.AND,
+					Right: &stmt.BinaryExpr{
+						Left: &stmt.FieldExpr{Name: "g"}, Operator: stmt.GREATER, Right: &stmt.NumberLiteral{Val: 0},
+					},
+				}
+				ctx.Deps.Statement.Fill = stmt.FillValue
+				ctx.Deps.Statement.FillValue = 1
+				ctx.groupAgg = groupAgg
+				newValues := func(val float64) *collections.FloatArray {
+					values := collections.NewFloatArray(3)
+					values.SetValue(1, val)
+					return values
+				}
+				groupIt1 := series.NewMockGroupedIterator(ctrl)
+				groupIt2 := series.NewMockGroupedIterator(ctrl)
+				groupAgg.EXPECT().ResultSet().Return(series.GroupedIterators{groupIt1, groupIt2})
+				expr.EXPECT().Eval(gomock.Any()).Times(2)
+				expr.EXPECT().ResultSet().Return(map[string]*collections.FloatArray{
+					"ff": newValues(1), "g": newValues(1), "max(f)": newValues(10),
+				})
+				expr.EXPECT().ResultSet().Return(map[string]*collections.FloatArray{
+					"ff": newValues(1), "g": newValues(1), "max(f)": newValues(1),
+				})
+				groupIt1.EXPECT().Tags().Return("a")
+				orderBy.EXPECT().Push(gomock.Any()).DoAndReturn(func(row aggregation.Row) {
+					tags, fields := row.ResultSet()
+					assert.Equal(t, "a", tags)
+					assert.Len(t, fields, 2)
+					assert.Equal(t, 3, fields["ff"].Size())
+					assert.Equal(t, 1.0, fields["ff"].GetValue(0))
+					assert.Equal(t, 3, fields["g"].Size())
+				})
+				orderBy.EXPECT().ResultSet().Return(nil)
+			},
+			assert: func(rs *models.ResultSet, err error) {
+				assert.NotNil(t, rs)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "build topk result set with others",
+			prepare: func(ctx *RootMetricContext) {
+				ctx.Deps.Statement.GroupBy = []string{"region", "host"}
+				ctx.Deps.Statement.SelectItems = []stmt.Expr{
+					&stmt.SelectItem{Expr: &stmt.CallExpr{
+						FuncType: function.TopK,
+						Params:   []stmt.Expr{&stmt.NumberLiteral{Val: 1}, &stmt.FieldExpr{Name: "f"}, &stmt.FieldExpr{Name: "region"}},
+					}},
+				}
+				ctx.Deps.Statement.Others = "others"
+				ctx.aggregatorSpecs["f"].FieldType = uint32(field.SumField)
+				ctx.groupAgg = groupAgg
+				var groupIts series.GroupedIterators
+				for _, tags := range [][]string{{"r1", "h1"}, {"r1", "h2"}, {"r1", "h3"}, {"r2", "h1"}} {
+					groupIt := series.NewMockGroupedIterator(ctrl)
+					groupIt.EXPECT().Tags().Return(tag.ConcatTagValues(tags))
+					groupIts = append(groupIts, groupIt)
+				}
+				groupAgg.EXPECT().ResultSet().Return(groupIts)
+				expr.EXPECT().Eval(gomock.Any()).Times(4)
+				for _, val := range []float64{1, 5, 2, 3} {
+					values := collections.NewFloatArray(3)
+					values.SetValue(1, val)
+					expr.EXPECT().ResultSet().Return(map[string]*collections.FloatArray{"topk(1.00,f,region)": values})
+				}
+			},
+			assert: func(rs *models.ResultSet, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, rs.Series, 3)
+				assert.Equal(t, map[string]string{"region": "r1", "host": "h2"}, rs.Series[0].Tags)
+				assert.Equal(t, map[string]string{"region": "r1", "host": "others"}, rs.Series[1].Tags)
+				assert.Equal(t, map[string]string{"region": "r2", "host": "h1"}, rs.Series[2].Tags)
+				for _, point := range rs.Series[1].Fields["topk(1.00,f,region)"] {
+					assert.Equal(t, 3.0, point)
+				}
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -19,11 +19,14 @@ package context
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/query/tracker"
@@ -50,7 +53,7 @@ type TaskContext interface {
 	Complete(err error)
 	// WaitResponse waits task complete and returns the response.
 	WaitResponse() (any, error)
-	// SetTracker sets stage tracker.
+	// SetTracker sets stage tracker, and the task context(timeout/memory limit/kill) of tracker.
 	SetTracker(stageTracker *tracker.StageTracker)
 }
 
@@ -64,6 +67,7 @@ type baseTaskContext struct {
 	transportMgr rpc.TransportManager
 
 	stageTracker *tracker.StageTracker
+	taskCtx      *flow.TaskContext
 
 	// handle response
 	doneCh        chan struct{}
@@ -138,9 +142,36 @@ func (ctx *baseTaskContext) Complete(err error) {
 	ctx.tryClose()
 }
 
-// SetTracker sets stage tracker.
+// SetTracker sets stage tracker, and the task context(timeout/memory limit/kill) of tracker.
 func (ctx *baseTaskContext) SetTracker(stageTracker *tracker.StageTracker) {
 	ctx.stageTracker = stageTracker
+	ctx.taskCtx = stageTracker.TaskContext()
+}
+
+// done returns a channel that's closed when task should be aborted.
+func (ctx *baseTaskContext) done() <-chan struct{} {
+	if ctx.taskCtx != nil && ctx.taskCtx.Ctx != nil {
+		return ctx.taskCtx.Ctx.Done()
+	}
+	return ctx.ctx.Done()
+}
+
+// abortErr returns the reason why task aborted(killed/memory limit), default returns timeout.
+func (ctx *baseTaskContext) abortErr() error {
+	if ctx.taskCtx != nil && ctx.taskCtx.Ctx != nil {
+		if err := ctx.taskCtx.Err(); err != nil && !errors.Is(err, context.Canceled) {
+			return err
+		}
+	}
+	return constants.ErrTimeout
+}
+
+// allocMemory records the memory used by task response, returns err if exceeds the memory limit.
+func (ctx *baseTaskContext) allocMemory(size int) error {
+	if ctx.taskCtx == nil {
+		return nil
+	}
+	return ctx.taskCtx.AllocMemory(int64(size))
 }
 
 // tryClose tries to complete the task.
@@ -18,6 +18,7 @@
 package context
 
 import (
+	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/sql/stmt"
@@ -50,3 +51,168 @@ func calcTimeRangeAndInterval(statement *stmt.Query, cfg models.Database) {
 	statement.TimeRange.Start = timeutil.Truncate(statement.TimeRange.Start, intervalVal)
 	statement.TimeRange.End = timeutil.Truncate(statement.TimeRange.End, intervalVal)
 }
+
+// maxRoutingTagValues represents the max combinations of routing tag values for pruning shards.
+const maxRoutingTagValues = 256
+
+// pruneShardsByRouting removes the shards which cannot include the series filtered by the condition from physical plans,
+// only works if the series of database are routed by tag keys and the condition pins all routing tag keys.
+func pruneShardsByRouting(stateMgr broker.StateManager, databaseCfg models.Database,
+	condition stmt.Expr, physicalPlans []*models.PhysicalPlan,
+) {
+	if !databaseCfg.Routing.IsTagKeys() || condition == nil {
+		return
+	}
+	storageState, ok := stateMgr.GetStorage(databaseCfg.Storage)
+	if !ok {
+		return
+	}
+	shardAssign, ok := storageState.ShardAssignments[databaseCfg.Name]
+	if !ok || shardAssign == nil {
+		return
+	}
+	// series may be routed by any num. of shards in routing history
+	numOfShards := []int{shardAssign.NumOfShard()}
+	for _, routing := range shardAssign.Routings {
+		numOfShards = append(numOfShards, routing.NumOfShard)
+	}
+	shards, ok := routingShards(databaseCfg.Routing, condition, numOfShards)
+	if !ok {
+		return
+	}
+	var targets [][]*models.Target
+	found := false
+	for _, physicalPlan := range physicalPlans {
+		var pruned []*models.Target
+		for _, target := range physicalPlan.Targets {
+			if len(target.ShardIDs) == 0 {
+				// compute node
+				pruned = append(pruned, target)
+				continue
+			}
+			var shardIDs []models.ShardID
+			for _, shardID := range target.ShardIDs {
+				if _, ok := shards[shardID]; ok {
+					shardIDs = append(shardIDs, shardID)
+				}
+			}
+			if len(shardIDs) > 0 {
+				found = true
+				pruned = append(pruned, &models.Target{
+					ReceiveOnly: target.ReceiveOnly,
+					Indicator:   target.Indicator,
+					ShardIDs:    shardIDs,
+				})
+			}
+		}
+		targets = append(targets, pruned)
+	}
+	if !found {
+		// shards not online, keep the plan
+		return
+	}
+	for idx, physicalPlan := range physicalPlans {
+		physicalPlan.Targets = targets[idx]
+	}
+}
+
+// routingShards returns the shards which the series filtered by the condition are routed to,
+// returns false if the condition cannot pin all routing tag keys.
+func routingShards(routing *models.Routing, condition stmt.Expr, numOfShards []int) (map[models.ShardID]struct{}, bool) {
+	combinations := []map[string]string{{}}
+	for _, tagKey := range routing.TagKeys {
+		values, ok := pinnedTagValues(condition, tagKey)
+		if !ok || len(values) == 0 || len(combinations)*len(values) > maxRoutingTagValues {
+			return nil, false
+		}
+		var next []map[string]string
+		for _, value := range values {
+			if value == "" {
+				return nil, false
+			}
+			for _, combination := range combinations {
+				tagValues := make(map[string]string, len(combination)+1)
+				for k, v := range combination {
+					tagValues[k] = v
+				}
+				tagValues[tagKey] = value
+				next = append(next, tagValues)
+			}
+		}
+		combinations = next
+	}
+	shards := make(map[models.ShardID]struct{})
+	for _, tagValues := range combinations {
+		tagValues := tagValues
+		hash, _ := routing.TagValuesHash(func(tagKey string) []byte {
+			return []byte(tagValues[tagKey])
+		})
+		for _, numOfShard := range numOfShards {
+			shards[models.ShardID(routing.ShardOf(hash, int32(numOfShard)))] = struct{}{}
+		}
+	}
+	return shards, true
+}
+
+// pinnedTagValues returns the tag values of tag key which the condition pins by equals/in expression,
+// returns false if the condition cannot pin the tag key.
+func pinnedTagValues(condition stmt.Expr, tagKey string) ([]string, bool) {
+	switch expr := condition.(type) {
+	case *stmt.EqualsExpr:
+		if expr.Key == tagKey {
+			return []string{expr.Value}, true
+		}
+	case *stmt.InExpr:
+		if expr.Key == tagKey {
+			return expr.Values, true
+		}
+	case *stmt.ParenExpr:
+		return pinnedTagValues(expr.Expr, tagKey)
+	case *stmt.BinaryExpr:
+		left, leftOk := pinnedTagValues(expr.Left, tagKey)
+		right, rightOk := pinnedTagValues(expr.Right, tagKey)
+		switch expr.Operator {
+		case stmt.AND:
+			switch {
+			case leftOk && rightOk:
+				return intersectValues(left, right), true
+			case leftOk:
+				return left, true
+			case rightOk:
+				return right, true
+			}
+		case stmt.OR:
+			if leftOk && rightOk {
+				return unionValues(left, right), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// intersectValues returns the values which are in both left and right.
+func intersectValues(left, right []string) (rs []string) {
+	values := make(map[string]struct{}, len(right))
+	for _, value := range right {
+		values[value] = struct{}{}
+	}
+	for _, value := range left {
+		if _, ok := values[value]; ok {
+			rs = append(rs, value)
+			delete(values, value)
+		}
+	}
+	return rs
+}
+
+// unionValues returns the distinct values which are in left or right.
+func unionValues(left, right []string) (rs []string) {
+	values := make(map[string]struct{}, len(left)+len(right))
+	for _, value := range append(append([]string{}, left...), right...) {
+		if _, ok := values[value]; !ok {
+			values[value] = struct{}{}
+			rs = append(rs, value)
+		}
+	}
+	return rs
+}
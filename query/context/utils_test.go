@@ -20,11 +20,14 @@ package context
 import (
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql"
 	"github.com/lindb/lindb/sql/stmt"
 )
 
@@ -46,3 +49,185 @@ func Test_calcTimeRangeAndInterval(t *testing.T) {
 	calcTimeRangeAndInterval(statement, cfg)
 	assert.Equal(t, timeutil.Interval(timeutil.OneHour), statement.Interval)
 }
+
+func parseCondition(t *testing.T, where string) stmt.Expr {
+	q, err := sql.Parse("select f from cpu where " + where)
+	assert.NoError(t, err)
+	return q.(*stmt.Query).Condition
+}
+
+func Test_pinnedTagValues(t *testing.T) {
+	cases := []struct {
+		where  string
+		values []string
+		ok     bool
+	}{
+		{where: "tenant='t1'", values: []string{"t1"}, ok: true},
+		{where: "host='h1'"},
+		{where: "tenant in ('t1','t2')", values: []string{"t1", "t2"}, ok: true},
+		{where: "(tenant='t1')", values: []string{"t1"}, ok: true},
+		{where: "tenant='t1' and host='h1'", values: []string{"t1"}, ok: true},
+		{where: "host='h1' and tenant='t1'", values: []string{"t1"}, ok: true},
+		{where: "tenant in ('t1','t2') and tenant='t2'", values: []string{"t2"}, ok: true},
+		{where: "tenant='t1' or tenant='t2'", values: []string{"t1", "t2"}, ok: true},
+		{where: "tenant='t1' or tenant in ('t1','t2')", values: []string{"t1", "t2"}, ok: true},
+		{where: "tenant='t1' or host='h1'"},
+		{where: "tenant!='t1'"},
+		{where: "tenant like 't*'"},
+		{where: "tenant=~'t1'"},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.where, func(t *testing.T) {
+			values, ok := pinnedTagValues(parseCondition(t, tt.where), "tenant")
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.values, values)
+		})
+	}
+}
+
+func Test_routingShards(t *testing.T) {
+	routing := &models.Routing{Method: models.TagKeysRouting, TagKeys: []string{"tenant", "app"}}
+	shardOf := func(tenant, app string, numOfShard int32) models.ShardID {
+		hash, _ := routing.TagValuesHash(func(tagKey string) []byte {
+			return []byte(map[string]string{"tenant": tenant, "app": app}[tagKey])
+		})
+		return models.ShardID(routing.ShardOf(hash, numOfShard))
+	}
+	// not pin all tag keys
+	_, ok := routingShards(routing, parseCondition(t, "tenant='t1'"), []int{10})
+	assert.False(t, ok)
+	// empty tag value
+	_, ok = routingShards(routing, parseCondition(t, "tenant='' and app='a1'"), []int{10})
+	assert.False(t, ok)
+	// empty values
+	_, ok = routingShards(routing, parseCondition(t, "tenant='t1' and tenant='t2' and app='a1'"), []int{10})
+	assert.False(t, ok)
+
+	shards, ok := routingShards(routing, parseCondition(t, "tenant='t1' and app='a1'"), []int{10})
+	assert.True(t, ok)
+	assert.Equal(t, map[models.ShardID]struct{}{shardOf("t1", "a1", 10): {}}, shards)
+
+	shards, ok = routingShards(routing, parseCondition(t, "tenant in ('t1','t2') and app='a1'"), []int{10, 3})
+	assert.True(t, ok)
+	expect := map[models.ShardID]struct{}{
+		shardOf("t1", "a1", 10): {}, shardOf("t2", "a1", 10): {},
+		shardOf("t1", "a1", 3): {}, shardOf("t2", "a1", 3): {},
+	}
+	assert.Equal(t, expect, shards)
+}
+
+func Test_pruneShardsByRouting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	routing := &models.Routing{Method: models.TagKeysRouting, TagKeys: []string{"tenant"}}
+	databaseCfg := models.Database{Name: "db", Storage: "storage", Routing: routing}
+	hash, _ := routing.TagValuesHash(func(tagKey string) []byte { return []byte("t1") })
+	shardID := models.ShardID(routing.ShardOf(hash, 4))
+	newPlans := func() []*models.PhysicalPlan {
+		return []*models.PhysicalPlan{{
+			Database: "db",
+			Targets: []*models.Target{
+				{Indicator: "1", ShardIDs: []models.ShardID{0, 1}},
+				{Indicator: "2", ShardIDs: []models.ShardID{2, 3}},
+			},
+		}}
+	}
+	storageState := models.NewStorageState("storage")
+	storageState.ShardAssignments["db"] = &models.ShardAssignment{
+		Shards: map[models.ShardID]*models.Replica{0: {}, 1: {}, 2: {}, 3: {}},
+	}
+
+	cases := []struct {
+		name     string
+		cfg      models.Database
+		where    string
+		prepare  func()
+		numOfIDs int
+	}{
+		{
+			name:     "routing not by tag keys",
+			cfg:      models.Database{Name: "db"},
+			where:    "tenant='t1'",
+			numOfIDs: 4,
+		},
+		{
+			name:     "no condition",
+			cfg:      databaseCfg,
+			numOfIDs: 4,
+		},
+		{
+			name:  "storage not found",
+			cfg:   databaseCfg,
+			where: "tenant='t1'",
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("storage").Return(nil, false)
+			},
+			numOfIDs: 4,
+		},
+		{
+			name:  "shard assignment not found",
+			cfg:   databaseCfg,
+			where: "tenant='t1'",
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("storage").Return(models.NewStorageState("storage"), true)
+			},
+			numOfIDs: 4,
+		},
+		{
+			name:  "condition not pin tag keys",
+			cfg:   databaseCfg,
+			where: "host='h1'",
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("storage").Return(storageState, true)
+			},
+			numOfIDs: 4,
+		},
+		{
+			name:  "prune shards",
+			cfg:   databaseCfg,
+			where: "tenant='t1' and host='h1'",
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("storage").Return(storageState, true)
+			},
+			numOfIDs: 1,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			var condition stmt.Expr
+			if tt.where != "" {
+				condition = parseCondition(t, tt.where)
+			}
+			plans := newPlans()
+			pruneShardsByRouting(stateMgr, tt.cfg, condition, plans)
+			numOfIDs := 0
+			for _, target := range plans[0].Targets {
+				numOfIDs += len(target.ShardIDs)
+				if tt.numOfIDs == 1 {
+					assert.Equal(t, []models.ShardID{shardID}, target.ShardIDs)
+				}
+			}
+			assert.Equal(t, tt.numOfIDs, numOfIDs)
+		})
+	}
+
+	// shards not online, keep the plan
+	stateMgr.EXPECT().GetStorage("storage").Return(storageState, true)
+	plans := []*models.PhysicalPlan{{
+		Database: "db",
+		Targets: []*models.Target{
+			{Indicator: "1", ShardIDs: []models.ShardID{(shardID + 1) % 4}},
+			{Indicator: "2", ReceiveOnly: true},
+		},
+	}}
+	pruneShardsByRouting(stateMgr, databaseCfg, parseCondition(t, "tenant='t1'"), plans)
+	assert.Len(t, plans[0].Targets, 2)
+	assert.Equal(t, []models.ShardID{(shardID + 1) % 4}, plans[0].Targets[0].ShardIDs)
+}
@@ -104,6 +104,25 @@ func (p *intermediateTaskProcessor) Process(ctx *flow.TaskContext,
 	return nil
 }
 
+// Kill notifies all live storage nodes to kill the tasks of request.
+func (p *intermediateTaskProcessor) Kill(requestID string) {
+	req := &protoCommonV1.TaskRequest{
+		RequestID:   requestID,
+		RequestType: protoCommonV1.RequestType_Kill,
+	}
+	for _, storage := range p.stateMgr.GetStorageList() {
+		for nodeID := range storage.LiveNodes {
+			node := storage.LiveNodes[nodeID]
+			target := node.Indicator()
+			if err := p.transportMgr.SendRequest(target, req); err != nil {
+				// ignore send failure, tasks on target node will be aborted by timeout
+				p.logger.Warn("send kill query request failure",
+					logger.String("requestID", requestID), logger.String("target", target), logger.Error(err))
+			}
+		}
+	}
+}
+
 // processDataSearch executes metric data search.
 func (p *intermediateTaskProcessor) processDataSearch(
 	ctx *flow.TaskContext, stream protoCommonV1.TaskService_HandleServer,
@@ -128,6 +147,7 @@ func (p *intermediateTaskProcessor) processDataSearch(
 			DB: physicalPlan.Database,
 		}, &SearchMgr{
 			Timeout:      p.timeout,
+			Limits:       p.stateMgr.GetDatabaseLimits(physicalPlan.Database),
 			RequestID:    req.RequestID,
 			CurNode:      p.curNode,
 			Choose:       p.stateMgr,
@@ -156,6 +176,7 @@ func (p *intermediateTaskProcessor) processMetadataSearch(
 		Database: physicalPlan.Database,
 	}, stmtQuery, &SearchMgr{
 		Timeout:      p.timeout,
+		Limits:       p.stateMgr.GetDatabaseLimits(physicalPlan.Database),
 		RequestID:    req.RequestID,
 		CurNode:      p.curNode,
 		Choose:       p.stateMgr,
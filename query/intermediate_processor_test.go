@@ -26,11 +26,13 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	queryctx "github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/sql/stmt"
 )
 
@@ -63,6 +65,29 @@ func TestProcess_Fail(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestIntermediateTaskProcessor_Kill(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	transportMgr := rpc.NewMockTransportManager(ctrl)
+	p := NewIntermediateTaskProcessor(models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}, time.Second,
+		stateMgr, nil, transportMgr)
+	stateMgr.EXPECT().GetStorageList().Return([]*models.StorageState{{
+		LiveNodes: map[models.NodeID]models.StatefulNode{
+			1: {StatelessNode: models.StatelessNode{HostIP: "127.0.0.3", GRPCPort: 2891}, ID: 1},
+			2: {StatelessNode: models.StatelessNode{HostIP: "127.0.0.4", GRPCPort: 2891}, ID: 2},
+		},
+	}})
+	// forward kill request to all storage nodes, ignore send failure
+	transportMgr.EXPECT().SendRequest("127.0.0.3:2891", &protoCommonV1.TaskRequest{
+		RequestID:   "req",
+		RequestType: protoCommonV1.RequestType_Kill,
+	}).Return(fmt.Errorf("err"))
+	transportMgr.EXPECT().SendRequest("127.0.0.4:2891", gomock.Any()).Return(nil)
+	p.Kill("req")
+}
+
 func TestProcessMetricDataSearch(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -73,7 +98,10 @@ func TestProcessMetricDataSearch(t *testing.T) {
 	physicalPlan := encoding.JSONMarshal(&models.PhysicalPlan{
 		Targets: []*models.Target{{Indicator: "1.1.1.1:9000"}},
 	})
-	ip := NewIntermediateTaskProcessor(models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}, time.Second, nil, nil, nil)
+	limits := models.NewDefaultLimits()
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseLimits(gomock.Any()).Return(limits).AnyTimes()
+	ip := NewIntermediateTaskProcessor(models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}, time.Second, stateMgr, nil, nil)
 	taskCtx := &flow.TaskContext{}
 	err := ip.Process(taskCtx, nil, &protoCommonV1.TaskRequest{
 		RequestType:  protoCommonV1.RequestType_Data,
@@ -96,6 +124,7 @@ func TestProcessMetricDataSearch(t *testing.T) {
 	stream := protoCommonV1.NewMockTaskService_HandleServer(ctrl)
 	stream.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
 	execFn = func(ctx queryctx.TaskContext, req *models.Request, mgr *SearchMgr) (any, error) {
+		assert.Equal(t, limits, mgr.Limits)
 		return &protoCommonV1.TaskResponse{}, nil
 	}
 	err = ip.Process(taskCtx, stream, &protoCommonV1.TaskRequest{
@@ -115,7 +144,10 @@ func TestProcessMetricMetadataSearch(t *testing.T) {
 	physicalPlan := encoding.JSONMarshal(&models.PhysicalPlan{
 		Targets: []*models.Target{{Indicator: "1.1.1.1:9000"}},
 	})
-	ip := NewIntermediateTaskProcessor(models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}, time.Second, nil, nil, nil)
+	limits := models.NewDefaultLimits()
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseLimits(gomock.Any()).Return(limits).AnyTimes()
+	ip := NewIntermediateTaskProcessor(models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 9000}, time.Second, stateMgr, nil, nil)
 	taskCtx := &flow.TaskContext{}
 	err := ip.Process(taskCtx, nil, &protoCommonV1.TaskRequest{
 		RequestType:  protoCommonV1.RequestType_Metadata,
@@ -138,6 +170,7 @@ func TestProcessMetricMetadataSearch(t *testing.T) {
 
 	metricMetadataSearchFn = func(ctx context.Context, param *models.ExecuteParam,
 		statement *stmt.MetricMetadata, mgr *SearchMgr) (any, error) {
+		assert.Equal(t, limits, mgr.Limits)
 		return []string{}, nil
 	}
 	stream := protoCommonV1.NewMockTaskService_HandleServer(ctrl)
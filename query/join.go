@@ -0,0 +1,371 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/tag"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// for testing
+var (
+	metricSearchFn      = metricDataSearch
+	newJoinExpressionFn = aggregation.NewJoinExpression
+)
+
+// joinSource represents the sub query of one metric in cross-metric query.
+type joinSource struct {
+	source  *stmtpkg.MetricSource
+	query   *stmtpkg.Query
+	fields  map[string]struct{} // pushed down expr(name) of select items
+	groupBy []string            // group by tag keys which metric has
+
+	resultSet *models.ResultSet
+	series    map[string]*models.Series // tag values => series
+}
+
+// joinPlan represents the plan of cross-metric query,
+// 1. pushes down the expr which only references one metric into the metric's sub query
+// 2. evaluates the remainder expr across metrics after joining series by group by tags.
+type joinPlan struct {
+	statement   *stmtpkg.Query
+	sources     []*joinSource
+	selectItems []stmtpkg.Expr
+}
+
+// metricJoinSearch executes cross-metric query, each metric as a sub query, then joins them at current node.
+//
+// Series are joined by group by tags and aligned by time slots:
+//  1. one-to-one, metrics which have all group by tag keys are matched by same tag values.
+//  2. many-to-one, metric which has part of group by tag keys matches all series with same values of those keys.
+//
+// Series without matched series of other metrics are dropped.
+func metricJoinSearch(ctx context.Context,
+	param *models.ExecuteParam, statement *stmtpkg.Query,
+	mgr *SearchMgr,
+) (any, error) {
+	plan, err := newJoinPlan(statement)
+	if err != nil {
+		return nil, err
+	}
+	// sub queries execute under the request of cross-metric query, can be killed by request id
+	subMgr, release := newParentRequest(ctx, param, mgr)
+	defer release()
+
+	if err := plan.planGroupBy(ctx, param, subMgr); err != nil {
+		return nil, err
+	}
+	for _, source := range plan.sources {
+		rs, err := metricSearchFn(ctx, param, source.query, subMgr)
+		if err != nil {
+			return nil, err
+		}
+		resultSet, ok := rs.(*models.ResultSet)
+		if !ok {
+			return nil, fmt.Errorf("unexpected result set of metric: %s", source.source.MetricName)
+		}
+		source.resultSet = resultSet
+	}
+	return plan.join(), nil
+}
+
+// newJoinPlan creates the plan of cross-metric query.
+func newJoinPlan(statement *stmtpkg.Query) (*joinPlan, error) {
+	plan := &joinPlan{statement: statement}
+	for _, source := range statement.Sources {
+		query := *statement
+		query.MetricName = source.MetricName
+		query.Sources = nil
+		query.SelectItems = nil
+		query.Having = nil
+		query.Others = ""
+		query.OrderByItems = nil
+		// limit and fill policy are applied to the joined result, metric data must be raw
+		query.Limit = constants.MaxJoinSeries
+		query.Fill = stmtpkg.FillNone
+		query.FillValue = 0
+		plan.sources = append(plan.sources, &joinSource{
+			source: source,
+			query:  &query,
+			fields: make(map[string]struct{}),
+		})
+	}
+	for _, item := range statement.SelectItems {
+		selectItem, ok := item.(*stmtpkg.SelectItem)
+		if !ok {
+			return nil, fmt.Errorf("unexpected select item: %s", item.Rewrite())
+		}
+		alias := selectItem.Alias
+		if alias == "" {
+			alias = selectItem.Expr.Rewrite()
+		}
+		plan.selectItems = append(plan.selectItems, &stmtpkg.SelectItem{
+			Expr:  plan.rewrite(selectItem.Expr),
+			Alias: alias,
+		})
+	}
+	for _, source := range plan.sources {
+		if len(source.query.SelectItems) == 0 {
+			return nil, fmt.Errorf("metric not referenced by select fields, metric: %s", source.source.Name())
+		}
+	}
+	return plan, nil
+}
+
+// rewrite pushes down the expr which only references one metric, returns the expr evaluated after joining.
+func (p *joinPlan) rewrite(expr stmtpkg.Expr) stmtpkg.Expr {
+	sources := p.referencedSources(expr)
+	switch len(sources) {
+	case 0:
+		return expr
+	case 1:
+		return p.pushDown(sources[0], expr)
+	}
+	switch e := expr.(type) {
+	case *stmtpkg.CallExpr:
+		callExpr := &stmtpkg.CallExpr{FuncType: e.FuncType}
+		for _, param := range e.Params {
+			callExpr.Params = append(callExpr.Params, p.rewrite(param))
+		}
+		return callExpr
+	case *stmtpkg.ParenExpr:
+		return &stmtpkg.ParenExpr{Expr: p.rewrite(e.Expr)}
+	case *stmtpkg.BinaryExpr:
+		return &stmtpkg.BinaryExpr{Left: p.rewrite(e.Left), Operator: e.Operator, Right: p.rewrite(e.Right)}
+	}
+	return expr
+}
+
+// referencedSources returns the sources which expr references.
+func (p *joinPlan) referencedSources(expr stmtpkg.Expr) (sources []*joinSource) {
+	stmtpkg.WalkFieldExpr(expr, func(e *stmtpkg.FieldExpr) {
+		source, _, ok := p.statement.ResolveSource(e.Name)
+		if !ok {
+			return
+		}
+		for _, s := range sources {
+			if s.source == source {
+				return
+			}
+		}
+		for _, s := range p.sources {
+			if s.source == source {
+				sources = append(sources, s)
+			}
+		}
+	})
+	return sources
+}
+
+// pushDown adds the expr into metric's sub query, returns field expr which references the result of sub query.
+func (p *joinPlan) pushDown(source *joinSource, expr stmtpkg.Expr) stmtpkg.Expr {
+	name := expr.Rewrite()
+	if _, ok := source.fields[name]; !ok {
+		source.fields[name] = struct{}{}
+		source.query.SelectItems = append(source.query.SelectItems, &stmtpkg.SelectItem{
+			Expr:  p.trimQualifier(expr),
+			Alias: name,
+		})
+	}
+	return &stmtpkg.FieldExpr{Name: name}
+}
+
+// trimQualifier returns a copy of expr which field exprs are not qualified by metric.
+func (p *joinPlan) trimQualifier(expr stmtpkg.Expr) stmtpkg.Expr {
+	switch e := expr.(type) {
+	case *stmtpkg.FieldExpr:
+		_, name, _ := p.statement.ResolveSource(e.Name)
+		return &stmtpkg.FieldExpr{Name: name}
+	case *stmtpkg.CallExpr:
+		callExpr := &stmtpkg.CallExpr{FuncType: e.FuncType}
+		for _, param := range e.Params {
+			callExpr.Params = append(callExpr.Params, p.trimQualifier(param))
+		}
+		return callExpr
+	case *stmtpkg.ParenExpr:
+		return &stmtpkg.ParenExpr{Expr: p.trimQualifier(e.Expr)}
+	case *stmtpkg.BinaryExpr:
+		return &stmtpkg.BinaryExpr{Left: p.trimQualifier(e.Left), Operator: e.Operator, Right: p.trimQualifier(e.Right)}
+	}
+	return expr
+}
+
+// planGroupBy sets group by tag keys which metric has for each sub query.
+func (p *joinPlan) planGroupBy(ctx context.Context, param *models.ExecuteParam, mgr *SearchMgr) error {
+	groupBy := p.statement.GroupBy
+	if len(groupBy) == 0 {
+		return nil
+	}
+	hasAll := false
+	for _, source := range p.sources {
+		rs, err := metricMetadataSearchFn(ctx, param, &stmtpkg.MetricMetadata{
+			Namespace:  p.statement.Namespace,
+			MetricName: source.source.MetricName,
+			Type:       stmtpkg.TagKey,
+			Limit:      constants.MaxSuggestions,
+		}, mgr)
+		if err != nil {
+			return err
+		}
+		tagKeys := make(map[string]struct{})
+		if values, ok := rs.([]string); ok {
+			for _, tagKey := range values {
+				tagKeys[tagKey] = struct{}{}
+			}
+		}
+		source.groupBy = nil
+		for _, tagKey := range groupBy {
+			if _, ok := tagKeys[tagKey]; ok {
+				source.groupBy = append(source.groupBy, tagKey)
+			}
+		}
+		source.query.GroupBy = source.groupBy
+		if len(source.groupBy) == len(groupBy) {
+			hasAll = true
+		}
+	}
+	if !hasAll {
+		return fmt.Errorf("no metric has all group by tag keys in cross-metric query, group by: %s",
+			strings.Join(groupBy, ","))
+	}
+	return nil
+}
+
+// join joins series of all metrics, then evaluates select items for each joined series.
+func (p *joinPlan) join() *models.ResultSet {
+	statement := p.statement
+	var driver *joinSource
+	var metricNames []string
+	for _, source := range p.sources {
+		metricNames = append(metricNames, source.source.MetricName)
+		source.series = make(map[string]*models.Series)
+		for _, series := range source.resultSet.Series {
+			source.series[series.TagValues] = series
+		}
+		if driver == nil && len(source.groupBy) == len(statement.GroupBy) {
+			// the first metric which has all group by tag keys drives joining
+			driver = source
+		}
+	}
+	timeRange := timeutil.TimeRange{Start: driver.resultSet.StartTime, End: driver.resultSet.EndTime}
+	interval := driver.resultSet.Interval
+	resultSet := &models.ResultSet{
+		MetricName: strings.Join(metricNames, ","),
+		GroupBy:    statement.GroupBy,
+		StartTime:  timeRange.Start,
+		EndTime:    timeRange.End,
+		Interval:   interval,
+	}
+	fieldNames := make(map[string]struct{})
+	for _, series := range driver.resultSet.Series {
+		values := make(map[string]*collections.FloatArray)
+		matched := true
+		for _, source := range p.sources {
+			other := source.match(series)
+			if other == nil {
+				matched = false
+				break
+			}
+			for fieldName, points := range other.Fields {
+				values[fieldName] = toFloatArray(timeRange, interval, points)
+			}
+		}
+		if !matched {
+			continue
+		}
+		expression := newJoinExpressionFn(timeRange, interval, p.selectItems)
+		expression.Eval(values)
+		fields := expression.ResultSet()
+		if len(fields) == 0 {
+			continue
+		}
+		timeSeries := models.NewSeries(series.Tags, series.TagValues)
+		for fieldName, values := range fields {
+			aggregation.Fill(values, statement.Fill, statement.FillValue)
+			points := models.NewPoints()
+			it := values.NewIterator()
+			for it.HasNext() {
+				slot, val := it.Next()
+				if math.IsNaN(val) {
+					continue
+				}
+				points.AddPoint(timeutil.CalcTimestamp(timeRange.Start, slot, timeutil.Interval(interval)), val)
+			}
+			timeSeries.AddField(fieldName, points)
+			fieldNames[fieldName] = struct{}{}
+		}
+		resultSet.AddSeries(timeSeries)
+	}
+	for fieldName := range fieldNames {
+		resultSet.Fields = append(resultSet.Fields, fieldName)
+	}
+	sort.Strings(resultSet.Fields)
+	sort.Slice(resultSet.Series, func(i, j int) bool {
+		return resultSet.Series[i].TagValues < resultSet.Series[j].TagValues
+	})
+	if statement.Limit > 0 && len(resultSet.Series) > statement.Limit {
+		resultSet.Series = resultSet.Series[:statement.Limit]
+	}
+	return resultSet
+}
+
+// match returns the series of metric which matches the series of driving metric by group by tags.
+func (s *joinSource) match(series *models.Series) *models.Series {
+	tagValues := make([]string, len(s.groupBy))
+	for idx, tagKey := range s.groupBy {
+		tagValues[idx] = series.Tags[tagKey]
+	}
+	if len(tagValues) == 0 {
+		// metric without group by, only one series
+		for _, other := range s.series {
+			return other
+		}
+		return nil
+	}
+	return s.series[tag.ConcatTagValues(tagValues)]
+}
+
+// toFloatArray converts the points of series into values aligned by time slot.
+func toFloatArray(timeRange timeutil.TimeRange, interval int64, points map[int64]float64) *collections.FloatArray {
+	pointCount := timeutil.CalPointCount(timeRange.Start, timeRange.End, interval) + 1
+	values := collections.NewFloatArray(pointCount)
+	if interval <= 0 {
+		return values
+	}
+	for timestamp, value := range points {
+		if timestamp < timeRange.Start {
+			continue
+		}
+		slot := int((timestamp - timeRange.Start) / interval)
+		if slot < pointCount {
+			values.SetValue(slot, value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,230 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func parseJoinQuery(t *testing.T, query string) *stmtpkg.Query {
+	q, err := sql.Parse(query)
+	assert.NoError(t, err)
+	return q.(*stmtpkg.Query)
+}
+
+func isAliveRequest(requestID string) bool {
+	for _, req := range GetRequestManager().GetAliveRequests() {
+		if req.RequestID == requestID {
+			return true
+		}
+	}
+	return false
+}
+
+func TestNewJoinPlan(t *testing.T) {
+	statement := parseJoinQuery(t, "select (sum(e.count)/sum(requests.count))*100 as ratio, e.count, requests.count+1 "+
+		"from errors as e, requests where host='a' group by host")
+	plan, err := newJoinPlan(statement)
+	assert.NoError(t, err)
+	assert.Len(t, plan.sources, 2)
+
+	errors := plan.sources[0].query
+	assert.Equal(t, "errors", errors.MetricName)
+	assert.Nil(t, errors.Sources)
+	assert.Equal(t, statement.Condition, errors.Condition)
+	assert.Equal(t, constants.MaxJoinSeries, errors.Limit)
+	assert.Equal(t, stmtpkg.FillNone, errors.Fill)
+	assert.Len(t, errors.SelectItems, 2)
+	assert.Equal(t, "sum(count) as sum(e.count)", errors.SelectItems[0].Rewrite())
+	assert.Equal(t, "count as e.count", errors.SelectItems[1].Rewrite())
+
+	requests := plan.sources[1].query
+	assert.Equal(t, "requests", requests.MetricName)
+	assert.Len(t, requests.SelectItems, 2)
+	assert.Equal(t, "sum(count) as sum(requests.count)", requests.SelectItems[0].Rewrite())
+	assert.Equal(t, "count+1.00 as requests.count+1.00", requests.SelectItems[1].Rewrite())
+
+	assert.Equal(t, "(sum(e.count)/sum(requests.count))*100.00 as ratio", plan.selectItems[0].Rewrite())
+	assert.Equal(t, "e.count as e.count", plan.selectItems[1].Rewrite())
+	assert.Equal(t, "requests.count+1.00 as requests.count+1.00", plan.selectItems[2].Rewrite())
+
+	statement = parseJoinQuery(t, "select abs((e.count-requests.count)) from errors as e, requests")
+	plan, err = newJoinPlan(statement)
+	assert.NoError(t, err)
+	assert.Equal(t, "abs((e.count-requests.count)) as abs((e.count-requests.count))", plan.selectItems[0].Rewrite())
+
+	statement = parseJoinQuery(t, "select e.count from errors as e, requests")
+	_, err = newJoinPlan(statement)
+	assert.Error(t, err)
+	statement.SelectItems = []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "e.count"}}
+	_, err = newJoinPlan(statement)
+	assert.Error(t, err)
+}
+
+func TestMetricJoinSearch(t *testing.T) {
+	defer func() {
+		metricSearchFn = metricDataSearch
+		metricMetadataSearchFn = MetricMetadataSearch
+	}()
+	newSeries := func(tags map[string]string, tagValues []string, fieldName string, points map[int64]float64) *models.Series {
+		series := models.NewSeries(tags, tag.ConcatTagValues(tagValues))
+		series.Fields[fieldName] = points
+		return series
+	}
+	tagKeys := map[string][]string{
+		"errors":   {"host", "code"},
+		"requests": {"host", "region"},
+	}
+	results := map[string]*models.ResultSet{
+		"errors": {
+			StartTime: 0, EndTime: 20, Interval: 10,
+			Series: []*models.Series{
+				newSeries(map[string]string{"host": "a", "code": "500"}, []string{"a", "500"}, "e.count",
+					map[int64]float64{0: 1, 10: 2, 20: 3}),
+				newSeries(map[string]string{"host": "a", "code": "503"}, []string{"a", "503"}, "e.count",
+					map[int64]float64{0: 2, 10: 4, 30: 1}),
+				newSeries(map[string]string{"host": "b", "code": "500"}, []string{"b", "500"}, "e.count",
+					map[int64]float64{0: 5}),
+			},
+		},
+		"requests": {
+			StartTime: 0, EndTime: 20, Interval: 10,
+			Series: []*models.Series{
+				newSeries(map[string]string{"host": "a"}, []string{"a"}, "requests.count",
+					map[int64]float64{0: 10, 10: 10}),
+			},
+		},
+	}
+	metricMetadataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+		statement *stmtpkg.MetricMetadata, mgr *SearchMgr) (any, error) {
+		assert.Equal(t, stmtpkg.TagKey, statement.Type)
+		// sub query executes under parent request
+		assert.Equal(t, "req", mgr.RequestID)
+		assert.NotNil(t, mgr.parentTask)
+		return tagKeys[statement.MetricName], nil
+	}
+	metricSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+		statement *stmtpkg.Query, mgr *SearchMgr) (any, error) {
+		assert.Equal(t, "req", mgr.RequestID)
+		assert.NotNil(t, mgr.parentTask)
+		return results[statement.MetricName], nil
+	}
+	mgr := &SearchMgr{RequestID: "req", Timeout: time.Minute}
+
+	t.Run("many to one", func(t *testing.T) {
+		statement := parseJoinQuery(t, "select e.count/requests.count as ratio from errors as e, requests group by host, code")
+		rs, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.NoError(t, err)
+		resultSet := rs.(*models.ResultSet)
+		assert.Equal(t, "errors,requests", resultSet.MetricName)
+		assert.Equal(t, []string{"ratio"}, resultSet.Fields)
+		assert.Len(t, resultSet.Series, 2)
+		assert.Equal(t, map[string]string{"host": "a", "code": "500"}, resultSet.Series[0].Tags)
+		assert.Equal(t, map[int64]float64{0: 0.1, 10: 0.2, 20: 0}, resultSet.Series[0].Fields["ratio"])
+		assert.Equal(t, map[string]string{"host": "a", "code": "503"}, resultSet.Series[1].Tags)
+		assert.Equal(t, map[int64]float64{0: 0.2, 10: 0.4}, resultSet.Series[1].Fields["ratio"])
+	})
+	t.Run("limit and fill", func(t *testing.T) {
+		statement := parseJoinQuery(t, "select e.count/requests.count as ratio from errors as e, requests "+
+			"group by host, code fill(0) limit 1")
+		rs, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.NoError(t, err)
+		resultSet := rs.(*models.ResultSet)
+		assert.Len(t, resultSet.Series, 1)
+		assert.Equal(t, map[string]string{"host": "a", "code": "500"}, resultSet.Series[0].Tags)
+		assert.Equal(t, map[int64]float64{0: 0.1, 10: 0.2, 20: 0}, resultSet.Series[0].Fields["ratio"])
+
+		statement = parseJoinQuery(t, "select e.count/requests.count as ratio from errors as e, requests "+
+			"group by host, code fill(previous)")
+		rs, err = MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.NoError(t, err)
+		resultSet = rs.(*models.ResultSet)
+		assert.Len(t, resultSet.Series, 2)
+		assert.Equal(t, map[int64]float64{0: 0.2, 10: 0.4, 20: 0.4}, resultSet.Series[1].Fields["ratio"])
+	})
+	t.Run("without group by", func(t *testing.T) {
+		statement := parseJoinQuery(t, "select e.count+requests.count as total from errors as e, requests")
+		results["errors"].Series = results["errors"].Series[:1]
+		rs, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.NoError(t, err)
+		resultSet := rs.(*models.ResultSet)
+		assert.Len(t, resultSet.Series, 1)
+		assert.Equal(t, map[int64]float64{0: 11, 10: 12, 20: 3}, resultSet.Series[0].Fields["total"])
+	})
+	t.Run("no metric has all group by tag keys", func(t *testing.T) {
+		statement := parseJoinQuery(t, "select e.count/requests.count from errors as e, requests group by code, region")
+		_, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.Error(t, err)
+	})
+	t.Run("plan failure", func(t *testing.T) {
+		statement := parseJoinQuery(t, "select e.count from errors as e, requests")
+		_, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.Error(t, err)
+	})
+	t.Run("search tag keys failure", func(t *testing.T) {
+		metricMetadataSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			_ *stmtpkg.MetricMetadata, _ *SearchMgr) (any, error) {
+			return nil, fmt.Errorf("err")
+		}
+		statement := parseJoinQuery(t, "select e.count/requests.count from errors as e, requests group by host")
+		_, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.Error(t, err)
+	})
+	t.Run("sub query failure", func(t *testing.T) {
+		metricSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			_ *stmtpkg.Query, _ *SearchMgr) (any, error) {
+			return nil, fmt.Errorf("err")
+		}
+		statement := parseJoinQuery(t, "select e.count/requests.count from errors as e, requests")
+		_, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.Error(t, err)
+	})
+	t.Run("kill parent request", func(t *testing.T) {
+		metricSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			_ *stmtpkg.Query, mgr *SearchMgr) (any, error) {
+			assert.True(t, isAliveRequest("req"))
+			assert.True(t, GetRequestManager().KillRequest("req"))
+			return nil, flow.NewChildTaskContext(mgr.parentTask).Err()
+		}
+		statement := parseJoinQuery(t, "select e.count/requests.count from errors as e, requests")
+		_, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.Equal(t, constants.ErrQueryKilled, err)
+		assert.False(t, isAliveRequest("req"))
+	})
+	t.Run("unexpected result set", func(t *testing.T) {
+		metricSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			_ *stmtpkg.Query, _ *SearchMgr) (any, error) {
+			return "rs", nil
+		}
+		statement := parseJoinQuery(t, "select e.count/requests.count from errors as e, requests")
+		_, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.Error(t, err)
+	})
+}
@@ -71,6 +71,9 @@ func NewLeafTaskProcessor(
 	}
 }
 
+// Kill does nothing, because leaf node hasn't downstream nodes.
+func (p *leafTaskProcessor) Kill(_ string) {}
+
 // Process processes the task request, searches the data of metric from time series engine
 func (p *leafTaskProcessor) Process(
 	ctx *flow.TaskContext,
@@ -100,6 +103,8 @@ func (p *leafTaskProcessor) Process(
 		p.statistics.OmitRequest.Incr()
 		return fmt.Errorf("%w: %s", ErrNoDatabase, physicalPlan.Database)
 	}
+	// apply query limits(timeout/max memory) of database
+	ctx.ApplyLimits(db.GetLimits())
 
 	switch req.RequestType {
 	case protoCommonV1.RequestType_Data:
@@ -46,10 +46,13 @@ func TestLeafTask_Process_Fail(t *testing.T) {
 	engine := tsdb.NewMockEngine(ctrl)
 	serverStream := protoCommonV1.NewMockTaskService_HandleServer(ctrl)
 	mockDatabase := tsdb.NewMockDatabase(ctrl)
+	mockDatabase.EXPECT().GetLimits().Return(models.NewDefaultLimits()).AnyTimes()
 
 	currentNode := models.StatelessNode{HostIP: "1.1.1.3", GRPCPort: 8000}
 	processorI := NewLeafTaskProcessor(&currentNode, engine, taskServerFactory)
 	processor := processorI.(*leafTaskProcessor)
+	// leaf node hasn't downstream nodes
+	processor.Kill("req")
 
 	cases := []struct {
 		name    string
@@ -164,6 +167,7 @@ func TestLeafProcessor_Process(t *testing.T) {
 	processorI := NewLeafTaskProcessor(&currentNode, engine, taskServerFactory)
 	processor := processorI.(*leafTaskProcessor)
 	mockDatabase := tsdb.NewMockDatabase(ctrl)
+	mockDatabase.EXPECT().GetLimits().Return(models.NewDefaultLimits()).AnyTimes()
 	plan := encoding.JSONMarshal(&models.PhysicalPlan{
 		Database: "test_db",
 		Targets:  []*models.Target{{Indicator: "1.1.1.3:8000"}},
@@ -198,6 +202,7 @@ func TestLeafTask_Suggest_Process(t *testing.T) {
 	processorI := NewLeafTaskProcessor(&currentNode, engine, taskServerFactory)
 	processor := processorI.(*leafTaskProcessor)
 	mockDatabase := tsdb.NewMockDatabase(ctrl)
+	mockDatabase.EXPECT().GetLimits().Return(models.NewDefaultLimits()).AnyTimes()
 	plan := encoding.JSONMarshal(&models.PhysicalPlan{
 		Database: "test_db",
 		Targets:  []*models.Target{{Indicator: "1.1.1.3:8000"}},
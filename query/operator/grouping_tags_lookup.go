@@ -32,6 +32,7 @@ func NewGroupingTagsLookup(executeCtx *flow.DataLoadContext) Operator {
 }
 
 // Execute executes grouping tag value ids lookup, if it hasn't grouping tag key returns no grouping.
+// After aggregators prepared, tracks the memory usage of query(maybe exceeds the memory limit).
 func (op *groupingTagsLookup) Execute() error {
 	op.executeCtx.Grouping()
 	if op.executeCtx.ShardExecuteCtx.GroupingContext != nil {
@@ -40,7 +41,8 @@ func (op *groupingTagsLookup) Execute() error {
 	} else {
 		op.executeCtx.PrepareAggregatorWithoutGrouping()
 	}
-	return nil
+	taskCtx := op.executeCtx.ShardExecuteCtx.StorageExecuteCtx.TaskCtx
+	return taskCtx.AllocMemory(op.executeCtx.EstimateAggregatorMemory())
 }
 
 // Identifier returns identifier string value of grouping tags lookup operator.
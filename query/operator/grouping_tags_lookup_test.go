@@ -26,7 +26,9 @@ import (
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -39,6 +41,7 @@ func TestGroupingTagsLookup_Execute(t *testing.T) {
 	ctx := &flow.ShardExecuteContext{
 		SeriesIDsAfterFiltering: seriesIDs,
 		StorageExecuteCtx: &flow.StorageExecuteContext{
+			TaskCtx:           &flow.TaskContext{},
 			Query:             &stmt.Query{},
 			DownSamplingSpecs: aggregation.AggregatorSpecs{aggregation.NewAggregatorSpec("f", field.SumField)},
 		},
@@ -59,6 +62,16 @@ func TestGroupingTagsLookup_Execute(t *testing.T) {
 		op := NewGroupingTagsLookup(dataLoadCtx)
 		assert.NoError(t, op.Execute())
 	})
+	t.Run("memory exceeded", func(t *testing.T) {
+		ctx.GroupingContext = nil
+		ctx.StorageExecuteCtx.Query = &stmt.Query{
+			Interval:  timeutil.Interval(timeutil.OneSecond),
+			TimeRange: timeutil.TimeRange{Start: 0, End: timeutil.OneHour},
+		}
+		ctx.StorageExecuteCtx.TaskCtx = &flow.TaskContext{MaxMemory: 1024}
+		op := NewGroupingTagsLookup(dataLoadCtx)
+		assert.ErrorIs(t, op.Execute(), constants.ErrQueryMemoryExceeded)
+	})
 }
 
 func TestGroupingTagsLookup_Identifier(t *testing.T) {
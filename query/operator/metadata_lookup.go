@@ -147,7 +147,11 @@ func (op *metadataLookup) selectList() error {
 			return op.err
 		}
 	}
-	return nil
+	// fields of having condition also need to be aggregated
+	if having := op.executeCtx.Query.Having; having != nil {
+		op.field(nil, having)
+	}
+	return op.err
 }
 
 // field plans the field expr from select list
@@ -163,6 +167,13 @@ func (op *metadataLookup) field(parentFunc *stmt.CallExpr, expr stmt.Expr) {
 			op.planHistogramFields(e)
 			return
 		}
+		if function.IsSelectorFunc(e.FuncType) {
+			// topk/bottomk ranks series at root, only plans the ranked expr(k and partition key are not fields)
+			if len(e.Params) > 1 {
+				op.field(nil, e.Params[1])
+			}
+			return
+		}
 		for _, param := range e.Params {
 			op.field(e, param)
 		}
@@ -86,6 +86,34 @@ func TestMetadataLookup_Execute(t *testing.T) {
 		}, nil)
 		assert.NoError(t, op.Execute())
 	})
+	t.Run("plan having fields", func(t *testing.T) {
+		defer func() {
+			ctx.Query.Having = nil
+		}()
+		ctx.Query.SelectItems = []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "f"}}
+		ctx.Query.Having = &stmtpkg.BinaryExpr{
+			Left: &stmtpkg.CallExpr{
+				FuncType: function.Max,
+				Params:   []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "g"}},
+			},
+			Operator: stmtpkg.GREATER,
+			Right:    &stmtpkg.NumberLiteral{Val: 10},
+		}
+		op := NewMetadataLookup(ctx, db)
+		metaDB.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(10), nil)
+		metaDB.EXPECT().GetField(gomock.Any(), gomock.Any(), field.Name("f")).Return(field.Meta{
+			ID:   10,
+			Type: field.SumField,
+			Name: "f",
+		}, nil)
+		metaDB.EXPECT().GetField(gomock.Any(), gomock.Any(), field.Name("g")).Return(field.Meta{
+			ID:   11,
+			Type: field.MaxField,
+			Name: "g",
+		}, nil)
+		assert.NoError(t, op.Execute())
+		assert.Len(t, ctx.Fields, 2)
+	})
 }
 
 func TestMetadataLookup_groupBy(t *testing.T) {
@@ -209,6 +237,52 @@ func TestMetadataLookup_field(t *testing.T) {
 					Right:    &stmtpkg.FieldExpr{Name: "a"},
 				}},
 		},
+		{
+			name: "handle math function",
+			in: &stmtpkg.CallExpr{
+				FuncType: function.Clamp,
+				Params: []stmtpkg.Expr{
+					&stmtpkg.FieldExpr{Name: "f"}, &stmtpkg.NumberLiteral{Val: 0}, &stmtpkg.NumberLiteral{Val: 10},
+				},
+			},
+		},
+		{
+			name: "range function not support sum field",
+			in: &stmtpkg.CallExpr{
+				FuncType: function.Increase,
+				Params:   []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "f"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "handle range function with aggregated value",
+			in: &stmtpkg.CallExpr{
+				FuncType: function.Increase,
+				Params: []stmtpkg.Expr{
+					&stmtpkg.CallExpr{FuncType: function.Sum, Params: []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "f"}}},
+				},
+			},
+		},
+		{
+			name: "handle topk function",
+			in: &stmtpkg.CallExpr{
+				FuncType: function.TopK,
+				Params: []stmtpkg.Expr{
+					&stmtpkg.NumberLiteral{Val: 5}, &stmtpkg.FieldExpr{Name: "f"}, &stmtpkg.FieldExpr{Name: "host"},
+				},
+			},
+		},
+		{
+			name: "topk ranked expr not support",
+			in: &stmtpkg.CallExpr{
+				FuncType: function.BottomK,
+				Params: []stmtpkg.Expr{
+					&stmtpkg.NumberLiteral{Val: 5},
+					&stmtpkg.CallExpr{FuncType: function.Increase, Params: []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "f"}}},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range cases {
@@ -22,6 +22,8 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 )
 
@@ -38,6 +40,11 @@ type RequestManager interface {
 	CompleteRequest(requestID string)
 	// GetAliveRequests returns all alive request.
 	GetAliveRequests() []*models.Request
+	// AttachTask attaches the task context which executes the request on current node,
+	// the task context is detached automatically after task done.
+	AttachTask(requestID string, taskCtx *flow.TaskContext)
+	// KillRequest aborts all tasks of the request on current node, returns if any task killed.
+	KillRequest(requestID string) bool
 }
 
 // GetRequestManager returns a singleton RequestManager instance.
@@ -54,6 +61,7 @@ func GetRequestManager() RequestManager {
 // requestManager implements RequestManager interface.
 type requestManager struct {
 	requests map[string]*models.Request
+	tasks    map[string][]*flow.TaskContext // request id => task contexts
 
 	mutex sync.RWMutex
 }
@@ -62,6 +70,7 @@ type requestManager struct {
 func newRequestManager() RequestManager {
 	return &requestManager{
 		requests: make(map[string]*models.Request),
+		tasks:    make(map[string][]*flow.TaskContext),
 	}
 }
 
@@ -99,3 +108,48 @@ func (r *requestManager) GetAliveRequests() (rs []*models.Request) {
 	}
 	return
 }
+
+// AttachTask attaches the task context which executes the request on current node,
+// the task context is detached automatically after task done.
+func (r *requestManager) AttachTask(requestID string, taskCtx *flow.TaskContext) {
+	r.mutex.Lock()
+	r.tasks[requestID] = append(r.tasks[requestID], taskCtx)
+	r.mutex.Unlock()
+
+	go func() {
+		// task done(completed/timeout/killed), detach it
+		<-taskCtx.Ctx.Done()
+		r.detachTask(requestID, taskCtx)
+	}()
+}
+
+// KillRequest aborts all tasks of the request on current node, returns if any task killed.
+func (r *requestManager) KillRequest(requestID string) bool {
+	r.mutex.RLock()
+	tasks := append([]*flow.TaskContext{}, r.tasks[requestID]...)
+	r.mutex.RUnlock()
+
+	for _, taskCtx := range tasks {
+		taskCtx.Abort(constants.ErrQueryKilled)
+	}
+	return len(tasks) > 0
+}
+
+// detachTask detaches the task context from the request.
+func (r *requestManager) detachTask(requestID string, taskCtx *flow.TaskContext) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	tasks := r.tasks[requestID]
+	for idx := range tasks {
+		if tasks[idx] == taskCtx {
+			tasks = append(tasks[:idx], tasks[idx+1:]...)
+			break
+		}
+	}
+	if len(tasks) == 0 {
+		delete(r.tasks, requestID)
+	} else {
+		r.tasks[requestID] = tasks
+	}
+}
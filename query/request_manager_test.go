@@ -18,11 +18,17 @@
 package query
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ltoml"
 )
 
 func TestGetRequestManager(t *testing.T) {
@@ -40,3 +46,61 @@ func TestRequestManager(t *testing.T) {
 	mgr.CompleteRequest(req)
 	assert.Empty(t, mgr.GetAliveRequests())
 }
+
+func TestRequestManager_KillRequest(t *testing.T) {
+	mgr := newRequestManager()
+	assert.False(t, mgr.KillRequest("req"))
+
+	taskCtx1 := flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	taskCtx2 := flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	mgr.AttachTask("req", taskCtx1)
+	mgr.AttachTask("req", taskCtx2)
+	assert.True(t, mgr.KillRequest("req"))
+	assert.Equal(t, constants.ErrQueryKilled, taskCtx1.Err())
+	assert.Equal(t, constants.ErrQueryKilled, taskCtx2.Err())
+	// wait tasks detached
+	assert.Eventually(t, func() bool {
+		return !mgr.KillRequest("req")
+	}, time.Second, time.Millisecond)
+
+	taskCtx3 := flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	mgr.AttachTask("req", taskCtx3)
+	taskCtx3.Release()
+	assert.Eventually(t, func() bool {
+		return !mgr.KillRequest("req")
+	}, time.Second, time.Millisecond)
+}
+
+func TestRequestManager_AttachTask_ApplyLimits(t *testing.T) {
+	mgr := newRequestManager()
+	limits := models.NewDefaultLimits()
+	limits.QueryTimeout = ltoml.Duration(10 * time.Millisecond)
+	limits.MaxMemoryPerQuery = 1024
+
+	var wait sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		taskCtx := flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)
+		// attach task before applying limits, same as task handler
+		mgr.AttachTask("req", taskCtx)
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			taskCtx.ApplyLimits(limits)
+			<-taskCtx.Ctx.Done()
+			assert.Equal(t, constants.ErrTimeout, taskCtx.Err())
+			taskCtx.Release()
+		}()
+	}
+	wait.Wait()
+	// wait tasks detached
+	assert.Eventually(t, func() bool {
+		return !mgr.KillRequest("req")
+	}, time.Second, time.Millisecond)
+
+	taskCtx := flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)
+	mgr.AttachTask("req", taskCtx)
+	go taskCtx.ApplyLimits(models.NewDefaultLimits())
+	assert.True(t, mgr.KillRequest("req"))
+	<-taskCtx.Ctx.Done()
+	assert.Equal(t, constants.ErrQueryKilled, taskCtx.Err())
+}
@@ -45,6 +45,12 @@ type SearchMgr struct {
 	Choose       flow.NodeChoose
 	TaskMgr      TaskManager
 	TransportMgr rpc.TransportManager
+	// query limits(timeout/max memory) of database, nil means no limit
+	Limits *models.Limits
+
+	// task context of parent request if executes as sub query(e.g. cross-metric query),
+	// request is registered by parent, sub query's task derives from parent's task.
+	parentTask *flow.TaskContext
 }
 
 // MetricMetadataSearchWithResult represents the metadata query executor and retruns the final result set.
@@ -109,6 +115,21 @@ func MetricMetadataSearch(ctx context.Context,
 func MetricDataSearch(ctx context.Context,
 	param *models.ExecuteParam, statement *stmtpkg.Query,
 	mgr *SearchMgr,
+) (any, error) {
+	switch {
+	case statement.IsSubQuery():
+		return metricSubQuerySearch(ctx, param, statement, mgr)
+	case statement.IsJoin():
+		return metricJoinSearch(ctx, param, statement, mgr)
+	default:
+		return metricSearchFn(ctx, param, statement, mgr)
+	}
+}
+
+// metricDataSearch executes the query pipeline of single metric.
+func metricDataSearch(ctx context.Context,
+	param *models.ExecuteParam, statement *stmtpkg.Query,
+	mgr *SearchMgr,
 ) (any, error) {
 	req := models.NewRequest(mgr.CurNode.Indicator(), param.Database, param.SQL)
 	taskCtx := queryctx.NewRootMetricContext(
@@ -124,6 +145,33 @@ func MetricDataSearch(ctx context.Context,
 	return exec(taskCtx, req, mgr)
 }
 
+// newParentRequest registers the request of query which executes sub queries at current node(e.g. cross-metric query),
+// returns the search manager of sub queries, which use the request id of parent and derive from parent's task,
+// so that kill/timeout/limits of parent request propagate to sub queries.
+// Reuses the parent request if it is already a sub query of other request.
+func newParentRequest(ctx context.Context, param *models.ExecuteParam, mgr *SearchMgr) (subMgr *SearchMgr, release func()) {
+	subMgr = &SearchMgr{}
+	*subMgr = *mgr
+	if mgr.parentTask != nil {
+		return subMgr, func() {}
+	}
+	req := models.NewRequest(mgr.CurNode.Indicator(), param.Database, param.SQL)
+	req.RequestID = mgr.RequestID
+	requestID := GetRequestManager().NewRequest(req)
+	taskCtx := flow.NewTaskContextWithTimeout(ctx, mgr.Timeout)
+	// apply query limits(timeout/max memory) of database
+	taskCtx.ApplyLimits(mgr.Limits)
+	// attach task context for killing query
+	GetRequestManager().AttachTask(requestID, taskCtx)
+
+	subMgr.RequestID = requestID
+	subMgr.parentTask = taskCtx
+	return subMgr, func() {
+		GetRequestManager().CompleteRequest(requestID)
+		taskCtx.Release()
+	}
+}
+
 // exec executes the query pipeline.
 func exec(ctx queryctx.TaskContext, req *models.Request, mgr *SearchMgr) (any, error) {
 	if strings.TrimSpace(req.DB) == "" {
@@ -132,16 +180,30 @@ func exec(ctx queryctx.TaskContext, req *models.Request, mgr *SearchMgr) (any, e
 	if mgr.RequestID != "" {
 		req.RequestID = mgr.RequestID
 	}
-	// set request id
-	GetRequestManager().NewRequest(req)
+	var taskCtx *flow.TaskContext
+	if mgr.parentTask != nil {
+		// sub query, request is registered by parent
+		taskCtx = flow.NewChildTaskContext(mgr.parentTask)
+	} else {
+		// set request id
+		GetRequestManager().NewRequest(req)
+		taskCtx = flow.NewTaskContextWithTimeout(ctx.Context(), mgr.Timeout)
+		// apply query limits(timeout/max memory) of database
+		taskCtx.ApplyLimits(mgr.Limits)
+		// attach task context for killing query
+		GetRequestManager().AttachTask(req.RequestID, taskCtx)
+	}
 	// execute metadata query pipeline
-	tracker := trackerpkg.NewStageTracker(flow.NewTaskContextWithTimeout(ctx.Context(), mgr.Timeout))
+	tracker := trackerpkg.NewStageTracker(taskCtx)
 	ctx.SetTracker(tracker)
 	mgr.TaskMgr.AddTask(req.RequestID, ctx)
 
 	defer func() {
 		mgr.TaskMgr.RemoveTask(req.RequestID)
-		GetRequestManager().CompleteRequest(req.RequestID)
+		if mgr.parentTask == nil {
+			GetRequestManager().CompleteRequest(req.RequestID)
+		}
+		taskCtx.Release()
 	}()
 
 	pipeline := newExecutePipelineFn(tracker, func(err error) {
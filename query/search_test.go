@@ -20,12 +20,15 @@ package query
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/query/stage"
 	trackerpkg "github.com/lindb/lindb/query/tracker"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/sql/stmt"
@@ -62,12 +65,83 @@ func TestMetricMetadataSearch(t *testing.T) {
 	taskMgr.EXPECT().RemoveTask(gomock.Any())
 	rs, err := MetricMetadataSearchWithResult(context.TODO(), &models.ExecuteParam{Database: "test"}, &stmt.MetricMetadata{}, &SearchMgr{
 		RequestID: "xxxx-1bc",
+		Timeout:   time.Minute,
 		TaskMgr:   taskMgr,
 	})
 	assert.NoError(t, err)
 	assert.NotNil(t, rs)
 }
 
+func TestMetricMetadataSearch_Kill(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newExecutePipelineFn = NewExecutePipeline
+		ctrl.Finish()
+	}()
+
+	pipeline := NewMockPipeline(ctrl)
+	newExecutePipelineFn = func(_ *trackerpkg.StageTracker, _ func(err error)) Pipeline {
+		return pipeline
+	}
+	pipeline.EXPECT().Execute(gomock.Any()).Do(func(_ stage.Stage) {
+		// kill query after pipeline started
+		go func() {
+			assert.True(t, GetRequestManager().KillRequest("kill-req"))
+		}()
+	})
+	taskMgr := NewMockTaskManager(ctrl)
+	taskMgr.EXPECT().AddTask(gomock.Any(), gomock.Any())
+	taskMgr.EXPECT().RemoveTask(gomock.Any())
+	rs, err := MetricMetadataSearch(context.TODO(), &models.ExecuteParam{Database: "test"}, &stmt.MetricMetadata{}, &SearchMgr{
+		RequestID: "kill-req",
+		Timeout:   time.Minute,
+		Limits:    models.NewDefaultLimits(),
+		TaskMgr:   taskMgr,
+	})
+	assert.Equal(t, constants.ErrQueryKilled, err)
+	assert.Nil(t, rs)
+}
+
+func TestMetricMetadataSearch_ParentRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newExecutePipelineFn = NewExecutePipeline
+		ctrl.Finish()
+	}()
+
+	pipeline := NewMockPipeline(ctrl)
+	newExecutePipelineFn = func(_ *trackerpkg.StageTracker, _ func(err error)) Pipeline {
+		return pipeline
+	}
+	pipeline.EXPECT().Execute(gomock.Any()).Do(func(_ stage.Stage) {
+		// kill parent request after sub query's pipeline started
+		go func() {
+			assert.True(t, GetRequestManager().KillRequest("parent-req"))
+		}()
+	})
+	taskMgr := NewMockTaskManager(ctrl)
+	taskMgr.EXPECT().AddTask("parent-req", gomock.Any())
+	taskMgr.EXPECT().RemoveTask("parent-req")
+	param := &models.ExecuteParam{Database: "test"}
+	subMgr, release := newParentRequest(context.TODO(), param, &SearchMgr{
+		RequestID: "parent-req",
+		Timeout:   time.Minute,
+		TaskMgr:   taskMgr,
+	})
+	rs, err := MetricMetadataSearch(context.TODO(), param, &stmt.MetricMetadata{}, subMgr)
+	assert.Equal(t, constants.ErrQueryKilled, err)
+	assert.Nil(t, rs)
+	// request completed by parent
+	assert.True(t, isAliveRequest("parent-req"))
+	// nested sub query reuses parent request
+	nestedMgr, nestedRelease := newParentRequest(context.TODO(), param, subMgr)
+	assert.Equal(t, subMgr.parentTask, nestedMgr.parentTask)
+	nestedRelease()
+	assert.True(t, isAliveRequest("parent-req"))
+	release()
+	assert.False(t, isAliveRequest("parent-req"))
+}
+
 func TestBuildMetadataResultSet(t *testing.T) {
 	rs, err := buildMetadataResultSet(&stmt.MetricMetadata{Type: stmt.Field}, []string{"avc"})
 	assert.Error(t, err)
@@ -62,6 +62,11 @@ func (stage *baseStage) Execute(node PlanNode, completeHandle func(), errHandle
 		}
 	}
 	if stage.IsAsync() {
+		if err := stage.ctx.Err(); err != nil {
+			// task context done(timeout/killed), cannot submit task
+			errHandle(err)
+			return
+		}
 		stage.execPool.Submit(stage.ctx, concurrent.NewTask(func() {
 			execFn()
 		}, errHandle))
@@ -148,6 +148,23 @@ func TestBaseStage_Execute(t *testing.T) {
 	}
 }
 
+func TestBaseStage_Execute_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	s := &baseStage{
+		ctx:       ctx,
+		stageType: Grouping,
+		execPool:  &mockPool{},
+	}
+	var err error
+	s.Execute(nil, func() {
+		assert.Fail(t, "stage cannot execute")
+	}, func(e error) {
+		err = e
+	})
+	assert.Equal(t, context.Canceled, err)
+}
+
 func TestBaseStage_Track(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
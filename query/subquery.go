@@ -0,0 +1,76 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lindb/lindb/models"
+	queryctx "github.com/lindb/lindb/query/context"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// metricSubQuerySearch executes sub query, then aggregates its result set by outer query at current node,
+// so outer query doesn't need to go back to storage.
+//
+// Group by tag keys of outer query not in sub query are appended into sub query's group by,
+// make sure series of sub query can be grouped by outer query.
+func metricSubQuerySearch(ctx context.Context,
+	param *models.ExecuteParam, statement *stmtpkg.Query,
+	mgr *SearchMgr,
+) (any, error) {
+	subQuery := *statement.SubQuery
+	subQuery.GroupBy = append([]string{}, statement.SubQuery.GroupBy...)
+	for _, tagKey := range statement.GroupBy {
+		if !containsTagKey(subQuery.GroupBy, tagKey) {
+			subQuery.GroupBy = append(subQuery.GroupBy, tagKey)
+		}
+	}
+	req := models.NewRequest(mgr.CurNode.Indicator(), param.Database, param.SQL)
+	taskCtx := queryctx.NewNestedMetricContext(&queryctx.RootMetricContextDeps{
+		Ctx:         ctx,
+		Request:     req,
+		Database:    param.Database,
+		CurrentNode: mgr.CurNode,
+		Statement:   statement,
+	})
+	// sub query executes under the request of outer query, inherits its kill/timeout/limits
+	subMgr, release := newParentRequest(ctx, param, mgr)
+	defer release()
+
+	rs, err := MetricDataSearch(ctx, param, &subQuery, subMgr)
+	if err != nil {
+		return nil, err
+	}
+	resultSet, ok := rs.(*models.ResultSet)
+	if !ok {
+		return nil, fmt.Errorf("unexpected result set of sub query")
+	}
+	return taskCtx.Aggregate(resultSet)
+}
+
+// containsTagKey checks if tag keys contain the tag key.
+func containsTagKey(tagKeys []string, tagKey string) bool {
+	for _, key := range tagKeys {
+		if key == tagKey {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series/tag"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func TestMetricSubQuerySearch(t *testing.T) {
+	defer func() {
+		metricSearchFn = metricDataSearch
+	}()
+	newResultSet := func() *models.ResultSet {
+		rs := &models.ResultSet{MetricName: "http", StartTime: 0, EndTime: 20, Interval: 10}
+		for _, s := range []struct {
+			host, dc string
+			points   map[int64]float64
+		}{
+			{host: "a", dc: "x", points: map[int64]float64{0: 1, 10: 2}},
+			{host: "b", dc: "x", points: map[int64]float64{0: 3}},
+			{host: "c", dc: "y", points: map[int64]float64{10: 4}},
+		} {
+			series := models.NewSeries(map[string]string{"host": s.host, "dc": s.dc},
+				tag.ConcatTagValues([]string{s.host, s.dc}))
+			series.Fields["r"] = s.points
+			rs.AddSeries(series)
+		}
+		return rs
+	}
+	mgr := &SearchMgr{RequestID: "req", Timeout: time.Minute}
+
+	t.Run("aggregate sub query result set", func(t *testing.T) {
+		metricSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			statement *stmtpkg.Query, mgr *SearchMgr) (any, error) {
+			assert.Equal(t, "http", statement.MetricName)
+			assert.Equal(t, []string{"host", "dc"}, statement.GroupBy)
+			// sub query executes under outer query's request
+			assert.Equal(t, "req", mgr.RequestID)
+			assert.NotNil(t, mgr.parentTask)
+			return newResultSet(), nil
+		}
+		statement := parseJoinQuery(t, "select max(r), sum(r) as s from (select rate(count) as r from http group by host) group by dc")
+		rs, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.NoError(t, err)
+		resultSet := rs.(*models.ResultSet)
+		assert.Equal(t, "http", resultSet.MetricName)
+		assert.Equal(t, []string{"dc"}, resultSet.GroupBy)
+		assert.Len(t, resultSet.Series, 2)
+		assert.Equal(t, map[string]string{"dc": "x"}, resultSet.Series[0].Tags)
+		assert.Equal(t, map[int64]float64{0: 3, 10: 2}, resultSet.Series[0].Fields["max(r)"])
+		assert.Equal(t, map[int64]float64{0: 4, 10: 2}, resultSet.Series[0].Fields["s"])
+		assert.Equal(t, map[string]string{"dc": "y"}, resultSet.Series[1].Tags)
+		assert.Equal(t, map[int64]float64{10: 4}, resultSet.Series[1].Fields["max(r)"])
+		// sub query statement not changed
+		assert.Equal(t, []string{"host"}, statement.SubQuery.GroupBy)
+	})
+	t.Run("without group by", func(t *testing.T) {
+		metricSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			statement *stmtpkg.Query, _ *SearchMgr) (any, error) {
+			assert.Equal(t, []string{"host"}, statement.GroupBy)
+			return newResultSet(), nil
+		}
+		statement := parseJoinQuery(t, "select count(r) from (select rate(count) as r from http group by host)")
+		rs, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.NoError(t, err)
+		resultSet := rs.(*models.ResultSet)
+		assert.Len(t, resultSet.Series, 1)
+		assert.Equal(t, map[int64]float64{0: 2, 10: 2}, resultSet.Series[0].Fields["count(r)"])
+	})
+	t.Run("sub query failure", func(t *testing.T) {
+		metricSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			_ *stmtpkg.Query, _ *SearchMgr) (any, error) {
+			return nil, fmt.Errorf("err")
+		}
+		statement := parseJoinQuery(t, "select max(r) from (select rate(count) as r from http group by host)")
+		rs, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.Error(t, err)
+		assert.Nil(t, rs)
+	})
+	t.Run("kill outer query", func(t *testing.T) {
+		metricSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			_ *stmtpkg.Query, mgr *SearchMgr) (any, error) {
+			assert.True(t, isAliveRequest("req"))
+			assert.True(t, GetRequestManager().KillRequest("req"))
+			return nil, flow.NewChildTaskContext(mgr.parentTask).Err()
+		}
+		statement := parseJoinQuery(t, "select max(r) from (select rate(count) as r from http group by host)")
+		_, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.Equal(t, constants.ErrQueryKilled, err)
+		assert.False(t, isAliveRequest("req"))
+	})
+	t.Run("unexpected result set", func(t *testing.T) {
+		metricSearchFn = func(_ context.Context, _ *models.ExecuteParam,
+			_ *stmtpkg.Query, _ *SearchMgr) (any, error) {
+			return "rs", nil
+		}
+		statement := parseJoinQuery(t, "select max(r) from (select rate(count) as r from http group by host)")
+		rs, err := MetricDataSearch(context.TODO(), &models.ExecuteParam{}, statement, mgr)
+		assert.Error(t, err)
+		assert.Nil(t, rs)
+	})
+}
@@ -92,7 +92,18 @@ func (q *TaskHandler) Handle(stream protoCommonV1.TaskService_HandleServer) (err
 
 // process dispatches request with timeout
 func (q *TaskHandler) process(ctx context.Context, stream protoCommonV1.TaskService_HandleServer, req *protoCommonV1.TaskRequest) {
+	if req.GetRequestType() == protoCommonV1.RequestType_Kill {
+		// kill the tasks of request which executing on current node
+		if GetRequestManager().KillRequest(req.GetRequestID()) {
+			q.logger.Info("kill query request", logger.String("requestID", req.GetRequestID()))
+		}
+		// root only notifies broker nodes, so broker forwards kill request to storage nodes
+		q.processor.Kill(req.GetRequestID())
+		return
+	}
 	taskCtx := flow.NewTaskContextWithTimeout(ctx, q.timeout)
+	// attach task context for killing query
+	GetRequestManager().AttachTask(req.GetRequestID(), taskCtx)
 	q.taskPool.Submit(taskCtx.Ctx,
 		concurrent.NewTask(func() {
 			if err := q.processor.Process(taskCtx, stream, req); err != nil {
@@ -93,4 +93,20 @@ func TestTaskHandler_dispatch(t *testing.T) {
 	processor.EXPECT().Process(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 	handler.process(context.Background(), stream, req)
 	time.Sleep(300 * time.Millisecond)
+
+	// test kill request, notify downstream nodes
+	processor.EXPECT().Kill("kill-task")
+	processor.EXPECT().Kill("kill-task-not-found")
+	taskCtx := flow.NewTaskContextWithTimeout(context.Background(), time.Minute)
+	GetRequestManager().AttachTask("kill-task", taskCtx)
+	handler.process(context.Background(), stream, &protoCommonV1.TaskRequest{
+		RequestID:   "kill-task",
+		RequestType: protoCommonV1.RequestType_Kill,
+	})
+	assert.Equal(t, constants.ErrQueryKilled, taskCtx.Err())
+	// request not found
+	handler.process(context.Background(), stream, &protoCommonV1.TaskRequest{
+		RequestID:   "kill-task-not-found",
+		RequestType: protoCommonV1.RequestType_Kill,
+	})
 }
@@ -28,4 +28,6 @@ import (
 type TaskProcessor interface {
 	// Process processes the task request.
 	Process(ctx *flow.TaskContext, stream protoCommonV1.TaskService_HandleServer, req *protoCommonV1.TaskRequest) error
+	// Kill notifies the downstream nodes to kill the tasks of request.
+	Kill(requestID string)
 }
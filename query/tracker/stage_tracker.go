@@ -75,6 +75,11 @@ func NewStageTracker(taskCtx *flow.TaskContext) *StageTracker {
 	}
 }
 
+// TaskContext returns the task context which tracked.
+func (s *StageTracker) TaskContext() *flow.TaskContext {
+	return s.taskCtx
+}
+
 // AddStage adds a stage execution stats.
 func (s *StageTracker) AddStage(stage *models.StageStats) {
 	s.mutex.Lock()
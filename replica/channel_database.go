@@ -41,10 +41,14 @@ var (
 
 // DatabaseChannel represents the database level replication shardChannel
 type DatabaseChannel interface {
-	// Write writes the metric data into shardChannel's buffer
-	Write(ctx context.Context, brokerBatchRows *metric.BrokerBatchRows) error
+	// Write writes the metric data into shardChannel's buffer,
+	// blocks until acknowledged by storage if write consistency requires acknowledgement.
+	Write(ctx context.Context, consistency models.WriteConsistency, brokerBatchRows *metric.BrokerBatchRows) error
 	// CreateChannel creates the shard level replication shardChannel by given shard id
 	CreateChannel(numOfShard int32, shardID models.ShardID) (ShardChannel, error)
+	// SetShardRoutings sets current number of shards and the shard routing history,
+	// rows are routed by the number of shards of the routing which takes effect at the timestamp of row.
+	SetShardRoutings(numOfShard int32, routings models.ShardRoutings)
 	// Stop stops current database write shardChannel.
 	Stop()
 
@@ -66,6 +70,7 @@ type (
 		cancel        context.CancelFunc
 		fct           rpc.ClientStreamFactory
 		numOfShard    atomic.Int32
+		routings      atomic.Value // models.ShardRoutings
 		shardChannels shardChannels
 		interval      timeutil.Interval
 
@@ -102,6 +107,7 @@ func newDatabaseChannel(
 	ch.interval = databaseCfg.Option.Intervals[0].Interval
 
 	ch.numOfShard.Store(numOfShard)
+	ch.routings.Store(models.ShardRoutings(nil))
 
 	return ch
 }
@@ -121,9 +127,18 @@ func (dc *databaseChannel) garbageCollect() {
 	}
 }
 
-// Write writes the metric data into shardChannel's buffer
-func (dc *databaseChannel) Write(ctx context.Context, brokerBatchRows *metric.BrokerBatchRows) error {
-	var err error
+// Write writes the metric data into shardChannel's buffer,
+// blocks until acknowledged by storage if write consistency requires acknowledgement.
+func (dc *databaseChannel) Write(
+	ctx context.Context,
+	consistency models.WriteConsistency,
+	brokerBatchRows *metric.BrokerBatchRows,
+) error {
+	var (
+		err    error
+		waiter sync.WaitGroup
+		lock   sync.Mutex
+	)
 
 	behind := dc.behind.Load()
 	ahead := dc.ahead.Load()
@@ -132,14 +147,17 @@ func (dc *databaseChannel) Write(ctx context.Context, brokerBatchRows *metric.Br
 	dc.statistics.OutOfTimeRange.Add(float64(evicted))
 
 	// sharding metrics to shards
-	shardingIterator := brokerBatchRows.NewShardGroupIterator(dc.numOfShard.Load())
+	shardingIterator := brokerBatchRows.NewShardGroupIterator(dc.numOfShard.Load(),
+		dc.routings.Load().(models.ShardRoutings), dc.databaseCfg.Routing)
 	for shardingIterator.HasRowsForNextShard() {
 		shardIdx, familyIterator := shardingIterator.FamilyRowsForNextShard(dc.interval)
 		shardID := models.ShardID(shardIdx)
 		channel, ok := dc.getChannelByShardID(shardID)
 		if !ok {
 			dc.statistics.ShardNotFound.Incr()
+			lock.Lock()
 			err = errChannelNotFound
+			lock.Unlock()
 			// broker error, do not return to client
 			dc.logger.Error("shardChannel not found",
 				logger.String("database", dc.databaseCfg.Name),
@@ -149,16 +167,32 @@ func (dc *databaseChannel) Write(ctx context.Context, brokerBatchRows *metric.Br
 		for familyIterator.HasNextFamily() {
 			familyTime, rows := familyIterator.NextFamily()
 			familyChannel := channel.GetOrCreateFamilyChannel(familyTime)
-			if err = familyChannel.Write(ctx, rows); err != nil {
-				dc.logger.Error("failed writing rows to family shardChannel",
-					logger.String("database", dc.databaseCfg.Name),
-					logger.Int("shardID", shardID.Int()),
-					logger.Int("rows", len(rows)),
-					logger.Int64("familyTime", familyTime),
-					logger.Error(err))
+			write := func() {
+				if err0 := familyChannel.Write(ctx, rows, consistency); err0 != nil {
+					dc.logger.Error("failed writing rows to family shardChannel",
+						logger.String("database", dc.databaseCfg.Name),
+						logger.Int("shardID", shardID.Int()),
+						logger.Int("rows", len(rows)),
+						logger.Int64("familyTime", familyTime),
+						logger.Error(err0))
+					lock.Lock()
+					err = err0
+					lock.Unlock()
+				}
+			}
+			if !consistency.RequireAck() {
+				write()
+				continue
 			}
+			// wait for acknowledgement of all families concurrently
+			waiter.Add(1)
+			go func() {
+				defer waiter.Done()
+				write()
+			}()
 		}
 	}
+	waiter.Wait()
 	return err
 }
 
@@ -187,6 +221,15 @@ func (dc *databaseChannel) CreateChannel(numOfShard int32, shardID models.ShardI
 	return ch, nil
 }
 
+// SetShardRoutings sets current number of shards and the shard routing history,
+// rows are routed by the number of shards of the routing which takes effect at the timestamp of row.
+func (dc *databaseChannel) SetShardRoutings(numOfShard int32, routings models.ShardRoutings) {
+	if numOfShard > 0 {
+		dc.numOfShard.Store(numOfShard)
+	}
+	dc.routings.Store(routings)
+}
+
 // Stop stops current database write shardChannel.
 func (dc *databaseChannel) Stop() {
 	dc.shardChannels.mu.Lock()
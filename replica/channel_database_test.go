@@ -56,14 +56,14 @@ func TestDatabaseChannel_Write(t *testing.T) {
 			Tags: []*protoMetricsV1.KeyValue{{Key: "host", Value: "1.1.1.1"}},
 		}, row)
 	})
-	err := ch.Write(context.TODO(), batch)
+	err := ch.Write(context.TODO(), models.WriteConsistencyAny, batch)
 	assert.Equal(t, errChannelNotFound, err)
 
 	shardCh := NewMockShardChannel(ctrl)
 	ch1 := ch.(*databaseChannel)
 	ch1.insertShardChannel(models.ShardID(0), shardCh)
 	familyChannel := NewMockFamilyChannel(ctrl)
-	familyChannel.EXPECT().Write(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	familyChannel.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 	shardCh.EXPECT().GetOrCreateFamilyChannel(gomock.Any()).Return(familyChannel).AnyTimes()
 
 	batch = metric.NewBrokerBatchRows()
@@ -76,8 +76,13 @@ func TestDatabaseChannel_Write(t *testing.T) {
 			Tags: []*protoMetricsV1.KeyValue{{Key: "host", Value: "1.1.1.1"}},
 		}, row)
 	})
-	err = ch.Write(context.TODO(), batch)
+	err = ch.Write(context.TODO(), models.WriteConsistencyAny, batch)
 	assert.Error(t, err)
+
+	// wait for acknowledgement
+	familyChannel.EXPECT().Write(gomock.Any(), gomock.Any(), models.WriteConsistencyQuorum).Return(ErrIngestTimeout)
+	err = ch.Write(context.TODO(), models.WriteConsistencyQuorum, batch)
+	assert.Equal(t, ErrIngestTimeout, err)
 }
 
 func TestDatabaseChannel_CreateChannel(t *testing.T) {
@@ -127,3 +132,49 @@ func TestDatabaseChannel_Stop(t *testing.T) {
 	shardCh.EXPECT().Stop()
 	ch.Stop()
 }
+
+func TestDatabaseChannel_SetShardRoutings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	opt := &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}}
+	ch := newDatabaseChannel(context.TODO(),
+		models.Database{
+			Name:   "database",
+			Option: opt,
+		}, 4, nil)
+	ch1 := ch.(*databaseChannel)
+	// reduce shards from 4 to 1, new rows route to shard 0
+	routings := models.ShardRoutings{{NumOfShard: 4}, {NumOfShard: 1, CutOverTime: 1}}
+	ch.SetShardRoutings(4, routings)
+	assert.Equal(t, int32(4), ch1.numOfShard.Load())
+	assert.Equal(t, routings, ch1.routings.Load().(models.ShardRoutings))
+
+	shardCh := NewMockShardChannel(ctrl)
+	ch1.insertShardChannel(models.ShardID(0), shardCh)
+	familyChannel := NewMockFamilyChannel(ctrl)
+	familyChannel.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	shardCh.EXPECT().GetOrCreateFamilyChannel(gomock.Any()).Return(familyChannel).AnyTimes()
+	converter := metric.NewProtoConverter(models.NewDefaultLimits())
+	batch := metric.NewBrokerBatchRows()
+	for i := 0; i < 10; i++ {
+		i := i
+		_ = batch.TryAppend(func(row *metric.BrokerRow) error {
+			return converter.ConvertTo(&protoMetricsV1.Metric{
+				Name:      "cpu",
+				Timestamp: timeutil.Now(),
+				SimpleFields: []*protoMetricsV1.SimpleField{
+					{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1}},
+				Tags: []*protoMetricsV1.KeyValue{{Key: "host", Value: fmt.Sprintf("1.1.1.%d", i)}},
+			}, row)
+		})
+	}
+	assert.NoError(t, ch.Write(context.TODO(), models.WriteConsistencyAny, batch))
+
+	// retired shards removed, routings cleared
+	ch.SetShardRoutings(1, nil)
+	assert.Equal(t, int32(1), ch1.numOfShard.Load())
+	assert.Empty(t, ch1.routings.Load().(models.ShardRoutings))
+	// keep number of shards if not set
+	ch.SetShardRoutings(0, nil)
+	assert.Equal(t, int32(1), ch1.numOfShard.Load())
+}
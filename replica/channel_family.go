@@ -27,6 +27,7 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
@@ -41,8 +42,9 @@ import (
 type FamilyChannel interface {
 	// Write writes the data into the shardChannel,
 	// ErrCanceled is returned when the shardChannel is canceled before data is written successfully.
+	// If write consistency requires acknowledgement, flushes the data then blocks until storage acknowledges it.
 	// Concurrent safe.
-	Write(ctx context.Context, rows []metric.BrokerRow) error
+	Write(ctx context.Context, rows []metric.BrokerRow, consistency models.WriteConsistency) error
 	// leaderChanged notifies family shardChannel need change leader send stream
 	leaderChanged(shardState models.ShardState,
 		liveNodes map[models.NodeID]models.StatefulNode)
@@ -66,7 +68,7 @@ type familyChannel struct {
 	newWriteStreamFn func(
 		ctx context.Context,
 		target models.Node,
-		database string, shardState *models.ShardState, familyTime int64,
+		familyState *models.FamilyState,
 		fct rpc.ClientStreamFactory,
 	) (rpc.WriteStream, error)
 
@@ -76,16 +78,22 @@ type familyChannel struct {
 	currentTarget models.Node
 
 	// shardChannel to convert multiple goroutine writeTask to single goroutine writeTask to FanOutQueue
-	ch                  chan *compressedChunk
-	leaderChangedSignal chan struct{}
-	stoppedSignal       chan struct{}
-	stoppingSignal      chan struct{}
-	chunk               Chunk // buffer current writeTask metric for compress
+	ch                       chan *compressedChunk
+	leaderChangedSignal      chan struct{}
+	consistencyChangedSignal chan struct{}
+	stoppedSignal            chan struct{}
+	stoppingSignal           chan struct{}
+	chunk                    Chunk // buffer current writeTask metric for compress
+
+	consistency *atomic.String // write consistency of write stream
+	ackTimeout  time.Duration  // timeout of waiting for acknowledgement in storage
+	acks        sync.Map       // *compressedChunk => func(err error), acks of chunks waiting for acknowledgement
 
 	lastFlushTime      *atomic.Int64 // last flush time
 	checkFlushInterval time.Duration // interval for check flush
 	batchTimeout       time.Duration // interval for flush
 	maxRetryBuf        int
+	spill              *spillQueue // spill message to local disk when shard leader is unreachable, nil if disabled
 
 	lock4write sync.Mutex
 	lock4meta  sync.Mutex
@@ -106,26 +114,40 @@ func newFamilyChannel(
 ) FamilyChannel {
 	c, cancel := context.WithCancel(ctx)
 	fc := &familyChannel{
-		ctx:                 c,
-		cancel:              cancel,
-		database:            database,
-		shardID:             shardID,
-		familyTime:          familyTime,
-		fct:                 fct,
-		shardState:          shardState,
-		liveNodes:           liveNodes,
-		newWriteStreamFn:    rpc.NewWriteStream,
-		ch:                  make(chan *compressedChunk, 2),
-		leaderChangedSignal: make(chan struct{}, 1),
-		stoppedSignal:       make(chan struct{}, 1),
-		stoppingSignal:      make(chan struct{}, 1),
-		checkFlushInterval:  time.Second,
-		batchTimeout:        cfg.BatchTimeout.Duration(),
-		maxRetryBuf:         100, // TODO add config
-		chunk:               newChunk(cfg.BatchBlockSize),
-		lastFlushTime:       atomic.NewInt64(timeutil.Now()),
-		statistics:          metrics.NewBrokerFamilyWriteStatistics(database),
-		logger:              logger.GetLogger("Replica", "FamilyChannel"),
+		ctx:                      c,
+		cancel:                   cancel,
+		database:                 database,
+		shardID:                  shardID,
+		familyTime:               familyTime,
+		fct:                      fct,
+		shardState:               shardState,
+		liveNodes:                liveNodes,
+		newWriteStreamFn:         rpc.NewWriteStream,
+		ch:                       make(chan *compressedChunk, 2),
+		leaderChangedSignal:      make(chan struct{}, 1),
+		consistencyChangedSignal: make(chan struct{}, 1),
+		stoppedSignal:            make(chan struct{}, 1),
+		stoppingSignal:           make(chan struct{}, 1),
+		checkFlushInterval:       time.Second,
+		batchTimeout:             cfg.BatchTimeout.Duration(),
+		maxRetryBuf:              100, // TODO add config
+		chunk:                    newChunk(cfg.BatchBlockSize),
+		consistency:              atomic.NewString(string(models.WriteConsistencyAny)),
+		ackTimeout:               config.GlobalBrokerConfig().Ingestion.IngestTimeout.Duration(),
+		lastFlushTime:            atomic.NewInt64(timeutil.Now()),
+		statistics:               metrics.NewBrokerFamilyWriteStatistics(database),
+		logger:                   logger.GetLogger("Replica", "FamilyChannel"),
+	}
+
+	if cfg.SpillEnabled {
+		fc.spill = newSpillQueue(familySpillDir(cfg, database, shardID, familyTime), int64(cfg.SpillMaxSize), fc.statistics)
+		if err := fc.spill.recover(); err != nil {
+			fc.logger.Error("recover spilled message failure",
+				logger.String("database", database),
+				logger.Any("shard", shardID),
+				logger.Int64("family", familyTime),
+				logger.Error(err))
+		}
 	}
 
 	fc.statistics.ActiveWriteFamilies.Incr()
@@ -141,8 +163,45 @@ func newFamilyChannel(
 
 // Write writes the data into the shardChannel, ErrCanceled is returned when the ctx is canceled before
 // data is written successfully.
+// If write consistency requires acknowledgement, flushes the data then blocks until storage acknowledges it,
+// ErrIngestTimeout is returned when the ctx is done before acknowledged.
 // Concurrent safe.
-func (fc *familyChannel) Write(ctx context.Context, rows []metric.BrokerRow) error {
+func (fc *familyChannel) Write(ctx context.Context, rows []metric.BrokerRow, consistency models.WriteConsistency) error {
+	if consistency == "" {
+		consistency = models.WriteConsistencyAny
+	}
+	if fc.consistency.Load() != string(consistency) {
+		fc.consistency.Store(string(consistency))
+		// write consistency changed, need re-create write stream with new consistency
+		select {
+		case fc.consistencyChangedSignal <- struct{}{}:
+		default:
+		}
+	}
+	acks, err := fc.write(ctx, rows, consistency.RequireAck())
+	if err != nil {
+		return err
+	}
+	// wait for acknowledgement of storage
+	for _, ack := range acks {
+		select {
+		case err = <-ack:
+			if err != nil {
+				fc.statistics.AckFailures.Incr()
+				return err
+			}
+		case <-ctx.Done(): // timeout of http ingestion api
+			fc.statistics.AckFailures.Incr()
+			return ErrIngestTimeout
+		case <-fc.ctx.Done():
+			return ErrFamilyChannelCanceled
+		}
+	}
+	return nil
+}
+
+// write writes the data into chunk, if requireAck, flushes the chunk and returns the acks of flushed chunks.
+func (fc *familyChannel) write(ctx context.Context, rows []metric.BrokerRow, requireAck bool) (acks []chan error, err error) {
 	total := len(rows)
 	success := 0
 
@@ -156,17 +215,30 @@ func (fc *familyChannel) Write(ctx context.Context, rows []metric.BrokerRow) err
 	}()
 
 	for idx := 0; idx < total; idx++ {
-		if _, err := rows[idx].WriteTo(fc.chunk); err != nil {
-			return err
+		if _, err = rows[idx].WriteTo(fc.chunk); err != nil {
+			return nil, err
 		}
-
-		if err := fc.flushChunkOnFull(ctx); err != nil {
-			return err
+		if !requireAck {
+			if err = fc.flushChunkOnFull(ctx); err != nil {
+				return nil, err
+			}
+		} else if fc.chunk.IsFull() {
+			ack, err0 := fc.flushChunkWithAck(ctx)
+			if err0 != nil {
+				return nil, err0
+			}
+			acks = append(acks, ack)
 		}
 		success++
 	}
-
-	return nil
+	if requireAck && !fc.chunk.IsEmpty() {
+		ack, err0 := fc.flushChunkWithAck(ctx)
+		if err0 != nil {
+			return nil, err0
+		}
+		acks = append(acks, ack)
+	}
+	return acks, nil
 }
 
 // leaderChanged notifies family shardChannel need change leader send stream
@@ -179,7 +251,12 @@ func (fc *familyChannel) leaderChanged(
 	fc.liveNodes = liveNodes
 	fc.lock4meta.Unlock()
 
-	fc.leaderChangedSignal <- struct{}{}
+	// don't block shard channel if write task is draining old stream(signal pending),
+	// write task creates new stream with the latest shard state.
+	select {
+	case fc.leaderChangedSignal <- struct{}{}:
+	default:
+	}
 	fc.statistics.LeaderChanged.Incr()
 }
 
@@ -203,6 +280,60 @@ func (fc *familyChannel) flushChunkOnFull(ctx context.Context) error {
 	}
 }
 
+// flushChunkWithAck flushes the chunk data, returns the ack which receives the acknowledgement of storage.
+func (fc *familyChannel) flushChunkWithAck(ctx context.Context) (chan error, error) {
+	compressed, err := fc.chunk.Compress()
+	if err != nil {
+		return nil, err
+	}
+	ack := make(chan error, 1)
+	fc.acks.Store(compressed, func(err error) {
+		ack <- err
+	})
+	select {
+	case <-ctx.Done(): // timeout of http ingestion api
+		fc.acks.Delete(compressed)
+		return nil, ErrIngestTimeout
+	case <-fc.ctx.Done():
+		fc.acks.Delete(compressed)
+		return nil, ErrFamilyChannelCanceled
+	case fc.ch <- compressed:
+		fc.lastFlushTime.Store(timeutil.Now())
+		fc.statistics.PendingSend.Incr()
+		return ack, nil
+	}
+}
+
+// ackChunk notifies the acknowledgement of chunk if it has ack.
+func (fc *familyChannel) ackChunk(compressed *compressedChunk, err error) {
+	if ack, ok := fc.acks.LoadAndDelete(compressed); ok {
+		ack.(func(err error))(err)
+	}
+}
+
+// spillChunk spills the chunk to local disk, returns false if spill disabled or failure.
+// Chunk waiting for acknowledgement cannot be spilled, because the writer needs the result of write.
+func (fc *familyChannel) spillChunk(compressed *compressedChunk) bool {
+	if fc.spill == nil {
+		return false
+	}
+	if _, hasAck := fc.acks.Load(compressed); hasAck {
+		return false
+	}
+	if err := fc.spill.Put(*compressed); err != nil {
+		fc.statistics.SpillFailures.Incr()
+		fc.logger.Error("spill message to local disk failure",
+			logger.String("database", fc.database),
+			logger.Any("shard", fc.shardID),
+			logger.Error(err))
+		return false
+	}
+	fc.statistics.Spill.Incr()
+	fc.statistics.PendingSend.Decr()
+	compressed.Release()
+	return true
+}
+
 // writeTask consumes data from chan, then appends the data into queue
 func (fc *familyChannel) writeTask(_ context.Context) {
 	// on avg 2 * limit could avoid buffer grow
@@ -212,55 +343,86 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 	retryBuffers := make([]*compressedChunk, 0)
 	retry := func(compressed *compressedChunk) {
 		if len(retryBuffers) > fc.maxRetryBuf {
+			if fc.spillChunk(compressed) {
+				return
+			}
 			fc.logger.Error("too many retry messages, drop current message")
 			fc.statistics.RetryDrop.Incr()
+			fc.ackChunk(compressed, constants.ErrWriteStreamClosed)
 		} else {
 			retryBuffers = append(retryBuffers, compressed)
 			fc.statistics.Retry.Incr()
 		}
 	}
 	var stream rpc.WriteStream
-	send := func(compressed *compressedChunk) bool {
-		if compressed == nil {
-			return true
-		}
-		if len(*compressed) == 0 {
-			compressed.Release()
-			return true
-		}
+	// getStream returns the write stream of shard leader, creates a new one if not exist.
+	getStream := func() bool {
 		if stream == nil {
 			fc.lock4meta.Lock()
 			leader := fc.liveNodes[fc.shardState.Leader]
-			shardState := fc.shardState
+			familyState := &models.FamilyState{
+				Database:         fc.database,
+				Shard:            fc.shardState,
+				FamilyTime:       fc.familyTime,
+				WriteConsistency: models.WriteConsistency(fc.consistency.Load()),
+				AckTimeout:       fc.ackTimeout.Milliseconds(),
+			}
 			fc.currentTarget = &leader
 			fc.lock4meta.Unlock()
-			s, err := fc.newWriteStreamFn(fc.ctx, fc.currentTarget, fc.database, &shardState, fc.familyTime, fc.fct)
+			s, err := fc.newWriteStreamFn(fc.ctx, fc.currentTarget, familyState, fc.fct)
 			if err != nil {
 				fc.statistics.CreateStreamFailures.Incr()
-				retry(compressed)
 				return false
 			}
 			fc.statistics.CreateStream.Incr()
 			stream = s
 		}
-		if err := stream.Send(*compressed); err != nil {
-			fc.statistics.SendFailure.Incr()
-			fc.logger.Error(
-				"failed writing compressed chunk to storage",
-				logger.String("target", fc.currentTarget.Indicator()),
-				logger.String("database", fc.database),
-				logger.Error(err))
-			if err == io.EOF {
-				if closeError := stream.Close(); closeError != nil {
-					fc.statistics.CloseStreamFailures.Incr()
-					fc.logger.Error("failed closing write stream",
-						logger.String("target", fc.currentTarget.Indicator()),
-						logger.Error(closeError))
-				} else {
-					fc.statistics.CloseStream.Incr()
-				}
-				stream = nil
+		return true
+	}
+	sendFailure := func(err error) {
+		fc.statistics.SendFailure.Incr()
+		fc.logger.Error(
+			"failed writing compressed chunk to storage",
+			logger.String("target", fc.currentTarget.Indicator()),
+			logger.String("database", fc.database),
+			logger.Error(err))
+		if err == io.EOF {
+			if closeError := stream.Close(); closeError != nil {
+				fc.statistics.CloseStreamFailures.Incr()
+				fc.logger.Error("failed closing write stream",
+					logger.String("target", fc.currentTarget.Indicator()),
+					logger.Error(closeError))
+			} else {
+				fc.statistics.CloseStream.Incr()
 			}
+			stream = nil
+		}
+	}
+	send := func(compressed *compressedChunk) bool {
+		if compressed == nil {
+			return true
+		}
+		if len(*compressed) == 0 {
+			compressed.Release()
+			return true
+		}
+		if !getStream() {
+			retry(compressed)
+			return false
+		}
+		var err error
+		ack, hasAck := fc.acks.LoadAndDelete(compressed)
+		if hasAck {
+			err = stream.SendWithAck(*compressed, ack.(func(err error)))
+		} else {
+			err = stream.Send(*compressed)
+		}
+		if err != nil {
+			if hasAck {
+				// keep ack for retry
+				fc.acks.Store(compressed, ack)
+			}
+			sendFailure(err)
 			// retry if err
 			retry(compressed)
 			return false
@@ -271,8 +433,38 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 		compressed.Release()
 		return true
 	}
+	// replay sends the spilled message to shard leader after write stream recovered.
+	replay := func() {
+		if fc.spill == nil || fc.spill.IsEmpty() || !getStream() {
+			return
+		}
+		for i := 0; i < maxReplayPerRound; i++ {
+			data, err := fc.spill.Peek()
+			if err != nil {
+				fc.logger.Error("read spilled message failure",
+					logger.String("database", fc.database),
+					logger.Any("shard", fc.shardID),
+					logger.Error(err))
+				return
+			}
+			if data == nil {
+				return
+			}
+			if err = stream.Send(data); err != nil {
+				sendFailure(err)
+				return
+			}
+			fc.statistics.SendSuccess.Incr()
+			fc.statistics.SendSize.Add(float64(len(data)))
+			fc.statistics.SpillReplay.Incr()
+			fc.spill.Ack(len(data))
+		}
+	}
 
 	defer func() {
+		if fc.spill != nil {
+			fc.spill.Close()
+		}
 		if stream != nil {
 			if err := stream.Close(); err != nil {
 				fc.statistics.CloseStreamFailures.Incr()
@@ -289,8 +481,9 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 			fc.stoppedSignal <- struct{}{}
 		}()
 		sendLastMsg := func(compressed *compressedChunk) {
-			if !send(compressed) {
+			if !send(compressed) && fc.spill == nil {
 				fc.logger.Error("send message failure before close channel, message lost")
+				fc.ackChunk(compressed, ErrFamilyChannelCanceled)
 			}
 		}
 		// flush chunk pending data if chunk not empty
@@ -304,6 +497,16 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 			}
 		}
 		fc.sendPendingMessage(sendLastMsg)
+		if fc.spill != nil {
+			// spill failure message, replays them after family channel recovered(e.g. broker restart).
+			for _, msg := range retryBuffers {
+				if !fc.spillChunk(msg) {
+					fc.logger.Error("spill message failure before close channel, message lost")
+					fc.ackChunk(msg, ErrFamilyChannelCanceled)
+				}
+			}
+			retryBuffers = nil
+		}
 	}
 	var err error
 	for {
@@ -325,6 +528,17 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 				}
 				stream = nil
 			}
+		case <-fc.consistencyChangedSignal:
+			if stream != nil {
+				fc.logger.Info("write consistency changed, need re-create send stream",
+					logger.String("target", fc.currentTarget.Indicator()),
+					logger.String("database", fc.database),
+					logger.String("consistency", fc.consistency.Load()))
+				if err = stream.Close(); err != nil {
+					fc.logger.Error("close write stream err when write consistency changed", logger.Error(err))
+				}
+				stream = nil
+			}
 		case compressed := <-fc.ch:
 			if send(compressed) {
 				// if send ok, retry pending message
@@ -337,12 +551,14 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 						}
 					}
 				}
+				replay()
 			} else {
 				stream = nil
 			}
 		case <-ticker.C:
 			// check
 			fc.checkFlush()
+			replay()
 		}
 	}
 }
@@ -404,6 +620,10 @@ func (fc *familyChannel) flushChunk() {
 
 // isExpire returns if current family is expired.
 func (fc *familyChannel) isExpire(ahead, _ int64) bool {
+	if fc.spill != nil && !fc.spill.IsEmpty() {
+		// keep family channel until all spilled message replayed
+		return false
+	}
 	now := timeutil.Now()
 	fc.logger.Info("family channel expire check",
 		logger.String("database", fc.database),
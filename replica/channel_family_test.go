@@ -32,8 +32,10 @@ import (
 	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/rpc"
@@ -115,16 +117,18 @@ func TestFamilyChannel_Write(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			ch := &familyChannel{
-				chunk:          chunk,
-				stoppedSignal:  make(chan struct{}, 1),
-				stoppingSignal: make(chan struct{}, 1),
-				statistics:     metrics.NewBrokerFamilyWriteStatistics("db"),
+				chunk:                    chunk,
+				stoppedSignal:            make(chan struct{}, 1),
+				stoppingSignal:           make(chan struct{}, 1),
+				consistencyChangedSignal: make(chan struct{}, 1),
+				consistency:              atomic.NewString(string(models.WriteConsistencyAny)),
+				statistics:               metrics.NewBrokerFamilyWriteStatistics("db"),
 			}
 			if tt.prepare != nil {
 				tt.prepare()
 			}
 
-			err := ch.Write(context.TODO(), tt.rows)
+			err := ch.Write(context.TODO(), tt.rows, models.WriteConsistencyAny)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Write() error = %v, wantErr %v", err, tt.wantErr)
@@ -133,6 +137,92 @@ func TestFamilyChannel_Write(t *testing.T) {
 	}
 }
 
+func TestFamilyChannel_Write_WithAck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var brokerRow metric.BrokerRow
+	converter := metric.NewProtoConverter(models.NewDefaultLimits())
+	assert.NoError(t, converter.ConvertTo(&protoMetricsV1.Metric{
+		Name:      "cpu",
+		Timestamp: timeutil.Now(),
+		SimpleFields: []*protoMetricsV1.SimpleField{
+			{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1}},
+	}, &brokerRow))
+
+	cases := []struct {
+		name    string
+		prepare func(f *familyChannel, chunk *MockChunk)
+		wantErr error
+	}{
+		{
+			name: "compress failure",
+			prepare: func(_ *familyChannel, chunk *MockChunk) {
+				chunk.EXPECT().IsFull().Return(false)
+				chunk.EXPECT().IsEmpty().Return(false)
+				chunk.EXPECT().Compress().Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: fmt.Errorf("err"),
+		},
+		{
+			name: "acknowledged",
+			prepare: func(f *familyChannel, chunk *MockChunk) {
+				chunk.EXPECT().IsFull().Return(true)
+				chunk.EXPECT().IsEmpty().Return(true)
+				chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
+				go func() {
+					f.ackChunk(<-f.ch, nil)
+				}()
+			},
+		},
+		{
+			name: "not acknowledged",
+			prepare: func(f *familyChannel, chunk *MockChunk) {
+				chunk.EXPECT().IsFull().Return(false)
+				chunk.EXPECT().IsEmpty().Return(false)
+				chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
+				go func() {
+					f.ackChunk(<-f.ch, constants.ErrWriteNotAcknowledged)
+				}()
+			},
+			wantErr: constants.ErrWriteNotAcknowledged,
+		},
+		{
+			name: "wait acknowledgement timeout",
+			prepare: func(_ *familyChannel, chunk *MockChunk) {
+				chunk.EXPECT().IsFull().Return(false)
+				chunk.EXPECT().IsEmpty().Return(false)
+				chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
+			},
+			wantErr: ErrIngestTimeout,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			chunk := NewMockChunk(ctrl)
+			chunk.EXPECT().Write(gomock.Any()).Return(0, nil)
+			f := &familyChannel{
+				ctx:                      context.TODO(),
+				ch:                       make(chan *compressedChunk, 2),
+				chunk:                    chunk,
+				consistencyChangedSignal: make(chan struct{}, 1),
+				consistency:              atomic.NewString(string(models.WriteConsistencyAny)),
+				lastFlushTime:            atomic.NewInt64(timeutil.Now()),
+				statistics:               metrics.NewBrokerFamilyWriteStatistics("db"),
+			}
+			tt.prepare(f, chunk)
+			ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+			defer cancel()
+			err := f.Write(ctx, []metric.BrokerRow{brokerRow}, models.WriteConsistencyQuorum)
+			assert.Equal(t, tt.wantErr, err)
+			// write consistency changed, need re-create write stream
+			assert.Len(t, f.consistencyChangedSignal, 1)
+			assert.Equal(t, string(models.WriteConsistencyQuorum), f.consistency.Load())
+		})
+	}
+}
+
 func TestFamilyChannel_leaderChanged(t *testing.T) {
 	shard := models.ShardState{ID: 1}
 	liveNodes := make(map[models.NodeID]models.StatefulNode)
@@ -145,6 +235,13 @@ func TestFamilyChannel_leaderChanged(t *testing.T) {
 	assert.Equal(t, shard, fc.shardState)
 	assert.Equal(t, liveNodes, fc.liveNodes)
 	fc.lock4meta.Unlock()
+	// signal pending, not block
+	shard2 := models.ShardState{ID: 1, Leader: 2}
+	fc.leaderChanged(shard2, liveNodes)
+	fc.lock4meta.Lock()
+	assert.Equal(t, shard2, fc.shardState)
+	fc.lock4meta.Unlock()
+	assert.Len(t, fc.leaderChangedSignal, 1)
 }
 
 func TestChannel_checkFlush(t *testing.T) {
@@ -325,8 +422,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				chunk.EXPECT().IsEmpty().Return(false)
 				chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
-					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					familyState *models.FamilyState, fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
 					return nil, fmt.Errorf("err")
 				}
 				go func() {
@@ -343,8 +439,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
-					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					familyState *models.FamilyState, fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Close()
@@ -363,8 +458,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
-					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					familyState *models.FamilyState, fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Close().Return(nil)
@@ -383,8 +477,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
-					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					familyState *models.FamilyState, fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Close().Return(fmt.Errorf("err"))
@@ -403,8 +496,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
-					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					familyState *models.FamilyState, fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Close().Return(fmt.Errorf("err"))
@@ -422,8 +514,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				chunk.EXPECT().IsEmpty().Return(true).AnyTimes()
 				lastCh := make(chan struct{})
 				f.newWriteStreamFn = func(_ context.Context, _ models.Node,
-					_ string, _ *models.ShardState, _ int64,
-					_ rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					_ *models.FamilyState, _ rpc.ClientStreamFactory) (rpc.WriteStream, error) {
 					time.Sleep(100 * time.Millisecond)
 					return nil, fmt.Errorf("err")
 				}
@@ -448,8 +539,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				chunk.EXPECT().IsEmpty().Return(true).AnyTimes()
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
-					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					familyState *models.FamilyState, fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Send(gomock.Any()).Return(nil)
@@ -464,6 +554,66 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				}()
 			},
 		},
+		{
+			name: "send msg with ack, write consistency changed",
+			prepare: func(f *familyChannel) {
+				chunk := NewMockChunk(ctrl)
+				f.chunk = chunk
+				chunk.EXPECT().IsEmpty().Return(true).AnyTimes()
+				stream := rpc.NewMockWriteStream(ctrl)
+				f.consistency.Store(string(models.WriteConsistencyQuorum))
+				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
+					familyState *models.FamilyState, fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					assert.Equal(t, models.WriteConsistencyQuorum, familyState.WriteConsistency)
+					return stream, nil
+				}
+				stream.EXPECT().SendWithAck(gomock.Any(), gomock.Any()).DoAndReturn(func(_ []byte, ack func(err error)) error {
+					ack(nil)
+					return nil
+				})
+				stream.EXPECT().Close().Return(fmt.Errorf("err"))
+				compressed := &compressedChunk{1, 2, 3}
+				f.acks.Store(compressed, func(err error) {
+					assert.NoError(t, err)
+				})
+				f.ch <- compressed
+
+				go func() {
+					time.Sleep(200 * time.Millisecond)
+					f.consistencyChangedSignal <- struct{}{} // mock write consistency change
+					time.Sleep(20 * time.Millisecond)
+					f.Stop(10)
+				}()
+			},
+		},
+		{
+			name: "send msg with ack failure, retry drop",
+			prepare: func(f *familyChannel) {
+				chunk := NewMockChunk(ctrl)
+				f.chunk = chunk
+				f.maxRetryBuf = 0
+				chunk.EXPECT().IsEmpty().Return(true).AnyTimes()
+				stream := rpc.NewMockWriteStream(ctrl)
+				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
+					familyState *models.FamilyState, fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					return stream, nil
+				}
+				stream.EXPECT().SendWithAck(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err")).AnyTimes()
+				stream.EXPECT().Close().Return(nil).AnyTimes()
+				for i := 0; i < 2; i++ {
+					compressed := &compressedChunk{1, 2, 3}
+					f.acks.Store(compressed, func(err error) {
+						assert.Error(t, err)
+					})
+					f.ch <- compressed
+				}
+
+				go func() {
+					time.Sleep(200 * time.Millisecond)
+					f.Stop(10)
+				}()
+			},
+		},
 		{
 			name: "send msg failure, retry drop",
 			prepare: func(f *familyChannel) {
@@ -473,8 +623,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				chunk.EXPECT().IsEmpty().Return(true).AnyTimes()
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
-					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					familyState *models.FamilyState, fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err")).AnyTimes()
@@ -497,8 +646,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				chunk.EXPECT().IsEmpty().Return(true).AnyTimes()
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
-					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					familyState *models.FamilyState, fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
@@ -521,17 +669,19 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 		t.Run(tt.name, func(_ *testing.T) {
 			ctx, cancel := context.WithCancel(context.TODO())
 			f := &familyChannel{
-				cancel:              cancel,
-				ctx:                 ctx,
-				ch:                  make(chan *compressedChunk, 2),
-				maxRetryBuf:         1,
-				checkFlushInterval:  time.Millisecond * 100,
-				lastFlushTime:       atomic.NewInt64(timeutil.Now()),
-				shardState:          models.ShardState{ID: 0, Leader: 1},
-				leaderChangedSignal: make(chan struct{}, 1),
-				stoppedSignal:       make(chan struct{}, 1),
-				stoppingSignal:      make(chan struct{}, 1),
-				currentTarget:       &models.StatefulNode{},
+				cancel:                   cancel,
+				ctx:                      ctx,
+				ch:                       make(chan *compressedChunk, 2),
+				maxRetryBuf:              1,
+				checkFlushInterval:       time.Millisecond * 100,
+				lastFlushTime:            atomic.NewInt64(timeutil.Now()),
+				shardState:               models.ShardState{ID: 0, Leader: 1},
+				leaderChangedSignal:      make(chan struct{}, 1),
+				consistencyChangedSignal: make(chan struct{}, 1),
+				stoppedSignal:            make(chan struct{}, 1),
+				stoppingSignal:           make(chan struct{}, 1),
+				consistency:              atomic.NewString(string(models.WriteConsistencyAny)),
+				currentTarget:            &models.StatefulNode{},
 				liveNodes: map[models.NodeID]models.StatefulNode{
 					1: {},
 				},
@@ -562,3 +712,89 @@ func TestFamilyChannel_sendingLastMessage(t *testing.T) {
 	close(f.ch)
 	wait.Wait()
 }
+
+func TestFamilyChannel_spill(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		spilledSize.Store(0)
+		ctrl.Finish()
+	}()
+	dir := t.TempDir()
+	newFamily := func(newWriteStreamFn func(ctx context.Context, target models.Node,
+		familyState *models.FamilyState, fct rpc.ClientStreamFactory) (rpc.WriteStream, error)) *familyChannel {
+		ctx, cancel := context.WithCancel(context.TODO())
+		chunk := NewMockChunk(ctrl)
+		chunk.EXPECT().IsEmpty().Return(true).AnyTimes()
+		statistics := metrics.NewBrokerFamilyWriteStatistics("db")
+		return &familyChannel{
+			cancel:                   cancel,
+			ctx:                      ctx,
+			chunk:                    chunk,
+			ch:                       make(chan *compressedChunk, 2),
+			maxRetryBuf:              1,
+			checkFlushInterval:       time.Millisecond * 10,
+			batchTimeout:             time.Hour,
+			lastFlushTime:            atomic.NewInt64(timeutil.Now()),
+			shardState:               models.ShardState{ID: 0, Leader: 1},
+			leaderChangedSignal:      make(chan struct{}, 1),
+			consistencyChangedSignal: make(chan struct{}, 1),
+			stoppedSignal:            make(chan struct{}, 1),
+			stoppingSignal:           make(chan struct{}, 1),
+			consistency:              atomic.NewString(string(models.WriteConsistencyAny)),
+			currentTarget:            &models.StatefulNode{},
+			liveNodes: map[models.NodeID]models.StatefulNode{
+				1: {},
+			},
+			newWriteStreamFn: newWriteStreamFn,
+			spill:            newSpillQueue(dir, 1024*1024*1024, statistics),
+			statistics:       statistics,
+			logger:           logger.GetLogger("Replica", "Test"),
+		}
+	}
+	// shard leader is unreachable, spill message to local disk
+	f := newFamily(func(ctx context.Context, target models.Node,
+		familyState *models.FamilyState, fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+		return nil, fmt.Errorf("err")
+	})
+	ack := make(chan error, 1)
+	ackChunk := &compressedChunk{9}
+	f.acks.Store(ackChunk, func(err error) {
+		ack <- err
+	})
+	go func() {
+		f.ch <- &compressedChunk{1}
+		f.ch <- &compressedChunk{2}
+		f.ch <- &compressedChunk{3}
+		f.ch <- ackChunk
+		time.Sleep(50 * time.Millisecond)
+		assert.False(t, f.isExpire(0, 0))
+		f.Stop(timeutil.OneSecond)
+	}()
+	f.writeTask(context.TODO())
+	// chunk waiting for acknowledgement cannot be spilled
+	assert.Error(t, <-ack)
+	assert.True(t, fileutil.Exist(dir))
+
+	// shard leader recovered, replay spilled message
+	var sent []byte
+	stream := rpc.NewMockWriteStream(ctrl)
+	stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(data []byte) error {
+		sent = append(sent, data...)
+		return nil
+	}).AnyTimes()
+	stream.EXPECT().Close().Return(nil)
+	f = newFamily(func(ctx context.Context, target models.Node,
+		familyState *models.FamilyState, fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+		return stream, nil
+	})
+	assert.NoError(t, f.spill.recover())
+	assert.False(t, f.spill.IsEmpty())
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		f.Stop(timeutil.OneSecond)
+	}()
+	f.writeTask(context.TODO())
+	assert.ElementsMatch(t, []byte{1, 2, 3}, sent)
+	assert.False(t, fileutil.Exist(dir))
+	assert.Equal(t, int64(0), spilledSize.Load())
+}
@@ -98,7 +98,11 @@ func (cm *channelManager) Write(ctx context.Context, database string, brokerBatc
 		return nil
 	}
 	if databaseChannel, ok := cm.getDatabaseChannel(database); ok {
-		return databaseChannel.Write(ctx, brokerBatchRows)
+		var consistency models.WriteConsistency
+		if databaseCfg, ok := cm.stateMgr.GetDatabaseCfg(database); ok {
+			consistency = databaseCfg.WriteConsistency
+		}
+		return databaseChannel.Write(ctx, consistency, brokerBatchRows)
 	}
 	return fmt.Errorf("database [%s] not found", database)
 }
@@ -170,6 +174,7 @@ func (cm *channelManager) insertDatabaseChannel(newDatabaseName string, newChann
 // handleShardStateChangeEvent handles shard state change event.
 func (cm *channelManager) handleShardStateChangeEvent(
 	databaseCfg models.Database,
+	routings models.ShardRoutings,
 	shards map[models.ShardID]models.ShardState,
 	liveNodes map[models.NodeID]models.StatefulNode,
 ) {
@@ -184,6 +189,9 @@ func (cm *channelManager) handleShardStateChangeEvent(
 			ch.SyncShardState(shardState, liveNodes)
 		}
 	}
+	if ch, ok := cm.getDatabaseChannel(databaseCfg.Name); ok {
+		ch.SetShardRoutings(int32(numOfShard), routings)
+	}
 }
 
 // gcWriteFamilies recycles write families which is expired.
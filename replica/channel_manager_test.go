@@ -92,7 +92,9 @@ func TestChannelManager_Write(t *testing.T) {
 	dbChannel.EXPECT().Stop()
 	cm1 := cm.(*channelManager)
 	cm1.insertDatabaseChannel("database", dbChannel)
-	dbChannel.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	dbChannel.EXPECT().Write(gomock.Any(), models.WriteConsistencyQuorum, gomock.Any()).Return(nil).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseCfg("database").
+		Return(models.Database{WriteConsistency: models.WriteConsistencyQuorum}, true).AnyTimes()
 	dbChannel.EXPECT().Stop().AnyTimes()
 	err = cm.Write(context.TODO(), "database", nil)
 	assert.NoError(t, err)
@@ -124,6 +126,7 @@ func TestChannelManager_handleShardStateChangeEvent(t *testing.T) {
 	cases := []struct {
 		name      string
 		db        models.Database
+		routings  models.ShardRoutings
 		shards    map[models.ShardID]models.ShardState
 		liveNodes map[models.NodeID]models.StatefulNode
 		prepare   func()
@@ -137,6 +140,9 @@ func TestChannelManager_handleShardStateChangeEvent(t *testing.T) {
 			shards: map[models.ShardID]models.ShardState{
 				3: {ID: 3},
 			},
+			prepare: func() {
+				dbChannel.EXPECT().SetShardRoutings(int32(1), gomock.Nil())
+			},
 		},
 		{
 			name: "sync shard state successfully",
@@ -148,6 +154,22 @@ func TestChannelManager_handleShardStateChangeEvent(t *testing.T) {
 				shardCh := NewMockShardChannel(ctrl)
 				dbChannel.EXPECT().CreateChannel(gomock.Any(), gomock.Any()).Return(shardCh, nil)
 				shardCh.EXPECT().SyncShardState(gomock.Any(), gomock.Any())
+				dbChannel.EXPECT().SetShardRoutings(int32(1), gomock.Nil())
+			},
+		},
+		{
+			name:     "sync shard routings",
+			db:       models.Database{Name: "database", NumOfShard: 2},
+			routings: models.ShardRoutings{{NumOfShard: 2}, {NumOfShard: 1, CutOverTime: 10}},
+			shards: map[models.ShardID]models.ShardState{
+				0: {ID: 0},
+				1: {ID: 1},
+			},
+			prepare: func() {
+				shardCh := NewMockShardChannel(ctrl)
+				dbChannel.EXPECT().CreateChannel(gomock.Any(), gomock.Any()).Return(shardCh, nil).Times(2)
+				shardCh.EXPECT().SyncShardState(gomock.Any(), gomock.Any()).Times(2)
+				dbChannel.EXPECT().SetShardRoutings(int32(2), models.ShardRoutings{{NumOfShard: 2}, {NumOfShard: 1, CutOverTime: 10}})
 			},
 		},
 	}
@@ -158,7 +180,7 @@ func TestChannelManager_handleShardStateChangeEvent(t *testing.T) {
 			if tt.prepare != nil {
 				tt.prepare()
 			}
-			cm.handleShardStateChangeEvent(tt.db, tt.shards, tt.liveNodes)
+			cm.handleShardStateChangeEvent(tt.db, tt.routings, tt.shards, tt.liveNodes)
 		})
 	}
 }
@@ -19,10 +19,12 @@ package replica
 
 import (
 	"context"
+	"strconv"
 	"sync"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/rpc"
@@ -61,6 +63,7 @@ type shardChannel struct {
 	families   *familyChannelSet // send shardChannel for each family time
 	shardState models.ShardState
 	liveNodes  map[models.NodeID]models.StatefulNode
+	recovered  bool // if recovered family channels of spilled message
 
 	mutex sync.Mutex
 
@@ -101,6 +104,49 @@ func (c *shardChannel) SyncShardState(shardState models.ShardState, liveNodes ma
 			logger.String("db", c.database),
 			logger.Any("shardID", c.shardID))
 	}
+	if !c.recovered {
+		c.recovered = true
+		c.recoverSpilledFamilies()
+	}
+}
+
+// recoverSpilledFamilies creates family channels for spilled message left by last running(e.g. broker restart),
+// so that spilled message can be replayed to shard leader.
+func (c *shardChannel) recoverSpilledFamilies() {
+	if !c.cfg.SpillEnabled {
+		return
+	}
+	dir := shardSpillDir(c.cfg, c.database, c.shardID)
+	if !fileutil.Exist(dir) {
+		return
+	}
+	names, err := listSpillDirFn(dir)
+	if err != nil {
+		c.logger.Error("list spilled family failure",
+			logger.String("db", c.database),
+			logger.Any("shardID", c.shardID),
+			logger.Error(err))
+		return
+	}
+	for _, name := range names {
+		familyTime, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			c.logger.Warn("invalid spilled family dir, ignore it",
+				logger.String("db", c.database),
+				logger.Any("shardID", c.shardID),
+				logger.String("family", name))
+			continue
+		}
+		if _, exist := c.families.GetFamilyChannel(familyTime); exist {
+			continue
+		}
+		familyChannel := newFamilyChannel(c.ctx, c.cfg, c.database, c.shardID, familyTime, c.fct, c.shardState, c.liveNodes)
+		c.families.InsertFamily(familyTime, familyChannel)
+		c.logger.Info("recover family channel for spilled message",
+			logger.String("db", c.database),
+			logger.Any("shardID", c.shardID),
+			logger.String("family", timeutil.FormatTimestamp(familyTime, timeutil.DataTimeFormat4)))
+	}
 }
 
 // GetOrCreateFamilyChannel returns family shardChannel by given family time.
@@ -19,12 +19,22 @@ package replica
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/rpc"
 )
 
 func TestShardChannel_SyncShardState(t *testing.T) {
@@ -113,3 +123,56 @@ func TestShardChannel_GetOrCreateFamilyChannel(t *testing.T) {
 	f3 := ch.GetOrCreateFamilyChannel(3)
 	assert.Equal(t, f1, f3)
 }
+
+func TestShardChannel_recoverSpilledFamilies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		listSpillDirFn = fileutil.GetDirectoryList
+		spilledSize.Store(0)
+		ctrl.Finish()
+	}()
+	fct := rpc.NewMockClientStreamFactory(ctrl)
+	fct.EXPECT().CreateWriteServiceClient(gomock.Any()).Return(nil, fmt.Errorf("err")).AnyTimes()
+	cfg := config.Write{SpillEnabled: true, SpillDir: t.TempDir(), SpillMaxSize: ltoml.Size(1024 * 1024 * 1024)}
+	ch := newShardChannel(context.TODO(), "database", 1, fct)
+	ch1 := ch.(*shardChannel)
+	ch1.cfg = cfg
+	// no spilled family
+	ch.SyncShardState(models.ShardState{Leader: 1}, nil)
+	assert.Empty(t, ch1.families.Entries())
+
+	// spill message of family
+	sq := newSpillQueue(familySpillDir(cfg, "database", 1, 100), int64(cfg.SpillMaxSize),
+		metrics.NewBrokerFamilyWriteStatistics("database"))
+	assert.NoError(t, sq.Put([]byte{1, 2, 3}))
+	sq.Close()
+	assert.NoError(t, os.MkdirAll(filepath.Join(shardSpillDir(cfg, "database", 1), "invalid"), os.ModePerm))
+
+	// list dir failure
+	listSpillDirFn = func(path string) ([]string, error) {
+		return nil, fmt.Errorf("err")
+	}
+	ch1.recoverSpilledFamilies()
+	assert.Empty(t, ch1.families.Entries())
+	listSpillDirFn = fileutil.GetDirectoryList
+
+	// recover only once
+	ch.SyncShardState(models.ShardState{Leader: 1}, nil)
+	assert.Empty(t, ch1.families.Entries())
+
+	ch1.recoverSpilledFamilies()
+	family, ok := ch1.families.GetFamilyChannel(100)
+	assert.True(t, ok)
+	assert.False(t, family.isExpire(0, 0))
+	time.Sleep(1500 * time.Millisecond) // wait replay failure
+	// family exist
+	ch1.recoverSpilledFamilies()
+	assert.Len(t, ch1.families.Entries(), 1)
+	family.Stop(timeutil.OneSecond)
+
+	// spill disabled
+	ch1.cfg.SpillEnabled = false
+	ch1.families = newFamilyChannelSet()
+	ch1.recoverSpilledFamilies()
+	assert.Empty(t, ch1.families.Entries())
+}
@@ -0,0 +1,206 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"errors"
+	"path/filepath"
+	"strconv"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/queue"
+)
+
+// for testing
+var (
+	newSpillQueueFn  = queue.NewQueue
+	removeSpillDirFn = fileutil.RemoveDir
+	listSpillDirFn   = fileutil.GetDirectoryList
+)
+
+var (
+	// spilledSize is the total bytes of spilled message of all family channels in broker.
+	spilledSize = atomic.NewInt64(0)
+	// errSpillQueueFull returns when spilled data exceeds the max size limit.
+	errSpillQueueFull = errors.New("spill queue exceeds the max size limit")
+)
+
+// maxReplayPerRound is the max number of spilled messages replayed in one round,
+// avoids blocking the write task of family channel too long.
+const maxReplayPerRound = 100
+
+// spillQueue spills the compressed chunk to local disk when shard leader is unreachable,
+// spilled message will be replayed after the write stream recovered.
+// NOTE: only used by the write task of family channel, not concurrent safe except IsEmpty.
+type spillQueue struct {
+	dir     string
+	maxSize int64
+	q       queue.Queue   // create lazily when spilling message
+	size    int64         // bytes of pending spilled message
+	pending *atomic.Int64 // number of pending spilled message
+
+	statistics *metrics.BrokerFamilyWriteStatistics
+	logger     *logger.Logger
+}
+
+// newSpillQueue creates a spill queue under given dir.
+func newSpillQueue(dir string, maxSize int64, statistics *metrics.BrokerFamilyWriteStatistics) *spillQueue {
+	return &spillQueue{
+		dir:        dir,
+		maxSize:    maxSize,
+		pending:    atomic.NewInt64(0),
+		statistics: statistics,
+		logger:     logger.GetLogger("Replica", "SpillQueue"),
+	}
+}
+
+// recover opens the spill queue if spilled message exists in the dir(e.g. broker restart).
+func (sq *spillQueue) recover() error {
+	if !fileutil.Exist(sq.dir) {
+		return nil
+	}
+	if err := sq.open(); err != nil {
+		return err
+	}
+	for seq := sq.q.AcknowledgedSeq() + 1; seq <= sq.q.AppendedSeq(); seq++ {
+		data, err := sq.q.Get(seq)
+		if err != nil {
+			return err
+		}
+		sq.incr(len(data))
+	}
+	if sq.IsEmpty() {
+		sq.remove()
+		return nil
+	}
+	sq.logger.Info("recover spilled message",
+		logger.String("dir", sq.dir),
+		logger.Int64("pending", sq.pending.Load()),
+		logger.Int64("size", sq.size))
+	return nil
+}
+
+// Put spills the message to local disk.
+func (sq *spillQueue) Put(data []byte) error {
+	if spilledSize.Load()+int64(len(data)) > sq.maxSize {
+		return errSpillQueueFull
+	}
+	if sq.q == nil {
+		if err := sq.open(); err != nil {
+			return err
+		}
+	}
+	if err := sq.q.Put(data); err != nil {
+		return err
+	}
+	sq.incr(len(data))
+	return nil
+}
+
+// Peek returns the oldest pending spilled message, returns nil if no pending message.
+// NOTE: data is the reference of mapped page, cannot be used after Ack.
+func (sq *spillQueue) Peek() ([]byte, error) {
+	if sq.IsEmpty() {
+		return nil, nil
+	}
+	return sq.q.Get(sq.q.AcknowledgedSeq() + 1)
+}
+
+// Ack acknowledges the oldest pending spilled message after replayed, removes the queue if no pending message.
+func (sq *spillQueue) Ack(dataLen int) {
+	if sq.IsEmpty() {
+		return
+	}
+	sq.q.SetAcknowledgedSeq(sq.q.AcknowledgedSeq() + 1)
+	sq.decr(dataLen)
+	if sq.IsEmpty() {
+		sq.remove()
+		return
+	}
+	sq.q.GC()
+}
+
+// IsEmpty returns if no pending spilled message, concurrent safe.
+func (sq *spillQueue) IsEmpty() bool {
+	return sq.pending.Load() == 0
+}
+
+// Close closes the spill queue, pending spilled message keeps in local disk.
+func (sq *spillQueue) Close() {
+	if sq.q == nil {
+		return
+	}
+	sq.q.Close()
+	sq.q = nil
+	spilledSize.Sub(sq.size)
+	sq.statistics.SpillPending.Sub(float64(sq.pending.Load()))
+	sq.statistics.SpillSize.Sub(float64(sq.size))
+	sq.size = 0
+	sq.pending.Store(0)
+}
+
+// open opens the underlying queue.
+func (sq *spillQueue) open() error {
+	q, err := newSpillQueueFn(sq.dir, sq.maxSize)
+	if err != nil {
+		return err
+	}
+	sq.q = q
+	return nil
+}
+
+// remove closes the underlying queue and removes the dir after all spilled message replayed.
+func (sq *spillQueue) remove() {
+	sq.Close()
+	if err := removeSpillDirFn(sq.dir); err != nil {
+		sq.logger.Warn("remove spill queue dir failure",
+			logger.String("dir", sq.dir), logger.Error(err))
+	}
+}
+
+func (sq *spillQueue) incr(dataLen int) {
+	sq.size += int64(dataLen)
+	sq.pending.Inc()
+	spilledSize.Add(int64(dataLen))
+	sq.statistics.SpillPending.Incr()
+	sq.statistics.SpillSize.Add(float64(dataLen))
+}
+
+func (sq *spillQueue) decr(dataLen int) {
+	sq.size -= int64(dataLen)
+	sq.pending.Dec()
+	spilledSize.Sub(int64(dataLen))
+	sq.statistics.SpillPending.Decr()
+	sq.statistics.SpillSize.Sub(float64(dataLen))
+}
+
+// shardSpillDir returns the spill dir of shard.
+func shardSpillDir(cfg config.Write, database string, shardID models.ShardID) string {
+	return filepath.Join(cfg.SpillDir, database, shardID.String())
+}
+
+// familySpillDir returns the spill dir of family.
+func familySpillDir(cfg config.Write, database string, shardID models.ShardID, familyTime int64) string {
+	return filepath.Join(shardSpillDir(cfg, database, shardID), strconv.FormatInt(familyTime, 10))
+}
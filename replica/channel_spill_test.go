@@ -0,0 +1,159 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/queue"
+)
+
+func TestSpillQueue_PutAndReplay(t *testing.T) {
+	defer spilledSize.Store(0)
+	dir := filepath.Join(t.TempDir(), "spill")
+	statistics := metrics.NewBrokerFamilyWriteStatistics("spill-db")
+	sq := newSpillQueue(dir, 1024*1024*1024, statistics)
+	// no spilled message
+	assert.NoError(t, sq.recover())
+	assert.True(t, sq.IsEmpty())
+	data, err := sq.Peek()
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+	sq.Ack(10)
+	sq.Close()
+
+	assert.NoError(t, sq.Put([]byte{1, 2, 3}))
+	assert.NoError(t, sq.Put([]byte{4, 5}))
+	assert.False(t, sq.IsEmpty())
+	assert.Equal(t, int64(5), spilledSize.Load())
+	// close keeps spilled message in disk
+	sq.Close()
+	assert.Equal(t, int64(0), spilledSize.Load())
+	assert.True(t, fileutil.Exist(dir))
+
+	// recover spilled message
+	sq = newSpillQueue(dir, 1024*1024*1024, statistics)
+	assert.NoError(t, sq.recover())
+	assert.False(t, sq.IsEmpty())
+	assert.Equal(t, int64(5), spilledSize.Load())
+	data, err = sq.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, data)
+	sq.Ack(len(data))
+	data, err = sq.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{4, 5}, data)
+	sq.Ack(len(data))
+	// remove spill queue after all message replayed
+	assert.True(t, sq.IsEmpty())
+	assert.Equal(t, int64(0), spilledSize.Load())
+	assert.False(t, fileutil.Exist(dir))
+
+	// recover empty spill queue
+	assert.NoError(t, sq.Put([]byte{1, 2, 3}))
+	data, err = sq.Peek()
+	assert.NoError(t, err)
+	sq.Ack(len(data))
+	assert.NoError(t, fileutil.MkDirIfNotExist(dir))
+	sq = newSpillQueue(dir, 1024*1024*1024, statistics)
+	assert.NoError(t, sq.recover())
+	assert.True(t, sq.IsEmpty())
+	assert.False(t, fileutil.Exist(dir))
+}
+
+func TestSpillQueue_Put(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newSpillQueueFn = queue.NewQueue
+		spilledSize.Store(0)
+		ctrl.Finish()
+	}()
+	statistics := metrics.NewBrokerFamilyWriteStatistics("spill-db")
+	q := queue.NewMockQueue(ctrl)
+	sq := newSpillQueue(t.TempDir(), 4, statistics)
+	// exceed max size
+	assert.ErrorIs(t, sq.Put([]byte{1, 2, 3, 4, 5}), errSpillQueueFull)
+	// open queue failure
+	newSpillQueueFn = func(dirPath string, dataSizeLimit int64) (queue.Queue, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, sq.Put([]byte{1, 2}))
+	// put failure
+	newSpillQueueFn = func(dirPath string, dataSizeLimit int64) (queue.Queue, error) {
+		return q, nil
+	}
+	q.EXPECT().Put(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, sq.Put([]byte{1, 2}))
+	assert.True(t, sq.IsEmpty())
+	// exceed max size of broker
+	q.EXPECT().Put(gomock.Any()).Return(nil)
+	assert.NoError(t, sq.Put([]byte{1, 2}))
+	sq2 := newSpillQueue(t.TempDir(), 4, statistics)
+	assert.ErrorIs(t, sq2.Put([]byte{1, 2, 3}), errSpillQueueFull)
+	q.EXPECT().Close()
+	sq.Close()
+}
+
+func TestSpillQueue_recover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newSpillQueueFn = queue.NewQueue
+		removeSpillDirFn = fileutil.RemoveDir
+		spilledSize.Store(0)
+		ctrl.Finish()
+	}()
+	statistics := metrics.NewBrokerFamilyWriteStatistics("spill-db")
+	q := queue.NewMockQueue(ctrl)
+	sq := newSpillQueue(t.TempDir(), 1024, statistics)
+	// open queue failure
+	newSpillQueueFn = func(dirPath string, dataSizeLimit int64) (queue.Queue, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, sq.recover())
+	// get message failure
+	newSpillQueueFn = func(dirPath string, dataSizeLimit int64) (queue.Queue, error) {
+		return q, nil
+	}
+	q.EXPECT().AcknowledgedSeq().Return(int64(-1))
+	q.EXPECT().AppendedSeq().Return(int64(0))
+	q.EXPECT().Get(int64(0)).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, sq.recover())
+	// remove dir failure
+	q.EXPECT().AcknowledgedSeq().Return(int64(-1))
+	q.EXPECT().AppendedSeq().Return(int64(-1))
+	q.EXPECT().Close()
+	removeSpillDirFn = func(path string) error {
+		return fmt.Errorf("err")
+	}
+	assert.NoError(t, sq.recover())
+}
+
+func TestSpillDir(t *testing.T) {
+	cfg := config.Write{SpillDir: "spill", SpillMaxSize: ltoml.Size(1024)}
+	assert.Equal(t, filepath.Join("spill", "db", "1"), shardSpillDir(cfg, "db", 1))
+	assert.Equal(t, filepath.Join("spill", "db", "1", "100"), familySpillDir(cfg, "db", 1, 100))
+}
@@ -0,0 +1,238 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/coordinator/storage"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+//go:generate mockgen -source=./consistency_checker.go -destination=./consistency_checker_mock.go -package=replica
+
+// ConsistencyChecker represents the anti-entropy checker of replicas,
+// leader compares the digest of each family with followers periodically,
+// reports the divergence and repairs diverged follower by snapshot optionally.
+type ConsistencyChecker interface {
+	// Start starts the background check task.
+	Start()
+	// Stop stops the background check task.
+	Stop()
+	// Check checks the consistency of all families led by current node.
+	Check()
+	// GetConsistencyState returns the latest consistency check result of database's families.
+	GetConsistencyState(database string) []models.ReplicaConsistencyState
+}
+
+// consistencyChecker implements ConsistencyChecker interface.
+type consistencyChecker struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	cfg           config.WAL
+	currentNodeID models.NodeID
+	walMgr        WriteAheadLogManager
+	stateMgr      storage.StateManager
+	cli           client.ReplicaCli
+
+	states     map[string][]models.ReplicaConsistencyState // database => check result
+	statistics map[string]*metrics.StorageReplicaConsistencyStatistics
+	mutex      sync.RWMutex
+
+	logger *logger.Logger
+}
+
+// NewConsistencyChecker creates a replica consistency checker instance.
+func NewConsistencyChecker(
+	ctx context.Context,
+	cfg config.WAL,
+	currentNodeID models.NodeID,
+	walMgr WriteAheadLogManager,
+	stateMgr storage.StateManager,
+	cli client.ReplicaCli,
+) ConsistencyChecker {
+	c, cancel := context.WithCancel(ctx)
+	return &consistencyChecker{
+		ctx:           c,
+		cancel:        cancel,
+		cfg:           cfg,
+		currentNodeID: currentNodeID,
+		walMgr:        walMgr,
+		stateMgr:      stateMgr,
+		cli:           cli,
+		states:        make(map[string][]models.ReplicaConsistencyState),
+		statistics:    make(map[string]*metrics.StorageReplicaConsistencyStatistics),
+		logger:        logger.GetLogger("Replica", "ConsistencyChecker"),
+	}
+}
+
+// Start starts the background check task.
+func (c *consistencyChecker) Start() {
+	interval := c.cfg.AntiEntropyInterval.Duration()
+	if interval <= 0 {
+		c.logger.Info("replica consistency check is disabled")
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.ctx.Done():
+				c.logger.Info("replica consistency check task exit")
+				return
+			case <-ticker.C:
+				c.Check()
+			}
+		}
+	}()
+	c.logger.Info("replica consistency check task started", logger.String("interval", interval.String()))
+}
+
+// Stop stops the background check task.
+func (c *consistencyChecker) Stop() {
+	c.cancel()
+}
+
+// Check checks the consistency of all families led by current node.
+func (c *consistencyChecker) Check() {
+	result := make(map[string][]models.ReplicaConsistencyState)
+	for _, log := range c.walMgr.getDatabaseLogs() {
+		database := log.Name()
+		statistics := c.getStatistics(database)
+		var states []models.ReplicaConsistencyState
+		for key, p := range log.getLeaderPartitions() {
+			followers := p.getFollowers()
+			if len(followers) == 0 {
+				continue
+			}
+			// compute digest of leader once, compare with each follower
+			digest, err := p.Digest()
+			for _, follower := range followers {
+				state := models.ReplicaConsistencyState{
+					ShardID:    key.shardID,
+					FamilyTime: timeutil.FormatTimestamp(key.familyTime, timeutil.DataTimeFormat2),
+					Leader:     c.currentNodeID,
+					Follower:   follower,
+					CheckTime:  timeutil.Now(),
+				}
+				statistics.Checks.Incr()
+				if err != nil {
+					c.checkFailure(statistics, &state, "compute digest of leader failure, root cause: "+err.Error())
+				} else {
+					c.compare(database, key, p, digest, statistics, &state)
+				}
+				states = append(states, state)
+			}
+		}
+		sort.Slice(states, func(i, j int) bool {
+			if states[i].ShardID != states[j].ShardID {
+				return states[i].ShardID < states[j].ShardID
+			}
+			if states[i].FamilyTime != states[j].FamilyTime {
+				return states[i].FamilyTime < states[j].FamilyTime
+			}
+			return states[i].Follower < states[j].Follower
+		})
+		result[database] = states
+	}
+	c.mutex.Lock()
+	c.states = result
+	c.mutex.Unlock()
+}
+
+// GetConsistencyState returns the latest consistency check result of database's families.
+func (c *consistencyChecker) GetConsistencyState(database string) []models.ReplicaConsistencyState {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.states[database]
+}
+
+// compare compares the digest of leader with follower, repairs diverged follower if repair enabled.
+func (c *consistencyChecker) compare(database string, key partitionKey, p Partition, digest *models.FamilyDigest,
+	statistics *metrics.StorageReplicaConsistencyStatistics, state *models.ReplicaConsistencyState,
+) {
+	node, ok := c.stateMgr.GetLiveNode(state.Follower)
+	if !ok {
+		c.checkFailure(statistics, state, "follower node is offline")
+		return
+	}
+	followerDigest, err := c.cli.GetFamilyDigest(node.HTTPAddress(), database, key.shardID, key.familyTime)
+	if err != nil {
+		c.checkFailure(statistics, state, "get digest of follower failure, root cause: "+err.Error())
+		return
+	}
+	if !digest.SameSequences(followerDigest) {
+		// data is in flight(not persisted by leader or follower), compare it next time
+		state.Consistency = models.ReplicaSyncing
+		statistics.Syncing.Incr()
+		return
+	}
+	state.DivergedMetrics = digest.DivergedMetrics(followerDigest)
+	if len(state.DivergedMetrics) == 0 {
+		state.Consistency = models.ReplicaConsistent
+		statistics.Consistent.Incr()
+		return
+	}
+	state.Consistency = models.ReplicaDiverged
+	statistics.Diverged.Incr()
+	c.logger.Warn("the data of follower is diverged from leader",
+		logger.String("database", database),
+		logger.Any("shardID", key.shardID),
+		logger.String("family", state.FamilyTime),
+		logger.Any("follower", state.Follower),
+		logger.Any("metrics", state.DivergedMetrics))
+	if !c.cfg.AntiEntropyRepair {
+		return
+	}
+	if err := p.RepairReplica(state.Follower); err != nil {
+		statistics.RepairFailures.Incr()
+		state.ErrMsg = "repair diverged follower failure, root cause: " + err.Error()
+		return
+	}
+	statistics.Repairs.Incr()
+	state.Repairing = true
+}
+
+// checkFailure marks the consistency check failure.
+func (c *consistencyChecker) checkFailure(statistics *metrics.StorageReplicaConsistencyStatistics,
+	state *models.ReplicaConsistencyState, errMsg string,
+) {
+	statistics.CheckFailures.Incr()
+	state.Consistency = models.ReplicaCheckFailure
+	state.ErrMsg = errMsg
+}
+
+// getStatistics returns the consistency check statistics of database.
+func (c *consistencyChecker) getStatistics(database string) *metrics.StorageReplicaConsistencyStatistics {
+	statistics, ok := c.statistics[database]
+	if !ok {
+		statistics = metrics.NewStorageReplicaConsistencyStatistics(database)
+		c.statistics[database] = statistics
+	}
+	return statistics
+}
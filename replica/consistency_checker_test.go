@@ -0,0 +1,214 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/coordinator/storage"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/ltoml"
+)
+
+func TestConsistencyChecker_Start_Stop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	walMgr := NewMockWriteAheadLogManager(ctrl)
+	// case 1: check disabled
+	checker := NewConsistencyChecker(context.TODO(), config.WAL{}, 1, walMgr, nil, nil)
+	checker.Start()
+	checker.Stop()
+	// case 2: check periodically
+	walMgr.EXPECT().getDatabaseLogs().Return(nil).MinTimes(1)
+	checker = NewConsistencyChecker(context.TODO(),
+		config.WAL{AntiEntropyInterval: ltoml.Duration(time.Millisecond)}, 1, walMgr, nil, nil)
+	checker.Start()
+	time.Sleep(20 * time.Millisecond)
+	checker.Stop()
+	time.Sleep(10 * time.Millisecond)
+}
+
+func TestConsistencyChecker_Check(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	walMgr := NewMockWriteAheadLogManager(ctrl)
+	stateMgr := storage.NewMockStateManager(ctrl)
+	cli := client.NewMockReplicaCli(ctrl)
+	log := NewMockWriteAheadLog(ctrl)
+	p := NewMockPartition(ctrl)
+	log.EXPECT().Name().Return("test").AnyTimes()
+	walMgr.EXPECT().getDatabaseLogs().Return([]WriteAheadLog{log}).AnyTimes()
+	log.EXPECT().getLeaderPartitions().Return(map[partitionKey]Partition{
+		{shardID: 1, familyTime: 1, leader: 1}: p,
+	}).AnyTimes()
+
+	leaderDigest := &models.FamilyDigest{
+		FamilyTime: 1,
+		Sequences:  map[int32]int64{1: 10},
+		Metrics:    []models.MetricDigest{{Namespace: "ns", Name: "cpu", Digest: 100}},
+	}
+	cases := []struct {
+		name    string
+		repair  bool
+		prepare func()
+		assert  func(states []models.ReplicaConsistencyState)
+	}{
+		{
+			name: "no follower",
+			prepare: func() {
+				p.EXPECT().getFollowers().Return(nil)
+			},
+			assert: func(states []models.ReplicaConsistencyState) {
+				assert.Empty(t, states)
+			},
+		},
+		{
+			name: "compute digest of leader failure",
+			prepare: func() {
+				p.EXPECT().getFollowers().Return([]models.NodeID{2, 3})
+				p.EXPECT().Digest().Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(states []models.ReplicaConsistencyState) {
+				assert.Len(t, states, 2)
+				assert.Equal(t, models.NodeID(2), states[0].Follower)
+				assert.Equal(t, models.NodeID(3), states[1].Follower)
+				for _, state := range states {
+					assert.Equal(t, models.ReplicaCheckFailure, state.Consistency)
+				}
+			},
+		},
+		{
+			name: "follower offline",
+			prepare: func() {
+				p.EXPECT().getFollowers().Return([]models.NodeID{2})
+				p.EXPECT().Digest().Return(leaderDigest, nil)
+				stateMgr.EXPECT().GetLiveNode(models.NodeID(2)).Return(models.StatefulNode{}, false)
+			},
+			assert: func(states []models.ReplicaConsistencyState) {
+				assert.Equal(t, models.ReplicaCheckFailure, states[0].Consistency)
+			},
+		},
+		{
+			name: "get digest of follower failure",
+			prepare: func() {
+				p.EXPECT().getFollowers().Return([]models.NodeID{2})
+				p.EXPECT().Digest().Return(leaderDigest, nil)
+				stateMgr.EXPECT().GetLiveNode(models.NodeID(2)).Return(models.StatefulNode{}, true)
+				cli.EXPECT().GetFamilyDigest(gomock.Any(), "test", models.ShardID(1), int64(1)).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(states []models.ReplicaConsistencyState) {
+				assert.Equal(t, models.ReplicaCheckFailure, states[0].Consistency)
+			},
+		},
+		{
+			name: "data is syncing",
+			prepare: func() {
+				p.EXPECT().getFollowers().Return([]models.NodeID{2})
+				p.EXPECT().Digest().Return(leaderDigest, nil)
+				stateMgr.EXPECT().GetLiveNode(models.NodeID(2)).Return(models.StatefulNode{}, true)
+				cli.EXPECT().GetFamilyDigest(gomock.Any(), "test", models.ShardID(1), int64(1)).
+					Return(&models.FamilyDigest{FamilyTime: 1, Sequences: map[int32]int64{1: 9}}, nil)
+			},
+			assert: func(states []models.ReplicaConsistencyState) {
+				assert.Equal(t, models.ReplicaSyncing, states[0].Consistency)
+			},
+		},
+		{
+			name: "consistent",
+			prepare: func() {
+				p.EXPECT().getFollowers().Return([]models.NodeID{2})
+				p.EXPECT().Digest().Return(leaderDigest, nil)
+				stateMgr.EXPECT().GetLiveNode(models.NodeID(2)).Return(models.StatefulNode{}, true)
+				cli.EXPECT().GetFamilyDigest(gomock.Any(), "test", models.ShardID(1), int64(1)).Return(leaderDigest, nil)
+			},
+			assert: func(states []models.ReplicaConsistencyState) {
+				assert.Equal(t, models.ReplicaConsistent, states[0].Consistency)
+				assert.Empty(t, states[0].DivergedMetrics)
+			},
+		},
+		{
+			name: "diverged, repair disabled",
+			prepare: func() {
+				p.EXPECT().getFollowers().Return([]models.NodeID{2})
+				p.EXPECT().Digest().Return(leaderDigest, nil)
+				stateMgr.EXPECT().GetLiveNode(models.NodeID(2)).Return(models.StatefulNode{}, true)
+				cli.EXPECT().GetFamilyDigest(gomock.Any(), "test", models.ShardID(1), int64(1)).
+					Return(&models.FamilyDigest{FamilyTime: 1, Sequences: map[int32]int64{1: 10}}, nil)
+			},
+			assert: func(states []models.ReplicaConsistencyState) {
+				assert.Equal(t, models.ReplicaDiverged, states[0].Consistency)
+				assert.Equal(t, []string{"ns:cpu"}, states[0].DivergedMetrics)
+				assert.False(t, states[0].Repairing)
+			},
+		},
+		{
+			name:   "diverged, repair failure",
+			repair: true,
+			prepare: func() {
+				p.EXPECT().getFollowers().Return([]models.NodeID{2})
+				p.EXPECT().Digest().Return(leaderDigest, nil)
+				stateMgr.EXPECT().GetLiveNode(models.NodeID(2)).Return(models.StatefulNode{}, true)
+				cli.EXPECT().GetFamilyDigest(gomock.Any(), "test", models.ShardID(1), int64(1)).
+					Return(&models.FamilyDigest{FamilyTime: 1, Sequences: map[int32]int64{1: 10}}, nil)
+				p.EXPECT().RepairReplica(models.NodeID(2)).Return(fmt.Errorf("err"))
+			},
+			assert: func(states []models.ReplicaConsistencyState) {
+				assert.Equal(t, models.ReplicaDiverged, states[0].Consistency)
+				assert.False(t, states[0].Repairing)
+				assert.NotEmpty(t, states[0].ErrMsg)
+			},
+		},
+		{
+			name:   "diverged, repairing",
+			repair: true,
+			prepare: func() {
+				p.EXPECT().getFollowers().Return([]models.NodeID{2})
+				p.EXPECT().Digest().Return(leaderDigest, nil)
+				stateMgr.EXPECT().GetLiveNode(models.NodeID(2)).Return(models.StatefulNode{}, true)
+				cli.EXPECT().GetFamilyDigest(gomock.Any(), "test", models.ShardID(1), int64(1)).
+					Return(&models.FamilyDigest{FamilyTime: 1, Sequences: map[int32]int64{1: 10}}, nil)
+				p.EXPECT().RepairReplica(models.NodeID(2)).Return(nil)
+			},
+			assert: func(states []models.ReplicaConsistencyState) {
+				assert.Equal(t, models.ReplicaDiverged, states[0].Consistency)
+				assert.True(t, states[0].Repairing)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewConsistencyChecker(context.TODO(), config.WAL{AntiEntropyRepair: tt.repair},
+				1, walMgr, stateMgr, cli)
+			tt.prepare()
+			checker.Check()
+			tt.assert(checker.GetConsistencyState("test"))
+			assert.Empty(t, checker.GetConsistencyState("not-exist"))
+		})
+	}
+}
@@ -27,4 +27,6 @@ var (
 	// ErrFamilyChannelCanceled is the error returned when a family channel is closed.
 	ErrFamilyChannelCanceled = errors.New("family Channel is canceled")
 	ErrIngestTimeout         = errors.New("ingest timout")
+	// ErrReplicaAckTimeout is the error returned when write log isn't acknowledged by enough replicas in time.
+	ErrReplicaAckTimeout = errors.New("wait for replica acknowledgement timeout")
 )
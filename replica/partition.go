@@ -21,7 +21,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
+	"time"
+
+	"github.com/golang/snappy"
 
 	"github.com/lindb/lindb/coordinator/storage"
 	"github.com/lindb/lindb/metrics"
@@ -40,6 +44,8 @@ var (
 	newLocalReplicatorFn  = NewLocalReplicator
 	newRemoteReplicatorFn = NewRemoteReplicator
 	newReplicatorPeerFn   = NewReplicatorPeer
+
+	replicaAckCheckInterval = 10 * time.Millisecond
 )
 
 // Partition represents a partition of writeTask ahead log.
@@ -49,11 +55,33 @@ type Partition interface {
 	BuildReplicaForLeader(leader models.NodeID, replicas []models.NodeID) error
 	// BuildReplicaForFollower builds replica relation when handle replica connection.
 	BuildReplicaForFollower(leader models.NodeID, replica models.NodeID) error
+	// PrepareReplica pins the write ahead log for replica which replicator will be built later(e.g. replica migration),
+	// the log after current acknowledged sequence will be kept until the replicator consumes it.
+	PrepareReplica(replica models.NodeID) error
+	// RemoveReplica stops the replicator of replica, then removes the consume sequence of it.
+	RemoveReplica(replica models.NodeID) error
 	// ReplicaLog writes msg that leader sends replica msg.
 	// return appended index, if success.
 	ReplicaLog(replicaIdx int64, msg []byte) (int64, error)
 	// WriteLog writes msg that leader handle client writeTask request.
-	WriteLog(msg []byte) error
+	// return appended sequence of msg, if success.
+	WriteLog(msg []byte) (int64, error)
+	// BeginSnapshot prepares installing the data snapshot of family from leader,
+	// skips pending write ahead log which is contained in snapshot, then removes all data of family.
+	BeginSnapshot(leader models.NodeID) error
+	// InstallSnapshot writes the rows block(snappy compressed) of snapshot into family,
+	// returns the number of rows written.
+	InstallSnapshot(rowsBlock []byte) (int, error)
+	// CompleteSnapshot persists the installed snapshot,
+	// then resets write ahead log based on the replica sequence of snapshot.
+	CompleteSnapshot(leader models.NodeID, seq int64) error
+	// Digest computes the digest of persisted data in family, which is used for comparing data between replicas.
+	Digest() (*models.FamilyDigest, error)
+	// RepairReplica repairs the diverged replica, replaces the data of replica with the snapshot of family.
+	RepairReplica(replica models.NodeID) error
+	// WaitReplicaAck waits until the log of sequence is acknowledged by number of replicas(including leader),
+	// ErrReplicaAckTimeout is returned when ctx is done before acknowledged.
+	WaitReplicaAck(ctx context.Context, seq int64, numOfAck int) error
 	// ReplicaAckIndex returns the index which replica appended index.
 	ReplicaAckIndex() int64
 	// ResetReplicaIndex resets replica index.
@@ -66,6 +94,8 @@ type Partition interface {
 	Stop()
 	// getReplicaState returns each family's log replica state.
 	getReplicaState() models.FamilyLogReplicaState
+	// getFollowers returns the followers which replicator built on current node(leader).
+	getFollowers() []models.NodeID
 	// recovery rebuilds replication relation based on local partition.
 	recovery(leader models.NodeID) error
 }
@@ -197,19 +227,149 @@ func (p *partition) stopReplicator(node string) {
 }
 
 // WriteLog writes msg that leader sends replica msg.
-func (p *partition) WriteLog(msg []byte) error {
+func (p *partition) WriteLog(msg []byte) (int64, error) {
 	if len(msg) == 0 {
-		return nil
+		return p.log.Queue().AppendedSeq(), nil
 	}
 	p.statistics.ReceiveWriteSize.Add(float64(len(msg)))
 	if err := p.log.Queue().Put(msg); err != nil {
 		p.statistics.WriteWALFailures.Incr()
-		return err
+		return -1, err
 	}
 	p.statistics.WriteWAL.Incr()
+	return p.log.Queue().AppendedSeq(), nil
+}
+
+// WaitReplicaAck waits until the log of sequence is acknowledged by number of replicas(including leader),
+// ErrReplicaAckTimeout is returned when ctx is done before acknowledged.
+func (p *partition) WaitReplicaAck(ctx context.Context, seq int64, numOfAck int) error {
+	ticker := time.NewTicker(replicaAckCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if p.numOfReplicaAck(seq) >= numOfAck {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ErrReplicaAckTimeout
+		case <-p.ctx.Done():
+			return ErrReplicaAckTimeout
+		case <-ticker.C:
+		}
+	}
+}
+
+// numOfReplicaAck returns the number of replicas which acknowledged the log of sequence,
+// leader acknowledges when log appended into write ahead log, followers acknowledge when log replicated.
+func (p *partition) numOfReplicaAck(seq int64) int {
+	numOfAck := 1
+	for _, name := range p.log.ConsumerGroupNames() {
+		if models.ParseNodeID(name) == p.currentNodeID {
+			continue
+		}
+		consumerGroup, err := p.log.GetOrCreateConsumerGroup(name)
+		if err != nil {
+			continue
+		}
+		if consumerGroup.AcknowledgedSeq() >= seq {
+			numOfAck++
+		}
+	}
+	return numOfAck
+}
+
+// BeginSnapshot prepares installing the data snapshot of family from leader,
+// skips pending write ahead log which is contained in snapshot, then removes all data of family.
+func (p *partition) BeginSnapshot(leader models.NodeID) error {
+	appendedSeq := p.log.Queue().AppendedSeq()
+	p.log.SetAppendedSeq(appendedSeq)
+	// reject the pending log which local replicator is writing
+	p.family.CommitSequence(int32(leader), appendedSeq)
+	if err := tsdb.TruncateDataFamily(p.family); err != nil {
+		return err
+	}
+	p.logger.Info("begin installing snapshot of family",
+		logger.String("family", p.family.Indicator()),
+		logger.Any("leader", leader),
+		logger.Int64("appendedSeq", appendedSeq))
+	return nil
+}
+
+// InstallSnapshot writes the rows block(snappy compressed) of snapshot into family,
+// returns the number of rows written.
+func (p *partition) InstallSnapshot(rowsBlock []byte) (int, error) {
+	rows, err := snappy.Decode(nil, rowsBlock)
+	if err != nil {
+		return 0, err
+	}
+	return p.shard.Import(p.family.FamilyTime(), rows)
+}
+
+// CompleteSnapshot persists the installed snapshot,
+// then resets write ahead log based on the replica sequence of snapshot.
+func (p *partition) CompleteSnapshot(leader models.NodeID, seq int64) error {
+	// data before sequence is installed, replica data after sequence
+	p.family.CommitSequence(int32(leader), seq)
+	// installed data hasn't write ahead log, need persist it
+	if err := tsdb.FlushDataFamily(p.family); err != nil {
+		return err
+	}
+	p.ResetReplicaIndex(seq + 1)
+	p.logger.Info("complete installing snapshot of family",
+		logger.String("family", p.family.Indicator()),
+		logger.Any("leader", leader),
+		logger.Int64("seq", seq))
+	return nil
+}
+
+// Digest computes the digest of persisted data in family, which is used for comparing data between replicas.
+func (p *partition) Digest() (*models.FamilyDigest, error) {
+	return p.shard.DigestFamily(p.family)
+}
+
+// RepairReplica repairs the diverged replica, replaces the data of replica with the snapshot of family.
+func (p *partition) RepairReplica(replica models.NodeID) error {
+	if replica == p.currentNodeID {
+		return fmt.Errorf("cannot repair local replica: %d", replica)
+	}
+	peer, ok := p.getReplicatorRunner(replica)
+	if !ok {
+		return fmt.Errorf("replicator of replica not found: %d", replica)
+	}
+	peer.RequestSnapshot()
+	p.logger.Info("request snapshot for repairing diverged replica",
+		logger.String("family", p.family.Indicator()),
+		logger.Any("replica", replica))
 	return nil
 }
 
+// exportSnapshot exports the data snapshot of family for the replica whose needed write ahead log is truncated,
+// pins write ahead log after persisted sequence of local replica first, returns the replica sequence of snapshot.
+func (p *partition) exportSnapshot(replica models.NodeID, fn tsdb.ExportFunc) (int64, error) {
+	local, err := p.log.GetOrCreateConsumerGroup(fmt.Sprintf("%d", p.currentNodeID))
+	if err != nil {
+		return -1, err
+	}
+	target, err := p.log.GetOrCreateConsumerGroup(fmt.Sprintf("%d", replica))
+	if err != nil {
+		return -1, err
+	}
+	persistedSeq := local.AcknowledgedSeq()
+	if target.AcknowledgedSeq() > persistedSeq {
+		// keep the log after snapshot for replica
+		target.SetSeq(persistedSeq)
+	}
+	backup, err := p.shard.ExportFamily(p.family, fn)
+	if err != nil {
+		return -1, err
+	}
+	if seq, ok := backup.Sequences[int32(p.currentNodeID)]; ok {
+		return seq, nil
+	}
+	return persistedSeq, nil
+}
+
 // BuildReplicaForLeader builds replica relation when handle writeTask connection.
 // local replicator: replica node == current node.
 // remote replicator: replica node != current node.
@@ -274,6 +434,25 @@ func (p *partition) Stop() {
 	waiter.Wait()
 }
 
+// PrepareReplica pins the write ahead log for replica which replicator will be built later(e.g. replica migration),
+// the log after current acknowledged sequence will be kept until the replicator consumes it.
+func (p *partition) PrepareReplica(replica models.NodeID) error {
+	_, err := p.log.GetOrCreateConsumerGroup(fmt.Sprintf("%d", replica))
+	return err
+}
+
+// RemoveReplica stops the replicator of replica, then removes the consume sequence of it.
+func (p *partition) RemoveReplica(replica models.NodeID) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if peer, ok := p.peers[replica]; ok {
+		peer.Shutdown()
+		delete(p.peers, replica)
+	}
+	return p.log.DropConsumerGroup(fmt.Sprintf("%d", replica))
+}
+
 // getReplicaState returns each family's log replica state.
 func (p *partition) getReplicaState() models.FamilyLogReplicaState {
 	replicators := p.log.ConsumerGroupNames()
@@ -296,6 +475,7 @@ func (p *partition) getReplicaState() models.FamilyLogReplicaState {
 			peerState.ReplicatorType = replicatorType
 			peerState.State = replicatorState.state
 			peerState.StateErrMsg = replicatorState.errMsg
+			peerState.Snapshot = replicatorState.snapshot
 		}
 
 		stateOfReplicators = append(stateOfReplicators, peerState)
@@ -337,6 +517,9 @@ func (p *partition) buildReplica(leader, replica models.NodeID) error {
 		replicator = newLocalReplicatorFn(&channel, p.shard, p.family)
 	} else {
 		// build remote replicator
+		channel.Snapshot = func(fn tsdb.ExportFunc) (int64, error) {
+			return p.exportSnapshot(replica, fn)
+		}
 		replicator = newRemoteReplicatorFn(p.ctx, &channel, p.stateMgr, p.cliFct)
 	}
 
@@ -348,6 +531,23 @@ func (p *partition) buildReplica(leader, replica models.NodeID) error {
 	return nil
 }
 
+// getFollowers returns the followers which replicator built on current node(leader).
+func (p *partition) getFollowers() []models.NodeID {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	var followers []models.NodeID
+	for nodeID := range p.peers {
+		if nodeID != p.currentNodeID {
+			followers = append(followers, nodeID)
+		}
+	}
+	sort.Slice(followers, func(i, j int) bool {
+		return followers[i] < followers[j]
+	})
+	return followers
+}
+
 func (p *partition) getReplicatorRunner(nodeID models.NodeID) (ReplicatorPeer, bool) {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
@@ -21,12 +21,16 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/golang/snappy"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/coordinator/storage"
+	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/queue"
 	"github.com/lindb/lindb/pkg/timeutil"
@@ -200,14 +204,141 @@ func TestPartition_WriteLog(t *testing.T) {
 	family.EXPECT().FamilyTime().Return(timeutil.Now()).AnyTimes()
 	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil)
 	q.EXPECT().Put(gomock.Any()).Return(fmt.Errorf("err"))
-	err := p.WriteLog([]byte{1})
+	_, err := p.WriteLog([]byte{1})
 	assert.Error(t, err)
+	q.EXPECT().AppendedSeq().Return(int64(10)).Times(2)
 	// msg is empty
-	err = p.WriteLog(nil)
+	seq, err := p.WriteLog(nil)
 	assert.NoError(t, err)
+	assert.Equal(t, int64(10), seq)
 	q.EXPECT().Put(gomock.Any()).Return(nil)
-	err = p.WriteLog([]byte{1})
+	seq, err = p.WriteLog([]byte{1})
 	assert.NoError(t, err)
+	assert.Equal(t, int64(10), seq)
+}
+
+func TestPartition_WaitReplicaAck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		replicaAckCheckInterval = 10 * time.Millisecond
+		ctrl.Finish()
+	}()
+	replicaAckCheckInterval = time.Millisecond
+	l := queue.NewMockFanOutQueue(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	shard := tsdb.NewMockShard(ctrl)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+	p := NewPartition(context.TODO(), shard, nil, 1, l, nil, nil)
+
+	l.EXPECT().ConsumerGroupNames().Return([]string{"1", "2", "3"}).AnyTimes()
+	cg2 := queue.NewMockConsumerGroup(ctrl)
+	l.EXPECT().GetOrCreateConsumerGroup("2").Return(cg2, nil).AnyTimes()
+	l.EXPECT().GetOrCreateConsumerGroup("3").Return(nil, fmt.Errorf("err")).AnyTimes()
+	// leader acknowledges
+	cg2.EXPECT().AcknowledgedSeq().Return(int64(9))
+	assert.NoError(t, p.WaitReplicaAck(context.TODO(), 10, 1))
+	// wait follower acknowledges
+	cg2.EXPECT().AcknowledgedSeq().Return(int64(9))
+	cg2.EXPECT().AcknowledgedSeq().Return(int64(10))
+	assert.NoError(t, p.WaitReplicaAck(context.TODO(), 10, 2))
+	// timeout
+	cg2.EXPECT().AcknowledgedSeq().Return(int64(9)).AnyTimes()
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, ErrReplicaAckTimeout, p.WaitReplicaAck(ctx, 10, 2))
+}
+
+func TestPartition_Snapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	l := queue.NewMockFanOutQueue(ctrl)
+	q := queue.NewMockQueue(ctrl)
+	l.EXPECT().Queue().Return(q).AnyTimes()
+	db := tsdb.NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	shard := tsdb.NewMockShard(ctrl)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+	family := tsdb.NewMockDataFamily(ctrl)
+	family.EXPECT().FamilyTime().Return(int64(10)).AnyTimes()
+	family.EXPECT().Indicator().Return("family").AnyTimes()
+	family.EXPECT().IsFlushing().Return(false).AnyTimes()
+	kvFamily := kv.NewMockFamily(ctrl)
+	family.EXPECT().Family().Return(kvFamily).AnyTimes()
+	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil)
+
+	// begin snapshot
+	q.EXPECT().AppendedSeq().Return(int64(10)).Times(2)
+	l.EXPECT().SetAppendedSeq(int64(10)).Times(2)
+	family.EXPECT().CommitSequence(int32(2), int64(10)).Times(2)
+	family.EXPECT().Flush().Return(fmt.Errorf("err"))
+	assert.Error(t, p.BeginSnapshot(2))
+	family.EXPECT().Flush().Return(nil)
+	kvFamily.EXPECT().Truncate().Return(nil)
+	assert.NoError(t, p.BeginSnapshot(2))
+
+	// install snapshot
+	_, err := p.InstallSnapshot([]byte{1, 2, 3})
+	assert.Error(t, err)
+	shard.EXPECT().Import(int64(10), []byte{1, 2, 3}).Return(3, nil)
+	rows, err := p.InstallSnapshot(snappy.Encode(nil, []byte{1, 2, 3}))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, rows)
+
+	// complete snapshot
+	family.EXPECT().CommitSequence(int32(2), int64(20)).Times(2)
+	family.EXPECT().Flush().Return(fmt.Errorf("err"))
+	assert.Error(t, p.CompleteSnapshot(2, 20))
+	family.EXPECT().Flush().Return(nil)
+	l.EXPECT().SetAppendedSeq(int64(20))
+	assert.NoError(t, p.CompleteSnapshot(2, 20))
+}
+
+func TestPartition_exportSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	l := queue.NewMockFanOutQueue(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	shard := tsdb.NewMockShard(ctrl)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+	family := tsdb.NewMockDataFamily(ctrl)
+	family.EXPECT().FamilyTime().Return(int64(10)).AnyTimes()
+	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil).(*partition)
+	fn := func(_ int64, _ []byte) error { return nil }
+
+	local := queue.NewMockConsumerGroup(ctrl)
+	target := queue.NewMockConsumerGroup(ctrl)
+	// get consumer group failure
+	l.EXPECT().GetOrCreateConsumerGroup("1").Return(nil, fmt.Errorf("err"))
+	_, err := p.exportSnapshot(2, fn)
+	assert.Error(t, err)
+	l.EXPECT().GetOrCreateConsumerGroup("1").Return(local, nil).AnyTimes()
+	l.EXPECT().GetOrCreateConsumerGroup("2").Return(nil, fmt.Errorf("err"))
+	_, err = p.exportSnapshot(2, fn)
+	assert.Error(t, err)
+	l.EXPECT().GetOrCreateConsumerGroup("2").Return(target, nil).AnyTimes()
+
+	local.EXPECT().AcknowledgedSeq().Return(int64(10)).AnyTimes()
+	target.EXPECT().AcknowledgedSeq().Return(int64(15)).AnyTimes()
+	// keep log after persisted sequence
+	target.EXPECT().SetSeq(int64(10)).AnyTimes()
+	shard.EXPECT().ExportFamily(family, gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, err = p.exportSnapshot(2, fn)
+	assert.Error(t, err)
+	shard.EXPECT().ExportFamily(family, gomock.Any()).Return(&models.FamilyBackup{Sequences: map[int32]int64{1: 12}}, nil)
+	seq, err := p.exportSnapshot(2, fn)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), seq)
+	shard.EXPECT().ExportFamily(family, gomock.Any()).Return(&models.FamilyBackup{}, nil)
+	seq, err = p.exportSnapshot(2, fn)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), seq)
 }
 
 func TestPartition_ReplicaLog(t *testing.T) {
@@ -407,3 +538,77 @@ func TestPartition_Stop(t *testing.T) {
 	peer2.EXPECT().Shutdown()
 	p.Stop()
 }
+
+func TestPartition_PrepareReplica(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log := queue.NewMockFanOutQueue(ctrl)
+	p := &partition{log: log}
+
+	log.EXPECT().GetOrCreateConsumerGroup("2").Return(nil, fmt.Errorf("err"))
+	assert.Error(t, p.PrepareReplica(2))
+	log.EXPECT().GetOrCreateConsumerGroup("2").Return(queue.NewMockConsumerGroup(ctrl), nil)
+	assert.NoError(t, p.PrepareReplica(2))
+}
+
+func TestPartition_RemoveReplica(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log := queue.NewMockFanOutQueue(ctrl)
+	peer := NewMockReplicatorPeer(ctrl)
+	p := &partition{
+		log: log,
+		peers: map[models.NodeID]ReplicatorPeer{
+			2: peer,
+		},
+	}
+	peer.EXPECT().Shutdown()
+	log.EXPECT().DropConsumerGroup("2").Return(nil)
+	assert.NoError(t, p.RemoveReplica(2))
+	assert.Empty(t, p.peers)
+	// replicator not exist
+	log.EXPECT().DropConsumerGroup("3").Return(fmt.Errorf("err"))
+	assert.Error(t, p.RemoveReplica(3))
+}
+
+func TestPartition_Digest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := tsdb.NewMockShard(ctrl)
+	family := tsdb.NewMockDataFamily(ctrl)
+	p := &partition{shard: shard, family: family}
+	shard.EXPECT().DigestFamily(family).Return(&models.FamilyDigest{FamilyTime: 1}, nil)
+	digest, err := p.Digest()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), digest.FamilyTime)
+}
+
+func TestPartition_RepairReplica(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := tsdb.NewMockDataFamily(ctrl)
+	family.EXPECT().Indicator().Return("family").AnyTimes()
+	peer := NewMockReplicatorPeer(ctrl)
+	p := &partition{
+		currentNodeID: 1,
+		family:        family,
+		peers: map[models.NodeID]ReplicatorPeer{
+			1: NewMockReplicatorPeer(ctrl),
+			3: peer,
+			2: NewMockReplicatorPeer(ctrl),
+		},
+		logger: logger.GetLogger("Replica", "Test"),
+	}
+	assert.Equal(t, []models.NodeID{2, 3}, p.getFollowers())
+	// case 1: cannot repair local replica
+	assert.Error(t, p.RepairReplica(1))
+	// case 2: replicator not found
+	assert.Error(t, p.RepairReplica(4))
+	// case 3: request snapshot
+	peer.EXPECT().RequestSnapshot()
+	assert.NoError(t, p.RepairReplica(3))
+}
@@ -28,8 +28,9 @@ import (
 
 // state represents the state of replicator.
 type state struct {
-	state  models.ReplicatorState
-	errMsg string
+	state    models.ReplicatorState
+	errMsg   string
+	snapshot *models.SnapshotProgress
 }
 
 // Replicator represents write ahead log replicator.
@@ -65,6 +66,8 @@ type Replicator interface {
 	Pending() int64
 	// IgnoreMessage ignores invalid message.
 	IgnoreMessage(replicaIdx int64)
+	// RequestSnapshot requests replicating the data snapshot of family to follower(e.g. repair diverged replica).
+	RequestSnapshot()
 	// Close closes replicator, releases resource.
 	Close()
 }
@@ -150,6 +153,11 @@ func (r *replicator) IgnoreMessage(replicaIdx int64) {
 	}
 }
 
+// RequestSnapshot requests replicating the data snapshot of family to follower.
+func (r *replicator) RequestSnapshot() {
+	// do nothing, need impl in child class
+}
+
 // Close closes replicator.
 func (r *replicator) Close() {
 	// do nothing
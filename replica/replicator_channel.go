@@ -20,12 +20,19 @@ package replica
 import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/queue"
+	"github.com/lindb/lindb/tsdb"
 )
 
+// SnapshotFunc exports the data snapshot of family, passes rows block to fn, returns the replica sequence of snapshot.
+type SnapshotFunc func(fn tsdb.ExportFunc) (int64, error)
+
 // ReplicatorChannel represents channel peer[from,to] for the shard of database.
 type ReplicatorChannel struct {
 	State *models.ReplicaState
 
 	// underlying ConsumerGroup records the replication process.
 	ConsumerGroup queue.ConsumerGroup
+
+	// Snapshot exports the data snapshot of family, catches up the follower whose needed write ahead log is truncated.
+	Snapshot SnapshotFunc
 }
@@ -40,6 +40,8 @@ type ReplicatorPeer interface {
 	Shutdown()
 	// ReplicatorState returns the state and type of the replicator.
 	ReplicatorState() (string, *state)
+	// RequestSnapshot requests replicating the data snapshot of family to follower.
+	RequestSnapshot()
 }
 
 // replicatorPeer implements ReplicatorPeer
@@ -79,6 +81,11 @@ func (r *replicatorPeer) ReplicatorState() (string, *state) {
 	return r.runner.replicatorType, r.runner.replicator.State()
 }
 
+// RequestSnapshot requests replicating the data snapshot of family to follower.
+func (r *replicatorPeer) RequestSnapshot() {
+	r.runner.replicator.RequestSnapshot()
+}
+
 type replicatorRunner struct {
 	ctx            context.Context
 	cannel         context.CancelFunc
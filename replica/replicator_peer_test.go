@@ -79,6 +79,9 @@ func TestReplicatorPeer(t *testing.T) {
 	rt, s := peer.ReplicatorState()
 	assert.Equal(t, "remote", rt)
 	assert.Equal(t, state{state: models.ReplicatorInitState}, *s)
+	remote.requireSnapshot = atomic.NewBool(false)
+	peer.RequestSnapshot()
+	assert.True(t, remote.requireSnapshot.Load())
 	go func() {
 		ch <- struct{}{}
 	}()
@@ -19,8 +19,10 @@ package replica
 
 import (
 	"context"
+	"errors"
 	"sync"
 
+	"github.com/golang/snappy"
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/constants"
@@ -29,6 +31,7 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
 	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
 	"github.com/lindb/lindb/rpc"
 )
@@ -45,8 +48,9 @@ type remoteReplicator struct {
 	replicaStream protoReplicaV1.ReplicaService_ReplicaClient
 	stateMgr      storage.StateManager
 
-	isSuspend *atomic.Bool
-	suspend   chan struct{}
+	isSuspend       *atomic.Bool
+	suspend         chan struct{}
+	requireSnapshot *atomic.Bool
 
 	rwMutex sync.RWMutex
 
@@ -66,12 +70,13 @@ func NewRemoteReplicator(
 		replicator: replicator{
 			channel: channel,
 		},
-		cliFct:     cliFct,
-		stateMgr:   stateMgr,
-		isSuspend:  atomic.NewBool(false),
-		suspend:    make(chan struct{}),
-		statistics: metrics.NewStorageRemoteReplicatorStatistics(channel.State.Database, channel.State.ShardID.String()),
-		logger:     logger.GetLogger("Replica", "RemoteReplicator"),
+		cliFct:          cliFct,
+		stateMgr:        stateMgr,
+		isSuspend:       atomic.NewBool(false),
+		suspend:         make(chan struct{}),
+		requireSnapshot: atomic.NewBool(false),
+		statistics:      metrics.NewStorageRemoteReplicatorStatistics(channel.State.Database, channel.State.ShardID.String()),
+		logger:          logger.GetLogger("Replica", "RemoteReplicator"),
 	}
 	r.state.Store(&state{state: models.ReplicatorInitState, errMsg: "replicator initialized"})
 
@@ -126,13 +131,16 @@ func (r *remoteReplicator) Connect() bool {
 //  1. state == ready, return true
 //  2. state != ready, do channel init like tcp three-way handshake.
 //     a. next remote replica index = current node's replica index, return true.
-//     b. last remote ack index < current node's smallest ack, need reset remote replica index, then return true.
+//     b. last remote ack index < current node's smallest ack,
+//     if write ahead log still kept, rewind replica index, then return true;
+//     if write ahead log truncated, transfer snapshot of family, then return true;
+//     else need reset remote replica index, then return true.
 //     c. last remote ack index > current node's append index,
 //     need reset current append index/replica index, then return true.
 func (r *remoteReplicator) IsReady() bool {
 	stateVal := r.state.Load().(*state)
 	r.rwMutex.Lock()
-	if stateVal.state == models.ReplicatorReadyState {
+	if stateVal.state == models.ReplicatorReadyState && !r.requireSnapshot.Load() {
 		r.rwMutex.Unlock()
 		return true
 	}
@@ -171,6 +179,11 @@ func (r *remoteReplicator) IsReady() bool {
 	r.replicaCli = replicaCli
 	r.statistics.CreateReplicaCli.Incr()
 
+	if r.requireSnapshot.CAS(true, false) && r.channel.Snapshot != nil {
+		// replace the data of follower with snapshot(e.g. repair diverged replica)
+		return r.catchUpBySnapshot()
+	}
+
 	r.state.Store(&state{state: models.ReplicatorInitState, errMsg: "getting ack index"})
 	remoteLastReplicaAckIdx, err := r.getLastAckIdxFromReplica() // last ack index remote replica node
 	if err != nil {
@@ -195,6 +208,21 @@ func (r *remoteReplicator) IsReady() bool {
 	switch {
 	case remoteLastReplicaAckIdx < smallestAckIdx:
 		// maybe new remote replica node add in cluster or remote replica data lost.
+		if remoteLastReplicaAckIdx >= r.channel.ConsumerGroup.Queue().Queue().AcknowledgedSeq() {
+			// write ahead log after remote ack index still kept, rewind replica index, replay it.
+			r.logger.Warn("replica node ack < current node ack, rewind replica index",
+				logger.String("replicator", r.String()),
+				logger.Int64("remoteLastReplicaAckIdx", remoteLastReplicaAckIdx),
+				logger.Int64("smallestAckIdx", smallestAckIdx))
+			r.channel.ConsumerGroup.SetSeq(remoteLastReplicaAckIdx)
+			r.statistics.RewindReplicaIdx.Incr()
+			r.state.Store(&state{state: models.ReplicatorReadyState})
+			return true
+		}
+		if r.channel.Snapshot != nil {
+			// write ahead log truncated, transfer data snapshot of family to remote replica node.
+			return r.catchUpBySnapshot()
+		}
 		needResetReplicaIdx := smallestAckIdx + 1
 		r.logger.Warn("replica node ack < current node ack, need reset remote replica node's append index",
 			logger.String("replicator", r.String()),
@@ -254,6 +282,101 @@ func (r *remoteReplicator) IsReady() bool {
 	return false
 }
 
+// RequestSnapshot requests replicating the data snapshot of family to follower,
+// snapshot will be transferred when replicator checks if it is ready next time.
+func (r *remoteReplicator) RequestSnapshot() {
+	r.requireSnapshot.Store(true)
+}
+
+// catchUpBySnapshot transfers the data snapshot of family to remote replica node whose needed write ahead log is truncated,
+// then replicates the write ahead log after the sequence of snapshot.
+func (r *remoteReplicator) catchUpBySnapshot() bool {
+	progress := &models.SnapshotProgress{StartTime: timeutil.Now()}
+	updateState := func(st models.ReplicatorState, errMsg string) {
+		snapshot := *progress
+		r.state.Store(&state{state: st, errMsg: errMsg, snapshot: &snapshot})
+	}
+	r.logger.Warn("replica node's write ahead log is truncated, need transfer snapshot",
+		logger.String("replicator", r.String()))
+	updateState(models.ReplicatorSnapshotState, "transferring snapshot")
+	seq, err := r.transferSnapshot(progress, func() {
+		updateState(models.ReplicatorSnapshotState, "transferring snapshot")
+	})
+	if err != nil {
+		r.statistics.SnapshotFailures.Incr()
+		r.logger.Warn("transfer snapshot err",
+			logger.String("replicator", r.String()),
+			logger.Error(err))
+		updateState(models.ReplicatorFailureState, "transfer snapshot failure, root cause: "+err.Error())
+		return false
+	}
+	// replicate write ahead log after snapshot
+	r.channel.ConsumerGroup.SetSeq(seq)
+	progress.Sequence = seq
+	progress.EndTime = timeutil.Now()
+	r.statistics.Snapshot.Incr()
+	r.logger.Info("transfer snapshot successfully",
+		logger.String("replicator", r.String()),
+		logger.Int64("sequence", seq),
+		logger.Int64("blocks", progress.Blocks),
+		logger.Int64("rows", progress.Rows),
+		logger.Int64("bytes", progress.Bytes))
+	updateState(models.ReplicatorReadyState, "")
+	return true
+}
+
+// transferSnapshot sends the data snapshot of family through a dedicated replica stream.
+// Rows blocks are sent one by one, the last request with empty record carries the sequence of snapshot.
+func (r *remoteReplicator) transferSnapshot(progress *models.SnapshotProgress, onProgress func()) (int64, error) {
+	ctx, cancel := context.WithCancel(r.ctx)
+	defer cancel()
+
+	replicaState := encoding.JSONMarshal(&r.channel.State)
+	ctx = rpc.CreateOutgoingContextWithPairs(ctx,
+		constants.RPCMetaReplicaState, string(replicaState),
+		constants.RPCMetaReplicaSnapshot, "true")
+	stream, err := r.replicaCli.Replica(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = stream.CloseSend()
+	}()
+	send := func(idx int64, record []byte) (int64, error) {
+		if err0 := stream.Send(&protoReplicaV1.ReplicaRequest{ReplicaIndex: idx, Record: record}); err0 != nil {
+			return 0, err0
+		}
+		resp, err0 := stream.Recv()
+		if err0 != nil {
+			return 0, err0
+		}
+		if resp.Err != "" {
+			return 0, errors.New(resp.Err)
+		}
+		return resp.AckIndex, nil
+	}
+	seq, err := r.channel.Snapshot(func(_ int64, rows []byte) error {
+		block := snappy.Encode(nil, rows)
+		installed, err0 := send(progress.Blocks+1, block)
+		if err0 != nil {
+			return err0
+		}
+		progress.Blocks++
+		progress.Rows += installed
+		progress.Bytes += int64(len(block))
+		onProgress()
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	// end of snapshot, remote replica node persists snapshot, then resets its write ahead log
+	if _, err = send(seq, nil); err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
+
 // Replica sends data to remote replica node.
 func (r *remoteReplicator) Replica(idx int64, msg []byte) {
 	cli := r.replicaStream
@@ -34,6 +34,7 @@ import (
 	"github.com/lindb/lindb/pkg/queue"
 	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
 	"github.com/lindb/lindb/rpc"
+	"github.com/lindb/lindb/tsdb"
 )
 
 func TestRemoteReplicator_IsReady(t *testing.T) {
@@ -106,6 +107,7 @@ func TestRemoteReplicator_IsReady(t *testing.T) {
 				q.EXPECT().AppendedSeq().Return(int64(10))
 				cg.EXPECT().ConsumedSeq().Return(int64(12))
 				cg.EXPECT().AcknowledgedSeq().Return(int64(13))
+				q.EXPECT().AcknowledgedSeq().Return(int64(13))
 				replicaCli.EXPECT().GetReplicaAckIndex(gomock.Any(), gomock.Any()).Return(&protoReplicaV1.GetReplicaAckIndexResponse{
 					AckIndex: 10,
 				}, nil)
@@ -120,6 +122,7 @@ func TestRemoteReplicator_IsReady(t *testing.T) {
 				q.EXPECT().AppendedSeq().Return(int64(10))
 				cg.EXPECT().ConsumedSeq().Return(int64(7))
 				cg.EXPECT().AcknowledgedSeq().Return(int64(8))
+				q.EXPECT().AcknowledgedSeq().Return(int64(13))
 				replicaCli.EXPECT().GetReplicaAckIndex(gomock.Any(), gomock.Any()).Return(&protoReplicaV1.GetReplicaAckIndexResponse{
 					AckIndex: 5,
 				}, nil)
@@ -128,6 +131,103 @@ func TestRemoteReplicator_IsReady(t *testing.T) {
 			},
 			ready: true,
 		},
+		{
+			name: "remote replica ack index < current smallest ack, rewind replica index",
+			prepare: func(r *remoteReplicator) {
+				cliFct.EXPECT().CreateReplicaServiceClient(gomock.Any()).Return(replicaCli, nil)
+				q.EXPECT().AppendedSeq().Return(int64(20))
+				cg.EXPECT().ConsumedSeq().Return(int64(12))
+				cg.EXPECT().AcknowledgedSeq().Return(int64(13))
+				q.EXPECT().AcknowledgedSeq().Return(int64(9))
+				replicaCli.EXPECT().GetReplicaAckIndex(gomock.Any(), gomock.Any()).Return(&protoReplicaV1.GetReplicaAckIndexResponse{
+					AckIndex: 10,
+				}, nil)
+				cg.EXPECT().SetSeq(int64(10))
+			},
+			ready: true,
+		},
+		{
+			name: "remote replica ack index < current smallest ack, create snapshot stream failure",
+			prepare: func(r *remoteReplicator) {
+				r.channel.Snapshot = func(_ tsdb.ExportFunc) (int64, error) {
+					return 0, nil
+				}
+				cliFct.EXPECT().CreateReplicaServiceClient(gomock.Any()).Return(replicaCli, nil)
+				q.EXPECT().AppendedSeq().Return(int64(20))
+				cg.EXPECT().ConsumedSeq().Return(int64(12))
+				cg.EXPECT().AcknowledgedSeq().Return(int64(13))
+				q.EXPECT().AcknowledgedSeq().Return(int64(13))
+				replicaCli.EXPECT().GetReplicaAckIndex(gomock.Any(), gomock.Any()).Return(&protoReplicaV1.GetReplicaAckIndexResponse{
+					AckIndex: 10,
+				}, nil)
+				replicaCli.EXPECT().Replica(gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			ready: false,
+		},
+		{
+			name: "snapshot requested(repair diverged replica), ready after snapshot transferred",
+			prepare: func(r *remoteReplicator) {
+				r.channel.Snapshot = func(_ tsdb.ExportFunc) (int64, error) {
+					return 20, nil
+				}
+				r.state.Store(&state{state: models.ReplicatorReadyState})
+				r.RequestSnapshot()
+				stream := protoReplicaV1.NewMockReplicaService_ReplicaClient(ctrl)
+				cliFct.EXPECT().CreateReplicaServiceClient(gomock.Any()).Return(replicaCli, nil)
+				replicaCli.EXPECT().Replica(gomock.Any()).Return(stream, nil)
+				stream.EXPECT().Send(gomock.Any()).Return(nil)
+				stream.EXPECT().Recv().Return(&protoReplicaV1.ReplicaResponse{}, nil)
+				stream.EXPECT().CloseSend().Return(nil)
+				cg.EXPECT().SetSeq(int64(20))
+			},
+			ready: true,
+		},
+		{
+			name: "remote replica ack index < current smallest ack, install snapshot failure",
+			prepare: func(r *remoteReplicator) {
+				r.channel.Snapshot = func(fn tsdb.ExportFunc) (int64, error) {
+					return 0, fn(10, []byte{1, 2, 3})
+				}
+				cliFct.EXPECT().CreateReplicaServiceClient(gomock.Any()).Return(replicaCli, nil)
+				q.EXPECT().AppendedSeq().Return(int64(20))
+				cg.EXPECT().ConsumedSeq().Return(int64(12))
+				cg.EXPECT().AcknowledgedSeq().Return(int64(13))
+				q.EXPECT().AcknowledgedSeq().Return(int64(13))
+				replicaCli.EXPECT().GetReplicaAckIndex(gomock.Any(), gomock.Any()).Return(&protoReplicaV1.GetReplicaAckIndexResponse{
+					AckIndex: 10,
+				}, nil)
+				stream := protoReplicaV1.NewMockReplicaService_ReplicaClient(ctrl)
+				replicaCli.EXPECT().Replica(gomock.Any()).Return(stream, nil)
+				stream.EXPECT().Send(gomock.Any()).Return(nil)
+				stream.EXPECT().Recv().Return(&protoReplicaV1.ReplicaResponse{Err: "err"}, nil)
+				stream.EXPECT().CloseSend().Return(nil)
+			},
+			ready: false,
+		},
+		{
+			name: "remote replica ack index < current smallest ack, transfer snapshot successfully",
+			prepare: func(r *remoteReplicator) {
+				r.channel.Snapshot = func(fn tsdb.ExportFunc) (int64, error) {
+					return 15, fn(10, []byte{1, 2, 3})
+				}
+				cliFct.EXPECT().CreateReplicaServiceClient(gomock.Any()).Return(replicaCli, nil)
+				q.EXPECT().AppendedSeq().Return(int64(20))
+				cg.EXPECT().ConsumedSeq().Return(int64(12))
+				cg.EXPECT().AcknowledgedSeq().Return(int64(13))
+				q.EXPECT().AcknowledgedSeq().Return(int64(13))
+				replicaCli.EXPECT().GetReplicaAckIndex(gomock.Any(), gomock.Any()).Return(&protoReplicaV1.GetReplicaAckIndexResponse{
+					AckIndex: 10,
+				}, nil)
+				stream := protoReplicaV1.NewMockReplicaService_ReplicaClient(ctrl)
+				replicaCli.EXPECT().Replica(gomock.Any()).Return(stream, nil)
+				stream.EXPECT().Send(gomock.Any()).Return(nil).Times(2)
+				stream.EXPECT().Recv().Return(&protoReplicaV1.ReplicaResponse{AckIndex: 3}, nil)
+				stream.EXPECT().Recv().Return(&protoReplicaV1.ReplicaResponse{AckIndex: 15}, nil)
+				stream.EXPECT().CloseSend().Return(nil)
+				cg.EXPECT().SetSeq(int64(15))
+			},
+			ready: true,
+		},
 		{
 			name: "remote replica ack index > current append index, maybe leader lost data",
 			prepare: func(r *remoteReplicator) {
@@ -189,6 +289,7 @@ func TestRemoteReplicator_IsReady(t *testing.T) {
 	for _, tt := range cases {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
+			rc.Snapshot = nil
 			r := NewRemoteReplicator(context.TODO(), rc, stateMgr, cliFct)
 			r1 := r.(*remoteReplicator)
 			if tt.prepare != nil {
@@ -196,6 +297,9 @@ func TestRemoteReplicator_IsReady(t *testing.T) {
 			}
 			ready := r.IsReady()
 			assert.Equal(t, tt.ready, ready)
+			if rc.Snapshot != nil {
+				assert.NotNil(t, r1.State().snapshot)
+			}
 		})
 	}
 }
@@ -45,12 +45,24 @@ type WriteAheadLog interface {
 	// GetOrCreatePartition returns a partition of write ahead log.
 	// if exist returns it, else create a new partition.
 	GetOrCreatePartition(shardID models.ShardID, familyTime int64, leader models.NodeID) (Partition, error)
+	// PrepareReplica pins write ahead log of shard's partitions(led by current node) for replica,
+	// includes the partitions created later, replicator will be built by BuildReplica.
+	PrepareReplica(shardID models.ShardID, replica models.NodeID) error
+	// BuildReplica builds the replicator of replica for shard's partitions pinned by PrepareReplica.
+	BuildReplica(shardID models.ShardID, replica models.NodeID) error
+	// CompleteReplica marks the replica relation completed, the partitions created later don't replicate to replica
+	// automatically, replica relation will be built based on shard's replica assignment.
+	CompleteReplica(shardID models.ShardID, replica models.NodeID)
+	// RemoveReplica removes the replicator and write ahead log consume sequence of replica for shard's partitions.
+	RemoveReplica(shardID models.ShardID, replica models.NodeID) error
 	// Stop stops all replicator channels.
 	Stop()
 	// Drop drops write ahead log.
 	Drop() error
 	// getReplicaState returns the state of replica.
 	getReplicaState() (rs []models.FamilyLogReplicaState)
+	// getLeaderPartitions returns the partitions led by current node.
+	getLeaderPartitions() map[partitionKey]Partition
 	// recovery recoveries database write ahead log from local storage.
 	recovery() error
 	// destroy removes expired write ahead log.
@@ -71,6 +83,8 @@ type writeAheadLog struct {
 	mutex sync.Mutex
 	// family log = shard + family + leader
 	familyLogs map[partitionKey]Partition
+	// pending replicas of shard, replica => if replicator built(e.g. replica migration)
+	pendingReplicas map[models.ShardID]map[models.NodeID]bool
 
 	logger *logger.Logger
 }
@@ -96,6 +110,8 @@ func NewWriteAheadLog(
 		stateMgr:      stateMgr,
 		familyLogs:    make(map[partitionKey]Partition),
 		logger:        logger.GetLogger("Replica", "WriteAheadLog"),
+
+		pendingReplicas: make(map[models.ShardID]map[models.NodeID]bool),
 	}
 	return log
 }
@@ -131,12 +147,7 @@ func (w *writeAheadLog) GetOrCreatePartition(
 	if err != nil {
 		return nil, err
 	}
-	// wal path: base dir + database + shard + family time + leader
-	dir := path.Join(
-		strconv.Itoa(int(shardID)),
-		timeutil.FormatTimestamp(familyTime, timeutil.DataTimeFormat4),
-		strconv.Itoa(int(leader)))
-	dirPath := path.Join(w.dir, dir)
+	dirPath := familyLogPath(w.dir, shardID, familyTime, leader)
 
 	q, err := newFanOutQueue(dirPath, w.cfg.GetDataSizeLimit())
 	if err != nil {
@@ -145,9 +156,95 @@ func (w *writeAheadLog) GetOrCreatePartition(
 	p := NewPartitionFn(w.ctx, shard, family, w.currentNodeID, q, w.cliFct, w.stateMgr)
 
 	w.familyLogs[key] = p
+	if leader == w.currentNodeID {
+		// pending replicas need to replicate the data written later
+		for replica, built := range w.pendingReplicas[shardID] {
+			if err := w.prepareReplica(p, replica, built); err != nil {
+				w.logger.Warn("prepare pending replica for new partition failure",
+					logger.String("path", dirPath), logger.Any("replica", replica), logger.Error(err))
+			}
+		}
+	}
 	return p, nil
 }
 
+// PrepareReplica pins write ahead log of shard's partitions(led by current node) for replica,
+// includes the partitions created later, replicator will be built by BuildReplica.
+func (w *writeAheadLog) PrepareReplica(shardID models.ShardID, replica models.NodeID) error {
+	return w.setPendingReplica(shardID, replica, false)
+}
+
+// BuildReplica builds the replicator of replica for shard's partitions pinned by PrepareReplica.
+func (w *writeAheadLog) BuildReplica(shardID models.ShardID, replica models.NodeID) error {
+	return w.setPendingReplica(shardID, replica, true)
+}
+
+// CompleteReplica marks the replica relation completed, the partitions created later don't replicate to replica
+// automatically, replica relation will be built based on shard's replica assignment.
+func (w *writeAheadLog) CompleteReplica(shardID models.ShardID, replica models.NodeID) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.removePendingReplica(shardID, replica)
+}
+
+// RemoveReplica removes the replicator and write ahead log consume sequence of replica for shard's partitions.
+func (w *writeAheadLog) RemoveReplica(shardID models.ShardID, replica models.NodeID) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.removePendingReplica(shardID, replica)
+	for key, p := range w.familyLogs {
+		if key.shardID != shardID || key.leader != w.currentNodeID {
+			continue
+		}
+		if err := p.RemoveReplica(replica); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setPendingReplica sets pending replica of shard, then prepares replica for shard's partitions.
+func (w *writeAheadLog) setPendingReplica(shardID models.ShardID, replica models.NodeID, built bool) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	replicas, ok := w.pendingReplicas[shardID]
+	if !ok {
+		replicas = make(map[models.NodeID]bool)
+		w.pendingReplicas[shardID] = replicas
+	}
+	replicas[replica] = built
+	for key, p := range w.familyLogs {
+		if key.shardID != shardID || key.leader != w.currentNodeID {
+			continue
+		}
+		if err := w.prepareReplica(p, replica, built); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removePendingReplica removes pending replica of shard.
+func (w *writeAheadLog) removePendingReplica(shardID models.ShardID, replica models.NodeID) {
+	if replicas, ok := w.pendingReplicas[shardID]; ok {
+		delete(replicas, replica)
+		if len(replicas) == 0 {
+			delete(w.pendingReplicas, shardID)
+		}
+	}
+}
+
+// prepareReplica pins write ahead log for replica, builds replicator if needed.
+func (w *writeAheadLog) prepareReplica(p Partition, replica models.NodeID, built bool) error {
+	if built {
+		return p.BuildReplicaForLeader(w.currentNodeID, []models.NodeID{replica})
+	}
+	return p.PrepareReplica(replica)
+}
+
 // getReplicaState returns the state of replica.
 func (w *writeAheadLog) getReplicaState() (rs []models.FamilyLogReplicaState) {
 	w.mutex.Lock()
@@ -161,6 +258,20 @@ func (w *writeAheadLog) getReplicaState() (rs []models.FamilyLogReplicaState) {
 	return
 }
 
+// getLeaderPartitions returns the partitions led by current node.
+func (w *writeAheadLog) getLeaderPartitions() map[partitionKey]Partition {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	partitions := make(map[partitionKey]Partition)
+	for key, p := range w.familyLogs {
+		if key.leader == w.currentNodeID {
+			partitions[key] = p
+		}
+	}
+	return partitions
+}
+
 // recovery recoveries database write ahead log from local storage.
 func (w *writeAheadLog) recovery() error {
 	shards, err := listDirFn(w.dir)
@@ -297,3 +408,13 @@ func (w *writeAheadLog) Stop() {
 func (w *writeAheadLog) Drop() error {
 	return removeDirFn(w.dir)
 }
+
+// familyLogPath returns the path of family write ahead log.
+// wal path: base dir + database + shard + family time + leader
+func familyLogPath(databaseDir string, shardID models.ShardID, familyTime int64, leader models.NodeID) string {
+	return path.Join(
+		databaseDir,
+		strconv.Itoa(int(shardID)),
+		timeutil.FormatTimestamp(familyTime, timeutil.DataTimeFormat4),
+		strconv.Itoa(int(leader)))
+}
@@ -69,6 +69,8 @@ type WriteAheadLogManager interface {
 	Recovery() error
 	// Stop stops all replicator channel.
 	Stop()
+	// getDatabaseLogs returns write ahead log of all databases.
+	getDatabaseLogs() []WriteAheadLog
 }
 
 // writeAheadLogManager implements WriteAheadLogManager.
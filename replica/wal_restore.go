@@ -0,0 +1,67 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+// RestoreWriteAheadLog rebuilds the write ahead log of database based on backup,
+// sets the appended sequence of each family log(include local replica) to the replica sequence in backup,
+// so that new written data/replication from leader continues after the data in backup.
+// NOTICE: storage node must be stopped, and the old write ahead log of database will be removed.
+func RestoreWriteAheadLog(cfg config.WAL, currentNodeID models.NodeID, database *models.DatabaseBackup) error {
+	dir := path.Join(cfg.Dir, database.Name)
+	if err := removeDirFn(dir); err != nil {
+		return err
+	}
+	for _, shard := range database.Shards {
+		for _, family := range shard.Families {
+			for leader, seq := range family.Sequences {
+				dirPath := familyLogPath(dir, shard.ShardID, family.FamilyTime, models.NodeID(leader))
+				if err := restoreFamilyLog(dirPath, cfg.GetDataSizeLimit(), currentNodeID, seq); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	logger.GetLogger("Replica", "WriteAheadLog").Info("restore write ahead log successfully",
+		logger.String("database", database.Name), logger.String("path", dir))
+	return nil
+}
+
+// restoreFamilyLog creates family log, then sets appended sequence of log/local replica.
+func restoreFamilyLog(dirPath string, dataSizeLimit int64, currentNodeID models.NodeID, seq int64) error {
+	q, err := newFanOutQueue(dirPath, dataSizeLimit)
+	if err != nil {
+		return err
+	}
+	defer q.Close()
+	// local replica consumer group
+	if _, err := q.GetOrCreateConsumerGroup(fmt.Sprintf("%d", currentNodeID)); err != nil {
+		return err
+	}
+	q.SetAppendedSeq(seq)
+	q.Sync()
+	return nil
+}
@@ -0,0 +1,81 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/queue"
+)
+
+func TestRestoreWriteAheadLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		removeDirFn = fileutil.RemoveDir
+		newFanOutQueue = queue.NewFanOutQueue
+		ctrl.Finish()
+	}()
+	cfg := config.WAL{Dir: t.TempDir(), DataSizeLimit: 1}
+	database := &models.DatabaseBackup{
+		Name: "db",
+		Shards: []*models.ShardBackup{{
+			ShardID:  1,
+			Families: []*models.FamilyBackup{{FamilyTime: 1, Sequences: map[int32]int64{2: 100}}},
+		}},
+	}
+	// remove old log failure
+	removeDirFn = func(path string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, RestoreWriteAheadLog(cfg, 1, database))
+	removeDirFn = fileutil.RemoveDir
+	// create log failure
+	newFanOutQueue = func(dirPath string, dataSizeLimit int64) (queue.FanOutQueue, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, RestoreWriteAheadLog(cfg, 1, database))
+	// create local replica failure
+	q := queue.NewMockFanOutQueue(ctrl)
+	newFanOutQueue = func(dirPath string, dataSizeLimit int64) (queue.FanOutQueue, error) {
+		return q, nil
+	}
+	q.EXPECT().GetOrCreateConsumerGroup("1").Return(nil, fmt.Errorf("err"))
+	q.EXPECT().Close()
+	assert.Error(t, RestoreWriteAheadLog(cfg, 1, database))
+	newFanOutQueue = queue.NewFanOutQueue
+
+	// restore successfully
+	assert.NoError(t, RestoreWriteAheadLog(cfg, 1, database))
+	log, err := queue.NewFanOutQueue(familyLogPath(path.Join(cfg.Dir, "db"), 1, 1, 2), cfg.GetDataSizeLimit())
+	assert.NoError(t, err)
+	defer log.Close()
+	assert.Equal(t, int64(100), log.Queue().AppendedSeq())
+	assert.Equal(t, []string{"1"}, log.ConsumerGroupNames())
+	local, err := log.GetOrCreateConsumerGroup("1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), local.ConsumedSeq())
+	assert.Equal(t, int64(100), local.AcknowledgedSeq())
+}
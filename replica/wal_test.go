@@ -377,3 +377,80 @@ func TestWriteAheadLog_Drop(t *testing.T) {
 	}
 	assert.Error(t, wal.Drop())
 }
+
+func TestWriteAheadLog_PendingReplica(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newFanOutQueue = queue.NewFanOutQueue
+		NewPartitionFn = NewPartition
+		ctrl.Finish()
+	}()
+	engine := tsdb.NewMockEngine(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	p1 := NewMockPartition(ctrl)
+	p2 := NewMockPartition(ctrl)
+	p3 := NewMockPartition(ctrl)
+	l := NewWriteAheadLog(context.TODO(), config.WAL{RemoveTaskInterval: ltoml.Duration(time.Minute)},
+		1, "test", engine, nil, nil)
+	wal := l.(*writeAheadLog)
+	wal.familyLogs[partitionKey{shardID: 1, familyTime: 1, leader: 1}] = p1
+	wal.familyLogs[partitionKey{shardID: 1, familyTime: 1, leader: 2}] = p2 // not led by current node
+	wal.familyLogs[partitionKey{shardID: 2, familyTime: 1, leader: 1}] = p3
+	assert.Equal(t, map[partitionKey]Partition{
+		{shardID: 1, familyTime: 1, leader: 1}: p1,
+		{shardID: 2, familyTime: 1, leader: 1}: p3,
+	}, wal.getLeaderPartitions())
+
+	// prepare replica
+	p1.EXPECT().PrepareReplica(models.NodeID(3)).Return(fmt.Errorf("err"))
+	assert.Error(t, wal.PrepareReplica(1, 3))
+	p1.EXPECT().PrepareReplica(models.NodeID(3)).Return(nil)
+	assert.NoError(t, wal.PrepareReplica(1, 3))
+
+	// new partition created after prepared
+	engine.EXPECT().GetShard(gomock.Any(), gomock.Any()).Return(shard, true).AnyTimes()
+	shard.EXPECT().GetOrCrateDataFamily(gomock.Any()).Return(nil, nil).AnyTimes()
+	newFanOutQueue = func(dirPath string, dataSizeLimit int64) (q queue.FanOutQueue, err error) {
+		return nil, nil
+	}
+	p4 := NewMockPartition(ctrl)
+	NewPartitionFn = func(ctx context.Context, shard tsdb.Shard, family tsdb.DataFamily,
+		currentNodeID models.NodeID, log queue.FanOutQueue,
+		cliFct rpc.ClientStreamFactory, stateMgr storage.StateManager) Partition {
+		return p4
+	}
+	p4.EXPECT().PrepareReplica(models.NodeID(3)).Return(fmt.Errorf("err"))
+	p, err := wal.GetOrCreatePartition(1, 2, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, p4, p)
+
+	// build replica
+	p1.EXPECT().BuildReplicaForLeader(models.NodeID(1), []models.NodeID{3}).Return(nil)
+	p4.EXPECT().BuildReplicaForLeader(models.NodeID(1), []models.NodeID{3}).Return(nil)
+	assert.NoError(t, wal.BuildReplica(1, 3))
+	p5 := NewMockPartition(ctrl)
+	NewPartitionFn = func(ctx context.Context, shard tsdb.Shard, family tsdb.DataFamily,
+		currentNodeID models.NodeID, log queue.FanOutQueue,
+		cliFct rpc.ClientStreamFactory, stateMgr storage.StateManager) Partition {
+		return p5
+	}
+	p5.EXPECT().BuildReplicaForLeader(models.NodeID(1), []models.NodeID{3}).Return(nil)
+	_, err = wal.GetOrCreatePartition(1, 3, 1)
+	assert.NoError(t, err)
+
+	// complete replica
+	wal.CompleteReplica(1, 3)
+	assert.Empty(t, wal.pendingReplicas)
+	wal.CompleteReplica(1, 3)
+
+	// remove replica
+	p3.EXPECT().PrepareReplica(models.NodeID(4)).Return(nil)
+	assert.NoError(t, wal.PrepareReplica(2, 4))
+	p1.EXPECT().RemoveReplica(models.NodeID(4)).Return(nil).AnyTimes()
+	p4.EXPECT().RemoveReplica(models.NodeID(4)).Return(nil).AnyTimes()
+	p5.EXPECT().RemoveReplica(models.NodeID(4)).Return(fmt.Errorf("err"))
+	assert.Error(t, wal.RemoveReplica(1, 4))
+	p5.EXPECT().RemoveReplica(models.NodeID(4)).Return(nil)
+	assert.NoError(t, wal.RemoveReplica(1, 4))
+	assert.Len(t, wal.pendingReplicas, 1)
+}
@@ -19,7 +19,10 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 
@@ -30,6 +33,11 @@ import (
 	protoWriteV1 "github.com/lindb/lindb/proto/gen/v1/write"
 )
 
+// for testing
+var (
+	closeTimeout = 5 * time.Second
+)
+
 //go:generate mockgen -source=./write_stream.go -destination=./write_stream_mock.go -package=rpc
 
 // WriteStream represents the channel which writes metric to storage based on grpc stream,
@@ -38,6 +46,9 @@ type WriteStream interface {
 	io.Closer
 	// Send sends metric data to storage.
 	Send(data []byte) error
+	// SendWithAck sends metric data to storage, ack is invoked after storage responds the data,
+	// or write stream is closed before storage responds.
+	SendWithAck(data []byte, ack func(err error)) error
 }
 
 // writeStream implements WriteStream interface.
@@ -45,14 +56,18 @@ type writeStream struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	target     models.Node
-	database   string
-	shardState *models.ShardState
-	familyTime int64
+	target      models.Node
+	familyState *models.FamilyState
 
 	fct    ClientStreamFactory
 	cli    protoWriteV1.WriteService_WriteClient
 	closed *atomic.Bool
+	// closed after receive response task exited.
+	recvDone chan struct{}
+
+	// storage responds each write request in order, pending acks of sent data which are waiting for response.
+	pendingAcks []func(err error)
+	lock4acks   sync.Mutex
 
 	logger *logger.Logger
 }
@@ -61,20 +76,19 @@ type writeStream struct {
 func NewWriteStream(
 	ctx context.Context,
 	target models.Node,
-	database string, shardState *models.ShardState, familyTime int64,
+	familyState *models.FamilyState,
 	fct ClientStreamFactory,
 ) (WriteStream, error) {
 	c, cancel := context.WithCancel(ctx)
 	s := &writeStream{
-		ctx:        c,
-		cancel:     cancel,
-		target:     target,
-		database:   database,
-		shardState: shardState,
-		familyTime: familyTime,
-		fct:        fct,
-		closed:     atomic.NewBool(false),
-		logger:     logger.GetLogger("RPC", "WriteStream"),
+		ctx:         c,
+		cancel:      cancel,
+		target:      target,
+		familyState: familyState,
+		fct:         fct,
+		closed:      atomic.NewBool(false),
+		recvDone:    make(chan struct{}),
+		logger:      logger.GetLogger("RPC", "WriteStream"),
 	}
 
 	// initialize write stream
@@ -92,11 +106,7 @@ func (s *writeStream) initialize() error {
 	}
 
 	// pass metadata(database/shard/family state) when create rpc connection.
-	familyState := encoding.JSONMarshal(&models.FamilyState{
-		Database:   s.database,
-		Shard:      *s.shardState,
-		FamilyTime: s.familyTime,
-	})
+	familyState := encoding.JSONMarshal(s.familyState)
 	ctx := CreateOutgoingContextWithPairs(s.ctx, constants.RPCMetaKeyFamilyState, string(familyState))
 	writeCli, err := writeService.Write(ctx)
 
@@ -111,31 +121,99 @@ func (s *writeStream) initialize() error {
 	go s.recvLoop()
 
 	s.logger.Info("initialize write client stream successfully",
-		logger.String("database", s.database),
-		logger.Any("shard", s.shardState.ID),
+		logger.String("database", s.familyState.Database),
+		logger.Any("shard", s.familyState.Shard.ID),
 		logger.String("target", s.target.Indicator()))
 	return nil
 }
 
 // Send sends metric data to storage.
 func (s *writeStream) Send(data []byte) error {
+	return s.SendWithAck(data, nil)
+}
+
+// SendWithAck sends metric data to storage, ack is invoked after storage responds the data,
+// or write stream is closed before storage responds.
+func (s *writeStream) SendWithAck(data []byte, ack func(err error)) error {
 	if s.closed.Load() {
 		// if write stream is closed, return EOF err
 		return io.EOF
 	}
-	return s.cli.Send(&protoWriteV1.WriteRequest{Record: data})
+	// add pending ack before send, because response maybe received before send returns
+	s.lock4acks.Lock()
+	s.pendingAcks = append(s.pendingAcks, ack)
+	s.lock4acks.Unlock()
+
+	if err := s.cli.Send(&protoWriteV1.WriteRequest{Record: data}); err != nil {
+		// remove pending ack of data which sends failure, caller will retry it
+		s.lock4acks.Lock()
+		if n := len(s.pendingAcks); n > 0 {
+			s.pendingAcks = s.pendingAcks[:n-1]
+		}
+		s.lock4acks.Unlock()
+		return err
+	}
+	return nil
+}
+
+// ack invokes the pending ack of the earliest sent data after storage responds.
+func (s *writeStream) ack(err error) {
+	s.lock4acks.Lock()
+	if len(s.pendingAcks) == 0 {
+		s.lock4acks.Unlock()
+		return
+	}
+	ack := s.pendingAcks[0]
+	s.pendingAcks[0] = nil
+	s.pendingAcks = s.pendingAcks[1:]
+	s.lock4acks.Unlock()
+
+	if ack != nil {
+		ack(err)
+	}
 }
 
-// Close closes send stream, and cancel stream context, server will stop receive write request under this stream.
+// failPendingAcks fails all pending acks after write stream closed.
+func (s *writeStream) failPendingAcks() {
+	s.lock4acks.Lock()
+	acks := s.pendingAcks
+	s.pendingAcks = nil
+	s.lock4acks.Unlock()
+
+	for _, ack := range acks {
+		if ack != nil {
+			ack(constants.ErrWriteStreamClosed)
+		}
+	}
+}
+
+// Close closes send stream, waits storage responds all sent data(or timeout), then cancel stream context,
+// server will stop receive write request under this stream.
 func (s *writeStream) Close() error {
 	defer s.cancel() // close stream context
 	s.logger.Info("close write stream",
 		logger.String("target", s.target.Indicator()))
-	return s.cli.CloseSend()
+	if err := s.cli.CloseSend(); err != nil {
+		return err
+	}
+	if s.recvDone != nil {
+		// storage returns io.EOF after all received data responded, wait it for avoiding losing in-flight data,
+		// for example, switching write stream to new leader after shard leader transferred.
+		timer := time.NewTimer(closeTimeout)
+		defer timer.Stop()
+		select {
+		case <-s.recvDone:
+		case <-timer.C:
+			s.logger.Warn("wait write stream drain timeout",
+				logger.String("target", s.target.Indicator()))
+		}
+	}
+	return nil
 }
 
 // recvLoop is a loop to receive message from write stream.
-// if stream context is done or receive io.EOF err, need mark stream is closed.
+// if stream context is done or receive any err(io.EOF or transport err, both are terminal for grpc stream),
+// need mark stream is closed.
 func (s *writeStream) recvLoop() {
 	defer func() {
 		if err := recover(); err != nil {
@@ -145,6 +223,10 @@ func (s *writeStream) recvLoop() {
 				logger.Stack())
 			s.closed.Store(true)
 		}
+		s.failPendingAcks()
+		if s.recvDone != nil {
+			close(s.recvDone)
+		}
 	}()
 
 	for {
@@ -161,21 +243,23 @@ func (s *writeStream) recvLoop() {
 		default:
 			resp, err := s.cli.Recv()
 			if err != nil {
-				s.logger.Error("receive error from write stream",
-					logger.String("target", s.target.Indicator()),
-					logger.Error(err))
-				if err == io.EOF {
-					s.closed.Store(true)
-					// stream is closed, return it.
-					return
+				if err != io.EOF {
+					s.logger.Error("receive error from write stream",
+						logger.String("target", s.target.Indicator()),
+						logger.Error(err))
 				}
-				continue
+				s.closed.Store(true)
+				// stream is broken or closed, return it.
+				return
 			}
 			if resp.Err != "" {
 				// get err from response
 				s.logger.Error("get err write response",
 					logger.String("target", s.target.Indicator()),
 					logger.String("err", resp.Err))
+				s.ack(fmt.Errorf("%w: %s", constants.ErrWriteNotAcknowledged, resp.Err))
+			} else {
+				s.ack(nil)
 			}
 		}
 	}
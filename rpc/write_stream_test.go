@@ -22,11 +22,13 @@ import (
 	"fmt"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	protoWriteV1 "github.com/lindb/lindb/proto/gen/v1/write"
@@ -40,7 +42,7 @@ func TestNewWriteStream(t *testing.T) {
 
 	// case 1: create write service cli err
 	fct.EXPECT().CreateWriteServiceClient(gomock.Any()).Return(nil, fmt.Errorf("err"))
-	stream, err := NewWriteStream(context.TODO(), nil, "test", &models.ShardState{}, 1, fct)
+	stream, err := NewWriteStream(context.TODO(), nil, &models.FamilyState{Database: "test", FamilyTime: 1}, fct)
 	assert.Error(t, err)
 	assert.Nil(t, stream)
 
@@ -48,7 +50,7 @@ func TestNewWriteStream(t *testing.T) {
 	writeSrv := protoWriteV1.NewMockWriteServiceClient(ctrl)
 	fct.EXPECT().CreateWriteServiceClient(gomock.Any()).Return(writeSrv, nil).AnyTimes()
 	writeSrv.EXPECT().Write(gomock.Any()).Return(nil, fmt.Errorf("err"))
-	stream, err = NewWriteStream(context.TODO(), nil, "test", &models.ShardState{}, 1, fct)
+	stream, err = NewWriteStream(context.TODO(), nil, &models.FamilyState{Database: "test", FamilyTime: 1}, fct)
 	assert.Error(t, err)
 	assert.Nil(t, stream)
 
@@ -57,7 +59,7 @@ func TestNewWriteStream(t *testing.T) {
 	writeSrv.EXPECT().Write(gomock.Any()).Return(cli, nil)
 	cli.EXPECT().Recv().Return(nil, io.EOF).AnyTimes()
 	cli.EXPECT().Context().Return(context.TODO()).AnyTimes()
-	stream, err = NewWriteStream(context.TODO(), &models.StatefulNode{}, "test", &models.ShardState{}, 1, fct)
+	stream, err = NewWriteStream(context.TODO(), &models.StatefulNode{}, &models.FamilyState{Database: "test", FamilyTime: 1}, fct)
 	assert.NoError(t, err)
 	assert.NotNil(t, stream)
 
@@ -66,6 +68,68 @@ func TestNewWriteStream(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestWriteStream_Close(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		closeTimeout = 5 * time.Second
+		ctrl.Finish()
+	}()
+
+	cli := protoWriteV1.NewMockWriteService_WriteClient(ctrl)
+	newStream := func() *writeStream {
+		return &writeStream{
+			cancel:   func() {},
+			cli:      cli,
+			target:   &models.StatefulNode{},
+			closed:   atomic.NewBool(false),
+			recvDone: make(chan struct{}),
+			logger:   logger.GetLogger("RPC", "WriteStream"),
+		}
+	}
+	// case 1: close send failure
+	stream := newStream()
+	cli.EXPECT().CloseSend().Return(fmt.Errorf("err"))
+	assert.Error(t, stream.Close())
+	// case 2: wait in-flight data responded
+	stream = newStream()
+	var acks []error
+	cli.EXPECT().Send(gomock.Any()).Return(nil)
+	assert.NoError(t, stream.SendWithAck([]byte{1}, func(err error) {
+		acks = append(acks, err)
+	}))
+	cli.EXPECT().CloseSend().Return(nil)
+	cli.EXPECT().Context().Return(context.TODO()).AnyTimes()
+	cli.EXPECT().Recv().DoAndReturn(func() (*protoWriteV1.WriteResponse, error) {
+		time.Sleep(10 * time.Millisecond)
+		return &protoWriteV1.WriteResponse{}, nil
+	})
+	cli.EXPECT().Recv().Return(nil, io.EOF)
+	go stream.recvLoop()
+	assert.NoError(t, stream.Close())
+	assert.Equal(t, []error{nil}, acks)
+	assert.True(t, stream.closed.Load())
+	// case 3: transport err, not wait timeout
+	stream = newStream()
+	acks = nil
+	cli.EXPECT().Send(gomock.Any()).Return(nil)
+	assert.NoError(t, stream.SendWithAck([]byte{1}, func(err error) {
+		acks = append(acks, err)
+	}))
+	cli.EXPECT().CloseSend().Return(nil)
+	cli.EXPECT().Recv().Return(nil, fmt.Errorf("transport is closing"))
+	go stream.recvLoop()
+	start := time.Now()
+	assert.NoError(t, stream.Close())
+	assert.Less(t, time.Since(start), closeTimeout)
+	assert.Equal(t, []error{constants.ErrWriteStreamClosed}, acks)
+	assert.True(t, stream.closed.Load())
+	// case 4: wait timeout
+	closeTimeout = 10 * time.Millisecond
+	stream = newStream()
+	cli.EXPECT().CloseSend().Return(nil)
+	assert.NoError(t, stream.Close())
+}
+
 func TestWriteStream_Send(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -115,7 +179,62 @@ func TestWriteStream_Recv(t *testing.T) {
 	}
 	cli.EXPECT().Context().Return(context.TODO()).AnyTimes()
 	cli.EXPECT().Recv().Return(nil, fmt.Errorf("err"))
+	stream.recvLoop()
+	assert.True(t, stream.closed.Load())
+	// case 4: response err
+	stream.closed.Store(false)
+	cli.EXPECT().Recv().Return(&protoWriteV1.WriteResponse{Err: "err"}, nil)
+	cli.EXPECT().Recv().Return(nil, io.EOF)
+	stream.recvLoop()
+	assert.True(t, stream.closed.Load())
+}
+
+func TestWriteStream_SendWithAck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cli := protoWriteV1.NewMockWriteService_WriteClient(ctrl)
+	stream := &writeStream{
+		cli:    cli,
+		target: &models.StatefulNode{},
+		closed: atomic.NewBool(false),
+		logger: logger.GetLogger("RPC", "WriteStream"),
+	}
+	var acks []error
+	ack := func(err error) {
+		acks = append(acks, err)
+	}
+	// case 1: send failure, remove pending ack
+	cli.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, stream.SendWithAck([]byte{1}, ack))
+	assert.Empty(t, stream.pendingAcks)
+	// case 2: ack in order
+	cli.EXPECT().Send(gomock.Any()).Return(nil).Times(3)
+	assert.NoError(t, stream.Send([]byte{1}))
+	assert.NoError(t, stream.SendWithAck([]byte{2}, ack))
+	assert.NoError(t, stream.SendWithAck([]byte{3}, ack))
+	assert.Len(t, stream.pendingAcks, 3)
+
+	cli.EXPECT().Context().Return(context.TODO()).AnyTimes()
+	cli.EXPECT().Recv().Return(&protoWriteV1.WriteResponse{}, nil)
+	cli.EXPECT().Recv().Return(&protoWriteV1.WriteResponse{}, nil)
 	cli.EXPECT().Recv().Return(&protoWriteV1.WriteResponse{Err: "err"}, nil)
 	cli.EXPECT().Recv().Return(nil, io.EOF)
 	stream.recvLoop()
+	assert.Len(t, acks, 2)
+	assert.NoError(t, acks[0])
+	assert.ErrorIs(t, acks[1], constants.ErrWriteNotAcknowledged)
+	// no pending ack
+	stream.ack(nil)
+	assert.Len(t, acks, 2)
+
+	// case 3: stream closed, fail pending acks
+	acks = nil
+	stream.closed.Store(false)
+	cli.EXPECT().Send(gomock.Any()).Return(nil)
+	assert.NoError(t, stream.SendWithAck([]byte{1}, ack))
+	cli.EXPECT().Recv().Return(nil, io.EOF)
+	stream.recvLoop()
+	assert.Equal(t, []error{constants.ErrWriteStreamClosed}, acks)
+	assert.Equal(t, io.EOF, stream.SendWithAck([]byte{1}, ack))
 }
@@ -138,7 +138,16 @@ func (t Type) DownSamplingFunc() function.FuncType {
 	}
 }
 
+// IsFuncSupported checks if field type supports the function.
 func (t Type) IsFuncSupported(funcType function.FuncType) bool {
+	switch {
+	case function.IsMathFunc(funcType):
+		// math helper evaluates the default down sampling value of field
+		return t != Unknown && t != HistogramField
+	case function.IsRangeFunc(funcType):
+		// range function evaluates the cumulative value(gauge/counter), sum field stores delta value.
+		return t == LastField || t == FirstField || t == MinField || t == MaxField
+	}
 	switch t {
 	case SumField:
 		switch funcType {
@@ -68,6 +68,19 @@ func TestIsSupportFunc(t *testing.T) {
 	assert.False(t, MinField.IsFuncSupported(function.Quantile))
 
 	assert.False(t, Unknown.IsFuncSupported(function.Quantile))
+
+	// math helper
+	assert.True(t, SumField.IsFuncSupported(function.Abs))
+	assert.True(t, LastField.IsFuncSupported(function.Clamp))
+	assert.False(t, HistogramField.IsFuncSupported(function.Round))
+	assert.False(t, Unknown.IsFuncSupported(function.Ceil))
+	// range function
+	assert.True(t, LastField.IsFuncSupported(function.Increase))
+	assert.True(t, FirstField.IsFuncSupported(function.Irate))
+	assert.True(t, MinField.IsFuncSupported(function.Delta))
+	assert.True(t, MaxField.IsFuncSupported(function.Deriv))
+	assert.False(t, SumField.IsFuncSupported(function.Increase))
+	assert.False(t, HistogramField.IsFuncSupported(function.Delta))
 }
 
 func TestAggType_Aggregate(t *testing.T) {
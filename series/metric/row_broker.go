@@ -23,12 +23,12 @@ import (
 	"sync"
 
 	flatbuffers "github.com/google/flatbuffers/go"
-	jump "github.com/lithammer/go-jump-consistent-hash"
 
 	"github.com/lindb/common/pkg/encoding"
 	"github.com/lindb/common/pkg/fasttime"
 	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
 
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
 
@@ -53,6 +53,17 @@ func (row *BrokerRow) FromBlock(block []byte) {
 
 func (row *BrokerRow) Metric() flatMetricsV1.Metric { return row.m }
 
+// TagValue returns the tag value by tag key, returns nil if tag key not exist.
+func (row *BrokerRow) TagValue(tagKey string) []byte {
+	var kv flatMetricsV1.KeyValue
+	for i := 0; i < row.m.KeyValuesLength(); i++ {
+		if row.m.KeyValues(&kv, i) && string(kv.Key()) == tagKey {
+			return kv.Value()
+		}
+	}
+	return nil
+}
+
 func (row *BrokerRow) Size() int {
 	if row.IsOutOfTimeRange {
 		return 0
@@ -134,9 +145,29 @@ func (br *BrokerBatchRows) TryAppend(appendFunc func(row *BrokerRow) error) erro
 	return nil
 }
 
-func (br *BrokerBatchRows) NewShardGroupIterator(numOfShards int32) *BrokerBatchShardIterator {
+// NewShardGroupIterator groups rows by shard index which is calculated by the routing of database,
+// the hash of row is series hash, or the hash of tag values if series are routed by tag keys.
+// If routings is not empty, the number of shards is picked by the routing which takes effect at the timestamp of row,
+// else uses numOfShards.
+func (br *BrokerBatchRows) NewShardGroupIterator(
+	numOfShards int32,
+	routings models.ShardRoutings,
+	routing *models.Routing,
+) *BrokerBatchShardIterator {
+	routeByTagKeys := routing.IsTagKeys()
 	for i := 0; i < br.Len(); i++ {
-		br.rows[i].shardIdx = int(jump.Hash(br.rows[i].m.Hash(), numOfShards))
+		row := &br.rows[i]
+		n := numOfShards
+		if len(routings) > 0 {
+			n = int32(routings.NumOfShardAt(row.m.Timestamp()))
+		}
+		hash := row.m.Hash()
+		if routeByTagKeys {
+			if tagValuesHash, ok := routing.TagValuesHash(row.TagValue); ok {
+				hash = tagValuesHash
+			}
+		}
+		row.shardIdx = int(routing.ShardOf(hash, n))
 	}
 	br.shardGroupIterator.batch = br
 	br.shardGroupIterator.Reset()
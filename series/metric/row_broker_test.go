@@ -29,6 +29,7 @@ import (
 	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
 	commonseries "github.com/lindb/common/series"
 
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
 
@@ -54,7 +55,7 @@ func assertBrokerBatchRows(t *testing.T, brokerRows *BrokerBatchRows) {
 	assert.Equal(t, 1000, brokerRows.Len())
 
 	// only one shard
-	itr := brokerRows.NewShardGroupIterator(1)
+	itr := brokerRows.NewShardGroupIterator(1, nil, nil)
 	assert.True(t, itr.HasRowsForNextShard())
 	var interval timeutil.Interval
 	_ = interval.ValueOf("10s")
@@ -73,7 +74,7 @@ func assertBrokerBatchRows(t *testing.T, brokerRows *BrokerBatchRows) {
 	assert.Len(t, allRows, 1000)
 	assert.False(t, itr.HasRowsForNextShard())
 
-	itr = brokerRows.NewShardGroupIterator(10)
+	itr = brokerRows.NewShardGroupIterator(10, nil, nil)
 	for i := 0; i < 10; i++ {
 		assert.True(t, itr.HasRowsForNextShard())
 		shardIdx, familyItr = itr.FamilyRowsForNextShard(interval)
@@ -90,6 +91,97 @@ func assertBrokerBatchRows(t *testing.T, brokerRows *BrokerBatchRows) {
 		brokerRows.EvictOutOfTimeRange(100, 100), 100)
 }
 
+func Test_BrokerBatchRows_ShardRoutings(t *testing.T) {
+	brokerRows := NewBrokerBatchRows()
+	defer brokerRows.Release()
+
+	now := fasttime.UnixMilliseconds()
+	cutOver := now - 500*1000*60
+	for i := 0; i < 1000; i++ {
+		i := i
+		assert.NoError(t, brokerRows.TryAppend(func(row *BrokerRow) error {
+			buildRow(row, now-int64(i)*1000*60)
+			return nil
+		}))
+	}
+	var interval timeutil.Interval
+	_ = interval.ValueOf("10s")
+	// reduce shards from 10 to 1 at cut-over time
+	itr := brokerRows.NewShardGroupIterator(10, models.ShardRoutings{
+		{NumOfShard: 10},
+		{NumOfShard: 1, CutOverTime: cutOver},
+	}, nil)
+	count := 0
+	for itr.HasRowsForNextShard() {
+		shardIdx, familyItr := itr.FamilyRowsForNextShard(interval)
+		for familyItr.HasNextFamily() {
+			_, rows := familyItr.NextFamily()
+			for idx := range rows {
+				count++
+				if shardIdx != 0 {
+					m := rows[idx].Metric()
+					assert.Less(t, m.Timestamp(), cutOver)
+				}
+			}
+		}
+	}
+	assert.Equal(t, 1000, count)
+}
+
+func Test_BrokerBatchRows_TagKeysRouting(t *testing.T) {
+	brokerRows := NewBrokerBatchRows()
+	defer brokerRows.Release()
+
+	now := fasttime.UnixMilliseconds()
+	for i := 0; i < 100; i++ {
+		i := i
+		assert.NoError(t, brokerRows.TryAppend(func(row *BrokerRow) error {
+			builder, releaseFunc := commonseries.NewRowBuilder()
+			defer releaseFunc(builder)
+			builder.AddMetricName([]byte("test"))
+			_ = builder.AddTag([]byte("tenant"), []byte("t"+strconv.Itoa(i%3)))
+			_ = builder.AddTag([]byte("host"), []byte(strconv.Itoa(i)))
+			_ = builder.AddSimpleField([]byte("f1"), flatMetricsV1.SimpleFieldTypeDeltaSum, 100)
+			builder.AddTimestamp(now)
+			data, err := builder.Build()
+			if err != nil {
+				return err
+			}
+			row.FromBlock(data)
+			return nil
+		}))
+	}
+	rows := brokerRows.Rows()
+	assert.Equal(t, []byte("t0"), rows[0].TagValue("tenant"))
+	assert.Nil(t, rows[0].TagValue("app"))
+
+	var interval timeutil.Interval
+	_ = interval.ValueOf("10s")
+	itr := brokerRows.NewShardGroupIterator(10, nil, &models.Routing{
+		Method:  models.TagKeysRouting,
+		TagKeys: []string{"tenant"},
+	})
+	// all series of one tenant are colocated in one shard
+	tenants := make(map[string]int)
+	count := 0
+	for itr.HasRowsForNextShard() {
+		shardIdx, familyItr := itr.FamilyRowsForNextShard(interval)
+		for familyItr.HasNextFamily() {
+			_, familyRows := familyItr.NextFamily()
+			for idx := range familyRows {
+				count++
+				tenant := string(familyRows[idx].TagValue("tenant"))
+				if s, ok := tenants[tenant]; ok {
+					assert.Equal(t, s, shardIdx)
+				}
+				tenants[tenant] = shardIdx
+			}
+		}
+	}
+	assert.Equal(t, 100, count)
+	assert.Len(t, tenants, 3)
+}
+
 func buildRow(row *BrokerRow, timestamp int64) {
 	builder, releaseFunc := commonseries.NewRowBuilder()
 	defer releaseFunc(builder)
@@ -159,7 +251,7 @@ func Test_BrokerBatchRows_FamilyRowsForNextShard_SingleShard(t *testing.T) {
 	_ = interval.ValueOf("10s")
 
 	// one shard
-	itr := brokerRows.NewShardGroupIterator(1)
+	itr := brokerRows.NewShardGroupIterator(1, nil, nil)
 	assert.True(t, itr.HasRowsForNextShard())
 
 	shardIdx, familyItr := itr.FamilyRowsForNextShard(interval)
@@ -196,7 +288,7 @@ func Test_BrokerBatchRows_FamilyRowsForNextShard_SameFamily(t *testing.T) {
 			return nil
 		})
 	}
-	itr := brokerRows.NewShardGroupIterator(1)
+	itr := brokerRows.NewShardGroupIterator(1, nil, nil)
 	var interval timeutil.Interval
 	_ = interval.ValueOf("10s")
 	assert.True(t, itr.HasRowsForNextShard())
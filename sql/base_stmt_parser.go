@@ -22,6 +22,7 @@ import (
 
 	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/sql/grammar"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -34,6 +35,9 @@ type baseStmtParser struct {
 	exprStack *collections.Stack
 	condition stmt.Expr
 
+	startTime int64
+	endTime   int64
+
 	limit int
 
 	err error
@@ -184,3 +188,86 @@ func (b *baseStmtParser) setExprParam(param stmt.Expr) {
 	default:
 	}
 }
+
+// visitTimeRangeExpr visits when production timeRange expression is entered.
+func (b *baseStmtParser) visitTimeRangeExpr(ctx *grammar.TimeRangeExprContext) {
+	timeExprCtxList := ctx.AllTimeExpr()
+	for _, timeExpr := range timeExprCtxList {
+		timeExprCtx, ok := timeExpr.(*grammar.TimeExprContext)
+		if !ok {
+			continue
+		}
+		var timestamp int64
+		var err error
+		switch {
+		case timeExprCtx.Ident() != nil:
+			timestamp, err = timeutil.ParseTimestamp(strutil.GetStringValue(timeExprCtx.Ident().GetText()))
+		case timeExprCtx.NowExpr() != nil:
+			timestamp = timeutil.Now()
+			durationExpr, durationExist := timeExprCtx.NowExpr().(*grammar.NowExprContext)
+			if durationExist {
+				timestamp += b.parseDuration(durationExpr.DurationLit())
+			}
+		}
+		if err != nil {
+			b.err = err
+			continue
+		}
+		binaryOp := timeExprCtx.BinaryOperator()
+		if binaryOp == nil {
+			continue
+		}
+		binaryOpCtx, ok := binaryOp.(*grammar.BinaryOperatorContext)
+		if !ok {
+			continue
+		}
+		if binaryOpCtx.T_GREATER() != nil || binaryOpCtx.T_GREATEREQUAL() != nil {
+			b.startTime = timestamp
+		}
+		if binaryOpCtx.T_LESS() != nil || binaryOpCtx.T_LESSEQUAL() != nil {
+			b.endTime = timestamp
+		}
+	}
+}
+
+// parseDuration parses time duration from duration string
+func (b *baseStmtParser) parseDuration(ctx grammar.IDurationLitContext) int64 {
+	if ctx == nil {
+		return 0
+	}
+	durationCtx, ok := ctx.(*grammar.DurationLitContext)
+	if !ok {
+		return 0
+	}
+
+	duration, err := strconv.ParseInt(durationCtx.IntNumber().GetText(), 10, 64)
+	if err != nil {
+		b.err = err
+		return 0
+	}
+	var result int64
+	if durationCtx.IntervalItem() == nil {
+		return result
+	}
+	unit, ok := durationCtx.IntervalItem().(*grammar.IntervalItemContext)
+	if !ok {
+		return result
+	}
+	switch {
+	case unit.T_SECOND() != nil:
+		result = duration * timeutil.OneSecond
+	case unit.T_MINUTE() != nil:
+		result = duration * timeutil.OneMinute
+	case unit.T_HOUR() != nil:
+		result = duration * timeutil.OneHour
+	case unit.T_DAY() != nil:
+		result = duration * timeutil.OneDay
+	case unit.T_WEEK() != nil:
+		result = duration * timeutil.OneWeek
+	case unit.T_MONTH() != nil:
+		result = duration * timeutil.OneMonth
+	case unit.T_YEAR() != nil:
+		result = duration * timeutil.OneYear
+	}
+	return result
+}
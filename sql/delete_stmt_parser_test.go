@@ -0,0 +1,78 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestDeleteSeriesStatement(t *testing.T) {
+	q, err := Parse("delete from cpu on ns where host='1.1.1.1' and time>'20190410 00:00:00' and time<'20190410 10:00:00'")
+	assert.NoError(t, err)
+	deleteStmt := q.(*stmt.Delete)
+	assert.Equal(t, stmt.DeleteSeries, deleteStmt.Type)
+	assert.Equal(t, "ns", deleteStmt.Namespace)
+	assert.Equal(t, "cpu", deleteStmt.MetricName)
+	assert.Equal(t, &stmt.EqualsExpr{Key: "host", Value: "1.1.1.1"}, deleteStmt.Condition)
+	startTime, _ := timeutil.ParseTimestamp("20190410 00:00:00")
+	endTime, _ := timeutil.ParseTimestamp("20190410 10:00:00")
+	assert.Equal(t, timeutil.TimeRange{Start: startTime, End: endTime}, deleteStmt.TimeRange)
+
+	q, err = Parse("delete from cpu where host in ('1.1.1.1','1.1.1.2') or region='sh'")
+	assert.NoError(t, err)
+	deleteStmt = q.(*stmt.Delete)
+	assert.Equal(t, "default-ns", deleteStmt.Namespace)
+	assert.Equal(t, int64(0), deleteStmt.TimeRange.Start)
+	// end time is the time of deletion created
+	assert.Equal(t, int64(0), deleteStmt.TimeRange.End)
+	assert.NotNil(t, deleteStmt.Condition)
+}
+
+func TestDeleteSeriesStatement_Fail(t *testing.T) {
+	// need tag filter condition
+	_, err := Parse("delete from cpu where time>now()-1h")
+	assert.Error(t, err)
+	// need where clause
+	_, err = Parse("delete from cpu")
+	assert.Error(t, err)
+	_, err = Parse("delete from cpu where host='1.1.1.1' and time>now()-1h and time<now()-2h")
+	assert.Error(t, err)
+	_, err = (&deleteStmtParser{}).build()
+	assert.Error(t, err)
+}
+
+func TestDropMetricStatement(t *testing.T) {
+	q, err := Parse("drop metric cpu on ns")
+	assert.NoError(t, err)
+	deleteStmt := q.(*stmt.Delete)
+	assert.Equal(t, stmt.DropMetric, deleteStmt.Type)
+	assert.Equal(t, "ns", deleteStmt.Namespace)
+	assert.Equal(t, "cpu", deleteStmt.MetricName)
+	assert.Nil(t, deleteStmt.Condition)
+
+	q, err = Parse("drop metric 'system.cpu'")
+	assert.NoError(t, err)
+	deleteStmt = q.(*stmt.Delete)
+	assert.Equal(t, "default-ns", deleteStmt.Namespace)
+	assert.Equal(t, "system.cpu", deleteStmt.MetricName)
+}
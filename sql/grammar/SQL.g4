@@ -6,11 +6,23 @@ statement               : showStmt
                         | createStorageStmt
                         | createBrokerStmt
                         | recoverStorageStmt
+                        | decommissionStorageNodeStmt
+                        | transferLeaderStmt
                         | useStmt
                         | queryStmt
                         | createDatabaseStmt
                         | dropDatabaseStmt
+                        | dropMetricStmt
+                        | deleteStmt
 						| setLimitStmt
+                        | createUserStmt
+                        | dropUserStmt
+                        | dropRoleStmt
+                        | grantStmt
+                        | revokeStmt
+                        | grantRoleStmt
+                        | revokeRoleStmt
+                        | killQueryStmt
                         | ident // just for suggest filtering.
                         EOF ;
 
@@ -32,6 +44,8 @@ showStmt                : showMasterStmt
                         | showStorageMetricStmt
                         | showReplicationStmt
                         | showMemoryDatabaseStmt
+                        | showRebalanceStmt
+                        | showReplicaConsistencyStmt
                         | showSchemasStmt
                         | showDatabaseStmt
                         | showNameSpacesStmt
@@ -41,11 +55,14 @@ showStmt                : showMasterStmt
                         | showTagValuesStmt
 						| showRequestsStmt
 						| showRequestStmt
+                        | showUsersStmt
+                        | showRolesStmt
                         ;
 //meta data query statement
 showMasterStmt       : T_SHOW T_MASTER ;
 showRequestsStmt     : T_SHOW T_REQUESTS ; 
 showRequestStmt      : T_SHOW T_REQUEST T_WHERE T_ID T_EQUAL requestID;
+killQueryStmt        : T_KILL T_QUERY requestID ;
 showStoragesStmt     : T_SHOW T_STORAGES ;
 showBrokersStmt      : T_SHOW T_BROKERS ;
 showLimitStmt        : T_SHOW T_LIMIT ; 
@@ -57,16 +74,32 @@ showStorageMetaStmt  : T_SHOW T_STORAGE T_METADATA T_FROM source T_WHERE (storag
 showAliveStmt        : T_SHOW (T_ROOT | T_BROKER | T_STORAGE) T_ALIVE;
 showReplicationStmt  : T_SHOW T_REPLICATION T_WHERE (storageFilter|databaseFilter) T_AND (storageFilter|databaseFilter);
 showMemoryDatabaseStmt  : T_SHOW T_MEMORY T_DATASBAE T_WHERE (storageFilter|databaseFilter) T_AND (storageFilter|databaseFilter);
+showRebalanceStmt    : T_SHOW T_REBALANCE ;
+showReplicaConsistencyStmt : T_SHOW T_REPLICA T_CONSISTENCY T_WHERE (storageFilter|databaseFilter) T_AND (storageFilter|databaseFilter);
 showRootMetricStmt   : T_SHOW T_ROOT T_METRIC T_WHERE metricListFilter ;
 showBrokerMetricStmt : T_SHOW T_BROKER T_METRIC T_WHERE metricListFilter ;
 showStorageMetricStmt: T_SHOW T_STORAGE T_METRIC T_WHERE (storageFilter|metricListFilter) T_AND (storageFilter|metricListFilter) ;
 createStorageStmt    : T_CREATE T_STORAGE json;
 createBrokerStmt     : T_CREATE T_BROKER json;
 recoverStorageStmt   : T_RECOVER T_STORAGE storageName;
+decommissionStorageNodeStmt : T_DECOMMISSION T_STORAGE T_NODE nodeID (T_WHERE storageFilter)?;
+transferLeaderStmt   : T_TRANSFER T_LEADER T_ON databaseName T_SHARD shardID (T_TO T_NODE nodeID)?;
 showSchemasStmt      : T_SHOW T_SCHEMAS ;
 createDatabaseStmt   : T_CREATE T_DATASBAE json;
 dropDatabaseStmt     : T_DROP T_DATASBAE databaseName;
+dropMetricStmt       : T_DROP T_METRIC metricName (T_ON namespace)? ;
+deleteStmt           : T_DELETE fromClause whereClause ;
 showDatabaseStmt     : T_SHOW T_DATASBAES ;
+showUsersStmt        : T_SHOW T_USERS ;
+showRolesStmt        : T_SHOW T_ROLES ;
+createUserStmt       : T_CREATE T_USER userName T_WITH T_PASSWORD password ;
+dropUserStmt         : T_DROP T_USER userName ;
+dropRoleStmt         : T_DROP T_ROLE roleName ;
+grantStmt            : T_GRANT privilege T_ON T_DATASBAE databaseName T_TO roleName ;
+revokeStmt           : T_REVOKE privilege T_ON T_DATASBAE databaseName T_FROM roleName ;
+grantRoleStmt        : T_GRANT T_ROLE roleName T_TO T_USER userName ;
+revokeRoleStmt       : T_REVOKE T_ROLE roleName T_FROM T_USER userName ;
+privilege            : T_READ | T_WRITE | T_ADMIN ;
 showNameSpacesStmt   : T_SHOW T_NAMESPACES (T_WHERE T_NAMESPACE T_EQUAL prefix)? limitClause?;
 showMetricsStmt      : T_SHOW T_METRICS (T_ON namespace)? (T_WHERE T_METRIC T_EQUAL prefix)? limitClause?;
 showFieldsStmt       : T_SHOW T_FIELDS fromClause;
@@ -77,12 +110,17 @@ withTagKey           : ident ;
 namespace            : ident ;
 databaseName         : ident ;
 storageName          : ident ;
+userName             : ident ;
+roleName             : ident ;
+password             : ident ;
+nodeID               : L_INT ;
+shardID              : L_INT ;
 requestID            : ident ;
 source               : (T_STATE_MACHINE|T_STATE_REPO) ;
 
 //data query plan
 queryStmt               : T_EXPLAIN? sourceAndSelect whereClause? groupByClause? orderByClause? limitClause? T_WITH_VALUE?;
-sourceAndSelect         : selectExpr fromClause | fromClause selectExpr ;
+sourceAndSelect         : selectExpr queryFromClause | queryFromClause selectExpr ;
 selectExpr              : T_SELECT fields;
 //select fields
 fields                  : field ( T_COMMA field )* ;
@@ -95,6 +133,10 @@ typeFilter              : T_TYPE T_EQUAL ident  ;
 
 //from clause
 fromClause              : T_FROM metricName (T_ON namespace)? ;
+queryFromClause         : T_FROM (metricSource (T_COMMA metricSource)* (T_ON namespace)? | subQuery) ;
+subQuery                : T_OPEN_P queryStmt T_CLOSE_P ;
+metricSource            : metricName metricAlias? ;
+metricAlias             : T_AS ident ;
 
 //where clause
 whereClause             : T_WHERE conditionExpr;
@@ -119,10 +161,11 @@ nowExpr                 : nowFunc  durationLit? ;
 nowFunc                 : T_NOW T_OPEN_P exprFuncParams? T_CLOSE_P ;
 
 //group by
-groupByClause          : T_GROUP T_BY groupByKeys (T_FILL T_OPEN_P fillOption T_CLOSE_P)? havingClause? ;
+groupByClause          : T_GROUP T_BY groupByKeys (T_FILL T_OPEN_P fillOption T_CLOSE_P)? havingClause? othersClause? ;
 groupByKeys            : groupByKey (T_COMMA groupByKey)* ;
 groupByKey             : ident | T_TIME T_OPEN_P durationLit T_CLOSE_P ;
 fillOption             : T_NULL | T_PREVIOUS | L_INT | L_DEC ;
+othersClause           : T_OTHERS T_AS ident ;
 
 orderByClause          : T_ORDER T_BY sortFields ;
 sortField               : fieldExpr ( T_ASC | T_DESC )* ;
@@ -170,7 +213,9 @@ intervalItem            :
                          | T_YEAR
                          ;
 exprFunc                : funcName T_OPEN_P exprFuncParams? T_CLOSE_P ;
-funcName                : T_SUM | T_MIN | T_MAX | T_AVG | T_COUNT | T_LAST | T_FIRST | T_STDDEV | T_QUANTILE | T_RATE;
+funcName                : T_SUM | T_MIN | T_MAX | T_AVG | T_COUNT | T_LAST | T_FIRST | T_STDDEV | T_QUANTILE | T_RATE
+                         | T_INCREASE | T_DELTA | T_IRATE | T_DERIV | T_ABS | T_CEIL | T_FLOOR | T_ROUND | T_CLAMP
+                         | T_TOPK | T_BOTTOMK;
 exprFuncParams          : funcParam (T_COMMA funcParam)* ;
 funcParam               :
                            fieldExpr
@@ -229,11 +274,29 @@ nonReservedWords      :
                         | T_UPDATE
                         | T_SET
                         | T_DROP
+                        | T_DELETE
                         | T_INTERVAL
                         | T_INTERVAL_NAME
                         | T_SHARD
                         | T_REPLICATION
                         | T_MEMORY
+                        | T_REBALANCE
+                        | T_REPLICA
+                        | T_CONSISTENCY
+                        | T_DECOMMISSION
+                        | T_TRANSFER
+                        | T_LEADER
+                        | T_TO
+                        | T_USER
+                        | T_USERS
+                        | T_ROLE
+                        | T_ROLES
+                        | T_PASSWORD
+                        | T_GRANT
+                        | T_REVOKE
+                        | T_READ
+                        | T_WRITE
+                        | T_ADMIN
                         | T_TTL
                         | T_META_TTL
                         | T_PAST_TTL
@@ -298,6 +361,18 @@ nonReservedWords      :
                         | T_STDDEV
                         | T_QUANTILE
                         | T_RATE
+                        | T_INCREASE
+                        | T_DELTA
+                        | T_IRATE
+                        | T_DERIV
+                        | T_ABS
+                        | T_CEIL
+                        | T_FLOOR
+                        | T_ROUND
+                        | T_CLAMP
+                        | T_TOPK
+                        | T_BOTTOMK
+                        | T_OTHERS
                         | T_SECOND
                         | T_MINUTE
                         | T_HOUR
@@ -357,11 +432,15 @@ T_CREATE             : C R E A T E                      ;
 T_UPDATE             : U P D A T E                      ;
 T_SET                : S E T                            ;
 T_DROP               : D R O P                          ;
+T_DELETE             : D E L E T E                      ;
 T_INTERVAL           : I N T E R V A L                  ;
 T_INTERVAL_NAME      : N A M E                          ;
 T_SHARD              : S H A R D                        ;
 T_REPLICATION        : R E P L I C A T I O N            ;
 T_MEMORY             : M E M O R Y                      ;
+T_REBALANCE          : R E B A L A N C E                ;
+T_REPLICA            : R E P L I C A                    ;
+T_CONSISTENCY        : C O N S I S T E N C Y            ;
 T_TTL                : T T L                            ;
 T_META_TTL           : M E T A T T L                    ;
 T_PAST_TTL           : P A S T T T L                    ;
@@ -370,6 +449,20 @@ T_KILL               : K I L L                          ;
 T_ON                 : O N                              ;
 T_SHOW               : S H O W                          ;
 T_RECOVER            : R E C O V E R                    ;
+T_DECOMMISSION       : D E C O M M I S S I O N          ;
+T_TRANSFER           : T R A N S F E R                  ;
+T_LEADER             : L E A D E R                      ;
+T_TO                 : T O                              ;
+T_USERS              : U S E R S                        ;
+T_USER               : U S E R                          ;
+T_ROLES              : R O L E S                        ;
+T_ROLE               : R O L E                          ;
+T_PASSWORD           : P A S S W O R D                  ;
+T_GRANT              : G R A N T                        ;
+T_REVOKE             : R E V O K E                      ;
+T_READ               : R E A D                          ;
+T_WRITE              : W R I T E                        ;
+T_ADMIN              : A D M I N                        ;
 T_USE                : U S E                            ;
 T_STATE_REPO         : S T A T E T_UNDERLINE R E P O    ;
 T_STATE_MACHINE      : S T A T E T_UNDERLINE M A C H I N E;
@@ -446,6 +539,18 @@ T_AVG                : A V G                            ;
 T_STDDEV             : S T D D E V                      ;
 T_QUANTILE           : Q U A N T I L E                  ;
 T_RATE               : R A T E                          ;
+T_INCREASE           : I N C R E A S E                  ;
+T_DELTA              : D E L T A                        ;
+T_IRATE              : I R A T E                        ;
+T_DERIV              : D E R I V                        ;
+T_ABS                : A B S                            ;
+T_CEIL               : C E I L                          ;
+T_FLOOR              : F L O O R                        ;
+T_ROUND              : R O U N D                        ;
+T_CLAMP              : C L A M P                        ;
+T_TOPK               : T O P K                          ;
+T_BOTTOMK            : B O T T O M K                    ;
+T_OTHERS             : O T H E R S                      ;
 
 //time unit
 T_SECOND             : S                                ;
@@ -79,6 +79,12 @@ func (s *BaseSQLListener) EnterShowRequestStmt(ctx *ShowRequestStmtContext) {}
 // ExitShowRequestStmt is called when production showRequestStmt is exited.
 func (s *BaseSQLListener) ExitShowRequestStmt(ctx *ShowRequestStmtContext) {}
 
+// EnterKillQueryStmt is called when production killQueryStmt is entered.
+func (s *BaseSQLListener) EnterKillQueryStmt(ctx *KillQueryStmtContext) {}
+
+// ExitKillQueryStmt is called when production killQueryStmt is exited.
+func (s *BaseSQLListener) ExitKillQueryStmt(ctx *KillQueryStmtContext) {}
+
 // EnterShowStoragesStmt is called when production showStoragesStmt is entered.
 func (s *BaseSQLListener) EnterShowStoragesStmt(ctx *ShowStoragesStmtContext) {}
 
@@ -145,6 +151,18 @@ func (s *BaseSQLListener) EnterShowMemoryDatabaseStmt(ctx *ShowMemoryDatabaseStm
 // ExitShowMemoryDatabaseStmt is called when production showMemoryDatabaseStmt is exited.
 func (s *BaseSQLListener) ExitShowMemoryDatabaseStmt(ctx *ShowMemoryDatabaseStmtContext) {}
 
+// EnterShowRebalanceStmt is called when production showRebalanceStmt is entered.
+func (s *BaseSQLListener) EnterShowRebalanceStmt(ctx *ShowRebalanceStmtContext) {}
+
+// ExitShowRebalanceStmt is called when production showRebalanceStmt is exited.
+func (s *BaseSQLListener) ExitShowRebalanceStmt(ctx *ShowRebalanceStmtContext) {}
+
+// EnterShowReplicaConsistencyStmt is called when production showReplicaConsistencyStmt is entered.
+func (s *BaseSQLListener) EnterShowReplicaConsistencyStmt(ctx *ShowReplicaConsistencyStmtContext) {}
+
+// ExitShowReplicaConsistencyStmt is called when production showReplicaConsistencyStmt is exited.
+func (s *BaseSQLListener) ExitShowReplicaConsistencyStmt(ctx *ShowReplicaConsistencyStmtContext) {}
+
 // EnterShowRootMetricStmt is called when production showRootMetricStmt is entered.
 func (s *BaseSQLListener) EnterShowRootMetricStmt(ctx *ShowRootMetricStmtContext) {}
 
@@ -181,6 +199,18 @@ func (s *BaseSQLListener) EnterRecoverStorageStmt(ctx *RecoverStorageStmtContext
 // ExitRecoverStorageStmt is called when production recoverStorageStmt is exited.
 func (s *BaseSQLListener) ExitRecoverStorageStmt(ctx *RecoverStorageStmtContext) {}
 
+// EnterDecommissionStorageNodeStmt is called when production decommissionStorageNodeStmt is entered.
+func (s *BaseSQLListener) EnterDecommissionStorageNodeStmt(ctx *DecommissionStorageNodeStmtContext) {}
+
+// ExitDecommissionStorageNodeStmt is called when production decommissionStorageNodeStmt is exited.
+func (s *BaseSQLListener) ExitDecommissionStorageNodeStmt(ctx *DecommissionStorageNodeStmtContext) {}
+
+// EnterTransferLeaderStmt is called when production transferLeaderStmt is entered.
+func (s *BaseSQLListener) EnterTransferLeaderStmt(ctx *TransferLeaderStmtContext) {}
+
+// ExitTransferLeaderStmt is called when production transferLeaderStmt is exited.
+func (s *BaseSQLListener) ExitTransferLeaderStmt(ctx *TransferLeaderStmtContext) {}
+
 // EnterShowSchemasStmt is called when production showSchemasStmt is entered.
 func (s *BaseSQLListener) EnterShowSchemasStmt(ctx *ShowSchemasStmtContext) {}
 
@@ -199,12 +229,84 @@ func (s *BaseSQLListener) EnterDropDatabaseStmt(ctx *DropDatabaseStmtContext) {}
 // ExitDropDatabaseStmt is called when production dropDatabaseStmt is exited.
 func (s *BaseSQLListener) ExitDropDatabaseStmt(ctx *DropDatabaseStmtContext) {}
 
+// EnterDropMetricStmt is called when production dropMetricStmt is entered.
+func (s *BaseSQLListener) EnterDropMetricStmt(ctx *DropMetricStmtContext) {}
+
+// ExitDropMetricStmt is called when production dropMetricStmt is exited.
+func (s *BaseSQLListener) ExitDropMetricStmt(ctx *DropMetricStmtContext) {}
+
+// EnterDeleteStmt is called when production deleteStmt is entered.
+func (s *BaseSQLListener) EnterDeleteStmt(ctx *DeleteStmtContext) {}
+
+// ExitDeleteStmt is called when production deleteStmt is exited.
+func (s *BaseSQLListener) ExitDeleteStmt(ctx *DeleteStmtContext) {}
+
 // EnterShowDatabaseStmt is called when production showDatabaseStmt is entered.
 func (s *BaseSQLListener) EnterShowDatabaseStmt(ctx *ShowDatabaseStmtContext) {}
 
 // ExitShowDatabaseStmt is called when production showDatabaseStmt is exited.
 func (s *BaseSQLListener) ExitShowDatabaseStmt(ctx *ShowDatabaseStmtContext) {}
 
+// EnterShowUsersStmt is called when production showUsersStmt is entered.
+func (s *BaseSQLListener) EnterShowUsersStmt(ctx *ShowUsersStmtContext) {}
+
+// ExitShowUsersStmt is called when production showUsersStmt is exited.
+func (s *BaseSQLListener) ExitShowUsersStmt(ctx *ShowUsersStmtContext) {}
+
+// EnterShowRolesStmt is called when production showRolesStmt is entered.
+func (s *BaseSQLListener) EnterShowRolesStmt(ctx *ShowRolesStmtContext) {}
+
+// ExitShowRolesStmt is called when production showRolesStmt is exited.
+func (s *BaseSQLListener) ExitShowRolesStmt(ctx *ShowRolesStmtContext) {}
+
+// EnterCreateUserStmt is called when production createUserStmt is entered.
+func (s *BaseSQLListener) EnterCreateUserStmt(ctx *CreateUserStmtContext) {}
+
+// ExitCreateUserStmt is called when production createUserStmt is exited.
+func (s *BaseSQLListener) ExitCreateUserStmt(ctx *CreateUserStmtContext) {}
+
+// EnterDropUserStmt is called when production dropUserStmt is entered.
+func (s *BaseSQLListener) EnterDropUserStmt(ctx *DropUserStmtContext) {}
+
+// ExitDropUserStmt is called when production dropUserStmt is exited.
+func (s *BaseSQLListener) ExitDropUserStmt(ctx *DropUserStmtContext) {}
+
+// EnterDropRoleStmt is called when production dropRoleStmt is entered.
+func (s *BaseSQLListener) EnterDropRoleStmt(ctx *DropRoleStmtContext) {}
+
+// ExitDropRoleStmt is called when production dropRoleStmt is exited.
+func (s *BaseSQLListener) ExitDropRoleStmt(ctx *DropRoleStmtContext) {}
+
+// EnterGrantStmt is called when production grantStmt is entered.
+func (s *BaseSQLListener) EnterGrantStmt(ctx *GrantStmtContext) {}
+
+// ExitGrantStmt is called when production grantStmt is exited.
+func (s *BaseSQLListener) ExitGrantStmt(ctx *GrantStmtContext) {}
+
+// EnterRevokeStmt is called when production revokeStmt is entered.
+func (s *BaseSQLListener) EnterRevokeStmt(ctx *RevokeStmtContext) {}
+
+// ExitRevokeStmt is called when production revokeStmt is exited.
+func (s *BaseSQLListener) ExitRevokeStmt(ctx *RevokeStmtContext) {}
+
+// EnterGrantRoleStmt is called when production grantRoleStmt is entered.
+func (s *BaseSQLListener) EnterGrantRoleStmt(ctx *GrantRoleStmtContext) {}
+
+// ExitGrantRoleStmt is called when production grantRoleStmt is exited.
+func (s *BaseSQLListener) ExitGrantRoleStmt(ctx *GrantRoleStmtContext) {}
+
+// EnterRevokeRoleStmt is called when production revokeRoleStmt is entered.
+func (s *BaseSQLListener) EnterRevokeRoleStmt(ctx *RevokeRoleStmtContext) {}
+
+// ExitRevokeRoleStmt is called when production revokeRoleStmt is exited.
+func (s *BaseSQLListener) ExitRevokeRoleStmt(ctx *RevokeRoleStmtContext) {}
+
+// EnterPrivilege is called when production privilege is entered.
+func (s *BaseSQLListener) EnterPrivilege(ctx *PrivilegeContext) {}
+
+// ExitPrivilege is called when production privilege is exited.
+func (s *BaseSQLListener) ExitPrivilege(ctx *PrivilegeContext) {}
+
 // EnterShowNameSpacesStmt is called when production showNameSpacesStmt is entered.
 func (s *BaseSQLListener) EnterShowNameSpacesStmt(ctx *ShowNameSpacesStmtContext) {}
 
@@ -265,6 +367,36 @@ func (s *BaseSQLListener) EnterStorageName(ctx *StorageNameContext) {}
 // ExitStorageName is called when production storageName is exited.
 func (s *BaseSQLListener) ExitStorageName(ctx *StorageNameContext) {}
 
+// EnterUserName is called when production userName is entered.
+func (s *BaseSQLListener) EnterUserName(ctx *UserNameContext) {}
+
+// ExitUserName is called when production userName is exited.
+func (s *BaseSQLListener) ExitUserName(ctx *UserNameContext) {}
+
+// EnterRoleName is called when production roleName is entered.
+func (s *BaseSQLListener) EnterRoleName(ctx *RoleNameContext) {}
+
+// ExitRoleName is called when production roleName is exited.
+func (s *BaseSQLListener) ExitRoleName(ctx *RoleNameContext) {}
+
+// EnterPassword is called when production password is entered.
+func (s *BaseSQLListener) EnterPassword(ctx *PasswordContext) {}
+
+// ExitPassword is called when production password is exited.
+func (s *BaseSQLListener) ExitPassword(ctx *PasswordContext) {}
+
+// EnterNodeID is called when production nodeID is entered.
+func (s *BaseSQLListener) EnterNodeID(ctx *NodeIDContext) {}
+
+// ExitNodeID is called when production nodeID is exited.
+func (s *BaseSQLListener) ExitNodeID(ctx *NodeIDContext) {}
+
+// EnterShardID is called when production shardID is entered.
+func (s *BaseSQLListener) EnterShardID(ctx *ShardIDContext) {}
+
+// ExitShardID is called when production shardID is exited.
+func (s *BaseSQLListener) ExitShardID(ctx *ShardIDContext) {}
+
 // EnterRequestID is called when production requestID is entered.
 func (s *BaseSQLListener) EnterRequestID(ctx *RequestIDContext) {}
 
@@ -343,6 +475,30 @@ func (s *BaseSQLListener) EnterFromClause(ctx *FromClauseContext) {}
 // ExitFromClause is called when production fromClause is exited.
 func (s *BaseSQLListener) ExitFromClause(ctx *FromClauseContext) {}
 
+// EnterQueryFromClause is called when production queryFromClause is entered.
+func (s *BaseSQLListener) EnterQueryFromClause(ctx *QueryFromClauseContext) {}
+
+// ExitQueryFromClause is called when production queryFromClause is exited.
+func (s *BaseSQLListener) ExitQueryFromClause(ctx *QueryFromClauseContext) {}
+
+// EnterSubQuery is called when production subQuery is entered.
+func (s *BaseSQLListener) EnterSubQuery(ctx *SubQueryContext) {}
+
+// ExitSubQuery is called when production subQuery is exited.
+func (s *BaseSQLListener) ExitSubQuery(ctx *SubQueryContext) {}
+
+// EnterMetricSource is called when production metricSource is entered.
+func (s *BaseSQLListener) EnterMetricSource(ctx *MetricSourceContext) {}
+
+// ExitMetricSource is called when production metricSource is exited.
+func (s *BaseSQLListener) ExitMetricSource(ctx *MetricSourceContext) {}
+
+// EnterMetricAlias is called when production metricAlias is entered.
+func (s *BaseSQLListener) EnterMetricAlias(ctx *MetricAliasContext) {}
+
+// ExitMetricAlias is called when production metricAlias is exited.
+func (s *BaseSQLListener) ExitMetricAlias(ctx *MetricAliasContext) {}
+
 // EnterWhereClause is called when production whereClause is entered.
 func (s *BaseSQLListener) EnterWhereClause(ctx *WhereClauseContext) {}
 
@@ -427,6 +583,12 @@ func (s *BaseSQLListener) EnterFillOption(ctx *FillOptionContext) {}
 // ExitFillOption is called when production fillOption is exited.
 func (s *BaseSQLListener) ExitFillOption(ctx *FillOptionContext) {}
 
+// EnterOthersClause is called when production othersClause is entered.
+func (s *BaseSQLListener) EnterOthersClause(ctx *OthersClauseContext) {}
+
+// ExitOthersClause is called when production othersClause is exited.
+func (s *BaseSQLListener) ExitOthersClause(ctx *OthersClauseContext) {}
+
 // EnterOrderByClause is called when production orderByClause is entered.
 func (s *BaseSQLListener) EnterOrderByClause(ctx *OrderByClauseContext) {}
 
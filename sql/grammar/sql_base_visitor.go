@@ -52,6 +52,10 @@ func (v *BaseSQLVisitor) VisitShowRequestStmt(ctx *ShowRequestStmtContext) inter
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitKillQueryStmt(ctx *KillQueryStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowStoragesStmt(ctx *ShowStoragesStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -96,6 +100,14 @@ func (v *BaseSQLVisitor) VisitShowMemoryDatabaseStmt(ctx *ShowMemoryDatabaseStmt
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitShowRebalanceStmt(ctx *ShowRebalanceStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowReplicaConsistencyStmt(ctx *ShowReplicaConsistencyStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowRootMetricStmt(ctx *ShowRootMetricStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -120,6 +132,14 @@ func (v *BaseSQLVisitor) VisitRecoverStorageStmt(ctx *RecoverStorageStmtContext)
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitDecommissionStorageNodeStmt(ctx *DecommissionStorageNodeStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitTransferLeaderStmt(ctx *TransferLeaderStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowSchemasStmt(ctx *ShowSchemasStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -132,10 +152,58 @@ func (v *BaseSQLVisitor) VisitDropDatabaseStmt(ctx *DropDatabaseStmtContext) int
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitDropMetricStmt(ctx *DropMetricStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitDeleteStmt(ctx *DeleteStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowDatabaseStmt(ctx *ShowDatabaseStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitShowUsersStmt(ctx *ShowUsersStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowRolesStmt(ctx *ShowRolesStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitCreateUserStmt(ctx *CreateUserStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitDropUserStmt(ctx *DropUserStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitDropRoleStmt(ctx *DropRoleStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitGrantStmt(ctx *GrantStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitRevokeStmt(ctx *RevokeStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitGrantRoleStmt(ctx *GrantRoleStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitRevokeRoleStmt(ctx *RevokeRoleStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitPrivilege(ctx *PrivilegeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowNameSpacesStmt(ctx *ShowNameSpacesStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -176,6 +244,26 @@ func (v *BaseSQLVisitor) VisitStorageName(ctx *StorageNameContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitUserName(ctx *UserNameContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitRoleName(ctx *RoleNameContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitPassword(ctx *PasswordContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitNodeID(ctx *NodeIDContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShardID(ctx *ShardIDContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitRequestID(ctx *RequestIDContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -228,6 +316,22 @@ func (v *BaseSQLVisitor) VisitFromClause(ctx *FromClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitQueryFromClause(ctx *QueryFromClauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitSubQuery(ctx *SubQueryContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitMetricSource(ctx *MetricSourceContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitMetricAlias(ctx *MetricAliasContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitWhereClause(ctx *WhereClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -284,6 +388,10 @@ func (v *BaseSQLVisitor) VisitFillOption(ctx *FillOptionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitOthersClause(ctx *OthersClauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitOrderByClause(ctx *OrderByClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
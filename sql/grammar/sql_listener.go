@@ -45,6 +45,9 @@ type SQLListener interface {
 	// EnterShowRequestStmt is called when entering the showRequestStmt production.
 	EnterShowRequestStmt(c *ShowRequestStmtContext)
 
+	// EnterKillQueryStmt is called when entering the killQueryStmt production.
+	EnterKillQueryStmt(c *KillQueryStmtContext)
+
 	// EnterShowStoragesStmt is called when entering the showStoragesStmt production.
 	EnterShowStoragesStmt(c *ShowStoragesStmtContext)
 
@@ -78,6 +81,12 @@ type SQLListener interface {
 	// EnterShowMemoryDatabaseStmt is called when entering the showMemoryDatabaseStmt production.
 	EnterShowMemoryDatabaseStmt(c *ShowMemoryDatabaseStmtContext)
 
+	// EnterShowRebalanceStmt is called when entering the showRebalanceStmt production.
+	EnterShowRebalanceStmt(c *ShowRebalanceStmtContext)
+
+	// EnterShowReplicaConsistencyStmt is called when entering the showReplicaConsistencyStmt production.
+	EnterShowReplicaConsistencyStmt(c *ShowReplicaConsistencyStmtContext)
+
 	// EnterShowRootMetricStmt is called when entering the showRootMetricStmt production.
 	EnterShowRootMetricStmt(c *ShowRootMetricStmtContext)
 
@@ -96,6 +105,12 @@ type SQLListener interface {
 	// EnterRecoverStorageStmt is called when entering the recoverStorageStmt production.
 	EnterRecoverStorageStmt(c *RecoverStorageStmtContext)
 
+	// EnterDecommissionStorageNodeStmt is called when entering the decommissionStorageNodeStmt production.
+	EnterDecommissionStorageNodeStmt(c *DecommissionStorageNodeStmtContext)
+
+	// EnterTransferLeaderStmt is called when entering the transferLeaderStmt production.
+	EnterTransferLeaderStmt(c *TransferLeaderStmtContext)
+
 	// EnterShowSchemasStmt is called when entering the showSchemasStmt production.
 	EnterShowSchemasStmt(c *ShowSchemasStmtContext)
 
@@ -105,9 +120,45 @@ type SQLListener interface {
 	// EnterDropDatabaseStmt is called when entering the dropDatabaseStmt production.
 	EnterDropDatabaseStmt(c *DropDatabaseStmtContext)
 
+	// EnterDropMetricStmt is called when entering the dropMetricStmt production.
+	EnterDropMetricStmt(c *DropMetricStmtContext)
+
+	// EnterDeleteStmt is called when entering the deleteStmt production.
+	EnterDeleteStmt(c *DeleteStmtContext)
+
 	// EnterShowDatabaseStmt is called when entering the showDatabaseStmt production.
 	EnterShowDatabaseStmt(c *ShowDatabaseStmtContext)
 
+	// EnterShowUsersStmt is called when entering the showUsersStmt production.
+	EnterShowUsersStmt(c *ShowUsersStmtContext)
+
+	// EnterShowRolesStmt is called when entering the showRolesStmt production.
+	EnterShowRolesStmt(c *ShowRolesStmtContext)
+
+	// EnterCreateUserStmt is called when entering the createUserStmt production.
+	EnterCreateUserStmt(c *CreateUserStmtContext)
+
+	// EnterDropUserStmt is called when entering the dropUserStmt production.
+	EnterDropUserStmt(c *DropUserStmtContext)
+
+	// EnterDropRoleStmt is called when entering the dropRoleStmt production.
+	EnterDropRoleStmt(c *DropRoleStmtContext)
+
+	// EnterGrantStmt is called when entering the grantStmt production.
+	EnterGrantStmt(c *GrantStmtContext)
+
+	// EnterRevokeStmt is called when entering the revokeStmt production.
+	EnterRevokeStmt(c *RevokeStmtContext)
+
+	// EnterGrantRoleStmt is called when entering the grantRoleStmt production.
+	EnterGrantRoleStmt(c *GrantRoleStmtContext)
+
+	// EnterRevokeRoleStmt is called when entering the revokeRoleStmt production.
+	EnterRevokeRoleStmt(c *RevokeRoleStmtContext)
+
+	// EnterPrivilege is called when entering the privilege production.
+	EnterPrivilege(c *PrivilegeContext)
+
 	// EnterShowNameSpacesStmt is called when entering the showNameSpacesStmt production.
 	EnterShowNameSpacesStmt(c *ShowNameSpacesStmtContext)
 
@@ -138,6 +189,21 @@ type SQLListener interface {
 	// EnterStorageName is called when entering the storageName production.
 	EnterStorageName(c *StorageNameContext)
 
+	// EnterUserName is called when entering the userName production.
+	EnterUserName(c *UserNameContext)
+
+	// EnterRoleName is called when entering the roleName production.
+	EnterRoleName(c *RoleNameContext)
+
+	// EnterPassword is called when entering the password production.
+	EnterPassword(c *PasswordContext)
+
+	// EnterNodeID is called when entering the nodeID production.
+	EnterNodeID(c *NodeIDContext)
+
+	// EnterShardID is called when entering the shardID production.
+	EnterShardID(c *ShardIDContext)
+
 	// EnterRequestID is called when entering the requestID production.
 	EnterRequestID(c *RequestIDContext)
 
@@ -177,6 +243,18 @@ type SQLListener interface {
 	// EnterFromClause is called when entering the fromClause production.
 	EnterFromClause(c *FromClauseContext)
 
+	// EnterQueryFromClause is called when entering the queryFromClause production.
+	EnterQueryFromClause(c *QueryFromClauseContext)
+
+	// EnterSubQuery is called when entering the subQuery production.
+	EnterSubQuery(c *SubQueryContext)
+
+	// EnterMetricSource is called when entering the metricSource production.
+	EnterMetricSource(c *MetricSourceContext)
+
+	// EnterMetricAlias is called when entering the metricAlias production.
+	EnterMetricAlias(c *MetricAliasContext)
+
 	// EnterWhereClause is called when entering the whereClause production.
 	EnterWhereClause(c *WhereClauseContext)
 
@@ -219,6 +297,9 @@ type SQLListener interface {
 	// EnterFillOption is called when entering the fillOption production.
 	EnterFillOption(c *FillOptionContext)
 
+	// EnterOthersClause is called when entering the othersClause production.
+	EnterOthersClause(c *OthersClauseContext)
+
 	// EnterOrderByClause is called when entering the orderByClause production.
 	EnterOrderByClause(c *OrderByClauseContext)
 
@@ -336,6 +417,9 @@ type SQLListener interface {
 	// ExitShowRequestStmt is called when exiting the showRequestStmt production.
 	ExitShowRequestStmt(c *ShowRequestStmtContext)
 
+	// ExitKillQueryStmt is called when exiting the killQueryStmt production.
+	ExitKillQueryStmt(c *KillQueryStmtContext)
+
 	// ExitShowStoragesStmt is called when exiting the showStoragesStmt production.
 	ExitShowStoragesStmt(c *ShowStoragesStmtContext)
 
@@ -369,6 +453,12 @@ type SQLListener interface {
 	// ExitShowMemoryDatabaseStmt is called when exiting the showMemoryDatabaseStmt production.
 	ExitShowMemoryDatabaseStmt(c *ShowMemoryDatabaseStmtContext)
 
+	// ExitShowRebalanceStmt is called when exiting the showRebalanceStmt production.
+	ExitShowRebalanceStmt(c *ShowRebalanceStmtContext)
+
+	// ExitShowReplicaConsistencyStmt is called when exiting the showReplicaConsistencyStmt production.
+	ExitShowReplicaConsistencyStmt(c *ShowReplicaConsistencyStmtContext)
+
 	// ExitShowRootMetricStmt is called when exiting the showRootMetricStmt production.
 	ExitShowRootMetricStmt(c *ShowRootMetricStmtContext)
 
@@ -387,6 +477,12 @@ type SQLListener interface {
 	// ExitRecoverStorageStmt is called when exiting the recoverStorageStmt production.
 	ExitRecoverStorageStmt(c *RecoverStorageStmtContext)
 
+	// ExitDecommissionStorageNodeStmt is called when exiting the decommissionStorageNodeStmt production.
+	ExitDecommissionStorageNodeStmt(c *DecommissionStorageNodeStmtContext)
+
+	// ExitTransferLeaderStmt is called when exiting the transferLeaderStmt production.
+	ExitTransferLeaderStmt(c *TransferLeaderStmtContext)
+
 	// ExitShowSchemasStmt is called when exiting the showSchemasStmt production.
 	ExitShowSchemasStmt(c *ShowSchemasStmtContext)
 
@@ -396,9 +492,45 @@ type SQLListener interface {
 	// ExitDropDatabaseStmt is called when exiting the dropDatabaseStmt production.
 	ExitDropDatabaseStmt(c *DropDatabaseStmtContext)
 
+	// ExitDropMetricStmt is called when exiting the dropMetricStmt production.
+	ExitDropMetricStmt(c *DropMetricStmtContext)
+
+	// ExitDeleteStmt is called when exiting the deleteStmt production.
+	ExitDeleteStmt(c *DeleteStmtContext)
+
 	// ExitShowDatabaseStmt is called when exiting the showDatabaseStmt production.
 	ExitShowDatabaseStmt(c *ShowDatabaseStmtContext)
 
+	// ExitShowUsersStmt is called when exiting the showUsersStmt production.
+	ExitShowUsersStmt(c *ShowUsersStmtContext)
+
+	// ExitShowRolesStmt is called when exiting the showRolesStmt production.
+	ExitShowRolesStmt(c *ShowRolesStmtContext)
+
+	// ExitCreateUserStmt is called when exiting the createUserStmt production.
+	ExitCreateUserStmt(c *CreateUserStmtContext)
+
+	// ExitDropUserStmt is called when exiting the dropUserStmt production.
+	ExitDropUserStmt(c *DropUserStmtContext)
+
+	// ExitDropRoleStmt is called when exiting the dropRoleStmt production.
+	ExitDropRoleStmt(c *DropRoleStmtContext)
+
+	// ExitGrantStmt is called when exiting the grantStmt production.
+	ExitGrantStmt(c *GrantStmtContext)
+
+	// ExitRevokeStmt is called when exiting the revokeStmt production.
+	ExitRevokeStmt(c *RevokeStmtContext)
+
+	// ExitGrantRoleStmt is called when exiting the grantRoleStmt production.
+	ExitGrantRoleStmt(c *GrantRoleStmtContext)
+
+	// ExitRevokeRoleStmt is called when exiting the revokeRoleStmt production.
+	ExitRevokeRoleStmt(c *RevokeRoleStmtContext)
+
+	// ExitPrivilege is called when exiting the privilege production.
+	ExitPrivilege(c *PrivilegeContext)
+
 	// ExitShowNameSpacesStmt is called when exiting the showNameSpacesStmt production.
 	ExitShowNameSpacesStmt(c *ShowNameSpacesStmtContext)
 
@@ -429,6 +561,21 @@ type SQLListener interface {
 	// ExitStorageName is called when exiting the storageName production.
 	ExitStorageName(c *StorageNameContext)
 
+	// ExitUserName is called when exiting the userName production.
+	ExitUserName(c *UserNameContext)
+
+	// ExitRoleName is called when exiting the roleName production.
+	ExitRoleName(c *RoleNameContext)
+
+	// ExitPassword is called when exiting the password production.
+	ExitPassword(c *PasswordContext)
+
+	// ExitNodeID is called when exiting the nodeID production.
+	ExitNodeID(c *NodeIDContext)
+
+	// ExitShardID is called when exiting the shardID production.
+	ExitShardID(c *ShardIDContext)
+
 	// ExitRequestID is called when exiting the requestID production.
 	ExitRequestID(c *RequestIDContext)
 
@@ -468,6 +615,18 @@ type SQLListener interface {
 	// ExitFromClause is called when exiting the fromClause production.
 	ExitFromClause(c *FromClauseContext)
 
+	// ExitQueryFromClause is called when exiting the queryFromClause production.
+	ExitQueryFromClause(c *QueryFromClauseContext)
+
+	// ExitSubQuery is called when exiting the subQuery production.
+	ExitSubQuery(c *SubQueryContext)
+
+	// ExitMetricSource is called when exiting the metricSource production.
+	ExitMetricSource(c *MetricSourceContext)
+
+	// ExitMetricAlias is called when exiting the metricAlias production.
+	ExitMetricAlias(c *MetricAliasContext)
+
 	// ExitWhereClause is called when exiting the whereClause production.
 	ExitWhereClause(c *WhereClauseContext)
 
@@ -510,6 +669,9 @@ type SQLListener interface {
 	// ExitFillOption is called when exiting the fillOption production.
 	ExitFillOption(c *FillOptionContext)
 
+	// ExitOthersClause is called when exiting the othersClause production.
+	ExitOthersClause(c *OthersClauseContext)
+
 	// ExitOrderByClause is called when exiting the orderByClause production.
 	ExitOrderByClause(c *OrderByClauseContext)
 
@@ -55,15 +55,20 @@ func sqlParserInit() {
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"'m'", "", "", "", "'M'", "", "'.'", "':'", "'='", "'<>'", "'!='", "'>'",
-		"'>='", "'<'", "'<='", "'=~'", "'!~'", "','", "'{'", "'}'", "'['", "']'",
-		"'('", "')'", "'+'", "'-'", "'/'", "'*'", "'%'", "'_'",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "'m'", "", "", "",
+		"'M'", "", "'.'", "':'", "'='", "'<>'", "'!='", "'>'", "'>='", "'<'",
+		"'<='", "'=~'", "'!~'", "','", "'{'", "'}'", "'['", "']'", "'('", "')'",
+		"'+'", "'-'", "'/'", "'*'", "'%'", "'_'",
 	}
 	staticData.symbolicNames = []string{
 		"", "", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP",
-		"T_INTERVAL", "T_INTERVAL_NAME", "T_SHARD", "T_REPLICATION", "T_MEMORY",
-		"T_TTL", "T_META_TTL", "T_PAST_TTL", "T_FUTURE_TTL", "T_KILL", "T_ON",
-		"T_SHOW", "T_RECOVER", "T_USE", "T_STATE_REPO", "T_STATE_MACHINE", "T_MASTER",
+		"T_DELETE", "T_INTERVAL", "T_INTERVAL_NAME", "T_SHARD", "T_REPLICATION",
+		"T_MEMORY", "T_REBALANCE", "T_REPLICA", "T_CONSISTENCY", "T_TTL", "T_META_TTL",
+		"T_PAST_TTL", "T_FUTURE_TTL", "T_KILL", "T_ON", "T_SHOW", "T_RECOVER",
+		"T_DECOMMISSION", "T_TRANSFER", "T_LEADER", "T_TO", "T_USERS", "T_USER",
+		"T_ROLES", "T_ROLE", "T_PASSWORD", "T_GRANT", "T_REVOKE", "T_READ",
+		"T_WRITE", "T_ADMIN", "T_USE", "T_STATE_REPO", "T_STATE_MACHINE", "T_MASTER",
 		"T_METADATA", "T_TYPES", "T_TYPE", "T_STORAGES", "T_STORAGE", "T_BROKER",
 		"T_ROOT", "T_BROKERS", "T_ALIVE", "T_SCHEMAS", "T_DATASBAE", "T_DATASBAES",
 		"T_NAMESPACE", "T_NAMESPACES", "T_NODE", "T_METRICS", "T_METRIC", "T_FIELD",
@@ -74,413 +79,522 @@ func sqlParserInit() {
 		"T_IS", "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME",
 		"T_NOW", "T_IN", "T_LOG", "T_PROFILE", "T_REQUESTS", "T_REQUEST", "T_ID",
 		"T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_LAST", "T_FIRST", "T_AVG",
-		"T_STDDEV", "T_QUANTILE", "T_RATE", "T_SECOND", "T_MINUTE", "T_HOUR",
-		"T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL",
-		"T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER", "T_GREATEREQUAL", "T_LESS",
-		"T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B",
-		"T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB",
-		"T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE", "L_ID", "L_INT", "L_DEC",
+		"T_STDDEV", "T_QUANTILE", "T_RATE", "T_INCREASE", "T_DELTA", "T_IRATE",
+		"T_DERIV", "T_ABS", "T_CEIL", "T_FLOOR", "T_ROUND", "T_CLAMP", "T_TOPK",
+		"T_BOTTOMK", "T_OTHERS", "T_SECOND", "T_MINUTE", "T_HOUR", "T_DAY",
+		"T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL", "T_NOTEQUAL",
+		"T_NOTEQUAL2", "T_GREATER", "T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL",
+		"T_REGEXP", "T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB",
+		"T_CLOSE_SB", "T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL",
+		"T_MOD", "T_UNDERLINE", "L_ID", "L_INT", "L_DEC",
 	}
 	staticData.ruleNames = []string{
 		"statement", "useStmt", "setLimitStmt", "showStmt", "showMasterStmt",
-		"showRequestsStmt", "showRequestStmt", "showStoragesStmt", "showBrokersStmt",
-		"showLimitStmt", "showMetadataTypesStmt", "showRootMetaStmt", "showBrokerMetaStmt",
-		"showMasterMetaStmt", "showStorageMetaStmt", "showAliveStmt", "showReplicationStmt",
-		"showMemoryDatabaseStmt", "showRootMetricStmt", "showBrokerMetricStmt",
+		"showRequestsStmt", "showRequestStmt", "killQueryStmt", "showStoragesStmt",
+		"showBrokersStmt", "showLimitStmt", "showMetadataTypesStmt", "showRootMetaStmt",
+		"showBrokerMetaStmt", "showMasterMetaStmt", "showStorageMetaStmt", "showAliveStmt",
+		"showReplicationStmt", "showMemoryDatabaseStmt", "showRebalanceStmt",
+		"showReplicaConsistencyStmt", "showRootMetricStmt", "showBrokerMetricStmt",
 		"showStorageMetricStmt", "createStorageStmt", "createBrokerStmt", "recoverStorageStmt",
-		"showSchemasStmt", "createDatabaseStmt", "dropDatabaseStmt", "showDatabaseStmt",
-		"showNameSpacesStmt", "showMetricsStmt", "showFieldsStmt", "showTagKeysStmt",
-		"showTagValuesStmt", "prefix", "withTagKey", "namespace", "databaseName",
-		"storageName", "requestID", "source", "queryStmt", "sourceAndSelect",
-		"selectExpr", "fields", "field", "alias", "storageFilter", "brokerFilter",
-		"databaseFilter", "typeFilter", "fromClause", "whereClause", "conditionExpr",
-		"tagFilterExpr", "tagValueList", "metricListFilter", "metricList", "timeRangeExpr",
-		"timeExpr", "nowExpr", "nowFunc", "groupByClause", "groupByKeys", "groupByKey",
-		"fillOption", "orderByClause", "sortField", "sortFields", "havingClause",
-		"boolExpr", "boolExprLogicalOp", "boolExprAtom", "binaryExpr", "binaryOperator",
-		"fieldExpr", "durationLit", "intervalItem", "exprFunc", "funcName",
-		"exprFuncParams", "funcParam", "exprAtom", "identFilter", "json", "toml",
-		"obj", "pair", "arr", "value", "intNumber", "decNumber", "limitClause",
-		"metricName", "tagKey", "tagValue", "ident", "nonReservedWords",
+		"decommissionStorageNodeStmt", "transferLeaderStmt", "showSchemasStmt",
+		"createDatabaseStmt", "dropDatabaseStmt", "dropMetricStmt", "deleteStmt",
+		"showDatabaseStmt", "showUsersStmt", "showRolesStmt", "createUserStmt",
+		"dropUserStmt", "dropRoleStmt", "grantStmt", "revokeStmt", "grantRoleStmt",
+		"revokeRoleStmt", "privilege", "showNameSpacesStmt", "showMetricsStmt",
+		"showFieldsStmt", "showTagKeysStmt", "showTagValuesStmt", "prefix",
+		"withTagKey", "namespace", "databaseName", "storageName", "userName",
+		"roleName", "password", "nodeID", "shardID", "requestID", "source",
+		"queryStmt", "sourceAndSelect", "selectExpr", "fields", "field", "alias",
+		"storageFilter", "brokerFilter", "databaseFilter", "typeFilter", "fromClause",
+		"queryFromClause", "subQuery", "metricSource", "metricAlias", "whereClause",
+		"conditionExpr", "tagFilterExpr", "tagValueList", "metricListFilter",
+		"metricList", "timeRangeExpr", "timeExpr", "nowExpr", "nowFunc", "groupByClause",
+		"groupByKeys", "groupByKey", "fillOption", "othersClause", "orderByClause",
+		"sortField", "sortFields", "havingClause", "boolExpr", "boolExprLogicalOp",
+		"boolExprAtom", "binaryExpr", "binaryOperator", "fieldExpr", "durationLit",
+		"intervalItem", "exprFunc", "funcName", "exprFuncParams", "funcParam",
+		"exprAtom", "identFilter", "json", "toml", "obj", "pair", "arr", "value",
+		"intNumber", "decNumber", "limitClause", "metricName", "tagKey", "tagValue",
+		"ident", "nonReservedWords",
 	}
 	staticData.predictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 130, 853, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
-		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
-		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
-		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
-		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
-		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
-		31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36,
-		2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41, 2,
-		42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2, 47,
-		7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52, 7,
-		52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7, 57,
-		2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 2,
-		63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2, 68,
-		7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73, 7,
-		73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7, 78,
-		2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83, 2,
-		84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2, 89,
-		7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94, 7,
-		94, 2, 95, 7, 95, 2, 96, 7, 96, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
-		0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 3, 0, 207, 8, 0, 1, 1, 1, 1, 1, 1, 1,
-		2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
-		3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
-		3, 1, 3, 1, 3, 1, 3, 3, 3, 240, 8, 3, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1,
-		5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 8, 1,
-		8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1,
-		11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12,
-		1, 12, 1, 12, 1, 12, 1, 12, 3, 12, 285, 8, 12, 1, 13, 1, 13, 1, 13, 1,
-		13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14,
-		1, 14, 1, 14, 3, 14, 303, 8, 14, 1, 14, 1, 14, 1, 14, 3, 14, 308, 8, 14,
-		1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 319,
-		8, 16, 1, 16, 1, 16, 1, 16, 3, 16, 324, 8, 16, 1, 17, 1, 17, 1, 17, 1,
-		17, 1, 17, 1, 17, 3, 17, 332, 8, 17, 1, 17, 1, 17, 1, 17, 3, 17, 337, 8,
-		17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19,
-		1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 3, 20, 357, 8,
-		20, 1, 20, 1, 20, 1, 20, 3, 20, 362, 8, 20, 1, 21, 1, 21, 1, 21, 1, 21,
-		1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1,
-		24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27,
-		1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 3, 28, 396, 8, 28, 1,
-		28, 3, 28, 399, 8, 28, 1, 29, 1, 29, 1, 29, 1, 29, 3, 29, 405, 8, 29, 1,
-		29, 1, 29, 1, 29, 1, 29, 3, 29, 411, 8, 29, 1, 29, 3, 29, 414, 8, 29, 1,
-		30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32,
-		1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 3, 32, 434, 8, 32, 1,
-		32, 3, 32, 437, 8, 32, 1, 33, 1, 33, 1, 34, 1, 34, 1, 35, 1, 35, 1, 36,
-		1, 36, 1, 37, 1, 37, 1, 38, 1, 38, 1, 39, 1, 39, 1, 40, 3, 40, 454, 8,
-		40, 1, 40, 1, 40, 3, 40, 458, 8, 40, 1, 40, 3, 40, 461, 8, 40, 1, 40, 3,
-		40, 464, 8, 40, 1, 40, 3, 40, 467, 8, 40, 1, 40, 3, 40, 470, 8, 40, 1,
-		41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 3, 41, 478, 8, 41, 1, 42, 1, 42,
-		1, 42, 1, 43, 1, 43, 1, 43, 5, 43, 486, 8, 43, 10, 43, 12, 43, 489, 9,
-		43, 1, 44, 1, 44, 3, 44, 493, 8, 44, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46,
-		1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1,
-		49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 50, 3, 50, 518, 8, 50,
-		1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1,
-		52, 3, 52, 531, 8, 52, 3, 52, 533, 8, 52, 1, 53, 1, 53, 1, 53, 1, 53, 1,
-		53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 3, 53,
-		549, 8, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 3, 53, 557, 8, 53,
-		1, 53, 1, 53, 1, 53, 1, 53, 3, 53, 563, 8, 53, 1, 53, 1, 53, 1, 53, 5,
-		53, 568, 8, 53, 10, 53, 12, 53, 571, 9, 53, 1, 54, 1, 54, 1, 54, 5, 54,
-		576, 8, 54, 10, 54, 12, 54, 579, 9, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1,
-		55, 1, 55, 1, 56, 1, 56, 1, 56, 5, 56, 590, 8, 56, 10, 56, 12, 56, 593,
-		9, 56, 1, 57, 1, 57, 1, 57, 3, 57, 598, 8, 57, 1, 58, 1, 58, 1, 58, 1,
-		58, 3, 58, 604, 8, 58, 1, 59, 1, 59, 3, 59, 608, 8, 59, 1, 60, 1, 60, 1,
-		60, 3, 60, 613, 8, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61,
-		1, 61, 1, 61, 1, 61, 3, 61, 625, 8, 61, 1, 61, 3, 61, 628, 8, 61, 1, 62,
-		1, 62, 1, 62, 5, 62, 633, 8, 62, 10, 62, 12, 62, 636, 9, 62, 1, 63, 1,
-		63, 1, 63, 1, 63, 1, 63, 1, 63, 3, 63, 644, 8, 63, 1, 64, 1, 64, 1, 65,
-		1, 65, 1, 65, 1, 65, 1, 66, 1, 66, 5, 66, 654, 8, 66, 10, 66, 12, 66, 657,
-		9, 66, 1, 67, 1, 67, 1, 67, 5, 67, 662, 8, 67, 10, 67, 12, 67, 665, 9,
-		67, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 3, 69,
-		676, 8, 69, 1, 69, 1, 69, 1, 69, 1, 69, 5, 69, 682, 8, 69, 10, 69, 12,
-		69, 685, 9, 69, 1, 70, 1, 70, 1, 71, 1, 71, 1, 72, 1, 72, 1, 72, 1, 72,
-		1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 3, 73, 703, 8,
-		73, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 3, 74, 713,
-		8, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1,
-		74, 1, 74, 1, 74, 5, 74, 727, 8, 74, 10, 74, 12, 74, 730, 9, 74, 1, 75,
-		1, 75, 1, 75, 1, 76, 1, 76, 1, 77, 1, 77, 1, 77, 3, 77, 740, 8, 77, 1,
-		77, 1, 77, 1, 78, 1, 78, 1, 79, 1, 79, 1, 79, 5, 79, 749, 8, 79, 10, 79,
-		12, 79, 752, 9, 79, 1, 80, 1, 80, 3, 80, 756, 8, 80, 1, 81, 1, 81, 3, 81,
-		760, 8, 81, 1, 81, 1, 81, 3, 81, 764, 8, 81, 1, 82, 1, 82, 1, 82, 1, 82,
-		1, 83, 1, 83, 1, 84, 1, 84, 1, 85, 1, 85, 1, 85, 1, 85, 5, 85, 778, 8,
-		85, 10, 85, 12, 85, 781, 9, 85, 1, 85, 1, 85, 1, 85, 1, 85, 3, 85, 787,
-		8, 85, 1, 86, 1, 86, 1, 86, 1, 86, 1, 87, 1, 87, 1, 87, 1, 87, 5, 87, 797,
-		8, 87, 10, 87, 12, 87, 800, 9, 87, 1, 87, 1, 87, 1, 87, 1, 87, 3, 87, 806,
-		8, 87, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 3, 88, 816,
-		8, 88, 1, 89, 3, 89, 819, 8, 89, 1, 89, 1, 89, 1, 90, 3, 90, 824, 8, 90,
-		1, 90, 1, 90, 1, 91, 1, 91, 1, 91, 1, 92, 1, 92, 1, 93, 1, 93, 1, 94, 1,
-		94, 1, 95, 1, 95, 3, 95, 839, 8, 95, 1, 95, 1, 95, 1, 95, 3, 95, 844, 8,
-		95, 5, 95, 846, 8, 95, 10, 95, 12, 95, 849, 9, 95, 1, 96, 1, 96, 1, 96,
-		0, 3, 106, 138, 148, 97, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24,
-		26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60,
-		62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96,
-		98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126,
-		128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148, 150, 152, 154, 156,
-		158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180, 182, 184, 186,
-		188, 190, 192, 0, 10, 1, 0, 31, 33, 1, 0, 24, 25, 1, 0, 62, 63, 2, 0, 65,
-		66, 129, 130, 1, 0, 68, 69, 2, 0, 70, 70, 113, 113, 1, 0, 97, 103, 1, 0,
-		87, 96, 1, 0, 122, 123, 2, 0, 6, 21, 23, 103, 876, 0, 206, 1, 0, 0, 0,
-		2, 208, 1, 0, 0, 0, 4, 211, 1, 0, 0, 0, 6, 239, 1, 0, 0, 0, 8, 241, 1,
-		0, 0, 0, 10, 244, 1, 0, 0, 0, 12, 247, 1, 0, 0, 0, 14, 254, 1, 0, 0, 0,
-		16, 257, 1, 0, 0, 0, 18, 260, 1, 0, 0, 0, 20, 263, 1, 0, 0, 0, 22, 267,
-		1, 0, 0, 0, 24, 275, 1, 0, 0, 0, 26, 286, 1, 0, 0, 0, 28, 294, 1, 0, 0,
-		0, 30, 309, 1, 0, 0, 0, 32, 313, 1, 0, 0, 0, 34, 325, 1, 0, 0, 0, 36, 338,
-		1, 0, 0, 0, 38, 344, 1, 0, 0, 0, 40, 350, 1, 0, 0, 0, 42, 363, 1, 0, 0,
-		0, 44, 367, 1, 0, 0, 0, 46, 371, 1, 0, 0, 0, 48, 375, 1, 0, 0, 0, 50, 378,
-		1, 0, 0, 0, 52, 382, 1, 0, 0, 0, 54, 386, 1, 0, 0, 0, 56, 389, 1, 0, 0,
-		0, 58, 400, 1, 0, 0, 0, 60, 415, 1, 0, 0, 0, 62, 419, 1, 0, 0, 0, 64, 424,
-		1, 0, 0, 0, 66, 438, 1, 0, 0, 0, 68, 440, 1, 0, 0, 0, 70, 442, 1, 0, 0,
-		0, 72, 444, 1, 0, 0, 0, 74, 446, 1, 0, 0, 0, 76, 448, 1, 0, 0, 0, 78, 450,
-		1, 0, 0, 0, 80, 453, 1, 0, 0, 0, 82, 477, 1, 0, 0, 0, 84, 479, 1, 0, 0,
-		0, 86, 482, 1, 0, 0, 0, 88, 490, 1, 0, 0, 0, 90, 494, 1, 0, 0, 0, 92, 497,
-		1, 0, 0, 0, 94, 501, 1, 0, 0, 0, 96, 505, 1, 0, 0, 0, 98, 509, 1, 0, 0,
-		0, 100, 513, 1, 0, 0, 0, 102, 519, 1, 0, 0, 0, 104, 532, 1, 0, 0, 0, 106,
-		562, 1, 0, 0, 0, 108, 572, 1, 0, 0, 0, 110, 580, 1, 0, 0, 0, 112, 586,
-		1, 0, 0, 0, 114, 594, 1, 0, 0, 0, 116, 599, 1, 0, 0, 0, 118, 605, 1, 0,
-		0, 0, 120, 609, 1, 0, 0, 0, 122, 616, 1, 0, 0, 0, 124, 629, 1, 0, 0, 0,
-		126, 643, 1, 0, 0, 0, 128, 645, 1, 0, 0, 0, 130, 647, 1, 0, 0, 0, 132,
-		651, 1, 0, 0, 0, 134, 658, 1, 0, 0, 0, 136, 666, 1, 0, 0, 0, 138, 675,
-		1, 0, 0, 0, 140, 686, 1, 0, 0, 0, 142, 688, 1, 0, 0, 0, 144, 690, 1, 0,
-		0, 0, 146, 702, 1, 0, 0, 0, 148, 712, 1, 0, 0, 0, 150, 731, 1, 0, 0, 0,
-		152, 734, 1, 0, 0, 0, 154, 736, 1, 0, 0, 0, 156, 743, 1, 0, 0, 0, 158,
-		745, 1, 0, 0, 0, 160, 755, 1, 0, 0, 0, 162, 763, 1, 0, 0, 0, 164, 765,
-		1, 0, 0, 0, 166, 769, 1, 0, 0, 0, 168, 771, 1, 0, 0, 0, 170, 786, 1, 0,
-		0, 0, 172, 788, 1, 0, 0, 0, 174, 805, 1, 0, 0, 0, 176, 815, 1, 0, 0, 0,
-		178, 818, 1, 0, 0, 0, 180, 823, 1, 0, 0, 0, 182, 827, 1, 0, 0, 0, 184,
-		830, 1, 0, 0, 0, 186, 832, 1, 0, 0, 0, 188, 834, 1, 0, 0, 0, 190, 838,
-		1, 0, 0, 0, 192, 850, 1, 0, 0, 0, 194, 207, 3, 6, 3, 0, 195, 207, 3, 42,
-		21, 0, 196, 207, 3, 44, 22, 0, 197, 207, 3, 46, 23, 0, 198, 207, 3, 2,
-		1, 0, 199, 207, 3, 80, 40, 0, 200, 207, 3, 50, 25, 0, 201, 207, 3, 52,
-		26, 0, 202, 207, 3, 4, 2, 0, 203, 204, 3, 190, 95, 0, 204, 205, 5, 0, 0,
-		1, 205, 207, 1, 0, 0, 0, 206, 194, 1, 0, 0, 0, 206, 195, 1, 0, 0, 0, 206,
-		196, 1, 0, 0, 0, 206, 197, 1, 0, 0, 0, 206, 198, 1, 0, 0, 0, 206, 199,
-		1, 0, 0, 0, 206, 200, 1, 0, 0, 0, 206, 201, 1, 0, 0, 0, 206, 202, 1, 0,
-		0, 0, 206, 203, 1, 0, 0, 0, 207, 1, 1, 0, 0, 0, 208, 209, 5, 23, 0, 0,
-		209, 210, 3, 190, 95, 0, 210, 3, 1, 0, 0, 0, 211, 212, 5, 8, 0, 0, 212,
-		213, 5, 55, 0, 0, 213, 214, 3, 168, 84, 0, 214, 5, 1, 0, 0, 0, 215, 240,
-		3, 8, 4, 0, 216, 240, 3, 20, 10, 0, 217, 240, 3, 22, 11, 0, 218, 240, 3,
-		24, 12, 0, 219, 240, 3, 26, 13, 0, 220, 240, 3, 28, 14, 0, 221, 240, 3,
-		14, 7, 0, 222, 240, 3, 16, 8, 0, 223, 240, 3, 18, 9, 0, 224, 240, 3, 30,
-		15, 0, 225, 240, 3, 36, 18, 0, 226, 240, 3, 38, 19, 0, 227, 240, 3, 40,
-		20, 0, 228, 240, 3, 32, 16, 0, 229, 240, 3, 34, 17, 0, 230, 240, 3, 48,
-		24, 0, 231, 240, 3, 54, 27, 0, 232, 240, 3, 56, 28, 0, 233, 240, 3, 58,
-		29, 0, 234, 240, 3, 60, 30, 0, 235, 240, 3, 62, 31, 0, 236, 240, 3, 64,
-		32, 0, 237, 240, 3, 10, 5, 0, 238, 240, 3, 12, 6, 0, 239, 215, 1, 0, 0,
-		0, 239, 216, 1, 0, 0, 0, 239, 217, 1, 0, 0, 0, 239, 218, 1, 0, 0, 0, 239,
-		219, 1, 0, 0, 0, 239, 220, 1, 0, 0, 0, 239, 221, 1, 0, 0, 0, 239, 222,
-		1, 0, 0, 0, 239, 223, 1, 0, 0, 0, 239, 224, 1, 0, 0, 0, 239, 225, 1, 0,
-		0, 0, 239, 226, 1, 0, 0, 0, 239, 227, 1, 0, 0, 0, 239, 228, 1, 0, 0, 0,
-		239, 229, 1, 0, 0, 0, 239, 230, 1, 0, 0, 0, 239, 231, 1, 0, 0, 0, 239,
-		232, 1, 0, 0, 0, 239, 233, 1, 0, 0, 0, 239, 234, 1, 0, 0, 0, 239, 235,
-		1, 0, 0, 0, 239, 236, 1, 0, 0, 0, 239, 237, 1, 0, 0, 0, 239, 238, 1, 0,
-		0, 0, 240, 7, 1, 0, 0, 0, 241, 242, 5, 21, 0, 0, 242, 243, 5, 26, 0, 0,
-		243, 9, 1, 0, 0, 0, 244, 245, 5, 21, 0, 0, 245, 246, 5, 84, 0, 0, 246,
-		11, 1, 0, 0, 0, 247, 248, 5, 21, 0, 0, 248, 249, 5, 85, 0, 0, 249, 250,
-		5, 54, 0, 0, 250, 251, 5, 86, 0, 0, 251, 252, 5, 106, 0, 0, 252, 253, 3,
-		76, 38, 0, 253, 13, 1, 0, 0, 0, 254, 255, 5, 21, 0, 0, 255, 256, 5, 30,
-		0, 0, 256, 15, 1, 0, 0, 0, 257, 258, 5, 21, 0, 0, 258, 259, 5, 34, 0, 0,
-		259, 17, 1, 0, 0, 0, 260, 261, 5, 21, 0, 0, 261, 262, 5, 55, 0, 0, 262,
-		19, 1, 0, 0, 0, 263, 264, 5, 21, 0, 0, 264, 265, 5, 27, 0, 0, 265, 266,
-		5, 28, 0, 0, 266, 21, 1, 0, 0, 0, 267, 268, 5, 21, 0, 0, 268, 269, 5, 33,
-		0, 0, 269, 270, 5, 27, 0, 0, 270, 271, 5, 53, 0, 0, 271, 272, 3, 78, 39,
-		0, 272, 273, 5, 54, 0, 0, 273, 274, 3, 98, 49, 0, 274, 23, 1, 0, 0, 0,
-		275, 276, 5, 21, 0, 0, 276, 277, 5, 32, 0, 0, 277, 278, 5, 27, 0, 0, 278,
-		279, 5, 53, 0, 0, 279, 280, 3, 78, 39, 0, 280, 281, 5, 54, 0, 0, 281, 284,
-		3, 98, 49, 0, 282, 283, 5, 62, 0, 0, 283, 285, 3, 94, 47, 0, 284, 282,
-		1, 0, 0, 0, 284, 285, 1, 0, 0, 0, 285, 25, 1, 0, 0, 0, 286, 287, 5, 21,
-		0, 0, 287, 288, 5, 26, 0, 0, 288, 289, 5, 27, 0, 0, 289, 290, 5, 53, 0,
-		0, 290, 291, 3, 78, 39, 0, 291, 292, 5, 54, 0, 0, 292, 293, 3, 98, 49,
-		0, 293, 27, 1, 0, 0, 0, 294, 295, 5, 21, 0, 0, 295, 296, 5, 31, 0, 0, 296,
-		297, 5, 27, 0, 0, 297, 298, 5, 53, 0, 0, 298, 299, 3, 78, 39, 0, 299, 302,
-		5, 54, 0, 0, 300, 303, 3, 92, 46, 0, 301, 303, 3, 98, 49, 0, 302, 300,
-		1, 0, 0, 0, 302, 301, 1, 0, 0, 0, 303, 304, 1, 0, 0, 0, 304, 307, 5, 62,
-		0, 0, 305, 308, 3, 92, 46, 0, 306, 308, 3, 98, 49, 0, 307, 305, 1, 0, 0,
-		0, 307, 306, 1, 0, 0, 0, 308, 29, 1, 0, 0, 0, 309, 310, 5, 21, 0, 0, 310,
-		311, 7, 0, 0, 0, 311, 312, 5, 35, 0, 0, 312, 31, 1, 0, 0, 0, 313, 314,
-		5, 21, 0, 0, 314, 315, 5, 13, 0, 0, 315, 318, 5, 54, 0, 0, 316, 319, 3,
-		92, 46, 0, 317, 319, 3, 96, 48, 0, 318, 316, 1, 0, 0, 0, 318, 317, 1, 0,
-		0, 0, 319, 320, 1, 0, 0, 0, 320, 323, 5, 62, 0, 0, 321, 324, 3, 92, 46,
-		0, 322, 324, 3, 96, 48, 0, 323, 321, 1, 0, 0, 0, 323, 322, 1, 0, 0, 0,
-		324, 33, 1, 0, 0, 0, 325, 326, 5, 21, 0, 0, 326, 327, 5, 14, 0, 0, 327,
-		328, 5, 37, 0, 0, 328, 331, 5, 54, 0, 0, 329, 332, 3, 92, 46, 0, 330, 332,
-		3, 96, 48, 0, 331, 329, 1, 0, 0, 0, 331, 330, 1, 0, 0, 0, 332, 333, 1,
-		0, 0, 0, 333, 336, 5, 62, 0, 0, 334, 337, 3, 92, 46, 0, 335, 337, 3, 96,
-		48, 0, 336, 334, 1, 0, 0, 0, 336, 335, 1, 0, 0, 0, 337, 35, 1, 0, 0, 0,
-		338, 339, 5, 21, 0, 0, 339, 340, 5, 33, 0, 0, 340, 341, 5, 43, 0, 0, 341,
-		342, 5, 54, 0, 0, 342, 343, 3, 110, 55, 0, 343, 37, 1, 0, 0, 0, 344, 345,
-		5, 21, 0, 0, 345, 346, 5, 32, 0, 0, 346, 347, 5, 43, 0, 0, 347, 348, 5,
-		54, 0, 0, 348, 349, 3, 110, 55, 0, 349, 39, 1, 0, 0, 0, 350, 351, 5, 21,
-		0, 0, 351, 352, 5, 31, 0, 0, 352, 353, 5, 43, 0, 0, 353, 356, 5, 54, 0,
-		0, 354, 357, 3, 92, 46, 0, 355, 357, 3, 110, 55, 0, 356, 354, 1, 0, 0,
-		0, 356, 355, 1, 0, 0, 0, 357, 358, 1, 0, 0, 0, 358, 361, 5, 62, 0, 0, 359,
-		362, 3, 92, 46, 0, 360, 362, 3, 110, 55, 0, 361, 359, 1, 0, 0, 0, 361,
-		360, 1, 0, 0, 0, 362, 41, 1, 0, 0, 0, 363, 364, 5, 6, 0, 0, 364, 365, 5,
-		31, 0, 0, 365, 366, 3, 166, 83, 0, 366, 43, 1, 0, 0, 0, 367, 368, 5, 6,
-		0, 0, 368, 369, 5, 32, 0, 0, 369, 370, 3, 166, 83, 0, 370, 45, 1, 0, 0,
-		0, 371, 372, 5, 22, 0, 0, 372, 373, 5, 31, 0, 0, 373, 374, 3, 74, 37, 0,
-		374, 47, 1, 0, 0, 0, 375, 376, 5, 21, 0, 0, 376, 377, 5, 36, 0, 0, 377,
-		49, 1, 0, 0, 0, 378, 379, 5, 6, 0, 0, 379, 380, 5, 37, 0, 0, 380, 381,
-		3, 166, 83, 0, 381, 51, 1, 0, 0, 0, 382, 383, 5, 9, 0, 0, 383, 384, 5,
-		37, 0, 0, 384, 385, 3, 72, 36, 0, 385, 53, 1, 0, 0, 0, 386, 387, 5, 21,
-		0, 0, 387, 388, 5, 38, 0, 0, 388, 55, 1, 0, 0, 0, 389, 390, 5, 21, 0, 0,
-		390, 395, 5, 40, 0, 0, 391, 392, 5, 54, 0, 0, 392, 393, 5, 39, 0, 0, 393,
-		394, 5, 106, 0, 0, 394, 396, 3, 66, 33, 0, 395, 391, 1, 0, 0, 0, 395, 396,
-		1, 0, 0, 0, 396, 398, 1, 0, 0, 0, 397, 399, 3, 182, 91, 0, 398, 397, 1,
-		0, 0, 0, 398, 399, 1, 0, 0, 0, 399, 57, 1, 0, 0, 0, 400, 401, 5, 21, 0,
-		0, 401, 404, 5, 42, 0, 0, 402, 403, 5, 20, 0, 0, 403, 405, 3, 70, 35, 0,
-		404, 402, 1, 0, 0, 0, 404, 405, 1, 0, 0, 0, 405, 410, 1, 0, 0, 0, 406,
-		407, 5, 54, 0, 0, 407, 408, 5, 43, 0, 0, 408, 409, 5, 106, 0, 0, 409, 411,
-		3, 66, 33, 0, 410, 406, 1, 0, 0, 0, 410, 411, 1, 0, 0, 0, 411, 413, 1,
-		0, 0, 0, 412, 414, 3, 182, 91, 0, 413, 412, 1, 0, 0, 0, 413, 414, 1, 0,
-		0, 0, 414, 59, 1, 0, 0, 0, 415, 416, 5, 21, 0, 0, 416, 417, 5, 45, 0, 0,
-		417, 418, 3, 100, 50, 0, 418, 61, 1, 0, 0, 0, 419, 420, 5, 21, 0, 0, 420,
-		421, 5, 46, 0, 0, 421, 422, 5, 48, 0, 0, 422, 423, 3, 100, 50, 0, 423,
-		63, 1, 0, 0, 0, 424, 425, 5, 21, 0, 0, 425, 426, 5, 46, 0, 0, 426, 427,
-		5, 51, 0, 0, 427, 428, 3, 100, 50, 0, 428, 429, 5, 50, 0, 0, 429, 430,
-		5, 49, 0, 0, 430, 431, 5, 106, 0, 0, 431, 433, 3, 68, 34, 0, 432, 434,
-		3, 102, 51, 0, 433, 432, 1, 0, 0, 0, 433, 434, 1, 0, 0, 0, 434, 436, 1,
-		0, 0, 0, 435, 437, 3, 182, 91, 0, 436, 435, 1, 0, 0, 0, 436, 437, 1, 0,
-		0, 0, 437, 65, 1, 0, 0, 0, 438, 439, 3, 190, 95, 0, 439, 67, 1, 0, 0, 0,
-		440, 441, 3, 190, 95, 0, 441, 69, 1, 0, 0, 0, 442, 443, 3, 190, 95, 0,
-		443, 71, 1, 0, 0, 0, 444, 445, 3, 190, 95, 0, 445, 73, 1, 0, 0, 0, 446,
-		447, 3, 190, 95, 0, 447, 75, 1, 0, 0, 0, 448, 449, 3, 190, 95, 0, 449,
-		77, 1, 0, 0, 0, 450, 451, 7, 1, 0, 0, 451, 79, 1, 0, 0, 0, 452, 454, 5,
-		58, 0, 0, 453, 452, 1, 0, 0, 0, 453, 454, 1, 0, 0, 0, 454, 455, 1, 0, 0,
-		0, 455, 457, 3, 82, 41, 0, 456, 458, 3, 102, 51, 0, 457, 456, 1, 0, 0,
-		0, 457, 458, 1, 0, 0, 0, 458, 460, 1, 0, 0, 0, 459, 461, 3, 122, 61, 0,
-		460, 459, 1, 0, 0, 0, 460, 461, 1, 0, 0, 0, 461, 463, 1, 0, 0, 0, 462,
-		464, 3, 130, 65, 0, 463, 462, 1, 0, 0, 0, 463, 464, 1, 0, 0, 0, 464, 466,
-		1, 0, 0, 0, 465, 467, 3, 182, 91, 0, 466, 465, 1, 0, 0, 0, 466, 467, 1,
-		0, 0, 0, 467, 469, 1, 0, 0, 0, 468, 470, 5, 59, 0, 0, 469, 468, 1, 0, 0,
-		0, 469, 470, 1, 0, 0, 0, 470, 81, 1, 0, 0, 0, 471, 472, 3, 84, 42, 0, 472,
-		473, 3, 100, 50, 0, 473, 478, 1, 0, 0, 0, 474, 475, 3, 100, 50, 0, 475,
-		476, 3, 84, 42, 0, 476, 478, 1, 0, 0, 0, 477, 471, 1, 0, 0, 0, 477, 474,
-		1, 0, 0, 0, 478, 83, 1, 0, 0, 0, 479, 480, 5, 60, 0, 0, 480, 481, 3, 86,
-		43, 0, 481, 85, 1, 0, 0, 0, 482, 487, 3, 88, 44, 0, 483, 484, 5, 115, 0,
-		0, 484, 486, 3, 88, 44, 0, 485, 483, 1, 0, 0, 0, 486, 489, 1, 0, 0, 0,
-		487, 485, 1, 0, 0, 0, 487, 488, 1, 0, 0, 0, 488, 87, 1, 0, 0, 0, 489, 487,
-		1, 0, 0, 0, 490, 492, 3, 148, 74, 0, 491, 493, 3, 90, 45, 0, 492, 491,
-		1, 0, 0, 0, 492, 493, 1, 0, 0, 0, 493, 89, 1, 0, 0, 0, 494, 495, 5, 61,
-		0, 0, 495, 496, 3, 190, 95, 0, 496, 91, 1, 0, 0, 0, 497, 498, 5, 31, 0,
-		0, 498, 499, 5, 106, 0, 0, 499, 500, 3, 190, 95, 0, 500, 93, 1, 0, 0, 0,
-		501, 502, 5, 32, 0, 0, 502, 503, 5, 106, 0, 0, 503, 504, 3, 190, 95, 0,
-		504, 95, 1, 0, 0, 0, 505, 506, 5, 37, 0, 0, 506, 507, 5, 106, 0, 0, 507,
-		508, 3, 190, 95, 0, 508, 97, 1, 0, 0, 0, 509, 510, 5, 29, 0, 0, 510, 511,
-		5, 106, 0, 0, 511, 512, 3, 190, 95, 0, 512, 99, 1, 0, 0, 0, 513, 514, 5,
-		53, 0, 0, 514, 517, 3, 184, 92, 0, 515, 516, 5, 20, 0, 0, 516, 518, 3,
-		70, 35, 0, 517, 515, 1, 0, 0, 0, 517, 518, 1, 0, 0, 0, 518, 101, 1, 0,
-		0, 0, 519, 520, 5, 54, 0, 0, 520, 521, 3, 104, 52, 0, 521, 103, 1, 0, 0,
-		0, 522, 533, 3, 106, 53, 0, 523, 524, 3, 106, 53, 0, 524, 525, 5, 62, 0,
-		0, 525, 526, 3, 114, 57, 0, 526, 533, 1, 0, 0, 0, 527, 530, 3, 114, 57,
-		0, 528, 529, 5, 62, 0, 0, 529, 531, 3, 106, 53, 0, 530, 528, 1, 0, 0, 0,
-		530, 531, 1, 0, 0, 0, 531, 533, 1, 0, 0, 0, 532, 522, 1, 0, 0, 0, 532,
-		523, 1, 0, 0, 0, 532, 527, 1, 0, 0, 0, 533, 105, 1, 0, 0, 0, 534, 535,
-		6, 53, -1, 0, 535, 536, 5, 120, 0, 0, 536, 537, 3, 106, 53, 0, 537, 538,
-		5, 121, 0, 0, 538, 563, 1, 0, 0, 0, 539, 548, 3, 186, 93, 0, 540, 549,
-		5, 106, 0, 0, 541, 549, 5, 70, 0, 0, 542, 543, 5, 71, 0, 0, 543, 549, 5,
-		70, 0, 0, 544, 549, 5, 113, 0, 0, 545, 549, 5, 114, 0, 0, 546, 549, 5,
-		107, 0, 0, 547, 549, 5, 108, 0, 0, 548, 540, 1, 0, 0, 0, 548, 541, 1, 0,
-		0, 0, 548, 542, 1, 0, 0, 0, 548, 544, 1, 0, 0, 0, 548, 545, 1, 0, 0, 0,
-		548, 546, 1, 0, 0, 0, 548, 547, 1, 0, 0, 0, 549, 550, 1, 0, 0, 0, 550,
-		551, 3, 188, 94, 0, 551, 563, 1, 0, 0, 0, 552, 556, 3, 186, 93, 0, 553,
-		557, 5, 81, 0, 0, 554, 555, 5, 71, 0, 0, 555, 557, 5, 81, 0, 0, 556, 553,
-		1, 0, 0, 0, 556, 554, 1, 0, 0, 0, 557, 558, 1, 0, 0, 0, 558, 559, 5, 120,
-		0, 0, 559, 560, 3, 108, 54, 0, 560, 561, 5, 121, 0, 0, 561, 563, 1, 0,
-		0, 0, 562, 534, 1, 0, 0, 0, 562, 539, 1, 0, 0, 0, 562, 552, 1, 0, 0, 0,
-		563, 569, 1, 0, 0, 0, 564, 565, 10, 1, 0, 0, 565, 566, 7, 2, 0, 0, 566,
-		568, 3, 106, 53, 2, 567, 564, 1, 0, 0, 0, 568, 571, 1, 0, 0, 0, 569, 567,
-		1, 0, 0, 0, 569, 570, 1, 0, 0, 0, 570, 107, 1, 0, 0, 0, 571, 569, 1, 0,
-		0, 0, 572, 577, 3, 188, 94, 0, 573, 574, 5, 115, 0, 0, 574, 576, 3, 188,
-		94, 0, 575, 573, 1, 0, 0, 0, 576, 579, 1, 0, 0, 0, 577, 575, 1, 0, 0, 0,
-		577, 578, 1, 0, 0, 0, 578, 109, 1, 0, 0, 0, 579, 577, 1, 0, 0, 0, 580,
-		581, 5, 43, 0, 0, 581, 582, 5, 81, 0, 0, 582, 583, 5, 120, 0, 0, 583, 584,
-		3, 112, 56, 0, 584, 585, 5, 121, 0, 0, 585, 111, 1, 0, 0, 0, 586, 591,
-		3, 190, 95, 0, 587, 588, 5, 115, 0, 0, 588, 590, 3, 190, 95, 0, 589, 587,
-		1, 0, 0, 0, 590, 593, 1, 0, 0, 0, 591, 589, 1, 0, 0, 0, 591, 592, 1, 0,
-		0, 0, 592, 113, 1, 0, 0, 0, 593, 591, 1, 0, 0, 0, 594, 597, 3, 116, 58,
-		0, 595, 596, 5, 62, 0, 0, 596, 598, 3, 116, 58, 0, 597, 595, 1, 0, 0, 0,
-		597, 598, 1, 0, 0, 0, 598, 115, 1, 0, 0, 0, 599, 600, 5, 79, 0, 0, 600,
-		603, 3, 146, 73, 0, 601, 604, 3, 118, 59, 0, 602, 604, 3, 190, 95, 0, 603,
-		601, 1, 0, 0, 0, 603, 602, 1, 0, 0, 0, 604, 117, 1, 0, 0, 0, 605, 607,
-		3, 120, 60, 0, 606, 608, 3, 150, 75, 0, 607, 606, 1, 0, 0, 0, 607, 608,
-		1, 0, 0, 0, 608, 119, 1, 0, 0, 0, 609, 610, 5, 80, 0, 0, 610, 612, 5, 120,
-		0, 0, 611, 613, 3, 158, 79, 0, 612, 611, 1, 0, 0, 0, 612, 613, 1, 0, 0,
-		0, 613, 614, 1, 0, 0, 0, 614, 615, 5, 121, 0, 0, 615, 121, 1, 0, 0, 0,
-		616, 617, 5, 74, 0, 0, 617, 618, 5, 76, 0, 0, 618, 624, 3, 124, 62, 0,
-		619, 620, 5, 64, 0, 0, 620, 621, 5, 120, 0, 0, 621, 622, 3, 128, 64, 0,
-		622, 623, 5, 121, 0, 0, 623, 625, 1, 0, 0, 0, 624, 619, 1, 0, 0, 0, 624,
-		625, 1, 0, 0, 0, 625, 627, 1, 0, 0, 0, 626, 628, 3, 136, 68, 0, 627, 626,
-		1, 0, 0, 0, 627, 628, 1, 0, 0, 0, 628, 123, 1, 0, 0, 0, 629, 634, 3, 126,
-		63, 0, 630, 631, 5, 115, 0, 0, 631, 633, 3, 126, 63, 0, 632, 630, 1, 0,
-		0, 0, 633, 636, 1, 0, 0, 0, 634, 632, 1, 0, 0, 0, 634, 635, 1, 0, 0, 0,
-		635, 125, 1, 0, 0, 0, 636, 634, 1, 0, 0, 0, 637, 644, 3, 190, 95, 0, 638,
-		639, 5, 79, 0, 0, 639, 640, 5, 120, 0, 0, 640, 641, 3, 150, 75, 0, 641,
-		642, 5, 121, 0, 0, 642, 644, 1, 0, 0, 0, 643, 637, 1, 0, 0, 0, 643, 638,
-		1, 0, 0, 0, 644, 127, 1, 0, 0, 0, 645, 646, 7, 3, 0, 0, 646, 129, 1, 0,
-		0, 0, 647, 648, 5, 67, 0, 0, 648, 649, 5, 76, 0, 0, 649, 650, 3, 134, 67,
-		0, 650, 131, 1, 0, 0, 0, 651, 655, 3, 148, 74, 0, 652, 654, 7, 4, 0, 0,
-		653, 652, 1, 0, 0, 0, 654, 657, 1, 0, 0, 0, 655, 653, 1, 0, 0, 0, 655,
-		656, 1, 0, 0, 0, 656, 133, 1, 0, 0, 0, 657, 655, 1, 0, 0, 0, 658, 663,
-		3, 132, 66, 0, 659, 660, 5, 115, 0, 0, 660, 662, 3, 132, 66, 0, 661, 659,
-		1, 0, 0, 0, 662, 665, 1, 0, 0, 0, 663, 661, 1, 0, 0, 0, 663, 664, 1, 0,
-		0, 0, 664, 135, 1, 0, 0, 0, 665, 663, 1, 0, 0, 0, 666, 667, 5, 75, 0, 0,
-		667, 668, 3, 138, 69, 0, 668, 137, 1, 0, 0, 0, 669, 670, 6, 69, -1, 0,
-		670, 671, 5, 120, 0, 0, 671, 672, 3, 138, 69, 0, 672, 673, 5, 121, 0, 0,
-		673, 676, 1, 0, 0, 0, 674, 676, 3, 142, 71, 0, 675, 669, 1, 0, 0, 0, 675,
-		674, 1, 0, 0, 0, 676, 683, 1, 0, 0, 0, 677, 678, 10, 2, 0, 0, 678, 679,
-		3, 140, 70, 0, 679, 680, 3, 138, 69, 3, 680, 682, 1, 0, 0, 0, 681, 677,
-		1, 0, 0, 0, 682, 685, 1, 0, 0, 0, 683, 681, 1, 0, 0, 0, 683, 684, 1, 0,
-		0, 0, 684, 139, 1, 0, 0, 0, 685, 683, 1, 0, 0, 0, 686, 687, 7, 2, 0, 0,
-		687, 141, 1, 0, 0, 0, 688, 689, 3, 144, 72, 0, 689, 143, 1, 0, 0, 0, 690,
-		691, 3, 148, 74, 0, 691, 692, 3, 146, 73, 0, 692, 693, 3, 148, 74, 0, 693,
-		145, 1, 0, 0, 0, 694, 703, 5, 106, 0, 0, 695, 703, 5, 107, 0, 0, 696, 703,
-		5, 108, 0, 0, 697, 703, 5, 111, 0, 0, 698, 703, 5, 112, 0, 0, 699, 703,
-		5, 109, 0, 0, 700, 703, 5, 110, 0, 0, 701, 703, 7, 5, 0, 0, 702, 694, 1,
-		0, 0, 0, 702, 695, 1, 0, 0, 0, 702, 696, 1, 0, 0, 0, 702, 697, 1, 0, 0,
-		0, 702, 698, 1, 0, 0, 0, 702, 699, 1, 0, 0, 0, 702, 700, 1, 0, 0, 0, 702,
-		701, 1, 0, 0, 0, 703, 147, 1, 0, 0, 0, 704, 705, 6, 74, -1, 0, 705, 706,
-		5, 120, 0, 0, 706, 707, 3, 148, 74, 0, 707, 708, 5, 121, 0, 0, 708, 713,
-		1, 0, 0, 0, 709, 713, 3, 154, 77, 0, 710, 713, 3, 162, 81, 0, 711, 713,
-		3, 150, 75, 0, 712, 704, 1, 0, 0, 0, 712, 709, 1, 0, 0, 0, 712, 710, 1,
-		0, 0, 0, 712, 711, 1, 0, 0, 0, 713, 728, 1, 0, 0, 0, 714, 715, 10, 8, 0,
-		0, 715, 716, 5, 125, 0, 0, 716, 727, 3, 148, 74, 9, 717, 718, 10, 7, 0,
-		0, 718, 719, 5, 124, 0, 0, 719, 727, 3, 148, 74, 8, 720, 721, 10, 6, 0,
-		0, 721, 722, 5, 122, 0, 0, 722, 727, 3, 148, 74, 7, 723, 724, 10, 5, 0,
-		0, 724, 725, 5, 123, 0, 0, 725, 727, 3, 148, 74, 6, 726, 714, 1, 0, 0,
-		0, 726, 717, 1, 0, 0, 0, 726, 720, 1, 0, 0, 0, 726, 723, 1, 0, 0, 0, 727,
-		730, 1, 0, 0, 0, 728, 726, 1, 0, 0, 0, 728, 729, 1, 0, 0, 0, 729, 149,
-		1, 0, 0, 0, 730, 728, 1, 0, 0, 0, 731, 732, 3, 178, 89, 0, 732, 733, 3,
-		152, 76, 0, 733, 151, 1, 0, 0, 0, 734, 735, 7, 6, 0, 0, 735, 153, 1, 0,
-		0, 0, 736, 737, 3, 156, 78, 0, 737, 739, 5, 120, 0, 0, 738, 740, 3, 158,
-		79, 0, 739, 738, 1, 0, 0, 0, 739, 740, 1, 0, 0, 0, 740, 741, 1, 0, 0, 0,
-		741, 742, 5, 121, 0, 0, 742, 155, 1, 0, 0, 0, 743, 744, 7, 7, 0, 0, 744,
-		157, 1, 0, 0, 0, 745, 750, 3, 160, 80, 0, 746, 747, 5, 115, 0, 0, 747,
-		749, 3, 160, 80, 0, 748, 746, 1, 0, 0, 0, 749, 752, 1, 0, 0, 0, 750, 748,
-		1, 0, 0, 0, 750, 751, 1, 0, 0, 0, 751, 159, 1, 0, 0, 0, 752, 750, 1, 0,
-		0, 0, 753, 756, 3, 148, 74, 0, 754, 756, 3, 106, 53, 0, 755, 753, 1, 0,
-		0, 0, 755, 754, 1, 0, 0, 0, 756, 161, 1, 0, 0, 0, 757, 759, 3, 190, 95,
-		0, 758, 760, 3, 164, 82, 0, 759, 758, 1, 0, 0, 0, 759, 760, 1, 0, 0, 0,
-		760, 764, 1, 0, 0, 0, 761, 764, 3, 180, 90, 0, 762, 764, 3, 178, 89, 0,
-		763, 757, 1, 0, 0, 0, 763, 761, 1, 0, 0, 0, 763, 762, 1, 0, 0, 0, 764,
-		163, 1, 0, 0, 0, 765, 766, 5, 118, 0, 0, 766, 767, 3, 106, 53, 0, 767,
-		768, 5, 119, 0, 0, 768, 165, 1, 0, 0, 0, 769, 770, 3, 176, 88, 0, 770,
-		167, 1, 0, 0, 0, 771, 772, 3, 190, 95, 0, 772, 169, 1, 0, 0, 0, 773, 774,
-		5, 116, 0, 0, 774, 779, 3, 172, 86, 0, 775, 776, 5, 115, 0, 0, 776, 778,
-		3, 172, 86, 0, 777, 775, 1, 0, 0, 0, 778, 781, 1, 0, 0, 0, 779, 777, 1,
-		0, 0, 0, 779, 780, 1, 0, 0, 0, 780, 782, 1, 0, 0, 0, 781, 779, 1, 0, 0,
-		0, 782, 783, 5, 117, 0, 0, 783, 787, 1, 0, 0, 0, 784, 785, 5, 116, 0, 0,
-		785, 787, 5, 117, 0, 0, 786, 773, 1, 0, 0, 0, 786, 784, 1, 0, 0, 0, 787,
-		171, 1, 0, 0, 0, 788, 789, 5, 4, 0, 0, 789, 790, 5, 105, 0, 0, 790, 791,
-		3, 176, 88, 0, 791, 173, 1, 0, 0, 0, 792, 793, 5, 118, 0, 0, 793, 798,
-		3, 176, 88, 0, 794, 795, 5, 115, 0, 0, 795, 797, 3, 176, 88, 0, 796, 794,
-		1, 0, 0, 0, 797, 800, 1, 0, 0, 0, 798, 796, 1, 0, 0, 0, 798, 799, 1, 0,
-		0, 0, 799, 801, 1, 0, 0, 0, 800, 798, 1, 0, 0, 0, 801, 802, 5, 119, 0,
-		0, 802, 806, 1, 0, 0, 0, 803, 804, 5, 118, 0, 0, 804, 806, 5, 119, 0, 0,
-		805, 792, 1, 0, 0, 0, 805, 803, 1, 0, 0, 0, 806, 175, 1, 0, 0, 0, 807,
-		816, 5, 4, 0, 0, 808, 816, 3, 178, 89, 0, 809, 816, 3, 180, 90, 0, 810,
-		816, 3, 170, 85, 0, 811, 816, 3, 174, 87, 0, 812, 816, 5, 1, 0, 0, 813,
-		816, 5, 2, 0, 0, 814, 816, 5, 3, 0, 0, 815, 807, 1, 0, 0, 0, 815, 808,
-		1, 0, 0, 0, 815, 809, 1, 0, 0, 0, 815, 810, 1, 0, 0, 0, 815, 811, 1, 0,
-		0, 0, 815, 812, 1, 0, 0, 0, 815, 813, 1, 0, 0, 0, 815, 814, 1, 0, 0, 0,
-		816, 177, 1, 0, 0, 0, 817, 819, 7, 8, 0, 0, 818, 817, 1, 0, 0, 0, 818,
-		819, 1, 0, 0, 0, 819, 820, 1, 0, 0, 0, 820, 821, 5, 129, 0, 0, 821, 179,
-		1, 0, 0, 0, 822, 824, 7, 8, 0, 0, 823, 822, 1, 0, 0, 0, 823, 824, 1, 0,
-		0, 0, 824, 825, 1, 0, 0, 0, 825, 826, 5, 130, 0, 0, 826, 181, 1, 0, 0,
-		0, 827, 828, 5, 55, 0, 0, 828, 829, 5, 129, 0, 0, 829, 183, 1, 0, 0, 0,
-		830, 831, 3, 190, 95, 0, 831, 185, 1, 0, 0, 0, 832, 833, 3, 190, 95, 0,
-		833, 187, 1, 0, 0, 0, 834, 835, 3, 190, 95, 0, 835, 189, 1, 0, 0, 0, 836,
-		839, 5, 128, 0, 0, 837, 839, 3, 192, 96, 0, 838, 836, 1, 0, 0, 0, 838,
-		837, 1, 0, 0, 0, 839, 847, 1, 0, 0, 0, 840, 843, 5, 104, 0, 0, 841, 844,
-		5, 128, 0, 0, 842, 844, 3, 192, 96, 0, 843, 841, 1, 0, 0, 0, 843, 842,
-		1, 0, 0, 0, 844, 846, 1, 0, 0, 0, 845, 840, 1, 0, 0, 0, 846, 849, 1, 0,
-		0, 0, 847, 845, 1, 0, 0, 0, 847, 848, 1, 0, 0, 0, 848, 191, 1, 0, 0, 0,
-		849, 847, 1, 0, 0, 0, 850, 851, 7, 9, 0, 0, 851, 193, 1, 0, 0, 0, 67, 206,
-		239, 284, 302, 307, 318, 323, 331, 336, 356, 361, 395, 398, 404, 410, 413,
-		433, 436, 453, 457, 460, 463, 466, 469, 477, 487, 492, 517, 530, 532, 548,
-		556, 562, 569, 577, 591, 597, 603, 607, 612, 624, 627, 634, 643, 655, 663,
-		675, 683, 702, 712, 726, 728, 739, 750, 755, 759, 763, 779, 786, 798, 805,
-		815, 818, 823, 838, 843, 847,
+		4, 1, 160, 1070, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4,
+		7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10,
+		7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7,
+		15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20,
+		2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2,
+		26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31,
+		7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7,
+		36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41,
+		2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2,
+		47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52,
+		7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7,
+		57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62,
+		2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2,
+		68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73,
+		7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7,
+		78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83,
+		2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2,
+		89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94,
+		7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99, 7,
+		99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103, 2,
+		104, 7, 104, 2, 105, 7, 105, 2, 106, 7, 106, 2, 107, 7, 107, 2, 108, 7,
+		108, 2, 109, 7, 109, 2, 110, 7, 110, 2, 111, 7, 111, 2, 112, 7, 112, 2,
+		113, 7, 113, 2, 114, 7, 114, 2, 115, 7, 115, 2, 116, 7, 116, 2, 117, 7,
+		117, 2, 118, 7, 118, 2, 119, 7, 119, 2, 120, 7, 120, 2, 121, 7, 121, 2,
+		122, 7, 122, 2, 123, 7, 123, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0,
+		1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0,
+		1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 3, 0, 273, 8, 0, 1, 1, 1, 1, 1, 1, 1, 2,
+		1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3,
+		1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3,
+		1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 3, 3, 310, 8, 3, 1, 4, 1, 4,
+		1, 4, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7,
+		1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1,
+		10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12,
+		1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1,
+		13, 3, 13, 359, 8, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14,
+		1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 3, 15, 377,
+		8, 15, 1, 15, 1, 15, 1, 15, 3, 15, 382, 8, 15, 1, 16, 1, 16, 1, 16, 1,
+		16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 3, 17, 393, 8, 17, 1, 17, 1, 17,
+		1, 17, 3, 17, 398, 8, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 3,
+		18, 406, 8, 18, 1, 18, 1, 18, 1, 18, 3, 18, 411, 8, 18, 1, 19, 1, 19, 1,
+		19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 3, 20, 422, 8, 20, 1, 20,
+		1, 20, 1, 20, 3, 20, 427, 8, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1,
+		21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23,
+		1, 23, 1, 23, 3, 23, 447, 8, 23, 1, 23, 1, 23, 1, 23, 3, 23, 452, 8, 23,
+		1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1,
+		26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27, 472, 8, 27,
+		1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 3, 28, 483,
+		8, 28, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1,
+		31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 3, 32, 501, 8, 32, 1, 33,
+		1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 36, 1,
+		36, 1, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38,
+		1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1,
+		40, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41,
+		1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1,
+		43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45,
+		1, 45, 1, 45, 3, 45, 569, 8, 45, 1, 45, 3, 45, 572, 8, 45, 1, 46, 1, 46,
+		1, 46, 1, 46, 3, 46, 578, 8, 46, 1, 46, 1, 46, 1, 46, 1, 46, 3, 46, 584,
+		8, 46, 1, 46, 3, 46, 587, 8, 46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 48, 1,
+		48, 1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49,
+		1, 49, 1, 49, 3, 49, 607, 8, 49, 1, 49, 3, 49, 610, 8, 49, 1, 50, 1, 50,
+		1, 51, 1, 51, 1, 52, 1, 52, 1, 53, 1, 53, 1, 54, 1, 54, 1, 55, 1, 55, 1,
+		56, 1, 56, 1, 57, 1, 57, 1, 58, 1, 58, 1, 59, 1, 59, 1, 60, 1, 60, 1, 61,
+		1, 61, 1, 62, 3, 62, 637, 8, 62, 1, 62, 1, 62, 3, 62, 641, 8, 62, 1, 62,
+		3, 62, 644, 8, 62, 1, 62, 3, 62, 647, 8, 62, 1, 62, 3, 62, 650, 8, 62,
+		1, 62, 3, 62, 653, 8, 62, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 3,
+		63, 661, 8, 63, 1, 64, 1, 64, 1, 64, 1, 65, 1, 65, 1, 65, 5, 65, 669, 8,
+		65, 10, 65, 12, 65, 672, 9, 65, 1, 66, 1, 66, 3, 66, 676, 8, 66, 1, 67,
+		1, 67, 1, 67, 1, 68, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1,
+		70, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1, 72,
+		1, 72, 3, 72, 701, 8, 72, 1, 73, 1, 73, 1, 73, 1, 73, 5, 73, 707, 8, 73,
+		10, 73, 12, 73, 710, 9, 73, 1, 73, 1, 73, 3, 73, 714, 8, 73, 1, 73, 3,
+		73, 717, 8, 73, 1, 74, 1, 74, 1, 74, 1, 74, 1, 75, 1, 75, 3, 75, 725, 8,
+		75, 1, 76, 1, 76, 1, 76, 1, 77, 1, 77, 1, 77, 1, 78, 1, 78, 1, 78, 1, 78,
+		1, 78, 1, 78, 1, 78, 1, 78, 3, 78, 741, 8, 78, 3, 78, 743, 8, 78, 1, 79,
+		1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1,
+		79, 1, 79, 1, 79, 3, 79, 759, 8, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79,
+		1, 79, 3, 79, 767, 8, 79, 1, 79, 1, 79, 1, 79, 1, 79, 3, 79, 773, 8, 79,
+		1, 79, 1, 79, 1, 79, 5, 79, 778, 8, 79, 10, 79, 12, 79, 781, 9, 79, 1,
+		80, 1, 80, 1, 80, 5, 80, 786, 8, 80, 10, 80, 12, 80, 789, 9, 80, 1, 81,
+		1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 82, 1, 82, 1, 82, 5, 82, 800, 8,
+		82, 10, 82, 12, 82, 803, 9, 82, 1, 83, 1, 83, 1, 83, 3, 83, 808, 8, 83,
+		1, 84, 1, 84, 1, 84, 1, 84, 3, 84, 814, 8, 84, 1, 85, 1, 85, 3, 85, 818,
+		8, 85, 1, 86, 1, 86, 1, 86, 3, 86, 823, 8, 86, 1, 86, 1, 86, 1, 87, 1,
+		87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 3, 87, 835, 8, 87, 1, 87,
+		3, 87, 838, 8, 87, 1, 87, 3, 87, 841, 8, 87, 1, 88, 1, 88, 1, 88, 5, 88,
+		846, 8, 88, 10, 88, 12, 88, 849, 9, 88, 1, 89, 1, 89, 1, 89, 1, 89, 1,
+		89, 1, 89, 3, 89, 857, 8, 89, 1, 90, 1, 90, 1, 91, 1, 91, 1, 91, 1, 91,
+		1, 92, 1, 92, 1, 92, 1, 92, 1, 93, 1, 93, 5, 93, 871, 8, 93, 10, 93, 12,
+		93, 874, 9, 93, 1, 94, 1, 94, 1, 94, 5, 94, 879, 8, 94, 10, 94, 12, 94,
+		882, 9, 94, 1, 95, 1, 95, 1, 95, 1, 96, 1, 96, 1, 96, 1, 96, 1, 96, 1,
+		96, 3, 96, 893, 8, 96, 1, 96, 1, 96, 1, 96, 1, 96, 5, 96, 899, 8, 96, 10,
+		96, 12, 96, 902, 9, 96, 1, 97, 1, 97, 1, 98, 1, 98, 1, 99, 1, 99, 1, 99,
+		1, 99, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100,
+		3, 100, 920, 8, 100, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1,
+		101, 1, 101, 3, 101, 930, 8, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101,
+		1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 5, 101, 944, 8,
+		101, 10, 101, 12, 101, 947, 9, 101, 1, 102, 1, 102, 1, 102, 1, 103, 1,
+		103, 1, 104, 1, 104, 1, 104, 3, 104, 957, 8, 104, 1, 104, 1, 104, 1, 105,
+		1, 105, 1, 106, 1, 106, 1, 106, 5, 106, 966, 8, 106, 10, 106, 12, 106,
+		969, 9, 106, 1, 107, 1, 107, 3, 107, 973, 8, 107, 1, 108, 1, 108, 3, 108,
+		977, 8, 108, 1, 108, 1, 108, 3, 108, 981, 8, 108, 1, 109, 1, 109, 1, 109,
+		1, 109, 1, 110, 1, 110, 1, 111, 1, 111, 1, 112, 1, 112, 1, 112, 1, 112,
+		5, 112, 995, 8, 112, 10, 112, 12, 112, 998, 9, 112, 1, 112, 1, 112, 1,
+		112, 1, 112, 3, 112, 1004, 8, 112, 1, 113, 1, 113, 1, 113, 1, 113, 1, 114,
+		1, 114, 1, 114, 1, 114, 5, 114, 1014, 8, 114, 10, 114, 12, 114, 1017, 9,
+		114, 1, 114, 1, 114, 1, 114, 1, 114, 3, 114, 1023, 8, 114, 1, 115, 1, 115,
+		1, 115, 1, 115, 1, 115, 1, 115, 1, 115, 1, 115, 3, 115, 1033, 8, 115, 1,
+		116, 3, 116, 1036, 8, 116, 1, 116, 1, 116, 1, 117, 3, 117, 1041, 8, 117,
+		1, 117, 1, 117, 1, 118, 1, 118, 1, 118, 1, 119, 1, 119, 1, 120, 1, 120,
+		1, 121, 1, 121, 1, 122, 1, 122, 3, 122, 1056, 8, 122, 1, 122, 1, 122, 1,
+		122, 3, 122, 1061, 8, 122, 5, 122, 1063, 8, 122, 10, 122, 12, 122, 1066,
+		9, 122, 1, 123, 1, 123, 1, 123, 0, 3, 158, 192, 202, 124, 0, 2, 4, 6, 8,
+		10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44,
+		46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80,
+		82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112,
+		114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142,
+		144, 146, 148, 150, 152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172,
+		174, 176, 178, 180, 182, 184, 186, 188, 190, 192, 194, 196, 198, 200, 202,
+		204, 206, 208, 210, 212, 214, 216, 218, 220, 222, 224, 226, 228, 230, 232,
+		234, 236, 238, 240, 242, 244, 246, 0, 11, 1, 0, 49, 51, 1, 0, 38, 40, 1,
+		0, 42, 43, 1, 0, 80, 81, 2, 0, 83, 84, 159, 160, 1, 0, 86, 87, 2, 0, 88,
+		88, 143, 143, 1, 0, 127, 133, 1, 0, 105, 125, 1, 0, 152, 153, 2, 0, 6,
+		25, 27, 133, 1092, 0, 272, 1, 0, 0, 0, 2, 274, 1, 0, 0, 0, 4, 277, 1, 0,
+		0, 0, 6, 309, 1, 0, 0, 0, 8, 311, 1, 0, 0, 0, 10, 314, 1, 0, 0, 0, 12,
+		317, 1, 0, 0, 0, 14, 324, 1, 0, 0, 0, 16, 328, 1, 0, 0, 0, 18, 331, 1,
+		0, 0, 0, 20, 334, 1, 0, 0, 0, 22, 337, 1, 0, 0, 0, 24, 341, 1, 0, 0, 0,
+		26, 349, 1, 0, 0, 0, 28, 360, 1, 0, 0, 0, 30, 368, 1, 0, 0, 0, 32, 383,
+		1, 0, 0, 0, 34, 387, 1, 0, 0, 0, 36, 399, 1, 0, 0, 0, 38, 412, 1, 0, 0,
+		0, 40, 415, 1, 0, 0, 0, 42, 428, 1, 0, 0, 0, 44, 434, 1, 0, 0, 0, 46, 440,
+		1, 0, 0, 0, 48, 453, 1, 0, 0, 0, 50, 457, 1, 0, 0, 0, 52, 461, 1, 0, 0,
+		0, 54, 465, 1, 0, 0, 0, 56, 473, 1, 0, 0, 0, 58, 484, 1, 0, 0, 0, 60, 487,
+		1, 0, 0, 0, 62, 491, 1, 0, 0, 0, 64, 495, 1, 0, 0, 0, 66, 502, 1, 0, 0,
+		0, 68, 506, 1, 0, 0, 0, 70, 509, 1, 0, 0, 0, 72, 512, 1, 0, 0, 0, 74, 515,
+		1, 0, 0, 0, 76, 522, 1, 0, 0, 0, 78, 526, 1, 0, 0, 0, 80, 530, 1, 0, 0,
+		0, 82, 538, 1, 0, 0, 0, 84, 546, 1, 0, 0, 0, 86, 553, 1, 0, 0, 0, 88, 560,
+		1, 0, 0, 0, 90, 562, 1, 0, 0, 0, 92, 573, 1, 0, 0, 0, 94, 588, 1, 0, 0,
+		0, 96, 592, 1, 0, 0, 0, 98, 597, 1, 0, 0, 0, 100, 611, 1, 0, 0, 0, 102,
+		613, 1, 0, 0, 0, 104, 615, 1, 0, 0, 0, 106, 617, 1, 0, 0, 0, 108, 619,
+		1, 0, 0, 0, 110, 621, 1, 0, 0, 0, 112, 623, 1, 0, 0, 0, 114, 625, 1, 0,
+		0, 0, 116, 627, 1, 0, 0, 0, 118, 629, 1, 0, 0, 0, 120, 631, 1, 0, 0, 0,
+		122, 633, 1, 0, 0, 0, 124, 636, 1, 0, 0, 0, 126, 660, 1, 0, 0, 0, 128,
+		662, 1, 0, 0, 0, 130, 665, 1, 0, 0, 0, 132, 673, 1, 0, 0, 0, 134, 677,
+		1, 0, 0, 0, 136, 680, 1, 0, 0, 0, 138, 684, 1, 0, 0, 0, 140, 688, 1, 0,
+		0, 0, 142, 692, 1, 0, 0, 0, 144, 696, 1, 0, 0, 0, 146, 702, 1, 0, 0, 0,
+		148, 718, 1, 0, 0, 0, 150, 722, 1, 0, 0, 0, 152, 726, 1, 0, 0, 0, 154,
+		729, 1, 0, 0, 0, 156, 742, 1, 0, 0, 0, 158, 772, 1, 0, 0, 0, 160, 782,
+		1, 0, 0, 0, 162, 790, 1, 0, 0, 0, 164, 796, 1, 0, 0, 0, 166, 804, 1, 0,
+		0, 0, 168, 809, 1, 0, 0, 0, 170, 815, 1, 0, 0, 0, 172, 819, 1, 0, 0, 0,
+		174, 826, 1, 0, 0, 0, 176, 842, 1, 0, 0, 0, 178, 856, 1, 0, 0, 0, 180,
+		858, 1, 0, 0, 0, 182, 860, 1, 0, 0, 0, 184, 864, 1, 0, 0, 0, 186, 868,
+		1, 0, 0, 0, 188, 875, 1, 0, 0, 0, 190, 883, 1, 0, 0, 0, 192, 892, 1, 0,
+		0, 0, 194, 903, 1, 0, 0, 0, 196, 905, 1, 0, 0, 0, 198, 907, 1, 0, 0, 0,
+		200, 919, 1, 0, 0, 0, 202, 929, 1, 0, 0, 0, 204, 948, 1, 0, 0, 0, 206,
+		951, 1, 0, 0, 0, 208, 953, 1, 0, 0, 0, 210, 960, 1, 0, 0, 0, 212, 962,
+		1, 0, 0, 0, 214, 972, 1, 0, 0, 0, 216, 980, 1, 0, 0, 0, 218, 982, 1, 0,
+		0, 0, 220, 986, 1, 0, 0, 0, 222, 988, 1, 0, 0, 0, 224, 1003, 1, 0, 0, 0,
+		226, 1005, 1, 0, 0, 0, 228, 1022, 1, 0, 0, 0, 230, 1032, 1, 0, 0, 0, 232,
+		1035, 1, 0, 0, 0, 234, 1040, 1, 0, 0, 0, 236, 1044, 1, 0, 0, 0, 238, 1047,
+		1, 0, 0, 0, 240, 1049, 1, 0, 0, 0, 242, 1051, 1, 0, 0, 0, 244, 1055, 1,
+		0, 0, 0, 246, 1067, 1, 0, 0, 0, 248, 273, 3, 6, 3, 0, 249, 273, 3, 48,
+		24, 0, 250, 273, 3, 50, 25, 0, 251, 273, 3, 52, 26, 0, 252, 273, 3, 54,
+		27, 0, 253, 273, 3, 56, 28, 0, 254, 273, 3, 2, 1, 0, 255, 273, 3, 124,
+		62, 0, 256, 273, 3, 60, 30, 0, 257, 273, 3, 62, 31, 0, 258, 273, 3, 64,
+		32, 0, 259, 273, 3, 66, 33, 0, 260, 273, 3, 4, 2, 0, 261, 273, 3, 74, 37,
+		0, 262, 273, 3, 76, 38, 0, 263, 273, 3, 78, 39, 0, 264, 273, 3, 80, 40,
+		0, 265, 273, 3, 82, 41, 0, 266, 273, 3, 84, 42, 0, 267, 273, 3, 86, 43,
+		0, 268, 273, 3, 14, 7, 0, 269, 270, 3, 244, 122, 0, 270, 271, 5, 0, 0,
+		1, 271, 273, 1, 0, 0, 0, 272, 248, 1, 0, 0, 0, 272, 249, 1, 0, 0, 0, 272,
+		250, 1, 0, 0, 0, 272, 251, 1, 0, 0, 0, 272, 252, 1, 0, 0, 0, 272, 253,
+		1, 0, 0, 0, 272, 254, 1, 0, 0, 0, 272, 255, 1, 0, 0, 0, 272, 256, 1, 0,
+		0, 0, 272, 257, 1, 0, 0, 0, 272, 258, 1, 0, 0, 0, 272, 259, 1, 0, 0, 0,
+		272, 260, 1, 0, 0, 0, 272, 261, 1, 0, 0, 0, 272, 262, 1, 0, 0, 0, 272,
+		263, 1, 0, 0, 0, 272, 264, 1, 0, 0, 0, 272, 265, 1, 0, 0, 0, 272, 266,
+		1, 0, 0, 0, 272, 267, 1, 0, 0, 0, 272, 268, 1, 0, 0, 0, 272, 269, 1, 0,
+		0, 0, 273, 1, 1, 0, 0, 0, 274, 275, 5, 41, 0, 0, 275, 276, 3, 244, 122,
+		0, 276, 3, 1, 0, 0, 0, 277, 278, 5, 8, 0, 0, 278, 279, 5, 73, 0, 0, 279,
+		280, 3, 222, 111, 0, 280, 5, 1, 0, 0, 0, 281, 310, 3, 8, 4, 0, 282, 310,
+		3, 22, 11, 0, 283, 310, 3, 24, 12, 0, 284, 310, 3, 26, 13, 0, 285, 310,
+		3, 28, 14, 0, 286, 310, 3, 30, 15, 0, 287, 310, 3, 16, 8, 0, 288, 310,
+		3, 18, 9, 0, 289, 310, 3, 20, 10, 0, 290, 310, 3, 32, 16, 0, 291, 310,
+		3, 42, 21, 0, 292, 310, 3, 44, 22, 0, 293, 310, 3, 46, 23, 0, 294, 310,
+		3, 34, 17, 0, 295, 310, 3, 36, 18, 0, 296, 310, 3, 38, 19, 0, 297, 310,
+		3, 40, 20, 0, 298, 310, 3, 58, 29, 0, 299, 310, 3, 68, 34, 0, 300, 310,
+		3, 90, 45, 0, 301, 310, 3, 92, 46, 0, 302, 310, 3, 94, 47, 0, 303, 310,
+		3, 96, 48, 0, 304, 310, 3, 98, 49, 0, 305, 310, 3, 10, 5, 0, 306, 310,
+		3, 12, 6, 0, 307, 310, 3, 70, 35, 0, 308, 310, 3, 72, 36, 0, 309, 281,
+		1, 0, 0, 0, 309, 282, 1, 0, 0, 0, 309, 283, 1, 0, 0, 0, 309, 284, 1, 0,
+		0, 0, 309, 285, 1, 0, 0, 0, 309, 286, 1, 0, 0, 0, 309, 287, 1, 0, 0, 0,
+		309, 288, 1, 0, 0, 0, 309, 289, 1, 0, 0, 0, 309, 290, 1, 0, 0, 0, 309,
+		291, 1, 0, 0, 0, 309, 292, 1, 0, 0, 0, 309, 293, 1, 0, 0, 0, 309, 294,
+		1, 0, 0, 0, 309, 295, 1, 0, 0, 0, 309, 296, 1, 0, 0, 0, 309, 297, 1, 0,
+		0, 0, 309, 298, 1, 0, 0, 0, 309, 299, 1, 0, 0, 0, 309, 300, 1, 0, 0, 0,
+		309, 301, 1, 0, 0, 0, 309, 302, 1, 0, 0, 0, 309, 303, 1, 0, 0, 0, 309,
+		304, 1, 0, 0, 0, 309, 305, 1, 0, 0, 0, 309, 306, 1, 0, 0, 0, 309, 307,
+		1, 0, 0, 0, 309, 308, 1, 0, 0, 0, 310, 7, 1, 0, 0, 0, 311, 312, 5, 25,
+		0, 0, 312, 313, 5, 44, 0, 0, 313, 9, 1, 0, 0, 0, 314, 315, 5, 25, 0, 0,
+		315, 316, 5, 102, 0, 0, 316, 11, 1, 0, 0, 0, 317, 318, 5, 25, 0, 0, 318,
+		319, 5, 103, 0, 0, 319, 320, 5, 72, 0, 0, 320, 321, 5, 104, 0, 0, 321,
+		322, 5, 136, 0, 0, 322, 323, 3, 120, 60, 0, 323, 13, 1, 0, 0, 0, 324, 325,
+		5, 23, 0, 0, 325, 326, 5, 75, 0, 0, 326, 327, 3, 120, 60, 0, 327, 15, 1,
+		0, 0, 0, 328, 329, 5, 25, 0, 0, 329, 330, 5, 48, 0, 0, 330, 17, 1, 0, 0,
+		0, 331, 332, 5, 25, 0, 0, 332, 333, 5, 52, 0, 0, 333, 19, 1, 0, 0, 0, 334,
+		335, 5, 25, 0, 0, 335, 336, 5, 73, 0, 0, 336, 21, 1, 0, 0, 0, 337, 338,
+		5, 25, 0, 0, 338, 339, 5, 45, 0, 0, 339, 340, 5, 46, 0, 0, 340, 23, 1,
+		0, 0, 0, 341, 342, 5, 25, 0, 0, 342, 343, 5, 51, 0, 0, 343, 344, 5, 45,
+		0, 0, 344, 345, 5, 71, 0, 0, 345, 346, 3, 122, 61, 0, 346, 347, 5, 72,
+		0, 0, 347, 348, 3, 142, 71, 0, 348, 25, 1, 0, 0, 0, 349, 350, 5, 25, 0,
+		0, 350, 351, 5, 50, 0, 0, 351, 352, 5, 45, 0, 0, 352, 353, 5, 71, 0, 0,
+		353, 354, 3, 122, 61, 0, 354, 355, 5, 72, 0, 0, 355, 358, 3, 142, 71, 0,
+		356, 357, 5, 80, 0, 0, 357, 359, 3, 138, 69, 0, 358, 356, 1, 0, 0, 0, 358,
+		359, 1, 0, 0, 0, 359, 27, 1, 0, 0, 0, 360, 361, 5, 25, 0, 0, 361, 362,
+		5, 44, 0, 0, 362, 363, 5, 45, 0, 0, 363, 364, 5, 71, 0, 0, 364, 365, 3,
+		122, 61, 0, 365, 366, 5, 72, 0, 0, 366, 367, 3, 142, 71, 0, 367, 29, 1,
+		0, 0, 0, 368, 369, 5, 25, 0, 0, 369, 370, 5, 49, 0, 0, 370, 371, 5, 45,
+		0, 0, 371, 372, 5, 71, 0, 0, 372, 373, 3, 122, 61, 0, 373, 376, 5, 72,
+		0, 0, 374, 377, 3, 136, 68, 0, 375, 377, 3, 142, 71, 0, 376, 374, 1, 0,
+		0, 0, 376, 375, 1, 0, 0, 0, 377, 378, 1, 0, 0, 0, 378, 381, 5, 80, 0, 0,
+		379, 382, 3, 136, 68, 0, 380, 382, 3, 142, 71, 0, 381, 379, 1, 0, 0, 0,
+		381, 380, 1, 0, 0, 0, 382, 31, 1, 0, 0, 0, 383, 384, 5, 25, 0, 0, 384,
+		385, 7, 0, 0, 0, 385, 386, 5, 53, 0, 0, 386, 33, 1, 0, 0, 0, 387, 388,
+		5, 25, 0, 0, 388, 389, 5, 14, 0, 0, 389, 392, 5, 72, 0, 0, 390, 393, 3,
+		136, 68, 0, 391, 393, 3, 140, 70, 0, 392, 390, 1, 0, 0, 0, 392, 391, 1,
+		0, 0, 0, 393, 394, 1, 0, 0, 0, 394, 397, 5, 80, 0, 0, 395, 398, 3, 136,
+		68, 0, 396, 398, 3, 140, 70, 0, 397, 395, 1, 0, 0, 0, 397, 396, 1, 0, 0,
+		0, 398, 35, 1, 0, 0, 0, 399, 400, 5, 25, 0, 0, 400, 401, 5, 15, 0, 0, 401,
+		402, 5, 55, 0, 0, 402, 405, 5, 72, 0, 0, 403, 406, 3, 136, 68, 0, 404,
+		406, 3, 140, 70, 0, 405, 403, 1, 0, 0, 0, 405, 404, 1, 0, 0, 0, 406, 407,
+		1, 0, 0, 0, 407, 410, 5, 80, 0, 0, 408, 411, 3, 136, 68, 0, 409, 411, 3,
+		140, 70, 0, 410, 408, 1, 0, 0, 0, 410, 409, 1, 0, 0, 0, 411, 37, 1, 0,
+		0, 0, 412, 413, 5, 25, 0, 0, 413, 414, 5, 16, 0, 0, 414, 39, 1, 0, 0, 0,
+		415, 416, 5, 25, 0, 0, 416, 417, 5, 17, 0, 0, 417, 418, 5, 18, 0, 0, 418,
+		421, 5, 72, 0, 0, 419, 422, 3, 136, 68, 0, 420, 422, 3, 140, 70, 0, 421,
+		419, 1, 0, 0, 0, 421, 420, 1, 0, 0, 0, 422, 423, 1, 0, 0, 0, 423, 426,
+		5, 80, 0, 0, 424, 427, 3, 136, 68, 0, 425, 427, 3, 140, 70, 0, 426, 424,
+		1, 0, 0, 0, 426, 425, 1, 0, 0, 0, 427, 41, 1, 0, 0, 0, 428, 429, 5, 25,
+		0, 0, 429, 430, 5, 51, 0, 0, 430, 431, 5, 61, 0, 0, 431, 432, 5, 72, 0,
+		0, 432, 433, 3, 162, 81, 0, 433, 43, 1, 0, 0, 0, 434, 435, 5, 25, 0, 0,
+		435, 436, 5, 50, 0, 0, 436, 437, 5, 61, 0, 0, 437, 438, 5, 72, 0, 0, 438,
+		439, 3, 162, 81, 0, 439, 45, 1, 0, 0, 0, 440, 441, 5, 25, 0, 0, 441, 442,
+		5, 49, 0, 0, 442, 443, 5, 61, 0, 0, 443, 446, 5, 72, 0, 0, 444, 447, 3,
+		136, 68, 0, 445, 447, 3, 162, 81, 0, 446, 444, 1, 0, 0, 0, 446, 445, 1,
+		0, 0, 0, 447, 448, 1, 0, 0, 0, 448, 451, 5, 80, 0, 0, 449, 452, 3, 136,
+		68, 0, 450, 452, 3, 162, 81, 0, 451, 449, 1, 0, 0, 0, 451, 450, 1, 0, 0,
+		0, 452, 47, 1, 0, 0, 0, 453, 454, 5, 6, 0, 0, 454, 455, 5, 49, 0, 0, 455,
+		456, 3, 220, 110, 0, 456, 49, 1, 0, 0, 0, 457, 458, 5, 6, 0, 0, 458, 459,
+		5, 50, 0, 0, 459, 460, 3, 220, 110, 0, 460, 51, 1, 0, 0, 0, 461, 462, 5,
+		26, 0, 0, 462, 463, 5, 49, 0, 0, 463, 464, 3, 108, 54, 0, 464, 53, 1, 0,
+		0, 0, 465, 466, 5, 27, 0, 0, 466, 467, 5, 49, 0, 0, 467, 468, 5, 59, 0,
+		0, 468, 471, 3, 116, 58, 0, 469, 470, 5, 72, 0, 0, 470, 472, 3, 136, 68,
+		0, 471, 469, 1, 0, 0, 0, 471, 472, 1, 0, 0, 0, 472, 55, 1, 0, 0, 0, 473,
+		474, 5, 28, 0, 0, 474, 475, 5, 29, 0, 0, 475, 476, 5, 24, 0, 0, 476, 477,
+		3, 106, 53, 0, 477, 478, 5, 13, 0, 0, 478, 482, 3, 118, 59, 0, 479, 480,
+		5, 30, 0, 0, 480, 481, 5, 59, 0, 0, 481, 483, 3, 116, 58, 0, 482, 479,
+		1, 0, 0, 0, 482, 483, 1, 0, 0, 0, 483, 57, 1, 0, 0, 0, 484, 485, 5, 25,
+		0, 0, 485, 486, 5, 54, 0, 0, 486, 59, 1, 0, 0, 0, 487, 488, 5, 6, 0, 0,
+		488, 489, 5, 55, 0, 0, 489, 490, 3, 220, 110, 0, 490, 61, 1, 0, 0, 0, 491,
+		492, 5, 9, 0, 0, 492, 493, 5, 55, 0, 0, 493, 494, 3, 106, 53, 0, 494, 63,
+		1, 0, 0, 0, 495, 496, 5, 9, 0, 0, 496, 497, 5, 61, 0, 0, 497, 500, 3, 238,
+		119, 0, 498, 499, 5, 24, 0, 0, 499, 501, 3, 104, 52, 0, 500, 498, 1, 0,
+		0, 0, 500, 501, 1, 0, 0, 0, 501, 65, 1, 0, 0, 0, 502, 503, 5, 10, 0, 0,
+		503, 504, 3, 144, 72, 0, 504, 505, 3, 154, 77, 0, 505, 67, 1, 0, 0, 0,
+		506, 507, 5, 25, 0, 0, 507, 508, 5, 56, 0, 0, 508, 69, 1, 0, 0, 0, 509,
+		510, 5, 25, 0, 0, 510, 511, 5, 31, 0, 0, 511, 71, 1, 0, 0, 0, 512, 513,
+		5, 25, 0, 0, 513, 514, 5, 33, 0, 0, 514, 73, 1, 0, 0, 0, 515, 516, 5, 6,
+		0, 0, 516, 517, 5, 32, 0, 0, 517, 518, 3, 110, 55, 0, 518, 519, 5, 68,
+		0, 0, 519, 520, 5, 35, 0, 0, 520, 521, 3, 114, 57, 0, 521, 75, 1, 0, 0,
+		0, 522, 523, 5, 9, 0, 0, 523, 524, 5, 32, 0, 0, 524, 525, 3, 110, 55, 0,
+		525, 77, 1, 0, 0, 0, 526, 527, 5, 9, 0, 0, 527, 528, 5, 34, 0, 0, 528,
+		529, 3, 112, 56, 0, 529, 79, 1, 0, 0, 0, 530, 531, 5, 36, 0, 0, 531, 532,
+		3, 88, 44, 0, 532, 533, 5, 24, 0, 0, 533, 534, 5, 55, 0, 0, 534, 535, 3,
+		106, 53, 0, 535, 536, 5, 30, 0, 0, 536, 537, 3, 112, 56, 0, 537, 81, 1,
+		0, 0, 0, 538, 539, 5, 37, 0, 0, 539, 540, 3, 88, 44, 0, 540, 541, 5, 24,
+		0, 0, 541, 542, 5, 55, 0, 0, 542, 543, 3, 106, 53, 0, 543, 544, 5, 71,
+		0, 0, 544, 545, 3, 112, 56, 0, 545, 83, 1, 0, 0, 0, 546, 547, 5, 36, 0,
+		0, 547, 548, 5, 34, 0, 0, 548, 549, 3, 112, 56, 0, 549, 550, 5, 30, 0,
+		0, 550, 551, 5, 32, 0, 0, 551, 552, 3, 110, 55, 0, 552, 85, 1, 0, 0, 0,
+		553, 554, 5, 37, 0, 0, 554, 555, 5, 34, 0, 0, 555, 556, 3, 112, 56, 0,
+		556, 557, 5, 71, 0, 0, 557, 558, 5, 32, 0, 0, 558, 559, 3, 110, 55, 0,
+		559, 87, 1, 0, 0, 0, 560, 561, 7, 1, 0, 0, 561, 89, 1, 0, 0, 0, 562, 563,
+		5, 25, 0, 0, 563, 568, 5, 58, 0, 0, 564, 565, 5, 72, 0, 0, 565, 566, 5,
+		57, 0, 0, 566, 567, 5, 136, 0, 0, 567, 569, 3, 100, 50, 0, 568, 564, 1,
+		0, 0, 0, 568, 569, 1, 0, 0, 0, 569, 571, 1, 0, 0, 0, 570, 572, 3, 236,
+		118, 0, 571, 570, 1, 0, 0, 0, 571, 572, 1, 0, 0, 0, 572, 91, 1, 0, 0, 0,
+		573, 574, 5, 25, 0, 0, 574, 577, 5, 60, 0, 0, 575, 576, 5, 24, 0, 0, 576,
+		578, 3, 104, 52, 0, 577, 575, 1, 0, 0, 0, 577, 578, 1, 0, 0, 0, 578, 583,
+		1, 0, 0, 0, 579, 580, 5, 72, 0, 0, 580, 581, 5, 61, 0, 0, 581, 582, 5,
+		136, 0, 0, 582, 584, 3, 100, 50, 0, 583, 579, 1, 0, 0, 0, 583, 584, 1,
+		0, 0, 0, 584, 586, 1, 0, 0, 0, 585, 587, 3, 236, 118, 0, 586, 585, 1, 0,
+		0, 0, 586, 587, 1, 0, 0, 0, 587, 93, 1, 0, 0, 0, 588, 589, 5, 25, 0, 0,
+		589, 590, 5, 63, 0, 0, 590, 591, 3, 144, 72, 0, 591, 95, 1, 0, 0, 0, 592,
+		593, 5, 25, 0, 0, 593, 594, 5, 64, 0, 0, 594, 595, 5, 66, 0, 0, 595, 596,
+		3, 144, 72, 0, 596, 97, 1, 0, 0, 0, 597, 598, 5, 25, 0, 0, 598, 599, 5,
+		64, 0, 0, 599, 600, 5, 69, 0, 0, 600, 601, 3, 144, 72, 0, 601, 602, 5,
+		68, 0, 0, 602, 603, 5, 67, 0, 0, 603, 604, 5, 136, 0, 0, 604, 606, 3, 102,
+		51, 0, 605, 607, 3, 154, 77, 0, 606, 605, 1, 0, 0, 0, 606, 607, 1, 0, 0,
+		0, 607, 609, 1, 0, 0, 0, 608, 610, 3, 236, 118, 0, 609, 608, 1, 0, 0, 0,
+		609, 610, 1, 0, 0, 0, 610, 99, 1, 0, 0, 0, 611, 612, 3, 244, 122, 0, 612,
+		101, 1, 0, 0, 0, 613, 614, 3, 244, 122, 0, 614, 103, 1, 0, 0, 0, 615, 616,
+		3, 244, 122, 0, 616, 105, 1, 0, 0, 0, 617, 618, 3, 244, 122, 0, 618, 107,
+		1, 0, 0, 0, 619, 620, 3, 244, 122, 0, 620, 109, 1, 0, 0, 0, 621, 622, 3,
+		244, 122, 0, 622, 111, 1, 0, 0, 0, 623, 624, 3, 244, 122, 0, 624, 113,
+		1, 0, 0, 0, 625, 626, 3, 244, 122, 0, 626, 115, 1, 0, 0, 0, 627, 628, 5,
+		159, 0, 0, 628, 117, 1, 0, 0, 0, 629, 630, 5, 159, 0, 0, 630, 119, 1, 0,
+		0, 0, 631, 632, 3, 244, 122, 0, 632, 121, 1, 0, 0, 0, 633, 634, 7, 2, 0,
+		0, 634, 123, 1, 0, 0, 0, 635, 637, 5, 76, 0, 0, 636, 635, 1, 0, 0, 0, 636,
+		637, 1, 0, 0, 0, 637, 638, 1, 0, 0, 0, 638, 640, 3, 126, 63, 0, 639, 641,
+		3, 154, 77, 0, 640, 639, 1, 0, 0, 0, 640, 641, 1, 0, 0, 0, 641, 643, 1,
+		0, 0, 0, 642, 644, 3, 174, 87, 0, 643, 642, 1, 0, 0, 0, 643, 644, 1, 0,
+		0, 0, 644, 646, 1, 0, 0, 0, 645, 647, 3, 184, 92, 0, 646, 645, 1, 0, 0,
+		0, 646, 647, 1, 0, 0, 0, 647, 649, 1, 0, 0, 0, 648, 650, 3, 236, 118, 0,
+		649, 648, 1, 0, 0, 0, 649, 650, 1, 0, 0, 0, 650, 652, 1, 0, 0, 0, 651,
+		653, 5, 77, 0, 0, 652, 651, 1, 0, 0, 0, 652, 653, 1, 0, 0, 0, 653, 125,
+		1, 0, 0, 0, 654, 655, 3, 128, 64, 0, 655, 656, 3, 146, 73, 0, 656, 661,
+		1, 0, 0, 0, 657, 658, 3, 146, 73, 0, 658, 659, 3, 128, 64, 0, 659, 661,
+		1, 0, 0, 0, 660, 654, 1, 0, 0, 0, 660, 657, 1, 0, 0, 0, 661, 127, 1, 0,
+		0, 0, 662, 663, 5, 78, 0, 0, 663, 664, 3, 130, 65, 0, 664, 129, 1, 0, 0,
+		0, 665, 670, 3, 132, 66, 0, 666, 667, 5, 145, 0, 0, 667, 669, 3, 132, 66,
+		0, 668, 666, 1, 0, 0, 0, 669, 672, 1, 0, 0, 0, 670, 668, 1, 0, 0, 0, 670,
+		671, 1, 0, 0, 0, 671, 131, 1, 0, 0, 0, 672, 670, 1, 0, 0, 0, 673, 675,
+		3, 202, 101, 0, 674, 676, 3, 134, 67, 0, 675, 674, 1, 0, 0, 0, 675, 676,
+		1, 0, 0, 0, 676, 133, 1, 0, 0, 0, 677, 678, 5, 79, 0, 0, 678, 679, 3, 244,
+		122, 0, 679, 135, 1, 0, 0, 0, 680, 681, 5, 49, 0, 0, 681, 682, 5, 136,
+		0, 0, 682, 683, 3, 244, 122, 0, 683, 137, 1, 0, 0, 0, 684, 685, 5, 50,
+		0, 0, 685, 686, 5, 136, 0, 0, 686, 687, 3, 244, 122, 0, 687, 139, 1, 0,
+		0, 0, 688, 689, 5, 55, 0, 0, 689, 690, 5, 136, 0, 0, 690, 691, 3, 244,
+		122, 0, 691, 141, 1, 0, 0, 0, 692, 693, 5, 47, 0, 0, 693, 694, 5, 136,
+		0, 0, 694, 695, 3, 244, 122, 0, 695, 143, 1, 0, 0, 0, 696, 697, 5, 71,
+		0, 0, 697, 700, 3, 238, 119, 0, 698, 699, 5, 24, 0, 0, 699, 701, 3, 104,
+		52, 0, 700, 698, 1, 0, 0, 0, 700, 701, 1, 0, 0, 0, 701, 145, 1, 0, 0, 0,
+		702, 716, 5, 71, 0, 0, 703, 708, 3, 150, 75, 0, 704, 705, 5, 145, 0, 0,
+		705, 707, 3, 150, 75, 0, 706, 704, 1, 0, 0, 0, 707, 710, 1, 0, 0, 0, 708,
+		706, 1, 0, 0, 0, 708, 709, 1, 0, 0, 0, 709, 713, 1, 0, 0, 0, 710, 708,
+		1, 0, 0, 0, 711, 712, 5, 24, 0, 0, 712, 714, 3, 104, 52, 0, 713, 711, 1,
+		0, 0, 0, 713, 714, 1, 0, 0, 0, 714, 717, 1, 0, 0, 0, 715, 717, 3, 148,
+		74, 0, 716, 703, 1, 0, 0, 0, 716, 715, 1, 0, 0, 0, 717, 147, 1, 0, 0, 0,
+		718, 719, 5, 150, 0, 0, 719, 720, 3, 124, 62, 0, 720, 721, 5, 151, 0, 0,
+		721, 149, 1, 0, 0, 0, 722, 724, 3, 238, 119, 0, 723, 725, 3, 152, 76, 0,
+		724, 723, 1, 0, 0, 0, 724, 725, 1, 0, 0, 0, 725, 151, 1, 0, 0, 0, 726,
+		727, 5, 79, 0, 0, 727, 728, 3, 244, 122, 0, 728, 153, 1, 0, 0, 0, 729,
+		730, 5, 72, 0, 0, 730, 731, 3, 156, 78, 0, 731, 155, 1, 0, 0, 0, 732, 743,
+		3, 158, 79, 0, 733, 734, 3, 158, 79, 0, 734, 735, 5, 80, 0, 0, 735, 736,
+		3, 166, 83, 0, 736, 743, 1, 0, 0, 0, 737, 740, 3, 166, 83, 0, 738, 739,
+		5, 80, 0, 0, 739, 741, 3, 158, 79, 0, 740, 738, 1, 0, 0, 0, 740, 741, 1,
+		0, 0, 0, 741, 743, 1, 0, 0, 0, 742, 732, 1, 0, 0, 0, 742, 733, 1, 0, 0,
+		0, 742, 737, 1, 0, 0, 0, 743, 157, 1, 0, 0, 0, 744, 745, 6, 79, -1, 0,
+		745, 746, 5, 150, 0, 0, 746, 747, 3, 158, 79, 0, 747, 748, 5, 151, 0, 0,
+		748, 773, 1, 0, 0, 0, 749, 758, 3, 240, 120, 0, 750, 759, 5, 136, 0, 0,
+		751, 759, 5, 88, 0, 0, 752, 753, 5, 89, 0, 0, 753, 759, 5, 88, 0, 0, 754,
+		759, 5, 143, 0, 0, 755, 759, 5, 144, 0, 0, 756, 759, 5, 137, 0, 0, 757,
+		759, 5, 138, 0, 0, 758, 750, 1, 0, 0, 0, 758, 751, 1, 0, 0, 0, 758, 752,
+		1, 0, 0, 0, 758, 754, 1, 0, 0, 0, 758, 755, 1, 0, 0, 0, 758, 756, 1, 0,
+		0, 0, 758, 757, 1, 0, 0, 0, 759, 760, 1, 0, 0, 0, 760, 761, 3, 242, 121,
+		0, 761, 773, 1, 0, 0, 0, 762, 766, 3, 240, 120, 0, 763, 767, 5, 99, 0,
+		0, 764, 765, 5, 89, 0, 0, 765, 767, 5, 99, 0, 0, 766, 763, 1, 0, 0, 0,
+		766, 764, 1, 0, 0, 0, 767, 768, 1, 0, 0, 0, 768, 769, 5, 150, 0, 0, 769,
+		770, 3, 160, 80, 0, 770, 771, 5, 151, 0, 0, 771, 773, 1, 0, 0, 0, 772,
+		744, 1, 0, 0, 0, 772, 749, 1, 0, 0, 0, 772, 762, 1, 0, 0, 0, 773, 779,
+		1, 0, 0, 0, 774, 775, 10, 1, 0, 0, 775, 776, 7, 3, 0, 0, 776, 778, 3, 158,
+		79, 2, 777, 774, 1, 0, 0, 0, 778, 781, 1, 0, 0, 0, 779, 777, 1, 0, 0, 0,
+		779, 780, 1, 0, 0, 0, 780, 159, 1, 0, 0, 0, 781, 779, 1, 0, 0, 0, 782,
+		787, 3, 242, 121, 0, 783, 784, 5, 145, 0, 0, 784, 786, 3, 242, 121, 0,
+		785, 783, 1, 0, 0, 0, 786, 789, 1, 0, 0, 0, 787, 785, 1, 0, 0, 0, 787,
+		788, 1, 0, 0, 0, 788, 161, 1, 0, 0, 0, 789, 787, 1, 0, 0, 0, 790, 791,
+		5, 61, 0, 0, 791, 792, 5, 99, 0, 0, 792, 793, 5, 150, 0, 0, 793, 794, 3,
+		164, 82, 0, 794, 795, 5, 151, 0, 0, 795, 163, 1, 0, 0, 0, 796, 801, 3,
+		244, 122, 0, 797, 798, 5, 145, 0, 0, 798, 800, 3, 244, 122, 0, 799, 797,
+		1, 0, 0, 0, 800, 803, 1, 0, 0, 0, 801, 799, 1, 0, 0, 0, 801, 802, 1, 0,
+		0, 0, 802, 165, 1, 0, 0, 0, 803, 801, 1, 0, 0, 0, 804, 807, 3, 168, 84,
+		0, 805, 806, 5, 80, 0, 0, 806, 808, 3, 168, 84, 0, 807, 805, 1, 0, 0, 0,
+		807, 808, 1, 0, 0, 0, 808, 167, 1, 0, 0, 0, 809, 810, 5, 97, 0, 0, 810,
+		813, 3, 200, 100, 0, 811, 814, 3, 170, 85, 0, 812, 814, 3, 244, 122, 0,
+		813, 811, 1, 0, 0, 0, 813, 812, 1, 0, 0, 0, 814, 169, 1, 0, 0, 0, 815,
+		817, 3, 172, 86, 0, 816, 818, 3, 204, 102, 0, 817, 816, 1, 0, 0, 0, 817,
+		818, 1, 0, 0, 0, 818, 171, 1, 0, 0, 0, 819, 820, 5, 98, 0, 0, 820, 822,
+		5, 150, 0, 0, 821, 823, 3, 212, 106, 0, 822, 821, 1, 0, 0, 0, 822, 823,
+		1, 0, 0, 0, 823, 824, 1, 0, 0, 0, 824, 825, 5, 151, 0, 0, 825, 173, 1,
+		0, 0, 0, 826, 827, 5, 92, 0, 0, 827, 828, 5, 94, 0, 0, 828, 834, 3, 176,
+		88, 0, 829, 830, 5, 82, 0, 0, 830, 831, 5, 150, 0, 0, 831, 832, 3, 180,
+		90, 0, 832, 833, 5, 151, 0, 0, 833, 835, 1, 0, 0, 0, 834, 829, 1, 0, 0,
+		0, 834, 835, 1, 0, 0, 0, 835, 837, 1, 0, 0, 0, 836, 838, 3, 190, 95, 0,
+		837, 836, 1, 0, 0, 0, 837, 838, 1, 0, 0, 0, 838, 840, 1, 0, 0, 0, 839,
+		841, 3, 182, 91, 0, 840, 839, 1, 0, 0, 0, 840, 841, 1, 0, 0, 0, 841, 175,
+		1, 0, 0, 0, 842, 847, 3, 178, 89, 0, 843, 844, 5, 145, 0, 0, 844, 846,
+		3, 178, 89, 0, 845, 843, 1, 0, 0, 0, 846, 849, 1, 0, 0, 0, 847, 845, 1,
+		0, 0, 0, 847, 848, 1, 0, 0, 0, 848, 177, 1, 0, 0, 0, 849, 847, 1, 0, 0,
+		0, 850, 857, 3, 244, 122, 0, 851, 852, 5, 97, 0, 0, 852, 853, 5, 150, 0,
+		0, 853, 854, 3, 204, 102, 0, 854, 855, 5, 151, 0, 0, 855, 857, 1, 0, 0,
+		0, 856, 850, 1, 0, 0, 0, 856, 851, 1, 0, 0, 0, 857, 179, 1, 0, 0, 0, 858,
+		859, 7, 4, 0, 0, 859, 181, 1, 0, 0, 0, 860, 861, 5, 126, 0, 0, 861, 862,
+		5, 79, 0, 0, 862, 863, 3, 244, 122, 0, 863, 183, 1, 0, 0, 0, 864, 865,
+		5, 85, 0, 0, 865, 866, 5, 94, 0, 0, 866, 867, 3, 188, 94, 0, 867, 185,
+		1, 0, 0, 0, 868, 872, 3, 202, 101, 0, 869, 871, 7, 5, 0, 0, 870, 869, 1,
+		0, 0, 0, 871, 874, 1, 0, 0, 0, 872, 870, 1, 0, 0, 0, 872, 873, 1, 0, 0,
+		0, 873, 187, 1, 0, 0, 0, 874, 872, 1, 0, 0, 0, 875, 880, 3, 186, 93, 0,
+		876, 877, 5, 145, 0, 0, 877, 879, 3, 186, 93, 0, 878, 876, 1, 0, 0, 0,
+		879, 882, 1, 0, 0, 0, 880, 878, 1, 0, 0, 0, 880, 881, 1, 0, 0, 0, 881,
+		189, 1, 0, 0, 0, 882, 880, 1, 0, 0, 0, 883, 884, 5, 93, 0, 0, 884, 885,
+		3, 192, 96, 0, 885, 191, 1, 0, 0, 0, 886, 887, 6, 96, -1, 0, 887, 888,
+		5, 150, 0, 0, 888, 889, 3, 192, 96, 0, 889, 890, 5, 151, 0, 0, 890, 893,
+		1, 0, 0, 0, 891, 893, 3, 196, 98, 0, 892, 886, 1, 0, 0, 0, 892, 891, 1,
+		0, 0, 0, 893, 900, 1, 0, 0, 0, 894, 895, 10, 2, 0, 0, 895, 896, 3, 194,
+		97, 0, 896, 897, 3, 192, 96, 3, 897, 899, 1, 0, 0, 0, 898, 894, 1, 0, 0,
+		0, 899, 902, 1, 0, 0, 0, 900, 898, 1, 0, 0, 0, 900, 901, 1, 0, 0, 0, 901,
+		193, 1, 0, 0, 0, 902, 900, 1, 0, 0, 0, 903, 904, 7, 3, 0, 0, 904, 195,
+		1, 0, 0, 0, 905, 906, 3, 198, 99, 0, 906, 197, 1, 0, 0, 0, 907, 908, 3,
+		202, 101, 0, 908, 909, 3, 200, 100, 0, 909, 910, 3, 202, 101, 0, 910, 199,
+		1, 0, 0, 0, 911, 920, 5, 136, 0, 0, 912, 920, 5, 137, 0, 0, 913, 920, 5,
+		138, 0, 0, 914, 920, 5, 141, 0, 0, 915, 920, 5, 142, 0, 0, 916, 920, 5,
+		139, 0, 0, 917, 920, 5, 140, 0, 0, 918, 920, 7, 6, 0, 0, 919, 911, 1, 0,
+		0, 0, 919, 912, 1, 0, 0, 0, 919, 913, 1, 0, 0, 0, 919, 914, 1, 0, 0, 0,
+		919, 915, 1, 0, 0, 0, 919, 916, 1, 0, 0, 0, 919, 917, 1, 0, 0, 0, 919,
+		918, 1, 0, 0, 0, 920, 201, 1, 0, 0, 0, 921, 922, 6, 101, -1, 0, 922, 923,
+		5, 150, 0, 0, 923, 924, 3, 202, 101, 0, 924, 925, 5, 151, 0, 0, 925, 930,
+		1, 0, 0, 0, 926, 930, 3, 208, 104, 0, 927, 930, 3, 216, 108, 0, 928, 930,
+		3, 204, 102, 0, 929, 921, 1, 0, 0, 0, 929, 926, 1, 0, 0, 0, 929, 927, 1,
+		0, 0, 0, 929, 928, 1, 0, 0, 0, 930, 945, 1, 0, 0, 0, 931, 932, 10, 8, 0,
+		0, 932, 933, 5, 155, 0, 0, 933, 944, 3, 202, 101, 9, 934, 935, 10, 7, 0,
+		0, 935, 936, 5, 154, 0, 0, 936, 944, 3, 202, 101, 8, 937, 938, 10, 6, 0,
+		0, 938, 939, 5, 152, 0, 0, 939, 944, 3, 202, 101, 7, 940, 941, 10, 5, 0,
+		0, 941, 942, 5, 153, 0, 0, 942, 944, 3, 202, 101, 6, 943, 931, 1, 0, 0,
+		0, 943, 934, 1, 0, 0, 0, 943, 937, 1, 0, 0, 0, 943, 940, 1, 0, 0, 0, 944,
+		947, 1, 0, 0, 0, 945, 943, 1, 0, 0, 0, 945, 946, 1, 0, 0, 0, 946, 203,
+		1, 0, 0, 0, 947, 945, 1, 0, 0, 0, 948, 949, 3, 232, 116, 0, 949, 950, 3,
+		206, 103, 0, 950, 205, 1, 0, 0, 0, 951, 952, 7, 7, 0, 0, 952, 207, 1, 0,
+		0, 0, 953, 954, 3, 210, 105, 0, 954, 956, 5, 150, 0, 0, 955, 957, 3, 212,
+		106, 0, 956, 955, 1, 0, 0, 0, 956, 957, 1, 0, 0, 0, 957, 958, 1, 0, 0,
+		0, 958, 959, 5, 151, 0, 0, 959, 209, 1, 0, 0, 0, 960, 961, 7, 8, 0, 0,
+		961, 211, 1, 0, 0, 0, 962, 967, 3, 214, 107, 0, 963, 964, 5, 145, 0, 0,
+		964, 966, 3, 214, 107, 0, 965, 963, 1, 0, 0, 0, 966, 969, 1, 0, 0, 0, 967,
+		965, 1, 0, 0, 0, 967, 968, 1, 0, 0, 0, 968, 213, 1, 0, 0, 0, 969, 967,
+		1, 0, 0, 0, 970, 973, 3, 202, 101, 0, 971, 973, 3, 158, 79, 0, 972, 970,
+		1, 0, 0, 0, 972, 971, 1, 0, 0, 0, 973, 215, 1, 0, 0, 0, 974, 976, 3, 244,
+		122, 0, 975, 977, 3, 218, 109, 0, 976, 975, 1, 0, 0, 0, 976, 977, 1, 0,
+		0, 0, 977, 981, 1, 0, 0, 0, 978, 981, 3, 234, 117, 0, 979, 981, 3, 232,
+		116, 0, 980, 974, 1, 0, 0, 0, 980, 978, 1, 0, 0, 0, 980, 979, 1, 0, 0,
+		0, 981, 217, 1, 0, 0, 0, 982, 983, 5, 148, 0, 0, 983, 984, 3, 158, 79,
+		0, 984, 985, 5, 149, 0, 0, 985, 219, 1, 0, 0, 0, 986, 987, 3, 230, 115,
+		0, 987, 221, 1, 0, 0, 0, 988, 989, 3, 244, 122, 0, 989, 223, 1, 0, 0, 0,
+		990, 991, 5, 146, 0, 0, 991, 996, 3, 226, 113, 0, 992, 993, 5, 145, 0,
+		0, 993, 995, 3, 226, 113, 0, 994, 992, 1, 0, 0, 0, 995, 998, 1, 0, 0, 0,
+		996, 994, 1, 0, 0, 0, 996, 997, 1, 0, 0, 0, 997, 999, 1, 0, 0, 0, 998,
+		996, 1, 0, 0, 0, 999, 1000, 5, 147, 0, 0, 1000, 1004, 1, 0, 0, 0, 1001,
+		1002, 5, 146, 0, 0, 1002, 1004, 5, 147, 0, 0, 1003, 990, 1, 0, 0, 0, 1003,
+		1001, 1, 0, 0, 0, 1004, 225, 1, 0, 0, 0, 1005, 1006, 5, 4, 0, 0, 1006,
+		1007, 5, 135, 0, 0, 1007, 1008, 3, 230, 115, 0, 1008, 227, 1, 0, 0, 0,
+		1009, 1010, 5, 148, 0, 0, 1010, 1015, 3, 230, 115, 0, 1011, 1012, 5, 145,
+		0, 0, 1012, 1014, 3, 230, 115, 0, 1013, 1011, 1, 0, 0, 0, 1014, 1017, 1,
+		0, 0, 0, 1015, 1013, 1, 0, 0, 0, 1015, 1016, 1, 0, 0, 0, 1016, 1018, 1,
+		0, 0, 0, 1017, 1015, 1, 0, 0, 0, 1018, 1019, 5, 149, 0, 0, 1019, 1023,
+		1, 0, 0, 0, 1020, 1021, 5, 148, 0, 0, 1021, 1023, 5, 149, 0, 0, 1022, 1009,
+		1, 0, 0, 0, 1022, 1020, 1, 0, 0, 0, 1023, 229, 1, 0, 0, 0, 1024, 1033,
+		5, 4, 0, 0, 1025, 1033, 3, 232, 116, 0, 1026, 1033, 3, 234, 117, 0, 1027,
+		1033, 3, 224, 112, 0, 1028, 1033, 3, 228, 114, 0, 1029, 1033, 5, 1, 0,
+		0, 1030, 1033, 5, 2, 0, 0, 1031, 1033, 5, 3, 0, 0, 1032, 1024, 1, 0, 0,
+		0, 1032, 1025, 1, 0, 0, 0, 1032, 1026, 1, 0, 0, 0, 1032, 1027, 1, 0, 0,
+		0, 1032, 1028, 1, 0, 0, 0, 1032, 1029, 1, 0, 0, 0, 1032, 1030, 1, 0, 0,
+		0, 1032, 1031, 1, 0, 0, 0, 1033, 231, 1, 0, 0, 0, 1034, 1036, 7, 9, 0,
+		0, 1035, 1034, 1, 0, 0, 0, 1035, 1036, 1, 0, 0, 0, 1036, 1037, 1, 0, 0,
+		0, 1037, 1038, 5, 159, 0, 0, 1038, 233, 1, 0, 0, 0, 1039, 1041, 7, 9, 0,
+		0, 1040, 1039, 1, 0, 0, 0, 1040, 1041, 1, 0, 0, 0, 1041, 1042, 1, 0, 0,
+		0, 1042, 1043, 5, 160, 0, 0, 1043, 235, 1, 0, 0, 0, 1044, 1045, 5, 73,
+		0, 0, 1045, 1046, 5, 159, 0, 0, 1046, 237, 1, 0, 0, 0, 1047, 1048, 3, 244,
+		122, 0, 1048, 239, 1, 0, 0, 0, 1049, 1050, 3, 244, 122, 0, 1050, 241, 1,
+		0, 0, 0, 1051, 1052, 3, 244, 122, 0, 1052, 243, 1, 0, 0, 0, 1053, 1056,
+		5, 158, 0, 0, 1054, 1056, 3, 246, 123, 0, 1055, 1053, 1, 0, 0, 0, 1055,
+		1054, 1, 0, 0, 0, 1056, 1064, 1, 0, 0, 0, 1057, 1060, 5, 134, 0, 0, 1058,
+		1061, 5, 158, 0, 0, 1059, 1061, 3, 246, 123, 0, 1060, 1058, 1, 0, 0, 0,
+		1060, 1059, 1, 0, 0, 0, 1061, 1063, 1, 0, 0, 0, 1062, 1057, 1, 0, 0, 0,
+		1063, 1066, 1, 0, 0, 0, 1064, 1062, 1, 0, 0, 0, 1064, 1065, 1, 0, 0, 0,
+		1065, 245, 1, 0, 0, 0, 1066, 1064, 1, 0, 0, 0, 1067, 1068, 7, 10, 0, 0,
+		1068, 247, 1, 0, 0, 0, 77, 272, 309, 358, 376, 381, 392, 397, 405, 410,
+		421, 426, 446, 451, 471, 482, 500, 568, 571, 577, 583, 586, 606, 609, 636,
+		640, 643, 646, 649, 652, 660, 670, 675, 700, 708, 713, 716, 724, 740, 742,
+		758, 766, 772, 779, 787, 801, 807, 813, 817, 822, 834, 837, 840, 847, 856,
+		872, 880, 892, 900, 919, 929, 943, 945, 956, 967, 972, 976, 980, 996, 1003,
+		1015, 1022, 1032, 1035, 1040, 1055, 1060, 1064,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -528,228 +642,285 @@ const (
 	SQLParserT_UPDATE        = 7
 	SQLParserT_SET           = 8
 	SQLParserT_DROP          = 9
-	SQLParserT_INTERVAL      = 10
-	SQLParserT_INTERVAL_NAME = 11
-	SQLParserT_SHARD         = 12
-	SQLParserT_REPLICATION   = 13
-	SQLParserT_MEMORY        = 14
-	SQLParserT_TTL           = 15
-	SQLParserT_META_TTL      = 16
-	SQLParserT_PAST_TTL      = 17
-	SQLParserT_FUTURE_TTL    = 18
-	SQLParserT_KILL          = 19
-	SQLParserT_ON            = 20
-	SQLParserT_SHOW          = 21
-	SQLParserT_RECOVER       = 22
-	SQLParserT_USE           = 23
-	SQLParserT_STATE_REPO    = 24
-	SQLParserT_STATE_MACHINE = 25
-	SQLParserT_MASTER        = 26
-	SQLParserT_METADATA      = 27
-	SQLParserT_TYPES         = 28
-	SQLParserT_TYPE          = 29
-	SQLParserT_STORAGES      = 30
-	SQLParserT_STORAGE       = 31
-	SQLParserT_BROKER        = 32
-	SQLParserT_ROOT          = 33
-	SQLParserT_BROKERS       = 34
-	SQLParserT_ALIVE         = 35
-	SQLParserT_SCHEMAS       = 36
-	SQLParserT_DATASBAE      = 37
-	SQLParserT_DATASBAES     = 38
-	SQLParserT_NAMESPACE     = 39
-	SQLParserT_NAMESPACES    = 40
-	SQLParserT_NODE          = 41
-	SQLParserT_METRICS       = 42
-	SQLParserT_METRIC        = 43
-	SQLParserT_FIELD         = 44
-	SQLParserT_FIELDS        = 45
-	SQLParserT_TAG           = 46
-	SQLParserT_INFO          = 47
-	SQLParserT_KEYS          = 48
-	SQLParserT_KEY           = 49
-	SQLParserT_WITH          = 50
-	SQLParserT_VALUES        = 51
-	SQLParserT_VALUE         = 52
-	SQLParserT_FROM          = 53
-	SQLParserT_WHERE         = 54
-	SQLParserT_LIMIT         = 55
-	SQLParserT_QUERIES       = 56
-	SQLParserT_QUERY         = 57
-	SQLParserT_EXPLAIN       = 58
-	SQLParserT_WITH_VALUE    = 59
-	SQLParserT_SELECT        = 60
-	SQLParserT_AS            = 61
-	SQLParserT_AND           = 62
-	SQLParserT_OR            = 63
-	SQLParserT_FILL          = 64
-	SQLParserT_NULL          = 65
-	SQLParserT_PREVIOUS      = 66
-	SQLParserT_ORDER         = 67
-	SQLParserT_ASC           = 68
-	SQLParserT_DESC          = 69
-	SQLParserT_LIKE          = 70
-	SQLParserT_NOT           = 71
-	SQLParserT_BETWEEN       = 72
-	SQLParserT_IS            = 73
-	SQLParserT_GROUP         = 74
-	SQLParserT_HAVING        = 75
-	SQLParserT_BY            = 76
-	SQLParserT_FOR           = 77
-	SQLParserT_STATS         = 78
-	SQLParserT_TIME          = 79
-	SQLParserT_NOW           = 80
-	SQLParserT_IN            = 81
-	SQLParserT_LOG           = 82
-	SQLParserT_PROFILE       = 83
-	SQLParserT_REQUESTS      = 84
-	SQLParserT_REQUEST       = 85
-	SQLParserT_ID            = 86
-	SQLParserT_SUM           = 87
-	SQLParserT_MIN           = 88
-	SQLParserT_MAX           = 89
-	SQLParserT_COUNT         = 90
-	SQLParserT_LAST          = 91
-	SQLParserT_FIRST         = 92
-	SQLParserT_AVG           = 93
-	SQLParserT_STDDEV        = 94
-	SQLParserT_QUANTILE      = 95
-	SQLParserT_RATE          = 96
-	SQLParserT_SECOND        = 97
-	SQLParserT_MINUTE        = 98
-	SQLParserT_HOUR          = 99
-	SQLParserT_DAY           = 100
-	SQLParserT_WEEK          = 101
-	SQLParserT_MONTH         = 102
-	SQLParserT_YEAR          = 103
-	SQLParserT_DOT           = 104
-	SQLParserT_COLON         = 105
-	SQLParserT_EQUAL         = 106
-	SQLParserT_NOTEQUAL      = 107
-	SQLParserT_NOTEQUAL2     = 108
-	SQLParserT_GREATER       = 109
-	SQLParserT_GREATEREQUAL  = 110
-	SQLParserT_LESS          = 111
-	SQLParserT_LESSEQUAL     = 112
-	SQLParserT_REGEXP        = 113
-	SQLParserT_NEQREGEXP     = 114
-	SQLParserT_COMMA         = 115
-	SQLParserT_OPEN_B        = 116
-	SQLParserT_CLOSE_B       = 117
-	SQLParserT_OPEN_SB       = 118
-	SQLParserT_CLOSE_SB      = 119
-	SQLParserT_OPEN_P        = 120
-	SQLParserT_CLOSE_P       = 121
-	SQLParserT_ADD           = 122
-	SQLParserT_SUB           = 123
-	SQLParserT_DIV           = 124
-	SQLParserT_MUL           = 125
-	SQLParserT_MOD           = 126
-	SQLParserT_UNDERLINE     = 127
-	SQLParserL_ID            = 128
-	SQLParserL_INT           = 129
-	SQLParserL_DEC           = 130
+	SQLParserT_DELETE        = 10
+	SQLParserT_INTERVAL      = 11
+	SQLParserT_INTERVAL_NAME = 12
+	SQLParserT_SHARD         = 13
+	SQLParserT_REPLICATION   = 14
+	SQLParserT_MEMORY        = 15
+	SQLParserT_REBALANCE     = 16
+	SQLParserT_REPLICA       = 17
+	SQLParserT_CONSISTENCY   = 18
+	SQLParserT_TTL           = 19
+	SQLParserT_META_TTL      = 20
+	SQLParserT_PAST_TTL      = 21
+	SQLParserT_FUTURE_TTL    = 22
+	SQLParserT_KILL          = 23
+	SQLParserT_ON            = 24
+	SQLParserT_SHOW          = 25
+	SQLParserT_RECOVER       = 26
+	SQLParserT_DECOMMISSION  = 27
+	SQLParserT_TRANSFER      = 28
+	SQLParserT_LEADER        = 29
+	SQLParserT_TO            = 30
+	SQLParserT_USERS         = 31
+	SQLParserT_USER          = 32
+	SQLParserT_ROLES         = 33
+	SQLParserT_ROLE          = 34
+	SQLParserT_PASSWORD      = 35
+	SQLParserT_GRANT         = 36
+	SQLParserT_REVOKE        = 37
+	SQLParserT_READ          = 38
+	SQLParserT_WRITE         = 39
+	SQLParserT_ADMIN         = 40
+	SQLParserT_USE           = 41
+	SQLParserT_STATE_REPO    = 42
+	SQLParserT_STATE_MACHINE = 43
+	SQLParserT_MASTER        = 44
+	SQLParserT_METADATA      = 45
+	SQLParserT_TYPES         = 46
+	SQLParserT_TYPE          = 47
+	SQLParserT_STORAGES      = 48
+	SQLParserT_STORAGE       = 49
+	SQLParserT_BROKER        = 50
+	SQLParserT_ROOT          = 51
+	SQLParserT_BROKERS       = 52
+	SQLParserT_ALIVE         = 53
+	SQLParserT_SCHEMAS       = 54
+	SQLParserT_DATASBAE      = 55
+	SQLParserT_DATASBAES     = 56
+	SQLParserT_NAMESPACE     = 57
+	SQLParserT_NAMESPACES    = 58
+	SQLParserT_NODE          = 59
+	SQLParserT_METRICS       = 60
+	SQLParserT_METRIC        = 61
+	SQLParserT_FIELD         = 62
+	SQLParserT_FIELDS        = 63
+	SQLParserT_TAG           = 64
+	SQLParserT_INFO          = 65
+	SQLParserT_KEYS          = 66
+	SQLParserT_KEY           = 67
+	SQLParserT_WITH          = 68
+	SQLParserT_VALUES        = 69
+	SQLParserT_VALUE         = 70
+	SQLParserT_FROM          = 71
+	SQLParserT_WHERE         = 72
+	SQLParserT_LIMIT         = 73
+	SQLParserT_QUERIES       = 74
+	SQLParserT_QUERY         = 75
+	SQLParserT_EXPLAIN       = 76
+	SQLParserT_WITH_VALUE    = 77
+	SQLParserT_SELECT        = 78
+	SQLParserT_AS            = 79
+	SQLParserT_AND           = 80
+	SQLParserT_OR            = 81
+	SQLParserT_FILL          = 82
+	SQLParserT_NULL          = 83
+	SQLParserT_PREVIOUS      = 84
+	SQLParserT_ORDER         = 85
+	SQLParserT_ASC           = 86
+	SQLParserT_DESC          = 87
+	SQLParserT_LIKE          = 88
+	SQLParserT_NOT           = 89
+	SQLParserT_BETWEEN       = 90
+	SQLParserT_IS            = 91
+	SQLParserT_GROUP         = 92
+	SQLParserT_HAVING        = 93
+	SQLParserT_BY            = 94
+	SQLParserT_FOR           = 95
+	SQLParserT_STATS         = 96
+	SQLParserT_TIME          = 97
+	SQLParserT_NOW           = 98
+	SQLParserT_IN            = 99
+	SQLParserT_LOG           = 100
+	SQLParserT_PROFILE       = 101
+	SQLParserT_REQUESTS      = 102
+	SQLParserT_REQUEST       = 103
+	SQLParserT_ID            = 104
+	SQLParserT_SUM           = 105
+	SQLParserT_MIN           = 106
+	SQLParserT_MAX           = 107
+	SQLParserT_COUNT         = 108
+	SQLParserT_LAST          = 109
+	SQLParserT_FIRST         = 110
+	SQLParserT_AVG           = 111
+	SQLParserT_STDDEV        = 112
+	SQLParserT_QUANTILE      = 113
+	SQLParserT_RATE          = 114
+	SQLParserT_INCREASE      = 115
+	SQLParserT_DELTA         = 116
+	SQLParserT_IRATE         = 117
+	SQLParserT_DERIV         = 118
+	SQLParserT_ABS           = 119
+	SQLParserT_CEIL          = 120
+	SQLParserT_FLOOR         = 121
+	SQLParserT_ROUND         = 122
+	SQLParserT_CLAMP         = 123
+	SQLParserT_TOPK          = 124
+	SQLParserT_BOTTOMK       = 125
+	SQLParserT_OTHERS        = 126
+	SQLParserT_SECOND        = 127
+	SQLParserT_MINUTE        = 128
+	SQLParserT_HOUR          = 129
+	SQLParserT_DAY           = 130
+	SQLParserT_WEEK          = 131
+	SQLParserT_MONTH         = 132
+	SQLParserT_YEAR          = 133
+	SQLParserT_DOT           = 134
+	SQLParserT_COLON         = 135
+	SQLParserT_EQUAL         = 136
+	SQLParserT_NOTEQUAL      = 137
+	SQLParserT_NOTEQUAL2     = 138
+	SQLParserT_GREATER       = 139
+	SQLParserT_GREATEREQUAL  = 140
+	SQLParserT_LESS          = 141
+	SQLParserT_LESSEQUAL     = 142
+	SQLParserT_REGEXP        = 143
+	SQLParserT_NEQREGEXP     = 144
+	SQLParserT_COMMA         = 145
+	SQLParserT_OPEN_B        = 146
+	SQLParserT_CLOSE_B       = 147
+	SQLParserT_OPEN_SB       = 148
+	SQLParserT_CLOSE_SB      = 149
+	SQLParserT_OPEN_P        = 150
+	SQLParserT_CLOSE_P       = 151
+	SQLParserT_ADD           = 152
+	SQLParserT_SUB           = 153
+	SQLParserT_DIV           = 154
+	SQLParserT_MUL           = 155
+	SQLParserT_MOD           = 156
+	SQLParserT_UNDERLINE     = 157
+	SQLParserL_ID            = 158
+	SQLParserL_INT           = 159
+	SQLParserL_DEC           = 160
 )
 
 // SQLParser rules.
 const (
-	SQLParserRULE_statement              = 0
-	SQLParserRULE_useStmt                = 1
-	SQLParserRULE_setLimitStmt           = 2
-	SQLParserRULE_showStmt               = 3
-	SQLParserRULE_showMasterStmt         = 4
-	SQLParserRULE_showRequestsStmt       = 5
-	SQLParserRULE_showRequestStmt        = 6
-	SQLParserRULE_showStoragesStmt       = 7
-	SQLParserRULE_showBrokersStmt        = 8
-	SQLParserRULE_showLimitStmt          = 9
-	SQLParserRULE_showMetadataTypesStmt  = 10
-	SQLParserRULE_showRootMetaStmt       = 11
-	SQLParserRULE_showBrokerMetaStmt     = 12
-	SQLParserRULE_showMasterMetaStmt     = 13
-	SQLParserRULE_showStorageMetaStmt    = 14
-	SQLParserRULE_showAliveStmt          = 15
-	SQLParserRULE_showReplicationStmt    = 16
-	SQLParserRULE_showMemoryDatabaseStmt = 17
-	SQLParserRULE_showRootMetricStmt     = 18
-	SQLParserRULE_showBrokerMetricStmt   = 19
-	SQLParserRULE_showStorageMetricStmt  = 20
-	SQLParserRULE_createStorageStmt      = 21
-	SQLParserRULE_createBrokerStmt       = 22
-	SQLParserRULE_recoverStorageStmt     = 23
-	SQLParserRULE_showSchemasStmt        = 24
-	SQLParserRULE_createDatabaseStmt     = 25
-	SQLParserRULE_dropDatabaseStmt       = 26
-	SQLParserRULE_showDatabaseStmt       = 27
-	SQLParserRULE_showNameSpacesStmt     = 28
-	SQLParserRULE_showMetricsStmt        = 29
-	SQLParserRULE_showFieldsStmt         = 30
-	SQLParserRULE_showTagKeysStmt        = 31
-	SQLParserRULE_showTagValuesStmt      = 32
-	SQLParserRULE_prefix                 = 33
-	SQLParserRULE_withTagKey             = 34
-	SQLParserRULE_namespace              = 35
-	SQLParserRULE_databaseName           = 36
-	SQLParserRULE_storageName            = 37
-	SQLParserRULE_requestID              = 38
-	SQLParserRULE_source                 = 39
-	SQLParserRULE_queryStmt              = 40
-	SQLParserRULE_sourceAndSelect        = 41
-	SQLParserRULE_selectExpr             = 42
-	SQLParserRULE_fields                 = 43
-	SQLParserRULE_field                  = 44
-	SQLParserRULE_alias                  = 45
-	SQLParserRULE_storageFilter          = 46
-	SQLParserRULE_brokerFilter           = 47
-	SQLParserRULE_databaseFilter         = 48
-	SQLParserRULE_typeFilter             = 49
-	SQLParserRULE_fromClause             = 50
-	SQLParserRULE_whereClause            = 51
-	SQLParserRULE_conditionExpr          = 52
-	SQLParserRULE_tagFilterExpr          = 53
-	SQLParserRULE_tagValueList           = 54
-	SQLParserRULE_metricListFilter       = 55
-	SQLParserRULE_metricList             = 56
-	SQLParserRULE_timeRangeExpr          = 57
-	SQLParserRULE_timeExpr               = 58
-	SQLParserRULE_nowExpr                = 59
-	SQLParserRULE_nowFunc                = 60
-	SQLParserRULE_groupByClause          = 61
-	SQLParserRULE_groupByKeys            = 62
-	SQLParserRULE_groupByKey             = 63
-	SQLParserRULE_fillOption             = 64
-	SQLParserRULE_orderByClause          = 65
-	SQLParserRULE_sortField              = 66
-	SQLParserRULE_sortFields             = 67
-	SQLParserRULE_havingClause           = 68
-	SQLParserRULE_boolExpr               = 69
-	SQLParserRULE_boolExprLogicalOp      = 70
-	SQLParserRULE_boolExprAtom           = 71
-	SQLParserRULE_binaryExpr             = 72
-	SQLParserRULE_binaryOperator         = 73
-	SQLParserRULE_fieldExpr              = 74
-	SQLParserRULE_durationLit            = 75
-	SQLParserRULE_intervalItem           = 76
-	SQLParserRULE_exprFunc               = 77
-	SQLParserRULE_funcName               = 78
-	SQLParserRULE_exprFuncParams         = 79
-	SQLParserRULE_funcParam              = 80
-	SQLParserRULE_exprAtom               = 81
-	SQLParserRULE_identFilter            = 82
-	SQLParserRULE_json                   = 83
-	SQLParserRULE_toml                   = 84
-	SQLParserRULE_obj                    = 85
-	SQLParserRULE_pair                   = 86
-	SQLParserRULE_arr                    = 87
-	SQLParserRULE_value                  = 88
-	SQLParserRULE_intNumber              = 89
-	SQLParserRULE_decNumber              = 90
-	SQLParserRULE_limitClause            = 91
-	SQLParserRULE_metricName             = 92
-	SQLParserRULE_tagKey                 = 93
-	SQLParserRULE_tagValue               = 94
-	SQLParserRULE_ident                  = 95
-	SQLParserRULE_nonReservedWords       = 96
+	SQLParserRULE_statement                   = 0
+	SQLParserRULE_useStmt                     = 1
+	SQLParserRULE_setLimitStmt                = 2
+	SQLParserRULE_showStmt                    = 3
+	SQLParserRULE_showMasterStmt              = 4
+	SQLParserRULE_showRequestsStmt            = 5
+	SQLParserRULE_showRequestStmt             = 6
+	SQLParserRULE_killQueryStmt               = 7
+	SQLParserRULE_showStoragesStmt            = 8
+	SQLParserRULE_showBrokersStmt             = 9
+	SQLParserRULE_showLimitStmt               = 10
+	SQLParserRULE_showMetadataTypesStmt       = 11
+	SQLParserRULE_showRootMetaStmt            = 12
+	SQLParserRULE_showBrokerMetaStmt          = 13
+	SQLParserRULE_showMasterMetaStmt          = 14
+	SQLParserRULE_showStorageMetaStmt         = 15
+	SQLParserRULE_showAliveStmt               = 16
+	SQLParserRULE_showReplicationStmt         = 17
+	SQLParserRULE_showMemoryDatabaseStmt      = 18
+	SQLParserRULE_showRebalanceStmt           = 19
+	SQLParserRULE_showReplicaConsistencyStmt  = 20
+	SQLParserRULE_showRootMetricStmt          = 21
+	SQLParserRULE_showBrokerMetricStmt        = 22
+	SQLParserRULE_showStorageMetricStmt       = 23
+	SQLParserRULE_createStorageStmt           = 24
+	SQLParserRULE_createBrokerStmt            = 25
+	SQLParserRULE_recoverStorageStmt          = 26
+	SQLParserRULE_decommissionStorageNodeStmt = 27
+	SQLParserRULE_transferLeaderStmt          = 28
+	SQLParserRULE_showSchemasStmt             = 29
+	SQLParserRULE_createDatabaseStmt          = 30
+	SQLParserRULE_dropDatabaseStmt            = 31
+	SQLParserRULE_dropMetricStmt              = 32
+	SQLParserRULE_deleteStmt                  = 33
+	SQLParserRULE_showDatabaseStmt            = 34
+	SQLParserRULE_showUsersStmt               = 35
+	SQLParserRULE_showRolesStmt               = 36
+	SQLParserRULE_createUserStmt              = 37
+	SQLParserRULE_dropUserStmt                = 38
+	SQLParserRULE_dropRoleStmt                = 39
+	SQLParserRULE_grantStmt                   = 40
+	SQLParserRULE_revokeStmt                  = 41
+	SQLParserRULE_grantRoleStmt               = 42
+	SQLParserRULE_revokeRoleStmt              = 43
+	SQLParserRULE_privilege                   = 44
+	SQLParserRULE_showNameSpacesStmt          = 45
+	SQLParserRULE_showMetricsStmt             = 46
+	SQLParserRULE_showFieldsStmt              = 47
+	SQLParserRULE_showTagKeysStmt             = 48
+	SQLParserRULE_showTagValuesStmt           = 49
+	SQLParserRULE_prefix                      = 50
+	SQLParserRULE_withTagKey                  = 51
+	SQLParserRULE_namespace                   = 52
+	SQLParserRULE_databaseName                = 53
+	SQLParserRULE_storageName                 = 54
+	SQLParserRULE_userName                    = 55
+	SQLParserRULE_roleName                    = 56
+	SQLParserRULE_password                    = 57
+	SQLParserRULE_nodeID                      = 58
+	SQLParserRULE_shardID                     = 59
+	SQLParserRULE_requestID                   = 60
+	SQLParserRULE_source                      = 61
+	SQLParserRULE_queryStmt                   = 62
+	SQLParserRULE_sourceAndSelect             = 63
+	SQLParserRULE_selectExpr                  = 64
+	SQLParserRULE_fields                      = 65
+	SQLParserRULE_field                       = 66
+	SQLParserRULE_alias                       = 67
+	SQLParserRULE_storageFilter               = 68
+	SQLParserRULE_brokerFilter                = 69
+	SQLParserRULE_databaseFilter              = 70
+	SQLParserRULE_typeFilter                  = 71
+	SQLParserRULE_fromClause                  = 72
+	SQLParserRULE_queryFromClause             = 73
+	SQLParserRULE_subQuery                    = 74
+	SQLParserRULE_metricSource                = 75
+	SQLParserRULE_metricAlias                 = 76
+	SQLParserRULE_whereClause                 = 77
+	SQLParserRULE_conditionExpr               = 78
+	SQLParserRULE_tagFilterExpr               = 79
+	SQLParserRULE_tagValueList                = 80
+	SQLParserRULE_metricListFilter            = 81
+	SQLParserRULE_metricList                  = 82
+	SQLParserRULE_timeRangeExpr               = 83
+	SQLParserRULE_timeExpr                    = 84
+	SQLParserRULE_nowExpr                     = 85
+	SQLParserRULE_nowFunc                     = 86
+	SQLParserRULE_groupByClause               = 87
+	SQLParserRULE_groupByKeys                 = 88
+	SQLParserRULE_groupByKey                  = 89
+	SQLParserRULE_fillOption                  = 90
+	SQLParserRULE_othersClause                = 91
+	SQLParserRULE_orderByClause               = 92
+	SQLParserRULE_sortField                   = 93
+	SQLParserRULE_sortFields                  = 94
+	SQLParserRULE_havingClause                = 95
+	SQLParserRULE_boolExpr                    = 96
+	SQLParserRULE_boolExprLogicalOp           = 97
+	SQLParserRULE_boolExprAtom                = 98
+	SQLParserRULE_binaryExpr                  = 99
+	SQLParserRULE_binaryOperator              = 100
+	SQLParserRULE_fieldExpr                   = 101
+	SQLParserRULE_durationLit                 = 102
+	SQLParserRULE_intervalItem                = 103
+	SQLParserRULE_exprFunc                    = 104
+	SQLParserRULE_funcName                    = 105
+	SQLParserRULE_exprFuncParams              = 106
+	SQLParserRULE_funcParam                   = 107
+	SQLParserRULE_exprAtom                    = 108
+	SQLParserRULE_identFilter                 = 109
+	SQLParserRULE_json                        = 110
+	SQLParserRULE_toml                        = 111
+	SQLParserRULE_obj                         = 112
+	SQLParserRULE_pair                        = 113
+	SQLParserRULE_arr                         = 114
+	SQLParserRULE_value                       = 115
+	SQLParserRULE_intNumber                   = 116
+	SQLParserRULE_decNumber                   = 117
+	SQLParserRULE_limitClause                 = 118
+	SQLParserRULE_metricName                  = 119
+	SQLParserRULE_tagKey                      = 120
+	SQLParserRULE_tagValue                    = 121
+	SQLParserRULE_ident                       = 122
+	SQLParserRULE_nonReservedWords            = 123
 )
 
 // IStatementContext is an interface to support dynamic dispatch.
@@ -854,6 +1025,38 @@ func (s *StatementContext) RecoverStorageStmt() IRecoverStorageStmtContext {
 	return t.(IRecoverStorageStmtContext)
 }
 
+func (s *StatementContext) DecommissionStorageNodeStmt() IDecommissionStorageNodeStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDecommissionStorageNodeStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDecommissionStorageNodeStmtContext)
+}
+
+func (s *StatementContext) TransferLeaderStmt() ITransferLeaderStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITransferLeaderStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITransferLeaderStmtContext)
+}
+
 func (s *StatementContext) UseStmt() IUseStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -918,6 +1121,38 @@ func (s *StatementContext) DropDatabaseStmt() IDropDatabaseStmtContext {
 	return t.(IDropDatabaseStmtContext)
 }
 
+func (s *StatementContext) DropMetricStmt() IDropMetricStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDropMetricStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDropMetricStmtContext)
+}
+
+func (s *StatementContext) DeleteStmt() IDeleteStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDeleteStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDeleteStmtContext)
+}
+
 func (s *StatementContext) SetLimitStmt() ISetLimitStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -934,6 +1169,134 @@ func (s *StatementContext) SetLimitStmt() ISetLimitStmtContext {
 	return t.(ISetLimitStmtContext)
 }
 
+func (s *StatementContext) CreateUserStmt() ICreateUserStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICreateUserStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICreateUserStmtContext)
+}
+
+func (s *StatementContext) DropUserStmt() IDropUserStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDropUserStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDropUserStmtContext)
+}
+
+func (s *StatementContext) DropRoleStmt() IDropRoleStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDropRoleStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDropRoleStmtContext)
+}
+
+func (s *StatementContext) GrantStmt() IGrantStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGrantStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGrantStmtContext)
+}
+
+func (s *StatementContext) RevokeStmt() IRevokeStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRevokeStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRevokeStmtContext)
+}
+
+func (s *StatementContext) GrantRoleStmt() IGrantRoleStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGrantRoleStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGrantRoleStmtContext)
+}
+
+func (s *StatementContext) RevokeRoleStmt() IRevokeRoleStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRevokeRoleStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRevokeRoleStmtContext)
+}
+
+func (s *StatementContext) KillQueryStmt() IKillQueryStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IKillQueryStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IKillQueryStmtContext)
+}
+
 func (s *StatementContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -1007,80 +1370,164 @@ func (p *SQLParser) Statement() (localctx IStatementContext) {
 		}
 	}()
 
-	p.SetState(206)
+	p.SetState(272)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(194)
+			p.SetState(248)
 			p.ShowStmt()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(195)
+			p.SetState(249)
 			p.CreateStorageStmt()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(196)
+			p.SetState(250)
 			p.CreateBrokerStmt()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(197)
+			p.SetState(251)
 			p.RecoverStorageStmt()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(198)
-			p.UseStmt()
+			p.SetState(252)
+			p.DecommissionStorageNodeStmt()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(199)
-			p.QueryStmt()
+			p.SetState(253)
+			p.TransferLeaderStmt()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(200)
-			p.CreateDatabaseStmt()
+			p.SetState(254)
+			p.UseStmt()
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(201)
-			p.DropDatabaseStmt()
+			p.SetState(255)
+			p.QueryStmt()
 		}
 
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(202)
-			p.SetLimitStmt()
+			p.SetState(256)
+			p.CreateDatabaseStmt()
 		}
 
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(203)
+			p.SetState(257)
+			p.DropDatabaseStmt()
+		}
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(258)
+			p.DropMetricStmt()
+		}
+
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(259)
+			p.DeleteStmt()
+		}
+
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(260)
+			p.SetLimitStmt()
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(261)
+			p.CreateUserStmt()
+		}
+
+	case 15:
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(262)
+			p.DropUserStmt()
+		}
+
+	case 16:
+		p.EnterOuterAlt(localctx, 16)
+		{
+			p.SetState(263)
+			p.DropRoleStmt()
+		}
+
+	case 17:
+		p.EnterOuterAlt(localctx, 17)
+		{
+			p.SetState(264)
+			p.GrantStmt()
+		}
+
+	case 18:
+		p.EnterOuterAlt(localctx, 18)
+		{
+			p.SetState(265)
+			p.RevokeStmt()
+		}
+
+	case 19:
+		p.EnterOuterAlt(localctx, 19)
+		{
+			p.SetState(266)
+			p.GrantRoleStmt()
+		}
+
+	case 20:
+		p.EnterOuterAlt(localctx, 20)
+		{
+			p.SetState(267)
+			p.RevokeRoleStmt()
+		}
+
+	case 21:
+		p.EnterOuterAlt(localctx, 21)
+		{
+			p.SetState(268)
+			p.KillQueryStmt()
+		}
+
+	case 22:
+		p.EnterOuterAlt(localctx, 22)
+		{
+			p.SetState(269)
 			p.Ident()
 		}
 		{
-			p.SetState(204)
+			p.SetState(270)
 			p.Match(SQLParserEOF)
 		}
 
@@ -1202,11 +1649,11 @@ func (p *SQLParser) UseStmt() (localctx IUseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(208)
+		p.SetState(274)
 		p.Match(SQLParserT_USE)
 	}
 	{
-		p.SetState(209)
+		p.SetState(275)
 		p.Ident()
 	}
 
@@ -1330,15 +1777,15 @@ func (p *SQLParser) SetLimitStmt() (localctx ISetLimitStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(211)
+		p.SetState(277)
 		p.Match(SQLParserT_SET)
 	}
 	{
-		p.SetState(212)
+		p.SetState(278)
 		p.Match(SQLParserT_LIMIT)
 	}
 	{
-		p.SetState(213)
+		p.SetState(279)
 		p.Toml()
 	}
 
@@ -1623,10 +2070,10 @@ func (s *ShowStmtContext) ShowMemoryDatabaseStmt() IShowMemoryDatabaseStmtContex
 	return t.(IShowMemoryDatabaseStmtContext)
 }
 
-func (s *ShowStmtContext) ShowSchemasStmt() IShowSchemasStmtContext {
+func (s *ShowStmtContext) ShowRebalanceStmt() IShowRebalanceStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowSchemasStmtContext); ok {
+		if _, ok := ctx.(IShowRebalanceStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1636,13 +2083,13 @@ func (s *ShowStmtContext) ShowSchemasStmt() IShowSchemasStmtContext {
 		return nil
 	}
 
-	return t.(IShowSchemasStmtContext)
+	return t.(IShowRebalanceStmtContext)
 }
 
-func (s *ShowStmtContext) ShowDatabaseStmt() IShowDatabaseStmtContext {
+func (s *ShowStmtContext) ShowReplicaConsistencyStmt() IShowReplicaConsistencyStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowDatabaseStmtContext); ok {
+		if _, ok := ctx.(IShowReplicaConsistencyStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1652,13 +2099,13 @@ func (s *ShowStmtContext) ShowDatabaseStmt() IShowDatabaseStmtContext {
 		return nil
 	}
 
-	return t.(IShowDatabaseStmtContext)
+	return t.(IShowReplicaConsistencyStmtContext)
 }
 
-func (s *ShowStmtContext) ShowNameSpacesStmt() IShowNameSpacesStmtContext {
+func (s *ShowStmtContext) ShowSchemasStmt() IShowSchemasStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowNameSpacesStmtContext); ok {
+		if _, ok := ctx.(IShowSchemasStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1668,13 +2115,13 @@ func (s *ShowStmtContext) ShowNameSpacesStmt() IShowNameSpacesStmtContext {
 		return nil
 	}
 
-	return t.(IShowNameSpacesStmtContext)
+	return t.(IShowSchemasStmtContext)
 }
 
-func (s *ShowStmtContext) ShowMetricsStmt() IShowMetricsStmtContext {
+func (s *ShowStmtContext) ShowDatabaseStmt() IShowDatabaseStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowMetricsStmtContext); ok {
+		if _, ok := ctx.(IShowDatabaseStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1684,13 +2131,13 @@ func (s *ShowStmtContext) ShowMetricsStmt() IShowMetricsStmtContext {
 		return nil
 	}
 
-	return t.(IShowMetricsStmtContext)
+	return t.(IShowDatabaseStmtContext)
 }
 
-func (s *ShowStmtContext) ShowFieldsStmt() IShowFieldsStmtContext {
+func (s *ShowStmtContext) ShowNameSpacesStmt() IShowNameSpacesStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IShowFieldsStmtContext); ok {
+		if _, ok := ctx.(IShowNameSpacesStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1700,10 +2147,42 @@ func (s *ShowStmtContext) ShowFieldsStmt() IShowFieldsStmtContext {
 		return nil
 	}
 
-	return t.(IShowFieldsStmtContext)
+	return t.(IShowNameSpacesStmtContext)
 }
 
-func (s *ShowStmtContext) ShowTagKeysStmt() IShowTagKeysStmtContext {
+func (s *ShowStmtContext) ShowMetricsStmt() IShowMetricsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowMetricsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowMetricsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowFieldsStmt() IShowFieldsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowFieldsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowFieldsStmtContext)
+}
+
+func (s *ShowStmtContext) ShowTagKeysStmt() IShowTagKeysStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IShowTagKeysStmtContext); ok {
@@ -1767,6 +2246,38 @@ func (s *ShowStmtContext) ShowRequestStmt() IShowRequestStmtContext {
 	return t.(IShowRequestStmtContext)
 }
 
+func (s *ShowStmtContext) ShowUsersStmt() IShowUsersStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowUsersStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowUsersStmtContext)
+}
+
+func (s *ShowStmtContext) ShowRolesStmt() IShowRolesStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowRolesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowRolesStmtContext)
+}
+
 func (s *ShowStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1820,177 +2331,205 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 		}
 	}()
 
-	p.SetState(239)
+	p.SetState(309)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(215)
+			p.SetState(281)
 			p.ShowMasterStmt()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(216)
+			p.SetState(282)
 			p.ShowMetadataTypesStmt()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(217)
+			p.SetState(283)
 			p.ShowRootMetaStmt()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(218)
+			p.SetState(284)
 			p.ShowBrokerMetaStmt()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(219)
+			p.SetState(285)
 			p.ShowMasterMetaStmt()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(220)
+			p.SetState(286)
 			p.ShowStorageMetaStmt()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(221)
+			p.SetState(287)
 			p.ShowStoragesStmt()
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(222)
+			p.SetState(288)
 			p.ShowBrokersStmt()
 		}
 
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(223)
+			p.SetState(289)
 			p.ShowLimitStmt()
 		}
 
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(224)
+			p.SetState(290)
 			p.ShowAliveStmt()
 		}
 
 	case 11:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(225)
+			p.SetState(291)
 			p.ShowRootMetricStmt()
 		}
 
 	case 12:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(226)
+			p.SetState(292)
 			p.ShowBrokerMetricStmt()
 		}
 
 	case 13:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(227)
+			p.SetState(293)
 			p.ShowStorageMetricStmt()
 		}
 
 	case 14:
 		p.EnterOuterAlt(localctx, 14)
 		{
-			p.SetState(228)
+			p.SetState(294)
 			p.ShowReplicationStmt()
 		}
 
 	case 15:
 		p.EnterOuterAlt(localctx, 15)
 		{
-			p.SetState(229)
+			p.SetState(295)
 			p.ShowMemoryDatabaseStmt()
 		}
 
 	case 16:
 		p.EnterOuterAlt(localctx, 16)
 		{
-			p.SetState(230)
-			p.ShowSchemasStmt()
+			p.SetState(296)
+			p.ShowRebalanceStmt()
 		}
 
 	case 17:
 		p.EnterOuterAlt(localctx, 17)
 		{
-			p.SetState(231)
-			p.ShowDatabaseStmt()
+			p.SetState(297)
+			p.ShowReplicaConsistencyStmt()
 		}
 
 	case 18:
 		p.EnterOuterAlt(localctx, 18)
 		{
-			p.SetState(232)
-			p.ShowNameSpacesStmt()
+			p.SetState(298)
+			p.ShowSchemasStmt()
 		}
 
 	case 19:
 		p.EnterOuterAlt(localctx, 19)
 		{
-			p.SetState(233)
-			p.ShowMetricsStmt()
+			p.SetState(299)
+			p.ShowDatabaseStmt()
 		}
 
 	case 20:
 		p.EnterOuterAlt(localctx, 20)
 		{
-			p.SetState(234)
-			p.ShowFieldsStmt()
+			p.SetState(300)
+			p.ShowNameSpacesStmt()
 		}
 
 	case 21:
 		p.EnterOuterAlt(localctx, 21)
 		{
-			p.SetState(235)
-			p.ShowTagKeysStmt()
+			p.SetState(301)
+			p.ShowMetricsStmt()
 		}
 
 	case 22:
 		p.EnterOuterAlt(localctx, 22)
 		{
-			p.SetState(236)
-			p.ShowTagValuesStmt()
+			p.SetState(302)
+			p.ShowFieldsStmt()
 		}
 
 	case 23:
 		p.EnterOuterAlt(localctx, 23)
 		{
-			p.SetState(237)
-			p.ShowRequestsStmt()
+			p.SetState(303)
+			p.ShowTagKeysStmt()
 		}
 
 	case 24:
 		p.EnterOuterAlt(localctx, 24)
 		{
-			p.SetState(238)
+			p.SetState(304)
+			p.ShowTagValuesStmt()
+		}
+
+	case 25:
+		p.EnterOuterAlt(localctx, 25)
+		{
+			p.SetState(305)
+			p.ShowRequestsStmt()
+		}
+
+	case 26:
+		p.EnterOuterAlt(localctx, 26)
+		{
+			p.SetState(306)
 			p.ShowRequestStmt()
 		}
 
+	case 27:
+		p.EnterOuterAlt(localctx, 27)
+		{
+			p.SetState(307)
+			p.ShowUsersStmt()
+		}
+
+	case 28:
+		p.EnterOuterAlt(localctx, 28)
+		{
+			p.SetState(308)
+			p.ShowRolesStmt()
+		}
+
 	}
 
 	return localctx
@@ -2097,11 +2636,11 @@ func (p *SQLParser) ShowMasterStmt() (localctx IShowMasterStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(241)
+		p.SetState(311)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(242)
+		p.SetState(312)
 		p.Match(SQLParserT_MASTER)
 	}
 
@@ -2209,11 +2748,11 @@ func (p *SQLParser) ShowRequestsStmt() (localctx IShowRequestsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(244)
+		p.SetState(314)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(245)
+		p.SetState(315)
 		p.Match(SQLParserT_REQUESTS)
 	}
 
@@ -2349,27 +2888,159 @@ func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(247)
+		p.SetState(317)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(248)
+		p.SetState(318)
 		p.Match(SQLParserT_REQUEST)
 	}
 	{
-		p.SetState(249)
+		p.SetState(319)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(250)
+		p.SetState(320)
 		p.Match(SQLParserT_ID)
 	}
 	{
-		p.SetState(251)
+		p.SetState(321)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(252)
+		p.SetState(322)
+		p.RequestID()
+	}
+
+	return localctx
+}
+
+// IKillQueryStmtContext is an interface to support dynamic dispatch.
+type IKillQueryStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsKillQueryStmtContext differentiates from other interfaces.
+	IsKillQueryStmtContext()
+}
+
+type KillQueryStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyKillQueryStmtContext() *KillQueryStmtContext {
+	var p = new(KillQueryStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_killQueryStmt
+	return p
+}
+
+func (*KillQueryStmtContext) IsKillQueryStmtContext() {}
+
+func NewKillQueryStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *KillQueryStmtContext {
+	var p = new(KillQueryStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_killQueryStmt
+
+	return p
+}
+
+func (s *KillQueryStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *KillQueryStmtContext) T_KILL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_KILL, 0)
+}
+
+func (s *KillQueryStmtContext) T_QUERY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_QUERY, 0)
+}
+
+func (s *KillQueryStmtContext) RequestID() IRequestIDContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRequestIDContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRequestIDContext)
+}
+
+func (s *KillQueryStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *KillQueryStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *KillQueryStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterKillQueryStmt(s)
+	}
+}
+
+func (s *KillQueryStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitKillQueryStmt(s)
+	}
+}
+
+func (s *KillQueryStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitKillQueryStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) KillQueryStmt() (localctx IKillQueryStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewKillQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, SQLParserRULE_killQueryStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(324)
+		p.Match(SQLParserT_KILL)
+	}
+	{
+		p.SetState(325)
+		p.Match(SQLParserT_QUERY)
+	}
+	{
+		p.SetState(326)
 		p.RequestID()
 	}
 
@@ -2457,7 +3128,7 @@ func (p *SQLParser) ShowStoragesStmt() (localctx IShowStoragesStmtContext) {
 	_ = this
 
 	localctx = NewShowStoragesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, SQLParserRULE_showStoragesStmt)
+	p.EnterRule(localctx, 16, SQLParserRULE_showStoragesStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2477,11 +3148,11 @@ func (p *SQLParser) ShowStoragesStmt() (localctx IShowStoragesStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(254)
+		p.SetState(328)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(255)
+		p.SetState(329)
 		p.Match(SQLParserT_STORAGES)
 	}
 
@@ -2569,7 +3240,7 @@ func (p *SQLParser) ShowBrokersStmt() (localctx IShowBrokersStmtContext) {
 	_ = this
 
 	localctx = NewShowBrokersStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, SQLParserRULE_showBrokersStmt)
+	p.EnterRule(localctx, 18, SQLParserRULE_showBrokersStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2589,11 +3260,11 @@ func (p *SQLParser) ShowBrokersStmt() (localctx IShowBrokersStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(257)
+		p.SetState(331)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(258)
+		p.SetState(332)
 		p.Match(SQLParserT_BROKERS)
 	}
 
@@ -2681,7 +3352,7 @@ func (p *SQLParser) ShowLimitStmt() (localctx IShowLimitStmtContext) {
 	_ = this
 
 	localctx = NewShowLimitStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, SQLParserRULE_showLimitStmt)
+	p.EnterRule(localctx, 20, SQLParserRULE_showLimitStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2701,11 +3372,11 @@ func (p *SQLParser) ShowLimitStmt() (localctx IShowLimitStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(260)
+		p.SetState(334)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(261)
+		p.SetState(335)
 		p.Match(SQLParserT_LIMIT)
 	}
 
@@ -2797,7 +3468,7 @@ func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtCont
 	_ = this
 
 	localctx = NewShowMetadataTypesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, SQLParserRULE_showMetadataTypesStmt)
+	p.EnterRule(localctx, 22, SQLParserRULE_showMetadataTypesStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2817,15 +3488,15 @@ func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtCont
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(263)
+		p.SetState(337)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(264)
+		p.SetState(338)
 		p.Match(SQLParserT_METADATA)
 	}
 	{
-		p.SetState(265)
+		p.SetState(339)
 		p.Match(SQLParserT_TYPES)
 	}
 
@@ -2957,7 +3628,7 @@ func (p *SQLParser) ShowRootMetaStmt() (localctx IShowRootMetaStmtContext) {
 	_ = this
 
 	localctx = NewShowRootMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, SQLParserRULE_showRootMetaStmt)
+	p.EnterRule(localctx, 24, SQLParserRULE_showRootMetaStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2977,31 +3648,31 @@ func (p *SQLParser) ShowRootMetaStmt() (localctx IShowRootMetaStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(267)
+		p.SetState(341)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(268)
+		p.SetState(342)
 		p.Match(SQLParserT_ROOT)
 	}
 	{
-		p.SetState(269)
+		p.SetState(343)
 		p.Match(SQLParserT_METADATA)
 	}
 	{
-		p.SetState(270)
+		p.SetState(344)
 		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(271)
+		p.SetState(345)
 		p.Source()
 	}
 	{
-		p.SetState(272)
+		p.SetState(346)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(273)
+		p.SetState(347)
 		p.TypeFilter()
 	}
 
@@ -3153,7 +3824,7 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 	_ = this
 
 	localctx = NewShowBrokerMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, SQLParserRULE_showBrokerMetaStmt)
+	p.EnterRule(localctx, 26, SQLParserRULE_showBrokerMetaStmt)
 	var _la int
 
 	defer func() {
@@ -3174,44 +3845,44 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(275)
+		p.SetState(349)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(276)
+		p.SetState(350)
 		p.Match(SQLParserT_BROKER)
 	}
 	{
-		p.SetState(277)
+		p.SetState(351)
 		p.Match(SQLParserT_METADATA)
 	}
 	{
-		p.SetState(278)
+		p.SetState(352)
 		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(279)
+		p.SetState(353)
 		p.Source()
 	}
 	{
-		p.SetState(280)
+		p.SetState(354)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(281)
+		p.SetState(355)
 		p.TypeFilter()
 	}
-	p.SetState(284)
+	p.SetState(358)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_AND {
 		{
-			p.SetState(282)
+			p.SetState(356)
 			p.Match(SQLParserT_AND)
 		}
 		{
-			p.SetState(283)
+			p.SetState(357)
 			p.BrokerFilter()
 		}
 
@@ -3345,7 +4016,7 @@ func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
 	_ = this
 
 	localctx = NewShowMasterMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, SQLParserRULE_showMasterMetaStmt)
+	p.EnterRule(localctx, 28, SQLParserRULE_showMasterMetaStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3365,31 +4036,31 @@ func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(286)
+		p.SetState(360)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(287)
+		p.SetState(361)
 		p.Match(SQLParserT_MASTER)
 	}
 	{
-		p.SetState(288)
+		p.SetState(362)
 		p.Match(SQLParserT_METADATA)
 	}
 	{
-		p.SetState(289)
+		p.SetState(363)
 		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(290)
+		p.SetState(364)
 		p.Source()
 	}
 	{
-		p.SetState(291)
+		p.SetState(365)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(292)
+		p.SetState(366)
 		p.TypeFilter()
 	}
 
@@ -3591,7 +4262,7 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 	_ = this
 
 	localctx = NewShowStorageMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, SQLParserRULE_showStorageMetaStmt)
+	p.EnterRule(localctx, 30, SQLParserRULE_showStorageMetaStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3611,42 +4282,42 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(294)
+		p.SetState(368)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(295)
+		p.SetState(369)
 		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(296)
+		p.SetState(370)
 		p.Match(SQLParserT_METADATA)
 	}
 	{
-		p.SetState(297)
+		p.SetState(371)
 		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(298)
+		p.SetState(372)
 		p.Source()
 	}
 	{
-		p.SetState(299)
+		p.SetState(373)
 		p.Match(SQLParserT_WHERE)
 	}
-	p.SetState(302)
+	p.SetState(376)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(300)
+			p.SetState(374)
 			p.StorageFilter()
 		}
 
 	case SQLParserT_TYPE:
 		{
-			p.SetState(301)
+			p.SetState(375)
 			p.TypeFilter()
 		}
 
@@ -3654,22 +4325,22 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(304)
+		p.SetState(378)
 		p.Match(SQLParserT_AND)
 	}
-	p.SetState(307)
+	p.SetState(381)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(305)
+			p.SetState(379)
 			p.StorageFilter()
 		}
 
 	case SQLParserT_TYPE:
 		{
-			p.SetState(306)
+			p.SetState(380)
 			p.TypeFilter()
 		}
 
@@ -3773,7 +4444,7 @@ func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
 	_ = this
 
 	localctx = NewShowAliveStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, SQLParserRULE_showAliveStmt)
+	p.EnterRule(localctx, 32, SQLParserRULE_showAliveStmt)
 	var _la int
 
 	defer func() {
@@ -3794,14 +4465,14 @@ func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(309)
+		p.SetState(383)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(310)
+		p.SetState(384)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&15032385536) != 0) {
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3940649673949184) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -3809,7 +4480,7 @@ func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
 		}
 	}
 	{
-		p.SetState(311)
+		p.SetState(385)
 		p.Match(SQLParserT_ALIVE)
 	}
 
@@ -3987,7 +4658,7 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 	_ = this
 
 	localctx = NewShowReplicationStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, SQLParserRULE_showReplicationStmt)
+	p.EnterRule(localctx, 34, SQLParserRULE_showReplicationStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4007,30 +4678,30 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(313)
+		p.SetState(387)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(314)
+		p.SetState(388)
 		p.Match(SQLParserT_REPLICATION)
 	}
 	{
-		p.SetState(315)
+		p.SetState(389)
 		p.Match(SQLParserT_WHERE)
 	}
-	p.SetState(318)
+	p.SetState(392)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(316)
+			p.SetState(390)
 			p.StorageFilter()
 		}
 
 	case SQLParserT_DATASBAE:
 		{
-			p.SetState(317)
+			p.SetState(391)
 			p.DatabaseFilter()
 		}
 
@@ -4038,22 +4709,22 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(320)
+		p.SetState(394)
 		p.Match(SQLParserT_AND)
 	}
-	p.SetState(323)
+	p.SetState(397)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(321)
+			p.SetState(395)
 			p.StorageFilter()
 		}
 
 	case SQLParserT_DATASBAE:
 		{
-			p.SetState(322)
+			p.SetState(396)
 			p.DatabaseFilter()
 		}
 
@@ -4239,7 +4910,7 @@ func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtCo
 	_ = this
 
 	localctx = NewShowMemoryDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, SQLParserRULE_showMemoryDatabaseStmt)
+	p.EnterRule(localctx, 36, SQLParserRULE_showMemoryDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4259,34 +4930,34 @@ func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtCo
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(325)
+		p.SetState(399)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(326)
+		p.SetState(400)
 		p.Match(SQLParserT_MEMORY)
 	}
 	{
-		p.SetState(327)
+		p.SetState(401)
 		p.Match(SQLParserT_DATASBAE)
 	}
 	{
-		p.SetState(328)
+		p.SetState(402)
 		p.Match(SQLParserT_WHERE)
 	}
-	p.SetState(331)
+	p.SetState(405)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(329)
+			p.SetState(403)
 			p.StorageFilter()
 		}
 
 	case SQLParserT_DATASBAE:
 		{
-			p.SetState(330)
+			p.SetState(404)
 			p.DatabaseFilter()
 		}
 
@@ -4294,22 +4965,22 @@ func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtCo
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(333)
+		p.SetState(407)
 		p.Match(SQLParserT_AND)
 	}
-	p.SetState(336)
+	p.SetState(410)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(334)
+			p.SetState(408)
 			p.StorageFilter()
 		}
 
 	case SQLParserT_DATASBAE:
 		{
-			p.SetState(335)
+			p.SetState(409)
 			p.DatabaseFilter()
 		}
 
@@ -4320,260 +4991,88 @@ func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtCo
 	return localctx
 }
 
-// IShowRootMetricStmtContext is an interface to support dynamic dispatch.
-type IShowRootMetricStmtContext interface {
+// IShowRebalanceStmtContext is an interface to support dynamic dispatch.
+type IShowRebalanceStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowRootMetricStmtContext differentiates from other interfaces.
-	IsShowRootMetricStmtContext()
+	// IsShowRebalanceStmtContext differentiates from other interfaces.
+	IsShowRebalanceStmtContext()
 }
 
-type ShowRootMetricStmtContext struct {
+type ShowRebalanceStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowRootMetricStmtContext() *ShowRootMetricStmtContext {
-	var p = new(ShowRootMetricStmtContext)
+func NewEmptyShowRebalanceStmtContext() *ShowRebalanceStmtContext {
+	var p = new(ShowRebalanceStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showRootMetricStmt
-	return p
-}
-
-func (*ShowRootMetricStmtContext) IsShowRootMetricStmtContext() {}
-
-func NewShowRootMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRootMetricStmtContext {
-	var p = new(ShowRootMetricStmtContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showRootMetricStmt
-
-	return p
-}
-
-func (s *ShowRootMetricStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowRootMetricStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowRootMetricStmtContext) T_ROOT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ROOT, 0)
-}
-
-func (s *ShowRootMetricStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
-}
-
-func (s *ShowRootMetricStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowRootMetricStmtContext) MetricListFilter() IMetricListFilterContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMetricListFilterContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IMetricListFilterContext)
-}
-
-func (s *ShowRootMetricStmtContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *ShowRootMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-func (s *ShowRootMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowRootMetricStmt(s)
-	}
-}
-
-func (s *ShowRootMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowRootMetricStmt(s)
-	}
-}
-
-func (s *ShowRootMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case SQLVisitor:
-		return t.VisitShowRootMetricStmt(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-func (p *SQLParser) ShowRootMetricStmt() (localctx IShowRootMetricStmtContext) {
-	this := p
-	_ = this
-
-	localctx = NewShowRootMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, SQLParserRULE_showRootMetricStmt)
-
-	defer func() {
-		p.ExitRule()
-	}()
-
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(338)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(339)
-		p.Match(SQLParserT_ROOT)
-	}
-	{
-		p.SetState(340)
-		p.Match(SQLParserT_METRIC)
-	}
-	{
-		p.SetState(341)
-		p.Match(SQLParserT_WHERE)
-	}
-	{
-		p.SetState(342)
-		p.MetricListFilter()
-	}
-
-	return localctx
-}
-
-// IShowBrokerMetricStmtContext is an interface to support dynamic dispatch.
-type IShowBrokerMetricStmtContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// IsShowBrokerMetricStmtContext differentiates from other interfaces.
-	IsShowBrokerMetricStmtContext()
-}
-
-type ShowBrokerMetricStmtContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyShowBrokerMetricStmtContext() *ShowBrokerMetricStmtContext {
-	var p = new(ShowBrokerMetricStmtContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
+	p.RuleIndex = SQLParserRULE_showRebalanceStmt
 	return p
 }
 
-func (*ShowBrokerMetricStmtContext) IsShowBrokerMetricStmtContext() {}
+func (*ShowRebalanceStmtContext) IsShowRebalanceStmtContext() {}
 
-func NewShowBrokerMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokerMetricStmtContext {
-	var p = new(ShowBrokerMetricStmtContext)
+func NewShowRebalanceStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRebalanceStmtContext {
+	var p = new(ShowRebalanceStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
+	p.RuleIndex = SQLParserRULE_showRebalanceStmt
 
 	return p
 }
 
-func (s *ShowBrokerMetricStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowRebalanceStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowBrokerMetricStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowRebalanceStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowBrokerMetricStmtContext) T_BROKER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKER, 0)
-}
-
-func (s *ShowBrokerMetricStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
-}
-
-func (s *ShowBrokerMetricStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowBrokerMetricStmtContext) MetricListFilter() IMetricListFilterContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMetricListFilterContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IMetricListFilterContext)
+func (s *ShowRebalanceStmtContext) T_REBALANCE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REBALANCE, 0)
 }
 
-func (s *ShowBrokerMetricStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowRebalanceStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowBrokerMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowRebalanceStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowBrokerMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowRebalanceStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowBrokerMetricStmt(s)
+		listenerT.EnterShowRebalanceStmt(s)
 	}
 }
 
-func (s *ShowBrokerMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowRebalanceStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowBrokerMetricStmt(s)
+		listenerT.ExitShowRebalanceStmt(s)
 	}
 }
 
-func (s *ShowBrokerMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowRebalanceStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowBrokerMetricStmt(s)
+		return t.VisitShowRebalanceStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContext) {
+func (p *SQLParser) ShowRebalanceStmt() (localctx IShowRebalanceStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowBrokerMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, SQLParserRULE_showBrokerMetricStmt)
+	localctx = NewShowRebalanceStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, SQLParserRULE_showRebalanceStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4593,88 +5092,76 @@ func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(344)
+		p.SetState(412)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(345)
-		p.Match(SQLParserT_BROKER)
-	}
-	{
-		p.SetState(346)
-		p.Match(SQLParserT_METRIC)
-	}
-	{
-		p.SetState(347)
-		p.Match(SQLParserT_WHERE)
-	}
-	{
-		p.SetState(348)
-		p.MetricListFilter()
+		p.SetState(413)
+		p.Match(SQLParserT_REBALANCE)
 	}
 
 	return localctx
 }
 
-// IShowStorageMetricStmtContext is an interface to support dynamic dispatch.
-type IShowStorageMetricStmtContext interface {
+// IShowReplicaConsistencyStmtContext is an interface to support dynamic dispatch.
+type IShowReplicaConsistencyStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowStorageMetricStmtContext differentiates from other interfaces.
-	IsShowStorageMetricStmtContext()
+	// IsShowReplicaConsistencyStmtContext differentiates from other interfaces.
+	IsShowReplicaConsistencyStmtContext()
 }
 
-type ShowStorageMetricStmtContext struct {
+type ShowReplicaConsistencyStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowStorageMetricStmtContext() *ShowStorageMetricStmtContext {
-	var p = new(ShowStorageMetricStmtContext)
+func NewEmptyShowReplicaConsistencyStmtContext() *ShowReplicaConsistencyStmtContext {
+	var p = new(ShowReplicaConsistencyStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showStorageMetricStmt
+	p.RuleIndex = SQLParserRULE_showReplicaConsistencyStmt
 	return p
 }
 
-func (*ShowStorageMetricStmtContext) IsShowStorageMetricStmtContext() {}
+func (*ShowReplicaConsistencyStmtContext) IsShowReplicaConsistencyStmtContext() {}
 
-func NewShowStorageMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStorageMetricStmtContext {
-	var p = new(ShowStorageMetricStmtContext)
+func NewShowReplicaConsistencyStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowReplicaConsistencyStmtContext {
+	var p = new(ShowReplicaConsistencyStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showStorageMetricStmt
+	p.RuleIndex = SQLParserRULE_showReplicaConsistencyStmt
 
 	return p
 }
 
-func (s *ShowStorageMetricStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowReplicaConsistencyStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowStorageMetricStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowReplicaConsistencyStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowStorageMetricStmtContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
+func (s *ShowReplicaConsistencyStmtContext) T_REPLICA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REPLICA, 0)
 }
 
-func (s *ShowStorageMetricStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
+func (s *ShowReplicaConsistencyStmtContext) T_CONSISTENCY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CONSISTENCY, 0)
 }
 
-func (s *ShowStorageMetricStmtContext) T_WHERE() antlr.TerminalNode {
+func (s *ShowReplicaConsistencyStmtContext) T_WHERE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_WHERE, 0)
 }
 
-func (s *ShowStorageMetricStmtContext) T_AND() antlr.TerminalNode {
+func (s *ShowReplicaConsistencyStmtContext) T_AND() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_AND, 0)
 }
 
-func (s *ShowStorageMetricStmtContext) AllStorageFilter() []IStorageFilterContext {
+func (s *ShowReplicaConsistencyStmtContext) AllStorageFilter() []IStorageFilterContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -4695,7 +5182,7 @@ func (s *ShowStorageMetricStmtContext) AllStorageFilter() []IStorageFilterContex
 	return tst
 }
 
-func (s *ShowStorageMetricStmtContext) StorageFilter(i int) IStorageFilterContext {
+func (s *ShowReplicaConsistencyStmtContext) StorageFilter(i int) IStorageFilterContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -4715,20 +5202,20 @@ func (s *ShowStorageMetricStmtContext) StorageFilter(i int) IStorageFilterContex
 	return t.(IStorageFilterContext)
 }
 
-func (s *ShowStorageMetricStmtContext) AllMetricListFilter() []IMetricListFilterContext {
+func (s *ShowReplicaConsistencyStmtContext) AllDatabaseFilter() []IDatabaseFilterContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IMetricListFilterContext); ok {
+		if _, ok := ctx.(IDatabaseFilterContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IMetricListFilterContext, len)
+	tst := make([]IDatabaseFilterContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IMetricListFilterContext); ok {
-			tst[i] = t.(IMetricListFilterContext)
+		if t, ok := ctx.(IDatabaseFilterContext); ok {
+			tst[i] = t.(IDatabaseFilterContext)
 			i++
 		}
 	}
@@ -4736,11 +5223,11 @@ func (s *ShowStorageMetricStmtContext) AllMetricListFilter() []IMetricListFilter
 	return tst
 }
 
-func (s *ShowStorageMetricStmtContext) MetricListFilter(i int) IMetricListFilterContext {
+func (s *ShowReplicaConsistencyStmtContext) DatabaseFilter(i int) IDatabaseFilterContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMetricListFilterContext); ok {
+		if _, ok := ctx.(IDatabaseFilterContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -4753,45 +5240,45 @@ func (s *ShowStorageMetricStmtContext) MetricListFilter(i int) IMetricListFilter
 		return nil
 	}
 
-	return t.(IMetricListFilterContext)
+	return t.(IDatabaseFilterContext)
 }
 
-func (s *ShowStorageMetricStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowReplicaConsistencyStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowStorageMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowReplicaConsistencyStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowStorageMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowReplicaConsistencyStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowStorageMetricStmt(s)
+		listenerT.EnterShowReplicaConsistencyStmt(s)
 	}
 }
 
-func (s *ShowStorageMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowReplicaConsistencyStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowStorageMetricStmt(s)
+		listenerT.ExitShowReplicaConsistencyStmt(s)
 	}
 }
 
-func (s *ShowStorageMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowReplicaConsistencyStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowStorageMetricStmt(s)
+		return t.VisitShowReplicaConsistencyStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtContext) {
+func (p *SQLParser) ShowReplicaConsistencyStmt() (localctx IShowReplicaConsistencyStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowStorageMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, SQLParserRULE_showStorageMetricStmt)
+	localctx = NewShowReplicaConsistencyStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, SQLParserRULE_showReplicaConsistencyStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4811,58 +5298,58 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(350)
+		p.SetState(415)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(351)
-		p.Match(SQLParserT_STORAGE)
+		p.SetState(416)
+		p.Match(SQLParserT_REPLICA)
 	}
 	{
-		p.SetState(352)
-		p.Match(SQLParserT_METRIC)
+		p.SetState(417)
+		p.Match(SQLParserT_CONSISTENCY)
 	}
 	{
-		p.SetState(353)
+		p.SetState(418)
 		p.Match(SQLParserT_WHERE)
 	}
-	p.SetState(356)
+	p.SetState(421)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(354)
+			p.SetState(419)
 			p.StorageFilter()
 		}
 
-	case SQLParserT_METRIC:
+	case SQLParserT_DATASBAE:
 		{
-			p.SetState(355)
-			p.MetricListFilter()
+			p.SetState(420)
+			p.DatabaseFilter()
 		}
 
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(358)
+		p.SetState(423)
 		p.Match(SQLParserT_AND)
 	}
-	p.SetState(361)
+	p.SetState(426)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(359)
+			p.SetState(424)
 			p.StorageFilter()
 		}
 
-	case SQLParserT_METRIC:
+	case SQLParserT_DATASBAE:
 		{
-			p.SetState(360)
-			p.MetricListFilter()
+			p.SetState(425)
+			p.DatabaseFilter()
 		}
 
 	default:
@@ -4872,56 +5359,64 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 	return localctx
 }
 
-// ICreateStorageStmtContext is an interface to support dynamic dispatch.
-type ICreateStorageStmtContext interface {
+// IShowRootMetricStmtContext is an interface to support dynamic dispatch.
+type IShowRootMetricStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCreateStorageStmtContext differentiates from other interfaces.
-	IsCreateStorageStmtContext()
+	// IsShowRootMetricStmtContext differentiates from other interfaces.
+	IsShowRootMetricStmtContext()
 }
 
-type CreateStorageStmtContext struct {
+type ShowRootMetricStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCreateStorageStmtContext() *CreateStorageStmtContext {
-	var p = new(CreateStorageStmtContext)
+func NewEmptyShowRootMetricStmtContext() *ShowRootMetricStmtContext {
+	var p = new(ShowRootMetricStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_createStorageStmt
+	p.RuleIndex = SQLParserRULE_showRootMetricStmt
 	return p
 }
 
-func (*CreateStorageStmtContext) IsCreateStorageStmtContext() {}
+func (*ShowRootMetricStmtContext) IsShowRootMetricStmtContext() {}
 
-func NewCreateStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateStorageStmtContext {
-	var p = new(CreateStorageStmtContext)
+func NewShowRootMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRootMetricStmtContext {
+	var p = new(ShowRootMetricStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_createStorageStmt
+	p.RuleIndex = SQLParserRULE_showRootMetricStmt
 
 	return p
 }
 
-func (s *CreateStorageStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowRootMetricStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CreateStorageStmtContext) T_CREATE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CREATE, 0)
+func (s *ShowRootMetricStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *CreateStorageStmtContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
+func (s *ShowRootMetricStmtContext) T_ROOT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROOT, 0)
 }
 
-func (s *CreateStorageStmtContext) Json() IJsonContext {
+func (s *ShowRootMetricStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
+
+func (s *ShowRootMetricStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowRootMetricStmtContext) MetricListFilter() IMetricListFilterContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJsonContext); ok {
+		if _, ok := ctx.(IMetricListFilterContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4931,45 +5426,45 @@ func (s *CreateStorageStmtContext) Json() IJsonContext {
 		return nil
 	}
 
-	return t.(IJsonContext)
+	return t.(IMetricListFilterContext)
 }
 
-func (s *CreateStorageStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowRootMetricStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CreateStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowRootMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CreateStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowRootMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterCreateStorageStmt(s)
+		listenerT.EnterShowRootMetricStmt(s)
 	}
 }
 
-func (s *CreateStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowRootMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitCreateStorageStmt(s)
+		listenerT.ExitShowRootMetricStmt(s)
 	}
 }
 
-func (s *CreateStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowRootMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitCreateStorageStmt(s)
+		return t.VisitShowRootMetricStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
+func (p *SQLParser) ShowRootMetricStmt() (localctx IShowRootMetricStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewCreateStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, SQLParserRULE_createStorageStmt)
+	localctx = NewShowRootMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, SQLParserRULE_showRootMetricStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4989,71 +5484,87 @@ func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(363)
-		p.Match(SQLParserT_CREATE)
+		p.SetState(428)
+		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(364)
-		p.Match(SQLParserT_STORAGE)
+		p.SetState(429)
+		p.Match(SQLParserT_ROOT)
 	}
 	{
-		p.SetState(365)
-		p.Json()
+		p.SetState(430)
+		p.Match(SQLParserT_METRIC)
+	}
+	{
+		p.SetState(431)
+		p.Match(SQLParserT_WHERE)
+	}
+	{
+		p.SetState(432)
+		p.MetricListFilter()
 	}
 
 	return localctx
 }
 
-// ICreateBrokerStmtContext is an interface to support dynamic dispatch.
-type ICreateBrokerStmtContext interface {
+// IShowBrokerMetricStmtContext is an interface to support dynamic dispatch.
+type IShowBrokerMetricStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCreateBrokerStmtContext differentiates from other interfaces.
-	IsCreateBrokerStmtContext()
+	// IsShowBrokerMetricStmtContext differentiates from other interfaces.
+	IsShowBrokerMetricStmtContext()
 }
 
-type CreateBrokerStmtContext struct {
+type ShowBrokerMetricStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCreateBrokerStmtContext() *CreateBrokerStmtContext {
-	var p = new(CreateBrokerStmtContext)
+func NewEmptyShowBrokerMetricStmtContext() *ShowBrokerMetricStmtContext {
+	var p = new(ShowBrokerMetricStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_createBrokerStmt
+	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
 	return p
 }
 
-func (*CreateBrokerStmtContext) IsCreateBrokerStmtContext() {}
+func (*ShowBrokerMetricStmtContext) IsShowBrokerMetricStmtContext() {}
 
-func NewCreateBrokerStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateBrokerStmtContext {
-	var p = new(CreateBrokerStmtContext)
+func NewShowBrokerMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowBrokerMetricStmtContext {
+	var p = new(ShowBrokerMetricStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_createBrokerStmt
+	p.RuleIndex = SQLParserRULE_showBrokerMetricStmt
 
 	return p
 }
 
-func (s *CreateBrokerStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowBrokerMetricStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CreateBrokerStmtContext) T_CREATE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CREATE, 0)
+func (s *ShowBrokerMetricStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *CreateBrokerStmtContext) T_BROKER() antlr.TerminalNode {
+func (s *ShowBrokerMetricStmtContext) T_BROKER() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_BROKER, 0)
 }
 
-func (s *CreateBrokerStmtContext) Json() IJsonContext {
+func (s *ShowBrokerMetricStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
+
+func (s *ShowBrokerMetricStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowBrokerMetricStmtContext) MetricListFilter() IMetricListFilterContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJsonContext); ok {
+		if _, ok := ctx.(IMetricListFilterContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5063,45 +5574,45 @@ func (s *CreateBrokerStmtContext) Json() IJsonContext {
 		return nil
 	}
 
-	return t.(IJsonContext)
+	return t.(IMetricListFilterContext)
 }
 
-func (s *CreateBrokerStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowBrokerMetricStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CreateBrokerStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowBrokerMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CreateBrokerStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowBrokerMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterCreateBrokerStmt(s)
+		listenerT.EnterShowBrokerMetricStmt(s)
 	}
 }
 
-func (s *CreateBrokerStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowBrokerMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitCreateBrokerStmt(s)
+		listenerT.ExitShowBrokerMetricStmt(s)
 	}
 }
 
-func (s *CreateBrokerStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowBrokerMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitCreateBrokerStmt(s)
+		return t.VisitShowBrokerMetricStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) CreateBrokerStmt() (localctx ICreateBrokerStmtContext) {
+func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewCreateBrokerStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, SQLParserRULE_createBrokerStmt)
+	localctx = NewShowBrokerMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, SQLParserRULE_showBrokerMetricStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5121,119 +5632,205 @@ func (p *SQLParser) CreateBrokerStmt() (localctx ICreateBrokerStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(367)
-		p.Match(SQLParserT_CREATE)
+		p.SetState(434)
+		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(368)
+		p.SetState(435)
 		p.Match(SQLParserT_BROKER)
 	}
 	{
-		p.SetState(369)
-		p.Json()
+		p.SetState(436)
+		p.Match(SQLParserT_METRIC)
+	}
+	{
+		p.SetState(437)
+		p.Match(SQLParserT_WHERE)
+	}
+	{
+		p.SetState(438)
+		p.MetricListFilter()
 	}
 
 	return localctx
 }
 
-// IRecoverStorageStmtContext is an interface to support dynamic dispatch.
-type IRecoverStorageStmtContext interface {
+// IShowStorageMetricStmtContext is an interface to support dynamic dispatch.
+type IShowStorageMetricStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsRecoverStorageStmtContext differentiates from other interfaces.
-	IsRecoverStorageStmtContext()
+	// IsShowStorageMetricStmtContext differentiates from other interfaces.
+	IsShowStorageMetricStmtContext()
 }
 
-type RecoverStorageStmtContext struct {
+type ShowStorageMetricStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRecoverStorageStmtContext() *RecoverStorageStmtContext {
-	var p = new(RecoverStorageStmtContext)
+func NewEmptyShowStorageMetricStmtContext() *ShowStorageMetricStmtContext {
+	var p = new(ShowStorageMetricStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_recoverStorageStmt
+	p.RuleIndex = SQLParserRULE_showStorageMetricStmt
 	return p
 }
 
-func (*RecoverStorageStmtContext) IsRecoverStorageStmtContext() {}
+func (*ShowStorageMetricStmtContext) IsShowStorageMetricStmtContext() {}
 
-func NewRecoverStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RecoverStorageStmtContext {
-	var p = new(RecoverStorageStmtContext)
+func NewShowStorageMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStorageMetricStmtContext {
+	var p = new(ShowStorageMetricStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_recoverStorageStmt
+	p.RuleIndex = SQLParserRULE_showStorageMetricStmt
 
 	return p
 }
 
-func (s *RecoverStorageStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowStorageMetricStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RecoverStorageStmtContext) T_RECOVER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_RECOVER, 0)
+func (s *ShowStorageMetricStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *RecoverStorageStmtContext) T_STORAGE() antlr.TerminalNode {
+func (s *ShowStorageMetricStmtContext) T_STORAGE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_STORAGE, 0)
 }
 
-func (s *RecoverStorageStmtContext) StorageName() IStorageNameContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStorageNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IStorageNameContext)
+func (s *ShowStorageMetricStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
 }
 
-func (s *RecoverStorageStmtContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *ShowStorageMetricStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
 }
 
-func (s *RecoverStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *ShowStorageMetricStmtContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
 }
 
-func (s *RecoverStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterRecoverStorageStmt(s)
+func (s *ShowStorageMetricStmtContext) AllStorageFilter() []IStorageFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			len++
+		}
 	}
-}
 
-func (s *RecoverStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitRecoverStorageStmt(s)
+	tst := make([]IStorageFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStorageFilterContext); ok {
+			tst[i] = t.(IStorageFilterContext)
+			i++
+		}
 	}
+
+	return tst
 }
 
-func (s *RecoverStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowStorageMetricStmtContext) StorageFilter(i int) IStorageFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStorageFilterContext)
+}
+
+func (s *ShowStorageMetricStmtContext) AllMetricListFilter() []IMetricListFilterContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMetricListFilterContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMetricListFilterContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMetricListFilterContext); ok {
+			tst[i] = t.(IMetricListFilterContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShowStorageMetricStmtContext) MetricListFilter(i int) IMetricListFilterContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricListFilterContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricListFilterContext)
+}
+
+func (s *ShowStorageMetricStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowStorageMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowStorageMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowStorageMetricStmt(s)
+	}
+}
+
+func (s *ShowStorageMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowStorageMetricStmt(s)
+	}
+}
+
+func (s *ShowStorageMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitRecoverStorageStmt(s)
+		return t.VisitShowStorageMetricStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) RecoverStorageStmt() (localctx IRecoverStorageStmtContext) {
+func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewRecoverStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, SQLParserRULE_recoverStorageStmt)
+	localctx = NewShowStorageMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, SQLParserRULE_showStorageMetricStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5253,103 +5850,165 @@ func (p *SQLParser) RecoverStorageStmt() (localctx IRecoverStorageStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(371)
-		p.Match(SQLParserT_RECOVER)
+		p.SetState(440)
+		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(372)
+		p.SetState(441)
 		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(373)
-		p.StorageName()
+		p.SetState(442)
+		p.Match(SQLParserT_METRIC)
+	}
+	{
+		p.SetState(443)
+		p.Match(SQLParserT_WHERE)
+	}
+	p.SetState(446)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(444)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_METRIC:
+		{
+			p.SetState(445)
+			p.MetricListFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(448)
+		p.Match(SQLParserT_AND)
+	}
+	p.SetState(451)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_STORAGE:
+		{
+			p.SetState(449)
+			p.StorageFilter()
+		}
+
+	case SQLParserT_METRIC:
+		{
+			p.SetState(450)
+			p.MetricListFilter()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IShowSchemasStmtContext is an interface to support dynamic dispatch.
-type IShowSchemasStmtContext interface {
+// ICreateStorageStmtContext is an interface to support dynamic dispatch.
+type ICreateStorageStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowSchemasStmtContext differentiates from other interfaces.
-	IsShowSchemasStmtContext()
+	// IsCreateStorageStmtContext differentiates from other interfaces.
+	IsCreateStorageStmtContext()
 }
 
-type ShowSchemasStmtContext struct {
+type CreateStorageStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowSchemasStmtContext() *ShowSchemasStmtContext {
-	var p = new(ShowSchemasStmtContext)
+func NewEmptyCreateStorageStmtContext() *CreateStorageStmtContext {
+	var p = new(CreateStorageStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showSchemasStmt
+	p.RuleIndex = SQLParserRULE_createStorageStmt
 	return p
 }
 
-func (*ShowSchemasStmtContext) IsShowSchemasStmtContext() {}
+func (*CreateStorageStmtContext) IsCreateStorageStmtContext() {}
 
-func NewShowSchemasStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowSchemasStmtContext {
-	var p = new(ShowSchemasStmtContext)
+func NewCreateStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateStorageStmtContext {
+	var p = new(CreateStorageStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showSchemasStmt
+	p.RuleIndex = SQLParserRULE_createStorageStmt
 
 	return p
 }
 
-func (s *ShowSchemasStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateStorageStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowSchemasStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *CreateStorageStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
 }
 
-func (s *ShowSchemasStmtContext) T_SCHEMAS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SCHEMAS, 0)
+func (s *CreateStorageStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
 }
 
-func (s *ShowSchemasStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateStorageStmtContext) Json() IJsonContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IJsonContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJsonContext)
+}
+
+func (s *CreateStorageStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowSchemasStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowSchemasStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowSchemasStmt(s)
+		listenerT.EnterCreateStorageStmt(s)
 	}
 }
 
-func (s *ShowSchemasStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowSchemasStmt(s)
+		listenerT.ExitCreateStorageStmt(s)
 	}
 }
 
-func (s *ShowSchemasStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowSchemasStmt(s)
+		return t.VisitCreateStorageStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
+func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowSchemasStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, SQLParserRULE_showSchemasStmt)
+	localctx = NewCreateStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, SQLParserRULE_createStorageStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5369,64 +6028,68 @@ func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(375)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(453)
+		p.Match(SQLParserT_CREATE)
 	}
 	{
-		p.SetState(376)
-		p.Match(SQLParserT_SCHEMAS)
+		p.SetState(454)
+		p.Match(SQLParserT_STORAGE)
+	}
+	{
+		p.SetState(455)
+		p.Json()
 	}
 
 	return localctx
 }
 
-// ICreateDatabaseStmtContext is an interface to support dynamic dispatch.
-type ICreateDatabaseStmtContext interface {
+// ICreateBrokerStmtContext is an interface to support dynamic dispatch.
+type ICreateBrokerStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCreateDatabaseStmtContext differentiates from other interfaces.
-	IsCreateDatabaseStmtContext()
+	// IsCreateBrokerStmtContext differentiates from other interfaces.
+	IsCreateBrokerStmtContext()
 }
 
-type CreateDatabaseStmtContext struct {
+type CreateBrokerStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCreateDatabaseStmtContext() *CreateDatabaseStmtContext {
-	var p = new(CreateDatabaseStmtContext)
+func NewEmptyCreateBrokerStmtContext() *CreateBrokerStmtContext {
+	var p = new(CreateBrokerStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_createDatabaseStmt
+	p.RuleIndex = SQLParserRULE_createBrokerStmt
 	return p
 }
 
-func (*CreateDatabaseStmtContext) IsCreateDatabaseStmtContext() {}
+func (*CreateBrokerStmtContext) IsCreateBrokerStmtContext() {}
 
-func NewCreateDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateDatabaseStmtContext {
-	var p = new(CreateDatabaseStmtContext)
+func NewCreateBrokerStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateBrokerStmtContext {
+	var p = new(CreateBrokerStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_createDatabaseStmt
+	p.RuleIndex = SQLParserRULE_createBrokerStmt
 
 	return p
 }
 
-func (s *CreateDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateBrokerStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CreateDatabaseStmtContext) T_CREATE() antlr.TerminalNode {
+func (s *CreateBrokerStmtContext) T_CREATE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_CREATE, 0)
 }
 
-func (s *CreateDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAE, 0)
+func (s *CreateBrokerStmtContext) T_BROKER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKER, 0)
 }
 
-func (s *CreateDatabaseStmtContext) Json() IJsonContext {
+func (s *CreateBrokerStmtContext) Json() IJsonContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IJsonContext); ok {
@@ -5442,42 +6105,42 @@ func (s *CreateDatabaseStmtContext) Json() IJsonContext {
 	return t.(IJsonContext)
 }
 
-func (s *CreateDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateBrokerStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CreateDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateBrokerStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CreateDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateBrokerStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterCreateDatabaseStmt(s)
+		listenerT.EnterCreateBrokerStmt(s)
 	}
 }
 
-func (s *CreateDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateBrokerStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitCreateDatabaseStmt(s)
+		listenerT.ExitCreateBrokerStmt(s)
 	}
 }
 
-func (s *CreateDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateBrokerStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitCreateDatabaseStmt(s)
+		return t.VisitCreateBrokerStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
+func (p *SQLParser) CreateBrokerStmt() (localctx ICreateBrokerStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewCreateDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, SQLParserRULE_createDatabaseStmt)
+	localctx = NewCreateBrokerStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, SQLParserRULE_createBrokerStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5497,71 +6160,71 @@ func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(378)
+		p.SetState(457)
 		p.Match(SQLParserT_CREATE)
 	}
 	{
-		p.SetState(379)
-		p.Match(SQLParserT_DATASBAE)
+		p.SetState(458)
+		p.Match(SQLParserT_BROKER)
 	}
 	{
-		p.SetState(380)
+		p.SetState(459)
 		p.Json()
 	}
 
 	return localctx
 }
 
-// IDropDatabaseStmtContext is an interface to support dynamic dispatch.
-type IDropDatabaseStmtContext interface {
+// IRecoverStorageStmtContext is an interface to support dynamic dispatch.
+type IRecoverStorageStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDropDatabaseStmtContext differentiates from other interfaces.
-	IsDropDatabaseStmtContext()
+	// IsRecoverStorageStmtContext differentiates from other interfaces.
+	IsRecoverStorageStmtContext()
 }
 
-type DropDatabaseStmtContext struct {
+type RecoverStorageStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDropDatabaseStmtContext() *DropDatabaseStmtContext {
-	var p = new(DropDatabaseStmtContext)
+func NewEmptyRecoverStorageStmtContext() *RecoverStorageStmtContext {
+	var p = new(RecoverStorageStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
+	p.RuleIndex = SQLParserRULE_recoverStorageStmt
 	return p
 }
 
-func (*DropDatabaseStmtContext) IsDropDatabaseStmtContext() {}
+func (*RecoverStorageStmtContext) IsRecoverStorageStmtContext() {}
 
-func NewDropDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropDatabaseStmtContext {
-	var p = new(DropDatabaseStmtContext)
+func NewRecoverStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RecoverStorageStmtContext {
+	var p = new(RecoverStorageStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
+	p.RuleIndex = SQLParserRULE_recoverStorageStmt
 
 	return p
 }
 
-func (s *DropDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *RecoverStorageStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DropDatabaseStmtContext) T_DROP() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DROP, 0)
+func (s *RecoverStorageStmtContext) T_RECOVER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RECOVER, 0)
 }
 
-func (s *DropDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAE, 0)
+func (s *RecoverStorageStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
 }
 
-func (s *DropDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
+func (s *RecoverStorageStmtContext) StorageName() IStorageNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDatabaseNameContext); ok {
+		if _, ok := ctx.(IStorageNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5571,45 +6234,45 @@ func (s *DropDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
 		return nil
 	}
 
-	return t.(IDatabaseNameContext)
+	return t.(IStorageNameContext)
 }
 
-func (s *DropDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *RecoverStorageStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DropDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RecoverStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DropDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RecoverStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterDropDatabaseStmt(s)
+		listenerT.EnterRecoverStorageStmt(s)
 	}
 }
 
-func (s *DropDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RecoverStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitDropDatabaseStmt(s)
+		listenerT.ExitRecoverStorageStmt(s)
 	}
 }
 
-func (s *DropDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RecoverStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitDropDatabaseStmt(s)
+		return t.VisitRecoverStorageStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
+func (p *SQLParser) RecoverStorageStmt() (localctx IRecoverStorageStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewDropDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, SQLParserRULE_dropDatabaseStmt)
+	localctx = NewRecoverStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, SQLParserRULE_recoverStorageStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5629,103 +6292,144 @@ func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(382)
-		p.Match(SQLParserT_DROP)
+		p.SetState(461)
+		p.Match(SQLParserT_RECOVER)
 	}
 	{
-		p.SetState(383)
-		p.Match(SQLParserT_DATASBAE)
+		p.SetState(462)
+		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(384)
-		p.DatabaseName()
+		p.SetState(463)
+		p.StorageName()
 	}
 
 	return localctx
 }
 
-// IShowDatabaseStmtContext is an interface to support dynamic dispatch.
-type IShowDatabaseStmtContext interface {
+// IDecommissionStorageNodeStmtContext is an interface to support dynamic dispatch.
+type IDecommissionStorageNodeStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowDatabaseStmtContext differentiates from other interfaces.
-	IsShowDatabaseStmtContext()
+	// IsDecommissionStorageNodeStmtContext differentiates from other interfaces.
+	IsDecommissionStorageNodeStmtContext()
 }
 
-type ShowDatabaseStmtContext struct {
+type DecommissionStorageNodeStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowDatabaseStmtContext() *ShowDatabaseStmtContext {
-	var p = new(ShowDatabaseStmtContext)
+func NewEmptyDecommissionStorageNodeStmtContext() *DecommissionStorageNodeStmtContext {
+	var p = new(DecommissionStorageNodeStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+	p.RuleIndex = SQLParserRULE_decommissionStorageNodeStmt
 	return p
 }
 
-func (*ShowDatabaseStmtContext) IsShowDatabaseStmtContext() {}
+func (*DecommissionStorageNodeStmtContext) IsDecommissionStorageNodeStmtContext() {}
 
-func NewShowDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowDatabaseStmtContext {
-	var p = new(ShowDatabaseStmtContext)
+func NewDecommissionStorageNodeStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DecommissionStorageNodeStmtContext {
+	var p = new(DecommissionStorageNodeStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+	p.RuleIndex = SQLParserRULE_decommissionStorageNodeStmt
 
 	return p
 }
 
-func (s *ShowDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *DecommissionStorageNodeStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *DecommissionStorageNodeStmtContext) T_DECOMMISSION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DECOMMISSION, 0)
 }
 
-func (s *ShowDatabaseStmtContext) T_DATASBAES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAES, 0)
+func (s *DecommissionStorageNodeStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
 }
 
-func (s *ShowDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *DecommissionStorageNodeStmtContext) T_NODE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NODE, 0)
+}
+
+func (s *DecommissionStorageNodeStmtContext) NodeID() INodeIDContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INodeIDContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INodeIDContext)
+}
+
+func (s *DecommissionStorageNodeStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *DecommissionStorageNodeStmtContext) StorageFilter() IStorageFilterContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStorageFilterContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStorageFilterContext)
+}
+
+func (s *DecommissionStorageNodeStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DecommissionStorageNodeStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DecommissionStorageNodeStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowDatabaseStmt(s)
+		listenerT.EnterDecommissionStorageNodeStmt(s)
 	}
 }
 
-func (s *ShowDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DecommissionStorageNodeStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowDatabaseStmt(s)
+		listenerT.ExitDecommissionStorageNodeStmt(s)
 	}
 }
 
-func (s *ShowDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DecommissionStorageNodeStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowDatabaseStmt(s)
+		return t.VisitDecommissionStorageNodeStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
+func (p *SQLParser) DecommissionStorageNodeStmt() (localctx IDecommissionStorageNodeStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, SQLParserRULE_showDatabaseStmt)
+	localctx = NewDecommissionStorageNodeStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, SQLParserRULE_decommissionStorageNodeStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5745,79 +6449,114 @@ func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(386)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(465)
+		p.Match(SQLParserT_DECOMMISSION)
 	}
 	{
-		p.SetState(387)
-		p.Match(SQLParserT_DATASBAES)
+		p.SetState(466)
+		p.Match(SQLParserT_STORAGE)
+	}
+	{
+		p.SetState(467)
+		p.Match(SQLParserT_NODE)
+	}
+	{
+		p.SetState(468)
+		p.NodeID()
+	}
+	p.SetState(471)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(469)
+			p.Match(SQLParserT_WHERE)
+		}
+		{
+			p.SetState(470)
+			p.StorageFilter()
+		}
+
 	}
 
 	return localctx
 }
 
-// IShowNameSpacesStmtContext is an interface to support dynamic dispatch.
-type IShowNameSpacesStmtContext interface {
+// ITransferLeaderStmtContext is an interface to support dynamic dispatch.
+type ITransferLeaderStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowNameSpacesStmtContext differentiates from other interfaces.
-	IsShowNameSpacesStmtContext()
+	// IsTransferLeaderStmtContext differentiates from other interfaces.
+	IsTransferLeaderStmtContext()
 }
 
-type ShowNameSpacesStmtContext struct {
+type TransferLeaderStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowNameSpacesStmtContext() *ShowNameSpacesStmtContext {
-	var p = new(ShowNameSpacesStmtContext)
+func NewEmptyTransferLeaderStmtContext() *TransferLeaderStmtContext {
+	var p = new(TransferLeaderStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+	p.RuleIndex = SQLParserRULE_transferLeaderStmt
 	return p
 }
 
-func (*ShowNameSpacesStmtContext) IsShowNameSpacesStmtContext() {}
+func (*TransferLeaderStmtContext) IsTransferLeaderStmtContext() {}
 
-func NewShowNameSpacesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowNameSpacesStmtContext {
-	var p = new(ShowNameSpacesStmtContext)
+func NewTransferLeaderStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TransferLeaderStmtContext {
+	var p = new(TransferLeaderStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+	p.RuleIndex = SQLParserRULE_transferLeaderStmt
 
 	return p
 }
 
-func (s *ShowNameSpacesStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *TransferLeaderStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowNameSpacesStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *TransferLeaderStmtContext) T_TRANSFER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TRANSFER, 0)
 }
 
-func (s *ShowNameSpacesStmtContext) T_NAMESPACES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NAMESPACES, 0)
+func (s *TransferLeaderStmtContext) T_LEADER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LEADER, 0)
 }
 
-func (s *ShowNameSpacesStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
+func (s *TransferLeaderStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
 }
 
-func (s *ShowNameSpacesStmtContext) T_NAMESPACE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NAMESPACE, 0)
+func (s *TransferLeaderStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
 }
 
-func (s *ShowNameSpacesStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *TransferLeaderStmtContext) T_SHARD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHARD, 0)
 }
 
-func (s *ShowNameSpacesStmtContext) Prefix() IPrefixContext {
+func (s *TransferLeaderStmtContext) ShardID() IShardIDContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrefixContext); ok {
+		if _, ok := ctx.(IShardIDContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5827,13 +6566,21 @@ func (s *ShowNameSpacesStmtContext) Prefix() IPrefixContext {
 		return nil
 	}
 
-	return t.(IPrefixContext)
+	return t.(IShardIDContext)
 }
 
-func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
+func (s *TransferLeaderStmtContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
+}
+
+func (s *TransferLeaderStmtContext) T_NODE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NODE, 0)
+}
+
+func (s *TransferLeaderStmtContext) NodeID() INodeIDContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
+		if _, ok := ctx.(INodeIDContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5843,45 +6590,45 @@ func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
 		return nil
 	}
 
-	return t.(ILimitClauseContext)
+	return t.(INodeIDContext)
 }
 
-func (s *ShowNameSpacesStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *TransferLeaderStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowNameSpacesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TransferLeaderStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowNameSpacesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TransferLeaderStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowNameSpacesStmt(s)
+		listenerT.EnterTransferLeaderStmt(s)
 	}
 }
 
-func (s *ShowNameSpacesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TransferLeaderStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowNameSpacesStmt(s)
+		listenerT.ExitTransferLeaderStmt(s)
 	}
 }
 
-func (s *ShowNameSpacesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TransferLeaderStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowNameSpacesStmt(s)
+		return t.VisitTransferLeaderStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
+func (p *SQLParser) TransferLeaderStmt() (localctx ITransferLeaderStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowNameSpacesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, SQLParserRULE_showNameSpacesStmt)
+	localctx = NewTransferLeaderStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, SQLParserRULE_transferLeaderStmt)
 	var _la int
 
 	defer func() {
@@ -5902,44 +6649,45 @@ func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(389)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(473)
+		p.Match(SQLParserT_TRANSFER)
 	}
 	{
-		p.SetState(390)
-		p.Match(SQLParserT_NAMESPACES)
+		p.SetState(474)
+		p.Match(SQLParserT_LEADER)
+	}
+	{
+		p.SetState(475)
+		p.Match(SQLParserT_ON)
+	}
+	{
+		p.SetState(476)
+		p.DatabaseName()
+	}
+	{
+		p.SetState(477)
+		p.Match(SQLParserT_SHARD)
+	}
+	{
+		p.SetState(478)
+		p.ShardID()
 	}
-	p.SetState(395)
+	p.SetState(482)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_WHERE {
-		{
-			p.SetState(391)
-			p.Match(SQLParserT_WHERE)
-		}
-		{
-			p.SetState(392)
-			p.Match(SQLParserT_NAMESPACE)
-		}
+	if _la == SQLParserT_TO {
 		{
-			p.SetState(393)
-			p.Match(SQLParserT_EQUAL)
+			p.SetState(479)
+			p.Match(SQLParserT_TO)
 		}
 		{
-			p.SetState(394)
-			p.Prefix()
+			p.SetState(480)
+			p.Match(SQLParserT_NODE)
 		}
-
-	}
-	p.SetState(398)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_LIMIT {
 		{
-			p.SetState(397)
-			p.LimitClause()
+			p.SetState(481)
+			p.NodeID()
 		}
 
 	}
@@ -5947,153 +6695,88 @@ func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
 	return localctx
 }
 
-// IShowMetricsStmtContext is an interface to support dynamic dispatch.
-type IShowMetricsStmtContext interface {
+// IShowSchemasStmtContext is an interface to support dynamic dispatch.
+type IShowSchemasStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowMetricsStmtContext differentiates from other interfaces.
-	IsShowMetricsStmtContext()
+	// IsShowSchemasStmtContext differentiates from other interfaces.
+	IsShowSchemasStmtContext()
 }
 
-type ShowMetricsStmtContext struct {
+type ShowSchemasStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowMetricsStmtContext() *ShowMetricsStmtContext {
-	var p = new(ShowMetricsStmtContext)
+func NewEmptyShowSchemasStmtContext() *ShowSchemasStmtContext {
+	var p = new(ShowSchemasStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	p.RuleIndex = SQLParserRULE_showSchemasStmt
 	return p
 }
 
-func (*ShowMetricsStmtContext) IsShowMetricsStmtContext() {}
+func (*ShowSchemasStmtContext) IsShowSchemasStmtContext() {}
 
-func NewShowMetricsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetricsStmtContext {
-	var p = new(ShowMetricsStmtContext)
+func NewShowSchemasStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowSchemasStmtContext {
+	var p = new(ShowSchemasStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	p.RuleIndex = SQLParserRULE_showSchemasStmt
 
 	return p
 }
 
-func (s *ShowMetricsStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowSchemasStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowMetricsStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowSchemasStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowMetricsStmtContext) T_METRICS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRICS, 0)
-}
-
-func (s *ShowMetricsStmtContext) T_ON() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ON, 0)
-}
-
-func (s *ShowMetricsStmtContext) Namespace() INamespaceContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INamespaceContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(INamespaceContext)
-}
-
-func (s *ShowMetricsStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowMetricsStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
-}
-
-func (s *ShowMetricsStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
-}
-
-func (s *ShowMetricsStmtContext) Prefix() IPrefixContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrefixContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IPrefixContext)
-}
-
-func (s *ShowMetricsStmtContext) LimitClause() ILimitClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ILimitClauseContext)
+func (s *ShowSchemasStmtContext) T_SCHEMAS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SCHEMAS, 0)
 }
 
-func (s *ShowMetricsStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowSchemasStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowMetricsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowSchemasStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowMetricsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowSchemasStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowMetricsStmt(s)
+		listenerT.EnterShowSchemasStmt(s)
 	}
 }
 
-func (s *ShowMetricsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowSchemasStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowMetricsStmt(s)
+		listenerT.ExitShowSchemasStmt(s)
 	}
 }
 
-func (s *ShowMetricsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowSchemasStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowMetricsStmt(s)
+		return t.VisitShowSchemasStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
+func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowMetricsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, SQLParserRULE_showMetricsStmt)
-	var _la int
+	localctx = NewShowSchemasStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, SQLParserRULE_showSchemasStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6113,116 +6796,67 @@ func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(400)
+		p.SetState(484)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(401)
-		p.Match(SQLParserT_METRICS)
-	}
-	p.SetState(404)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_ON {
-		{
-			p.SetState(402)
-			p.Match(SQLParserT_ON)
-		}
-		{
-			p.SetState(403)
-			p.Namespace()
-		}
-
-	}
-	p.SetState(410)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_WHERE {
-		{
-			p.SetState(406)
-			p.Match(SQLParserT_WHERE)
-		}
-		{
-			p.SetState(407)
-			p.Match(SQLParserT_METRIC)
-		}
-		{
-			p.SetState(408)
-			p.Match(SQLParserT_EQUAL)
-		}
-		{
-			p.SetState(409)
-			p.Prefix()
-		}
-
-	}
-	p.SetState(413)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_LIMIT {
-		{
-			p.SetState(412)
-			p.LimitClause()
-		}
-
+		p.SetState(485)
+		p.Match(SQLParserT_SCHEMAS)
 	}
 
 	return localctx
 }
 
-// IShowFieldsStmtContext is an interface to support dynamic dispatch.
-type IShowFieldsStmtContext interface {
+// ICreateDatabaseStmtContext is an interface to support dynamic dispatch.
+type ICreateDatabaseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowFieldsStmtContext differentiates from other interfaces.
-	IsShowFieldsStmtContext()
+	// IsCreateDatabaseStmtContext differentiates from other interfaces.
+	IsCreateDatabaseStmtContext()
 }
 
-type ShowFieldsStmtContext struct {
+type CreateDatabaseStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowFieldsStmtContext() *ShowFieldsStmtContext {
-	var p = new(ShowFieldsStmtContext)
+func NewEmptyCreateDatabaseStmtContext() *CreateDatabaseStmtContext {
+	var p = new(CreateDatabaseStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showFieldsStmt
+	p.RuleIndex = SQLParserRULE_createDatabaseStmt
 	return p
 }
 
-func (*ShowFieldsStmtContext) IsShowFieldsStmtContext() {}
+func (*CreateDatabaseStmtContext) IsCreateDatabaseStmtContext() {}
 
-func NewShowFieldsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowFieldsStmtContext {
-	var p = new(ShowFieldsStmtContext)
+func NewCreateDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateDatabaseStmtContext {
+	var p = new(CreateDatabaseStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showFieldsStmt
+	p.RuleIndex = SQLParserRULE_createDatabaseStmt
 
 	return p
 }
 
-func (s *ShowFieldsStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowFieldsStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *CreateDatabaseStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
 }
 
-func (s *ShowFieldsStmtContext) T_FIELDS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_FIELDS, 0)
+func (s *CreateDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
 }
 
-func (s *ShowFieldsStmtContext) FromClause() IFromClauseContext {
+func (s *CreateDatabaseStmtContext) Json() IJsonContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFromClauseContext); ok {
+		if _, ok := ctx.(IJsonContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6232,45 +6866,45 @@ func (s *ShowFieldsStmtContext) FromClause() IFromClauseContext {
 		return nil
 	}
 
-	return t.(IFromClauseContext)
+	return t.(IJsonContext)
 }
 
-func (s *ShowFieldsStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowFieldsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowFieldsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowFieldsStmt(s)
+		listenerT.EnterCreateDatabaseStmt(s)
 	}
 }
 
-func (s *ShowFieldsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowFieldsStmt(s)
+		listenerT.ExitCreateDatabaseStmt(s)
 	}
 }
 
-func (s *ShowFieldsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowFieldsStmt(s)
+		return t.VisitCreateDatabaseStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
+func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowFieldsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, SQLParserRULE_showFieldsStmt)
+	localctx = NewCreateDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, SQLParserRULE_createDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6290,75 +6924,71 @@ func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(415)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(487)
+		p.Match(SQLParserT_CREATE)
 	}
 	{
-		p.SetState(416)
-		p.Match(SQLParserT_FIELDS)
+		p.SetState(488)
+		p.Match(SQLParserT_DATASBAE)
 	}
 	{
-		p.SetState(417)
-		p.FromClause()
+		p.SetState(489)
+		p.Json()
 	}
 
 	return localctx
 }
 
-// IShowTagKeysStmtContext is an interface to support dynamic dispatch.
-type IShowTagKeysStmtContext interface {
+// IDropDatabaseStmtContext is an interface to support dynamic dispatch.
+type IDropDatabaseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowTagKeysStmtContext differentiates from other interfaces.
-	IsShowTagKeysStmtContext()
+	// IsDropDatabaseStmtContext differentiates from other interfaces.
+	IsDropDatabaseStmtContext()
 }
 
-type ShowTagKeysStmtContext struct {
+type DropDatabaseStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowTagKeysStmtContext() *ShowTagKeysStmtContext {
-	var p = new(ShowTagKeysStmtContext)
+func NewEmptyDropDatabaseStmtContext() *DropDatabaseStmtContext {
+	var p = new(DropDatabaseStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showTagKeysStmt
+	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
 	return p
 }
 
-func (*ShowTagKeysStmtContext) IsShowTagKeysStmtContext() {}
+func (*DropDatabaseStmtContext) IsDropDatabaseStmtContext() {}
 
-func NewShowTagKeysStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagKeysStmtContext {
-	var p = new(ShowTagKeysStmtContext)
+func NewDropDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropDatabaseStmtContext {
+	var p = new(DropDatabaseStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showTagKeysStmt
+	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
 
 	return p
 }
 
-func (s *ShowTagKeysStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowTagKeysStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
+func (s *DropDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowTagKeysStmtContext) T_TAG() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TAG, 0)
+func (s *DropDatabaseStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
 }
 
-func (s *ShowTagKeysStmtContext) T_KEYS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_KEYS, 0)
+func (s *DropDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
 }
 
-func (s *ShowTagKeysStmtContext) FromClause() IFromClauseContext {
+func (s *DropDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFromClauseContext); ok {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6368,45 +6998,45 @@ func (s *ShowTagKeysStmtContext) FromClause() IFromClauseContext {
 		return nil
 	}
 
-	return t.(IFromClauseContext)
+	return t.(IDatabaseNameContext)
 }
 
-func (s *ShowTagKeysStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *DropDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowTagKeysStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowTagKeysStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowTagKeysStmt(s)
+		listenerT.EnterDropDatabaseStmt(s)
 	}
 }
 
-func (s *ShowTagKeysStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowTagKeysStmt(s)
+		listenerT.ExitDropDatabaseStmt(s)
 	}
 }
 
-func (s *ShowTagKeysStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowTagKeysStmt(s)
+		return t.VisitDropDatabaseStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
+func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowTagKeysStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, SQLParserRULE_showTagKeysStmt)
+	localctx = NewDropDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, SQLParserRULE_dropDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6426,107 +7056,71 @@ func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(419)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(420)
-		p.Match(SQLParserT_TAG)
+		p.SetState(491)
+		p.Match(SQLParserT_DROP)
 	}
 	{
-		p.SetState(421)
-		p.Match(SQLParserT_KEYS)
+		p.SetState(492)
+		p.Match(SQLParserT_DATASBAE)
 	}
 	{
-		p.SetState(422)
-		p.FromClause()
+		p.SetState(493)
+		p.DatabaseName()
 	}
 
 	return localctx
 }
 
-// IShowTagValuesStmtContext is an interface to support dynamic dispatch.
-type IShowTagValuesStmtContext interface {
+// IDropMetricStmtContext is an interface to support dynamic dispatch.
+type IDropMetricStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowTagValuesStmtContext differentiates from other interfaces.
-	IsShowTagValuesStmtContext()
+	// IsDropMetricStmtContext differentiates from other interfaces.
+	IsDropMetricStmtContext()
 }
 
-type ShowTagValuesStmtContext struct {
+type DropMetricStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowTagValuesStmtContext() *ShowTagValuesStmtContext {
-	var p = new(ShowTagValuesStmtContext)
+func NewEmptyDropMetricStmtContext() *DropMetricStmtContext {
+	var p = new(DropMetricStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+	p.RuleIndex = SQLParserRULE_dropMetricStmt
 	return p
 }
 
-func (*ShowTagValuesStmtContext) IsShowTagValuesStmtContext() {}
+func (*DropMetricStmtContext) IsDropMetricStmtContext() {}
 
-func NewShowTagValuesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagValuesStmtContext {
-	var p = new(ShowTagValuesStmtContext)
+func NewDropMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropMetricStmtContext {
+	var p = new(DropMetricStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+	p.RuleIndex = SQLParserRULE_dropMetricStmt
 
 	return p
 }
 
-func (s *ShowTagValuesStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowTagValuesStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowTagValuesStmtContext) T_TAG() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TAG, 0)
-}
-
-func (s *ShowTagValuesStmtContext) T_VALUES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_VALUES, 0)
-}
-
-func (s *ShowTagValuesStmtContext) FromClause() IFromClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFromClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFromClauseContext)
-}
-
-func (s *ShowTagValuesStmtContext) T_WITH() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WITH, 0)
-}
+func (s *DropMetricStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowTagValuesStmtContext) T_KEY() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_KEY, 0)
+func (s *DropMetricStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
 }
 
-func (s *ShowTagValuesStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *DropMetricStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
 }
 
-func (s *ShowTagValuesStmtContext) WithTagKey() IWithTagKeyContext {
+func (s *DropMetricStmtContext) MetricName() IMetricNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWithTagKeyContext); ok {
+		if _, ok := ctx.(IMetricNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6536,29 +7130,17 @@ func (s *ShowTagValuesStmtContext) WithTagKey() IWithTagKeyContext {
 		return nil
 	}
 
-	return t.(IWithTagKeyContext)
+	return t.(IMetricNameContext)
 }
 
-func (s *ShowTagValuesStmtContext) WhereClause() IWhereClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWhereClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IWhereClauseContext)
+func (s *DropMetricStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
 }
 
-func (s *ShowTagValuesStmtContext) LimitClause() ILimitClauseContext {
+func (s *DropMetricStmtContext) Namespace() INamespaceContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
+		if _, ok := ctx.(INamespaceContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6568,45 +7150,45 @@ func (s *ShowTagValuesStmtContext) LimitClause() ILimitClauseContext {
 		return nil
 	}
 
-	return t.(ILimitClauseContext)
+	return t.(INamespaceContext)
 }
 
-func (s *ShowTagValuesStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *DropMetricStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowTagValuesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowTagValuesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowTagValuesStmt(s)
+		listenerT.EnterDropMetricStmt(s)
 	}
 }
 
-func (s *ShowTagValuesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowTagValuesStmt(s)
+		listenerT.ExitDropMetricStmt(s)
 	}
 }
 
-func (s *ShowTagValuesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowTagValuesStmt(s)
+		return t.VisitDropMetricStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
+func (p *SQLParser) DropMetricStmt() (localctx IDropMetricStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowTagValuesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, SQLParserRULE_showTagValuesStmt)
+	localctx = NewDropMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, SQLParserRULE_dropMetricStmt)
 	var _la int
 
 	defer func() {
@@ -6627,56 +7209,29 @@ func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(424)
-		p.Match(SQLParserT_SHOW)
-	}
-	{
-		p.SetState(425)
-		p.Match(SQLParserT_TAG)
-	}
-	{
-		p.SetState(426)
-		p.Match(SQLParserT_VALUES)
-	}
-	{
-		p.SetState(427)
-		p.FromClause()
-	}
-	{
-		p.SetState(428)
-		p.Match(SQLParserT_WITH)
-	}
-	{
-		p.SetState(429)
-		p.Match(SQLParserT_KEY)
+		p.SetState(495)
+		p.Match(SQLParserT_DROP)
 	}
 	{
-		p.SetState(430)
-		p.Match(SQLParserT_EQUAL)
+		p.SetState(496)
+		p.Match(SQLParserT_METRIC)
 	}
 	{
-		p.SetState(431)
-		p.WithTagKey()
+		p.SetState(497)
+		p.MetricName()
 	}
-	p.SetState(433)
+	p.SetState(500)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_WHERE {
+	if _la == SQLParserT_ON {
 		{
-			p.SetState(432)
-			p.WhereClause()
+			p.SetState(498)
+			p.Match(SQLParserT_ON)
 		}
-
-	}
-	p.SetState(436)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_LIMIT {
 		{
-			p.SetState(435)
-			p.LimitClause()
+			p.SetState(499)
+			p.Namespace()
 		}
 
 	}
@@ -6684,48 +7239,52 @@ func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
 	return localctx
 }
 
-// IPrefixContext is an interface to support dynamic dispatch.
-type IPrefixContext interface {
+// IDeleteStmtContext is an interface to support dynamic dispatch.
+type IDeleteStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsPrefixContext differentiates from other interfaces.
-	IsPrefixContext()
+	// IsDeleteStmtContext differentiates from other interfaces.
+	IsDeleteStmtContext()
 }
 
-type PrefixContext struct {
+type DeleteStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPrefixContext() *PrefixContext {
-	var p = new(PrefixContext)
+func NewEmptyDeleteStmtContext() *DeleteStmtContext {
+	var p = new(DeleteStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_prefix
+	p.RuleIndex = SQLParserRULE_deleteStmt
 	return p
 }
 
-func (*PrefixContext) IsPrefixContext() {}
+func (*DeleteStmtContext) IsDeleteStmtContext() {}
 
-func NewPrefixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrefixContext {
-	var p = new(PrefixContext)
+func NewDeleteStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeleteStmtContext {
+	var p = new(DeleteStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_prefix
+	p.RuleIndex = SQLParserRULE_deleteStmt
 
 	return p
 }
 
-func (s *PrefixContext) GetParser() antlr.Parser { return s.parser }
+func (s *DeleteStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PrefixContext) Ident() IIdentContext {
+func (s *DeleteStmtContext) T_DELETE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELETE, 0)
+}
+
+func (s *DeleteStmtContext) FromClause() IFromClauseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(IFromClauseContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6735,45 +7294,61 @@ func (s *PrefixContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IFromClauseContext)
 }
 
-func (s *PrefixContext) GetRuleContext() antlr.RuleContext {
+func (s *DeleteStmtContext) WhereClause() IWhereClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWhereClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWhereClauseContext)
+}
+
+func (s *DeleteStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PrefixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DeleteStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PrefixContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DeleteStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterPrefix(s)
+		listenerT.EnterDeleteStmt(s)
 	}
 }
 
-func (s *PrefixContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DeleteStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitPrefix(s)
+		listenerT.ExitDeleteStmt(s)
 	}
 }
 
-func (s *PrefixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DeleteStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitPrefix(s)
+		return t.VisitDeleteStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Prefix() (localctx IPrefixContext) {
+func (p *SQLParser) DeleteStmt() (localctx IDeleteStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewPrefixContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, SQLParserRULE_prefix)
+	localctx = NewDeleteStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, SQLParserRULE_deleteStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6793,103 +7368,103 @@ func (p *SQLParser) Prefix() (localctx IPrefixContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(438)
-		p.Ident()
+		p.SetState(502)
+		p.Match(SQLParserT_DELETE)
+	}
+	{
+		p.SetState(503)
+		p.FromClause()
+	}
+	{
+		p.SetState(504)
+		p.WhereClause()
 	}
 
 	return localctx
 }
 
-// IWithTagKeyContext is an interface to support dynamic dispatch.
-type IWithTagKeyContext interface {
+// IShowDatabaseStmtContext is an interface to support dynamic dispatch.
+type IShowDatabaseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsWithTagKeyContext differentiates from other interfaces.
-	IsWithTagKeyContext()
+	// IsShowDatabaseStmtContext differentiates from other interfaces.
+	IsShowDatabaseStmtContext()
 }
 
-type WithTagKeyContext struct {
+type ShowDatabaseStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyWithTagKeyContext() *WithTagKeyContext {
-	var p = new(WithTagKeyContext)
+func NewEmptyShowDatabaseStmtContext() *ShowDatabaseStmtContext {
+	var p = new(ShowDatabaseStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_withTagKey
+	p.RuleIndex = SQLParserRULE_showDatabaseStmt
 	return p
 }
 
-func (*WithTagKeyContext) IsWithTagKeyContext() {}
+func (*ShowDatabaseStmtContext) IsShowDatabaseStmtContext() {}
 
-func NewWithTagKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithTagKeyContext {
-	var p = new(WithTagKeyContext)
+func NewShowDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowDatabaseStmtContext {
+	var p = new(ShowDatabaseStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_withTagKey
+	p.RuleIndex = SQLParserRULE_showDatabaseStmt
 
 	return p
 }
 
-func (s *WithTagKeyContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WithTagKeyContext) Ident() IIdentContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ShowDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
 
-	return t.(IIdentContext)
+func (s *ShowDatabaseStmtContext) T_DATASBAES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAES, 0)
 }
 
-func (s *WithTagKeyContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *WithTagKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *WithTagKeyContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterWithTagKey(s)
+		listenerT.EnterShowDatabaseStmt(s)
 	}
 }
 
-func (s *WithTagKeyContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitWithTagKey(s)
+		listenerT.ExitShowDatabaseStmt(s)
 	}
 }
 
-func (s *WithTagKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitWithTagKey(s)
+		return t.VisitShowDatabaseStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
+func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewWithTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, SQLParserRULE_withTagKey)
+	localctx = NewShowDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, SQLParserRULE_showDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6909,103 +7484,99 @@ func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(440)
-		p.Ident()
+		p.SetState(506)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(507)
+		p.Match(SQLParserT_DATASBAES)
 	}
 
 	return localctx
 }
 
-// INamespaceContext is an interface to support dynamic dispatch.
-type INamespaceContext interface {
+// IShowUsersStmtContext is an interface to support dynamic dispatch.
+type IShowUsersStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsNamespaceContext differentiates from other interfaces.
-	IsNamespaceContext()
+	// IsShowUsersStmtContext differentiates from other interfaces.
+	IsShowUsersStmtContext()
 }
 
-type NamespaceContext struct {
+type ShowUsersStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNamespaceContext() *NamespaceContext {
-	var p = new(NamespaceContext)
+func NewEmptyShowUsersStmtContext() *ShowUsersStmtContext {
+	var p = new(ShowUsersStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_namespace
+	p.RuleIndex = SQLParserRULE_showUsersStmt
 	return p
 }
 
-func (*NamespaceContext) IsNamespaceContext() {}
+func (*ShowUsersStmtContext) IsShowUsersStmtContext() {}
 
-func NewNamespaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceContext {
-	var p = new(NamespaceContext)
+func NewShowUsersStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowUsersStmtContext {
+	var p = new(ShowUsersStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_namespace
+	p.RuleIndex = SQLParserRULE_showUsersStmt
 
 	return p
 }
 
-func (s *NamespaceContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *NamespaceContext) Ident() IIdentContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *ShowUsersStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
+func (s *ShowUsersStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
 
-	return t.(IIdentContext)
+func (s *ShowUsersStmtContext) T_USERS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USERS, 0)
 }
 
-func (s *NamespaceContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowUsersStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NamespaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowUsersStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NamespaceContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowUsersStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterNamespace(s)
+		listenerT.EnterShowUsersStmt(s)
 	}
 }
 
-func (s *NamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowUsersStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitNamespace(s)
+		listenerT.ExitShowUsersStmt(s)
 	}
 }
 
-func (s *NamespaceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowUsersStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitNamespace(s)
+		return t.VisitShowUsersStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Namespace() (localctx INamespaceContext) {
+func (p *SQLParser) ShowUsersStmt() (localctx IShowUsersStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, SQLParserRULE_namespace)
+	localctx = NewShowUsersStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, SQLParserRULE_showUsersStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -7025,103 +7596,99 @@ func (p *SQLParser) Namespace() (localctx INamespaceContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(442)
-		p.Ident()
+		p.SetState(509)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(510)
+		p.Match(SQLParserT_USERS)
 	}
 
 	return localctx
 }
 
-// IDatabaseNameContext is an interface to support dynamic dispatch.
-type IDatabaseNameContext interface {
+// IShowRolesStmtContext is an interface to support dynamic dispatch.
+type IShowRolesStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDatabaseNameContext differentiates from other interfaces.
-	IsDatabaseNameContext()
+	// IsShowRolesStmtContext differentiates from other interfaces.
+	IsShowRolesStmtContext()
 }
 
-type DatabaseNameContext struct {
+type ShowRolesStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDatabaseNameContext() *DatabaseNameContext {
-	var p = new(DatabaseNameContext)
+func NewEmptyShowRolesStmtContext() *ShowRolesStmtContext {
+	var p = new(ShowRolesStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_databaseName
+	p.RuleIndex = SQLParserRULE_showRolesStmt
 	return p
 }
 
-func (*DatabaseNameContext) IsDatabaseNameContext() {}
+func (*ShowRolesStmtContext) IsShowRolesStmtContext() {}
 
-func NewDatabaseNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseNameContext {
-	var p = new(DatabaseNameContext)
+func NewShowRolesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowRolesStmtContext {
+	var p = new(ShowRolesStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_databaseName
+	p.RuleIndex = SQLParserRULE_showRolesStmt
 
 	return p
 }
 
-func (s *DatabaseNameContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *DatabaseNameContext) Ident() IIdentContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *ShowRolesStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
+func (s *ShowRolesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
 
-	return t.(IIdentContext)
+func (s *ShowRolesStmtContext) T_ROLES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLES, 0)
 }
 
-func (s *DatabaseNameContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowRolesStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DatabaseNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowRolesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DatabaseNameContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowRolesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterDatabaseName(s)
+		listenerT.EnterShowRolesStmt(s)
 	}
 }
 
-func (s *DatabaseNameContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowRolesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitDatabaseName(s)
+		listenerT.ExitShowRolesStmt(s)
 	}
 }
 
-func (s *DatabaseNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowRolesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitDatabaseName(s)
+		return t.VisitShowRolesStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
+func (p *SQLParser) ShowRolesStmt() (localctx IShowRolesStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewDatabaseNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, SQLParserRULE_databaseName)
+	localctx = NewShowRolesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, SQLParserRULE_showRolesStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -7141,55 +7708,67 @@ func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(444)
-		p.Ident()
+		p.SetState(512)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(513)
+		p.Match(SQLParserT_ROLES)
 	}
 
 	return localctx
 }
 
-// IStorageNameContext is an interface to support dynamic dispatch.
-type IStorageNameContext interface {
+// ICreateUserStmtContext is an interface to support dynamic dispatch.
+type ICreateUserStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsStorageNameContext differentiates from other interfaces.
-	IsStorageNameContext()
+	// IsCreateUserStmtContext differentiates from other interfaces.
+	IsCreateUserStmtContext()
 }
 
-type StorageNameContext struct {
+type CreateUserStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStorageNameContext() *StorageNameContext {
-	var p = new(StorageNameContext)
+func NewEmptyCreateUserStmtContext() *CreateUserStmtContext {
+	var p = new(CreateUserStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_storageName
+	p.RuleIndex = SQLParserRULE_createUserStmt
 	return p
 }
 
-func (*StorageNameContext) IsStorageNameContext() {}
+func (*CreateUserStmtContext) IsCreateUserStmtContext() {}
 
-func NewStorageNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StorageNameContext {
-	var p = new(StorageNameContext)
+func NewCreateUserStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateUserStmtContext {
+	var p = new(CreateUserStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_storageName
+	p.RuleIndex = SQLParserRULE_createUserStmt
 
 	return p
 }
 
-func (s *StorageNameContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateUserStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StorageNameContext) Ident() IIdentContext {
+func (s *CreateUserStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
+}
+
+func (s *CreateUserStmtContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *CreateUserStmtContext) UserName() IUserNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(IUserNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7199,45 +7778,69 @@ func (s *StorageNameContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IUserNameContext)
 }
 
-func (s *StorageNameContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateUserStmtContext) T_WITH() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH, 0)
+}
+
+func (s *CreateUserStmtContext) T_PASSWORD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PASSWORD, 0)
+}
+
+func (s *CreateUserStmtContext) Password() IPasswordContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPasswordContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPasswordContext)
+}
+
+func (s *CreateUserStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StorageNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateUserStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StorageNameContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateUserStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterStorageName(s)
+		listenerT.EnterCreateUserStmt(s)
 	}
 }
 
-func (s *StorageNameContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateUserStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitStorageName(s)
+		listenerT.ExitCreateUserStmt(s)
 	}
 }
 
-func (s *StorageNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateUserStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitStorageName(s)
+		return t.VisitCreateUserStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) StorageName() (localctx IStorageNameContext) {
+func (p *SQLParser) CreateUserStmt() (localctx ICreateUserStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewStorageNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, SQLParserRULE_storageName)
+	localctx = NewCreateUserStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, SQLParserRULE_createUserStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -7257,55 +7860,83 @@ func (p *SQLParser) StorageName() (localctx IStorageNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(446)
-		p.Ident()
+		p.SetState(515)
+		p.Match(SQLParserT_CREATE)
+	}
+	{
+		p.SetState(516)
+		p.Match(SQLParserT_USER)
+	}
+	{
+		p.SetState(517)
+		p.UserName()
+	}
+	{
+		p.SetState(518)
+		p.Match(SQLParserT_WITH)
+	}
+	{
+		p.SetState(519)
+		p.Match(SQLParserT_PASSWORD)
+	}
+	{
+		p.SetState(520)
+		p.Password()
 	}
 
 	return localctx
 }
 
-// IRequestIDContext is an interface to support dynamic dispatch.
-type IRequestIDContext interface {
+// IDropUserStmtContext is an interface to support dynamic dispatch.
+type IDropUserStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsRequestIDContext differentiates from other interfaces.
-	IsRequestIDContext()
+	// IsDropUserStmtContext differentiates from other interfaces.
+	IsDropUserStmtContext()
 }
 
-type RequestIDContext struct {
+type DropUserStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRequestIDContext() *RequestIDContext {
-	var p = new(RequestIDContext)
+func NewEmptyDropUserStmtContext() *DropUserStmtContext {
+	var p = new(DropUserStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_requestID
+	p.RuleIndex = SQLParserRULE_dropUserStmt
 	return p
 }
 
-func (*RequestIDContext) IsRequestIDContext() {}
+func (*DropUserStmtContext) IsDropUserStmtContext() {}
 
-func NewRequestIDContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RequestIDContext {
-	var p = new(RequestIDContext)
+func NewDropUserStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropUserStmtContext {
+	var p = new(DropUserStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_requestID
+	p.RuleIndex = SQLParserRULE_dropUserStmt
 
 	return p
 }
 
-func (s *RequestIDContext) GetParser() antlr.Parser { return s.parser }
+func (s *DropUserStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RequestIDContext) Ident() IIdentContext {
+func (s *DropUserStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
+}
+
+func (s *DropUserStmtContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *DropUserStmtContext) UserName() IUserNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(IUserNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7315,45 +7946,45 @@ func (s *RequestIDContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IUserNameContext)
 }
 
-func (s *RequestIDContext) GetRuleContext() antlr.RuleContext {
+func (s *DropUserStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RequestIDContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropUserStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RequestIDContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropUserStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterRequestID(s)
+		listenerT.EnterDropUserStmt(s)
 	}
 }
 
-func (s *RequestIDContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropUserStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitRequestID(s)
+		listenerT.ExitDropUserStmt(s)
 	}
 }
 
-func (s *RequestIDContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropUserStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitRequestID(s)
+		return t.VisitDropUserStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
+func (p *SQLParser) DropUserStmt() (localctx IDropUserStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewRequestIDContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, SQLParserRULE_requestID)
+	localctx = NewDropUserStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, SQLParserRULE_dropUserStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -7373,96 +8004,119 @@ func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(448)
-		p.Ident()
+		p.SetState(522)
+		p.Match(SQLParserT_DROP)
+	}
+	{
+		p.SetState(523)
+		p.Match(SQLParserT_USER)
+	}
+	{
+		p.SetState(524)
+		p.UserName()
 	}
 
 	return localctx
 }
 
-// ISourceContext is an interface to support dynamic dispatch.
-type ISourceContext interface {
+// IDropRoleStmtContext is an interface to support dynamic dispatch.
+type IDropRoleStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSourceContext differentiates from other interfaces.
-	IsSourceContext()
+	// IsDropRoleStmtContext differentiates from other interfaces.
+	IsDropRoleStmtContext()
 }
 
-type SourceContext struct {
+type DropRoleStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySourceContext() *SourceContext {
-	var p = new(SourceContext)
+func NewEmptyDropRoleStmtContext() *DropRoleStmtContext {
+	var p = new(DropRoleStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_source
+	p.RuleIndex = SQLParserRULE_dropRoleStmt
 	return p
 }
 
-func (*SourceContext) IsSourceContext() {}
+func (*DropRoleStmtContext) IsDropRoleStmtContext() {}
 
-func NewSourceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceContext {
-	var p = new(SourceContext)
+func NewDropRoleStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropRoleStmtContext {
+	var p = new(DropRoleStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_source
+	p.RuleIndex = SQLParserRULE_dropRoleStmt
 
 	return p
 }
 
-func (s *SourceContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SourceContext) T_STATE_MACHINE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STATE_MACHINE, 0)
-}
+func (s *DropRoleStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SourceContext) T_STATE_REPO() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STATE_REPO, 0)
+func (s *DropRoleStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
 }
 
-func (s *SourceContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *DropRoleStmtContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
 }
 
-func (s *SourceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropRoleStmtContext) RoleName() IRoleNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRoleNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRoleNameContext)
+}
+
+func (s *DropRoleStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DropRoleStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SourceContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropRoleStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterSource(s)
+		listenerT.EnterDropRoleStmt(s)
 	}
 }
 
-func (s *SourceContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropRoleStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitSource(s)
+		listenerT.ExitDropRoleStmt(s)
 	}
 }
 
-func (s *SourceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropRoleStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitSource(s)
+		return t.VisitDropRoleStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Source() (localctx ISourceContext) {
+func (p *SQLParser) DropRoleStmt() (localctx IDropRoleStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewSourceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, SQLParserRULE_source)
-	var _la int
+	localctx = NewDropRoleStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, SQLParserRULE_dropRoleStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -7482,62 +8136,67 @@ func (p *SQLParser) Source() (localctx ISourceContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(450)
-		_la = p.GetTokenStream().LA(1)
-
-		if !(_la == SQLParserT_STATE_REPO || _la == SQLParserT_STATE_MACHINE) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
-		}
+		p.SetState(526)
+		p.Match(SQLParserT_DROP)
+	}
+	{
+		p.SetState(527)
+		p.Match(SQLParserT_ROLE)
+	}
+	{
+		p.SetState(528)
+		p.RoleName()
 	}
 
 	return localctx
 }
 
-// IQueryStmtContext is an interface to support dynamic dispatch.
-type IQueryStmtContext interface {
+// IGrantStmtContext is an interface to support dynamic dispatch.
+type IGrantStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsQueryStmtContext differentiates from other interfaces.
-	IsQueryStmtContext()
+	// IsGrantStmtContext differentiates from other interfaces.
+	IsGrantStmtContext()
 }
 
-type QueryStmtContext struct {
+type GrantStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyQueryStmtContext() *QueryStmtContext {
-	var p = new(QueryStmtContext)
+func NewEmptyGrantStmtContext() *GrantStmtContext {
+	var p = new(GrantStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_queryStmt
+	p.RuleIndex = SQLParserRULE_grantStmt
 	return p
 }
 
-func (*QueryStmtContext) IsQueryStmtContext() {}
+func (*GrantStmtContext) IsGrantStmtContext() {}
 
-func NewQueryStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryStmtContext {
-	var p = new(QueryStmtContext)
+func NewGrantStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GrantStmtContext {
+	var p = new(GrantStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_queryStmt
+	p.RuleIndex = SQLParserRULE_grantStmt
 
 	return p
 }
 
-func (s *QueryStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *GrantStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *QueryStmtContext) SourceAndSelect() ISourceAndSelectContext {
+func (s *GrantStmtContext) T_GRANT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_GRANT, 0)
+}
+
+func (s *GrantStmtContext) Privilege() IPrivilegeContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISourceAndSelectContext); ok {
+		if _, ok := ctx.(IPrivilegeContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7547,33 +8206,21 @@ func (s *QueryStmtContext) SourceAndSelect() ISourceAndSelectContext {
 		return nil
 	}
 
-	return t.(ISourceAndSelectContext)
+	return t.(IPrivilegeContext)
 }
 
-func (s *QueryStmtContext) T_EXPLAIN() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EXPLAIN, 0)
+func (s *GrantStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
 }
 
-func (s *QueryStmtContext) WhereClause() IWhereClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWhereClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IWhereClauseContext)
+func (s *GrantStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
 }
 
-func (s *QueryStmtContext) GroupByClause() IGroupByClauseContext {
+func (s *GrantStmtContext) DatabaseName() IDatabaseNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IGroupByClauseContext); ok {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7583,29 +8230,17 @@ func (s *QueryStmtContext) GroupByClause() IGroupByClauseContext {
 		return nil
 	}
 
-	return t.(IGroupByClauseContext)
+	return t.(IDatabaseNameContext)
 }
 
-func (s *QueryStmtContext) OrderByClause() IOrderByClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOrderByClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IOrderByClauseContext)
+func (s *GrantStmtContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
 }
 
-func (s *QueryStmtContext) LimitClause() ILimitClauseContext {
+func (s *GrantStmtContext) RoleName() IRoleNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
+		if _, ok := ctx.(IRoleNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7615,50 +8250,45 @@ func (s *QueryStmtContext) LimitClause() ILimitClauseContext {
 		return nil
 	}
 
-	return t.(ILimitClauseContext)
-}
-
-func (s *QueryStmtContext) T_WITH_VALUE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WITH_VALUE, 0)
+	return t.(IRoleNameContext)
 }
 
-func (s *QueryStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *GrantStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *QueryStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GrantStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *QueryStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GrantStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterQueryStmt(s)
+		listenerT.EnterGrantStmt(s)
 	}
 }
 
-func (s *QueryStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GrantStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitQueryStmt(s)
+		listenerT.ExitGrantStmt(s)
 	}
 }
 
-func (s *QueryStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GrantStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitQueryStmt(s)
+		return t.VisitGrantStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
+func (p *SQLParser) GrantStmt() (localctx IGrantStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, SQLParserRULE_queryStmt)
-	var _la int
+	localctx = NewGrantStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, SQLParserRULE_grantStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -7677,122 +8307,84 @@ func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(453)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_EXPLAIN {
-		{
-			p.SetState(452)
-			p.Match(SQLParserT_EXPLAIN)
-		}
-
+	{
+		p.SetState(530)
+		p.Match(SQLParserT_GRANT)
 	}
 	{
-		p.SetState(455)
-		p.SourceAndSelect()
+		p.SetState(531)
+		p.Privilege()
 	}
-	p.SetState(457)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_WHERE {
-		{
-			p.SetState(456)
-			p.WhereClause()
-		}
-
+	{
+		p.SetState(532)
+		p.Match(SQLParserT_ON)
 	}
-	p.SetState(460)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_GROUP {
-		{
-			p.SetState(459)
-			p.GroupByClause()
-		}
-
+	{
+		p.SetState(533)
+		p.Match(SQLParserT_DATASBAE)
 	}
-	p.SetState(463)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_ORDER {
-		{
-			p.SetState(462)
-			p.OrderByClause()
-		}
-
+	{
+		p.SetState(534)
+		p.DatabaseName()
 	}
-	p.SetState(466)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_LIMIT {
-		{
-			p.SetState(465)
-			p.LimitClause()
-		}
-
+	{
+		p.SetState(535)
+		p.Match(SQLParserT_TO)
 	}
-	p.SetState(469)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_WITH_VALUE {
-		{
-			p.SetState(468)
-			p.Match(SQLParserT_WITH_VALUE)
-		}
-
+	{
+		p.SetState(536)
+		p.RoleName()
 	}
 
 	return localctx
 }
 
-// ISourceAndSelectContext is an interface to support dynamic dispatch.
-type ISourceAndSelectContext interface {
+// IRevokeStmtContext is an interface to support dynamic dispatch.
+type IRevokeStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSourceAndSelectContext differentiates from other interfaces.
-	IsSourceAndSelectContext()
+	// IsRevokeStmtContext differentiates from other interfaces.
+	IsRevokeStmtContext()
 }
 
-type SourceAndSelectContext struct {
+type RevokeStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySourceAndSelectContext() *SourceAndSelectContext {
-	var p = new(SourceAndSelectContext)
+func NewEmptyRevokeStmtContext() *RevokeStmtContext {
+	var p = new(RevokeStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_sourceAndSelect
+	p.RuleIndex = SQLParserRULE_revokeStmt
 	return p
 }
 
-func (*SourceAndSelectContext) IsSourceAndSelectContext() {}
+func (*RevokeStmtContext) IsRevokeStmtContext() {}
 
-func NewSourceAndSelectContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceAndSelectContext {
-	var p = new(SourceAndSelectContext)
+func NewRevokeStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RevokeStmtContext {
+	var p = new(RevokeStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_sourceAndSelect
+	p.RuleIndex = SQLParserRULE_revokeStmt
 
 	return p
 }
 
-func (s *SourceAndSelectContext) GetParser() antlr.Parser { return s.parser }
+func (s *RevokeStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SourceAndSelectContext) SelectExpr() ISelectExprContext {
+func (s *RevokeStmtContext) T_REVOKE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REVOKE, 0)
+}
+
+func (s *RevokeStmtContext) Privilege() IPrivilegeContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISelectExprContext); ok {
+		if _, ok := ctx.(IPrivilegeContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7802,13 +8394,21 @@ func (s *SourceAndSelectContext) SelectExpr() ISelectExprContext {
 		return nil
 	}
 
-	return t.(ISelectExprContext)
+	return t.(IPrivilegeContext)
+}
+
+func (s *RevokeStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
 }
 
-func (s *SourceAndSelectContext) FromClause() IFromClauseContext {
+func (s *RevokeStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
+
+func (s *RevokeStmtContext) DatabaseName() IDatabaseNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFromClauseContext); ok {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7818,45 +8418,65 @@ func (s *SourceAndSelectContext) FromClause() IFromClauseContext {
 		return nil
 	}
 
-	return t.(IFromClauseContext)
+	return t.(IDatabaseNameContext)
 }
 
-func (s *SourceAndSelectContext) GetRuleContext() antlr.RuleContext {
+func (s *RevokeStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *RevokeStmtContext) RoleName() IRoleNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRoleNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRoleNameContext)
+}
+
+func (s *RevokeStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SourceAndSelectContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RevokeStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SourceAndSelectContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RevokeStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterSourceAndSelect(s)
+		listenerT.EnterRevokeStmt(s)
 	}
 }
 
-func (s *SourceAndSelectContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RevokeStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitSourceAndSelect(s)
+		listenerT.ExitRevokeStmt(s)
 	}
 }
 
-func (s *SourceAndSelectContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RevokeStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitSourceAndSelect(s)
+		return t.VisitRevokeStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) SourceAndSelect() (localctx ISourceAndSelectContext) {
+func (p *SQLParser) RevokeStmt() (localctx IRevokeStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewSourceAndSelectContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, SQLParserRULE_sourceAndSelect)
+	localctx = NewRevokeStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, SQLParserRULE_revokeStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -7874,85 +8494,3812 @@ func (p *SQLParser) SourceAndSelect() (localctx ISourceAndSelectContext) {
 		}
 	}()
 
-	p.SetState(477)
-	p.GetErrorHandler().Sync(p)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(538)
+		p.Match(SQLParserT_REVOKE)
+	}
+	{
+		p.SetState(539)
+		p.Privilege()
+	}
+	{
+		p.SetState(540)
+		p.Match(SQLParserT_ON)
+	}
+	{
+		p.SetState(541)
+		p.Match(SQLParserT_DATASBAE)
+	}
+	{
+		p.SetState(542)
+		p.DatabaseName()
+	}
+	{
+		p.SetState(543)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(544)
+		p.RoleName()
+	}
 
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_SELECT:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(471)
-			p.SelectExpr()
-		}
-		{
-			p.SetState(472)
-			p.FromClause()
-		}
+	return localctx
+}
 
-	case SQLParserT_FROM:
-		p.EnterOuterAlt(localctx, 2)
+// IGrantRoleStmtContext is an interface to support dynamic dispatch.
+type IGrantRoleStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsGrantRoleStmtContext differentiates from other interfaces.
+	IsGrantRoleStmtContext()
+}
+
+type GrantRoleStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGrantRoleStmtContext() *GrantRoleStmtContext {
+	var p = new(GrantRoleStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_grantRoleStmt
+	return p
+}
+
+func (*GrantRoleStmtContext) IsGrantRoleStmtContext() {}
+
+func NewGrantRoleStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GrantRoleStmtContext {
+	var p = new(GrantRoleStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_grantRoleStmt
+
+	return p
+}
+
+func (s *GrantRoleStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GrantRoleStmtContext) T_GRANT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_GRANT, 0)
+}
+
+func (s *GrantRoleStmtContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
+}
+
+func (s *GrantRoleStmtContext) RoleName() IRoleNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRoleNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRoleNameContext)
+}
+
+func (s *GrantRoleStmtContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
+}
+
+func (s *GrantRoleStmtContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *GrantRoleStmtContext) UserName() IUserNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUserNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUserNameContext)
+}
+
+func (s *GrantRoleStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GrantRoleStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GrantRoleStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterGrantRoleStmt(s)
+	}
+}
+
+func (s *GrantRoleStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitGrantRoleStmt(s)
+	}
+}
+
+func (s *GrantRoleStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitGrantRoleStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) GrantRoleStmt() (localctx IGrantRoleStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewGrantRoleStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, SQLParserRULE_grantRoleStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(546)
+		p.Match(SQLParserT_GRANT)
+	}
+	{
+		p.SetState(547)
+		p.Match(SQLParserT_ROLE)
+	}
+	{
+		p.SetState(548)
+		p.RoleName()
+	}
+	{
+		p.SetState(549)
+		p.Match(SQLParserT_TO)
+	}
+	{
+		p.SetState(550)
+		p.Match(SQLParserT_USER)
+	}
+	{
+		p.SetState(551)
+		p.UserName()
+	}
+
+	return localctx
+}
+
+// IRevokeRoleStmtContext is an interface to support dynamic dispatch.
+type IRevokeRoleStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsRevokeRoleStmtContext differentiates from other interfaces.
+	IsRevokeRoleStmtContext()
+}
+
+type RevokeRoleStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRevokeRoleStmtContext() *RevokeRoleStmtContext {
+	var p = new(RevokeRoleStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_revokeRoleStmt
+	return p
+}
+
+func (*RevokeRoleStmtContext) IsRevokeRoleStmtContext() {}
+
+func NewRevokeRoleStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RevokeRoleStmtContext {
+	var p = new(RevokeRoleStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_revokeRoleStmt
+
+	return p
+}
+
+func (s *RevokeRoleStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RevokeRoleStmtContext) T_REVOKE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REVOKE, 0)
+}
+
+func (s *RevokeRoleStmtContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
+}
+
+func (s *RevokeRoleStmtContext) RoleName() IRoleNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRoleNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRoleNameContext)
+}
+
+func (s *RevokeRoleStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *RevokeRoleStmtContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *RevokeRoleStmtContext) UserName() IUserNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUserNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUserNameContext)
+}
+
+func (s *RevokeRoleStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RevokeRoleStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RevokeRoleStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterRevokeRoleStmt(s)
+	}
+}
+
+func (s *RevokeRoleStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitRevokeRoleStmt(s)
+	}
+}
+
+func (s *RevokeRoleStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitRevokeRoleStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) RevokeRoleStmt() (localctx IRevokeRoleStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewRevokeRoleStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, SQLParserRULE_revokeRoleStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(553)
+		p.Match(SQLParserT_REVOKE)
+	}
+	{
+		p.SetState(554)
+		p.Match(SQLParserT_ROLE)
+	}
+	{
+		p.SetState(555)
+		p.RoleName()
+	}
+	{
+		p.SetState(556)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(557)
+		p.Match(SQLParserT_USER)
+	}
+	{
+		p.SetState(558)
+		p.UserName()
+	}
+
+	return localctx
+}
+
+// IPrivilegeContext is an interface to support dynamic dispatch.
+type IPrivilegeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsPrivilegeContext differentiates from other interfaces.
+	IsPrivilegeContext()
+}
+
+type PrivilegeContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPrivilegeContext() *PrivilegeContext {
+	var p = new(PrivilegeContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_privilege
+	return p
+}
+
+func (*PrivilegeContext) IsPrivilegeContext() {}
+
+func NewPrivilegeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrivilegeContext {
+	var p = new(PrivilegeContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_privilege
+
+	return p
+}
+
+func (s *PrivilegeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PrivilegeContext) T_READ() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_READ, 0)
+}
+
+func (s *PrivilegeContext) T_WRITE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WRITE, 0)
+}
+
+func (s *PrivilegeContext) T_ADMIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ADMIN, 0)
+}
+
+func (s *PrivilegeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrivilegeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PrivilegeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterPrivilege(s)
+	}
+}
+
+func (s *PrivilegeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitPrivilege(s)
+	}
+}
+
+func (s *PrivilegeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitPrivilege(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) Privilege() (localctx IPrivilegeContext) {
+	this := p
+	_ = this
+
+	localctx = NewPrivilegeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, SQLParserRULE_privilege)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(560)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1924145348608) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+	return localctx
+}
+
+// IShowNameSpacesStmtContext is an interface to support dynamic dispatch.
+type IShowNameSpacesStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowNameSpacesStmtContext differentiates from other interfaces.
+	IsShowNameSpacesStmtContext()
+}
+
+type ShowNameSpacesStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowNameSpacesStmtContext() *ShowNameSpacesStmtContext {
+	var p = new(ShowNameSpacesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+	return p
+}
+
+func (*ShowNameSpacesStmtContext) IsShowNameSpacesStmtContext() {}
+
+func NewShowNameSpacesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowNameSpacesStmtContext {
+	var p = new(ShowNameSpacesStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+
+	return p
+}
+
+func (s *ShowNameSpacesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowNameSpacesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowNameSpacesStmtContext) T_NAMESPACES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACES, 0)
+}
+
+func (s *ShowNameSpacesStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowNameSpacesStmtContext) T_NAMESPACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACE, 0)
+}
+
+func (s *ShowNameSpacesStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowNameSpacesStmtContext) Prefix() IPrefixContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrefixContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrefixContext)
+}
+
+func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowNameSpacesStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowNameSpacesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowNameSpacesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowNameSpacesStmt(s)
+	}
+}
+
+func (s *ShowNameSpacesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowNameSpacesStmt(s)
+	}
+}
+
+func (s *ShowNameSpacesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowNameSpacesStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowNameSpacesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, SQLParserRULE_showNameSpacesStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(562)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(563)
+		p.Match(SQLParserT_NAMESPACES)
+	}
+	p.SetState(568)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(564)
+			p.Match(SQLParserT_WHERE)
+		}
+		{
+			p.SetState(565)
+			p.Match(SQLParserT_NAMESPACE)
+		}
+		{
+			p.SetState(566)
+			p.Match(SQLParserT_EQUAL)
+		}
+		{
+			p.SetState(567)
+			p.Prefix()
+		}
+
+	}
+	p.SetState(571)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(570)
+			p.LimitClause()
+		}
+
+	}
+
+	return localctx
+}
+
+// IShowMetricsStmtContext is an interface to support dynamic dispatch.
+type IShowMetricsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowMetricsStmtContext differentiates from other interfaces.
+	IsShowMetricsStmtContext()
+}
+
+type ShowMetricsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowMetricsStmtContext() *ShowMetricsStmtContext {
+	var p = new(ShowMetricsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	return p
+}
+
+func (*ShowMetricsStmtContext) IsShowMetricsStmtContext() {}
+
+func NewShowMetricsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetricsStmtContext {
+	var p = new(ShowMetricsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showMetricsStmt
+
+	return p
+}
+
+func (s *ShowMetricsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowMetricsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_METRICS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRICS, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *ShowMetricsStmtContext) Namespace() INamespaceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamespaceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamespaceContext)
+}
+
+func (s *ShowMetricsStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowMetricsStmtContext) Prefix() IPrefixContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrefixContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrefixContext)
+}
+
+func (s *ShowMetricsStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowMetricsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowMetricsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowMetricsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowMetricsStmt(s)
+	}
+}
+
+func (s *ShowMetricsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowMetricsStmt(s)
+	}
+}
+
+func (s *ShowMetricsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowMetricsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowMetricsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, SQLParserRULE_showMetricsStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(573)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(574)
+		p.Match(SQLParserT_METRICS)
+	}
+	p.SetState(577)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(575)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(576)
+			p.Namespace()
+		}
+
+	}
+	p.SetState(583)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(579)
+			p.Match(SQLParserT_WHERE)
+		}
+		{
+			p.SetState(580)
+			p.Match(SQLParserT_METRIC)
+		}
+		{
+			p.SetState(581)
+			p.Match(SQLParserT_EQUAL)
+		}
+		{
+			p.SetState(582)
+			p.Prefix()
+		}
+
+	}
+	p.SetState(586)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(585)
+			p.LimitClause()
+		}
+
+	}
+
+	return localctx
+}
+
+// IShowFieldsStmtContext is an interface to support dynamic dispatch.
+type IShowFieldsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowFieldsStmtContext differentiates from other interfaces.
+	IsShowFieldsStmtContext()
+}
+
+type ShowFieldsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowFieldsStmtContext() *ShowFieldsStmtContext {
+	var p = new(ShowFieldsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showFieldsStmt
+	return p
+}
+
+func (*ShowFieldsStmtContext) IsShowFieldsStmtContext() {}
+
+func NewShowFieldsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowFieldsStmtContext {
+	var p = new(ShowFieldsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showFieldsStmt
+
+	return p
+}
+
+func (s *ShowFieldsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowFieldsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowFieldsStmtContext) T_FIELDS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FIELDS, 0)
+}
+
+func (s *ShowFieldsStmtContext) FromClause() IFromClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFromClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFromClauseContext)
+}
+
+func (s *ShowFieldsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowFieldsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowFieldsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowFieldsStmt(s)
+	}
+}
+
+func (s *ShowFieldsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowFieldsStmt(s)
+	}
+}
+
+func (s *ShowFieldsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowFieldsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowFieldsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, SQLParserRULE_showFieldsStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(588)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(589)
+		p.Match(SQLParserT_FIELDS)
+	}
+	{
+		p.SetState(590)
+		p.FromClause()
+	}
+
+	return localctx
+}
+
+// IShowTagKeysStmtContext is an interface to support dynamic dispatch.
+type IShowTagKeysStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowTagKeysStmtContext differentiates from other interfaces.
+	IsShowTagKeysStmtContext()
+}
+
+type ShowTagKeysStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowTagKeysStmtContext() *ShowTagKeysStmtContext {
+	var p = new(ShowTagKeysStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showTagKeysStmt
+	return p
+}
+
+func (*ShowTagKeysStmtContext) IsShowTagKeysStmtContext() {}
+
+func NewShowTagKeysStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagKeysStmtContext {
+	var p = new(ShowTagKeysStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showTagKeysStmt
+
+	return p
+}
+
+func (s *ShowTagKeysStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowTagKeysStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowTagKeysStmtContext) T_TAG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TAG, 0)
+}
+
+func (s *ShowTagKeysStmtContext) T_KEYS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_KEYS, 0)
+}
+
+func (s *ShowTagKeysStmtContext) FromClause() IFromClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFromClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFromClauseContext)
+}
+
+func (s *ShowTagKeysStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowTagKeysStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowTagKeysStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowTagKeysStmt(s)
+	}
+}
+
+func (s *ShowTagKeysStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowTagKeysStmt(s)
+	}
+}
+
+func (s *ShowTagKeysStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowTagKeysStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowTagKeysStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, SQLParserRULE_showTagKeysStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(592)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(593)
+		p.Match(SQLParserT_TAG)
+	}
+	{
+		p.SetState(594)
+		p.Match(SQLParserT_KEYS)
+	}
+	{
+		p.SetState(595)
+		p.FromClause()
+	}
+
+	return localctx
+}
+
+// IShowTagValuesStmtContext is an interface to support dynamic dispatch.
+type IShowTagValuesStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowTagValuesStmtContext differentiates from other interfaces.
+	IsShowTagValuesStmtContext()
+}
+
+type ShowTagValuesStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowTagValuesStmtContext() *ShowTagValuesStmtContext {
+	var p = new(ShowTagValuesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+	return p
+}
+
+func (*ShowTagValuesStmtContext) IsShowTagValuesStmtContext() {}
+
+func NewShowTagValuesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagValuesStmtContext {
+	var p = new(ShowTagValuesStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+
+	return p
+}
+
+func (s *ShowTagValuesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowTagValuesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_TAG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TAG, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_VALUES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_VALUES, 0)
+}
+
+func (s *ShowTagValuesStmtContext) FromClause() IFromClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFromClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFromClauseContext)
+}
+
+func (s *ShowTagValuesStmtContext) T_WITH() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_KEY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_KEY, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowTagValuesStmtContext) WithTagKey() IWithTagKeyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWithTagKeyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWithTagKeyContext)
+}
+
+func (s *ShowTagValuesStmtContext) WhereClause() IWhereClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWhereClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWhereClauseContext)
+}
+
+func (s *ShowTagValuesStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowTagValuesStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowTagValuesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowTagValuesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowTagValuesStmt(s)
+	}
+}
+
+func (s *ShowTagValuesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowTagValuesStmt(s)
+	}
+}
+
+func (s *ShowTagValuesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowTagValuesStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowTagValuesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, SQLParserRULE_showTagValuesStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(597)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(598)
+		p.Match(SQLParserT_TAG)
+	}
+	{
+		p.SetState(599)
+		p.Match(SQLParserT_VALUES)
+	}
+	{
+		p.SetState(600)
+		p.FromClause()
+	}
+	{
+		p.SetState(601)
+		p.Match(SQLParserT_WITH)
+	}
+	{
+		p.SetState(602)
+		p.Match(SQLParserT_KEY)
+	}
+	{
+		p.SetState(603)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(604)
+		p.WithTagKey()
+	}
+	p.SetState(606)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(605)
+			p.WhereClause()
+		}
+
+	}
+	p.SetState(609)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(608)
+			p.LimitClause()
+		}
+
+	}
+
+	return localctx
+}
+
+// IPrefixContext is an interface to support dynamic dispatch.
+type IPrefixContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsPrefixContext differentiates from other interfaces.
+	IsPrefixContext()
+}
+
+type PrefixContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPrefixContext() *PrefixContext {
+	var p = new(PrefixContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_prefix
+	return p
+}
+
+func (*PrefixContext) IsPrefixContext() {}
+
+func NewPrefixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrefixContext {
+	var p = new(PrefixContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_prefix
+
+	return p
+}
+
+func (s *PrefixContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PrefixContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *PrefixContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrefixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PrefixContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterPrefix(s)
+	}
+}
+
+func (s *PrefixContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitPrefix(s)
+	}
+}
+
+func (s *PrefixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitPrefix(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) Prefix() (localctx IPrefixContext) {
+	this := p
+	_ = this
+
+	localctx = NewPrefixContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 100, SQLParserRULE_prefix)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(611)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// IWithTagKeyContext is an interface to support dynamic dispatch.
+type IWithTagKeyContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsWithTagKeyContext differentiates from other interfaces.
+	IsWithTagKeyContext()
+}
+
+type WithTagKeyContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyWithTagKeyContext() *WithTagKeyContext {
+	var p = new(WithTagKeyContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_withTagKey
+	return p
+}
+
+func (*WithTagKeyContext) IsWithTagKeyContext() {}
+
+func NewWithTagKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithTagKeyContext {
+	var p = new(WithTagKeyContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_withTagKey
+
+	return p
+}
+
+func (s *WithTagKeyContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *WithTagKeyContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *WithTagKeyContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WithTagKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *WithTagKeyContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterWithTagKey(s)
+	}
+}
+
+func (s *WithTagKeyContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitWithTagKey(s)
+	}
+}
+
+func (s *WithTagKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitWithTagKey(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
+	this := p
+	_ = this
+
+	localctx = NewWithTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, SQLParserRULE_withTagKey)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(613)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// INamespaceContext is an interface to support dynamic dispatch.
+type INamespaceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsNamespaceContext differentiates from other interfaces.
+	IsNamespaceContext()
+}
+
+type NamespaceContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNamespaceContext() *NamespaceContext {
+	var p = new(NamespaceContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_namespace
+	return p
+}
+
+func (*NamespaceContext) IsNamespaceContext() {}
+
+func NewNamespaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceContext {
+	var p = new(NamespaceContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_namespace
+
+	return p
+}
+
+func (s *NamespaceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NamespaceContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *NamespaceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NamespaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *NamespaceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterNamespace(s)
+	}
+}
+
+func (s *NamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitNamespace(s)
+	}
+}
+
+func (s *NamespaceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitNamespace(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) Namespace() (localctx INamespaceContext) {
+	this := p
+	_ = this
+
+	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, SQLParserRULE_namespace)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(615)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// IDatabaseNameContext is an interface to support dynamic dispatch.
+type IDatabaseNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDatabaseNameContext differentiates from other interfaces.
+	IsDatabaseNameContext()
+}
+
+type DatabaseNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDatabaseNameContext() *DatabaseNameContext {
+	var p = new(DatabaseNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_databaseName
+	return p
+}
+
+func (*DatabaseNameContext) IsDatabaseNameContext() {}
+
+func NewDatabaseNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseNameContext {
+	var p = new(DatabaseNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_databaseName
+
+	return p
+}
+
+func (s *DatabaseNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DatabaseNameContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *DatabaseNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DatabaseNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DatabaseNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDatabaseName(s)
+	}
+}
+
+func (s *DatabaseNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDatabaseName(s)
+	}
+}
+
+func (s *DatabaseNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitDatabaseName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
+	this := p
+	_ = this
+
+	localctx = NewDatabaseNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, SQLParserRULE_databaseName)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(617)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// IStorageNameContext is an interface to support dynamic dispatch.
+type IStorageNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsStorageNameContext differentiates from other interfaces.
+	IsStorageNameContext()
+}
+
+type StorageNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStorageNameContext() *StorageNameContext {
+	var p = new(StorageNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_storageName
+	return p
+}
+
+func (*StorageNameContext) IsStorageNameContext() {}
+
+func NewStorageNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StorageNameContext {
+	var p = new(StorageNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_storageName
+
+	return p
+}
+
+func (s *StorageNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StorageNameContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *StorageNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StorageNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StorageNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterStorageName(s)
+	}
+}
+
+func (s *StorageNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitStorageName(s)
+	}
+}
+
+func (s *StorageNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitStorageName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) StorageName() (localctx IStorageNameContext) {
+	this := p
+	_ = this
+
+	localctx = NewStorageNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, SQLParserRULE_storageName)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(619)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// IUserNameContext is an interface to support dynamic dispatch.
+type IUserNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsUserNameContext differentiates from other interfaces.
+	IsUserNameContext()
+}
+
+type UserNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUserNameContext() *UserNameContext {
+	var p = new(UserNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_userName
+	return p
+}
+
+func (*UserNameContext) IsUserNameContext() {}
+
+func NewUserNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UserNameContext {
+	var p = new(UserNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_userName
+
+	return p
+}
+
+func (s *UserNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UserNameContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *UserNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UserNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *UserNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterUserName(s)
+	}
+}
+
+func (s *UserNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitUserName(s)
+	}
+}
+
+func (s *UserNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitUserName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) UserName() (localctx IUserNameContext) {
+	this := p
+	_ = this
+
+	localctx = NewUserNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 110, SQLParserRULE_userName)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(621)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// IRoleNameContext is an interface to support dynamic dispatch.
+type IRoleNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsRoleNameContext differentiates from other interfaces.
+	IsRoleNameContext()
+}
+
+type RoleNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRoleNameContext() *RoleNameContext {
+	var p = new(RoleNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_roleName
+	return p
+}
+
+func (*RoleNameContext) IsRoleNameContext() {}
+
+func NewRoleNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RoleNameContext {
+	var p = new(RoleNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_roleName
+
+	return p
+}
+
+func (s *RoleNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RoleNameContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *RoleNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RoleNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RoleNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterRoleName(s)
+	}
+}
+
+func (s *RoleNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitRoleName(s)
+	}
+}
+
+func (s *RoleNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitRoleName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) RoleName() (localctx IRoleNameContext) {
+	this := p
+	_ = this
+
+	localctx = NewRoleNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 112, SQLParserRULE_roleName)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(623)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// IPasswordContext is an interface to support dynamic dispatch.
+type IPasswordContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsPasswordContext differentiates from other interfaces.
+	IsPasswordContext()
+}
+
+type PasswordContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPasswordContext() *PasswordContext {
+	var p = new(PasswordContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_password
+	return p
+}
+
+func (*PasswordContext) IsPasswordContext() {}
+
+func NewPasswordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PasswordContext {
+	var p = new(PasswordContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_password
+
+	return p
+}
+
+func (s *PasswordContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PasswordContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *PasswordContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PasswordContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PasswordContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterPassword(s)
+	}
+}
+
+func (s *PasswordContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitPassword(s)
+	}
+}
+
+func (s *PasswordContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitPassword(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) Password() (localctx IPasswordContext) {
+	this := p
+	_ = this
+
+	localctx = NewPasswordContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 114, SQLParserRULE_password)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(625)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// INodeIDContext is an interface to support dynamic dispatch.
+type INodeIDContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsNodeIDContext differentiates from other interfaces.
+	IsNodeIDContext()
+}
+
+type NodeIDContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNodeIDContext() *NodeIDContext {
+	var p = new(NodeIDContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_nodeID
+	return p
+}
+
+func (*NodeIDContext) IsNodeIDContext() {}
+
+func NewNodeIDContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NodeIDContext {
+	var p = new(NodeIDContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_nodeID
+
+	return p
+}
+
+func (s *NodeIDContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NodeIDContext) L_INT() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, 0)
+}
+
+func (s *NodeIDContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NodeIDContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *NodeIDContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterNodeID(s)
+	}
+}
+
+func (s *NodeIDContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitNodeID(s)
+	}
+}
+
+func (s *NodeIDContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitNodeID(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) NodeID() (localctx INodeIDContext) {
+	this := p
+	_ = this
+
+	localctx = NewNodeIDContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 116, SQLParserRULE_nodeID)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(627)
+		p.Match(SQLParserL_INT)
+	}
+
+	return localctx
+}
+
+// IShardIDContext is an interface to support dynamic dispatch.
+type IShardIDContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShardIDContext differentiates from other interfaces.
+	IsShardIDContext()
+}
+
+type ShardIDContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShardIDContext() *ShardIDContext {
+	var p = new(ShardIDContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_shardID
+	return p
+}
+
+func (*ShardIDContext) IsShardIDContext() {}
+
+func NewShardIDContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShardIDContext {
+	var p = new(ShardIDContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_shardID
+
+	return p
+}
+
+func (s *ShardIDContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShardIDContext) L_INT() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, 0)
+}
+
+func (s *ShardIDContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShardIDContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShardIDContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShardID(s)
+	}
+}
+
+func (s *ShardIDContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShardID(s)
+	}
+}
+
+func (s *ShardIDContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShardID(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShardID() (localctx IShardIDContext) {
+	this := p
+	_ = this
+
+	localctx = NewShardIDContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 118, SQLParserRULE_shardID)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(629)
+		p.Match(SQLParserL_INT)
+	}
+
+	return localctx
+}
+
+// IRequestIDContext is an interface to support dynamic dispatch.
+type IRequestIDContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsRequestIDContext differentiates from other interfaces.
+	IsRequestIDContext()
+}
+
+type RequestIDContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRequestIDContext() *RequestIDContext {
+	var p = new(RequestIDContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_requestID
+	return p
+}
+
+func (*RequestIDContext) IsRequestIDContext() {}
+
+func NewRequestIDContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RequestIDContext {
+	var p = new(RequestIDContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_requestID
+
+	return p
+}
+
+func (s *RequestIDContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RequestIDContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *RequestIDContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RequestIDContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RequestIDContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterRequestID(s)
+	}
+}
+
+func (s *RequestIDContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitRequestID(s)
+	}
+}
+
+func (s *RequestIDContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitRequestID(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
+	this := p
+	_ = this
+
+	localctx = NewRequestIDContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 120, SQLParserRULE_requestID)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(631)
+		p.Ident()
+	}
+
+	return localctx
+}
+
+// ISourceContext is an interface to support dynamic dispatch.
+type ISourceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSourceContext differentiates from other interfaces.
+	IsSourceContext()
+}
+
+type SourceContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySourceContext() *SourceContext {
+	var p = new(SourceContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_source
+	return p
+}
+
+func (*SourceContext) IsSourceContext() {}
+
+func NewSourceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceContext {
+	var p = new(SourceContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_source
+
+	return p
+}
+
+func (s *SourceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SourceContext) T_STATE_MACHINE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STATE_MACHINE, 0)
+}
+
+func (s *SourceContext) T_STATE_REPO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STATE_REPO, 0)
+}
+
+func (s *SourceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SourceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SourceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterSource(s)
+	}
+}
+
+func (s *SourceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitSource(s)
+	}
+}
+
+func (s *SourceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitSource(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) Source() (localctx ISourceContext) {
+	this := p
+	_ = this
+
+	localctx = NewSourceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 122, SQLParserRULE_source)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(633)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == SQLParserT_STATE_REPO || _la == SQLParserT_STATE_MACHINE) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+	return localctx
+}
+
+// IQueryStmtContext is an interface to support dynamic dispatch.
+type IQueryStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsQueryStmtContext differentiates from other interfaces.
+	IsQueryStmtContext()
+}
+
+type QueryStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyQueryStmtContext() *QueryStmtContext {
+	var p = new(QueryStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_queryStmt
+	return p
+}
+
+func (*QueryStmtContext) IsQueryStmtContext() {}
+
+func NewQueryStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryStmtContext {
+	var p = new(QueryStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_queryStmt
+
+	return p
+}
+
+func (s *QueryStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *QueryStmtContext) SourceAndSelect() ISourceAndSelectContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISourceAndSelectContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISourceAndSelectContext)
+}
+
+func (s *QueryStmtContext) T_EXPLAIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EXPLAIN, 0)
+}
+
+func (s *QueryStmtContext) WhereClause() IWhereClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWhereClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWhereClauseContext)
+}
+
+func (s *QueryStmtContext) GroupByClause() IGroupByClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGroupByClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGroupByClauseContext)
+}
+
+func (s *QueryStmtContext) OrderByClause() IOrderByClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOrderByClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrderByClauseContext)
+}
+
+func (s *QueryStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *QueryStmtContext) T_WITH_VALUE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH_VALUE, 0)
+}
+
+func (s *QueryStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *QueryStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *QueryStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterQueryStmt(s)
+	}
+}
+
+func (s *QueryStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitQueryStmt(s)
+	}
+}
+
+func (s *QueryStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitQueryStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 124, SQLParserRULE_queryStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(636)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_EXPLAIN {
+		{
+			p.SetState(635)
+			p.Match(SQLParserT_EXPLAIN)
+		}
+
+	}
+	{
+		p.SetState(638)
+		p.SourceAndSelect()
+	}
+	p.SetState(640)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(639)
+			p.WhereClause()
+		}
+
+	}
+	p.SetState(643)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_GROUP {
+		{
+			p.SetState(642)
+			p.GroupByClause()
+		}
+
+	}
+	p.SetState(646)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ORDER {
+		{
+			p.SetState(645)
+			p.OrderByClause()
+		}
+
+	}
+	p.SetState(649)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(648)
+			p.LimitClause()
+		}
+
+	}
+	p.SetState(652)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WITH_VALUE {
+		{
+			p.SetState(651)
+			p.Match(SQLParserT_WITH_VALUE)
+		}
+
+	}
+
+	return localctx
+}
+
+// ISourceAndSelectContext is an interface to support dynamic dispatch.
+type ISourceAndSelectContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSourceAndSelectContext differentiates from other interfaces.
+	IsSourceAndSelectContext()
+}
+
+type SourceAndSelectContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySourceAndSelectContext() *SourceAndSelectContext {
+	var p = new(SourceAndSelectContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_sourceAndSelect
+	return p
+}
+
+func (*SourceAndSelectContext) IsSourceAndSelectContext() {}
+
+func NewSourceAndSelectContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceAndSelectContext {
+	var p = new(SourceAndSelectContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_sourceAndSelect
+
+	return p
+}
+
+func (s *SourceAndSelectContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SourceAndSelectContext) SelectExpr() ISelectExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectExprContext)
+}
+
+func (s *SourceAndSelectContext) QueryFromClause() IQueryFromClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IQueryFromClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQueryFromClauseContext)
+}
+
+func (s *SourceAndSelectContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SourceAndSelectContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SourceAndSelectContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterSourceAndSelect(s)
+	}
+}
+
+func (s *SourceAndSelectContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitSourceAndSelect(s)
+	}
+}
+
+func (s *SourceAndSelectContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitSourceAndSelect(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) SourceAndSelect() (localctx ISourceAndSelectContext) {
+	this := p
+	_ = this
+
+	localctx = NewSourceAndSelectContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 126, SQLParserRULE_sourceAndSelect)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(660)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_SELECT:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(654)
+			p.SelectExpr()
+		}
+		{
+			p.SetState(655)
+			p.QueryFromClause()
+		}
+
+	case SQLParserT_FROM:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(657)
+			p.QueryFromClause()
+		}
+		{
+			p.SetState(658)
+			p.SelectExpr()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ISelectExprContext is an interface to support dynamic dispatch.
+type ISelectExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSelectExprContext differentiates from other interfaces.
+	IsSelectExprContext()
+}
+
+type SelectExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySelectExprContext() *SelectExprContext {
+	var p = new(SelectExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_selectExpr
+	return p
+}
+
+func (*SelectExprContext) IsSelectExprContext() {}
+
+func NewSelectExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectExprContext {
+	var p = new(SelectExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_selectExpr
+
+	return p
+}
+
+func (s *SelectExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SelectExprContext) T_SELECT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SELECT, 0)
+}
+
+func (s *SelectExprContext) Fields() IFieldsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldsContext)
+}
+
+func (s *SelectExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SelectExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterSelectExpr(s)
+	}
+}
+
+func (s *SelectExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitSelectExpr(s)
+	}
+}
+
+func (s *SelectExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitSelectExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
+	this := p
+	_ = this
+
+	localctx = NewSelectExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 128, SQLParserRULE_selectExpr)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(662)
+		p.Match(SQLParserT_SELECT)
+	}
+	{
+		p.SetState(663)
+		p.Fields()
+	}
+
+	return localctx
+}
+
+// IFieldsContext is an interface to support dynamic dispatch.
+type IFieldsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFieldsContext differentiates from other interfaces.
+	IsFieldsContext()
+}
+
+type FieldsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFieldsContext() *FieldsContext {
+	var p = new(FieldsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_fields
+	return p
+}
+
+func (*FieldsContext) IsFieldsContext() {}
+
+func NewFieldsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldsContext {
+	var p = new(FieldsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_fields
+
+	return p
+}
+
+func (s *FieldsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FieldsContext) AllField() []IFieldContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFieldContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFieldContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFieldContext); ok {
+			tst[i] = t.(IFieldContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FieldsContext) Field(i int) IFieldContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldContext)
+}
+
+func (s *FieldsContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
+
+func (s *FieldsContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
+
+func (s *FieldsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FieldsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FieldsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterFields(s)
+	}
+}
+
+func (s *FieldsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitFields(s)
+	}
+}
+
+func (s *FieldsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitFields(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) Fields() (localctx IFieldsContext) {
+	this := p
+	_ = this
+
+	localctx = NewFieldsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 130, SQLParserRULE_fields)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(665)
+		p.Field()
+	}
+	p.SetState(670)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(474)
-			p.FromClause()
+			p.SetState(666)
+			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(475)
-			p.SelectExpr()
+			p.SetState(667)
+			p.Field()
+		}
+
+		p.SetState(672)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IFieldContext is an interface to support dynamic dispatch.
+type IFieldContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFieldContext differentiates from other interfaces.
+	IsFieldContext()
+}
+
+type FieldContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFieldContext() *FieldContext {
+	var p = new(FieldContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_field
+	return p
+}
+
+func (*FieldContext) IsFieldContext() {}
+
+func NewFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldContext {
+	var p = new(FieldContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_field
+
+	return p
+}
+
+func (s *FieldContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FieldContext) FieldExpr() IFieldExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldExprContext)
+}
+
+func (s *FieldContext) Alias() IAliasContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAliasContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAliasContext)
+}
+
+func (s *FieldContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FieldContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterField(s)
+	}
+}
+
+func (s *FieldContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitField(s)
+	}
+}
+
+func (s *FieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitField(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) Field() (localctx IFieldContext) {
+	this := p
+	_ = this
+
+	localctx = NewFieldContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 132, SQLParserRULE_field)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(673)
+		p.fieldExpr(0)
+	}
+	p.SetState(675)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_AS {
+		{
+			p.SetState(674)
+			p.Alias()
+		}
+
+	}
+
+	return localctx
+}
+
+// IAliasContext is an interface to support dynamic dispatch.
+type IAliasContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAliasContext differentiates from other interfaces.
+	IsAliasContext()
+}
+
+type AliasContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAliasContext() *AliasContext {
+	var p = new(AliasContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_alias
+	return p
+}
+
+func (*AliasContext) IsAliasContext() {}
+
+func NewAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AliasContext {
+	var p = new(AliasContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_alias
+
+	return p
+}
+
+func (s *AliasContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AliasContext) T_AS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AS, 0)
+}
+
+func (s *AliasContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *AliasContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AliasContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterAlias(s)
+	}
+}
+
+func (s *AliasContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitAlias(s)
+	}
+}
+
+func (s *AliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitAlias(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) Alias() (localctx IAliasContext) {
+	this := p
+	_ = this
+
+	localctx = NewAliasContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 134, SQLParserRULE_alias)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
 		}
+	}()
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(677)
+		p.Match(SQLParserT_AS)
+	}
+	{
+		p.SetState(678)
+		p.Ident()
 	}
 
 	return localctx
 }
 
-// ISelectExprContext is an interface to support dynamic dispatch.
-type ISelectExprContext interface {
+// IStorageFilterContext is an interface to support dynamic dispatch.
+type IStorageFilterContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSelectExprContext differentiates from other interfaces.
-	IsSelectExprContext()
+	// IsStorageFilterContext differentiates from other interfaces.
+	IsStorageFilterContext()
 }
 
-type SelectExprContext struct {
+type StorageFilterContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySelectExprContext() *SelectExprContext {
-	var p = new(SelectExprContext)
+func NewEmptyStorageFilterContext() *StorageFilterContext {
+	var p = new(StorageFilterContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_selectExpr
+	p.RuleIndex = SQLParserRULE_storageFilter
 	return p
 }
 
-func (*SelectExprContext) IsSelectExprContext() {}
+func (*StorageFilterContext) IsStorageFilterContext() {}
 
-func NewSelectExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectExprContext {
-	var p = new(SelectExprContext)
+func NewStorageFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StorageFilterContext {
+	var p = new(StorageFilterContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_selectExpr
+	p.RuleIndex = SQLParserRULE_storageFilter
 
 	return p
 }
 
-func (s *SelectExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *StorageFilterContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SelectExprContext) T_SELECT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SELECT, 0)
+func (s *StorageFilterContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
 }
 
-func (s *SelectExprContext) Fields() IFieldsContext {
+func (s *StorageFilterContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *StorageFilterContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldsContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7962,45 +12309,45 @@ func (s *SelectExprContext) Fields() IFieldsContext {
 		return nil
 	}
 
-	return t.(IFieldsContext)
+	return t.(IIdentContext)
 }
 
-func (s *SelectExprContext) GetRuleContext() antlr.RuleContext {
+func (s *StorageFilterContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SelectExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StorageFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SelectExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StorageFilterContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterSelectExpr(s)
+		listenerT.EnterStorageFilter(s)
 	}
 }
 
-func (s *SelectExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StorageFilterContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitSelectExpr(s)
+		listenerT.ExitStorageFilter(s)
 	}
 }
 
-func (s *SelectExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StorageFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitSelectExpr(s)
+		return t.VisitStorageFilter(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
+func (p *SQLParser) StorageFilter() (localctx IStorageFilterContext) {
 	this := p
 	_ = this
 
-	localctx = NewSelectExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, SQLParserRULE_selectExpr)
+	localctx = NewStorageFilterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 136, SQLParserRULE_storageFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -8020,86 +12367,73 @@ func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(479)
-		p.Match(SQLParserT_SELECT)
+		p.SetState(680)
+		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(480)
-		p.Fields()
+		p.SetState(681)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(682)
+		p.Ident()
 	}
 
 	return localctx
 }
 
-// IFieldsContext is an interface to support dynamic dispatch.
-type IFieldsContext interface {
+// IBrokerFilterContext is an interface to support dynamic dispatch.
+type IBrokerFilterContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFieldsContext differentiates from other interfaces.
-	IsFieldsContext()
+	// IsBrokerFilterContext differentiates from other interfaces.
+	IsBrokerFilterContext()
 }
 
-type FieldsContext struct {
+type BrokerFilterContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFieldsContext() *FieldsContext {
-	var p = new(FieldsContext)
+func NewEmptyBrokerFilterContext() *BrokerFilterContext {
+	var p = new(BrokerFilterContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_fields
+	p.RuleIndex = SQLParserRULE_brokerFilter
 	return p
 }
 
-func (*FieldsContext) IsFieldsContext() {}
+func (*BrokerFilterContext) IsBrokerFilterContext() {}
 
-func NewFieldsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldsContext {
-	var p = new(FieldsContext)
+func NewBrokerFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BrokerFilterContext {
+	var p = new(BrokerFilterContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_fields
+	p.RuleIndex = SQLParserRULE_brokerFilter
 
 	return p
 }
 
-func (s *FieldsContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *FieldsContext) AllField() []IFieldContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IFieldContext); ok {
-			len++
-		}
-	}
+func (s *BrokerFilterContext) GetParser() antlr.Parser { return s.parser }
 
-	tst := make([]IFieldContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IFieldContext); ok {
-			tst[i] = t.(IFieldContext)
-			i++
-		}
-	}
+func (s *BrokerFilterContext) T_BROKER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKER, 0)
+}
 
-	return tst
+func (s *BrokerFilterContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
 }
 
-func (s *FieldsContext) Field(i int) IFieldContext {
+func (s *BrokerFilterContext) Ident() IIdentContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -8107,54 +12441,45 @@ func (s *FieldsContext) Field(i int) IFieldContext {
 		return nil
 	}
 
-	return t.(IFieldContext)
-}
-
-func (s *FieldsContext) AllT_COMMA() []antlr.TerminalNode {
-	return s.GetTokens(SQLParserT_COMMA)
-}
-
-func (s *FieldsContext) T_COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(SQLParserT_COMMA, i)
+	return t.(IIdentContext)
 }
 
-func (s *FieldsContext) GetRuleContext() antlr.RuleContext {
+func (s *BrokerFilterContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FieldsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *BrokerFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FieldsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BrokerFilterContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterFields(s)
+		listenerT.EnterBrokerFilter(s)
 	}
 }
 
-func (s *FieldsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BrokerFilterContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitFields(s)
+		listenerT.ExitBrokerFilter(s)
 	}
 }
 
-func (s *FieldsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BrokerFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitFields(s)
+		return t.VisitBrokerFilter(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Fields() (localctx IFieldsContext) {
+func (p *SQLParser) BrokerFilter() (localctx IBrokerFilterContext) {
 	this := p
 	_ = this
 
-	localctx = NewFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, SQLParserRULE_fields)
-	var _la int
+	localctx = NewBrokerFilterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 138, SQLParserRULE_brokerFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -8174,89 +12499,71 @@ func (p *SQLParser) Fields() (localctx IFieldsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(482)
-		p.Field()
+		p.SetState(684)
+		p.Match(SQLParserT_BROKER)
 	}
-	p.SetState(487)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == SQLParserT_COMMA {
-		{
-			p.SetState(483)
-			p.Match(SQLParserT_COMMA)
-		}
-		{
-			p.SetState(484)
-			p.Field()
-		}
-
-		p.SetState(489)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	{
+		p.SetState(685)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(686)
+		p.Ident()
 	}
 
 	return localctx
 }
 
-// IFieldContext is an interface to support dynamic dispatch.
-type IFieldContext interface {
+// IDatabaseFilterContext is an interface to support dynamic dispatch.
+type IDatabaseFilterContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFieldContext differentiates from other interfaces.
-	IsFieldContext()
+	// IsDatabaseFilterContext differentiates from other interfaces.
+	IsDatabaseFilterContext()
 }
 
-type FieldContext struct {
+type DatabaseFilterContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFieldContext() *FieldContext {
-	var p = new(FieldContext)
+func NewEmptyDatabaseFilterContext() *DatabaseFilterContext {
+	var p = new(DatabaseFilterContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_field
+	p.RuleIndex = SQLParserRULE_databaseFilter
 	return p
 }
 
-func (*FieldContext) IsFieldContext() {}
+func (*DatabaseFilterContext) IsDatabaseFilterContext() {}
 
-func NewFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldContext {
-	var p = new(FieldContext)
+func NewDatabaseFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseFilterContext {
+	var p = new(DatabaseFilterContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_field
+	p.RuleIndex = SQLParserRULE_databaseFilter
 
 	return p
 }
 
-func (s *FieldContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *FieldContext) FieldExpr() IFieldExprContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *DatabaseFilterContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
+func (s *DatabaseFilterContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
 
-	return t.(IFieldExprContext)
+func (s *DatabaseFilterContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
 }
 
-func (s *FieldContext) Alias() IAliasContext {
+func (s *DatabaseFilterContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAliasContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8266,46 +12573,45 @@ func (s *FieldContext) Alias() IAliasContext {
 		return nil
 	}
 
-	return t.(IAliasContext)
+	return t.(IIdentContext)
 }
 
-func (s *FieldContext) GetRuleContext() antlr.RuleContext {
+func (s *DatabaseFilterContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DatabaseFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FieldContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DatabaseFilterContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterField(s)
+		listenerT.EnterDatabaseFilter(s)
 	}
 }
 
-func (s *FieldContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DatabaseFilterContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitField(s)
+		listenerT.ExitDatabaseFilter(s)
 	}
 }
 
-func (s *FieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DatabaseFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitField(s)
+		return t.VisitDatabaseFilter(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Field() (localctx IFieldContext) {
+func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
 	this := p
 	_ = this
 
-	localctx = NewFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, SQLParserRULE_field)
-	var _la int
+	localctx = NewDatabaseFilterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 140, SQLParserRULE_databaseFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -8325,67 +12631,68 @@ func (p *SQLParser) Field() (localctx IFieldContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(490)
-		p.fieldExpr(0)
+		p.SetState(688)
+		p.Match(SQLParserT_DATASBAE)
 	}
-	p.SetState(492)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_AS {
-		{
-			p.SetState(491)
-			p.Alias()
-		}
-
+	{
+		p.SetState(689)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(690)
+		p.Ident()
 	}
 
 	return localctx
 }
 
-// IAliasContext is an interface to support dynamic dispatch.
-type IAliasContext interface {
+// ITypeFilterContext is an interface to support dynamic dispatch.
+type ITypeFilterContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsAliasContext differentiates from other interfaces.
-	IsAliasContext()
+	// IsTypeFilterContext differentiates from other interfaces.
+	IsTypeFilterContext()
 }
 
-type AliasContext struct {
+type TypeFilterContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAliasContext() *AliasContext {
-	var p = new(AliasContext)
+func NewEmptyTypeFilterContext() *TypeFilterContext {
+	var p = new(TypeFilterContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_alias
+	p.RuleIndex = SQLParserRULE_typeFilter
 	return p
 }
 
-func (*AliasContext) IsAliasContext() {}
+func (*TypeFilterContext) IsTypeFilterContext() {}
 
-func NewAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AliasContext {
-	var p = new(AliasContext)
+func NewTypeFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeFilterContext {
+	var p = new(TypeFilterContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_alias
+	p.RuleIndex = SQLParserRULE_typeFilter
 
 	return p
 }
 
-func (s *AliasContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeFilterContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *AliasContext) T_AS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AS, 0)
+func (s *TypeFilterContext) T_TYPE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TYPE, 0)
 }
 
-func (s *AliasContext) Ident() IIdentContext {
+func (s *TypeFilterContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *TypeFilterContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentContext); ok {
@@ -8401,42 +12708,42 @@ func (s *AliasContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *AliasContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeFilterContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AliasContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeFilterContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterAlias(s)
+		listenerT.EnterTypeFilter(s)
 	}
 }
 
-func (s *AliasContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeFilterContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitAlias(s)
+		listenerT.ExitTypeFilter(s)
 	}
 }
 
-func (s *AliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TypeFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitAlias(s)
+		return t.VisitTypeFilter(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Alias() (localctx IAliasContext) {
+func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
 	this := p
 	_ = this
 
-	localctx = NewAliasContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, SQLParserRULE_alias)
+	localctx = NewTypeFilterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 142, SQLParserRULE_typeFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -8456,67 +12763,87 @@ func (p *SQLParser) Alias() (localctx IAliasContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(494)
-		p.Match(SQLParserT_AS)
+		p.SetState(692)
+		p.Match(SQLParserT_TYPE)
 	}
 	{
-		p.SetState(495)
+		p.SetState(693)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(694)
 		p.Ident()
 	}
 
 	return localctx
 }
 
-// IStorageFilterContext is an interface to support dynamic dispatch.
-type IStorageFilterContext interface {
+// IFromClauseContext is an interface to support dynamic dispatch.
+type IFromClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsStorageFilterContext differentiates from other interfaces.
-	IsStorageFilterContext()
+	// IsFromClauseContext differentiates from other interfaces.
+	IsFromClauseContext()
 }
 
-type StorageFilterContext struct {
+type FromClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStorageFilterContext() *StorageFilterContext {
-	var p = new(StorageFilterContext)
+func NewEmptyFromClauseContext() *FromClauseContext {
+	var p = new(FromClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_storageFilter
+	p.RuleIndex = SQLParserRULE_fromClause
 	return p
 }
 
-func (*StorageFilterContext) IsStorageFilterContext() {}
+func (*FromClauseContext) IsFromClauseContext() {}
 
-func NewStorageFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StorageFilterContext {
-	var p = new(StorageFilterContext)
+func NewFromClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FromClauseContext {
+	var p = new(FromClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_storageFilter
+	p.RuleIndex = SQLParserRULE_fromClause
 
 	return p
 }
 
-func (s *StorageFilterContext) GetParser() antlr.Parser { return s.parser }
+func (s *FromClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StorageFilterContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
+func (s *FromClauseContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
 }
 
-func (s *StorageFilterContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *FromClauseContext) MetricName() IMetricNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricNameContext)
 }
 
-func (s *StorageFilterContext) Ident() IIdentContext {
+func (s *FromClauseContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *FromClauseContext) Namespace() INamespaceContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(INamespaceContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8526,45 +12853,46 @@ func (s *StorageFilterContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(INamespaceContext)
 }
 
-func (s *StorageFilterContext) GetRuleContext() antlr.RuleContext {
+func (s *FromClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StorageFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FromClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StorageFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FromClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterStorageFilter(s)
+		listenerT.EnterFromClause(s)
 	}
 }
 
-func (s *StorageFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FromClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitStorageFilter(s)
+		listenerT.ExitFromClause(s)
 	}
 }
 
-func (s *StorageFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FromClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitStorageFilter(s)
+		return t.VisitFromClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) StorageFilter() (localctx IStorageFilterContext) {
+func (p *SQLParser) FromClause() (localctx IFromClauseContext) {
 	this := p
 	_ = this
 
-	localctx = NewStorageFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, SQLParserRULE_storageFilter)
+	localctx = NewFromClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 144, SQLParserRULE_fromClause)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -8584,71 +12912,147 @@ func (p *SQLParser) StorageFilter() (localctx IStorageFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(497)
-		p.Match(SQLParserT_STORAGE)
+		p.SetState(696)
+		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(498)
-		p.Match(SQLParserT_EQUAL)
+		p.SetState(697)
+		p.MetricName()
 	}
-	{
-		p.SetState(499)
-		p.Ident()
+	p.SetState(700)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(698)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(699)
+			p.Namespace()
+		}
+
 	}
 
 	return localctx
 }
 
-// IBrokerFilterContext is an interface to support dynamic dispatch.
-type IBrokerFilterContext interface {
+// IQueryFromClauseContext is an interface to support dynamic dispatch.
+type IQueryFromClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsBrokerFilterContext differentiates from other interfaces.
-	IsBrokerFilterContext()
+	// IsQueryFromClauseContext differentiates from other interfaces.
+	IsQueryFromClauseContext()
 }
 
-type BrokerFilterContext struct {
+type QueryFromClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyBrokerFilterContext() *BrokerFilterContext {
-	var p = new(BrokerFilterContext)
+func NewEmptyQueryFromClauseContext() *QueryFromClauseContext {
+	var p = new(QueryFromClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_brokerFilter
+	p.RuleIndex = SQLParserRULE_queryFromClause
 	return p
 }
 
-func (*BrokerFilterContext) IsBrokerFilterContext() {}
+func (*QueryFromClauseContext) IsQueryFromClauseContext() {}
 
-func NewBrokerFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BrokerFilterContext {
-	var p = new(BrokerFilterContext)
+func NewQueryFromClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryFromClauseContext {
+	var p = new(QueryFromClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_brokerFilter
+	p.RuleIndex = SQLParserRULE_queryFromClause
 
 	return p
 }
 
-func (s *BrokerFilterContext) GetParser() antlr.Parser { return s.parser }
+func (s *QueryFromClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *QueryFromClauseContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *QueryFromClauseContext) AllMetricSource() []IMetricSourceContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMetricSourceContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMetricSourceContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMetricSourceContext); ok {
+			tst[i] = t.(IMetricSourceContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *QueryFromClauseContext) MetricSource(i int) IMetricSourceContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricSourceContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricSourceContext)
+}
+
+func (s *QueryFromClauseContext) SubQuery() ISubQueryContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISubQueryContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubQueryContext)
+}
+
+func (s *QueryFromClauseContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
 
-func (s *BrokerFilterContext) T_BROKER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKER, 0)
+func (s *QueryFromClauseContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
 }
 
-func (s *BrokerFilterContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *QueryFromClauseContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
 }
 
-func (s *BrokerFilterContext) Ident() IIdentContext {
+func (s *QueryFromClauseContext) Namespace() INamespaceContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(INamespaceContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8658,45 +13062,46 @@ func (s *BrokerFilterContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(INamespaceContext)
 }
 
-func (s *BrokerFilterContext) GetRuleContext() antlr.RuleContext {
+func (s *QueryFromClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BrokerFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *QueryFromClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *BrokerFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *QueryFromClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterBrokerFilter(s)
+		listenerT.EnterQueryFromClause(s)
 	}
 }
 
-func (s *BrokerFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *QueryFromClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitBrokerFilter(s)
+		listenerT.ExitQueryFromClause(s)
 	}
 }
 
-func (s *BrokerFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *QueryFromClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitBrokerFilter(s)
+		return t.VisitQueryFromClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) BrokerFilter() (localctx IBrokerFilterContext) {
+func (p *SQLParser) QueryFromClause() (localctx IQueryFromClauseContext) {
 	this := p
 	_ = this
 
-	localctx = NewBrokerFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, SQLParserRULE_brokerFilter)
+	localctx = NewQueryFromClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 146, SQLParserRULE_queryFromClause)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -8716,71 +13121,111 @@ func (p *SQLParser) BrokerFilter() (localctx IBrokerFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(501)
-		p.Match(SQLParserT_BROKER)
-	}
-	{
-		p.SetState(502)
-		p.Match(SQLParserT_EQUAL)
+		p.SetState(702)
+		p.Match(SQLParserT_FROM)
 	}
-	{
-		p.SetState(503)
-		p.Ident()
+	p.SetState(716)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_DELETE, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_REBALANCE, SQLParserT_REPLICA, SQLParserT_CONSISTENCY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_DECOMMISSION, SQLParserT_TRANSFER, SQLParserT_LEADER, SQLParserT_TO, SQLParserT_USERS, SQLParserT_USER, SQLParserT_ROLES, SQLParserT_ROLE, SQLParserT_PASSWORD, SQLParserT_GRANT, SQLParserT_REVOKE, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ADMIN, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_INCREASE, SQLParserT_DELTA, SQLParserT_IRATE, SQLParserT_DERIV, SQLParserT_ABS, SQLParserT_CEIL, SQLParserT_FLOOR, SQLParserT_ROUND, SQLParserT_CLAMP, SQLParserT_TOPK, SQLParserT_BOTTOMK, SQLParserT_OTHERS, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR, SQLParserL_ID:
+		{
+			p.SetState(703)
+			p.MetricSource()
+		}
+		p.SetState(708)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == SQLParserT_COMMA {
+			{
+				p.SetState(704)
+				p.Match(SQLParserT_COMMA)
+			}
+			{
+				p.SetState(705)
+				p.MetricSource()
+			}
+
+			p.SetState(710)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		p.SetState(713)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SQLParserT_ON {
+			{
+				p.SetState(711)
+				p.Match(SQLParserT_ON)
+			}
+			{
+				p.SetState(712)
+				p.Namespace()
+			}
+
+		}
+
+	case SQLParserT_OPEN_P:
+		{
+			p.SetState(715)
+			p.SubQuery()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IDatabaseFilterContext is an interface to support dynamic dispatch.
-type IDatabaseFilterContext interface {
+// ISubQueryContext is an interface to support dynamic dispatch.
+type ISubQueryContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDatabaseFilterContext differentiates from other interfaces.
-	IsDatabaseFilterContext()
+	// IsSubQueryContext differentiates from other interfaces.
+	IsSubQueryContext()
 }
 
-type DatabaseFilterContext struct {
+type SubQueryContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDatabaseFilterContext() *DatabaseFilterContext {
-	var p = new(DatabaseFilterContext)
+func NewEmptySubQueryContext() *SubQueryContext {
+	var p = new(SubQueryContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_databaseFilter
+	p.RuleIndex = SQLParserRULE_subQuery
 	return p
 }
 
-func (*DatabaseFilterContext) IsDatabaseFilterContext() {}
+func (*SubQueryContext) IsSubQueryContext() {}
 
-func NewDatabaseFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseFilterContext {
-	var p = new(DatabaseFilterContext)
+func NewSubQueryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SubQueryContext {
+	var p = new(SubQueryContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_databaseFilter
+	p.RuleIndex = SQLParserRULE_subQuery
 
 	return p
 }
 
-func (s *DatabaseFilterContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *DatabaseFilterContext) T_DATASBAE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAE, 0)
-}
+func (s *SubQueryContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DatabaseFilterContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *SubQueryContext) T_OPEN_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, 0)
 }
 
-func (s *DatabaseFilterContext) Ident() IIdentContext {
+func (s *SubQueryContext) QueryStmt() IQueryStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(IQueryStmtContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8790,45 +13235,49 @@ func (s *DatabaseFilterContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IQueryStmtContext)
 }
 
-func (s *DatabaseFilterContext) GetRuleContext() antlr.RuleContext {
+func (s *SubQueryContext) T_CLOSE_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLOSE_P, 0)
+}
+
+func (s *SubQueryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DatabaseFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SubQueryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DatabaseFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SubQueryContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterDatabaseFilter(s)
+		listenerT.EnterSubQuery(s)
 	}
 }
 
-func (s *DatabaseFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SubQueryContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitDatabaseFilter(s)
+		listenerT.ExitSubQuery(s)
 	}
 }
 
-func (s *DatabaseFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SubQueryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitDatabaseFilter(s)
+		return t.VisitSubQuery(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
+func (p *SQLParser) SubQuery() (localctx ISubQueryContext) {
 	this := p
 	_ = this
 
-	localctx = NewDatabaseFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, SQLParserRULE_databaseFilter)
+	localctx = NewSubQueryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 148, SQLParserRULE_subQuery)
 
 	defer func() {
 		p.ExitRule()
@@ -8848,71 +13297,79 @@ func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(505)
-		p.Match(SQLParserT_DATASBAE)
+		p.SetState(718)
+		p.Match(SQLParserT_OPEN_P)
 	}
 	{
-		p.SetState(506)
-		p.Match(SQLParserT_EQUAL)
+		p.SetState(719)
+		p.QueryStmt()
 	}
 	{
-		p.SetState(507)
-		p.Ident()
+		p.SetState(720)
+		p.Match(SQLParserT_CLOSE_P)
 	}
 
 	return localctx
 }
 
-// ITypeFilterContext is an interface to support dynamic dispatch.
-type ITypeFilterContext interface {
+// IMetricSourceContext is an interface to support dynamic dispatch.
+type IMetricSourceContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeFilterContext differentiates from other interfaces.
-	IsTypeFilterContext()
+	// IsMetricSourceContext differentiates from other interfaces.
+	IsMetricSourceContext()
 }
 
-type TypeFilterContext struct {
+type MetricSourceContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeFilterContext() *TypeFilterContext {
-	var p = new(TypeFilterContext)
+func NewEmptyMetricSourceContext() *MetricSourceContext {
+	var p = new(MetricSourceContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_typeFilter
+	p.RuleIndex = SQLParserRULE_metricSource
 	return p
 }
 
-func (*TypeFilterContext) IsTypeFilterContext() {}
+func (*MetricSourceContext) IsMetricSourceContext() {}
 
-func NewTypeFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeFilterContext {
-	var p = new(TypeFilterContext)
+func NewMetricSourceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MetricSourceContext {
+	var p = new(MetricSourceContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_typeFilter
+	p.RuleIndex = SQLParserRULE_metricSource
 
 	return p
 }
 
-func (s *TypeFilterContext) GetParser() antlr.Parser { return s.parser }
+func (s *MetricSourceContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeFilterContext) T_TYPE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TYPE, 0)
-}
+func (s *MetricSourceContext) MetricName() IMetricNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *TypeFilterContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricNameContext)
 }
 
-func (s *TypeFilterContext) Ident() IIdentContext {
+func (s *MetricSourceContext) MetricAlias() IMetricAliasContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(IMetricAliasContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8922,45 +13379,46 @@ func (s *TypeFilterContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IMetricAliasContext)
 }
 
-func (s *TypeFilterContext) GetRuleContext() antlr.RuleContext {
+func (s *MetricSourceContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MetricSourceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MetricSourceContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterTypeFilter(s)
+		listenerT.EnterMetricSource(s)
 	}
 }
 
-func (s *TypeFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MetricSourceContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitTypeFilter(s)
+		listenerT.ExitMetricSource(s)
 	}
 }
 
-func (s *TypeFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MetricSourceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitTypeFilter(s)
+		return t.VisitMetricSource(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
+func (p *SQLParser) MetricSource() (localctx IMetricSourceContext) {
 	this := p
 	_ = this
 
-	localctx = NewTypeFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, SQLParserRULE_typeFilter)
+	localctx = NewMetricSourceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 150, SQLParserRULE_metricSource)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -8980,87 +13438,70 @@ func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(509)
-		p.Match(SQLParserT_TYPE)
-	}
-	{
-		p.SetState(510)
-		p.Match(SQLParserT_EQUAL)
+		p.SetState(722)
+		p.MetricName()
 	}
-	{
-		p.SetState(511)
-		p.Ident()
+	p.SetState(724)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_AS {
+		{
+			p.SetState(723)
+			p.MetricAlias()
+		}
+
 	}
 
 	return localctx
 }
 
-// IFromClauseContext is an interface to support dynamic dispatch.
-type IFromClauseContext interface {
+// IMetricAliasContext is an interface to support dynamic dispatch.
+type IMetricAliasContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFromClauseContext differentiates from other interfaces.
-	IsFromClauseContext()
+	// IsMetricAliasContext differentiates from other interfaces.
+	IsMetricAliasContext()
 }
 
-type FromClauseContext struct {
+type MetricAliasContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFromClauseContext() *FromClauseContext {
-	var p = new(FromClauseContext)
+func NewEmptyMetricAliasContext() *MetricAliasContext {
+	var p = new(MetricAliasContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_fromClause
+	p.RuleIndex = SQLParserRULE_metricAlias
 	return p
 }
 
-func (*FromClauseContext) IsFromClauseContext() {}
+func (*MetricAliasContext) IsMetricAliasContext() {}
 
-func NewFromClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FromClauseContext {
-	var p = new(FromClauseContext)
+func NewMetricAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MetricAliasContext {
+	var p = new(MetricAliasContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_fromClause
+	p.RuleIndex = SQLParserRULE_metricAlias
 
 	return p
 }
 
-func (s *FromClauseContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *FromClauseContext) T_FROM() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_FROM, 0)
-}
-
-func (s *FromClauseContext) MetricName() IMetricNameContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMetricNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IMetricNameContext)
-}
+func (s *MetricAliasContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FromClauseContext) T_ON() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ON, 0)
+func (s *MetricAliasContext) T_AS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AS, 0)
 }
 
-func (s *FromClauseContext) Namespace() INamespaceContext {
+func (s *MetricAliasContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INamespaceContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9070,46 +13511,45 @@ func (s *FromClauseContext) Namespace() INamespaceContext {
 		return nil
 	}
 
-	return t.(INamespaceContext)
+	return t.(IIdentContext)
 }
 
-func (s *FromClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *MetricAliasContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FromClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MetricAliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FromClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MetricAliasContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterFromClause(s)
+		listenerT.EnterMetricAlias(s)
 	}
 }
 
-func (s *FromClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MetricAliasContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitFromClause(s)
+		listenerT.ExitMetricAlias(s)
 	}
 }
 
-func (s *FromClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MetricAliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitFromClause(s)
+		return t.VisitMetricAlias(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) FromClause() (localctx IFromClauseContext) {
+func (p *SQLParser) MetricAlias() (localctx IMetricAliasContext) {
 	this := p
 	_ = this
 
-	localctx = NewFromClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, SQLParserRULE_fromClause)
-	var _la int
+	localctx = NewMetricAliasContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 152, SQLParserRULE_metricAlias)
 
 	defer func() {
 		p.ExitRule()
@@ -9129,27 +13569,12 @@ func (p *SQLParser) FromClause() (localctx IFromClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(513)
-		p.Match(SQLParserT_FROM)
+		p.SetState(726)
+		p.Match(SQLParserT_AS)
 	}
 	{
-		p.SetState(514)
-		p.MetricName()
-	}
-	p.SetState(517)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_ON {
-		{
-			p.SetState(515)
-			p.Match(SQLParserT_ON)
-		}
-		{
-			p.SetState(516)
-			p.Namespace()
-		}
-
+		p.SetState(727)
+		p.Ident()
 	}
 
 	return localctx
@@ -9248,7 +13673,7 @@ func (p *SQLParser) WhereClause() (localctx IWhereClauseContext) {
 	_ = this
 
 	localctx = NewWhereClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, SQLParserRULE_whereClause)
+	p.EnterRule(localctx, 154, SQLParserRULE_whereClause)
 
 	defer func() {
 		p.ExitRule()
@@ -9268,11 +13693,11 @@ func (p *SQLParser) WhereClause() (localctx IWhereClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(519)
+		p.SetState(729)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(520)
+		p.SetState(730)
 		p.ConditionExpr()
 	}
 
@@ -9388,7 +13813,7 @@ func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
 	_ = this
 
 	localctx = NewConditionExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, SQLParserRULE_conditionExpr)
+	p.EnterRule(localctx, 156, SQLParserRULE_conditionExpr)
 	var _la int
 
 	defer func() {
@@ -9407,48 +13832,48 @@ func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
 		}
 	}()
 
-	p.SetState(532)
+	p.SetState(742)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 29, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 38, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(522)
+			p.SetState(732)
 			p.tagFilterExpr(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(523)
+			p.SetState(733)
 			p.tagFilterExpr(0)
 		}
 		{
-			p.SetState(524)
+			p.SetState(734)
 			p.Match(SQLParserT_AND)
 		}
 		{
-			p.SetState(525)
+			p.SetState(735)
 			p.TimeRangeExpr()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(527)
+			p.SetState(737)
 			p.TimeRangeExpr()
 		}
-		p.SetState(530)
+		p.SetState(740)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == SQLParserT_AND {
 			{
-				p.SetState(528)
+				p.SetState(738)
 				p.Match(SQLParserT_AND)
 			}
 			{
-				p.SetState(529)
+				p.SetState(739)
 				p.tagFilterExpr(0)
 			}
 
@@ -9677,8 +14102,8 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 	localctx = NewTagFilterExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx ITagFilterExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 106
-	p.EnterRecursionRule(localctx, 106, SQLParserRULE_tagFilterExpr, _p)
+	_startState := 158
+	p.EnterRecursionRule(localctx, 158, SQLParserRULE_tagFilterExpr, _p)
 	var _la int
 
 	defer func() {
@@ -9700,75 +14125,75 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(562)
+	p.SetState(772)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 41, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(535)
+			p.SetState(745)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(536)
+			p.SetState(746)
 			p.tagFilterExpr(0)
 		}
 		{
-			p.SetState(537)
+			p.SetState(747)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	case 2:
 		{
-			p.SetState(539)
+			p.SetState(749)
 			p.TagKey()
 		}
-		p.SetState(548)
+		p.SetState(758)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case SQLParserT_EQUAL:
 			{
-				p.SetState(540)
+				p.SetState(750)
 				p.Match(SQLParserT_EQUAL)
 			}
 
 		case SQLParserT_LIKE:
 			{
-				p.SetState(541)
+				p.SetState(751)
 				p.Match(SQLParserT_LIKE)
 			}
 
 		case SQLParserT_NOT:
 			{
-				p.SetState(542)
+				p.SetState(752)
 				p.Match(SQLParserT_NOT)
 			}
 			{
-				p.SetState(543)
+				p.SetState(753)
 				p.Match(SQLParserT_LIKE)
 			}
 
 		case SQLParserT_REGEXP:
 			{
-				p.SetState(544)
+				p.SetState(754)
 				p.Match(SQLParserT_REGEXP)
 			}
 
 		case SQLParserT_NEQREGEXP:
 			{
-				p.SetState(545)
+				p.SetState(755)
 				p.Match(SQLParserT_NEQREGEXP)
 			}
 
 		case SQLParserT_NOTEQUAL:
 			{
-				p.SetState(546)
+				p.SetState(756)
 				p.Match(SQLParserT_NOTEQUAL)
 			}
 
 		case SQLParserT_NOTEQUAL2:
 			{
-				p.SetState(547)
+				p.SetState(757)
 				p.Match(SQLParserT_NOTEQUAL2)
 			}
 
@@ -9776,32 +14201,32 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 		{
-			p.SetState(550)
+			p.SetState(760)
 			p.TagValue()
 		}
 
 	case 3:
 		{
-			p.SetState(552)
+			p.SetState(762)
 			p.TagKey()
 		}
-		p.SetState(556)
+		p.SetState(766)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case SQLParserT_IN:
 			{
-				p.SetState(553)
+				p.SetState(763)
 				p.Match(SQLParserT_IN)
 			}
 
 		case SQLParserT_NOT:
 			{
-				p.SetState(554)
+				p.SetState(764)
 				p.Match(SQLParserT_NOT)
 			}
 			{
-				p.SetState(555)
+				p.SetState(765)
 				p.Match(SQLParserT_IN)
 			}
 
@@ -9809,23 +14234,23 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 		{
-			p.SetState(558)
+			p.SetState(768)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(559)
+			p.SetState(769)
 			p.TagValueList()
 		}
 		{
-			p.SetState(560)
+			p.SetState(770)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(569)
+	p.SetState(779)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -9835,13 +14260,13 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			_prevctx = localctx
 			localctx = NewTagFilterExprContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_tagFilterExpr)
-			p.SetState(564)
+			p.SetState(774)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 			}
 			{
-				p.SetState(565)
+				p.SetState(775)
 				_la = p.GetTokenStream().LA(1)
 
 				if !(_la == SQLParserT_AND || _la == SQLParserT_OR) {
@@ -9852,14 +14277,14 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 				}
 			}
 			{
-				p.SetState(566)
+				p.SetState(776)
 				p.tagFilterExpr(2)
 			}
 
 		}
-		p.SetState(571)
+		p.SetState(781)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -9987,7 +14412,7 @@ func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
 	_ = this
 
 	localctx = NewTagValueListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, SQLParserRULE_tagValueList)
+	p.EnterRule(localctx, 160, SQLParserRULE_tagValueList)
 	var _la int
 
 	defer func() {
@@ -10008,24 +14433,24 @@ func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(572)
+		p.SetState(782)
 		p.TagValue()
 	}
-	p.SetState(577)
+	p.SetState(787)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(573)
+			p.SetState(783)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(574)
+			p.SetState(784)
 			p.TagValue()
 		}
 
-		p.SetState(579)
+		p.SetState(789)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -10138,7 +14563,7 @@ func (p *SQLParser) MetricListFilter() (localctx IMetricListFilterContext) {
 	_ = this
 
 	localctx = NewMetricListFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, SQLParserRULE_metricListFilter)
+	p.EnterRule(localctx, 162, SQLParserRULE_metricListFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -10158,24 +14583,24 @@ func (p *SQLParser) MetricListFilter() (localctx IMetricListFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(580)
+		p.SetState(790)
 		p.Match(SQLParserT_METRIC)
 	}
 	{
-		p.SetState(581)
+		p.SetState(791)
 		p.Match(SQLParserT_IN)
 	}
 
 	{
-		p.SetState(582)
+		p.SetState(792)
 		p.Match(SQLParserT_OPEN_P)
 	}
 	{
-		p.SetState(583)
+		p.SetState(793)
 		p.MetricList()
 	}
 	{
-		p.SetState(584)
+		p.SetState(794)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -10304,7 +14729,7 @@ func (p *SQLParser) MetricList() (localctx IMetricListContext) {
 	_ = this
 
 	localctx = NewMetricListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, SQLParserRULE_metricList)
+	p.EnterRule(localctx, 164, SQLParserRULE_metricList)
 	var _la int
 
 	defer func() {
@@ -10325,24 +14750,24 @@ func (p *SQLParser) MetricList() (localctx IMetricListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(586)
+		p.SetState(796)
 		p.Ident()
 	}
-	p.SetState(591)
+	p.SetState(801)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(587)
+			p.SetState(797)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(588)
+			p.SetState(798)
 			p.Ident()
 		}
 
-		p.SetState(593)
+		p.SetState(803)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -10468,7 +14893,7 @@ func (p *SQLParser) TimeRangeExpr() (localctx ITimeRangeExprContext) {
 	_ = this
 
 	localctx = NewTimeRangeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, SQLParserRULE_timeRangeExpr)
+	p.EnterRule(localctx, 166, SQLParserRULE_timeRangeExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -10488,19 +14913,19 @@ func (p *SQLParser) TimeRangeExpr() (localctx ITimeRangeExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(594)
+		p.SetState(804)
 		p.TimeExpr()
 	}
-	p.SetState(597)
+	p.SetState(807)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(595)
+			p.SetState(805)
 			p.Match(SQLParserT_AND)
 		}
 		{
-			p.SetState(596)
+			p.SetState(806)
 			p.TimeExpr()
 		}
 
@@ -10634,7 +15059,7 @@ func (p *SQLParser) TimeExpr() (localctx ITimeExprContext) {
 	_ = this
 
 	localctx = NewTimeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, SQLParserRULE_timeExpr)
+	p.EnterRule(localctx, 168, SQLParserRULE_timeExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -10654,25 +15079,25 @@ func (p *SQLParser) TimeExpr() (localctx ITimeExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(599)
+		p.SetState(809)
 		p.Match(SQLParserT_TIME)
 	}
 	{
-		p.SetState(600)
+		p.SetState(810)
 		p.BinaryOperator()
 	}
-	p.SetState(603)
+	p.SetState(813)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(601)
+			p.SetState(811)
 			p.NowExpr()
 		}
 
 	case 2:
 		{
-			p.SetState(602)
+			p.SetState(812)
 			p.Ident()
 		}
 
@@ -10786,7 +15211,7 @@ func (p *SQLParser) NowExpr() (localctx INowExprContext) {
 	_ = this
 
 	localctx = NewNowExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, SQLParserRULE_nowExpr)
+	p.EnterRule(localctx, 170, SQLParserRULE_nowExpr)
 	var _la int
 
 	defer func() {
@@ -10807,16 +15232,16 @@ func (p *SQLParser) NowExpr() (localctx INowExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(605)
+		p.SetState(815)
 		p.NowFunc()
 	}
-	p.SetState(607)
+	p.SetState(817)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64((_la-122)) & ^0x3f) == 0 && ((int64(1)<<(_la-122))&131) != 0 {
+	if (int64((_la-152)) & ^0x3f) == 0 && ((int64(1)<<(_la-152))&131) != 0 {
 		{
-			p.SetState(606)
+			p.SetState(816)
 			p.DurationLit()
 		}
 
@@ -10926,7 +15351,7 @@ func (p *SQLParser) NowFunc() (localctx INowFuncContext) {
 	_ = this
 
 	localctx = NewNowFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, SQLParserRULE_nowFunc)
+	p.EnterRule(localctx, 172, SQLParserRULE_nowFunc)
 	var _la int
 
 	defer func() {
@@ -10947,26 +15372,26 @@ func (p *SQLParser) NowFunc() (localctx INowFuncContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(609)
+		p.SetState(819)
 		p.Match(SQLParserT_NOW)
 	}
 	{
-		p.SetState(610)
+		p.SetState(820)
 		p.Match(SQLParserT_OPEN_P)
 	}
-	p.SetState(612)
+	p.SetState(822)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64((_la-6)) & ^0x3f) == 0 && ((int64(1)<<(_la-6))&-65537) != 0 || (int64((_la-70)) & ^0x3f) == 0 && ((int64(1)<<(_la-70))&2032249349030805503) != 0 {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-67108928) != 0 || (int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&-1) != 0 || (int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&7570718783) != 0 {
 		{
-			p.SetState(611)
+			p.SetState(821)
 			p.ExprFuncParams()
 		}
 
 	}
 	{
-		p.SetState(614)
+		p.SetState(824)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -11079,6 +15504,22 @@ func (s *GroupByClauseContext) HavingClause() IHavingClauseContext {
 	return t.(IHavingClauseContext)
 }
 
+func (s *GroupByClauseContext) OthersClause() IOthersClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOthersClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOthersClauseContext)
+}
+
 func (s *GroupByClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -11114,7 +15555,7 @@ func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
 	_ = this
 
 	localctx = NewGroupByClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, SQLParserRULE_groupByClause)
+	p.EnterRule(localctx, 174, SQLParserRULE_groupByClause)
 	var _la int
 
 	defer func() {
@@ -11135,50 +15576,61 @@ func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(616)
+		p.SetState(826)
 		p.Match(SQLParserT_GROUP)
 	}
 	{
-		p.SetState(617)
+		p.SetState(827)
 		p.Match(SQLParserT_BY)
 	}
 	{
-		p.SetState(618)
+		p.SetState(828)
 		p.GroupByKeys()
 	}
-	p.SetState(624)
+	p.SetState(834)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_FILL {
 		{
-			p.SetState(619)
+			p.SetState(829)
 			p.Match(SQLParserT_FILL)
 		}
 		{
-			p.SetState(620)
+			p.SetState(830)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(621)
+			p.SetState(831)
 			p.FillOption()
 		}
 		{
-			p.SetState(622)
+			p.SetState(832)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	}
-	p.SetState(627)
+	p.SetState(837)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_HAVING {
 		{
-			p.SetState(626)
+			p.SetState(836)
 			p.HavingClause()
 		}
 
+	}
+	p.SetState(840)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_OTHERS {
+		{
+			p.SetState(839)
+			p.OthersClause()
+		}
+
 	}
 
 	return localctx
@@ -11306,7 +15758,7 @@ func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
 	_ = this
 
 	localctx = NewGroupByKeysContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, SQLParserRULE_groupByKeys)
+	p.EnterRule(localctx, 176, SQLParserRULE_groupByKeys)
 	var _la int
 
 	defer func() {
@@ -11327,24 +15779,24 @@ func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(629)
+		p.SetState(842)
 		p.GroupByKey()
 	}
-	p.SetState(634)
+	p.SetState(847)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(630)
+			p.SetState(843)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(631)
+			p.SetState(844)
 			p.GroupByKey()
 		}
 
-		p.SetState(636)
+		p.SetState(849)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -11469,7 +15921,7 @@ func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
 	_ = this
 
 	localctx = NewGroupByKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, SQLParserRULE_groupByKey)
+	p.EnterRule(localctx, 178, SQLParserRULE_groupByKey)
 
 	defer func() {
 		p.ExitRule()
@@ -11487,32 +15939,32 @@ func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
 		}
 	}()
 
-	p.SetState(643)
+	p.SetState(856)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(637)
+			p.SetState(850)
 			p.Ident()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(638)
+			p.SetState(851)
 			p.Match(SQLParserT_TIME)
 		}
 		{
-			p.SetState(639)
+			p.SetState(852)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(640)
+			p.SetState(853)
 			p.DurationLit()
 		}
 		{
-			p.SetState(641)
+			p.SetState(854)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
@@ -11610,7 +16062,7 @@ func (p *SQLParser) FillOption() (localctx IFillOptionContext) {
 	_ = this
 
 	localctx = NewFillOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 128, SQLParserRULE_fillOption)
+	p.EnterRule(localctx, 180, SQLParserRULE_fillOption)
 	var _la int
 
 	defer func() {
@@ -11631,7 +16083,7 @@ func (p *SQLParser) FillOption() (localctx IFillOptionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(645)
+		p.SetState(858)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_NULL || _la == SQLParserT_PREVIOUS || _la == SQLParserL_INT || _la == SQLParserL_DEC) {
@@ -11645,6 +16097,138 @@ func (p *SQLParser) FillOption() (localctx IFillOptionContext) {
 	return localctx
 }
 
+// IOthersClauseContext is an interface to support dynamic dispatch.
+type IOthersClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsOthersClauseContext differentiates from other interfaces.
+	IsOthersClauseContext()
+}
+
+type OthersClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOthersClauseContext() *OthersClauseContext {
+	var p = new(OthersClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_othersClause
+	return p
+}
+
+func (*OthersClauseContext) IsOthersClauseContext() {}
+
+func NewOthersClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OthersClauseContext {
+	var p = new(OthersClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_othersClause
+
+	return p
+}
+
+func (s *OthersClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OthersClauseContext) T_OTHERS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OTHERS, 0)
+}
+
+func (s *OthersClauseContext) T_AS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AS, 0)
+}
+
+func (s *OthersClauseContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *OthersClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OthersClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OthersClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterOthersClause(s)
+	}
+}
+
+func (s *OthersClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitOthersClause(s)
+	}
+}
+
+func (s *OthersClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitOthersClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) OthersClause() (localctx IOthersClauseContext) {
+	this := p
+	_ = this
+
+	localctx = NewOthersClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 182, SQLParserRULE_othersClause)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(860)
+		p.Match(SQLParserT_OTHERS)
+	}
+	{
+		p.SetState(861)
+		p.Match(SQLParserT_AS)
+	}
+	{
+		p.SetState(862)
+		p.Ident()
+	}
+
+	return localctx
+}
+
 // IOrderByClauseContext is an interface to support dynamic dispatch.
 type IOrderByClauseContext interface {
 	antlr.ParserRuleContext
@@ -11742,7 +16326,7 @@ func (p *SQLParser) OrderByClause() (localctx IOrderByClauseContext) {
 	_ = this
 
 	localctx = NewOrderByClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, SQLParserRULE_orderByClause)
+	p.EnterRule(localctx, 184, SQLParserRULE_orderByClause)
 
 	defer func() {
 		p.ExitRule()
@@ -11762,15 +16346,15 @@ func (p *SQLParser) OrderByClause() (localctx IOrderByClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(647)
+		p.SetState(864)
 		p.Match(SQLParserT_ORDER)
 	}
 	{
-		p.SetState(648)
+		p.SetState(865)
 		p.Match(SQLParserT_BY)
 	}
 	{
-		p.SetState(649)
+		p.SetState(866)
 		p.SortFields()
 	}
 
@@ -11882,7 +16466,7 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 	_ = this
 
 	localctx = NewSortFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, SQLParserRULE_sortField)
+	p.EnterRule(localctx, 186, SQLParserRULE_sortField)
 	var _la int
 
 	defer func() {
@@ -11903,16 +16487,16 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(651)
+		p.SetState(868)
 		p.fieldExpr(0)
 	}
-	p.SetState(655)
+	p.SetState(872)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_ASC || _la == SQLParserT_DESC {
 		{
-			p.SetState(652)
+			p.SetState(869)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ASC || _la == SQLParserT_DESC) {
@@ -11923,7 +16507,7 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 			}
 		}
 
-		p.SetState(657)
+		p.SetState(874)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -12053,7 +16637,7 @@ func (p *SQLParser) SortFields() (localctx ISortFieldsContext) {
 	_ = this
 
 	localctx = NewSortFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, SQLParserRULE_sortFields)
+	p.EnterRule(localctx, 188, SQLParserRULE_sortFields)
 	var _la int
 
 	defer func() {
@@ -12074,24 +16658,24 @@ func (p *SQLParser) SortFields() (localctx ISortFieldsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(658)
+		p.SetState(875)
 		p.SortField()
 	}
-	p.SetState(663)
+	p.SetState(880)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(659)
+			p.SetState(876)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(660)
+			p.SetState(877)
 			p.SortField()
 		}
 
-		p.SetState(665)
+		p.SetState(882)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -12192,7 +16776,7 @@ func (p *SQLParser) HavingClause() (localctx IHavingClauseContext) {
 	_ = this
 
 	localctx = NewHavingClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, SQLParserRULE_havingClause)
+	p.EnterRule(localctx, 190, SQLParserRULE_havingClause)
 
 	defer func() {
 		p.ExitRule()
@@ -12212,11 +16796,11 @@ func (p *SQLParser) HavingClause() (localctx IHavingClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(666)
+		p.SetState(883)
 		p.Match(SQLParserT_HAVING)
 	}
 	{
-		p.SetState(667)
+		p.SetState(884)
 		p.boolExpr(0)
 	}
 
@@ -12385,8 +16969,8 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 	localctx = NewBoolExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IBoolExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 138
-	p.EnterRecursionRule(localctx, 138, SQLParserRULE_boolExpr, _p)
+	_startState := 192
+	p.EnterRecursionRule(localctx, 192, SQLParserRULE_boolExpr, _p)
 
 	defer func() {
 		p.UnrollRecursionContexts(_parentctx)
@@ -12407,34 +16991,34 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(675)
+	p.SetState(892)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 56, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(670)
+			p.SetState(887)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(671)
+			p.SetState(888)
 			p.boolExpr(0)
 		}
 		{
-			p.SetState(672)
+			p.SetState(889)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	case 2:
 		{
-			p.SetState(674)
+			p.SetState(891)
 			p.BoolExprAtom()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(683)
+	p.SetState(900)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -12444,24 +17028,24 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 			_prevctx = localctx
 			localctx = NewBoolExprContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_boolExpr)
-			p.SetState(677)
+			p.SetState(894)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 			}
 			{
-				p.SetState(678)
+				p.SetState(895)
 				p.BoolExprLogicalOp()
 			}
 			{
-				p.SetState(679)
+				p.SetState(896)
 				p.boolExpr(3)
 			}
 
 		}
-		p.SetState(685)
+		p.SetState(902)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -12548,7 +17132,7 @@ func (p *SQLParser) BoolExprLogicalOp() (localctx IBoolExprLogicalOpContext) {
 	_ = this
 
 	localctx = NewBoolExprLogicalOpContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, SQLParserRULE_boolExprLogicalOp)
+	p.EnterRule(localctx, 194, SQLParserRULE_boolExprLogicalOp)
 	var _la int
 
 	defer func() {
@@ -12569,7 +17153,7 @@ func (p *SQLParser) BoolExprLogicalOp() (localctx IBoolExprLogicalOpContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(686)
+		p.SetState(903)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_AND || _la == SQLParserT_OR) {
@@ -12672,7 +17256,7 @@ func (p *SQLParser) BoolExprAtom() (localctx IBoolExprAtomContext) {
 	_ = this
 
 	localctx = NewBoolExprAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, SQLParserRULE_boolExprAtom)
+	p.EnterRule(localctx, 196, SQLParserRULE_boolExprAtom)
 
 	defer func() {
 		p.ExitRule()
@@ -12692,7 +17276,7 @@ func (p *SQLParser) BoolExprAtom() (localctx IBoolExprAtomContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(688)
+		p.SetState(905)
 		p.BinaryExpr()
 	}
 
@@ -12829,7 +17413,7 @@ func (p *SQLParser) BinaryExpr() (localctx IBinaryExprContext) {
 	_ = this
 
 	localctx = NewBinaryExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, SQLParserRULE_binaryExpr)
+	p.EnterRule(localctx, 198, SQLParserRULE_binaryExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -12849,15 +17433,15 @@ func (p *SQLParser) BinaryExpr() (localctx IBinaryExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(690)
+		p.SetState(907)
 		p.fieldExpr(0)
 	}
 	{
-		p.SetState(691)
+		p.SetState(908)
 		p.BinaryOperator()
 	}
 	{
-		p.SetState(692)
+		p.SetState(909)
 		p.fieldExpr(0)
 	}
 
@@ -12973,7 +17557,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	_ = this
 
 	localctx = NewBinaryOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, SQLParserRULE_binaryOperator)
+	p.EnterRule(localctx, 200, SQLParserRULE_binaryOperator)
 	var _la int
 
 	defer func() {
@@ -12992,63 +17576,63 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 		}
 	}()
 
-	p.SetState(702)
+	p.SetState(919)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_EQUAL:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(694)
+			p.SetState(911)
 			p.Match(SQLParserT_EQUAL)
 		}
 
 	case SQLParserT_NOTEQUAL:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(695)
+			p.SetState(912)
 			p.Match(SQLParserT_NOTEQUAL)
 		}
 
 	case SQLParserT_NOTEQUAL2:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(696)
+			p.SetState(913)
 			p.Match(SQLParserT_NOTEQUAL2)
 		}
 
 	case SQLParserT_LESS:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(697)
+			p.SetState(914)
 			p.Match(SQLParserT_LESS)
 		}
 
 	case SQLParserT_LESSEQUAL:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(698)
+			p.SetState(915)
 			p.Match(SQLParserT_LESSEQUAL)
 		}
 
 	case SQLParserT_GREATER:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(699)
+			p.SetState(916)
 			p.Match(SQLParserT_GREATER)
 		}
 
 	case SQLParserT_GREATEREQUAL:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(700)
+			p.SetState(917)
 			p.Match(SQLParserT_GREATEREQUAL)
 		}
 
 	case SQLParserT_LIKE, SQLParserT_REGEXP:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(701)
+			p.SetState(918)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_LIKE || _la == SQLParserT_REGEXP) {
@@ -13260,8 +17844,8 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 	localctx = NewFieldExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IFieldExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 148
-	p.EnterRecursionRule(localctx, 148, SQLParserRULE_fieldExpr, _p)
+	_startState := 202
+	p.EnterRecursionRule(localctx, 202, SQLParserRULE_fieldExpr, _p)
 
 	defer func() {
 		p.UnrollRecursionContexts(_parentctx)
@@ -13282,46 +17866,46 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(712)
+	p.SetState(929)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(705)
+			p.SetState(922)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(706)
+			p.SetState(923)
 			p.fieldExpr(0)
 		}
 		{
-			p.SetState(707)
+			p.SetState(924)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	case 2:
 		{
-			p.SetState(709)
+			p.SetState(926)
 			p.ExprFunc()
 		}
 
 	case 3:
 		{
-			p.SetState(710)
+			p.SetState(927)
 			p.ExprAtom()
 		}
 
 	case 4:
 		{
-			p.SetState(711)
+			p.SetState(928)
 			p.DurationLit()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(728)
+	p.SetState(945)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -13329,83 +17913,83 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(726)
+			p.SetState(943)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 50, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 60, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(714)
+				p.SetState(931)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 				}
 				{
-					p.SetState(715)
+					p.SetState(932)
 					p.Match(SQLParserT_MUL)
 				}
 				{
-					p.SetState(716)
+					p.SetState(933)
 					p.fieldExpr(9)
 				}
 
 			case 2:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(717)
+				p.SetState(934)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 				}
 				{
-					p.SetState(718)
+					p.SetState(935)
 					p.Match(SQLParserT_DIV)
 				}
 				{
-					p.SetState(719)
+					p.SetState(936)
 					p.fieldExpr(8)
 				}
 
 			case 3:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(720)
+				p.SetState(937)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
 				{
-					p.SetState(721)
+					p.SetState(938)
 					p.Match(SQLParserT_ADD)
 				}
 				{
-					p.SetState(722)
+					p.SetState(939)
 					p.fieldExpr(7)
 				}
 
 			case 4:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(723)
+				p.SetState(940)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
 				{
-					p.SetState(724)
+					p.SetState(941)
 					p.Match(SQLParserT_SUB)
 				}
 				{
-					p.SetState(725)
+					p.SetState(942)
 					p.fieldExpr(6)
 				}
 
 			}
 
 		}
-		p.SetState(730)
+		p.SetState(947)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -13516,7 +18100,7 @@ func (p *SQLParser) DurationLit() (localctx IDurationLitContext) {
 	_ = this
 
 	localctx = NewDurationLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, SQLParserRULE_durationLit)
+	p.EnterRule(localctx, 204, SQLParserRULE_durationLit)
 
 	defer func() {
 		p.ExitRule()
@@ -13536,11 +18120,11 @@ func (p *SQLParser) DurationLit() (localctx IDurationLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(731)
+		p.SetState(948)
 		p.IntNumber()
 	}
 	{
-		p.SetState(732)
+		p.SetState(949)
 		p.IntervalItem()
 	}
 
@@ -13648,7 +18232,7 @@ func (p *SQLParser) IntervalItem() (localctx IIntervalItemContext) {
 	_ = this
 
 	localctx = NewIntervalItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, SQLParserRULE_intervalItem)
+	p.EnterRule(localctx, 206, SQLParserRULE_intervalItem)
 	var _la int
 
 	defer func() {
@@ -13669,10 +18253,10 @@ func (p *SQLParser) IntervalItem() (localctx IIntervalItemContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(734)
+		p.SetState(951)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-97)) & ^0x3f) == 0 && ((int64(1)<<(_la-97))&127) != 0) {
+		if !((int64((_la-127)) & ^0x3f) == 0 && ((int64(1)<<(_la-127))&127) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -13796,7 +18380,7 @@ func (p *SQLParser) ExprFunc() (localctx IExprFuncContext) {
 	_ = this
 
 	localctx = NewExprFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, SQLParserRULE_exprFunc)
+	p.EnterRule(localctx, 208, SQLParserRULE_exprFunc)
 	var _la int
 
 	defer func() {
@@ -13817,26 +18401,26 @@ func (p *SQLParser) ExprFunc() (localctx IExprFuncContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(736)
+		p.SetState(953)
 		p.FuncName()
 	}
 	{
-		p.SetState(737)
+		p.SetState(954)
 		p.Match(SQLParserT_OPEN_P)
 	}
-	p.SetState(739)
+	p.SetState(956)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64((_la-6)) & ^0x3f) == 0 && ((int64(1)<<(_la-6))&-65537) != 0 || (int64((_la-70)) & ^0x3f) == 0 && ((int64(1)<<(_la-70))&2032249349030805503) != 0 {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-67108928) != 0 || (int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&-1) != 0 || (int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&7570718783) != 0 {
 		{
-			p.SetState(738)
+			p.SetState(955)
 			p.ExprFuncParams()
 		}
 
 	}
 	{
-		p.SetState(741)
+		p.SetState(958)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -13921,6 +18505,50 @@ func (s *FuncNameContext) T_RATE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_RATE, 0)
 }
 
+func (s *FuncNameContext) T_INCREASE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INCREASE, 0)
+}
+
+func (s *FuncNameContext) T_DELTA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELTA, 0)
+}
+
+func (s *FuncNameContext) T_IRATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_IRATE, 0)
+}
+
+func (s *FuncNameContext) T_DERIV() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DERIV, 0)
+}
+
+func (s *FuncNameContext) T_ABS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ABS, 0)
+}
+
+func (s *FuncNameContext) T_CEIL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CEIL, 0)
+}
+
+func (s *FuncNameContext) T_FLOOR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FLOOR, 0)
+}
+
+func (s *FuncNameContext) T_ROUND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROUND, 0)
+}
+
+func (s *FuncNameContext) T_CLAMP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP, 0)
+}
+
+func (s *FuncNameContext) T_TOPK() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TOPK, 0)
+}
+
+func (s *FuncNameContext) T_BOTTOMK() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BOTTOMK, 0)
+}
+
 func (s *FuncNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -13956,7 +18584,7 @@ func (p *SQLParser) FuncName() (localctx IFuncNameContext) {
 	_ = this
 
 	localctx = NewFuncNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, SQLParserRULE_funcName)
+	p.EnterRule(localctx, 210, SQLParserRULE_funcName)
 	var _la int
 
 	defer func() {
@@ -13977,10 +18605,10 @@ func (p *SQLParser) FuncName() (localctx IFuncNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(743)
+		p.SetState(960)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&1023) != 0) {
+		if !((int64((_la-105)) & ^0x3f) == 0 && ((int64(1)<<(_la-105))&2097151) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -14113,7 +18741,7 @@ func (p *SQLParser) ExprFuncParams() (localctx IExprFuncParamsContext) {
 	_ = this
 
 	localctx = NewExprFuncParamsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, SQLParserRULE_exprFuncParams)
+	p.EnterRule(localctx, 212, SQLParserRULE_exprFuncParams)
 	var _la int
 
 	defer func() {
@@ -14134,24 +18762,24 @@ func (p *SQLParser) ExprFuncParams() (localctx IExprFuncParamsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(745)
+		p.SetState(962)
 		p.FuncParam()
 	}
-	p.SetState(750)
+	p.SetState(967)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(746)
+			p.SetState(963)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(747)
+			p.SetState(964)
 			p.FuncParam()
 		}
 
-		p.SetState(752)
+		p.SetState(969)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -14264,7 +18892,7 @@ func (p *SQLParser) FuncParam() (localctx IFuncParamContext) {
 	_ = this
 
 	localctx = NewFuncParamContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 160, SQLParserRULE_funcParam)
+	p.EnterRule(localctx, 214, SQLParserRULE_funcParam)
 
 	defer func() {
 		p.ExitRule()
@@ -14282,20 +18910,20 @@ func (p *SQLParser) FuncParam() (localctx IFuncParamContext) {
 		}
 	}()
 
-	p.SetState(755)
+	p.SetState(972)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 64, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(753)
+			p.SetState(970)
 			p.fieldExpr(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(754)
+			p.SetState(971)
 			p.tagFilterExpr(0)
 		}
 
@@ -14441,7 +19069,7 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 	_ = this
 
 	localctx = NewExprAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, SQLParserRULE_exprAtom)
+	p.EnterRule(localctx, 216, SQLParserRULE_exprAtom)
 
 	defer func() {
 		p.ExitRule()
@@ -14459,21 +19087,21 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 		}
 	}()
 
-	p.SetState(763)
+	p.SetState(980)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 56, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(757)
+			p.SetState(974)
 			p.Ident()
 		}
-		p.SetState(759)
+		p.SetState(976)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 65, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(758)
+				p.SetState(975)
 				p.IdentFilter()
 			}
 
@@ -14482,14 +19110,14 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(761)
+			p.SetState(978)
 			p.DecNumber()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(762)
+			p.SetState(979)
 			p.IntNumber()
 		}
 
@@ -14595,7 +19223,7 @@ func (p *SQLParser) IdentFilter() (localctx IIdentFilterContext) {
 	_ = this
 
 	localctx = NewIdentFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 164, SQLParserRULE_identFilter)
+	p.EnterRule(localctx, 218, SQLParserRULE_identFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -14615,15 +19243,15 @@ func (p *SQLParser) IdentFilter() (localctx IIdentFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(765)
+		p.SetState(982)
 		p.Match(SQLParserT_OPEN_SB)
 	}
 	{
-		p.SetState(766)
+		p.SetState(983)
 		p.tagFilterExpr(0)
 	}
 	{
-		p.SetState(767)
+		p.SetState(984)
 		p.Match(SQLParserT_CLOSE_SB)
 	}
 
@@ -14719,7 +19347,7 @@ func (p *SQLParser) Json() (localctx IJsonContext) {
 	_ = this
 
 	localctx = NewJsonContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 166, SQLParserRULE_json)
+	p.EnterRule(localctx, 220, SQLParserRULE_json)
 
 	defer func() {
 		p.ExitRule()
@@ -14739,7 +19367,7 @@ func (p *SQLParser) Json() (localctx IJsonContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(769)
+		p.SetState(986)
 		p.Value()
 	}
 
@@ -14835,7 +19463,7 @@ func (p *SQLParser) Toml() (localctx ITomlContext) {
 	_ = this
 
 	localctx = NewTomlContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 168, SQLParserRULE_toml)
+	p.EnterRule(localctx, 222, SQLParserRULE_toml)
 
 	defer func() {
 		p.ExitRule()
@@ -14855,7 +19483,7 @@ func (p *SQLParser) Toml() (localctx ITomlContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(771)
+		p.SetState(988)
 		p.Ident()
 	}
 
@@ -14992,7 +19620,7 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 	_ = this
 
 	localctx = NewObjContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 170, SQLParserRULE_obj)
+	p.EnterRule(localctx, 224, SQLParserRULE_obj)
 	var _la int
 
 	defer func() {
@@ -15011,50 +19639,50 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 		}
 	}()
 
-	p.SetState(786)
+	p.SetState(1003)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(773)
+			p.SetState(990)
 			p.Match(SQLParserT_OPEN_B)
 		}
 		{
-			p.SetState(774)
+			p.SetState(991)
 			p.Pair()
 		}
-		p.SetState(779)
+		p.SetState(996)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SQLParserT_COMMA {
 			{
-				p.SetState(775)
+				p.SetState(992)
 				p.Match(SQLParserT_COMMA)
 			}
 			{
-				p.SetState(776)
+				p.SetState(993)
 				p.Pair()
 			}
 
-			p.SetState(781)
+			p.SetState(998)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(782)
+			p.SetState(999)
 			p.Match(SQLParserT_CLOSE_B)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(784)
+			p.SetState(1001)
 			p.Match(SQLParserT_OPEN_B)
 		}
 		{
-			p.SetState(785)
+			p.SetState(1002)
 			p.Match(SQLParserT_CLOSE_B)
 		}
 
@@ -15160,7 +19788,7 @@ func (p *SQLParser) Pair() (localctx IPairContext) {
 	_ = this
 
 	localctx = NewPairContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 172, SQLParserRULE_pair)
+	p.EnterRule(localctx, 226, SQLParserRULE_pair)
 
 	defer func() {
 		p.ExitRule()
@@ -15180,15 +19808,15 @@ func (p *SQLParser) Pair() (localctx IPairContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(788)
+		p.SetState(1005)
 		p.Match(SQLParserSTRING)
 	}
 	{
-		p.SetState(789)
+		p.SetState(1006)
 		p.Match(SQLParserT_COLON)
 	}
 	{
-		p.SetState(790)
+		p.SetState(1007)
 		p.Value()
 	}
 
@@ -15325,7 +19953,7 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 	_ = this
 
 	localctx = NewArrContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 174, SQLParserRULE_arr)
+	p.EnterRule(localctx, 228, SQLParserRULE_arr)
 	var _la int
 
 	defer func() {
@@ -15344,50 +19972,50 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 		}
 	}()
 
-	p.SetState(805)
+	p.SetState(1022)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 60, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(792)
+			p.SetState(1009)
 			p.Match(SQLParserT_OPEN_SB)
 		}
 		{
-			p.SetState(793)
+			p.SetState(1010)
 			p.Value()
 		}
-		p.SetState(798)
+		p.SetState(1015)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SQLParserT_COMMA {
 			{
-				p.SetState(794)
+				p.SetState(1011)
 				p.Match(SQLParserT_COMMA)
 			}
 			{
-				p.SetState(795)
+				p.SetState(1012)
 				p.Value()
 			}
 
-			p.SetState(800)
+			p.SetState(1017)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(801)
+			p.SetState(1018)
 			p.Match(SQLParserT_CLOSE_SB)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(803)
+			p.SetState(1020)
 			p.Match(SQLParserT_OPEN_SB)
 		}
 		{
-			p.SetState(804)
+			p.SetState(1021)
 			p.Match(SQLParserT_CLOSE_SB)
 		}
 
@@ -15537,7 +20165,7 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	_ = this
 
 	localctx = NewValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 176, SQLParserRULE_value)
+	p.EnterRule(localctx, 230, SQLParserRULE_value)
 
 	defer func() {
 		p.ExitRule()
@@ -15555,62 +20183,62 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 		}
 	}()
 
-	p.SetState(815)
+	p.SetState(1032)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 71, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(807)
+			p.SetState(1024)
 			p.Match(SQLParserSTRING)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(808)
+			p.SetState(1025)
 			p.IntNumber()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(809)
+			p.SetState(1026)
 			p.DecNumber()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(810)
+			p.SetState(1027)
 			p.Obj()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(811)
+			p.SetState(1028)
 			p.Arr()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(812)
+			p.SetState(1029)
 			p.Match(SQLParserT__0)
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(813)
+			p.SetState(1030)
 			p.Match(SQLParserT__1)
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(814)
+			p.SetState(1031)
 			p.Match(SQLParserT__2)
 		}
 
@@ -15704,7 +20332,7 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 	_ = this
 
 	localctx = NewIntNumberContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 178, SQLParserRULE_intNumber)
+	p.EnterRule(localctx, 232, SQLParserRULE_intNumber)
 	var _la int
 
 	defer func() {
@@ -15724,13 +20352,13 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(818)
+	p.SetState(1035)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
 		{
-			p.SetState(817)
+			p.SetState(1034)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
@@ -15743,7 +20371,7 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 
 	}
 	{
-		p.SetState(820)
+		p.SetState(1037)
 		p.Match(SQLParserL_INT)
 	}
 
@@ -15835,7 +20463,7 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 	_ = this
 
 	localctx = NewDecNumberContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 180, SQLParserRULE_decNumber)
+	p.EnterRule(localctx, 234, SQLParserRULE_decNumber)
 	var _la int
 
 	defer func() {
@@ -15855,13 +20483,13 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(823)
+	p.SetState(1040)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
 		{
-			p.SetState(822)
+			p.SetState(1039)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
@@ -15874,7 +20502,7 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 
 	}
 	{
-		p.SetState(825)
+		p.SetState(1042)
 		p.Match(SQLParserL_DEC)
 	}
 
@@ -15962,7 +20590,7 @@ func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
 	_ = this
 
 	localctx = NewLimitClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 182, SQLParserRULE_limitClause)
+	p.EnterRule(localctx, 236, SQLParserRULE_limitClause)
 
 	defer func() {
 		p.ExitRule()
@@ -15982,11 +20610,11 @@ func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(827)
+		p.SetState(1044)
 		p.Match(SQLParserT_LIMIT)
 	}
 	{
-		p.SetState(828)
+		p.SetState(1045)
 		p.Match(SQLParserL_INT)
 	}
 
@@ -16082,7 +20710,7 @@ func (p *SQLParser) MetricName() (localctx IMetricNameContext) {
 	_ = this
 
 	localctx = NewMetricNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 184, SQLParserRULE_metricName)
+	p.EnterRule(localctx, 238, SQLParserRULE_metricName)
 
 	defer func() {
 		p.ExitRule()
@@ -16102,7 +20730,7 @@ func (p *SQLParser) MetricName() (localctx IMetricNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(830)
+		p.SetState(1047)
 		p.Ident()
 	}
 
@@ -16198,7 +20826,7 @@ func (p *SQLParser) TagKey() (localctx ITagKeyContext) {
 	_ = this
 
 	localctx = NewTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 186, SQLParserRULE_tagKey)
+	p.EnterRule(localctx, 240, SQLParserRULE_tagKey)
 
 	defer func() {
 		p.ExitRule()
@@ -16218,7 +20846,7 @@ func (p *SQLParser) TagKey() (localctx ITagKeyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(832)
+		p.SetState(1049)
 		p.Ident()
 	}
 
@@ -16314,7 +20942,7 @@ func (p *SQLParser) TagValue() (localctx ITagValueContext) {
 	_ = this
 
 	localctx = NewTagValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 188, SQLParserRULE_tagValue)
+	p.EnterRule(localctx, 242, SQLParserRULE_tagValue)
 
 	defer func() {
 		p.ExitRule()
@@ -16334,7 +20962,7 @@ func (p *SQLParser) TagValue() (localctx ITagValueContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(834)
+		p.SetState(1051)
 		p.Ident()
 	}
 
@@ -16471,7 +21099,7 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 	_ = this
 
 	localctx = NewIdentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 190, SQLParserRULE_ident)
+	p.EnterRule(localctx, 244, SQLParserRULE_ident)
 
 	defer func() {
 		p.ExitRule()
@@ -16492,48 +21120,48 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(838)
+	p.SetState(1055)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserL_ID:
 		{
-			p.SetState(836)
+			p.SetState(1053)
 			p.Match(SQLParserL_ID)
 		}
 
-	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
+	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_DELETE, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_REBALANCE, SQLParserT_REPLICA, SQLParserT_CONSISTENCY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_DECOMMISSION, SQLParserT_TRANSFER, SQLParserT_LEADER, SQLParserT_TO, SQLParserT_USERS, SQLParserT_USER, SQLParserT_ROLES, SQLParserT_ROLE, SQLParserT_PASSWORD, SQLParserT_GRANT, SQLParserT_REVOKE, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ADMIN, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_INCREASE, SQLParserT_DELTA, SQLParserT_IRATE, SQLParserT_DERIV, SQLParserT_ABS, SQLParserT_CEIL, SQLParserT_FLOOR, SQLParserT_ROUND, SQLParserT_CLAMP, SQLParserT_TOPK, SQLParserT_BOTTOMK, SQLParserT_OTHERS, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
 		{
-			p.SetState(837)
+			p.SetState(1054)
 			p.NonReservedWords()
 		}
 
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(847)
+	p.SetState(1064)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 76, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(840)
+				p.SetState(1057)
 				p.Match(SQLParserT_DOT)
 			}
-			p.SetState(843)
+			p.SetState(1060)
 			p.GetErrorHandler().Sync(p)
 
 			switch p.GetTokenStream().LA(1) {
 			case SQLParserL_ID:
 				{
-					p.SetState(841)
+					p.SetState(1058)
 					p.Match(SQLParserL_ID)
 				}
 
-			case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
+			case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_DELETE, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_REBALANCE, SQLParserT_REPLICA, SQLParserT_CONSISTENCY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_DECOMMISSION, SQLParserT_TRANSFER, SQLParserT_LEADER, SQLParserT_TO, SQLParserT_USERS, SQLParserT_USER, SQLParserT_ROLES, SQLParserT_ROLE, SQLParserT_PASSWORD, SQLParserT_GRANT, SQLParserT_REVOKE, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ADMIN, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_INCREASE, SQLParserT_DELTA, SQLParserT_IRATE, SQLParserT_DERIV, SQLParserT_ABS, SQLParserT_CEIL, SQLParserT_FLOOR, SQLParserT_ROUND, SQLParserT_CLAMP, SQLParserT_TOPK, SQLParserT_BOTTOMK, SQLParserT_OTHERS, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
 				{
-					p.SetState(842)
+					p.SetState(1059)
 					p.NonReservedWords()
 				}
 
@@ -16542,9 +21170,9 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 			}
 
 		}
-		p.SetState(849)
+		p.SetState(1066)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 76, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -16604,6 +21232,10 @@ func (s *NonReservedWordsContext) T_DROP() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_DROP, 0)
 }
 
+func (s *NonReservedWordsContext) T_DELETE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELETE, 0)
+}
+
 func (s *NonReservedWordsContext) T_INTERVAL() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_INTERVAL, 0)
 }
@@ -16624,6 +21256,74 @@ func (s *NonReservedWordsContext) T_MEMORY() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_MEMORY, 0)
 }
 
+func (s *NonReservedWordsContext) T_REBALANCE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REBALANCE, 0)
+}
+
+func (s *NonReservedWordsContext) T_REPLICA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REPLICA, 0)
+}
+
+func (s *NonReservedWordsContext) T_CONSISTENCY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CONSISTENCY, 0)
+}
+
+func (s *NonReservedWordsContext) T_DECOMMISSION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DECOMMISSION, 0)
+}
+
+func (s *NonReservedWordsContext) T_TRANSFER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TRANSFER, 0)
+}
+
+func (s *NonReservedWordsContext) T_LEADER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LEADER, 0)
+}
+
+func (s *NonReservedWordsContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
+}
+
+func (s *NonReservedWordsContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *NonReservedWordsContext) T_USERS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USERS, 0)
+}
+
+func (s *NonReservedWordsContext) T_ROLE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLE, 0)
+}
+
+func (s *NonReservedWordsContext) T_ROLES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLES, 0)
+}
+
+func (s *NonReservedWordsContext) T_PASSWORD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PASSWORD, 0)
+}
+
+func (s *NonReservedWordsContext) T_GRANT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_GRANT, 0)
+}
+
+func (s *NonReservedWordsContext) T_REVOKE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REVOKE, 0)
+}
+
+func (s *NonReservedWordsContext) T_READ() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_READ, 0)
+}
+
+func (s *NonReservedWordsContext) T_WRITE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WRITE, 0)
+}
+
+func (s *NonReservedWordsContext) T_ADMIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ADMIN, 0)
+}
+
 func (s *NonReservedWordsContext) T_TTL() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_TTL, 0)
 }
@@ -16880,6 +21580,54 @@ func (s *NonReservedWordsContext) T_RATE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_RATE, 0)
 }
 
+func (s *NonReservedWordsContext) T_INCREASE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INCREASE, 0)
+}
+
+func (s *NonReservedWordsContext) T_DELTA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELTA, 0)
+}
+
+func (s *NonReservedWordsContext) T_IRATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_IRATE, 0)
+}
+
+func (s *NonReservedWordsContext) T_DERIV() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DERIV, 0)
+}
+
+func (s *NonReservedWordsContext) T_ABS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ABS, 0)
+}
+
+func (s *NonReservedWordsContext) T_CEIL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CEIL, 0)
+}
+
+func (s *NonReservedWordsContext) T_FLOOR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FLOOR, 0)
+}
+
+func (s *NonReservedWordsContext) T_ROUND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROUND, 0)
+}
+
+func (s *NonReservedWordsContext) T_CLAMP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP, 0)
+}
+
+func (s *NonReservedWordsContext) T_TOPK() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TOPK, 0)
+}
+
+func (s *NonReservedWordsContext) T_BOTTOMK() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BOTTOMK, 0)
+}
+
+func (s *NonReservedWordsContext) T_OTHERS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OTHERS, 0)
+}
+
 func (s *NonReservedWordsContext) T_SECOND() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SECOND, 0)
 }
@@ -17011,7 +21759,7 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 	_ = this
 
 	localctx = NewNonReservedWordsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 192, SQLParserRULE_nonReservedWords)
+	p.EnterRule(localctx, 246, SQLParserRULE_nonReservedWords)
 	var _la int
 
 	defer func() {
@@ -17032,10 +21780,10 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(850)
+		p.SetState(1067)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4194368) != 0 || (int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&1099511627775) != 0) {
+		if !((int64((_la-6)) & ^0x3f) == 0 && ((int64(1)<<(_la-6))&-1048577) != 0 || (int64((_la-70)) & ^0x3f) == 0 && ((int64(1)<<(_la-70))&-1) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -17048,21 +21796,21 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 
 func (p *SQLParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 53:
+	case 79:
 		var t *TagFilterExprContext = nil
 		if localctx != nil {
 			t = localctx.(*TagFilterExprContext)
 		}
 		return p.TagFilterExpr_Sempred(t, predIndex)
 
-	case 69:
+	case 96:
 		var t *BoolExprContext = nil
 		if localctx != nil {
 			t = localctx.(*BoolExprContext)
 		}
 		return p.BoolExpr_Sempred(t, predIndex)
 
-	case 74:
+	case 101:
 		var t *FieldExprContext = nil
 		if localctx != nil {
 			t = localctx.(*FieldExprContext)
@@ -45,6 +45,9 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#showRequestStmt.
 	VisitShowRequestStmt(ctx *ShowRequestStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#killQueryStmt.
+	VisitKillQueryStmt(ctx *KillQueryStmtContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showStoragesStmt.
 	VisitShowStoragesStmt(ctx *ShowStoragesStmtContext) interface{}
 
@@ -78,6 +81,12 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#showMemoryDatabaseStmt.
 	VisitShowMemoryDatabaseStmt(ctx *ShowMemoryDatabaseStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#showRebalanceStmt.
+	VisitShowRebalanceStmt(ctx *ShowRebalanceStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showReplicaConsistencyStmt.
+	VisitShowReplicaConsistencyStmt(ctx *ShowReplicaConsistencyStmtContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showRootMetricStmt.
 	VisitShowRootMetricStmt(ctx *ShowRootMetricStmtContext) interface{}
 
@@ -96,6 +105,12 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#recoverStorageStmt.
 	VisitRecoverStorageStmt(ctx *RecoverStorageStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#decommissionStorageNodeStmt.
+	VisitDecommissionStorageNodeStmt(ctx *DecommissionStorageNodeStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#transferLeaderStmt.
+	VisitTransferLeaderStmt(ctx *TransferLeaderStmtContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showSchemasStmt.
 	VisitShowSchemasStmt(ctx *ShowSchemasStmtContext) interface{}
 
@@ -105,9 +120,45 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#dropDatabaseStmt.
 	VisitDropDatabaseStmt(ctx *DropDatabaseStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#dropMetricStmt.
+	VisitDropMetricStmt(ctx *DropMetricStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#deleteStmt.
+	VisitDeleteStmt(ctx *DeleteStmtContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showDatabaseStmt.
 	VisitShowDatabaseStmt(ctx *ShowDatabaseStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#showUsersStmt.
+	VisitShowUsersStmt(ctx *ShowUsersStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showRolesStmt.
+	VisitShowRolesStmt(ctx *ShowRolesStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#createUserStmt.
+	VisitCreateUserStmt(ctx *CreateUserStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#dropUserStmt.
+	VisitDropUserStmt(ctx *DropUserStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#dropRoleStmt.
+	VisitDropRoleStmt(ctx *DropRoleStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#grantStmt.
+	VisitGrantStmt(ctx *GrantStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#revokeStmt.
+	VisitRevokeStmt(ctx *RevokeStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#grantRoleStmt.
+	VisitGrantRoleStmt(ctx *GrantRoleStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#revokeRoleStmt.
+	VisitRevokeRoleStmt(ctx *RevokeRoleStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#privilege.
+	VisitPrivilege(ctx *PrivilegeContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showNameSpacesStmt.
 	VisitShowNameSpacesStmt(ctx *ShowNameSpacesStmtContext) interface{}
 
@@ -138,6 +189,21 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#storageName.
 	VisitStorageName(ctx *StorageNameContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#userName.
+	VisitUserName(ctx *UserNameContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#roleName.
+	VisitRoleName(ctx *RoleNameContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#password.
+	VisitPassword(ctx *PasswordContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#nodeID.
+	VisitNodeID(ctx *NodeIDContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#shardID.
+	VisitShardID(ctx *ShardIDContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#requestID.
 	VisitRequestID(ctx *RequestIDContext) interface{}
 
@@ -177,6 +243,18 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#fromClause.
 	VisitFromClause(ctx *FromClauseContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#queryFromClause.
+	VisitQueryFromClause(ctx *QueryFromClauseContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#subQuery.
+	VisitSubQuery(ctx *SubQueryContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#metricSource.
+	VisitMetricSource(ctx *MetricSourceContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#metricAlias.
+	VisitMetricAlias(ctx *MetricAliasContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#whereClause.
 	VisitWhereClause(ctx *WhereClauseContext) interface{}
 
@@ -219,6 +297,9 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#fillOption.
 	VisitFillOption(ctx *FillOptionContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#othersClause.
+	VisitOthersClause(ctx *OthersClauseContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#orderByClause.
 	VisitOrderByClause(ctx *OrderByClauseContext) interface{}
 
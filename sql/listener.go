@@ -26,6 +26,7 @@ type listener struct {
 	*grammar.BaseSQLListener
 
 	queryStmt          *queryStmtParser
+	outerQueryStmts    []*queryStmtParser // outer query parsers of sub query
 	metadataStmt       *metadataStmtParser
 	stateStmt          *stateStmtParser
 	metricMetadataStmt *metricMetadataStmtParser
@@ -35,13 +36,32 @@ type listener struct {
 	requestStmt        *requestStmtParser
 	brokerStmt         *brokerStmtParser
 	limitStmt          *limitStmtParser
+	deleteStmt         *deleteStmtParser
+	userStmt           *userStmtParser
 }
 
 // EnterQueryStmt is called when production queryStmt is entered.
 func (l *listener) EnterQueryStmt(ctx *grammar.QueryStmtContext) {
+	if l.queryStmt != nil {
+		// enter sub query, keep outer query parser
+		l.outerQueryStmts = append(l.outerQueryStmts, l.queryStmt)
+	}
 	l.queryStmt = newQueryStmtParse(ctx.T_EXPLAIN() != nil)
 }
 
+// ExitQueryStmt is called when production queryStmt is exited.
+func (l *listener) ExitQueryStmt(ctx *grammar.QueryStmtContext) {
+	size := len(l.outerQueryStmts)
+	if size == 0 {
+		return
+	}
+	// exit sub query, back to outer query parser
+	subQuery := l.queryStmt
+	l.queryStmt = l.outerQueryStmts[size-1]
+	l.outerQueryStmts = l.outerQueryStmts[:size-1]
+	l.queryStmt.visitSubQuery(subQuery, ctx.LimitClause() != nil)
+}
+
 // EnterShowMetadataTypesStmt is called when production showMetadataTypesStmt is entered.
 func (l *listener) EnterShowMetadataTypesStmt(_ *grammar.ShowMetadataTypesStmtContext) {
 	l.metadataStmt = newMetadataStmtParser(stmt.MetadataTypes)
@@ -57,6 +77,11 @@ func (l *listener) EnterShowRequestStmt(_ *grammar.ShowRequestStmtContext) {
 	l.requestStmt = newRequestStmtParse()
 }
 
+// EnterKillQueryStmt is called when production killQueryStmt is entered.
+func (l *listener) EnterKillQueryStmt(_ *grammar.KillQueryStmtContext) {
+	l.requestStmt = newKillRequestStmtParse()
+}
+
 // EnterRequestID is called when production requestID is entered.
 func (l *listener) EnterRequestID(ctx *grammar.RequestIDContext) {
 	l.requestStmt.visitRequestID(ctx)
@@ -139,11 +164,41 @@ func (l *listener) EnterShowMemoryDatabaseStmt(_ *grammar.ShowMemoryDatabaseStmt
 	l.stateStmt = newStateStmtParse(stmt.MemoryDatabase)
 }
 
+// EnterShowRebalanceStmt is called when production showRebalanceStmt is entered.
+func (l *listener) EnterShowRebalanceStmt(_ *grammar.ShowRebalanceStmtContext) {
+	l.stateStmt = newStateStmtParse(stmt.Rebalance)
+}
+
+// EnterShowReplicaConsistencyStmt is called when production showReplicaConsistencyStmt is entered.
+func (l *listener) EnterShowReplicaConsistencyStmt(_ *grammar.ShowReplicaConsistencyStmtContext) {
+	l.stateStmt = newStateStmtParse(stmt.ReplicaConsistency)
+}
+
 // EnterRecoverStorageStmt is called when entering the recoverStorageStmt production.
 func (l *listener) EnterRecoverStorageStmt(_ *grammar.RecoverStorageStmtContext) {
 	l.storageStmt = newStorageStmtParse(stmt.StorageOpRecover)
 }
 
+// EnterDecommissionStorageNodeStmt is called when entering the decommissionStorageNodeStmt production.
+func (l *listener) EnterDecommissionStorageNodeStmt(_ *grammar.DecommissionStorageNodeStmtContext) {
+	l.storageStmt = newStorageStmtParse(stmt.StorageOpDecommission)
+}
+
+// EnterTransferLeaderStmt is called when entering the transferLeaderStmt production.
+func (l *listener) EnterTransferLeaderStmt(_ *grammar.TransferLeaderStmtContext) {
+	l.storageStmt = newStorageStmtParse(stmt.StorageOpTransferLeader)
+}
+
+// EnterShardID is called when entering the shardID production.
+func (l *listener) EnterShardID(c *grammar.ShardIDContext) {
+	l.storageStmt.visitShardID(c)
+}
+
+// EnterNodeID is called when entering the nodeID production.
+func (l *listener) EnterNodeID(c *grammar.NodeIDContext) {
+	l.storageStmt.visitNodeID(c)
+}
+
 // EnterStorageName is called when entering the storageName production.
 func (l *listener) EnterStorageName(c *grammar.StorageNameContext) {
 	l.storageStmt.visitStorageName(c)
@@ -161,6 +216,8 @@ func (l *listener) EnterStorageFilter(ctx *grammar.StorageFilterContext) {
 		l.stateStmt.visitStorageFilter(ctx)
 	case l.metadataStmt != nil:
 		l.metadataStmt.visitStorageFilter(ctx)
+	case l.storageStmt != nil:
+		l.storageStmt.visitStorageFilter(ctx)
 	}
 }
 
@@ -216,9 +273,99 @@ func (l *listener) EnterDropDatabaseStmt(_ *grammar.DropDatabaseStmtContext) {
 	l.schemasStmt = newSchemasStmtParse(stmt.DropDatabaseSchemaType)
 }
 
+// EnterDropMetricStmt is called when production dropMetricStmt is entered.
+func (l *listener) EnterDropMetricStmt(_ *grammar.DropMetricStmtContext) {
+	l.deleteStmt = newDeleteStmtParse(stmt.DropMetric)
+}
+
+// EnterDeleteStmt is called when production deleteStmt is entered.
+func (l *listener) EnterDeleteStmt(_ *grammar.DeleteStmtContext) {
+	l.deleteStmt = newDeleteStmtParse(stmt.DeleteSeries)
+}
+
 // EnterDatabaseName is called when production databaseName is entered.
 func (l *listener) EnterDatabaseName(ctx *grammar.DatabaseNameContext) {
-	l.schemasStmt.visitDatabaseName(ctx)
+	switch {
+	case l.storageStmt != nil:
+		l.storageStmt.visitDatabaseName(ctx)
+	case l.schemasStmt != nil:
+		l.schemasStmt.visitDatabaseName(ctx)
+	case l.userStmt != nil:
+		l.userStmt.visitDatabaseName(ctx)
+	}
+}
+
+// EnterShowUsersStmt is called when production showUsersStmt is entered.
+func (l *listener) EnterShowUsersStmt(_ *grammar.ShowUsersStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpShow)
+}
+
+// EnterShowRolesStmt is called when production showRolesStmt is entered.
+func (l *listener) EnterShowRolesStmt(_ *grammar.ShowRolesStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.RoleOpShow)
+}
+
+// EnterCreateUserStmt is called when production createUserStmt is entered.
+func (l *listener) EnterCreateUserStmt(_ *grammar.CreateUserStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpCreate)
+}
+
+// EnterDropUserStmt is called when production dropUserStmt is entered.
+func (l *listener) EnterDropUserStmt(_ *grammar.DropUserStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpDrop)
+}
+
+// EnterDropRoleStmt is called when production dropRoleStmt is entered.
+func (l *listener) EnterDropRoleStmt(_ *grammar.DropRoleStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.RoleOpDrop)
+}
+
+// EnterGrantStmt is called when production grantStmt is entered.
+func (l *listener) EnterGrantStmt(_ *grammar.GrantStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.RoleOpGrant)
+}
+
+// EnterRevokeStmt is called when production revokeStmt is entered.
+func (l *listener) EnterRevokeStmt(_ *grammar.RevokeStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.RoleOpRevoke)
+}
+
+// EnterGrantRoleStmt is called when production grantRoleStmt is entered.
+func (l *listener) EnterGrantRoleStmt(_ *grammar.GrantRoleStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpGrantRole)
+}
+
+// EnterRevokeRoleStmt is called when production revokeRoleStmt is entered.
+func (l *listener) EnterRevokeRoleStmt(_ *grammar.RevokeRoleStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpRevokeRole)
+}
+
+// EnterUserName is called when production userName is entered.
+func (l *listener) EnterUserName(ctx *grammar.UserNameContext) {
+	if l.userStmt != nil {
+		l.userStmt.visitUserName(ctx)
+	}
+}
+
+// EnterRoleName is called when production roleName is entered.
+func (l *listener) EnterRoleName(ctx *grammar.RoleNameContext) {
+	if l.userStmt != nil {
+		l.userStmt.visitRoleName(ctx)
+	}
+}
+
+// EnterPassword is called when production password is entered.
+func (l *listener) EnterPassword(ctx *grammar.PasswordContext) {
+	if l.userStmt != nil {
+		l.userStmt.visitPassword(ctx)
+	}
+}
+
+// EnterPrivilege is called when production privilege is entered.
+func (l *listener) EnterPrivilege(ctx *grammar.PrivilegeContext) {
+	if l.userStmt != nil {
+		l.userStmt.visitPrivilege(ctx)
+	}
 }
 
 // EnterSetLimtStmt is called when production setLimitStmt is entered.
@@ -275,6 +422,8 @@ func (l *listener) EnterNamespace(ctx *grammar.NamespaceContext) {
 		l.queryStmt.visitNamespace(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitNamespace(ctx)
+	case l.deleteStmt != nil:
+		l.deleteStmt.visitNamespace(ctx)
 	}
 }
 
@@ -299,6 +448,15 @@ func (l *listener) EnterMetricName(ctx *grammar.MetricNameContext) {
 		l.queryStmt.visitMetricName(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitMetricName(ctx)
+	case l.deleteStmt != nil:
+		l.deleteStmt.visitMetricName(ctx)
+	}
+}
+
+// EnterMetricAlias is called when production metricAlias is entered.
+func (l *listener) EnterMetricAlias(ctx *grammar.MetricAliasContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitMetricAlias(ctx)
 	}
 }
 
@@ -375,6 +533,8 @@ func (l *listener) EnterTagFilterExpr(ctx *grammar.TagFilterExprContext) {
 		l.queryStmt.visitTagFilterExpr(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitTagFilterExpr(ctx)
+	case l.deleteStmt != nil:
+		l.deleteStmt.visitTagFilterExpr(ctx)
 	}
 }
 
@@ -385,6 +545,8 @@ func (l *listener) ExitTagFilterExpr(_ *grammar.TagFilterExprContext) {
 		l.queryStmt.completeTagFilterExpr()
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.completeTagFilterExpr()
+	case l.deleteStmt != nil:
+		l.deleteStmt.completeTagFilterExpr()
 	}
 }
 
@@ -395,13 +557,18 @@ func (l *listener) EnterTagValue(ctx *grammar.TagValueContext) {
 		l.queryStmt.visitTagValue(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitTagValue(ctx)
+	case l.deleteStmt != nil:
+		l.deleteStmt.visitTagValue(ctx)
 	}
 }
 
 // EnterTimeRangeExpr is called when production timeRangeExpr is entered.
 func (l *listener) EnterTimeRangeExpr(ctx *grammar.TimeRangeExprContext) {
-	if l.queryStmt != nil {
+	switch {
+	case l.queryStmt != nil:
 		l.queryStmt.visitTimeRangeExpr(ctx)
+	case l.deleteStmt != nil:
+		l.deleteStmt.visitTimeRangeExpr(ctx)
 	}
 }
 
@@ -412,6 +579,62 @@ func (l *listener) EnterGroupByKey(ctx *grammar.GroupByKeyContext) {
 	}
 }
 
+// EnterFillOption is called when production fillOption is entered.
+func (l *listener) EnterFillOption(ctx *grammar.FillOptionContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitFillOption(ctx)
+	}
+}
+
+// EnterHavingClause is called when production havingClause is entered.
+func (l *listener) EnterHavingClause(_ *grammar.HavingClauseContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitHavingClause()
+	}
+}
+
+// ExitHavingClause is called when production havingClause is exited.
+func (l *listener) ExitHavingClause(_ *grammar.HavingClauseContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.completeHavingClause()
+	}
+}
+
+// EnterOthersClause is called when production othersClause is entered.
+func (l *listener) EnterOthersClause(ctx *grammar.OthersClauseContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitOthersClause(ctx)
+	}
+}
+
+// EnterBoolExpr is called when production boolExpr is entered.
+func (l *listener) EnterBoolExpr(ctx *grammar.BoolExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitBoolExpr(ctx)
+	}
+}
+
+// ExitBoolExpr is called when production boolExpr is exited.
+func (l *listener) ExitBoolExpr(ctx *grammar.BoolExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.completeBoolExpr(ctx)
+	}
+}
+
+// EnterBinaryExpr is called when production binaryExpr is entered.
+func (l *listener) EnterBinaryExpr(ctx *grammar.BinaryExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitBinaryExpr(ctx)
+	}
+}
+
+// ExitBinaryExpr is called when production binaryExpr is exited.
+func (l *listener) ExitBinaryExpr(_ *grammar.BinaryExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.completeBinaryExpr()
+	}
+}
+
 // EnterSortField is called when production sortField is entered.
 func (l *listener) EnterSortField(ctx *grammar.SortFieldContext) {
 	if l.queryStmt != nil {
@@ -449,6 +672,10 @@ func (l *listener) statement() (stmt.Statement, error) {
 		return l.brokerStmt.build()
 	case l.limitStmt != nil:
 		return l.limitStmt.build()
+	case l.deleteStmt != nil:
+		return l.deleteStmt.build()
+	case l.userStmt != nil:
+		return l.userStmt.build()
 	default:
 		return nil, nil
 	}
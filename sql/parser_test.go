@@ -117,6 +117,21 @@ func TestShowReplication(t *testing.T) {
 	assert.Equal(t, &stmt.State{Type: stmt.Replication, StorageName: "s", Database: "d"}, query)
 }
 
+func TestShowRebalance(t *testing.T) {
+	query, err := Parse("show rebalance")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.Rebalance}, query)
+}
+
+func TestShowReplicaConsistency(t *testing.T) {
+	query, err := Parse("show replica consistency where storage=s and database=d")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.ReplicaConsistency, StorageName: "s", Database: "d"}, query)
+	query, err = Parse("show replication where database=d and storage=s")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.Replication, StorageName: "s", Database: "d"}, query)
+}
+
 func TestShowMemoryDatabase(t *testing.T) {
 	query, err := Parse("show memory database where storage=s and database=d")
 	assert.NoError(t, err)
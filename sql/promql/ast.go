@@ -0,0 +1,255 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Expr represents the node of PromQL expression tree.
+type Expr interface {
+	// String returns the PromQL string of expression.
+	String() string
+}
+
+// MatchType represents the type of label matcher.
+type MatchType int
+
+// Defines all types of label matcher.
+const (
+	MatchEqual MatchType = iota
+	MatchNotEqual
+	MatchRegexp
+	MatchNotRegexp
+)
+
+// String returns the operator of label matcher.
+func (m MatchType) String() string {
+	switch m {
+	case MatchEqual:
+		return "="
+	case MatchNotEqual:
+		return "!="
+	case MatchRegexp:
+		return "=~"
+	default:
+		return "!~"
+	}
+}
+
+// LabelMatcher represents the label matcher of vector selector, like job="api".
+type LabelMatcher struct {
+	Type  MatchType
+	Name  string
+	Value string
+}
+
+// String returns the PromQL string of label matcher.
+func (m *LabelMatcher) String() string {
+	return fmt.Sprintf("%s%s%q", m.Name, m.Type, m.Value)
+}
+
+// NumberLiteral represents a number literal.
+type NumberLiteral struct {
+	Val float64
+}
+
+// StringLiteral represents a string literal.
+type StringLiteral struct {
+	Val string
+}
+
+// ParenExpr represents a parenthesized expression.
+type ParenExpr struct {
+	Expr Expr
+}
+
+// BinaryExpr represents a binary expression between two expressions.
+type BinaryExpr struct {
+	Op       string
+	LHS, RHS Expr
+}
+
+// VectorSelector represents instant vector selector(metric{matchers}),
+// or range vector selector if Range > 0(metric{matchers}[range]).
+type VectorSelector struct {
+	Name     string
+	Matchers []*LabelMatcher
+	Range    time.Duration
+	Offset   time.Duration
+}
+
+// AggregateExpr represents an aggregation operation on a vector, like sum by (job) (x).
+type AggregateExpr struct {
+	Op       string
+	Param    Expr
+	Expr     Expr
+	Grouping []string
+	Without  bool
+}
+
+// Call represents a function call.
+type Call struct {
+	Func string
+	Args []Expr
+}
+
+// String returns the PromQL string of number literal.
+func (e *NumberLiteral) String() string {
+	return strconv.FormatFloat(e.Val, 'f', -1, 64)
+}
+
+// String returns the PromQL string of string literal.
+func (e *StringLiteral) String() string {
+	return strconv.Quote(e.Val)
+}
+
+// String returns the PromQL string of paren expression.
+func (e *ParenExpr) String() string {
+	return fmt.Sprintf("(%s)", e.Expr)
+}
+
+// String returns the PromQL string of binary expression.
+func (e *BinaryExpr) String() string {
+	return fmt.Sprintf("%s %s %s", e.LHS, e.Op, e.RHS)
+}
+
+// String returns the PromQL string of vector selector.
+func (e *VectorSelector) String() string {
+	var sb strings.Builder
+	sb.WriteString(e.Name)
+	if len(e.Matchers) > 0 {
+		matchers := make([]string, len(e.Matchers))
+		for idx, m := range e.Matchers {
+			matchers[idx] = m.String()
+		}
+		sb.WriteString("{")
+		sb.WriteString(strings.Join(matchers, ","))
+		sb.WriteString("}")
+	}
+	if e.Range > 0 {
+		sb.WriteString(fmt.Sprintf("[%s]", formatDuration(e.Range)))
+	}
+	if e.Offset != 0 {
+		sb.WriteString(fmt.Sprintf(" offset %s", formatDuration(e.Offset)))
+	}
+	return sb.String()
+}
+
+// String returns the PromQL string of aggregate expression.
+func (e *AggregateExpr) String() string {
+	var sb strings.Builder
+	sb.WriteString(e.Op)
+	if len(e.Grouping) > 0 || e.Without {
+		if e.Without {
+			sb.WriteString(" without (")
+		} else {
+			sb.WriteString(" by (")
+		}
+		sb.WriteString(strings.Join(e.Grouping, ","))
+		sb.WriteString(") ")
+	}
+	sb.WriteString("(")
+	if e.Param != nil {
+		sb.WriteString(e.Param.String())
+		sb.WriteString(", ")
+	}
+	sb.WriteString(e.Expr.String())
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// String returns the PromQL string of function call.
+func (e *Call) String() string {
+	args := make([]string, len(e.Args))
+	for idx, arg := range e.Args {
+		args[idx] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", e.Func, strings.Join(args, ", "))
+}
+
+// ParseDuration parses PromQL duration string, like 5m, 1h30m, 100ms.
+func ParseDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty duration string")
+	}
+	var (
+		result time.Duration
+		pos    int
+	)
+	for pos < len(s) {
+		start := pos
+		for pos < len(s) && isDigit(s[pos]) {
+			pos++
+		}
+		if start == pos {
+			return 0, fmt.Errorf("bad duration string: %q", s)
+		}
+		num, err := strconv.ParseInt(s[start:pos], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("bad duration string: %q", s)
+		}
+		var unit time.Duration
+		switch {
+		case strings.HasPrefix(s[pos:], "ms"):
+			unit = time.Millisecond
+			pos += 2
+		case pos < len(s):
+			switch s[pos] {
+			case 's':
+				unit = time.Second
+			case 'm':
+				unit = time.Minute
+			case 'h':
+				unit = time.Hour
+			case 'd':
+				unit = 24 * time.Hour
+			case 'w':
+				unit = 7 * 24 * time.Hour
+			case 'y':
+				unit = 365 * 24 * time.Hour
+			default:
+				return 0, fmt.Errorf("bad duration string: %q", s)
+			}
+			pos++
+		default:
+			return 0, fmt.Errorf("missing unit in duration string: %q", s)
+		}
+		result += time.Duration(num) * unit
+	}
+	return result, nil
+}
+
+// formatDuration formats duration as PromQL duration string.
+func formatDuration(d time.Duration) string {
+	if d%time.Second != 0 {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+	seconds := int64(d / time.Second)
+	switch {
+	case seconds%3600 == 0:
+		return fmt.Sprintf("%dh", seconds/3600)
+	case seconds%60 == 0:
+		return fmt.Sprintf("%dm", seconds/60)
+	default:
+		return fmt.Sprintf("%ds", seconds)
+	}
+}
@@ -0,0 +1,250 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tokenType represents the type of PromQL lexical token.
+type tokenType int
+
+// Defines all token types of PromQL subset.
+const (
+	tokenEOF tokenType = iota
+	tokenIdentifier
+	tokenNumber
+	tokenDuration
+	tokenString
+	tokenLeftParen
+	tokenRightParen
+	tokenLeftBrace
+	tokenRightBrace
+	tokenLeftBracket
+	tokenRightBracket
+	tokenComma
+	tokenAssign
+	tokenEQL
+	tokenNEQ
+	tokenEQLRegex
+	tokenNEQRegex
+	tokenADD
+	tokenSUB
+	tokenMUL
+	tokenDIV
+	tokenMOD
+	tokenPOW
+	tokenGTR
+	tokenGTE
+	tokenLSS
+	tokenLTE
+)
+
+// token represents a lexical token with its position in input.
+type token struct {
+	typ tokenType
+	val string
+	pos int
+}
+
+// String returns the string value of token.
+func (t token) String() string {
+	if t.typ == tokenEOF {
+		return "EOF"
+	}
+	return fmt.Sprintf("%q", t.val)
+}
+
+// durationUnits represents the units which PromQL duration supports.
+const durationUnits = "smhdwy"
+
+// lex splits the PromQL expression into tokens.
+func lex(input string) ([]token, error) {
+	var tokens []token
+	pos := 0
+	for pos < len(input) {
+		c := input[pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			pos++
+		case c == '#':
+			// comment, skip until end of line
+			for pos < len(input) && input[pos] != '\n' {
+				pos++
+			}
+		case isDigit(c) || (c == '.' && pos+1 < len(input) && isDigit(input[pos+1])):
+			tok := lexNumberOrDuration(input, pos)
+			tokens = append(tokens, tok)
+			pos += len(tok.val)
+		case isIdentifierStart(c):
+			start := pos
+			for pos < len(input) && isIdentifierChar(input[pos]) {
+				pos++
+			}
+			tokens = append(tokens, token{typ: tokenIdentifier, val: input[start:pos], pos: start})
+		case c == '"' || c == '\'' || c == '`':
+			val, n, err := lexString(input, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{typ: tokenString, val: val, pos: pos})
+			pos += n
+		default:
+			typ, n := lexOperator(input, pos)
+			if n == 0 {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, pos)
+			}
+			tokens = append(tokens, token{typ: typ, val: input[pos : pos+n], pos: pos})
+			pos += n
+		}
+	}
+	tokens = append(tokens, token{typ: tokenEOF, pos: pos})
+	return tokens, nil
+}
+
+// lexNumberOrDuration lexes number literal(123, 1.5, 1e3) or duration literal(5m, 1h30m).
+func lexNumberOrDuration(input string, start int) token {
+	pos := start
+	for pos < len(input) && isDigit(input[pos]) {
+		pos++
+	}
+	// tests if duration literal
+	if pos < len(input) && strings.IndexByte(durationUnits, input[pos]) >= 0 && pos > start {
+		for pos < len(input) && (isDigit(input[pos]) || strings.IndexByte(durationUnits, input[pos]) >= 0) {
+			pos++
+		}
+		return token{typ: tokenDuration, val: input[start:pos], pos: start}
+	}
+	if pos < len(input) && input[pos] == '.' {
+		pos++
+		for pos < len(input) && isDigit(input[pos]) {
+			pos++
+		}
+	}
+	if pos < len(input) && (input[pos] == 'e' || input[pos] == 'E') {
+		next := pos + 1
+		if next < len(input) && (input[next] == '+' || input[next] == '-') {
+			next++
+		}
+		if next < len(input) && isDigit(input[next]) {
+			pos = next
+			for pos < len(input) && isDigit(input[pos]) {
+				pos++
+			}
+		}
+	}
+	return token{typ: tokenNumber, val: input[start:pos], pos: start}
+}
+
+// lexString lexes quoted string, returns the unquoted value and consumed length.
+func lexString(input string, start int) (value string, n int, err error) {
+	quote := input[start]
+	var sb strings.Builder
+	pos := start + 1
+	for pos < len(input) {
+		c := input[pos]
+		switch {
+		case c == quote:
+			return sb.String(), pos - start + 1, nil
+		case c == '\\' && quote != '`' && pos+1 < len(input):
+			pos++
+			switch input[pos] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			default:
+				sb.WriteByte(input[pos])
+			}
+		default:
+			sb.WriteByte(c)
+		}
+		pos++
+	}
+	return "", 0, fmt.Errorf("unterminated quoted string at position %d", start)
+}
+
+// lexOperator lexes punctuation and operator, returns token type and consumed length.
+func lexOperator(input string, pos int) (tokenType, int) {
+	if pos+1 < len(input) {
+		switch input[pos : pos+2] {
+		case "==":
+			return tokenEQL, 2
+		case "!=":
+			return tokenNEQ, 2
+		case "=~":
+			return tokenEQLRegex, 2
+		case "!~":
+			return tokenNEQRegex, 2
+		case ">=":
+			return tokenGTE, 2
+		case "<=":
+			return tokenLTE, 2
+		}
+	}
+	switch input[pos] {
+	case '(':
+		return tokenLeftParen, 1
+	case ')':
+		return tokenRightParen, 1
+	case '{':
+		return tokenLeftBrace, 1
+	case '}':
+		return tokenRightBrace, 1
+	case '[':
+		return tokenLeftBracket, 1
+	case ']':
+		return tokenRightBracket, 1
+	case ',':
+		return tokenComma, 1
+	case '=':
+		return tokenAssign, 1
+	case '+':
+		return tokenADD, 1
+	case '-':
+		return tokenSUB, 1
+	case '*':
+		return tokenMUL, 1
+	case '/':
+		return tokenDIV, 1
+	case '%':
+		return tokenMOD, 1
+	case '^':
+		return tokenPOW, 1
+	case '>':
+		return tokenGTR, 1
+	case '<':
+		return tokenLSS, 1
+	}
+	return tokenEOF, 0
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || c == ':' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierChar(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c)
+}
@@ -0,0 +1,404 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lindb/lindb/ingestion/prometheus"
+)
+
+// aggregateOps represents the aggregation operators of PromQL.
+var aggregateOps = map[string]struct{}{
+	"sum":          {},
+	"min":          {},
+	"max":          {},
+	"avg":          {},
+	"count":        {},
+	"stddev":       {},
+	"stdvar":       {},
+	"group":        {},
+	"topk":         {},
+	"bottomk":      {},
+	"quantile":     {},
+	"count_values": {},
+}
+
+// binaryPrecedence represents the precedence of binary operators, higher value binds tighter.
+var binaryPrecedence = map[tokenType]int{
+	tokenEQL: 1,
+	tokenNEQ: 1,
+	tokenGTR: 1,
+	tokenGTE: 1,
+	tokenLSS: 1,
+	tokenLTE: 1,
+	tokenADD: 2,
+	tokenSUB: 2,
+	tokenMUL: 3,
+	tokenDIV: 3,
+	tokenMOD: 3,
+	tokenPOW: 4,
+}
+
+// Parse parses the PromQL expression into expression tree.
+func Parse(input string) (Expr, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	expr, err := p.parseExpr(0)
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.typ != tokenEOF {
+		return nil, p.unexpected(tok, "end of input")
+	}
+	return expr, nil
+}
+
+// parser represents PromQL recursive descent parser.
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// peek returns the current token without consuming it.
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// next consumes and returns the current token.
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.typ != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// expect consumes the current token, returns err if token type not match.
+func (p *parser) expect(typ tokenType, context string) (token, error) {
+	tok := p.next()
+	if tok.typ != typ {
+		return tok, p.unexpected(tok, context)
+	}
+	return tok, nil
+}
+
+// unexpected returns the unexpected token error.
+func (p *parser) unexpected(tok token, context string) error {
+	return fmt.Errorf("parse error at position %d: unexpected %s, expected %s", tok.pos, tok, context)
+}
+
+// parseExpr parses binary expression using precedence climbing.
+func (p *parser) parseExpr(minPrecedence int) (Expr, error) {
+	lhs, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		precedence, ok := binaryPrecedence[tok.typ]
+		if !ok || precedence < minPrecedence {
+			return lhs, nil
+		}
+		p.next()
+		if next := p.peek(); next.typ == tokenIdentifier && next.val == "bool" {
+			p.next()
+		}
+		// power operator is right associative
+		nextPrecedence := precedence + 1
+		if tok.typ == tokenPOW {
+			nextPrecedence = precedence
+		}
+		rhs, err := p.parseExpr(nextPrecedence)
+		if err != nil {
+			return nil, err
+		}
+		lhs = &BinaryExpr{Op: tok.val, LHS: lhs, RHS: rhs}
+	}
+}
+
+// parseUnary parses unary expression, like -x, +1.
+func (p *parser) parseUnary() (Expr, error) {
+	tok := p.peek()
+	if tok.typ != tokenADD && tok.typ != tokenSUB {
+		return p.parsePrimary()
+	}
+	p.next()
+	expr, err := p.parseExpr(binaryPrecedence[tokenPOW])
+	if err != nil {
+		return nil, err
+	}
+	if tok.typ == tokenADD {
+		return expr, nil
+	}
+	if number, ok := expr.(*NumberLiteral); ok {
+		number.Val = -number.Val
+		return number, nil
+	}
+	return &BinaryExpr{Op: "*", LHS: &NumberLiteral{Val: -1}, RHS: expr}, nil
+}
+
+// parsePrimary parses number/paren/aggregation/function call/vector selector expression.
+func (p *parser) parsePrimary() (Expr, error) {
+	tok := p.peek()
+	switch tok.typ {
+	case tokenNumber:
+		p.next()
+		val, err := strconv.ParseFloat(tok.val, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse error at position %d: bad number %s", tok.pos, tok)
+		}
+		return &NumberLiteral{Val: val}, nil
+	case tokenString:
+		p.next()
+		return &StringLiteral{Val: tok.val}, nil
+	case tokenLeftParen:
+		p.next()
+		expr, err := p.parseExpr(0)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen, "\")\""); err != nil {
+			return nil, err
+		}
+		return p.parseRangeSuffix(&ParenExpr{Expr: expr})
+	case tokenLeftBrace:
+		return p.parseVectorSelector("")
+	case tokenIdentifier:
+		p.next()
+		lowerName := strings.ToLower(tok.val)
+		next := p.peek()
+		if _, ok := aggregateOps[lowerName]; ok && (next.typ == tokenLeftParen || isGroupingKeyword(next)) {
+			return p.parseAggregateExpr(lowerName)
+		}
+		switch {
+		case lowerName == "inf" || lowerName == "nan":
+			val, _ := strconv.ParseFloat(tok.val, 64)
+			return &NumberLiteral{Val: val}, nil
+		case next.typ == tokenLeftParen:
+			return p.parseCall(tok.val)
+		default:
+			return p.parseVectorSelector(tok.val)
+		}
+	default:
+		return nil, p.unexpected(tok, "expression")
+	}
+}
+
+// parseRangeSuffix checks if subquery([range:step]) follows the expression, subquery is not supported.
+func (p *parser) parseRangeSuffix(expr Expr) (Expr, error) {
+	if tok := p.peek(); tok.typ == tokenLeftBracket {
+		return nil, fmt.Errorf("parse error at position %d: subquery is not supported", tok.pos)
+	}
+	return expr, nil
+}
+
+// parseAggregateExpr parses aggregation expression, grouping clause can be before or after the parameters.
+func (p *parser) parseAggregateExpr(op string) (Expr, error) {
+	agg := &AggregateExpr{Op: op}
+	hasGrouping := false
+	if isGroupingKeyword(p.peek()) {
+		if err := p.parseGrouping(agg); err != nil {
+			return nil, err
+		}
+		hasGrouping = true
+	}
+	args, err := p.parseArgs()
+	if err != nil {
+		return nil, err
+	}
+	switch len(args) {
+	case 1:
+		agg.Expr = args[0]
+	case 2:
+		agg.Param = args[0]
+		agg.Expr = args[1]
+	default:
+		return nil, fmt.Errorf("wrong number of arguments for aggregate expression %s, got %d", op, len(args))
+	}
+	if !hasGrouping && isGroupingKeyword(p.peek()) {
+		if err := p.parseGrouping(agg); err != nil {
+			return nil, err
+		}
+	}
+	return agg, nil
+}
+
+// parseGrouping parses by/without grouping clause.
+func (p *parser) parseGrouping(agg *AggregateExpr) error {
+	keyword := p.next()
+	agg.Without = strings.EqualFold(keyword.val, "without")
+	if _, err := p.expect(tokenLeftParen, "\"(\""); err != nil {
+		return err
+	}
+	for p.peek().typ != tokenRightParen {
+		label, err := p.expect(tokenIdentifier, "label name")
+		if err != nil {
+			return err
+		}
+		agg.Grouping = append(agg.Grouping, label.val)
+		if p.peek().typ == tokenComma {
+			p.next()
+			continue
+		}
+		if p.peek().typ != tokenRightParen {
+			return p.unexpected(p.peek(), "\",\" or \")\"")
+		}
+	}
+	p.next()
+	return nil
+}
+
+// parseCall parses function call expression.
+func (p *parser) parseCall(name string) (Expr, error) {
+	args, err := p.parseArgs()
+	if err != nil {
+		return nil, err
+	}
+	return p.parseRangeSuffix(&Call{Func: name, Args: args})
+}
+
+// parseArgs parses the argument list of function call or aggregation.
+func (p *parser) parseArgs() ([]Expr, error) {
+	if _, err := p.expect(tokenLeftParen, "\"(\""); err != nil {
+		return nil, err
+	}
+	var args []Expr
+	for p.peek().typ != tokenRightParen {
+		arg, err := p.parseExpr(0)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.peek().typ == tokenComma {
+			p.next()
+			continue
+		}
+		if p.peek().typ != tokenRightParen {
+			return nil, p.unexpected(p.peek(), "\",\" or \")\"")
+		}
+	}
+	p.next()
+	return args, nil
+}
+
+// parseVectorSelector parses instant/range vector selector.
+func (p *parser) parseVectorSelector(name string) (Expr, error) {
+	vs := &VectorSelector{Name: name}
+	if p.peek().typ == tokenLeftBrace {
+		p.next()
+		for p.peek().typ != tokenRightBrace {
+			matcher, err := p.parseLabelMatcher()
+			if err != nil {
+				return nil, err
+			}
+			if matcher.Name == prometheus.MetricNameLabel {
+				if matcher.Type != MatchEqual || vs.Name != "" {
+					return nil, fmt.Errorf("only support single metric name matcher with \"=\"")
+				}
+				vs.Name = matcher.Value
+			} else {
+				vs.Matchers = append(vs.Matchers, matcher)
+			}
+			if p.peek().typ == tokenComma {
+				p.next()
+				continue
+			}
+			if p.peek().typ != tokenRightBrace {
+				return nil, p.unexpected(p.peek(), "\",\" or \"}\"")
+			}
+		}
+		p.next()
+	}
+	if vs.Name == "" {
+		return nil, fmt.Errorf("vector selector must contain metric name")
+	}
+	if p.peek().typ == tokenLeftBracket {
+		p.next()
+		tok, err := p.expect(tokenDuration, "duration")
+		if err != nil {
+			return nil, err
+		}
+		if vs.Range, err = ParseDuration(tok.val); err != nil {
+			return nil, err
+		}
+		if p.peek().typ == tokenIdentifier && strings.HasPrefix(p.peek().val, ":") {
+			return nil, fmt.Errorf("parse error at position %d: subquery is not supported", p.peek().pos)
+		}
+		if _, err := p.expect(tokenRightBracket, "\"]\""); err != nil {
+			return nil, err
+		}
+	}
+	if tok := p.peek(); tok.typ == tokenIdentifier && strings.EqualFold(tok.val, "offset") {
+		p.next()
+		negative := false
+		if p.peek().typ == tokenSUB {
+			p.next()
+			negative = true
+		}
+		durationTok, err := p.expect(tokenDuration, "duration")
+		if err != nil {
+			return nil, err
+		}
+		if vs.Offset, err = ParseDuration(durationTok.val); err != nil {
+			return nil, err
+		}
+		if negative {
+			vs.Offset = -vs.Offset
+		}
+	}
+	return vs, nil
+}
+
+// parseLabelMatcher parses label matcher, like job="api", path=~"/api/.*".
+func (p *parser) parseLabelMatcher() (*LabelMatcher, error) {
+	name, err := p.expect(tokenIdentifier, "label name")
+	if err != nil {
+		return nil, err
+	}
+	op := p.next()
+	matcher := &LabelMatcher{Name: name.val}
+	switch op.typ {
+	case tokenAssign:
+		matcher.Type = MatchEqual
+	case tokenNEQ:
+		matcher.Type = MatchNotEqual
+	case tokenEQLRegex:
+		matcher.Type = MatchRegexp
+	case tokenNEQRegex:
+		matcher.Type = MatchNotRegexp
+	default:
+		return nil, p.unexpected(op, "label matching operator")
+	}
+	value, err := p.expect(tokenString, "label value")
+	if err != nil {
+		return nil, err
+	}
+	matcher.Value = value.val
+	return matcher, nil
+}
+
+// isGroupingKeyword checks if token is by/without keyword.
+func isGroupingKeyword(tok token) bool {
+	return tok.typ == tokenIdentifier && (strings.EqualFold(tok.val, "by") || strings.EqualFold(tok.val, "without"))
+}
@@ -0,0 +1,146 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		input string
+		want  string
+	}{
+		{input: "http_requests_total", want: "http_requests_total"},
+		{input: `http_requests_total{job="api", path!="/health"}`, want: `http_requests_total{job="api",path!="/health"}`},
+		{input: `{__name__="up", job=~"api.*"}`, want: `up{job=~"api.*"}`},
+		{input: `rate(http_requests_total{job!~'test'}[5m])`, want: `rate(http_requests_total{job!~"test"}[5m])`},
+		{input: "sum by (job) (rate(x[1m]))", want: "sum by (job) (rate(x[1m]))"},
+		{input: "sum(rate(x[1m])) by (job, host)", want: "sum by (job,host) (rate(x[1m]))"},
+		{input: "max without (host) (x)", want: "max without (host) (x)"},
+		{input: "topk(5, x)", want: "topk(5, x)"},
+		{input: "histogram_quantile(0.99, sum by (le) (rate(latency_bucket[5m])))",
+			want: "histogram_quantile(0.99, sum by (le) (rate(latency_bucket[5m])))"},
+		{input: "1 + 2 * 3", want: "1 + 2 * 3"},
+		{input: "(1 + 2) * 3", want: "(1 + 2) * 3"},
+		{input: "-x", want: "-1 * x"},
+		{input: "-1.5e3", want: "-1500"},
+		{input: "2 ^ 3 ^ 2", want: "2 ^ 3 ^ 2"},
+		{input: "x > bool 1", want: "x > 1"},
+		{input: "x offset 5m", want: "x offset 5m"},
+		{input: "x offset -1h", want: "x offset -1h"},
+		{input: "x # comment", want: "x"},
+		{input: `label_replace(x, "a", "b")`, want: `label_replace(x, "a", "b")`},
+	}
+	for _, c := range cases {
+		expr, err := Parse(c.input)
+		assert.NoError(t, err, c.input)
+		assert.Equal(t, c.want, expr.String(), c.input)
+	}
+}
+
+func TestParse_Precedence(t *testing.T) {
+	expr, err := Parse("1 + 2 * 3 - 4")
+	assert.NoError(t, err)
+	sub := expr.(*BinaryExpr)
+	assert.Equal(t, "-", sub.Op)
+	add := sub.LHS.(*BinaryExpr)
+	assert.Equal(t, "+", add.Op)
+	assert.Equal(t, "*", add.RHS.(*BinaryExpr).Op)
+
+	expr, err = Parse("2 ^ 3 ^ 2")
+	assert.NoError(t, err)
+	pow := expr.(*BinaryExpr)
+	assert.Equal(t, &NumberLiteral{Val: 2}, pow.LHS)
+	assert.Equal(t, "^", pow.RHS.(*BinaryExpr).Op)
+}
+
+func TestParse_Error(t *testing.T) {
+	cases := []string{
+		"",
+		"sum(",
+		"x{job=}",
+		"x{job='a'",
+		"x{job~'a'}",
+		`x{job="a}`,
+		"x[5]",
+		"x[5m",
+		"x[5m:1m]",
+		"rate(x[5m])[5m:1m]",
+		"(x)[5m:]",
+		"{job='a'}",
+		`{__name__!="up"}`,
+		`x{__name__="up"}`,
+		"sum by job (x)",
+		"sum by (job x)",
+		"sum(1, 2, 3)",
+		"x y",
+		"x offset 5",
+		"1 +",
+		"$",
+		")",
+		"x{1='a'}",
+		"x{a='b' c='d'}",
+		"f(x y)",
+	}
+	for _, c := range cases {
+		_, err := Parse(c)
+		assert.Error(t, err, c)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	cases := []struct {
+		input string
+		want  time.Duration
+	}{
+		{input: "100ms", want: 100 * time.Millisecond},
+		{input: "30s", want: 30 * time.Second},
+		{input: "5m", want: 5 * time.Minute},
+		{input: "1h30m", want: 90 * time.Minute},
+		{input: "1d", want: 24 * time.Hour},
+		{input: "1w", want: 7 * 24 * time.Hour},
+		{input: "1y", want: 365 * 24 * time.Hour},
+	}
+	for _, c := range cases {
+		d, err := ParseDuration(c.input)
+		assert.NoError(t, err, c.input)
+		assert.Equal(t, c.want, d, c.input)
+	}
+	for _, c := range []string{"", "5", "m", "5x", "99999999999999999999s"} {
+		_, err := ParseDuration(c)
+		assert.Error(t, err, c)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	assert.Equal(t, "100ms", formatDuration(100*time.Millisecond))
+	assert.Equal(t, "30s", formatDuration(30*time.Second))
+	assert.Equal(t, "5m", formatDuration(5*time.Minute))
+	assert.Equal(t, "2h", formatDuration(2*time.Hour))
+}
+
+func TestMatchType_String(t *testing.T) {
+	assert.Equal(t, "=", MatchEqual.String())
+	assert.Equal(t, "!=", MatchNotEqual.String())
+	assert.Equal(t, "=~", MatchRegexp.String())
+	assert.Equal(t, "!~", MatchNotRegexp.String())
+}
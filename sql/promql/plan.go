@@ -0,0 +1,382 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/ingestion/prometheus"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// Plan represents the LinDB metric query translated from PromQL expression.
+type Plan struct {
+	// Query represents the metric data query, nil if the expression is scalar.
+	Query *stmt.Query
+	// Scalar represents the value of scalar expression.
+	Scalar float64
+	// GroupByAllTags represents the result keeps each series, so need group by all tag keys of metric.
+	GroupByAllTags bool
+	// KeepMetricName represents the result series need __name__ label(vector selector without function/aggregation).
+	KeepMetricName bool
+}
+
+// IsScalar returns if the expression is scalar.
+func (p *Plan) IsScalar() bool {
+	return p.Query == nil
+}
+
+// operand represents the intermediate result when translating PromQL expression.
+type operand struct {
+	scalar bool
+	value  float64
+
+	metricName     string
+	condition      stmt.Expr
+	groupBy        []string
+	groupByAllTags bool
+	keepMetricName bool
+	aggregated     bool
+	expr           stmt.Expr
+}
+
+// sameSeries checks if two operands select same series, so that can be evaluated in one query.
+func (o *operand) sameSeries(other *operand) bool {
+	if o.metricName != other.metricName || o.groupByAllTags != other.groupByAllTags ||
+		conditionString(o.condition) != conditionString(other.condition) ||
+		len(o.groupBy) != len(other.groupBy) {
+		return false
+	}
+	for idx := range o.groupBy {
+		if o.groupBy[idx] != other.groupBy[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+// Translate translates PromQL expression into LinDB metric query plan.
+// Vector selector maps to metric, sample value maps to value field, label matchers map to tag filter.
+func Translate(expr Expr) (*Plan, error) {
+	op, err := translate(expr)
+	if err != nil {
+		return nil, err
+	}
+	if op.scalar {
+		return &Plan{Scalar: op.value}, nil
+	}
+	return &Plan{
+		Query: &stmt.Query{
+			MetricName:  op.metricName,
+			SelectItems: []stmt.Expr{&stmt.SelectItem{Expr: op.expr, Alias: prometheus.ValueFieldName}},
+			Condition:   op.condition,
+			GroupBy:     op.groupBy,
+		},
+		GroupByAllTags: op.groupByAllTags,
+		KeepMetricName: op.keepMetricName,
+	}, nil
+}
+
+// translate translates the expression node into operand.
+func translate(expr Expr) (*operand, error) {
+	switch e := expr.(type) {
+	case *NumberLiteral:
+		return &operand{scalar: true, value: e.Val}, nil
+	case *ParenExpr:
+		op, err := translate(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if !op.scalar {
+			op.expr = &stmt.ParenExpr{Expr: op.expr}
+		}
+		return op, nil
+	case *VectorSelector:
+		if e.Range > 0 {
+			return nil, fmt.Errorf("range vector selector %s must be used in rate function", e)
+		}
+		return translateSelector(e)
+	case *Call:
+		switch e.Func {
+		case "rate":
+			return translateRate(e)
+		case "histogram_quantile":
+			return translateHistogramQuantile(e)
+		default:
+			return nil, fmt.Errorf("function %s is not supported", e.Func)
+		}
+	case *AggregateExpr:
+		return translateAggregate(e)
+	case *BinaryExpr:
+		return translateBinary(e)
+	default:
+		return nil, fmt.Errorf("expression %s is not supported", expr)
+	}
+}
+
+// translateSelector translates vector selector into operand which selects value field of metric.
+func translateSelector(vs *VectorSelector) (*operand, error) {
+	if vs.Offset != 0 {
+		return nil, fmt.Errorf("offset modifier is not supported")
+	}
+	condition, err := buildCondition(vs.Matchers)
+	if err != nil {
+		return nil, err
+	}
+	return &operand{
+		metricName:     vs.Name,
+		condition:      condition,
+		groupByAllTags: true,
+		keepMetricName: true,
+		expr:           &stmt.FieldExpr{Name: prometheus.ValueFieldName},
+	}, nil
+}
+
+// translateRate translates rate(selector[range]), range is replaced by query step(down sampling interval).
+func translateRate(call *Call) (*operand, error) {
+	if len(call.Args) != 1 {
+		return nil, fmt.Errorf("expected 1 argument in call to rate, got %d", len(call.Args))
+	}
+	vs, ok := call.Args[0].(*VectorSelector)
+	if !ok || vs.Range <= 0 {
+		return nil, fmt.Errorf("expected range vector in call to function rate, got %s", call.Args[0])
+	}
+	op, err := translateSelector(vs)
+	if err != nil {
+		return nil, err
+	}
+	op.keepMetricName = false
+	op.expr = &stmt.CallExpr{FuncType: function.Rate, Params: []stmt.Expr{op.expr}}
+	return op, nil
+}
+
+// translateHistogramQuantile translates histogram_quantile(φ, sum by (le) (rate(x_bucket[range]))),
+// histogram buckets of x are stored as histogram field of metric x.
+func translateHistogramQuantile(call *Call) (*operand, error) {
+	if len(call.Args) != 2 {
+		return nil, fmt.Errorf("expected 2 arguments in call to histogram_quantile, got %d", len(call.Args))
+	}
+	phi, ok := unwrapParen(call.Args[0]).(*NumberLiteral)
+	if !ok || phi.Val < 0 || phi.Val > 1 {
+		return nil, fmt.Errorf("quantile value should be number between 0 and 1, got %s", call.Args[0])
+	}
+	var (
+		groupBy        []string
+		groupByAllTags = true
+		bucketExpr     = unwrapParen(call.Args[1])
+	)
+	if agg, ok := bucketExpr.(*AggregateExpr); ok {
+		if agg.Op != "sum" || agg.Without {
+			return nil, fmt.Errorf("only support sum by aggregation in histogram_quantile")
+		}
+		hasBucket := false
+		for _, label := range agg.Grouping {
+			if label == prometheus.BucketLabel {
+				hasBucket = true
+				continue
+			}
+			groupBy = append(groupBy, label)
+		}
+		if !hasBucket {
+			return nil, fmt.Errorf("histogram_quantile requires le label in grouping of aggregation")
+		}
+		groupByAllTags = false
+		bucketExpr = unwrapParen(agg.Expr)
+	}
+	if rate, ok := bucketExpr.(*Call); ok && rate.Func == "rate" && len(rate.Args) == 1 {
+		bucketExpr = rate.Args[0]
+	}
+	vs, ok := bucketExpr.(*VectorSelector)
+	if !ok || !strings.HasSuffix(vs.Name, "_bucket") {
+		return nil, fmt.Errorf("expected histogram bucket selector in call to histogram_quantile, got %s", call.Args[1])
+	}
+	for _, m := range vs.Matchers {
+		if m.Name == prometheus.BucketLabel {
+			return nil, fmt.Errorf("matcher on le label is not supported in histogram_quantile")
+		}
+	}
+	op, err := translateSelector(&VectorSelector{
+		Name:     strings.TrimSuffix(vs.Name, "_bucket"),
+		Matchers: vs.Matchers,
+		Offset:   vs.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	op.groupBy = groupBy
+	op.groupByAllTags = groupByAllTags
+	op.aggregated = !groupByAllTags
+	op.keepMetricName = false
+	op.expr = &stmt.CallExpr{FuncType: function.Quantile, Params: []stmt.Expr{&stmt.NumberLiteral{Val: phi.Val}}}
+	return op, nil
+}
+
+// translateAggregate translates sum/min/max/avg by (labels) aggregation.
+func translateAggregate(agg *AggregateExpr) (*operand, error) {
+	var funcType function.FuncType
+	switch agg.Op {
+	case "sum":
+		funcType = function.Sum
+	case "min":
+		funcType = function.Min
+	case "max":
+		funcType = function.Max
+	case "avg":
+		funcType = function.Avg
+	default:
+		return nil, fmt.Errorf("aggregation %s is not supported", agg.Op)
+	}
+	if agg.Without {
+		return nil, fmt.Errorf("without clause is not supported in aggregation")
+	}
+	op, err := translate(agg.Expr)
+	if err != nil {
+		return nil, err
+	}
+	if op.scalar {
+		return nil, fmt.Errorf("expected instant vector in aggregation %s, got scalar", agg.Op)
+	}
+	if op.aggregated {
+		return nil, fmt.Errorf("nested aggregation is not supported")
+	}
+	switch e := op.expr.(type) {
+	case *stmt.FieldExpr:
+		op.expr = &stmt.CallExpr{FuncType: funcType, Params: []stmt.Expr{e}}
+	case *stmt.CallExpr:
+		// value of rate is sum of series, cannot be aggregated by other function
+		if e.FuncType != function.Rate || funcType != function.Sum {
+			return nil, fmt.Errorf("aggregation %s over function %s is not supported", agg.Op, e.FuncType)
+		}
+	default:
+		return nil, fmt.Errorf("aggregation over expression %s is not supported", agg.Expr)
+	}
+	op.groupBy = agg.Grouping
+	op.groupByAllTags = false
+	op.keepMetricName = false
+	op.aggregated = true
+	return op, nil
+}
+
+// translateBinary translates arithmetic binary expression, both sides must select same series if not scalar.
+func translateBinary(e *BinaryExpr) (*operand, error) {
+	var binaryOP stmt.BinaryOP
+	switch e.Op {
+	case "+":
+		binaryOP = stmt.ADD
+	case "-":
+		binaryOP = stmt.SUB
+	case "*":
+		binaryOP = stmt.MUL
+	case "/":
+		binaryOP = stmt.DIV
+	default:
+		return nil, fmt.Errorf("binary operator %s is not supported", e.Op)
+	}
+	lhs, err := translate(e.LHS)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err := translate(e.RHS)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case lhs.scalar && rhs.scalar:
+		return &operand{scalar: true, value: calcScalar(binaryOP, lhs.value, rhs.value)}, nil
+	case lhs.scalar:
+		rhs.expr = &stmt.BinaryExpr{Left: &stmt.NumberLiteral{Val: lhs.value}, Operator: binaryOP, Right: rhs.expr}
+		rhs.keepMetricName = false
+		return rhs, nil
+	case rhs.scalar:
+		lhs.expr = &stmt.BinaryExpr{Left: lhs.expr, Operator: binaryOP, Right: &stmt.NumberLiteral{Val: rhs.value}}
+		lhs.keepMetricName = false
+		return lhs, nil
+	case !lhs.sameSeries(rhs):
+		return nil, fmt.Errorf("binary expression between different series selectors is not supported: %s", e)
+	default:
+		lhs.expr = &stmt.BinaryExpr{Left: lhs.expr, Operator: binaryOP, Right: rhs.expr}
+		lhs.keepMetricName = false
+		lhs.aggregated = lhs.aggregated || rhs.aggregated
+		return lhs, nil
+	}
+}
+
+// buildCondition builds tag filter condition from label matchers, regex is fully anchored as PromQL.
+func buildCondition(matchers []*LabelMatcher) (stmt.Expr, error) {
+	var condition stmt.Expr
+	for _, m := range matchers {
+		var expr stmt.Expr
+		switch m.Type {
+		case MatchEqual:
+			expr = &stmt.EqualsExpr{Key: m.Name, Value: m.Value}
+		case MatchNotEqual:
+			expr = &stmt.NotExpr{Expr: &stmt.EqualsExpr{Key: m.Name, Value: m.Value}}
+		case MatchRegexp, MatchNotRegexp:
+			pattern := "^(?:" + m.Value + ")$"
+			if _, err := regexp.Compile(pattern); err != nil {
+				return nil, fmt.Errorf("invalid regular expression in label matcher %s: %w", m, err)
+			}
+			expr = &stmt.RegexExpr{Key: m.Name, Regexp: pattern}
+			if m.Type == MatchNotRegexp {
+				expr = &stmt.NotExpr{Expr: expr}
+			}
+		}
+		if condition == nil {
+			condition = expr
+		} else {
+			condition = &stmt.BinaryExpr{Left: condition, Operator: stmt.AND, Right: expr}
+		}
+	}
+	return condition, nil
+}
+
+// calcScalar calculates the binary operation of two scalars.
+func calcScalar(op stmt.BinaryOP, left, right float64) float64 {
+	switch op {
+	case stmt.ADD:
+		return left + right
+	case stmt.SUB:
+		return left - right
+	case stmt.MUL:
+		return left * right
+	default:
+		return left / right
+	}
+}
+
+// unwrapParen returns the expression in parentheses.
+func unwrapParen(expr Expr) Expr {
+	for {
+		paren, ok := expr.(*ParenExpr)
+		if !ok {
+			return expr
+		}
+		expr = paren.Expr
+	}
+}
+
+// conditionString returns the string of tag filter condition.
+func conditionString(condition stmt.Expr) string {
+	if condition == nil {
+		return ""
+	}
+	return condition.Rewrite()
+}
@@ -0,0 +1,184 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func translateString(t *testing.T, input string) (*Plan, error) {
+	expr, err := Parse(input)
+	assert.NoError(t, err, input)
+	return Translate(expr)
+}
+
+func TestTranslate(t *testing.T) {
+	cases := []struct {
+		input          string
+		selectItem     string
+		groupBy        []string
+		metricName     string
+		groupByAllTags bool
+		keepMetricName bool
+	}{
+		{
+			input:          `cpu{host="a", region!="b"}`,
+			metricName:     "cpu",
+			selectItem:     "value as value",
+			groupByAllTags: true,
+			keepMetricName: true,
+		},
+		{
+			input:          `rate(http_requests_total{path=~"/api/.*"}[5m])`,
+			metricName:     "http_requests_total",
+			selectItem:     "rate(value) as value",
+			groupByAllTags: true,
+		},
+		{
+			input:      "sum by (job) (rate(http_requests_total[5m]))",
+			metricName: "http_requests_total",
+			selectItem: "rate(value) as value",
+			groupBy:    []string{"job"},
+		},
+		{
+			input:      "max(cpu) by (host)",
+			metricName: "cpu",
+			selectItem: "max(value) as value",
+			groupBy:    []string{"host"},
+		},
+		{
+			input:      "avg(cpu)",
+			metricName: "cpu",
+			selectItem: "avg(value) as value",
+		},
+		{
+			input:      "min(cpu) * 100",
+			metricName: "cpu",
+			selectItem: "min(value)*100.00 as value",
+		},
+		{
+			input:          "100 - cpu",
+			metricName:     "cpu",
+			selectItem:     "100.00-value as value",
+			groupByAllTags: true,
+		},
+		{
+			input:          "(cpu + cpu) / 2",
+			metricName:     "cpu",
+			selectItem:     "(value+value)/2.00 as value",
+			groupByAllTags: true,
+		},
+		{
+			input:      "histogram_quantile(0.99, sum by (le, job) (rate(latency_bucket{job='api'}[5m])))",
+			metricName: "latency",
+			selectItem: "quantile(0.99) as value",
+			groupBy:    []string{"job"},
+		},
+		{
+			input:          "histogram_quantile(0.9, rate(latency_bucket[5m]))",
+			metricName:     "latency",
+			selectItem:     "quantile(0.90) as value",
+			groupByAllTags: true,
+		},
+		{
+			input:          "histogram_quantile((0.5), (latency_bucket))",
+			metricName:     "latency",
+			selectItem:     "quantile(0.50) as value",
+			groupByAllTags: true,
+		},
+	}
+	for _, c := range cases {
+		plan, err := translateString(t, c.input)
+		assert.NoError(t, err, c.input)
+		assert.False(t, plan.IsScalar(), c.input)
+		q := plan.Query
+		assert.Equal(t, c.metricName, q.MetricName, c.input)
+		assert.Len(t, q.SelectItems, 1, c.input)
+		assert.Equal(t, c.selectItem, q.SelectItems[0].Rewrite(), c.input)
+		assert.Equal(t, c.groupBy, q.GroupBy, c.input)
+		assert.Equal(t, c.groupByAllTags, plan.GroupByAllTags, c.input)
+		assert.Equal(t, c.keepMetricName, plan.KeepMetricName, c.input)
+	}
+}
+
+func TestTranslate_Condition(t *testing.T) {
+	plan, err := translateString(t, `cpu{host="a", region!="b", path=~"/api", job!~"test.*"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, "host=aandnot region=bandpath=~^(?:/api)$andnot job=~^(?:test.*)$",
+		plan.Query.Condition.Rewrite())
+
+	plan, err = translateString(t, "cpu")
+	assert.NoError(t, err)
+	assert.Nil(t, plan.Query.Condition)
+}
+
+func TestTranslate_Scalar(t *testing.T) {
+	cases := map[string]float64{
+		"1":           1,
+		"1 + 2":       3,
+		"(5 - 2) * 4": 12,
+		"9 / 3":       3,
+		"-2":          -2,
+	}
+	for input, want := range cases {
+		plan, err := translateString(t, input)
+		assert.NoError(t, err, input)
+		assert.True(t, plan.IsScalar(), input)
+		assert.Equal(t, want, plan.Scalar, input)
+	}
+}
+
+func TestTranslate_Error(t *testing.T) {
+	cases := []string{
+		"cpu[5m]",
+		"cpu offset 5m",
+		"irate(cpu[5m])",
+		"rate(cpu)",
+		"rate(cpu[5m], 1)",
+		"topk(5, cpu)",
+		"sum without (host) (cpu)",
+		"sum(1)",
+		"sum(sum(cpu))",
+		"max(rate(cpu[5m]))",
+		"sum(cpu * 2)",
+		"sum(bad[5m])",
+		"cpu > 1",
+		"cpu + mem",
+		"sum by (host) (cpu) + sum by (job) (cpu)",
+		"cpu{host='a'} / cpu{host='b'}",
+		"bad[5m] + 1",
+		"1 + bad[5m]",
+		`cpu{host=~"("}`,
+		`"abc"`,
+		"histogram_quantile(0.9)",
+		"histogram_quantile(2, latency_bucket)",
+		"histogram_quantile(x, latency_bucket)",
+		"histogram_quantile(0.9, latency)",
+		"histogram_quantile(0.9, max by (le) (latency_bucket))",
+		"histogram_quantile(0.9, sum by (job) (latency_bucket))",
+		"histogram_quantile(0.9, latency_bucket{le='1'})",
+		"histogram_quantile(0.9, latency_bucket offset 1m)",
+		"(bad[5m])",
+	}
+	for _, c := range cases {
+		_, err := translateString(t, c)
+		assert.Error(t, err, c)
+	}
+}
@@ -21,10 +21,12 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	commonconstants "github.com/lindb/common/constants"
 
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/strutil"
 	"github.com/lindb/lindb/pkg/timeutil"
@@ -37,15 +39,20 @@ type queryStmtParser struct {
 	baseStmtParser
 	explain bool
 
+	sources     []*stmt.MetricSource
+	subQuery    *stmt.Query
 	selectItems []stmt.Expr
 	fieldNames  map[string]struct{} // cache field name include alias
 
-	startTime int64
-	endTime   int64
+	groupBy   []string
+	interval  int64
+	fill      stmt.FillType
+	fillValue float64
+	having    stmt.Expr
+	others    string
+	orderBy   []stmt.Expr
 
-	groupBy  []string
-	interval int64
-	orderBy  []stmt.Expr
+	inHaving bool
 
 	curOrderByExpr *stmt.OrderByExpr
 	hasOrderBy     bool
@@ -74,11 +81,22 @@ func (q *queryStmtParser) build() (stmt.Statement, error) {
 	query.Explain = q.explain
 	query.Namespace = q.namespace
 	query.MetricName = q.metricName
+	if len(q.sources) > 1 {
+		query.Sources = q.sources
+	} else if len(q.sources) == 1 && q.sources[0].Alias != "" {
+		// single metric query, just removes metric alias qualifier of field
+		q.trimMetricAlias(q.sources[0].Alias)
+	}
 	query.SelectItems = q.selectItems
 	query.Condition = q.condition
+	query.SubQuery = q.subQuery
 
 	now := timeutil.Now()
 	query.TimeRange = timeutil.TimeRange{Start: q.startTime, End: q.endTime}
+	if q.subQuery != nil {
+		// outer query aggregates the result set of sub query, uses sub query's time range
+		query.TimeRange = q.subQuery.TimeRange
+	}
 	if query.TimeRange.Start <= 0 {
 		query.TimeRange.Start = now - timeutil.OneHour
 	}
@@ -91,6 +109,10 @@ func (q *queryStmtParser) build() (stmt.Statement, error) {
 
 	query.Interval = timeutil.Interval(q.interval)
 	query.GroupBy = q.groupBy
+	query.Fill = q.fill
+	query.FillValue = q.fillValue
+	query.Having = q.resolveAlias(q.having)
+	query.Others = q.others
 	query.OrderByItems = q.orderBy
 	query.Limit = q.limit
 	return query, nil
@@ -101,15 +123,230 @@ func (q *queryStmtParser) validation() error {
 	if q.err != nil {
 		return q.err
 	}
-	if q.metricName == "" {
+	if q.metricName == "" && q.subQuery == nil {
 		return fmt.Errorf("metric name cannot be empty")
 	}
 	if len(q.selectItems) == 0 {
 		return fmt.Errorf("select fields cannbe be empty")
 	}
+	if q.subQuery != nil {
+		return q.validateSubQuery()
+	}
+	if err := q.validateSelector(); err != nil {
+		return err
+	}
+	return q.validateJoin()
+}
+
+// validateJoin checks cross-metric query if valid, all fields must be qualified by metric name or alias.
+func (q *queryStmtParser) validateJoin() error {
+	if len(q.sources) < 2 {
+		return nil
+	}
+	names := make(map[string]struct{})
+	for _, source := range q.sources {
+		if _, ok := names[source.Name()]; ok {
+			return fmt.Errorf("duplicate metric in cross-metric query, metric: %s", source.Name())
+		}
+		names[source.Name()] = struct{}{}
+	}
+	if q.having != nil {
+		return errors.New("having clause not support cross-metric query")
+	}
+	if len(q.orderBy) > 0 {
+		return errors.New("order by not support cross-metric query")
+	}
+	query := &stmt.Query{Sources: q.sources}
+	for _, item := range q.selectItems {
+		if hasSelectorFunc(item) {
+			return errors.New("topk/bottomk function not support cross-metric query")
+		}
+		var err error
+		stmt.WalkFieldExpr(item, func(e *stmt.FieldExpr) {
+			if _, _, ok := query.ResolveSource(e.Name); !ok && err == nil {
+				err = fmt.Errorf("field must be qualified by metric in cross-metric query, field: %s", e.Name)
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// validateSubQuery checks the outer query which selects from sub query if valid,
+// all fields must be aggregated by sum/min/max/last/avg/count and reference sub query's select fields.
+func (q *queryStmtParser) validateSubQuery() error {
+	if q.condition != nil || q.startTime > 0 || q.endTime > 0 {
+		return errors.New("where clause not support query which selects from sub query")
+	}
+	names := make(map[string]struct{})
+	for _, item := range q.subQuery.SelectItems {
+		if selectItem, ok := item.(*stmt.SelectItem); ok && selectItem.Alias != "" {
+			names[selectItem.Alias] = struct{}{}
+		} else {
+			names[item.Rewrite()] = struct{}{}
+		}
+	}
+	for _, item := range q.selectItems {
+		if hasSelectorFunc(item) {
+			return errors.New("topk/bottomk function not support query which selects from sub query")
+		}
+		if err := validateNestedAgg(item, names, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateNestedAgg checks if each field of outer query is the only param of aggregate function.
+func validateNestedAgg(expr stmt.Expr, names map[string]struct{}, inAgg bool) error {
+	switch e := expr.(type) {
+	case *stmt.SelectItem:
+		return validateNestedAgg(e.Expr, names, inAgg)
+	case *stmt.CallExpr:
+		if inAgg {
+			return fmt.Errorf("[%s] function not support in aggregate function of sub query's fields", e.FuncType)
+		}
+		isAgg := isNestedAggFunc(e.FuncType)
+		if isAgg && len(e.Params) != 1 {
+			return fmt.Errorf("[%s] function params length invalid", e.FuncType)
+		}
+		for _, param := range e.Params {
+			if err := validateNestedAgg(param, names, isAgg); err != nil {
+				return err
+			}
+		}
+	case *stmt.ParenExpr:
+		return validateNestedAgg(e.Expr, names, inAgg)
+	case *stmt.BinaryExpr:
+		if inAgg {
+			return errors.New("binary expression not support in aggregate function of sub query's fields")
+		}
+		if err := validateNestedAgg(e.Left, names, inAgg); err != nil {
+			return err
+		}
+		return validateNestedAgg(e.Right, names, inAgg)
+	case *stmt.FieldExpr:
+		if !inAgg {
+			return fmt.Errorf("field of sub query must be aggregated by sum/min/max/last/avg/count, field: %s", e.Name)
+		}
+		if _, ok := names[e.Name]; !ok {
+			return fmt.Errorf("field not in select fields of sub query, field: %s", e.Name)
+		}
+	}
+	return nil
+}
+
+// isNestedAggFunc checks if function can aggregate the result set of sub query.
+func isNestedAggFunc(funcType function.FuncType) bool {
+	switch funcType {
+	case function.Sum, function.Min, function.Max, function.Last, function.Avg, function.Count:
+		return true
+	default:
+		return false
+	}
+}
+
+// trimMetricAlias removes metric alias qualifier(alias.field) of field exprs.
+func (q *queryStmtParser) trimMetricAlias(alias string) {
+	prefix := alias + "."
+	trim := func(e *stmt.FieldExpr) {
+		if len(e.Name) > len(prefix) && strings.HasPrefix(e.Name, prefix) {
+			e.Name = e.Name[len(prefix):]
+		}
+	}
+	for _, item := range q.selectItems {
+		stmt.WalkFieldExpr(item, trim)
+	}
+	for _, item := range q.orderBy {
+		stmt.WalkFieldExpr(item, trim)
+	}
+	if q.having != nil {
+		stmt.WalkFieldExpr(q.having, trim)
+	}
+}
+
+// validateSelector checks topk/bottomk select item and others clause if valid.
+func (q *queryStmtParser) validateSelector() error {
+	var selector *stmt.CallExpr
+	for _, item := range q.selectItems {
+		expr := item
+		if selectItem, ok := item.(*stmt.SelectItem); ok {
+			expr = selectItem.Expr
+		}
+		if callExpr, ok := expr.(*stmt.CallExpr); ok && function.IsSelectorFunc(callExpr.FuncType) {
+			if selector != nil {
+				return errors.New("only one topk/bottomk function allowed in select fields")
+			}
+			selector = callExpr
+			for _, param := range callExpr.Params {
+				if hasSelectorFunc(param) {
+					return fmt.Errorf("[%s] function cannot be nested", callExpr.FuncType)
+				}
+			}
+			continue
+		}
+		if hasSelectorFunc(expr) {
+			return errors.New("topk/bottomk function must be a top level select field")
+		}
+	}
+	if selector == nil {
+		if q.others != "" {
+			return errors.New("others clause requires topk/bottomk function")
+		}
+		return nil
+	}
+	if len(q.groupBy) == 0 {
+		return fmt.Errorf("[%s] function requires group by", selector.FuncType)
+	}
+	if len(q.orderBy) > 0 {
+		return fmt.Errorf("[%s] function cannot be used with order by", selector.FuncType)
+	}
+	if len(selector.Params) != 2 && len(selector.Params) != 3 {
+		return fmt.Errorf("[%s] function params length invalid", selector.FuncType)
+	}
+	k, ok := selector.Params[0].(*stmt.NumberLiteral)
+	if !ok || k.Val < 1 || k.Val != float64(int(k.Val)) {
+		return fmt.Errorf("[%s] function requires positive integer k", selector.FuncType)
+	}
+	if len(selector.Params) == 3 {
+		partitionKey, ok := selector.Params[2].(*stmt.FieldExpr)
+		if !ok {
+			return fmt.Errorf("[%s] function partition key must be a tag key", selector.FuncType)
+		}
+		for _, tagKey := range q.groupBy {
+			if tagKey == partitionKey.Name {
+				return nil
+			}
+		}
+		return fmt.Errorf("[%s] function partition key not in group by, tag key: %s", selector.FuncType, partitionKey.Name)
+	}
+	return nil
+}
+
+// hasSelectorFunc checks if expr contains topk/bottomk function.
+func hasSelectorFunc(expr stmt.Expr) bool {
+	switch e := expr.(type) {
+	case *stmt.CallExpr:
+		if function.IsSelectorFunc(e.FuncType) {
+			return true
+		}
+		for _, param := range e.Params {
+			if hasSelectorFunc(param) {
+				return true
+			}
+		}
+	case *stmt.SelectItem:
+		return hasSelectorFunc(e.Expr)
+	case *stmt.ParenExpr:
+		return hasSelectorFunc(e.Expr)
+	case *stmt.BinaryExpr:
+		return hasSelectorFunc(e.Left) || hasSelectorFunc(e.Right)
+	}
+	return false
+}
+
 // resetExprStack resets expr stack for next parse fragment
 func (q *queryStmtParser) resetExprStack() {
 	q.exprStack = collections.NewStack()
@@ -126,6 +363,176 @@ func (q *queryStmtParser) visitGroupByKey(ctx *grammar.GroupByKeyContext) {
 	}
 }
 
+// visitFillOption visits when production fill option expression is entered.
+func (q *queryStmtParser) visitFillOption(ctx *grammar.FillOptionContext) {
+	switch {
+	case ctx.T_NULL() != nil:
+		q.fill = stmt.FillNull
+	case ctx.T_PREVIOUS() != nil:
+		q.fill = stmt.FillPrevious
+	case ctx.L_INT() != nil || ctx.L_DEC() != nil:
+		val, err := strconv.ParseFloat(ctx.GetText(), 64)
+		if err != nil {
+			q.err = err
+			return
+		}
+		q.fill = stmt.FillValue
+		q.fillValue = val
+	}
+}
+
+// visitMetricName visits when production metric name expression is entered, collects metric sources.
+func (q *queryStmtParser) visitMetricName(ctx *grammar.MetricNameContext) {
+	metricName := strutil.GetStringValue(ctx.Ident().GetText())
+	if q.metricName == "" {
+		q.metricName = metricName
+	}
+	q.sources = append(q.sources, &stmt.MetricSource{MetricName: metricName})
+}
+
+// visitSubQuery visits when production sub query is exited, builds sub query statement.
+func (q *queryStmtParser) visitSubQuery(subQuery *queryStmtParser, hasLimit bool) {
+	if q.err != nil {
+		return
+	}
+	if subQuery.explain {
+		q.err = errors.New("explain not support in sub query")
+		return
+	}
+	if !hasLimit {
+		// outer query need aggregate all series of sub query if limit not set
+		subQuery.limit = constants.MaxSubQuerySeries
+	}
+	query, err := subQuery.build()
+	if err != nil {
+		q.err = err
+		return
+	}
+	q.subQuery = query.(*stmt.Query)
+}
+
+// visitMetricAlias visits when production metric alias expression is entered.
+func (q *queryStmtParser) visitMetricAlias(ctx *grammar.MetricAliasContext) {
+	if len(q.sources) == 0 {
+		return
+	}
+	q.sources[len(q.sources)-1].Alias = strutil.GetStringValue(ctx.Ident().GetText())
+}
+
+// visitOthersClause visits when production others clause expression is entered.
+func (q *queryStmtParser) visitOthersClause(ctx *grammar.OthersClauseContext) {
+	q.others = strutil.GetStringValue(ctx.Ident().GetText())
+}
+
+// visitHavingClause visits when production having clause expression is entered.
+func (q *queryStmtParser) visitHavingClause() {
+	q.inHaving = true
+	q.resetExprStack()
+}
+
+// completeHavingClause completes having clause.
+func (q *queryStmtParser) completeHavingClause() {
+	q.inHaving = false
+	q.resetExprStack()
+}
+
+// visitBoolExpr visits when production bool expression is entered.
+func (q *queryStmtParser) visitBoolExpr(ctx *grammar.BoolExprContext) {
+	switch {
+	case ctx.T_OPEN_P() != nil:
+		q.exprStack.Push(&stmt.ParenExpr{})
+	case ctx.BoolExprLogicalOp() != nil:
+		logicalOp, ok := ctx.BoolExprLogicalOp().(*grammar.BoolExprLogicalOpContext)
+		if !ok {
+			return
+		}
+		if logicalOp.T_AND() != nil {
+			q.exprStack.Push(&stmt.BinaryExpr{Operator: stmt.AND})
+		} else {
+			q.exprStack.Push(&stmt.BinaryExpr{Operator: stmt.OR})
+		}
+	}
+}
+
+// completeBoolExpr completes a bool expression, only paren and logical expr need to do set expr param.
+func (q *queryStmtParser) completeBoolExpr(ctx *grammar.BoolExprContext) {
+	if ctx.T_OPEN_P() == nil && ctx.BoolExprLogicalOp() == nil {
+		return
+	}
+	q.completeHavingExpr()
+}
+
+// visitBinaryExpr visits when production binary expression is entered,
+// binary expression is comparison of having clause.
+func (q *queryStmtParser) visitBinaryExpr(ctx *grammar.BinaryExprContext) {
+	if !q.inHaving {
+		return
+	}
+	operator, ok := ctx.BinaryOperator().(*grammar.BinaryOperatorContext)
+	if !ok {
+		return
+	}
+	op := stmt.UNKNOWN
+	switch {
+	case operator.T_EQUAL() != nil:
+		op = stmt.EQUAL
+	case operator.T_NOTEQUAL() != nil || operator.T_NOTEQUAL2() != nil:
+		op = stmt.NOTEQUAL
+	case operator.T_LESS() != nil:
+		op = stmt.LESS
+	case operator.T_LESSEQUAL() != nil:
+		op = stmt.LESSEQUAL
+	case operator.T_GREATER() != nil:
+		op = stmt.GREATER
+	case operator.T_GREATEREQUAL() != nil:
+		op = stmt.GREATEREQUAL
+	default:
+		q.err = fmt.Errorf("having clause not support operator: %s", operator.GetText())
+	}
+	q.exprStack.Push(&stmt.BinaryExpr{Operator: op})
+}
+
+// completeBinaryExpr completes a comparison expression of having clause.
+func (q *queryStmtParser) completeBinaryExpr() {
+	if !q.inHaving {
+		return
+	}
+	q.completeHavingExpr()
+}
+
+// completeHavingExpr pops the current having expr, then sets it as parent's param or root having condition.
+func (q *queryStmtParser) completeHavingExpr() {
+	cur := q.exprStack.Pop()
+	expr, ok := cur.(stmt.Expr)
+	if !ok {
+		return
+	}
+	if q.exprStack.Empty() {
+		q.having = expr
+		return
+	}
+	q.setExprParam(expr)
+}
+
+// resolveAlias replaces the field expr which references select item's alias with the aliased expr.
+func (q *queryStmtParser) resolveAlias(expr stmt.Expr) stmt.Expr {
+	switch e := expr.(type) {
+	case *stmt.FieldExpr:
+		for _, item := range q.selectItems {
+			selectItem, ok := item.(*stmt.SelectItem)
+			if ok && selectItem.Alias == e.Name {
+				return selectItem.Expr
+			}
+		}
+	case *stmt.ParenExpr:
+		e.Expr = q.resolveAlias(e.Expr)
+	case *stmt.BinaryExpr:
+		e.Left = q.resolveAlias(e.Left)
+		e.Right = q.resolveAlias(e.Right)
+	}
+	return expr
+}
+
 // visitSortField visits when production sort field expression is entered.
 func (q *queryStmtParser) visitSortField(ctx *grammar.SortFieldContext) {
 	q.hasOrderBy = true
@@ -167,89 +574,6 @@ func (q *queryStmtParser) check() error {
 	return nil
 }
 
-// visitTimeRangeExpr visits when production timeRange expression is entered.
-func (q *queryStmtParser) visitTimeRangeExpr(ctx *grammar.TimeRangeExprContext) {
-	timeExprCtxList := ctx.AllTimeExpr()
-	for _, timeExpr := range timeExprCtxList {
-		timeExprCtx, ok := timeExpr.(*grammar.TimeExprContext)
-		if !ok {
-			continue
-		}
-		var timestamp int64
-		var err error
-		switch {
-		case timeExprCtx.Ident() != nil:
-			timestamp, err = timeutil.ParseTimestamp(strutil.GetStringValue(timeExprCtx.Ident().GetText()))
-		case timeExprCtx.NowExpr() != nil:
-			timestamp = timeutil.Now()
-			durationExpr, durationExist := timeExprCtx.NowExpr().(*grammar.NowExprContext)
-			if durationExist {
-				timestamp += q.parseDuration(durationExpr.DurationLit())
-			}
-		}
-		if err != nil {
-			q.err = err
-			continue
-		}
-		binaryOp := timeExprCtx.BinaryOperator()
-		if binaryOp == nil {
-			continue
-		}
-		binaryOpCtx, ok := binaryOp.(*grammar.BinaryOperatorContext)
-		if !ok {
-			continue
-		}
-		if binaryOpCtx.T_GREATER() != nil || binaryOpCtx.T_GREATEREQUAL() != nil {
-			q.startTime = timestamp
-		}
-		if binaryOpCtx.T_LESS() != nil || binaryOpCtx.T_LESSEQUAL() != nil {
-			q.endTime = timestamp
-		}
-	}
-}
-
-// parseDuration parses time duration from duration string
-func (q *queryStmtParser) parseDuration(ctx grammar.IDurationLitContext) int64 {
-	if ctx == nil {
-		return 0
-	}
-	durationCtx, ok := ctx.(*grammar.DurationLitContext)
-	if !ok {
-		return 0
-	}
-
-	duration, err := strconv.ParseInt(durationCtx.IntNumber().GetText(), 10, 64)
-	if err != nil {
-		q.err = err
-		return 0
-	}
-	var result int64
-	if durationCtx.IntervalItem() == nil {
-		return result
-	}
-	unit, ok := durationCtx.IntervalItem().(*grammar.IntervalItemContext)
-	if !ok {
-		return result
-	}
-	switch {
-	case unit.T_SECOND() != nil:
-		result = duration * timeutil.OneSecond
-	case unit.T_MINUTE() != nil:
-		result = duration * timeutil.OneMinute
-	case unit.T_HOUR() != nil:
-		result = duration * timeutil.OneHour
-	case unit.T_DAY() != nil:
-		result = duration * timeutil.OneDay
-	case unit.T_WEEK() != nil:
-		result = duration * timeutil.OneWeek
-	case unit.T_MONTH() != nil:
-		result = duration * timeutil.OneMonth
-	case unit.T_YEAR() != nil:
-		result = duration * timeutil.OneYear
-	}
-	return result
-}
-
 // visitFieldExpr visits when production field expression is entered
 func (q *queryStmtParser) visitFieldExpr(ctx *grammar.FieldExprContext) {
 	switch {
@@ -310,6 +634,28 @@ func (q *queryStmtParser) visitFuncName(ctx *grammar.FuncNameContext) {
 		callExpr.FuncType = function.Quantile
 	case ctx.T_RATE() != nil:
 		callExpr.FuncType = function.Rate
+	case ctx.T_INCREASE() != nil:
+		callExpr.FuncType = function.Increase
+	case ctx.T_DELTA() != nil:
+		callExpr.FuncType = function.Delta
+	case ctx.T_IRATE() != nil:
+		callExpr.FuncType = function.Irate
+	case ctx.T_DERIV() != nil:
+		callExpr.FuncType = function.Deriv
+	case ctx.T_ABS() != nil:
+		callExpr.FuncType = function.Abs
+	case ctx.T_CEIL() != nil:
+		callExpr.FuncType = function.Ceil
+	case ctx.T_FLOOR() != nil:
+		callExpr.FuncType = function.Floor
+	case ctx.T_ROUND() != nil:
+		callExpr.FuncType = function.Round
+	case ctx.T_CLAMP() != nil:
+		callExpr.FuncType = function.Clamp
+	case ctx.T_TOPK() != nil:
+		callExpr.FuncType = function.TopK
+	case ctx.T_BOTTOMK() != nil:
+		callExpr.FuncType = function.BottomK
 	}
 }
 
@@ -361,6 +707,8 @@ func (q *queryStmtParser) parseFieldName(fieldName string) {
 	fieldExpr := &stmt.FieldExpr{Name: fieldName}
 
 	switch {
+	case q.inHaving: // handle having item
+		q.setExprParam(fieldExpr)
 	case q.hasOrderBy: // handle order by item
 		if q.exprStack.Empty() {
 			q.curOrderByExpr.Expr = fieldExpr
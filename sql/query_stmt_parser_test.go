@@ -26,6 +26,7 @@ import (
 	commonconstants "github.com/lindb/common/constants"
 
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -174,6 +175,55 @@ func TestSelectFuncItem(t *testing.T) {
 	}, *selectItem)
 }
 
+func TestSelectRangeAndMathFuncItem(t *testing.T) {
+	cases := []struct {
+		sql      string
+		funcType function.FuncType
+		params   []stmt.Expr
+	}{
+		{sql: "select increase(f) from memory", funcType: function.Increase, params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+		{sql: "select delta(f) from memory", funcType: function.Delta, params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+		{sql: "select irate(f) from memory", funcType: function.Irate, params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+		{sql: "select deriv(f) from memory", funcType: function.Deriv, params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+		{sql: "select abs(f) from memory", funcType: function.Abs, params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+		{sql: "select ceil(f) from memory", funcType: function.Ceil, params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+		{sql: "select floor(f) from memory", funcType: function.Floor, params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+		{
+			sql:      "select round(f, 0.5) from memory",
+			funcType: function.Round,
+			params:   []stmt.Expr{&stmt.FieldExpr{Name: "f"}, &stmt.NumberLiteral{Val: 0.5}},
+		},
+		{
+			sql:      "select clamp(f, -10, 100) from memory",
+			funcType: function.Clamp,
+			params:   []stmt.Expr{&stmt.FieldExpr{Name: "f"}, &stmt.NumberLiteral{Val: -10}, &stmt.NumberLiteral{Val: 100}},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.sql, func(t *testing.T) {
+			q, err := Parse(tt.sql)
+			assert.NoError(t, err)
+			query := q.(*stmt.Query)
+			assert.Len(t, query.SelectItems, 1)
+			assert.Equal(t, stmt.SelectItem{
+				Expr: &stmt.CallExpr{FuncType: tt.funcType, Params: tt.params},
+			}, *(query.SelectItems[0]).(*stmt.SelectItem))
+		})
+	}
+	// function name can be used as identifier
+	q, err := Parse("select delta from round")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, "round", query.MetricName)
+	assert.Equal(t, stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "delta"}}, *(query.SelectItems[0]).(*stmt.SelectItem))
+	// nested function
+	q, err = Parse("select abs(increase(f)) from memory")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, "abs(increase(f))", query.SelectItems[0].Rewrite())
+}
+
 func TestFieldExpression(t *testing.T) {
 	q, err := Parse("select f+100 from cpu")
 	query := q.(*stmt.Query)
@@ -422,6 +472,292 @@ func TestGroupBy(t *testing.T) {
 	assert.Equal(t, "/data", query.GroupBy[1])
 }
 
+func TestFill(t *testing.T) {
+	cases := []struct {
+		sql   string
+		fill  stmt.FillType
+		value float64
+	}{
+		{sql: "select f from cpu group by host", fill: stmt.FillNone},
+		{sql: "select f from cpu group by host fill(NULL)", fill: stmt.FillNull},
+		{sql: "select f from cpu group by host fill(previous)", fill: stmt.FillPrevious},
+		{sql: "select f from cpu group by host fill(10)", fill: stmt.FillValue, value: 10},
+		{sql: "select f from cpu group by time(1m) fill(1.5)", fill: stmt.FillValue, value: 1.5},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.sql, func(t *testing.T) {
+			q, err := Parse(tt.sql)
+			assert.NoError(t, err)
+			query := q.(*stmt.Query)
+			assert.Equal(t, tt.fill, query.Fill)
+			assert.Equal(t, tt.value, query.FillValue)
+		})
+	}
+}
+
+func TestHaving(t *testing.T) {
+	maxF := &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}}
+	sumF := &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}}
+	cases := []struct {
+		name    string
+		sql     string
+		having  stmt.Expr
+		wantErr bool
+	}{
+		{
+			name: "no having",
+			sql:  "select f from cpu group by host",
+		},
+		{
+			name: "having function",
+			sql:  "select max(f) from cpu group by host having max(f) > 10",
+			having: &stmt.BinaryExpr{
+				Left: maxF, Operator: stmt.GREATER, Right: &stmt.NumberLiteral{Val: 10},
+			},
+		},
+		{
+			name: "having field",
+			sql:  "select f from cpu group by host having f <= 1.5",
+			having: &stmt.BinaryExpr{
+				Left: &stmt.FieldExpr{Name: "f"}, Operator: stmt.LESSEQUAL, Right: &stmt.NumberLiteral{Val: 1.5},
+			},
+		},
+		{
+			name: "having alias",
+			sql:  "select max(f) as m from cpu group by host having m != 1",
+			having: &stmt.BinaryExpr{
+				Left: maxF, Operator: stmt.NOTEQUAL, Right: &stmt.NumberLiteral{Val: 1},
+			},
+		},
+		{
+			name: "having math expr",
+			sql:  "select f from cpu group by host having sum(f)/2 >= max(f)",
+			having: &stmt.BinaryExpr{
+				Left: &stmt.BinaryExpr{
+					Left: sumF, Operator: stmt.DIV, Right: &stmt.NumberLiteral{Val: 2},
+				},
+				Operator: stmt.GREATEREQUAL,
+				Right:    maxF,
+			},
+		},
+		{
+			name: "having logical expr",
+			sql:  "select f from cpu group by host having (max(f) < 1 or max(f) = 5) and sum(f) <> 2",
+			having: &stmt.BinaryExpr{
+				Left: &stmt.ParenExpr{Expr: &stmt.BinaryExpr{
+					Left:     &stmt.BinaryExpr{Left: maxF, Operator: stmt.LESS, Right: &stmt.NumberLiteral{Val: 1}},
+					Operator: stmt.OR,
+					Right:    &stmt.BinaryExpr{Left: maxF, Operator: stmt.EQUAL, Right: &stmt.NumberLiteral{Val: 5}},
+				}},
+				Operator: stmt.AND,
+				Right:    &stmt.BinaryExpr{Left: sumF, Operator: stmt.NOTEQUAL, Right: &stmt.NumberLiteral{Val: 2}},
+			},
+		},
+		{
+			name:    "having like not support",
+			sql:     "select f from cpu group by host having f like 1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.sql)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			query := q.(*stmt.Query)
+			assert.Equal(t, tt.having, query.Having)
+			assert.Len(t, query.SelectItems, 1)
+		})
+	}
+}
+
+func TestTopK(t *testing.T) {
+	avgF := &stmt.CallExpr{FuncType: function.Avg, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}}
+	cases := []struct {
+		name    string
+		sql     string
+		item    stmt.Expr
+		others  string
+		wantErr bool
+	}{
+		{
+			name: "topk",
+			sql:  "select topk(5, avg(f)) from cpu group by host",
+			item: &stmt.SelectItem{Expr: &stmt.CallExpr{
+				FuncType: function.TopK,
+				Params:   []stmt.Expr{&stmt.NumberLiteral{Val: 5}, avgF},
+			}},
+		},
+		{
+			name: "bottomk with alias and others",
+			sql:  "select bottomk(3, avg(f)) as b from cpu group by host others as 'rest'",
+			item: &stmt.SelectItem{Alias: "b", Expr: &stmt.CallExpr{
+				FuncType: function.BottomK,
+				Params:   []stmt.Expr{&stmt.NumberLiteral{Val: 3}, avgF},
+			}},
+			others: "rest",
+		},
+		{
+			name: "topk per partition key",
+			sql:  "select topk(2, avg(f), region) from cpu group by region, host having avg(f) > 1 others as other",
+			item: &stmt.SelectItem{Expr: &stmt.CallExpr{
+				FuncType: function.TopK,
+				Params:   []stmt.Expr{&stmt.NumberLiteral{Val: 2}, avgF, &stmt.FieldExpr{Name: "region"}},
+			}},
+			others: "other",
+		},
+		{
+			name:    "without group by",
+			sql:     "select topk(5, avg(f)) from cpu",
+			wantErr: true,
+		},
+		{
+			name:    "invalid k",
+			sql:     "select topk(1.5, avg(f)) from cpu group by host",
+			wantErr: true,
+		},
+		{
+			name:    "k not number",
+			sql:     "select topk(f, avg(f)) from cpu group by host",
+			wantErr: true,
+		},
+		{
+			name:    "params length invalid",
+			sql:     "select topk(5) from cpu group by host",
+			wantErr: true,
+		},
+		{
+			name:    "partition key not in group by",
+			sql:     "select topk(5, avg(f), region) from cpu group by host",
+			wantErr: true,
+		},
+		{
+			name:    "partition key not tag key",
+			sql:     "select topk(5, avg(f), avg(f)) from cpu group by host",
+			wantErr: true,
+		},
+		{
+			name:    "nested topk",
+			sql:     "select avg(topk(5, f)) from cpu group by host",
+			wantErr: true,
+		},
+		{
+			name:    "nested in topk",
+			sql:     "select topk(5, bottomk(5, f)) from cpu group by host",
+			wantErr: true,
+		},
+		{
+			name:    "multiple topk",
+			sql:     "select topk(5, avg(f)), bottomk(5, avg(f)) from cpu group by host",
+			wantErr: true,
+		},
+		{
+			name:    "topk with order by",
+			sql:     "select topk(5, avg(f)), avg(f) from cpu group by host order by avg(f)",
+			wantErr: true,
+		},
+		{
+			name:    "others without topk",
+			sql:     "select avg(f) from cpu group by host others as rest",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.sql)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			query := q.(*stmt.Query)
+			assert.Equal(t, tt.item, query.SelectItems[0])
+			assert.Equal(t, tt.others, query.Others)
+		})
+	}
+}
+
+func TestCrossMetricQuery(t *testing.T) {
+	q, err := Parse("select sum(e.count)/sum(requests.count) as ratio from errors as e, requests group by host")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.True(t, query.IsJoin())
+	assert.Equal(t, "errors", query.MetricName)
+	assert.Equal(t, []*stmt.MetricSource{{MetricName: "errors", Alias: "e"}, {MetricName: "requests"}}, query.Sources)
+	assert.Equal(t, "sum(e.count)/sum(requests.count) as ratio", query.SelectItems[0].Rewrite())
+
+	q, err = Parse("select c.f, max(c.g) from cpu as c where host='a' group by host having max(c.g) > 1 order by c.f")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.False(t, query.IsJoin())
+	assert.Equal(t, "cpu", query.MetricName)
+	assert.Equal(t, "f", query.SelectItems[0].Rewrite())
+	assert.Equal(t, "max(g)", query.SelectItems[1].Rewrite())
+	assert.Equal(t, "max(g)>1.00", query.Having.Rewrite())
+	assert.Equal(t, "f asc", query.OrderByItems[0].Rewrite())
+
+	for _, sql := range []string{
+		"select errors.count/count from errors, requests",
+		"select a.count from errors as a, requests as a",
+		"select errors.count from errors, requests group by host having errors.count > 1",
+		"select errors.count from errors, requests group by host order by errors.count",
+		"select topk(1, errors.count) from errors, requests group by host",
+	} {
+		_, err = Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+func TestSubQuery(t *testing.T) {
+	q, err := Parse("select max(r), avg(r) as a from (select rate(count) as r from http where time>now()-2h group by host, dc) group by dc")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.True(t, query.IsSubQuery())
+	assert.Empty(t, query.MetricName)
+	assert.Equal(t, []string{"dc"}, query.GroupBy)
+	assert.Equal(t, "max(r)", query.SelectItems[0].Rewrite())
+	assert.Equal(t, "avg(r) as a", query.SelectItems[1].Rewrite())
+	assert.Equal(t, 20, query.Limit)
+	subQuery := query.SubQuery
+	assert.Equal(t, "http", subQuery.MetricName)
+	assert.Equal(t, []string{"host", "dc"}, subQuery.GroupBy)
+	assert.Equal(t, "rate(count) as r", subQuery.SelectItems[0].Rewrite())
+	assert.Equal(t, constants.MaxSubQuerySeries, subQuery.Limit)
+	assert.Equal(t, subQuery.TimeRange, query.TimeRange)
+
+	q, err = Parse("select sum(c) from (select sum(count) as c from (select count from http limit 5) group by host) " +
+		"group by time(1m) having sum(c) > 1 order by c limit 10")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, 10, query.Limit)
+	assert.Equal(t, timeutil.Interval(timeutil.OneMinute), query.Interval)
+	assert.Equal(t, "sum(c)>1.00", query.Having.Rewrite())
+	assert.Equal(t, constants.MaxSubQuerySeries, query.SubQuery.Limit)
+	assert.Equal(t, 5, query.SubQuery.SubQuery.Limit)
+
+	for _, sql := range []string{
+		"select r from (select rate(count) as r from http group by host)",
+		"select max(r)+r from (select rate(count) as r from http group by host)",
+		"select max(count) from (select rate(count) as r from http group by host)",
+		"select max(abs(r)) from (select rate(count) as r from http group by host)",
+		"select max(r*2) from (select rate(count) as r from http group by host)",
+		"select max(sum(r)) from (select rate(count) as r from http group by host)",
+		"select topk(1, max(r), dc) from (select rate(count) as r from http group by host) group by dc",
+		"select max(r) from (select rate(count) as r from http group by host) where dc='a'",
+		"select max(r) from (explain select rate(count) as r from http group by host)",
+		"select max(r) from (select rate(count) as r from http where time>now()-1h and time<now()-2h)",
+	} {
+		_, err = Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
 func TestEmptyCondition(t *testing.T) {
 	sql := "select f from cpu"
 	q, err := Parse(sql)
@@ -23,11 +23,18 @@ import (
 	"github.com/lindb/lindb/sql/stmt"
 )
 
-// requestStmtParser represents show reuqests/request statement parser.
+// requestStmtParser represents show reuqests/request and kill query statement parser.
 type requestStmtParser struct {
 	request *stmt.Request
 }
 
+// newKillRequestStmtParse creates a kill query statement parser.
+func newKillRequestStmtParse() *requestStmtParser {
+	return &requestStmtParser{
+		request: &stmt.Request{Type: stmt.RequestOpKill},
+	}
+}
+
 // newRequestStmtParse creates a show requests/request statement parser.
 func newRequestStmtParse() *requestStmtParser {
 	return &requestStmtParser{
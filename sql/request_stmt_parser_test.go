@@ -33,4 +33,8 @@ func TestRequestStmt(t *testing.T) {
 	q, err = Parse("show request where id='xxx'")
 	assert.NoError(t, err)
 	assert.Equal(t, &stmt.Request{RequestID: "xxx"}, q)
+
+	q, err = Parse("kill query 'xxx'")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Request{Type: stmt.RequestOpKill, RequestID: "xxx"}, q)
 }
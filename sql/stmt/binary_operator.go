@@ -29,6 +29,13 @@ const (
 	MUL
 	DIV
 
+	EQUAL
+	NOTEQUAL
+	LESS
+	LESSEQUAL
+	GREATER
+	GREATEREQUAL
+
 	UNKNOWN
 )
 
@@ -47,6 +54,18 @@ func BinaryOPString(op BinaryOP) string {
 		return "*"
 	case DIV:
 		return "/"
+	case EQUAL:
+		return "="
+	case NOTEQUAL:
+		return "!="
+	case LESS:
+		return "<"
+	case LESSEQUAL:
+		return "<="
+	case GREATER:
+		return ">"
+	case GREATEREQUAL:
+		return ">="
 	default:
 		return "unknown"
 	}
@@ -0,0 +1,41 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+import "github.com/lindb/lindb/pkg/timeutil"
+
+type DeleteType int
+
+const (
+	DeleteSeries DeleteType = iota + 1
+	DropMetric
+)
+
+// Delete represents delete series/drop metric statement.
+type Delete struct {
+	Type       DeleteType
+	Namespace  string
+	MetricName string
+	Condition  Expr               // tag filter condition expression, required when delete series
+	TimeRange  timeutil.TimeRange // time range of deleted data, end time 0 means the time of deletion created
+}
+
+// StatementType returns delete type.
+func (q *Delete) StatementType() StatementType {
+	return DeleteStatement
+}
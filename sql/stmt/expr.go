@@ -228,6 +228,27 @@ func (e *RegexExpr) Rewrite() string {
 	return fmt.Sprintf("%s=~%s", e.Key, e.Regexp)
 }
 
+// WalkFieldExpr calls fn for each field expr of given expr(select item/order by/call/paren/binary expr).
+func WalkFieldExpr(expr Expr, fn func(e *FieldExpr)) {
+	switch e := expr.(type) {
+	case *FieldExpr:
+		fn(e)
+	case *SelectItem:
+		WalkFieldExpr(e.Expr, fn)
+	case *OrderByExpr:
+		WalkFieldExpr(e.Expr, fn)
+	case *CallExpr:
+		for _, param := range e.Params {
+			WalkFieldExpr(param, fn)
+		}
+	case *ParenExpr:
+		WalkFieldExpr(e.Expr, fn)
+	case *BinaryExpr:
+		WalkFieldExpr(e.Left, fn)
+		WalkFieldExpr(e.Right, fn)
+	}
+}
+
 // Marshal returns json of expr using custom json marshal
 func Marshal(expr Expr) []byte {
 	switch e := expr.(type) {
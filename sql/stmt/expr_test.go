@@ -67,6 +67,23 @@ func TestExpr_Rewrite(t *testing.T) {
 	assert.Equal(t, "max(f) asc", (&OrderByExpr{Expr: &CallExpr{FuncType: function.Max, Params: []Expr{&FieldExpr{Name: "f"}}}}).Rewrite())
 }
 
+func TestWalkFieldExpr(t *testing.T) {
+	var names []string
+	WalkFieldExpr(&SelectItem{Expr: &BinaryExpr{
+		Left: &CallExpr{FuncType: function.Sum, Params: []Expr{&FieldExpr{Name: "a"}}},
+		Right: &ParenExpr{Expr: &BinaryExpr{
+			Left: &FieldExpr{Name: "b"}, Operator: ADD, Right: &NumberLiteral{Val: 1},
+		}},
+		Operator: DIV,
+	}}, func(e *FieldExpr) {
+		names = append(names, e.Name)
+	})
+	WalkFieldExpr(&OrderByExpr{Expr: &FieldExpr{Name: "c"}}, func(e *FieldExpr) {
+		names = append(names, e.Name)
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+}
+
 func TestTagFilter(t *testing.T) {
 	assert.Equal(t, "tagKey", (&EqualsExpr{Key: "tagKey", Value: "tagValue"}).TagKey())
 	assert.Equal(t, "tagKey", (&LikeExpr{Key: "tagKey", Value: "tagValue"}).TagKey())
@@ -0,0 +1,47 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// FillType represents the fill policy for empty down sampling slots.
+type FillType uint8
+
+// Defines all fill policies of metric query.
+const (
+	// FillNone represents no fill clause, keep empty slots.
+	FillNone FillType = iota
+	// FillNull represents fill(null), keep empty slots.
+	FillNull
+	// FillPrevious represents fill(previous), fill empty slot with previous value.
+	FillPrevious
+	// FillValue represents fill(value), fill empty slot with constant value.
+	FillValue
+)
+
+// String returns the string value of fill type.
+func (f FillType) String() string {
+	switch f {
+	case FillNull:
+		return "null"
+	case FillPrevious:
+		return "previous"
+	case FillValue:
+		return "value"
+	default:
+		return "none"
+	}
+}
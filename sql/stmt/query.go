@@ -19,18 +19,35 @@ package stmt
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
 
+// MetricSource represents the metric which cross-metric query selects from.
+type MetricSource struct {
+	MetricName string `json:"metricName"`
+	Alias      string `json:"alias,omitempty"`
+}
+
+// Name returns the name which select items reference metric's fields with, like name.field.
+func (s *MetricSource) Name() string {
+	if s.Alias != "" {
+		return s.Alias
+	}
+	return s.MetricName
+}
+
 // Query represents search statement
 type Query struct {
-	Explain     bool   // need explain query execute stat
-	Namespace   string // namespace
-	MetricName  string // like table name
-	SelectItems []Expr // select list, such as field, function call, math expression etc.
-	Condition   Expr   // tag filter condition expression
+	Explain     bool            // need explain query execute stat
+	Namespace   string          // namespace
+	MetricName  string          // like table name
+	Sources     []*MetricSource // metrics of cross-metric query, empty if query selects from one metric
+	SelectItems []Expr          // select list, such as field, function call, math expression etc.
+	Condition   Expr            // tag filter condition expression
+	SubQuery    *Query          // inner query which outer query aggregates its result set, nil if selects from metric
 
 	// broker plan maybe reset
 	TimeRange       timeutil.TimeRange // query time range
@@ -39,6 +56,10 @@ type Query struct {
 	StorageInterval timeutil.Interval  // down sampling storage interval, data find
 
 	GroupBy      []string // group by tag keys
+	Fill         FillType // fill policy for empty down sampling slots
+	FillValue    float64  // fill value if fill policy is FillValue
+	Having       Expr     // having condition expression, filters grouped time series
+	Others       string   // label of series which rolls up the remainder of topk/bottomk
 	OrderByItems []Expr   // order by field expr list
 	Limit        int      // num. of time series list for result
 }
@@ -48,6 +69,32 @@ func (q *Query) StatementType() StatementType {
 	return QueryStatement
 }
 
+// IsJoin returns whether query selects from multiple metrics.
+func (q *Query) IsJoin() bool {
+	return len(q.Sources) > 1
+}
+
+// ResolveSource returns the source which qualified field name(source.field) references and the field name,
+// if multiple sources match, the longest source name wins.
+func (q *Query) ResolveSource(fieldName string) (source *MetricSource, name string, ok bool) {
+	for _, s := range q.Sources {
+		prefix := s.Name() + "."
+		if len(fieldName) > len(prefix) && strings.HasPrefix(fieldName, prefix) &&
+			(source == nil || len(s.Name()) > len(source.Name())) {
+			source = s
+		}
+	}
+	if source == nil {
+		return nil, "", false
+	}
+	return source, fieldName[len(source.Name())+1:], true
+}
+
+// IsSubQuery returns whether query selects from the result set of inner query.
+func (q *Query) IsSubQuery() bool {
+	return q.SubQuery != nil
+}
+
 // HasGroupBy returns whether query has grouping tag keys
 func (q *Query) HasGroupBy() bool {
 	return len(q.GroupBy) > 0
@@ -58,8 +105,10 @@ type innerQuery struct {
 	Explain     bool              `json:"Explain,omitempty"`
 	Namespace   string            `json:"namespace,omitempty"`
 	MetricName  string            `json:"metricName,omitempty"`
+	Sources     []*MetricSource   `json:"sources,omitempty"`
 	SelectItems []json.RawMessage `json:"selectItems,omitempty"`
 	Condition   json.RawMessage   `json:"condition,omitempty"`
+	SubQuery    *Query            `json:"subQuery,omitempty"`
 
 	TimeRange       timeutil.TimeRange `json:"timeRange,omitempty"`
 	Interval        timeutil.Interval  `json:"interval,omitempty"`
@@ -67,6 +116,10 @@ type innerQuery struct {
 	StorageInterval timeutil.Interval  `json:"storageInterval,omitempty"`
 
 	GroupBy      []string          `json:"groupBy,omitempty"`
+	Fill         FillType          `json:"fill,omitempty"`
+	FillValue    float64           `json:"fillValue,omitempty"`
+	Having       json.RawMessage   `json:"having,omitempty"`
+	Others       string            `json:"others,omitempty"`
 	OrderByItems []json.RawMessage `json:"orderByItems,omitempty"`
 	Limit        int               `json:"limit,omitempty"`
 }
@@ -77,12 +130,18 @@ func (q *Query) MarshalJSON() ([]byte, error) {
 		Explain:         q.Explain,
 		MetricName:      q.MetricName,
 		Namespace:       q.Namespace,
+		Sources:         q.Sources,
 		Condition:       Marshal(q.Condition),
+		SubQuery:        q.SubQuery,
 		TimeRange:       q.TimeRange,
 		Interval:        q.Interval,
 		IntervalRatio:   q.IntervalRatio,
 		StorageInterval: q.StorageInterval,
 		GroupBy:         q.GroupBy,
+		Fill:            q.Fill,
+		FillValue:       q.FillValue,
+		Having:          Marshal(q.Having),
+		Others:          q.Others,
 		Limit:           q.Limit,
 	}
 	for _, item := range q.SelectItems {
@@ -107,6 +166,13 @@ func (q *Query) UnmarshalJSON(value []byte) error {
 		}
 		q.Condition = condition
 	}
+	if inner.Having != nil {
+		having, err := Unmarshal(inner.Having)
+		if err != nil {
+			return err
+		}
+		q.Having = having
+	}
 	// select list
 	var selectItems []Expr
 	for _, item := range inner.SelectItems {
@@ -129,12 +195,17 @@ func (q *Query) UnmarshalJSON(value []byte) error {
 	q.Explain = inner.Explain
 	q.MetricName = inner.MetricName
 	q.Namespace = inner.Namespace
+	q.Sources = inner.Sources
+	q.SubQuery = inner.SubQuery
 	q.SelectItems = selectItems
 	q.TimeRange = inner.TimeRange
 	q.Interval = inner.Interval
 	q.IntervalRatio = inner.IntervalRatio
 	q.StorageInterval = inner.StorageInterval
 	q.GroupBy = inner.GroupBy
+	q.Fill = inner.Fill
+	q.FillValue = inner.FillValue
+	q.Others = inner.Others
 	q.OrderByItems = orderByItems
 	q.Limit = inner.Limit
 	return nil
@@ -65,6 +65,21 @@ func TestQuery_Marshal(t *testing.T) {
 		TimeRange: timeutil.TimeRange{Start: 10, End: 30},
 		Interval:  1000,
 		GroupBy:   []string{"a", "b", "c"},
+		Fill:      FillValue,
+		FillValue: 1.5,
+		Having: &BinaryExpr{
+			Left: &CallExpr{
+				FuncType: function.Max,
+				Params:   []Expr{&FieldExpr{Name: "c"}},
+			},
+			Operator: GREATER,
+			Right:    &NumberLiteral{Val: 10},
+		},
+		Others: "others",
+		Sources: []*MetricSource{
+			{MetricName: "errors", Alias: "e"},
+			{MetricName: "requests"},
+		},
 		OrderByItems: []Expr{
 			&FieldExpr{Name: "b"},
 			&CallExpr{
@@ -81,6 +96,7 @@ func TestQuery_Marshal(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, query, query1)
 	assert.True(t, query.HasGroupBy())
+	assert.True(t, query.IsJoin())
 }
 
 func TestQuery_Marshal_Fail(t *testing.T) {
@@ -93,8 +109,69 @@ func TestQuery_Marshal_Fail(t *testing.T) {
 	assert.Error(t, err)
 	err = query.UnmarshalJSON([]byte("{\"orderByItems\":[\"123\"]}"))
 	assert.Error(t, err)
+	err = query.UnmarshalJSON([]byte("{\"having\":\"123\"}"))
+	assert.Error(t, err)
 }
 
 func TestQuery_StatementType(t *testing.T) {
 	assert.Equal(t, QueryStatement, (&Query{}).StatementType())
 }
+
+func TestQuery_ResolveSource(t *testing.T) {
+	query := &Query{
+		Sources: []*MetricSource{
+			{MetricName: "http"},
+			{MetricName: "http.errors"},
+			{MetricName: "requests", Alias: "r"},
+		},
+	}
+	source, name, ok := query.ResolveSource("http.errors.count")
+	assert.True(t, ok)
+	assert.Equal(t, "http.errors", source.MetricName)
+	assert.Equal(t, "count", name)
+	source, name, ok = query.ResolveSource("http.count")
+	assert.True(t, ok)
+	assert.Equal(t, "http", source.Name())
+	assert.Equal(t, "count", name)
+	source, name, ok = query.ResolveSource("r.count")
+	assert.True(t, ok)
+	assert.Equal(t, "requests", source.MetricName)
+	assert.Equal(t, "count", name)
+	_, _, ok = query.ResolveSource("requests.count")
+	assert.False(t, ok)
+	_, _, ok = query.ResolveSource("r.")
+	assert.False(t, ok)
+	assert.False(t, (&Query{}).IsJoin())
+}
+
+func TestQuery_SubQuery_Marshal(t *testing.T) {
+	query := Query{
+		Namespace: "ns",
+		SelectItems: []Expr{
+			&SelectItem{Expr: &CallExpr{FuncType: function.Max, Params: []Expr{&FieldExpr{Name: "r"}}}},
+		},
+		SubQuery: &Query{
+			Namespace:  "ns",
+			MetricName: "http",
+			SelectItems: []Expr{
+				&SelectItem{
+					Expr:  &CallExpr{FuncType: function.Rate, Params: []Expr{&FieldExpr{Name: "count"}}},
+					Alias: "r",
+				},
+			},
+			TimeRange: timeutil.TimeRange{Start: 10, End: 30},
+			GroupBy:   []string{"host"},
+			Limit:     100,
+		},
+		TimeRange: timeutil.TimeRange{Start: 10, End: 30},
+		GroupBy:   []string{"dc"},
+		Limit:     20,
+	}
+	data := encoding.JSONMarshal(&query)
+	query1 := Query{}
+	err := encoding.JSONUnmarshal(data, &query1)
+	assert.NoError(t, err)
+	assert.Equal(t, query, query1)
+	assert.True(t, query1.IsSubQuery())
+	assert.False(t, query1.SubQuery.IsSubQuery())
+}
@@ -17,8 +17,19 @@
 
 package stmt
 
-// Request represents show request statement.
+// RequestOpType represents request related operation.
+type RequestOpType int
+
+const (
+	// RequestOpShow represents show requests/request.
+	RequestOpShow RequestOpType = iota
+	// RequestOpKill represents kill running query request.
+	RequestOpKill
+)
+
+// Request represents show/kill request statement.
 type Request struct {
+	Type      RequestOpType
 	RequestID string
 }
 
@@ -39,6 +39,10 @@ const (
 	StorageMetric
 	// MemoryDatabase represents show memory database statement.
 	MemoryDatabase
+	// Rebalance represents show shard rebalance(replica migration) statement.
+	Rebalance
+	// ReplicaConsistency represents show replica consistency(anti-entropy check result) statement.
+	ReplicaConsistency
 )
 
 // State represents show state statement.
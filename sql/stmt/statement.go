@@ -30,6 +30,8 @@ const (
 	RequestStatement
 	BrokerStatement
 	LimitStatement
+	DeleteStatement
+	UserStatement
 )
 
 // Statement represents LinDB query language statement
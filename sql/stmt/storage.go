@@ -31,12 +31,19 @@ const (
 	StorageOpDelete
 	// StorageOpRecover represents recover storage metadata.
 	StorageOpRecover
+	// StorageOpDecommission represents decommission storage node.
+	StorageOpDecommission
+	// StorageOpTransferLeader represents transfer leader of database's shard.
+	StorageOpTransferLeader
 )
 
 // Storage represent storage statement.
 type Storage struct {
-	Type  StorageOpType
-	Value string
+	Type     StorageOpType
+	Value    string
+	NodeID   int64  // storage node id for decommission, target node id for transfer leader(-1 means preferred replica)
+	Database string // database name for transfer leader
+	ShardID  int64  // shard id for transfer leader
 }
 
 // StatementType returns storage query type.
@@ -0,0 +1,59 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// UserOpType represents user/role related operation.
+type UserOpType int
+
+const (
+	// UserOpUnknown represents unknown operation.
+	UserOpUnknown UserOpType = iota
+	// UserOpCreate represents create user.
+	UserOpCreate
+	// UserOpDrop represents drop user.
+	UserOpDrop
+	// UserOpShow represents show users.
+	UserOpShow
+	// RoleOpDrop represents drop role.
+	RoleOpDrop
+	// RoleOpShow represents show roles.
+	RoleOpShow
+	// RoleOpGrant represents grant privilege on database to role.
+	RoleOpGrant
+	// RoleOpRevoke represents revoke privilege on database from role.
+	RoleOpRevoke
+	// UserOpGrantRole represents grant role to user.
+	UserOpGrantRole
+	// UserOpRevokeRole represents revoke role from user.
+	UserOpRevokeRole
+)
+
+// User represents user/role management statement.
+type User struct {
+	Type      UserOpType
+	UserName  string
+	Password  string
+	RoleName  string
+	Database  string // database name for grant/revoke privilege, '*' means all databases
+	Privilege string // read/write/admin
+}
+
+// StatementType returns user query type.
+func (q *User) StatementType() StatementType {
+	return UserStatement
+}
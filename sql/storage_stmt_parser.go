@@ -18,6 +18,8 @@
 package sql
 
 import (
+	"strconv"
+
 	"github.com/lindb/lindb/pkg/strutil"
 	"github.com/lindb/lindb/sql/grammar"
 	"github.com/lindb/lindb/sql/stmt"
@@ -26,12 +28,19 @@ import (
 // storageStmtParser represents storage statement parser.
 type storageStmtParser struct {
 	storage *stmt.Storage
+
+	err error
 }
 
 // newStorageStmtParse creates a storage statement parser.
 func newStorageStmtParse(opType stmt.StorageOpType) *storageStmtParser {
+	storage := &stmt.Storage{Type: opType}
+	if opType == stmt.StorageOpTransferLeader {
+		// transfer leader to the preferred replica if target node not set
+		storage.NodeID = -1
+	}
 	return &storageStmtParser{
-		storage: &stmt.Storage{Type: opType},
+		storage: storage,
 	}
 }
 
@@ -40,6 +49,36 @@ func (s *storageStmtParser) visitStorageName(ctx *grammar.StorageNameContext) {
 	s.storage.Value = strutil.GetStringValue(ctx.GetText())
 }
 
+// visitStorageFilter visits when production storage filter expression is entered.
+func (s *storageStmtParser) visitStorageFilter(ctx *grammar.StorageFilterContext) {
+	s.storage.Value = strutil.GetStringValue(ctx.Ident().GetText())
+}
+
+// visitNodeID visits when production storage node id expression is entered.
+func (s *storageStmtParser) visitNodeID(ctx *grammar.NodeIDContext) {
+	nodeID, err := strconv.ParseInt(ctx.L_INT().GetText(), 10, 64)
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.storage.NodeID = nodeID
+}
+
+// visitDatabaseName visits when production database name expression is entered.
+func (s *storageStmtParser) visitDatabaseName(ctx *grammar.DatabaseNameContext) {
+	s.storage.Database = strutil.GetStringValue(ctx.GetText())
+}
+
+// visitShardID visits when production shard id expression is entered.
+func (s *storageStmtParser) visitShardID(ctx *grammar.ShardIDContext) {
+	shardID, err := strconv.ParseInt(ctx.L_INT().GetText(), 10, 32)
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.storage.ShardID = shardID
+}
+
 // visitName visits when production storage config expression is entered.
 func (s *storageStmtParser) visitCfg(ctx *grammar.JsonContext) {
 	s.storage.Value = ctx.GetText()
@@ -47,5 +86,8 @@ func (s *storageStmtParser) visitCfg(ctx *grammar.JsonContext) {
 
 // build returns the state statement.
 func (s *storageStmtParser) build() (stmt.Statement, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
 	return s.storage, nil
 }
@@ -51,3 +51,46 @@ func TestRecoverStorage(t *testing.T) {
 		Value: "test",
 	}, q)
 }
+
+func TestDecommissionStorageNode(t *testing.T) {
+	q, err := Parse(`decommission storage node 2`)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Storage{
+		Type:   stmt.StorageOpDecommission,
+		NodeID: 2,
+	}, q)
+	q, err = Parse(`decommission storage node 2 where storage='/lindb-storage'`)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Storage{
+		Type:   stmt.StorageOpDecommission,
+		Value:  "/lindb-storage",
+		NodeID: 2,
+	}, q)
+	_, err = Parse(`decommission storage node 99999999999999999999`)
+	assert.Error(t, err)
+	_, err = Parse(`decommission storage node abc`)
+	assert.Error(t, err)
+}
+
+func TestTransferLeader(t *testing.T) {
+	q, err := Parse(`transfer leader on test shard 1`)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Storage{
+		Type:     stmt.StorageOpTransferLeader,
+		Database: "test",
+		ShardID:  1,
+		NodeID:   -1,
+	}, q)
+	q, err = Parse(`transfer leader on 'test' shard 1 to node 2`)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Storage{
+		Type:     stmt.StorageOpTransferLeader,
+		Database: "test",
+		ShardID:  1,
+		NodeID:   2,
+	}, q)
+	_, err = Parse(`transfer leader on test shard 99999999999`)
+	assert.Error(t, err)
+	_, err = Parse(`transfer leader on test`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,68 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"strings"
+
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// userStmtParser represents user/role statement parser.
+type userStmtParser struct {
+	user *stmt.User
+}
+
+// newUserStmtParse creates a user/role statement parser.
+func newUserStmtParse(opType stmt.UserOpType) *userStmtParser {
+	return &userStmtParser{
+		user: &stmt.User{Type: opType},
+	}
+}
+
+// visitUserName visits when production user name expression is entered.
+func (s *userStmtParser) visitUserName(ctx *grammar.UserNameContext) {
+	s.user.UserName = strutil.GetStringValue(ctx.GetText())
+}
+
+// visitRoleName visits when production role name expression is entered.
+func (s *userStmtParser) visitRoleName(ctx *grammar.RoleNameContext) {
+	s.user.RoleName = strutil.GetStringValue(ctx.GetText())
+}
+
+// visitPassword visits when production password expression is entered.
+func (s *userStmtParser) visitPassword(ctx *grammar.PasswordContext) {
+	s.user.Password = strutil.GetStringValue(ctx.GetText())
+}
+
+// visitDatabaseName visits when production database name expression is entered.
+func (s *userStmtParser) visitDatabaseName(ctx *grammar.DatabaseNameContext) {
+	s.user.Database = strutil.GetStringValue(ctx.GetText())
+}
+
+// visitPrivilege visits when production privilege expression is entered.
+func (s *userStmtParser) visitPrivilege(ctx *grammar.PrivilegeContext) {
+	s.user.Privilege = strings.ToLower(ctx.GetText())
+}
+
+// build returns the user statement.
+func (s *userStmtParser) build() (stmt.Statement, error) {
+	return s.user, nil
+}
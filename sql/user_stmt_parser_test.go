@@ -0,0 +1,84 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestUserStmt(t *testing.T) {
+	cases := []struct {
+		sql  string
+		stmt *stmt.User
+	}{
+		{
+			sql:  "show users",
+			stmt: &stmt.User{Type: stmt.UserOpShow},
+		},
+		{
+			sql:  "show roles",
+			stmt: &stmt.User{Type: stmt.RoleOpShow},
+		},
+		{
+			sql:  "create user bob with password 'pwd 123'",
+			stmt: &stmt.User{Type: stmt.UserOpCreate, UserName: "bob", Password: "pwd 123"},
+		},
+		{
+			sql:  "drop user bob",
+			stmt: &stmt.User{Type: stmt.UserOpDrop, UserName: "bob"},
+		},
+		{
+			sql:  "drop role ops",
+			stmt: &stmt.User{Type: stmt.RoleOpDrop, RoleName: "ops"},
+		},
+		{
+			sql:  "grant READ on database test to ops",
+			stmt: &stmt.User{Type: stmt.RoleOpGrant, RoleName: "ops", Database: "test", Privilege: "read"},
+		},
+		{
+			sql:  "grant admin on database '*' to admins",
+			stmt: &stmt.User{Type: stmt.RoleOpGrant, RoleName: "admins", Database: "*", Privilege: "admin"},
+		},
+		{
+			sql:  "revoke write on database test from ops",
+			stmt: &stmt.User{Type: stmt.RoleOpRevoke, RoleName: "ops", Database: "test", Privilege: "write"},
+		},
+		{
+			sql:  "grant role ops to user bob",
+			stmt: &stmt.User{Type: stmt.UserOpGrantRole, RoleName: "ops", UserName: "bob"},
+		},
+		{
+			sql:  "revoke role ops from user bob",
+			stmt: &stmt.User{Type: stmt.UserOpRevokeRole, RoleName: "ops", UserName: "bob"},
+		},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, tt.stmt, q, tt.sql)
+	}
+
+	_, err := Parse("grant delete on database test to ops")
+	assert.Error(t, err)
+	_, err = Parse("create user bob")
+	assert.Error(t, err)
+}
@@ -0,0 +1,81 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+// define backup storage structure.
+// directory tree for backup:
+//
+//	backup/manifest.json => backup manifest, includes replica sequence of data families
+//	backup/xx/(path) => database[xx], same as database storage structure
+const backupManifestFile = "manifest.json"
+
+// for testing
+var (
+	readFileFn = os.ReadFile
+	copyDirFn  = fileutil.CopyDir
+)
+
+// backupManifestPath returns the manifest file path of backup.
+func backupManifestPath(path string) string {
+	return filepath.Join(path, backupManifestFile)
+}
+
+// ReadBackupManifest reads the manifest of backup under path.
+func ReadBackupManifest(path string) (*models.BackupManifest, error) {
+	data, err := readFileFn(backupManifestPath(path))
+	if err != nil {
+		return nil, err
+	}
+	manifest := &models.BackupManifest{}
+	if err := encoding.JSONUnmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// RestoreDatabase restores database's data/index/metadata from backup path into storage path,
+// NOTICE: storage node must be stopped, and the database must not exist in storage path.
+func RestoreDatabase(path, databaseName string) error {
+	source := filepath.Join(path, databaseName)
+	if !fileExist(source) {
+		return fmt.Errorf("database: %s not exist in backup: %s", databaseName, path)
+	}
+	target := filepath.Join(config.GlobalStorageConfig().TSDB.Dir, databaseName)
+	if fileExist(target) {
+		return fmt.Errorf("database: %s already exist in storage: %s, need drop it first", databaseName, target)
+	}
+	// copy files, because some files will be rewritten in place(options etc.) after restored.
+	if err := copyDirFn(source, target); err != nil {
+		return err
+	}
+	engineLogger.Info("restore database successfully",
+		logger.String("db", databaseName), logger.String("backup", path), logger.String("path", target))
+	return nil
+}
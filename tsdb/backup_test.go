@@ -0,0 +1,78 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+func TestReadBackupManifest(t *testing.T) {
+	defer func() {
+		readFileFn = os.ReadFile
+	}()
+	dir := t.TempDir()
+	// read file failure
+	manifest, err := ReadBackupManifest(dir)
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	// unmarshal failure
+	readFileFn = func(name string) ([]byte, error) {
+		return []byte("abc"), nil
+	}
+	manifest, err = ReadBackupManifest(dir)
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+}
+
+func TestRestoreDatabase(t *testing.T) {
+	cfg := config.GlobalStorageConfig()
+	dir := cfg.TSDB.Dir
+	defer func() {
+		cfg.TSDB.Dir = dir
+		copyDirFn = fileutil.CopyDir
+	}()
+	backupPath := t.TempDir()
+	cfg.TSDB.Dir = t.TempDir()
+
+	// database not exist in backup
+	assert.Error(t, RestoreDatabase(backupPath, "db"))
+	assert.NoError(t, fileutil.MkDirIfNotExist(filepath.Join(backupPath, "db", metaDir)))
+	assert.NoError(t, os.WriteFile(filepath.Join(backupPath, "db", options), []byte("options"), 0644))
+	// copy failure
+	copyDirFn = func(src, dst string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, RestoreDatabase(backupPath, "db"))
+	copyDirFn = fileutil.CopyDir
+	// restore successfully
+	assert.NoError(t, RestoreDatabase(backupPath, "db"))
+	data, err := os.ReadFile(filepath.Join(cfg.TSDB.Dir, "db", options))
+	assert.NoError(t, err)
+	assert.Equal(t, "options", string(data))
+	assert.True(t, fileutil.Exist(filepath.Join(cfg.TSDB.Dir, "db", metaDir)))
+	// database exist in storage
+	assert.Error(t, RestoreDatabase(backupPath, "db"))
+}
@@ -166,6 +166,8 @@ func newDataFamily(
 
 	f.indicator = fmt.Sprintf("%s/%s/%s", dbName, shardIDStr,
 		timeutil.FormatTimestamp(familyTime, timeutil.DataTimeFormat4))
+	// purge deleted series when doing compaction
+	family.SetTombstone(newFamilyTombstone(shard, familyTime, interval, timeRange))
 
 	// add data family into global family manager
 	GetFamilyManager().AddFamily(f)
@@ -397,6 +399,14 @@ func (f *dataFamily) Filter(executeCtx *flow.ShardExecuteContext) (resultSet []f
 	}
 	resultSet = append(resultSet, memRS...)
 	resultSet = append(resultSet, fileRS...)
+	if len(resultSet) == 0 {
+		return
+	}
+	// hide the series data which deleted in family
+	deletedSeries := f.shard.IndexDatabase().GetDeletedSeries(executeCtx.StorageExecuteCtx.MetricID, f.familyTime, f.interval)
+	if deletedSeries != nil && !deletedSeries.SeriesIDs().IsEmpty() {
+		resultSet = filterDeletedSeries(resultSet, deletedSeries)
+	}
 	return
 }
 
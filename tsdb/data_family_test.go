@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/atomic"
 
@@ -42,6 +43,7 @@ import (
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/metric"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb/indexdb"
 	"github.com/lindb/lindb/tsdb/memdb"
 	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
 )
@@ -63,6 +65,7 @@ func TestDataFamily_BaseTime(t *testing.T) {
 	snapshot.EXPECT().GetCurrent().Return(v)
 	snapshot.EXPECT().Close()
 	family.EXPECT().GetSnapshot().Return(snapshot)
+	family.EXPECT().SetTombstone(gomock.Any())
 	shard := NewMockShard(ctrl)
 	shard.EXPECT().Database().Return(database)
 	shard.EXPECT().ShardID().Return(models.ShardID(1))
@@ -87,6 +90,10 @@ func TestDataFamily_Filter(t *testing.T) {
 	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
 	reader := table.NewMockReader(ctrl)
 	reader.EXPECT().Path().Return("test").AnyTimes()
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	indexDB.EXPECT().GetDeletedSeries(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
 	now := timeutil.Now()
 	cases := []struct {
 		name    string
@@ -123,6 +130,29 @@ func TestDataFamily_Filter(t *testing.T) {
 			wantErr: false,
 			len:     1,
 		},
+		{
+			name: "hide deleted series",
+			prepare: func(f *dataFamily) {
+				memDB := memdb.NewMockMemoryDatabase(ctrl)
+				f.immutableMemDB = memDB
+				rs1 := flow.NewMockFilterResultSet(ctrl)
+				rs1.EXPECT().SeriesIDs().Return(roaring.BitmapOf(1, 2))
+				rs2 := flow.NewMockFilterResultSet(ctrl)
+				rs2.EXPECT().SeriesIDs().Return(roaring.BitmapOf(1))
+				rs2.EXPECT().Close()
+				memDB.EXPECT().Filter(gomock.Any()).Return([]flow.FilterResultSet{rs1, rs2}, nil)
+				snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, nil)
+				indexDB1 := indexdb.NewMockIndexDatabase(ctrl)
+				deletedSeries := indexdb.NewDeletedSeries(359)
+				deletedSeries.Add(timeutil.SlotRange{Start: 0, End: 359}, roaring.BitmapOf(1))
+				indexDB1.EXPECT().GetDeletedSeries(metric.ID(1), gomock.Any(), gomock.Any()).Return(deletedSeries)
+				shard1 := NewMockShard(ctrl)
+				shard1.EXPECT().IndexDatabase().Return(indexDB1)
+				f.shard = shard1
+			},
+			wantErr: false,
+			len:     1,
+		},
 		{
 			name: "get file reader failure",
 			prepare: func(_ *dataFamily) {
@@ -194,6 +224,7 @@ func TestDataFamily_Filter(t *testing.T) {
 				newFilterFunc = metricsdata.NewFilter
 			}()
 			f := &dataFamily{
+				shard:        shard,
 				familyTime:   now,
 				family:       family,
 				lastReadTime: atomic.NewInt64(fasttime.UnixMilliseconds()),
@@ -19,14 +19,20 @@ package tsdb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"path/filepath"
 	"runtime"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/lindb/roaring"
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/kv"
@@ -34,6 +40,10 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb/indexdb"
 	"github.com/lindb/lindb/tsdb/metadb"
 	"github.com/lindb/lindb/tsdb/tblstore/tagkeymeta"
 )
@@ -76,6 +86,12 @@ type Database interface {
 	SetLimits(limits *models.Limits)
 	// GetLimits returns database's limits.
 	GetLimits() *models.Limits
+	// DeleteSeries tombstones the series matched by series deletion(delete series/drop metric) for all shards,
+	// deleted series are hidden from query immediately, then purged when compacting data family.
+	DeleteSeries(deletion *models.SeriesDeletion) error
+	// Backup backups the persisted data/index/metadata of database into target path online,
+	// returns the replica sequence of data families in backup.
+	Backup(path string) (*models.DatabaseBackup, error)
 }
 
 // database implements Database for storing families,
@@ -191,6 +207,125 @@ func (db *database) GetLimits() *models.Limits {
 	return db.limits.Load().(*models.Limits)
 }
 
+// DeleteSeries tombstones the series matched by series deletion(delete series/drop metric) for all shards,
+// deleted series are hidden from query immediately, then purged when compacting data family.
+// NOTICE: deletion is applied once for each shard by deletion id.
+func (db *database) DeleteSeries(deletion *models.SeriesDeletion) error {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	if deletion.IsExpired(models.GetSeriesDeletionExpireTime(db.GetOption().MaxRetention(), timeutil.Now())) {
+		// all deleted data has been dropped by data retention, no need to tombstone(tombstone maybe removed by ttl)
+		return nil
+	}
+	metadataDB := db.metadata.MetadataDatabase()
+	metricID := metric.EmptyMetricID
+	applied := false
+	var pending []Shard
+	for _, entry := range db.shardSet.Entries() {
+		if tombstone, ok := entry.shard.IndexDatabase().GetTombstone(deletion.ID); ok {
+			// use the metric id which resolved when deletion applied
+			metricID = tombstone.MetricID
+			applied = true
+			continue
+		}
+		pending = append(pending, entry.shard)
+	}
+	if !applied {
+		id, err := metadataDB.GetMetricID(deletion.Namespace, deletion.MetricName)
+		if err != nil && !errors.Is(err, constants.ErrNotFound) {
+			return err
+		}
+		metricID = id
+	}
+	condition, err := deletion.GetCondition()
+	if err != nil {
+		return err
+	}
+	for _, shard := range pending {
+		tombstone := &indexdb.Tombstone{
+			ID:        deletion.ID,
+			MetricID:  metricID,
+			TimeRange: deletion.TimeRange,
+			CreatedAt: deletion.CreatedAt,
+			SeriesIDs: roaring.New(),
+		}
+		if metricID != metric.EmptyMetricID {
+			switch deletion.Type {
+			case stmt.DropMetric:
+				// drop all series of metric
+				tombstone.TimeRange = timeutil.TimeRange{Start: 0, End: math.MaxInt64}
+				tombstone.SeriesIDs.AddRange(0, math.MaxUint32+1)
+			default:
+				filter := newSeriesDeletionFilter(deletion.Namespace, deletion.MetricName, db.metadata, shard.IndexDatabase())
+				if tombstone.SeriesIDs, err = filter.findSeriesIDs(condition); err != nil {
+					return err
+				}
+			}
+		}
+		if err := shard.IndexDatabase().AddTombstone(tombstone); err != nil {
+			return err
+		}
+		engineLogger.Info("tombstone deleted series successfully",
+			logger.String("db", db.name), logger.Any("shard", shard.ShardID()),
+			logger.String("metric", deletion.MetricName), logger.Int64("id", deletion.ID),
+			logger.Any("series", tombstone.SeriesIDs.GetCardinality()))
+	}
+	if deletion.Type == stmt.DropMetric && metricID != metric.EmptyMetricID {
+		// drop metric metadata if not dropped, metric maybe written again with new metric id after dropped
+		if current, err := metadataDB.GetMetricID(deletion.Namespace, deletion.MetricName); err == nil && current == metricID {
+			if _, err := metadataDB.DropMetric(deletion.Namespace, deletion.MetricName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Backup backups the persisted data/index/metadata of database into target path online,
+// returns the replica sequence of data families in backup.
+// NOTICE: backup data/index of shards first, then metadata, make sure metadata includes all ids which used by data/index.
+func (db *database) Backup(path string) (*models.DatabaseBackup, error) {
+	// hold lock, prevent creating shard/deleting series when backup
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	backup := &models.DatabaseBackup{Name: db.name}
+	// 1. backup data/index of all shards
+	for _, entry := range db.shardSet.Entries() {
+		shardBackup, err := entry.shard.Backup(filepath.Join(path, shardDir, strconv.Itoa(int(entry.shardID))))
+		if err != nil {
+			return nil, err
+		}
+		backup.Shards = append(backup.Shards, shardBackup)
+	}
+	// 2. flush metadata, make sure metadata which generated before shard backup persisted
+	db.WaitFlushMetaCompleted()
+	if err := db.FlushMeta(); err != nil {
+		return nil, err
+	}
+	// 3. backup tag value metadata, then metric/tag key/field metadata
+	if _, err := db.metaStore.Backup(filepath.Join(path, metaDir, tagValueMetaDir)); err != nil {
+		return nil, err
+	}
+	if err := db.metadata.MetadataDatabase().Backup(filepath.Join(path, metaDir)); err != nil {
+		return nil, err
+	}
+	// 4. backup database config/limits
+	for _, file := range []string{options, limits} {
+		source := filepath.Join(db.dir, file)
+		if !fileExist(source) {
+			continue
+		}
+		if err := copyFile(source, filepath.Join(path, file)); err != nil {
+			return nil, err
+		}
+	}
+	engineLogger.Info("backup database successfully",
+		logger.String("db", db.name), logger.String("path", path))
+	return backup, nil
+}
+
 // Metadata returns the metadata include metric/tag
 func (db *database) Metadata() metadb.Metadata {
 	return db.metadata
@@ -20,14 +20,18 @@ package tsdb
 import (
 	"context"
 	"fmt"
+	"math"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
@@ -35,6 +39,10 @@ import (
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb/indexdb"
 	"github.com/lindb/lindb/tsdb/metadb"
 )
 
@@ -663,3 +671,284 @@ func Benchmark_ShardSet_binarySearch(b *testing.B) {
 		}
 	})
 }
+
+func TestDatabase_DeleteSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	tagMeta := metadb.NewMockTagMetadata(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	metadata.EXPECT().TagMetadata().Return(tagMeta).AnyTimes()
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+	now := timeutil.Now()
+	deleteSeries := models.NewSeriesDeletion(&stmt.Delete{
+		Type:       stmt.DeleteSeries,
+		Namespace:  "ns",
+		MetricName: "cpu",
+		Condition:  &stmt.EqualsExpr{Key: "host", Value: "1.1.1.1"},
+		TimeRange:  timeutil.TimeRange{Start: now - timeutil.OneHour, End: now},
+	})
+	expiredDeletion := models.NewSeriesDeletion(&stmt.Delete{
+		Type:       stmt.DeleteSeries,
+		Namespace:  "ns",
+		MetricName: "cpu",
+		TimeRange:  timeutil.TimeRange{Start: 10, End: 100},
+	})
+	dropMetric := models.NewSeriesDeletion(&stmt.Delete{
+		Type:       stmt.DropMetric,
+		Namespace:  "ns",
+		MetricName: "cpu",
+	})
+
+	cases := []struct {
+		name     string
+		deletion *models.SeriesDeletion
+		prepare  func()
+		wantErr  bool
+	}{
+		{
+			name:     "get metric id failure",
+			deletion: deleteSeries,
+			prepare: func() {
+				indexDB.EXPECT().GetTombstone(gomock.Any()).Return(nil, false)
+				metadataDB.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.EmptyMetricID, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:     "metric not found, add empty tombstone",
+			deletion: deleteSeries,
+			prepare: func() {
+				indexDB.EXPECT().GetTombstone(gomock.Any()).Return(nil, false)
+				metadataDB.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.EmptyMetricID, constants.ErrNotFound)
+				indexDB.EXPECT().AddTombstone(gomock.Any()).DoAndReturn(func(tombstone *indexdb.Tombstone) error {
+					assert.Equal(t, metric.EmptyMetricID, tombstone.MetricID)
+					assert.Equal(t, deleteSeries.CreatedAt, tombstone.CreatedAt)
+					assert.True(t, tombstone.SeriesIDs.IsEmpty())
+					return nil
+				})
+			},
+		},
+		{
+			name:     "find series ids failure",
+			deletion: deleteSeries,
+			prepare: func() {
+				indexDB.EXPECT().GetTombstone(gomock.Any()).Return(nil, false)
+				metadataDB.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(10), nil)
+				metadataDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), gomock.Any()).Return(tag.EmptyTagKeyID, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:     "add tombstone failure",
+			deletion: deleteSeries,
+			prepare: func() {
+				indexDB.EXPECT().GetTombstone(gomock.Any()).Return(nil, false)
+				metadataDB.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(10), nil)
+				metadataDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), gomock.Any()).Return(tag.KeyID(1), nil)
+				tagMeta.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1), nil)
+				indexDB.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
+				indexDB.EXPECT().AddTombstone(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:     "delete series successfully",
+			deletion: deleteSeries,
+			prepare: func() {
+				indexDB.EXPECT().GetTombstone(gomock.Any()).Return(nil, false)
+				metadataDB.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(10), nil)
+				metadataDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), gomock.Any()).Return(tag.KeyID(1), nil)
+				tagMeta.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1), nil)
+				indexDB.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
+				indexDB.EXPECT().AddTombstone(gomock.Any()).DoAndReturn(func(tombstone *indexdb.Tombstone) error {
+					assert.Equal(t, metric.ID(10), tombstone.MetricID)
+					assert.Equal(t, timeutil.TimeRange{Start: now - timeutil.OneHour, End: now}, tombstone.TimeRange)
+					assert.Equal(t, []uint32{1, 2}, tombstone.SeriesIDs.ToArray())
+					return nil
+				})
+			},
+		},
+		{
+			name:     "all deleted data expired",
+			deletion: expiredDeletion,
+		},
+		{
+			name:     "deletion already applied",
+			deletion: deleteSeries,
+			prepare: func() {
+				indexDB.EXPECT().GetTombstone(gomock.Any()).Return(&indexdb.Tombstone{MetricID: 10}, true)
+			},
+		},
+		{
+			name:     "drop metric failure",
+			deletion: dropMetric,
+			prepare: func() {
+				indexDB.EXPECT().GetTombstone(gomock.Any()).Return(&indexdb.Tombstone{MetricID: 10}, true)
+				metadataDB.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(10), nil)
+				metadataDB.EXPECT().DropMetric(gomock.Any(), gomock.Any()).Return(metric.EmptyMetricID, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:     "drop metric successfully",
+			deletion: dropMetric,
+			prepare: func() {
+				indexDB.EXPECT().GetTombstone(gomock.Any()).Return(nil, false)
+				metadataDB.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(10), nil).Times(2)
+				indexDB.EXPECT().AddTombstone(gomock.Any()).DoAndReturn(func(tombstone *indexdb.Tombstone) error {
+					assert.Equal(t, metric.ID(10), tombstone.MetricID)
+					assert.Equal(t, timeutil.TimeRange{Start: 0, End: math.MaxInt64}, tombstone.TimeRange)
+					assert.True(t, tombstone.SeriesIDs.Contains(math.MaxUint32))
+					return nil
+				})
+				metadataDB.EXPECT().DropMetric(gomock.Any(), gomock.Any()).Return(metric.ID(10), nil)
+			},
+		},
+		{
+			name:     "metric written again after dropped",
+			deletion: dropMetric,
+			prepare: func() {
+				indexDB.EXPECT().GetTombstone(gomock.Any()).Return(&indexdb.Tombstone{MetricID: 10}, true)
+				metadataDB.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(11), nil)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			db := &database{
+				name: "test",
+				config: &models.DatabaseConfig{Option: &option.DatabaseOption{
+					Intervals: option.Intervals{{Retention: timeutil.Interval(timeutil.OneDay)}},
+				}},
+				metadata: metadata,
+				shardSet: *newShardSet(),
+			}
+			db.shardSet.InsertShard(models.ShardID(1), shard)
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			err := db.DeleteSeries(tt.deletion)
+			if (err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+		})
+	}
+}
+
+func TestDatabase_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		copyFile = fileutil.CopyFile
+		ctrl.Finish()
+	}()
+
+	dir := t.TempDir()
+	assert.NoError(t, ltoml.WriteConfig(filepath.Join(dir, options), "options"))
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	metaStore := kv.NewMockStore(ctrl)
+	shard := NewMockShard(ctrl)
+	db := &database{
+		name:           "db",
+		dir:            dir,
+		metadata:       metadata,
+		metaStore:      metaStore,
+		shardSet:       *newShardSet(),
+		flushCondition: sync.NewCond(&sync.Mutex{}),
+		isFlushing:     *atomic.NewBool(false),
+		statistics:     metrics.NewDatabaseStatistics("test"),
+	}
+	db.shardSet.InsertShard(1, shard)
+	backupPath := t.TempDir()
+	shardBackup := &models.ShardBackup{ShardID: 1}
+	shardPath := filepath.Join(backupPath, shardDir, "1")
+	tagValuePath := filepath.Join(backupPath, metaDir, tagValueMetaDir)
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "backup shard failure",
+			prepare: func() {
+				shard.EXPECT().Backup(shardPath).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "flush metadata failure",
+			prepare: func() {
+				shard.EXPECT().Backup(shardPath).Return(shardBackup, nil)
+				metadata.EXPECT().Flush().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "backup tag value store failure",
+			prepare: func() {
+				shard.EXPECT().Backup(shardPath).Return(shardBackup, nil)
+				metadata.EXPECT().Flush().Return(nil)
+				metaStore.EXPECT().Backup(tagValuePath).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "backup metadata database failure",
+			prepare: func() {
+				shard.EXPECT().Backup(shardPath).Return(shardBackup, nil)
+				metadata.EXPECT().Flush().Return(nil)
+				metaStore.EXPECT().Backup(tagValuePath).Return(nil, nil)
+				metadataDB.EXPECT().Backup(filepath.Join(backupPath, metaDir)).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "copy options failure",
+			prepare: func() {
+				shard.EXPECT().Backup(shardPath).Return(shardBackup, nil)
+				metadata.EXPECT().Flush().Return(nil)
+				metaStore.EXPECT().Backup(tagValuePath).Return(nil, nil)
+				metadataDB.EXPECT().Backup(filepath.Join(backupPath, metaDir)).Return(nil)
+				copyFile = func(src, dst string) error {
+					return fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "backup successfully",
+			prepare: func() {
+				shard.EXPECT().Backup(shardPath).Return(shardBackup, nil)
+				metadata.EXPECT().Flush().Return(nil)
+				metaStore.EXPECT().Backup(tagValuePath).Return(nil, nil)
+				metadataDB.EXPECT().Backup(filepath.Join(backupPath, metaDir)).Return(nil)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				copyFile = fileutil.CopyFile
+			}()
+			tt.prepare()
+			backup, err := db.Backup(backupPath)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Backup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.Equal(t, &models.DatabaseBackup{Name: "db", Shards: []*models.ShardBackup{shardBackup}}, backup)
+				assert.True(t, fileutil.Exist(filepath.Join(backupPath, options)))
+				assert.False(t, fileutil.Exist(filepath.Join(backupPath, limits)))
+			}
+		})
+	}
+}
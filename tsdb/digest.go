@@ -0,0 +1,199 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+)
+
+// digestPrecision is the significant digits of point value in digest,
+// because float sum depends on the merge order of files, low digits of same data may be different between replicas.
+const digestPrecision = 12
+
+// digestPoint represents the point of series field in a slot.
+type digestPoint struct {
+	seriesID uint32
+	fieldID  field.ID
+	slot     uint16
+}
+
+// DigestFamily computes the digest of persisted data in data family by metric.
+// NOTICE: metric/field/tag/series ids are node-local, so digest is computed based on metric name/tags/field name,
+// the values of same point in different files are merged like compaction, so digest is independent of flush/compaction.
+func (s *shard) DigestFamily(family DataFamily) (*models.FamilyDigest, error) {
+	metrics, err := s.loadExportMetrics()
+	if err != nil {
+		return nil, err
+	}
+	snapshot := family.Family().GetSnapshot()
+	defer snapshot.Close()
+
+	current := snapshot.GetCurrent()
+	files := current.GetAllFiles()
+	// merge files by file number, keeps the order of written data
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].GetFileNumber() < files[j].GetFileNumber()
+	})
+	metricBlocks := make(map[metric.ID][][]byte)
+	for _, file := range files {
+		reader, err := snapshot.GetReader(file.GetFileNumber())
+		if err != nil {
+			return nil, err
+		}
+		it := reader.Iterator()
+		for it.HasNext() {
+			metricID := metric.ID(it.Key())
+			if _, ok := metrics[metricID]; !ok {
+				// metric metadata not exist(dropped), ignore it
+				continue
+			}
+			metricBlocks[metricID] = append(metricBlocks[metricID], it.Value())
+		}
+	}
+	result := &models.FamilyDigest{
+		FamilyTime: family.FamilyTime(),
+		Sequences:  make(map[int32]int64),
+	}
+	for metricID, blocks := range metricBlocks {
+		digest, err := s.digestMetric(family, metricID, metrics[metricID], blocks)
+		if err != nil {
+			return nil, err
+		}
+		if digest != nil {
+			result.Metrics = append(result.Metrics, *digest)
+		}
+	}
+	sort.Slice(result.Metrics, func(i, j int) bool {
+		if result.Metrics[i].Namespace == result.Metrics[j].Namespace {
+			return result.Metrics[i].Name < result.Metrics[j].Name
+		}
+		return result.Metrics[i].Namespace < result.Metrics[j].Namespace
+	})
+	for leader, seq := range current.GetSequences() {
+		result.Sequences[leader] = seq
+	}
+	return result, nil
+}
+
+// digestMetric computes the digest of metric's data blocks, returns nil if metric hasn't data.
+func (s *shard) digestMetric(family DataFamily, metricID metric.ID, m *exportMetric, blocks [][]byte) (*models.MetricDigest, error) {
+	seriesIDs := roaring.New()
+	points := make(map[digestPoint]float64)
+	deletedSeries := s.indexDB.GetDeletedSeries(metricID, family.FamilyTime(), family.Interval())
+	for _, block := range blocks {
+		if err := metricsdata.ScanSeriesData(block, func(data *metricsdata.SeriesData) error {
+			seriesIDs.Add(data.SeriesID)
+			for fieldIdx, f := range data.Fields {
+				fieldMeta, ok := m.fields.GetFromID(f.ID)
+				if !ok || fieldMeta.Type == field.Unknown {
+					continue
+				}
+				aggType := fieldMeta.Type.AggType()
+				for i, value := range data.Values[fieldIdx] {
+					if math.IsNaN(value) {
+						continue
+					}
+					point := digestPoint{seriesID: data.SeriesID, fieldID: f.ID, slot: data.SlotRange.Start + uint16(i)}
+					if deletedSeries != nil && deletedSeries.IsDeleted(point.seriesID, point.slot) {
+						continue
+					}
+					if old, ok := points[point]; ok {
+						value = aggType.Aggregate(old, value)
+					}
+					points[point] = value
+				}
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if deletedSeries != nil {
+		seriesIDs.AndNot(deletedSeries.FullSeriesIDs())
+	}
+	if seriesIDs.IsEmpty() || len(points) == 0 {
+		return nil, nil
+	}
+	tags, err := s.lookupSeriesTags(m, seriesIDs)
+	if err != nil {
+		return nil, err
+	}
+	seriesHashes := make(map[uint32]uint64)
+	for it := seriesIDs.Iterator(); it.HasNext(); {
+		seriesID := it.Next()
+		seriesTags, ok := tags[seriesID]
+		if seriesID != 0 && !ok {
+			// tags of series not found, ignore it
+			continue
+		}
+		sort.Slice(seriesTags, func(i, j int) bool {
+			return string(seriesTags[i].key) < string(seriesTags[j].key)
+		})
+		var buf []byte
+		for _, t := range seriesTags {
+			buf = append(buf, t.key...)
+			buf = append(buf, '=')
+			buf = append(buf, t.value...)
+			buf = append(buf, ',')
+		}
+		seriesHashes[seriesID] = xxhash.Sum64(buf)
+	}
+	familyTime := family.FamilyTime()
+	interval := family.Interval().Int64()
+	digest := &models.MetricDigest{
+		Namespace: string(m.namespace),
+		Name:      string(m.name),
+		Series:    len(seriesHashes),
+	}
+	buf := make([]byte, 0, 64)
+	var scratch [8]byte
+	appendUint64 := func(v uint64) {
+		binary.LittleEndian.PutUint64(scratch[:], v)
+		buf = append(buf, scratch[:]...)
+	}
+	for point, value := range points {
+		seriesHash, ok := seriesHashes[point.seriesID]
+		if !ok {
+			continue
+		}
+		fieldMeta, _ := m.fields.GetFromID(point.fieldID)
+		buf = buf[:0]
+		appendUint64(seriesHash)
+		buf = append(buf, fieldMeta.Name...)
+		appendUint64(uint64(familyTime + int64(point.slot)*interval))
+		if value == 0 {
+			value = 0 // normalize -0
+		}
+		buf = strconv.AppendFloat(buf, value, 'g', digestPrecision, 64)
+		// sum of point hashes, independent of the order of points
+		digest.Digest += xxhash.Sum64(buf)
+		digest.Points++
+	}
+	return digest, nil
+}
@@ -0,0 +1,169 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+)
+
+func TestShard_DigestFamily(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := NewMockDataFamily(ctrl)
+	index := indexdb.NewMockIndexDatabase(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	tagMetadata := metadb.NewMockTagMetadata(ctrl)
+	kvFamily := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	scanner := flow.NewMockGroupingScanner(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	metadata.EXPECT().TagMetadata().Return(tagMetadata).AnyTimes()
+	s := &shard{
+		id:       1,
+		indexDB:  index,
+		metadata: metadata,
+		logger:   logger.GetLogger("TSDB", "Test"),
+	}
+	familyTime := int64(3600 * 1000)
+	family.EXPECT().FamilyTime().Return(familyTime).AnyTimes()
+	family.EXPECT().Interval().Return(timeutil.Interval(10 * timeutil.OneSecond)).AnyTimes()
+	family.EXPECT().TimeRange().Return(timeutil.TimeRange{Start: familyTime, End: familyTime + timeutil.OneHour}).AnyTimes()
+	family.EXPECT().Family().Return(kvFamily).AnyTimes()
+	kvFamily.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	snapshot.EXPECT().Close().AnyTimes()
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+	v.EXPECT().GetSequences().Return(map[int32]int64{1: 100}).AnyTimes()
+
+	// case 1: load metadata failure
+	metadataDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return(nil, fmt.Errorf("err"))
+	_, err := s.DigestFamily(family)
+	assert.Error(t, err)
+	// case 2: get snapshot reader failure
+	metadataDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return(nil, nil)
+	v.EXPECT().GetAllFiles().Return([]*version.FileMeta{version.NewFileMeta(1, 1, 10, 1024)})
+	snapshot.EXPECT().GetReader(table.FileNumber(1)).Return(nil, fmt.Errorf("err"))
+	_, err = s.DigestFamily(family)
+	assert.Error(t, err)
+
+	metadataDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return([]string{"ns"}, nil).AnyTimes()
+	metadataDB.EXPECT().SuggestMetrics("ns", "", math.MaxInt32).Return([]string{"cpu"}, nil).AnyTimes()
+	metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil).AnyTimes()
+	metadataDB.EXPECT().GetAllFields("ns", "cpu").Return(field.Metas{
+		{ID: 2, Type: field.SumField, Name: "f1"},
+		{ID: 10, Type: field.MinField, Name: "f2"},
+	}, nil).AnyTimes()
+	metadataDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(tag.Metas{{ID: 5, Key: "host"}}, nil).AnyTimes()
+	index.EXPECT().GetDeletedSeries(metric.ID(10), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	index.EXPECT().GetGroupingScanners(tag.KeyID(5), gomock.Any()).Return([]flow.GroupingScanner{scanner}, nil).AnyTimes()
+	scanner.EXPECT().GetSeriesAndTagValue(uint16(0)).Return(roaring.BitmapOf(1).GetContainer(0), []uint32{10}).AnyTimes()
+	tagMetadata.EXPECT().CollectTagValues(tag.KeyID(5), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ tag.KeyID, _ *roaring.Bitmap, values map[uint32]string) error {
+			values[10] = "host-1"
+			return nil
+		}).AnyTimes()
+
+	digest := func(files ...[]byte) *models.FamilyDigest {
+		var fileMetas []*version.FileMeta
+		for idx, block := range files {
+			fileNumber := table.FileNumber(idx + 1)
+			fileMetas = append(fileMetas, version.NewFileMeta(fileNumber, 1, 10, 1024))
+			reader := table.NewMockReader(ctrl)
+			it := table.NewMockIterator(ctrl)
+			snapshot.EXPECT().GetReader(fileNumber).Return(reader, nil)
+			reader.EXPECT().Iterator().Return(it)
+			it.EXPECT().HasNext().Return(true)
+			it.EXPECT().Key().Return(uint32(10))
+			it.EXPECT().Value().Return(block)
+			it.EXPECT().HasNext().Return(true)
+			it.EXPECT().Key().Return(uint32(20)) // metric dropped
+			it.EXPECT().HasNext().Return(false)
+		}
+		v.EXPECT().GetAllFiles().Return(fileMetas)
+		d, err0 := s.DigestFamily(family)
+		assert.NoError(t, err0)
+		return d
+	}
+	// case 3: same data written by different flush
+	merged := digest(mockDigestMetricBlock(5, 7, 2, 1))
+	assert.Equal(t, map[int32]int64{1: 100}, merged.Sequences)
+	assert.Len(t, merged.Metrics, 1)
+	assert.Equal(t, "ns", merged.Metrics[0].Namespace)
+	assert.Equal(t, "cpu", merged.Metrics[0].Name)
+	assert.Equal(t, 1, merged.Metrics[0].Series)
+	assert.Equal(t, 6, merged.Metrics[0].Points)
+	split := digest(mockDigestMetricBlock(5, 7, 1, 1), mockDigestMetricBlock(5, 7, 1, 2))
+	assert.Equal(t, merged, split)
+	assert.Nil(t, merged.DivergedMetrics(split))
+	// case 4: data diverged
+	diverged := digest(mockDigestMetricBlock(5, 7, 1, 1))
+	assert.Equal(t, []string{"ns:cpu"}, merged.DivergedMetrics(diverged))
+	// case 5: empty family
+	empty := digest()
+	assert.Empty(t, empty.Metrics)
+	// case 6: same sums split across different file boundaries, 0.1+0.5 != 0.30000000000000004+0.3
+	left := digest(mockDigestMetricBlock(1, 1, 0.1, 1), mockDigestMetricBlock(1, 1, 0.5, 1))
+	right := digest(mockDigestMetricBlock(1, 1, 0.30000000000000004, 1), mockDigestMetricBlock(1, 1, 0.3, 1))
+	assert.Equal(t, left, right)
+	assert.Nil(t, left.DivergedMetrics(right))
+}
+
+// mockDigestMetricBlock builds the metric block of series 1 with sum/min field, value = slot * factor.
+func mockDigestMetricBlock(start, end uint16, sumFactor, minFactor float64) []byte {
+	nopKVFlusher := kv.NewNopFlusher()
+	flusher, _ := metricsdata.NewFlusher(nopKVFlusher)
+	flusher.PrepareMetric(10, field.Metas{
+		{ID: 2, Type: field.SumField},
+		{ID: 10, Type: field.MinField},
+	})
+	for _, factor := range []float64{sumFactor, minFactor} {
+		encoder := encoding.NewTSDEncoder(start)
+		for i := start; i <= end; i++ {
+			encoder.AppendTime(true)
+			encoder.AppendValue(math.Float64bits(float64(i) * factor))
+		}
+		data, _ := encoder.BytesWithoutTime()
+		_ = flusher.FlushField(data)
+	}
+	_ = flusher.FlushSeries(1)
+	_ = flusher.CommitMetric(timeutil.SlotRange{Start: start, End: end})
+	return nopKVFlusher.Bytes()
+}
@@ -20,6 +20,8 @@ package tsdb
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"sort"
 	"sync"
 
 	"github.com/lindb/lindb/config"
@@ -27,6 +29,7 @@ import (
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 //go:generate mockgen -source=./engine.go -destination=./engine_mock.go -package=tsdb
@@ -63,6 +66,9 @@ type Engine interface {
 	TTL()
 	// EvictSegment evicts segment which long term no read operation.
 	EvictSegment()
+	// Backup backups the persisted data of databases into target path online(all databases if not specified),
+	// then writes backup manifest which includes the replica sequence of data families.
+	Backup(path string, databaseNames ...string) (*models.BackupManifest, error)
 	// Close closes the cached time series databases
 	Close()
 }
@@ -247,6 +253,39 @@ func (e *engine) EvictSegment() {
 	}
 }
 
+// Backup backups the persisted data of databases into target path online(all databases if not specified),
+// then writes backup manifest which includes the replica sequence of data families.
+func (e *engine) Backup(path string, databaseNames ...string) (*models.BackupManifest, error) {
+	if fileExist(backupManifestPath(path)) {
+		return nil, fmt.Errorf("backup already exist under path: %s", path)
+	}
+	databases := e.dbSet.Entries()
+	if len(databaseNames) == 0 {
+		for name := range databases {
+			databaseNames = append(databaseNames, name)
+		}
+	}
+	sort.Strings(databaseNames)
+	manifest := &models.BackupManifest{Timestamp: timeutil.Now()}
+	for _, name := range databaseNames {
+		db, ok := databases[name]
+		if !ok {
+			return nil, fmt.Errorf("database: %s not exist", name)
+		}
+		backup, err := db.Backup(filepath.Join(path, name))
+		if err != nil {
+			return nil, err
+		}
+		manifest.Databases = append(manifest.Databases, backup)
+	}
+	if err := writeConfigFn(backupManifestPath(path), string(encoding.JSONMarshal(manifest))); err != nil {
+		return nil, err
+	}
+	engineLogger.Info("backup databases successfully",
+		logger.String("path", path), logger.Any("databases", databaseNames))
+	return manifest, nil
+}
+
 // load the time series engines if exist
 func (e *engine) load() error {
 	databaseNames, err := listDir(config.GlobalStorageConfig().TSDB.Dir)
@@ -445,6 +445,57 @@ func TestEngine_SetDatabaseLimits(t *testing.T) {
 	engine.SetDatabaseLimits("test", models.NewDefaultLimits())
 }
 
+func TestEngine_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		ctrl.Finish()
+		writeConfigFn = ltoml.WriteConfig
+	}()
+
+	db1 := NewMockDatabase(ctrl)
+	db2 := NewMockDatabase(ctrl)
+	engine := &engine{
+		dbSet: *newDatabaseSet(),
+	}
+	engine.dbSet.PutDatabase("db1", db1)
+	engine.dbSet.PutDatabase("db2", db2)
+
+	dir := t.TempDir()
+	// database not exist
+	manifest, err := engine.Backup(dir, "db3")
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	// backup database failure
+	db1.EXPECT().Backup(path.Join(dir, "db1")).Return(nil, fmt.Errorf("err"))
+	manifest, err = engine.Backup(dir, "db1")
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	// write manifest failure
+	writeConfigFn = func(fileName, content string) error {
+		return fmt.Errorf("err")
+	}
+	db1.EXPECT().Backup(path.Join(dir, "db1")).Return(&models.DatabaseBackup{Name: "db1"}, nil)
+	manifest, err = engine.Backup(dir, "db1")
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	writeConfigFn = ltoml.WriteConfig
+	// backup all databases
+	db1.EXPECT().Backup(path.Join(dir, "db1")).Return(&models.DatabaseBackup{Name: "db1"}, nil)
+	db2.EXPECT().Backup(path.Join(dir, "db2")).Return(&models.DatabaseBackup{Name: "db2"}, nil)
+	manifest, err = engine.Backup(dir)
+	assert.NoError(t, err)
+	assert.Len(t, manifest.Databases, 2)
+	assert.Equal(t, "db1", manifest.Databases[0].Name)
+	assert.Equal(t, "db2", manifest.Databases[1].Name)
+	manifest2, err := ReadBackupManifest(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, manifest, manifest2)
+	// backup exist
+	manifest, err = engine.Backup(dir)
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+}
+
 var testDatabaseNames = []string{
 	"_internal", "system", "docker", "network", "java",
 	"runtime", "go", "php", "k8s", "infra", "prometheus",
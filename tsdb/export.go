@@ -0,0 +1,549 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/lindb/common/pkg/fasttime"
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	commonseries "github.com/lindb/common/series"
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+)
+
+// ExportFunc receives the rows block exported from data family,
+// rows block can be unmarshalled by metric.StorageBatchRows.
+type ExportFunc func(familyTime int64, rows []byte) error
+
+// for testing
+var (
+	exportBlockSize      = 256 * 1024
+	waitFlushInterval    = 10 * time.Millisecond
+	newMetricsDataReader = metricsdata.NewReader
+)
+
+// exportMetric represents the metadata of metric for exporting.
+type exportMetric struct {
+	namespace []byte
+	name      []byte
+	fields    field.Metas
+	tagKeys   tag.Metas
+	histogram bool // if metric has histogram fields
+}
+
+// exportTag represents the tag key/value of series.
+type exportTag struct {
+	key, value []byte
+}
+
+// histogramValues represents the values of histogram in a slot.
+type histogramValues struct {
+	bounds               []float64
+	values               []float64
+	min, max, sum, count float64
+	hasBucket            bool
+}
+
+// Export exports the persisted data of writable interval as rows by data family,
+// returns the replica sequence of data families which exported,
+// the data after these sequences need to be replicated by write ahead log.
+// NOTICE: metric/field/tag/series ids are node-local, so data is exported as rows which can be written into any node.
+func (s *shard) Export(fn ExportFunc) ([]*models.FamilyBackup, error) {
+	ahead, _ := s.option.GetAcceptWritableRange()
+	families := s.segment.GetDataFamilies(timeutil.TimeRange{
+		Start: 0,
+		End:   fasttime.UnixMilliseconds() + ahead,
+	})
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].FamilyTime() < families[j].FamilyTime()
+	})
+	// 1. flush memory database of all families, make sure all written data persisted
+	for _, family := range families {
+		if err := FlushDataFamily(family); err != nil {
+			return nil, err
+		}
+	}
+	// 2. export data of each family based on snapshot
+	exporter, release, err := s.newFamilyExporter(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var result []*models.FamilyBackup
+	for _, family := range families {
+		sequences, err := exporter.export(family)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &models.FamilyBackup{
+			FamilyTime: family.FamilyTime(),
+			Sequences:  sequences,
+		})
+	}
+	s.logger.Info("export shard data successfully",
+		logger.String("database", s.db.Name()),
+		logger.Any("shardID", s.id),
+		logger.Int("families", len(result)),
+		logger.Int64("rows", exporter.rows))
+	return result, nil
+}
+
+// ExportFamily exports the persisted data of data family as rows, returns the replica sequence of data family.
+func (s *shard) ExportFamily(family DataFamily, fn ExportFunc) (*models.FamilyBackup, error) {
+	// make sure all written data persisted
+	if err := FlushDataFamily(family); err != nil {
+		return nil, err
+	}
+	exporter, release, err := s.newFamilyExporter(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	sequences, err := exporter.export(family)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("export family data successfully",
+		logger.String("database", s.db.Name()),
+		logger.Any("shardID", s.id),
+		logger.String("family", family.Indicator()),
+		logger.Int64("rows", exporter.rows))
+	return &models.FamilyBackup{
+		FamilyTime: family.FamilyTime(),
+		Sequences:  sequences,
+	}, nil
+}
+
+// newFamilyExporter creates the exporter of data family with all metrics' metadata.
+func (s *shard) newFamilyExporter(fn ExportFunc) (exporter *familyExporter, release func(), err error) {
+	metrics, err := s.loadExportMetrics()
+	if err != nil {
+		return nil, nil, err
+	}
+	builder, releaseBuilder := commonseries.NewRowBuilder()
+	exporter = &familyExporter{
+		shard:   s,
+		metrics: metrics,
+		builder: builder,
+		fn:      fn,
+	}
+	return exporter, func() { releaseBuilder(builder) }, nil
+}
+
+// Import writes the rows block exported by other replica into data family,
+// returns the number of rows written(the row which lookups metadata failure will be dropped).
+func (s *shard) Import(familyTime int64, rowsBlock []byte) (int, error) {
+	family, err := s.GetOrCrateDataFamily(familyTime)
+	if err != nil {
+		return 0, err
+	}
+	// mark data writing, prevent evicting family
+	family.Retain()
+	defer family.Release()
+
+	batchRows := metric.NewStorageBatchRows()
+	batchRows.UnmarshalRows(rowsBlock)
+	if batchRows.Len() == 0 {
+		return 0, nil
+	}
+	rows := batchRows.Rows()
+	if err := s.LookupRowMetricMeta(rows); err != nil {
+		return 0, err
+	}
+	if err := family.WriteRows(rows); err != nil {
+		return 0, err
+	}
+	written := 0
+	for idx := range rows {
+		if rows[idx].Writable {
+			written++
+		}
+	}
+	return written, nil
+}
+
+// loadExportMetrics loads the metadata of all metrics under database.
+func (s *shard) loadExportMetrics() (map[metric.ID]*exportMetric, error) {
+	metadataDB := s.metadata.MetadataDatabase()
+	namespaces, err := metadataDB.SuggestNamespace("", math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	metrics := make(map[metric.ID]*exportMetric)
+	for _, ns := range namespaces {
+		metricNames, err := metadataDB.SuggestMetrics(ns, "", math.MaxInt32)
+		if err != nil {
+			return nil, err
+		}
+		for _, metricName := range metricNames {
+			metricID, err := metadataDB.GetMetricID(ns, metricName)
+			if err != nil {
+				// metric maybe dropped, ignore it
+				continue
+			}
+			fields, err := metadataDB.GetAllFields(ns, metricName)
+			if err != nil {
+				return nil, err
+			}
+			tagKeys, err := metadataDB.GetAllTagKeys(ns, metricName)
+			if err != nil {
+				return nil, err
+			}
+			m := &exportMetric{
+				namespace: []byte(ns),
+				name:      []byte(metricName),
+				fields:    fields,
+				tagKeys:   tagKeys,
+			}
+			for _, f := range fields {
+				if f.Type == field.HistogramField {
+					m.histogram = true
+					break
+				}
+			}
+			metrics[metricID] = m
+		}
+	}
+	return metrics, nil
+}
+
+// FlushDataFamily flushes memory database of family, waits flush job completed.
+func FlushDataFamily(family DataFamily) error {
+	waitFlushCompleted := func() {
+		for family.IsFlushing() {
+			time.Sleep(waitFlushInterval)
+		}
+	}
+	// if another flush job is running, flush will be skipped, so wait it completed first.
+	waitFlushCompleted()
+	if err := family.Flush(); err != nil {
+		return err
+	}
+	waitFlushCompleted()
+	return nil
+}
+
+// TruncateDataFamily removes all data of family, flushes memory database first, then removes all files.
+func TruncateDataFamily(family DataFamily) error {
+	if err := FlushDataFamily(family); err != nil {
+		return err
+	}
+	return family.Family().Truncate()
+}
+
+// familyExporter exports the persisted data of data family as rows.
+type familyExporter struct {
+	shard   *shard
+	metrics map[metric.ID]*exportMetric
+	builder *commonseries.RowBuilder
+	fn      ExportFunc
+
+	familyTime int64
+	interval   int64
+	block      []byte
+	histogram  histogramValues
+	rows       int64
+}
+
+// export exports the data of family based on current snapshot, returns the replica sequence of snapshot.
+func (e *familyExporter) export(family DataFamily) (map[int32]int64, error) {
+	snapshot := family.Family().GetSnapshot()
+	defer snapshot.Close()
+
+	e.familyTime = family.FamilyTime()
+	e.interval = family.Interval().Int64()
+	e.block = e.block[:0]
+
+	current := snapshot.GetCurrent()
+	files := current.GetAllFiles()
+	// export files by file number, keeps the order of written data
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].GetFileNumber() < files[j].GetFileNumber()
+	})
+	for _, file := range files {
+		reader, err := snapshot.GetReader(file.GetFileNumber())
+		if err != nil {
+			return nil, err
+		}
+		it := reader.Iterator()
+		for it.HasNext() {
+			if err := e.exportMetric(family, metric.ID(it.Key()), it.Value()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := e.flush(); err != nil {
+		return nil, err
+	}
+	sequences := make(map[int32]int64)
+	for leader, seq := range current.GetSequences() {
+		sequences[leader] = seq
+	}
+	return sequences, nil
+}
+
+// exportMetric exports the data of metric block.
+func (e *familyExporter) exportMetric(family DataFamily, metricID metric.ID, block []byte) error {
+	m, ok := e.metrics[metricID]
+	if !ok {
+		// metric metadata not exist(dropped), ignore it
+		return nil
+	}
+	reader, err := newMetricsDataReader(family.Indicator(), block)
+	if err != nil {
+		return err
+	}
+	seriesIDs := reader.GetSeriesIDs().Clone()
+	deletedSeries := e.shard.indexDB.GetDeletedSeries(metricID, family.FamilyTime(), family.Interval())
+	if deletedSeries != nil {
+		seriesIDs.AndNot(deletedSeries.FullSeriesIDs())
+	}
+	if seriesIDs.IsEmpty() {
+		return nil
+	}
+	tags, err := e.shard.lookupSeriesTags(m, seriesIDs)
+	if err != nil {
+		return err
+	}
+	return metricsdata.ScanSeriesData(block, func(data *metricsdata.SeriesData) error {
+		if !seriesIDs.Contains(data.SeriesID) {
+			return nil
+		}
+		seriesTags, ok := tags[data.SeriesID]
+		if data.SeriesID != 0 && !ok {
+			// tags of series not found, ignore it
+			return nil
+		}
+		return e.exportSeries(m, seriesTags, data, deletedSeries)
+	})
+}
+
+// lookupSeriesTags returns the tags of series ids, series id => tags.
+func (s *shard) lookupSeriesTags(m *exportMetric, seriesIDs *roaring.Bitmap) (map[uint32][]exportTag, error) {
+	result := make(map[uint32][]exportTag)
+	for _, tagKey := range m.tagKeys {
+		scanners, err := s.indexDB.GetGroupingScanners(tagKey.ID, seriesIDs)
+		if err != nil {
+			return nil, err
+		}
+		seriesTagValueIDs := make(map[uint32]uint32)
+		tagValueIDs := roaring.New()
+		highKeys := seriesIDs.GetHighKeys()
+		for _, scanner := range scanners {
+			for _, highKey := range highKeys {
+				container, ids := scanner.GetSeriesAndTagValue(highKey)
+				if container == nil {
+					continue
+				}
+				it := container.PeekableIterator()
+				idx := 0
+				for it.HasNext() {
+					seriesID := uint32(highKey)<<16 | uint32(it.Next())
+					if seriesIDs.Contains(seriesID) {
+						seriesTagValueIDs[seriesID] = ids[idx]
+						tagValueIDs.Add(ids[idx])
+					}
+					idx++
+				}
+			}
+		}
+		tagValues := make(map[uint32]string)
+		if err := s.metadata.TagMetadata().CollectTagValues(tagKey.ID, tagValueIDs, tagValues); err != nil {
+			return nil, err
+		}
+		key := []byte(tagKey.Key)
+		for seriesID, tagValueID := range seriesTagValueIDs {
+			if tagValue, ok := tagValues[tagValueID]; ok {
+				result[seriesID] = append(result[seriesID], exportTag{key: key, value: []byte(tagValue)})
+			}
+		}
+	}
+	return result, nil
+}
+
+// exportSeries builds rows for each slot of series data, skips the deleted slot.
+func (e *familyExporter) exportSeries(
+	m *exportMetric,
+	tags []exportTag,
+	data *metricsdata.SeriesData,
+	deletedSeries *indexdb.DeletedSeries,
+) error {
+	numOfSlots := int(data.SlotRange.End-data.SlotRange.Start) + 1
+	for i := 0; i < numOfSlots; i++ {
+		if deletedSeries != nil && deletedSeries.IsDeleted(data.SeriesID, data.SlotRange.Start+uint16(i)) {
+			continue
+		}
+		e.builder.Reset()
+		e.histogram.reset()
+		hasValue := false
+		for fieldIdx, f := range data.Fields {
+			value := data.Values[fieldIdx][i]
+			if math.IsNaN(value) {
+				continue
+			}
+			fieldMeta, ok := m.fields.GetFromID(f.ID)
+			if !ok {
+				continue
+			}
+			if m.histogram && e.histogram.add(fieldMeta, value) {
+				continue
+			}
+			fieldType := simpleFieldType(fieldMeta.Type)
+			if fieldType == flatMetricsV1.SimpleFieldTypeUnSpecified {
+				continue
+			}
+			if err := e.builder.AddSimpleField([]byte(fieldMeta.Name), fieldType, value); err != nil {
+				return err
+			}
+			hasValue = true
+		}
+		if e.histogram.hasBucket {
+			if err := e.histogram.build(e.builder); err != nil {
+				e.shard.logger.Warn("build histogram field failure when export data, ignore it",
+					logger.String("metric", string(m.name)), logger.Error(err))
+			} else {
+				hasValue = true
+			}
+		}
+		if !hasValue {
+			continue
+		}
+		e.builder.AddNameSpace(m.namespace)
+		e.builder.AddMetricName(m.name)
+		e.builder.AddTimestamp(e.familyTime + int64(int(data.SlotRange.Start)+i)*e.interval)
+		for _, t := range tags {
+			if err := e.builder.AddTag(t.key, t.value); err != nil {
+				return err
+			}
+		}
+		row, err := e.builder.Build()
+		if err != nil {
+			return err
+		}
+		e.block = append(e.block, row...)
+		e.rows++
+		if len(e.block) >= exportBlockSize {
+			if err := e.flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// flush invokes export func with rows block.
+func (e *familyExporter) flush() error {
+	if len(e.block) == 0 {
+		return nil
+	}
+	if err := e.fn(e.familyTime, e.block); err != nil {
+		return err
+	}
+	e.block = e.block[:0]
+	return nil
+}
+
+// reset resets the values of histogram.
+func (h *histogramValues) reset() {
+	h.bounds = h.bounds[:0]
+	h.values = h.values[:0]
+	h.min, h.max, h.sum, h.count = 0, 0, 0, 0
+	h.hasBucket = false
+}
+
+// add adds the value of histogram field, returns false if field isn't a part of histogram.
+func (h *histogramValues) add(f field.Meta, value float64) bool {
+	if f.Type == field.HistogramField {
+		upperBound, err := metric.UpperBound(string(f.Name))
+		if err != nil {
+			return false
+		}
+		h.bounds = append(h.bounds, upperBound)
+		h.values = append(h.values, value)
+		h.hasBucket = true
+		return true
+	}
+	switch f.Name {
+	case histogramSumField:
+		h.sum = value
+	case histogramCountField:
+		h.count = value
+	case histogramMinField:
+		h.min = value
+	case histogramMaxField:
+		h.max = value
+	default:
+		return false
+	}
+	return true
+}
+
+// build adds the compound field of histogram into row builder.
+func (h *histogramValues) build(builder *commonseries.RowBuilder) error {
+	sort.Sort(h)
+	if err := builder.AddCompoundFieldData(h.values, h.bounds); err != nil {
+		return err
+	}
+	return builder.AddCompoundFieldMMSC(h.min, h.max, h.sum, h.count)
+}
+
+func (h *histogramValues) Len() int           { return len(h.bounds) }
+func (h *histogramValues) Less(i, j int) bool { return h.bounds[i] < h.bounds[j] }
+func (h *histogramValues) Swap(i, j int) {
+	h.bounds[i], h.bounds[j] = h.bounds[j], h.bounds[i]
+	h.values[i], h.values[j] = h.values[j], h.values[i]
+}
+
+// define the field names of histogram summary.
+const (
+	histogramSumField   = field.Name("HistogramSum")
+	histogramCountField = field.Name("HistogramCount")
+	histogramMinField   = field.Name("HistogramMin")
+	histogramMaxField   = field.Name("HistogramMax")
+)
+
+// simpleFieldType returns the simple field type of row based on field type.
+func simpleFieldType(fieldType field.Type) flatMetricsV1.SimpleFieldType {
+	switch fieldType {
+	case field.SumField:
+		return flatMetricsV1.SimpleFieldTypeDeltaSum
+	case field.MinField:
+		return flatMetricsV1.SimpleFieldTypeMin
+	case field.MaxField:
+		return flatMetricsV1.SimpleFieldTypeMax
+	case field.LastField:
+		return flatMetricsV1.SimpleFieldTypeLast
+	case field.FirstField:
+		return flatMetricsV1.SimpleFieldTypeFirst
+	default:
+		return flatMetricsV1.SimpleFieldTypeUnSpecified
+	}
+}
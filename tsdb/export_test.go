@@ -0,0 +1,472 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	commonseries "github.com/lindb/common/series"
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+)
+
+func TestShard_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	segment := NewMockIntervalSegment(ctrl)
+	family := NewMockDataFamily(ctrl)
+	index := indexdb.NewMockIndexDatabase(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	tagMetadata := metadb.NewMockTagMetadata(ctrl)
+	kvFamily := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	reader := table.NewMockReader(ctrl)
+	it := table.NewMockIterator(ctrl)
+	scanner := flow.NewMockGroupingScanner(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	metadata.EXPECT().TagMetadata().Return(tagMetadata).AnyTimes()
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+
+	s := &shard{
+		id:       1,
+		db:       db,
+		option:   &option.DatabaseOption{},
+		segment:  segment,
+		indexDB:  index,
+		metadata: metadata,
+		logger:   logger.GetLogger("TSDB", "Test"),
+	}
+	interval := timeutil.Interval(10 * timeutil.OneSecond)
+	familyTime := int64(3600 * 1000)
+	family.EXPECT().FamilyTime().Return(familyTime).AnyTimes()
+	family.EXPECT().Interval().Return(interval).AnyTimes()
+	family.EXPECT().Indicator().Return("test/1/1").AnyTimes()
+	family.EXPECT().TimeRange().Return(timeutil.TimeRange{Start: familyTime, End: familyTime + timeutil.OneHour}).AnyTimes()
+	family.EXPECT().IsFlushing().Return(false).AnyTimes()
+	family.EXPECT().Family().Return(kvFamily).AnyTimes()
+	kvFamily.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	snapshot.EXPECT().Close().AnyTimes()
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+
+	mockMetadata := func() {
+		metadataDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return([]string{"ns"}, nil)
+		metadataDB.EXPECT().SuggestMetrics("ns", "", math.MaxInt32).Return([]string{"cpu", "dropped"}, nil)
+		metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+		metadataDB.EXPECT().GetMetricID("ns", "dropped").Return(metric.ID(0), fmt.Errorf("err"))
+		metadataDB.EXPECT().GetAllFields("ns", "cpu").Return(field.Metas{
+			{ID: 2, Type: field.SumField, Name: "f1"},
+			{ID: 10, Type: field.MinField, Name: "f2"},
+		}, nil)
+		metadataDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(tag.Metas{{ID: 5, Key: "host"}}, nil)
+	}
+	cases := []struct {
+		name    string
+		prepare func()
+		fn      ExportFunc
+		rows    int
+		wantErr bool
+	}{
+		{
+			name: "flush family failure",
+			prepare: func() {
+				segment.EXPECT().GetDataFamilies(gomock.Any()).Return([]DataFamily{family})
+				family.EXPECT().Flush().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "suggest namespace failure",
+			prepare: func() {
+				segment.EXPECT().GetDataFamilies(gomock.Any()).Return([]DataFamily{family})
+				family.EXPECT().Flush().Return(nil)
+				metadataDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "get snapshot reader failure",
+			prepare: func() {
+				segment.EXPECT().GetDataFamilies(gomock.Any()).Return([]DataFamily{family})
+				family.EXPECT().Flush().Return(nil)
+				mockMetadata()
+				v.EXPECT().GetAllFiles().Return([]*version.FileMeta{version.NewFileMeta(1, 1, 10, 1024)})
+				snapshot.EXPECT().GetReader(table.FileNumber(1)).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "export func failure",
+			prepare: func() {
+				segment.EXPECT().GetDataFamilies(gomock.Any()).Return([]DataFamily{family})
+				family.EXPECT().Flush().Return(nil)
+				mockMetadata()
+				v.EXPECT().GetAllFiles().Return([]*version.FileMeta{version.NewFileMeta(1, 1, 10, 1024)})
+				snapshot.EXPECT().GetReader(table.FileNumber(1)).Return(reader, nil)
+				reader.EXPECT().Iterator().Return(it)
+				it.EXPECT().HasNext().Return(true)
+				it.EXPECT().Key().Return(uint32(10))
+				it.EXPECT().Value().Return(mockExportMetricBlock([]uint32{1}, 5, 5))
+				index.EXPECT().GetDeletedSeries(metric.ID(10), gomock.Any(), gomock.Any()).Return(nil)
+				index.EXPECT().GetGroupingScanners(tag.KeyID(5), gomock.Any()).Return([]flow.GroupingScanner{scanner}, nil)
+				scanner.EXPECT().GetSeriesAndTagValue(uint16(0)).Return(roaring.BitmapOf(1).GetContainer(0), []uint32{10})
+				tagMetadata.EXPECT().CollectTagValues(tag.KeyID(5), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ tag.KeyID, _ *roaring.Bitmap, values map[uint32]string) error {
+						values[10] = "host-1"
+						return nil
+					})
+				it.EXPECT().HasNext().Return(false)
+			},
+			fn: func(_ int64, _ []byte) error {
+				return fmt.Errorf("err")
+			},
+			wantErr: true,
+		},
+		{
+			name: "export successfully",
+			prepare: func() {
+				segment.EXPECT().GetDataFamilies(gomock.Any()).Return([]DataFamily{family})
+				family.EXPECT().Flush().Return(nil)
+				mockMetadata()
+				v.EXPECT().GetAllFiles().Return([]*version.FileMeta{version.NewFileMeta(1, 1, 10, 1024)})
+				v.EXPECT().GetSequences().Return(map[int32]int64{1: 100})
+				snapshot.EXPECT().GetReader(table.FileNumber(1)).Return(reader, nil)
+				reader.EXPECT().Iterator().Return(it)
+				it.EXPECT().HasNext().Return(true)
+				it.EXPECT().Key().Return(uint32(10))
+				it.EXPECT().Value().Return(mockExportMetricBlock([]uint32{1, 2, 3}, 5, 7))
+				it.EXPECT().HasNext().Return(true)
+				it.EXPECT().Key().Return(uint32(20)) // metric dropped
+				it.EXPECT().Value().Return([]byte{1, 2, 3})
+				// series 2 deleted, slot 6 of series 3 deleted
+				deletedSeries := indexdb.NewDeletedSeries(359)
+				deletedSeries.Add(timeutil.SlotRange{Start: 0, End: 359}, roaring.BitmapOf(2))
+				deletedSeries.Add(timeutil.SlotRange{Start: 6, End: 6}, roaring.BitmapOf(3))
+				index.EXPECT().GetDeletedSeries(metric.ID(10), gomock.Any(), gomock.Any()).Return(deletedSeries)
+				index.EXPECT().GetGroupingScanners(tag.KeyID(5), gomock.Any()).Return([]flow.GroupingScanner{scanner}, nil)
+				scanner.EXPECT().GetSeriesAndTagValue(uint16(0)).Return(roaring.BitmapOf(1, 3).GetContainer(0), []uint32{10, 30})
+				tagMetadata.EXPECT().CollectTagValues(tag.KeyID(5), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ tag.KeyID, _ *roaring.Bitmap, values map[uint32]string) error {
+						values[10] = "host-1"
+						values[30] = "host-3"
+						return nil
+					})
+				it.EXPECT().HasNext().Return(false)
+			},
+			rows: 5,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			rows := 0
+			var hosts []string
+			fn := func(ft int64, block []byte) error {
+				assert.Equal(t, familyTime, ft)
+				batchRows := metric.NewStorageBatchRows()
+				batchRows.UnmarshalRows(block)
+				for _, row := range batchRows.Rows() {
+					assert.Equal(t, "ns", string(row.NameSpace()))
+					assert.Equal(t, "cpu", string(row.Name()))
+					assert.True(t, row.Timestamp() >= familyTime+5*interval.Int64())
+					assert.Equal(t, 2, row.SimpleFieldsLen())
+					kvs := row.NewKeyValueIterator()
+					for kvs.HasNext() {
+						assert.Equal(t, "host", string(kvs.NextKey()))
+						hosts = append(hosts, string(kvs.NextValue()))
+					}
+					rows++
+				}
+				return nil
+			}
+			if tt.fn != nil {
+				fn = tt.fn
+			}
+			tt.prepare()
+			families, err := s.Export(fn)
+			if (err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+			if !tt.wantErr {
+				assert.Len(t, families, 1)
+				assert.Equal(t, familyTime, families[0].FamilyTime)
+				assert.Equal(t, map[int32]int64{1: 100}, families[0].Sequences)
+				assert.Equal(t, tt.rows, rows)
+				sort.Strings(hosts)
+				assert.Equal(t, []string{"host-1", "host-1", "host-1", "host-3", "host-3"}, hosts)
+			}
+		})
+	}
+}
+
+func TestShard_ExportFamily(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := NewMockDataFamily(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	kvFamily := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	s := &shard{
+		id:       1,
+		db:       db,
+		metadata: metadata,
+		logger:   logger.GetLogger("TSDB", "Test"),
+	}
+	familyTime := int64(3600 * 1000)
+	family.EXPECT().FamilyTime().Return(familyTime).AnyTimes()
+	family.EXPECT().Interval().Return(timeutil.Interval(10 * timeutil.OneSecond)).AnyTimes()
+	family.EXPECT().Indicator().Return("test/1/1").AnyTimes()
+	family.EXPECT().IsFlushing().Return(false).AnyTimes()
+	family.EXPECT().Family().Return(kvFamily).AnyTimes()
+	kvFamily.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	snapshot.EXPECT().Close().AnyTimes()
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+
+	// case 1: flush failure
+	family.EXPECT().Flush().Return(fmt.Errorf("err"))
+	_, err := s.ExportFamily(family, nil)
+	assert.Error(t, err)
+	// case 2: load metadata failure
+	family.EXPECT().Flush().Return(nil)
+	metadataDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return(nil, fmt.Errorf("err"))
+	_, err = s.ExportFamily(family, nil)
+	assert.Error(t, err)
+	// case 3: get snapshot reader failure
+	family.EXPECT().Flush().Return(nil)
+	metadataDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return(nil, nil)
+	v.EXPECT().GetAllFiles().Return([]*version.FileMeta{version.NewFileMeta(1, 1, 10, 1024)})
+	snapshot.EXPECT().GetReader(table.FileNumber(1)).Return(nil, fmt.Errorf("err"))
+	_, err = s.ExportFamily(family, nil)
+	assert.Error(t, err)
+	// case 4: export empty family
+	family.EXPECT().Flush().Return(nil)
+	metadataDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return(nil, nil)
+	v.EXPECT().GetAllFiles().Return(nil)
+	v.EXPECT().GetSequences().Return(map[int32]int64{1: 100})
+	backup, err := s.ExportFamily(family, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.FamilyBackup{FamilyTime: familyTime, Sequences: map[int32]int64{1: 100}}, backup)
+}
+
+func TestTruncateDataFamily(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := NewMockDataFamily(ctrl)
+	kvFamily := kv.NewMockFamily(ctrl)
+	family.EXPECT().IsFlushing().Return(false).AnyTimes()
+	family.EXPECT().Family().Return(kvFamily).AnyTimes()
+
+	// case 1: flush failure
+	family.EXPECT().Flush().Return(fmt.Errorf("err"))
+	assert.Error(t, TruncateDataFamily(family))
+	// case 2: truncate family
+	family.EXPECT().Flush().Return(nil)
+	kvFamily.EXPECT().Truncate().Return(nil)
+	assert.NoError(t, TruncateDataFamily(family))
+}
+
+func TestShard_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	segment := NewMockIntervalSegment(ctrl)
+	seg := NewMockSegment(ctrl)
+	family := NewMockDataFamily(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	interval := timeutil.Interval(10 * timeutil.OneSecond)
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	s := &shard{
+		db:            db,
+		interval:      interval,
+		segment:       segment,
+		rollupTargets: map[timeutil.Interval]IntervalSegment{interval: segment},
+		metadata:      metadata,
+		statistics:    metrics.NewShardStatistics("data", "1"),
+		logger:        logger.GetLogger("TSDB", "Test"),
+	}
+	builder, release := commonseries.NewRowBuilder()
+	defer release(builder)
+	builder.AddMetricName([]byte("cpu"))
+	builder.AddTimestamp(timeutil.OneHour)
+	_ = builder.AddSimpleField([]byte("f1"), flatMetricsV1.SimpleFieldTypeDeltaSum, 1)
+	block, _ := builder.Build()
+
+	cases := []struct {
+		name    string
+		block   []byte
+		prepare func()
+		rows    int
+		wantErr bool
+	}{
+		{
+			name: "get family failure",
+			prepare: func() {
+				segment.EXPECT().GetOrCreateSegment(gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty rows",
+			prepare: func() {
+				segment.EXPECT().GetOrCreateSegment(gomock.Any()).Return(seg, nil).Times(2)
+				seg.EXPECT().GetOrCreateDataFamily(gomock.Any()).Return(family, nil)
+			},
+		},
+		{
+			name:  "lookup metric meta failure",
+			block: block,
+			prepare: func() {
+				segment.EXPECT().GetOrCreateSegment(gomock.Any()).Return(seg, nil).Times(2)
+				seg.EXPECT().GetOrCreateDataFamily(gomock.Any()).Return(family, nil)
+				metadataDB.EXPECT().GenMetricID(gomock.Any(), "cpu", gomock.Any()).Return(metric.ID(0), fmt.Errorf("err"))
+				family.EXPECT().WriteRows(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:  "write rows failure",
+			block: block,
+			prepare: func() {
+				segment.EXPECT().GetOrCreateSegment(gomock.Any()).Return(seg, nil).Times(2)
+				seg.EXPECT().GetOrCreateDataFamily(gomock.Any()).Return(family, nil)
+				metadataDB.EXPECT().GenMetricID(gomock.Any(), "cpu", gomock.Any()).Return(metric.ID(10), nil)
+				metadataDB.EXPECT().GenFieldID(gomock.Any(), "cpu", field.Name("f1"), field.SumField, gomock.Any()).
+					Return(field.ID(1), nil)
+				family.EXPECT().WriteRows(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "import successfully",
+			block: block,
+			prepare: func() {
+				segment.EXPECT().GetOrCreateSegment(gomock.Any()).Return(seg, nil).Times(2)
+				seg.EXPECT().GetOrCreateDataFamily(gomock.Any()).Return(family, nil)
+				metadataDB.EXPECT().GenMetricID(gomock.Any(), "cpu", gomock.Any()).Return(metric.ID(10), nil)
+				metadataDB.EXPECT().GenFieldID(gomock.Any(), "cpu", field.Name("f1"), field.SumField, gomock.Any()).
+					Return(field.ID(1), nil)
+				family.EXPECT().WriteRows(gomock.Any()).Return(nil)
+			},
+			rows: 1,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			family.EXPECT().Retain().AnyTimes()
+			family.EXPECT().Release().AnyTimes()
+			tt.prepare()
+			rows, err := s.Import(timeutil.OneHour, tt.block)
+			if (err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+			assert.Equal(t, tt.rows, rows)
+		})
+	}
+}
+
+func TestHistogramValues_Build(t *testing.T) {
+	builder, release := commonseries.NewRowBuilder()
+	defer release(builder)
+
+	h := &histogramValues{}
+	assert.False(t, h.add(field.Meta{Name: "__bucket_", Type: field.HistogramField}, 1))
+	assert.False(t, h.add(field.Meta{Name: "f1", Type: field.SumField}, 1))
+	assert.True(t, h.add(field.Meta{Name: "__bucket_+Inf", Type: field.HistogramField}, 1))
+	assert.True(t, h.add(field.Meta{Name: "__bucket_10", Type: field.HistogramField}, 2))
+	assert.True(t, h.add(field.Meta{Name: histogramSumField, Type: field.SumField}, 30))
+	assert.True(t, h.add(field.Meta{Name: histogramCountField, Type: field.SumField}, 3))
+	assert.True(t, h.add(field.Meta{Name: histogramMinField, Type: field.MinField}, 1))
+	assert.True(t, h.add(field.Meta{Name: histogramMaxField, Type: field.MaxField}, 20))
+	assert.NoError(t, h.build(builder))
+	assert.Equal(t, []float64{10, math.Inf(1)}, h.bounds)
+	assert.Equal(t, []float64{2, 1}, h.values)
+
+	// last bound not +Inf
+	h.reset()
+	h.add(field.Meta{Name: "__bucket_10", Type: field.HistogramField}, 2)
+	h.add(field.Meta{Name: "__bucket_20", Type: field.HistogramField}, 2)
+	assert.Error(t, h.build(builder))
+}
+
+func TestSimpleFieldType(t *testing.T) {
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeDeltaSum, simpleFieldType(field.SumField))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeMin, simpleFieldType(field.MinField))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeMax, simpleFieldType(field.MaxField))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeLast, simpleFieldType(field.LastField))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeFirst, simpleFieldType(field.FirstField))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeUnSpecified, simpleFieldType(field.HistogramField))
+}
+
+func mockExportMetricBlock(seriesIDs []uint32, start, end uint16) []byte {
+	nopKVFlusher := kv.NewNopFlusher()
+	flusher, _ := metricsdata.NewFlusher(nopKVFlusher)
+	flusher.PrepareMetric(10, field.Metas{
+		{ID: 2, Type: field.SumField},
+		{ID: 10, Type: field.MinField},
+	})
+	encoder := encoding.NewTSDEncoder(start)
+	for i := start; i <= end; i++ {
+		encoder.AppendTime(true)
+		encoder.AppendValue(math.Float64bits(float64(i)))
+	}
+	data, _ := encoder.BytesWithoutTime()
+	for _, seriesID := range seriesIDs {
+		_ = flusher.FlushField(data)
+		_ = flusher.FlushField(data)
+		_ = flusher.FlushSeries(seriesID)
+	}
+	_ = flusher.CommitMetric(timeutil.SlotRange{Start: start, End: end})
+	return nopKVFlusher.Bytes()
+}
@@ -32,6 +32,7 @@ var (
 	listDir                = fileutil.GetDirectoryList
 	removeDir              = fileutil.RemoveDir
 	fileExist              = fileutil.Exist
+	copyFile               = fileutil.CopyFile
 	decodeToml             = ltoml.DecodeToml
 	newDatabaseFunc        = newDatabase
 	newSegmentFunc         = newSegment
@@ -55,6 +55,8 @@ type IDMappingBackend interface {
 	genSeriesID(metricID metric.ID, tagsHash uint64, seriesID uint32) error
 	// sync the backend memory data into persist storage.
 	sync() error
+	// backup creates a consistent checkpoint of backend storage into target path.
+	backup(parent string) error
 }
 
 // idMappingBackend implements IDMappingBackend interface
@@ -143,3 +145,11 @@ func (imb *idMappingBackend) Close() error {
 func (imb *idMappingBackend) sync() error {
 	return imb.db.Flush()
 }
+
+// backup creates a consistent checkpoint of backend storage into target path.
+func (imb *idMappingBackend) backup(parent string) error {
+	if err := mkDir(parent); err != nil {
+		return err
+	}
+	return imb.db.Checkpoint(path.Join(parent, SeriesDB))
+}
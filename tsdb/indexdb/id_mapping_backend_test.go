@@ -19,6 +19,7 @@ package indexdb
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -108,6 +109,28 @@ func TestIDMappingBackend_Close_sync(t *testing.T) {
 	assert.NoError(t, backend.Close())
 }
 
+func TestIDMappingBackend_backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		mkDir = fileutil.MkDirIfNotExist
+		ctrl.Finish()
+	}()
+
+	idStore := unique.NewMockIDStore(ctrl)
+	backend := &idMappingBackend{
+		db: idStore,
+	}
+	mkDir = func(path string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, backend.backup("backup"))
+	mkDir = func(path string) error {
+		return nil
+	}
+	idStore.EXPECT().Checkpoint(filepath.Join("backup", SeriesDB)).Return(nil)
+	assert.NoError(t, backend.backup("backup"))
+}
+
 func TestIDMappingBackend_getSeriesID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
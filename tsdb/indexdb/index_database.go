@@ -30,6 +30,7 @@ import (
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
@@ -50,6 +51,7 @@ type indexDatabase struct {
 	metricID2Mapping map[metric.ID]MetricIDMapping // key: metric id, value: metric id mapping
 	metadata         metadb.Metadata               // the metadata for generating ID of metric, field
 	index            InvertedIndex
+	tombstones       *seriesTombstones // tombstones of deleted series
 
 	statistics *metrics.IndexDBStatistics
 
@@ -60,7 +62,10 @@ type indexDatabase struct {
 func NewIndexDatabase(ctx context.Context, parent string, metadata metadb.Metadata,
 	forwardFamily kv.Family, invertedFamily kv.Family,
 ) (IndexDatabase, error) {
-	var err error
+	tombstones, err := newSeriesTombstones(parent)
+	if err != nil {
+		return nil, err
+	}
 	backend, err := createBackendFn(parent)
 	if err != nil {
 		return nil, err
@@ -74,6 +79,7 @@ func NewIndexDatabase(ctx context.Context, parent string, metadata metadb.Metada
 		metadata:         metadata,
 		metricID2Mapping: make(map[metric.ID]MetricIDMapping),
 		index:            newInvertedIndex(metadata, forwardFamily, invertedFamily),
+		tombstones:       tombstones,
 		statistics:       metrics.NewIndexDBStatistics(metadata.DatabaseName()),
 	}
 
@@ -90,6 +96,11 @@ func (db *indexDatabase) GetGroupingContext(ctx *flow.ShardExecuteContext) error
 	return db.index.GetGroupingContext(ctx)
 }
 
+// GetGroupingScanners returns the grouping scanners(series id => tag value id) of tag key for series ids.
+func (db *indexDatabase) GetGroupingScanners(tagKeyID tag.KeyID, seriesIDs *roaring.Bitmap) ([]flow.GroupingScanner, error) {
+	return db.index.GetGroupingScanners(tagKeyID, seriesIDs)
+}
+
 // GetOrCreateSeriesID gets series by tags hash, if not exist generate new series id in memory,
 // if generate a new series id returns isCreate is true
 // if generate fail return err
@@ -192,6 +203,32 @@ func (db *indexDatabase) BuildInvertIndex(
 	db.statistics.BuildInvertedIndex.Incr()
 }
 
+// AddTombstone adds the tombstone of deleted series, persists it into tombstone file.
+func (db *indexDatabase) AddTombstone(tombstone *Tombstone) error {
+	return db.tombstones.addTombstone(tombstone)
+}
+
+// GetTombstone returns the tombstone by series deletion id, returns false if not exist.
+func (db *indexDatabase) GetTombstone(id int64) (*Tombstone, bool) {
+	return db.tombstones.getTombstone(id)
+}
+
+// HasTombstone returns if it has any series deleted within time range.
+func (db *indexDatabase) HasTombstone(timeRange timeutil.TimeRange) bool {
+	return db.tombstones.hasTombstone(timeRange)
+}
+
+// RemoveTombstones removes the tombstones which all deleted data is before expire time(dropped by data retention),
+// returns the number of removed tombstones.
+func (db *indexDatabase) RemoveTombstones(expireTime int64) (int, error) {
+	return db.tombstones.removeTombstones(expireTime)
+}
+
+// GetDeletedSeries returns the deleted series of metric in data family, returns nil if no series deleted.
+func (db *indexDatabase) GetDeletedSeries(metricID metric.ID, familyTime int64, interval timeutil.Interval) *DeletedSeries {
+	return db.tombstones.getDeletedSeries(metricID, familyTime, interval)
+}
+
 // Flush flushes index data to disk
 func (db *indexDatabase) Flush() error {
 	// TODO need flush metric level time series sequence?
@@ -205,6 +242,17 @@ func (db *indexDatabase) Flush() error {
 	return db.index.Flush()
 }
 
+// Backup creates a consistent checkpoint of series id mapping and tombstones into target path.
+func (db *indexDatabase) Backup(path string) error {
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+
+	if err := db.backend.backup(path); err != nil {
+		return err
+	}
+	return db.tombstones.backup(path)
+}
+
 // Close closes the database, releases the resources
 func (db *indexDatabase) Close() error {
 	db.cancel()
@@ -21,6 +21,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -33,6 +35,7 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/pkg/unique"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/metric"
@@ -65,6 +68,44 @@ func TestNewIndexDatabase(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestIndexDatabase_Tombstone(t *testing.T) {
+	testPath := t.TempDir()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	meta := metadb.NewMockMetadata(ctrl)
+	meta.EXPECT().DatabaseName().Return("test").AnyTimes()
+	db, err := NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.NoError(t, err)
+	_, ok := db.GetTombstone(1)
+	assert.False(t, ok)
+	err = db.AddTombstone(&Tombstone{
+		ID:        1,
+		MetricID:  10,
+		TimeRange: timeutil.TimeRange{Start: 10, End: 20},
+		SeriesIDs: roaring.BitmapOf(1, 2),
+	})
+	assert.NoError(t, err)
+	_, ok = db.GetTombstone(1)
+	assert.True(t, ok)
+	assert.Equal(t, roaring.BitmapOf(1, 2), db.GetDeletedSeries(10, 0, timeutil.Interval(10)).SeriesIDs())
+	assert.Nil(t, db.GetDeletedSeries(10, 0, timeutil.Interval(10*timeutil.OneSecond)))
+	assert.True(t, db.HasTombstone(timeutil.TimeRange{Start: 0, End: 100}))
+	assert.False(t, db.HasTombstone(timeutil.TimeRange{Start: 30, End: 100}))
+	removed, err := db.RemoveTombstones(21)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	assert.False(t, db.HasTombstone(timeutil.TimeRange{Start: 0, End: 100}))
+	err = db.Close()
+	assert.NoError(t, err)
+
+	// load tombstone failure
+	assert.NoError(t, os.WriteFile(filepath.Join(testPath, tombstoneFile), []byte("err"), 0644))
+	db, err = NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.Error(t, err)
+	assert.Nil(t, db)
+}
+
 func TestIndexDatabase_SuggestTagValues(t *testing.T) {
 	testPath := t.TempDir()
 	ctrl := gomock.NewController(t)
@@ -396,6 +437,11 @@ func TestIndexDatabase_GetGroupingContext(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, shardExecuteCtx.GroupingContext)
 
+	index.EXPECT().GetGroupingScanners(tag.KeyID(1), gomock.Any()).Return(nil, nil)
+	scanners, err := db.GetGroupingScanners(1, roaring.BitmapOf(1, 2))
+	assert.NoError(t, err)
+	assert.Nil(t, scanners)
+
 	index.EXPECT().Flush().Return(nil)
 	err = db.Close()
 	assert.NoError(t, err)
@@ -499,3 +545,32 @@ func TestIndexDatabase_Flush(t *testing.T) {
 	backend.EXPECT().sync().Return(fmt.Errorf("err"))
 	assert.Error(t, db.Flush())
 }
+
+func TestIndexDatabase_Backup(t *testing.T) {
+	testPath := t.TempDir()
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createBackendFn = newIDMappingBackend
+		ctrl.Finish()
+	}()
+	backend := NewMockIDMappingBackend(ctrl)
+	createBackendFn = func(parent string) (IDMappingBackend, error) {
+		return backend, nil
+	}
+
+	meta := metadb.NewMockMetadata(ctrl)
+	meta.EXPECT().DatabaseName().Return("test").AnyTimes()
+	db, err := NewIndexDatabase(context.TODO(), testPath, meta, nil, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, db.AddTombstone(&Tombstone{ID: 1, MetricID: 10, SeriesIDs: roaring.BitmapOf(1)}))
+
+	backupPath := t.TempDir()
+	backend.EXPECT().backup(backupPath).Return(fmt.Errorf("err"))
+	assert.Error(t, db.Backup(backupPath))
+	backend.EXPECT().backup(backupPath).Return(nil)
+	assert.NoError(t, db.Backup(backupPath))
+	tombstones, err := newSeriesTombstones(backupPath)
+	assert.NoError(t, err)
+	_, ok := tombstones.getTombstone(1)
+	assert.True(t, ok)
+}
@@ -20,11 +20,15 @@ package indexdb
 import (
 	"io"
 
+	"github.com/lindb/roaring"
+
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
 )
 
 //go:generate mockgen -source ./interface.go -destination=./interface_mock.go -package=indexdb
@@ -55,6 +59,21 @@ type IndexDatabase interface {
 	// BuildInvertIndex builds the inverted index for tag value => series ids,
 	// the tags is considered as an empty key-value pair while tags is nil.
 	BuildInvertIndex(namespace, metricName string, tagIterator *metric.KeyValueIterator, seriesID uint32, limits *models.Limits)
+	// AddTombstone adds the tombstone of deleted series, persists it into tombstone file.
+	AddTombstone(tombstone *Tombstone) error
+	// GetTombstone returns the tombstone by series deletion id, returns false if not exist.
+	GetTombstone(id int64) (*Tombstone, bool)
+	// HasTombstone returns if it has any series deleted within time range.
+	HasTombstone(timeRange timeutil.TimeRange) bool
+	// RemoveTombstones removes the tombstones which all deleted data is before expire time(dropped by data retention),
+	// returns the number of removed tombstones.
+	RemoveTombstones(expireTime int64) (int, error)
+	// GetGroupingScanners returns the grouping scanners(series id => tag value id) of tag key for series ids.
+	GetGroupingScanners(tagKeyID tag.KeyID, seriesIDs *roaring.Bitmap) ([]flow.GroupingScanner, error)
+	// GetDeletedSeries returns the deleted series of metric in data family, returns nil if no series deleted.
+	GetDeletedSeries(metricID metric.ID, familyTime int64, interval timeutil.Interval) *DeletedSeries
 	// Flush flushes index data to disk
 	Flush() error
+	// Backup creates a consistent checkpoint of series id mapping and tombstones into target path.
+	Backup(path string) error
 }
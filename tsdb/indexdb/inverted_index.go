@@ -54,6 +54,8 @@ type InvertedIndex interface {
 	GetSeriesIDsForTags(tagKeyIDs []tag.KeyID) (*roaring.Bitmap, error)
 	// GetGroupingContext returns the context of group by
 	GetGroupingContext(ctx *flow.ShardExecuteContext) error
+	// GetGroupingScanners returns the grouping scanners(series id => tag value id) of tag key for series ids.
+	GetGroupingScanners(tagKeyID tag.KeyID, seriesIDs *roaring.Bitmap) ([]flow.GroupingScanner, error)
 	// buildInvertIndex builds the inverted index for tag value => series ids,
 	// the tags is considered as an empty key-value pair while tags is nil.
 	buildInvertIndex(namespace, metricName string, tagIterator *metric.KeyValueIterator, seriesID uint32, limits *models.Limits)
@@ -196,6 +198,15 @@ func (index *invertedIndex) GetGroupingContext(ctx *flow.ShardExecuteContext) er
 	return nil
 }
 
+// GetGroupingScanners returns the grouping scanners(series id => tag value id) of tag key for series ids.
+func (index *invertedIndex) GetGroupingScanners(tagKeyID tag.KeyID, seriesIDs *roaring.Bitmap) ([]flow.GroupingScanner, error) {
+	// get kv store snapshot
+	snapshot := index.forwardFamily.GetSnapshot()
+	defer snapshot.Close()
+
+	return index.getGroupingScanners(tagKeyID, seriesIDs, snapshot)
+}
+
 // withLock retrieves the lock of inverted index, and returns the release function.
 func (index *invertedIndex) withLock() (release func()) {
 	index.rwMutex.RLock()
@@ -374,3 +374,31 @@ func prepareInvertedIndex(ctrl *gomock.Controller) InvertedIndex {
 	}), 3, limits)
 	return index
 }
+
+func TestInvertedIndex_GetGroupingScanners(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	index := prepareInvertedIndex(ctrl)
+	idx := index.(*invertedIndex)
+	family := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	snapshot.EXPECT().Close().AnyTimes()
+	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	idx.forwardFamily = family
+
+	// case 1: find reader err
+	snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	scanners, err := index.GetGroupingScanners(2, roaring.BitmapOf(1, 2))
+	assert.Error(t, err)
+	assert.Nil(t, scanners)
+	// case 2: get scanners from memory
+	snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, nil)
+	scanners, err = index.GetGroupingScanners(2, roaring.BitmapOf(1, 2))
+	assert.NoError(t, err)
+	assert.Len(t, scanners, 1)
+	container, tagValueIDs := scanners[0].GetSeriesAndTagValue(0)
+	assert.True(t, container.Contains(1))
+	assert.True(t, container.Contains(2))
+	assert.Equal(t, []uint32{1, 2}, tagValueIDs)
+}
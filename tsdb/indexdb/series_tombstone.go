@@ -0,0 +1,309 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package indexdb
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+)
+
+// for testing
+var (
+	writeTombstoneFn = ltoml.WriteConfig
+	readTombstoneFn  = os.ReadFile
+	copyFileFn       = fileutil.CopyFile
+)
+
+const tombstoneFile = "TOMBSTONE"
+
+// Tombstone represents the series of metric deleted within time range,
+// data written after tombstone created(time slot >= created time) is never deleted.
+type Tombstone struct {
+	ID        int64              `json:"id"` // series deletion id
+	MetricID  metric.ID          `json:"metricID"`
+	TimeRange timeutil.TimeRange `json:"timeRange"`
+	CreatedAt int64              `json:"createdAt"` // created time of series deletion
+	SeriesIDs *roaring.Bitmap    `json:"-"`
+}
+
+// deletedTimeRange returns the time range of deleted data, the end time is before created time.
+func (t *Tombstone) deletedTimeRange() timeutil.TimeRange {
+	timeRange := t.TimeRange
+	if t.CreatedAt > 0 && timeRange.End >= t.CreatedAt {
+		timeRange.End = t.CreatedAt - 1
+	}
+	return timeRange
+}
+
+// deletedSlotRange returns the slot range of deleted data in family, returns false if no data deleted.
+// the slot which created time in is not deleted, because data of it maybe written after tombstone created.
+func (t *Tombstone) deletedSlotRange(familyTime, familyEndTime, interval int64) (timeutil.SlotRange, bool) {
+	timeRange := t.deletedTimeRange()
+	if timeRange.Start < familyTime {
+		timeRange.Start = familyTime
+	}
+	if timeRange.End > familyEndTime {
+		timeRange.End = familyEndTime
+	}
+	if timeRange.End < timeRange.Start {
+		return timeutil.SlotRange{}, false
+	}
+	// slot time in [start, end] is deleted
+	startSlot := (timeRange.Start - familyTime + interval - 1) / interval
+	endSlot := (timeRange.End - familyTime) / interval
+	if t.CreatedAt > 0 && t.CreatedAt <= familyEndTime {
+		if createdSlot := (t.CreatedAt - familyTime) / interval; endSlot >= createdSlot {
+			endSlot = createdSlot - 1
+		}
+	}
+	if endSlot < startSlot {
+		return timeutil.SlotRange{}, false
+	}
+	return timeutil.SlotRange{Start: uint16(startSlot), End: uint16(endSlot)}, true
+}
+
+// DeletedSeries represents the deleted series of metric in data family,
+// the time range of tombstone is converted to slot range of family.
+type DeletedSeries struct {
+	maxSlot       uint16          // max slot of family
+	seriesIDs     *roaring.Bitmap // series ids which has deleted data
+	fullSeriesIDs *roaring.Bitmap // series ids which all data of family deleted
+	slots         []deletedSlots
+}
+
+// deletedSlots represents the slot range of series deleted.
+type deletedSlots struct {
+	slotRange timeutil.SlotRange
+	seriesIDs *roaring.Bitmap
+}
+
+// NewDeletedSeries creates the deleted series of family with max slot.
+func NewDeletedSeries(maxSlot uint16) *DeletedSeries {
+	return &DeletedSeries{
+		maxSlot:       maxSlot,
+		seriesIDs:     roaring.New(),
+		fullSeriesIDs: roaring.New(),
+	}
+}
+
+// Add adds the series ids which data deleted in slot range.
+func (ds *DeletedSeries) Add(slotRange timeutil.SlotRange, seriesIDs *roaring.Bitmap) {
+	ds.seriesIDs.Or(seriesIDs)
+	if slotRange.Start == 0 && slotRange.End >= ds.maxSlot {
+		ds.fullSeriesIDs.Or(seriesIDs)
+	}
+	ds.slots = append(ds.slots, deletedSlots{slotRange: slotRange, seriesIDs: seriesIDs})
+}
+
+// SeriesIDs returns the series ids which has deleted data in family.
+func (ds *DeletedSeries) SeriesIDs() *roaring.Bitmap {
+	return ds.seriesIDs
+}
+
+// FullSeriesIDs returns the series ids which all data of family deleted.
+func (ds *DeletedSeries) FullSeriesIDs() *roaring.Bitmap {
+	return ds.fullSeriesIDs
+}
+
+// IsDeleted returns if the data of series at slot is deleted.
+func (ds *DeletedSeries) IsDeleted(seriesID uint32, slot uint16) bool {
+	if !ds.seriesIDs.Contains(seriesID) {
+		return false
+	}
+	for idx := range ds.slots {
+		deleted := &ds.slots[idx]
+		if slot >= deleted.slotRange.Start && slot <= deleted.slotRange.End && deleted.seriesIDs.Contains(seriesID) {
+			return true
+		}
+	}
+	return false
+}
+
+// tombstoneData represents the persist format of tombstone.
+type tombstoneData struct {
+	Tombstone
+	SeriesIDs []byte `json:"seriesIDs"`
+}
+
+// seriesTombstones maintains all tombstones of shard, persists into tombstone file(json format).
+type seriesTombstones struct {
+	fileName   string
+	tombstones []*Tombstone
+	ids        map[int64]*Tombstone
+
+	lock sync.RWMutex
+}
+
+// newSeriesTombstones creates the series tombstones, loads tombstones from file if exist.
+func newSeriesTombstones(parent string) (*seriesTombstones, error) {
+	t := &seriesTombstones{
+		fileName: filepath.Join(parent, tombstoneFile),
+		ids:      make(map[int64]*Tombstone),
+	}
+	if !fileutil.Exist(t.fileName) {
+		return t, nil
+	}
+	data, err := readTombstoneFn(t.fileName)
+	if err != nil {
+		return nil, err
+	}
+	var items []*tombstoneData
+	if err := encoding.JSONUnmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		seriesIDs := roaring.New()
+		if err := seriesIDs.UnmarshalBinary(item.SeriesIDs); err != nil {
+			return nil, err
+		}
+		tombstone := item.Tombstone
+		tombstone.SeriesIDs = seriesIDs
+		t.tombstones = append(t.tombstones, &tombstone)
+		t.ids[tombstone.ID] = &tombstone
+	}
+	return t, nil
+}
+
+// addTombstone adds the tombstone, then persists all tombstones into file.
+func (t *seriesTombstones) addTombstone(tombstone *Tombstone) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, ok := t.ids[tombstone.ID]; ok {
+		return nil
+	}
+	tombstones := make([]*Tombstone, 0, len(t.tombstones)+1)
+	tombstones = append(tombstones, t.tombstones...)
+	tombstones = append(tombstones, tombstone)
+	if err := t.persist(tombstones); err != nil {
+		return err
+	}
+	t.tombstones = tombstones
+	t.ids[tombstone.ID] = tombstone
+	return nil
+}
+
+// removeTombstones removes the tombstones which all deleted data is before expire time(dropped by data retention),
+// then persists the remaining tombstones into file, returns the number of removed tombstones.
+func (t *seriesTombstones) removeTombstones(expireTime int64) (int, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var remaining []*Tombstone
+	for _, tombstone := range t.tombstones {
+		if tombstone.deletedTimeRange().End >= expireTime {
+			remaining = append(remaining, tombstone)
+		}
+	}
+	removed := len(t.tombstones) - len(remaining)
+	if removed == 0 {
+		return 0, nil
+	}
+	if err := t.persist(remaining); err != nil {
+		return 0, err
+	}
+	t.tombstones = remaining
+	t.ids = make(map[int64]*Tombstone, len(remaining))
+	for _, tombstone := range remaining {
+		t.ids[tombstone.ID] = tombstone
+	}
+	return removed, nil
+}
+
+// persist writes the tombstones into file(json format), must hold write lock.
+func (t *seriesTombstones) persist(tombstones []*Tombstone) error {
+	items := make([]*tombstoneData, 0, len(tombstones))
+	for _, item := range tombstones {
+		seriesIDs, err := item.SeriesIDs.ToBytes()
+		if err != nil {
+			return err
+		}
+		items = append(items, &tombstoneData{Tombstone: *item, SeriesIDs: seriesIDs})
+	}
+	return writeTombstoneFn(t.fileName, string(encoding.JSONMarshal(items)))
+}
+
+// backup copies the tombstone file into target path if exist.
+func (t *seriesTombstones) backup(parent string) error {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if !fileutil.Exist(t.fileName) {
+		return nil
+	}
+	return copyFileFn(t.fileName, filepath.Join(parent, tombstoneFile))
+}
+
+// getTombstone returns the tombstone by series deletion id, returns false if not exist.
+func (t *seriesTombstones) getTombstone(id int64) (*Tombstone, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	tombstone, ok := t.ids[id]
+	return tombstone, ok
+}
+
+// hasTombstone returns if it has any tombstone overlap with time range.
+func (t *seriesTombstones) hasTombstone(timeRange timeutil.TimeRange) bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	for _, tombstone := range t.tombstones {
+		if deletedTimeRange := tombstone.deletedTimeRange(); deletedTimeRange.Overlap(timeRange) {
+			return true
+		}
+	}
+	return false
+}
+
+// getDeletedSeries returns the deleted series of metric in data family, returns nil if no series deleted.
+func (t *seriesTombstones) getDeletedSeries(metricID metric.ID, familyTime int64, interval timeutil.Interval) *DeletedSeries {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	intervalVal := interval.Int64()
+	if intervalVal <= 0 {
+		return nil
+	}
+	familyEndTime := interval.Calculator().CalcFamilyEndTime(familyTime)
+	maxSlot := uint16((familyEndTime - familyTime) / intervalVal)
+	var rs *DeletedSeries
+	for _, tombstone := range t.tombstones {
+		if tombstone.MetricID != metricID {
+			continue
+		}
+		slotRange, ok := tombstone.deletedSlotRange(familyTime, familyEndTime, intervalVal)
+		if !ok {
+			continue
+		}
+		if rs == nil {
+			rs = NewDeletedSeries(maxSlot)
+		}
+		rs.Add(slotRange, tombstone.SeriesIDs)
+	}
+	return rs
+}
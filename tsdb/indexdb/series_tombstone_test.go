@@ -0,0 +1,272 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package indexdb
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+)
+
+func TestSeriesTombstones(t *testing.T) {
+	testPath := t.TempDir()
+	interval := timeutil.Interval(10 * timeutil.OneSecond)
+	familyTime, _ := timeutil.ParseTimestamp("20220326 10:00:00")
+	tombstones, err := newSeriesTombstones(testPath)
+	assert.NoError(t, err)
+	_, ok := tombstones.getTombstone(1)
+	assert.False(t, ok)
+	assert.Nil(t, tombstones.getDeletedSeries(1, familyTime, interval))
+
+	assert.NoError(t, tombstones.addTombstone(&Tombstone{
+		ID:        1,
+		MetricID:  1,
+		TimeRange: timeutil.TimeRange{Start: familyTime + 10*timeutil.OneSecond, End: familyTime + 25*timeutil.OneSecond},
+		SeriesIDs: roaring.BitmapOf(1, 2),
+	}))
+	assert.NoError(t, tombstones.addTombstone(&Tombstone{
+		ID:        2,
+		MetricID:  1,
+		TimeRange: timeutil.TimeRange{Start: 0, End: math.MaxInt64},
+		CreatedAt: familyTime + 35*timeutil.OneSecond,
+		SeriesIDs: roaring.BitmapOf(3),
+	}))
+	allSeriesIDs := roaring.New()
+	allSeriesIDs.AddRange(0, 1<<32)
+	assert.NoError(t, tombstones.addTombstone(&Tombstone{
+		ID:        3,
+		MetricID:  1,
+		TimeRange: timeutil.TimeRange{Start: familyTime + 2*timeutil.OneHour, End: familyTime + 3*timeutil.OneHour},
+		SeriesIDs: allSeriesIDs,
+	}))
+	// add duplicate tombstone
+	assert.NoError(t, tombstones.addTombstone(&Tombstone{ID: 2}))
+	_, ok = tombstones.getTombstone(1)
+	assert.True(t, ok)
+	tombstone, ok := tombstones.getTombstone(2)
+	assert.True(t, ok)
+	assert.Equal(t, familyTime+35*timeutil.OneSecond, tombstone.CreatedAt)
+
+	check := func(tombstones *seriesTombstones) {
+		deletedSeries := tombstones.getDeletedSeries(1, familyTime, interval)
+		assert.Equal(t, []uint32{1, 2, 3}, deletedSeries.SeriesIDs().ToArray())
+		assert.True(t, deletedSeries.FullSeriesIDs().IsEmpty())
+		// deleted slot range: [1,2]
+		assert.False(t, deletedSeries.IsDeleted(1, 0))
+		assert.True(t, deletedSeries.IsDeleted(1, 1))
+		assert.True(t, deletedSeries.IsDeleted(2, 2))
+		assert.False(t, deletedSeries.IsDeleted(2, 3))
+		// data written after tombstone created(slot >= 3) not deleted
+		assert.True(t, deletedSeries.IsDeleted(3, 0))
+		assert.True(t, deletedSeries.IsDeleted(3, 2))
+		assert.False(t, deletedSeries.IsDeleted(3, 3))
+		assert.False(t, deletedSeries.IsDeleted(4, 1))
+		// all data of family deleted
+		deletedSeries = tombstones.getDeletedSeries(1, familyTime+2*timeutil.OneHour, interval)
+		assert.True(t, allSeriesIDs.Equals(deletedSeries.FullSeriesIDs()))
+		assert.True(t, deletedSeries.IsDeleted(100, 359))
+		assert.Nil(t, tombstones.getDeletedSeries(1, familyTime+timeutil.OneHour, interval))
+		assert.Nil(t, tombstones.getDeletedSeries(2, familyTime, interval))
+		assert.Nil(t, tombstones.getDeletedSeries(1, familyTime, 0))
+
+		nextFamilyTime := familyTime + timeutil.OneHour
+		assert.True(t, tombstones.hasTombstone(timeutil.TimeRange{Start: familyTime, End: familyTime + 15*timeutil.OneSecond}))
+		assert.False(t, tombstones.hasTombstone(timeutil.TimeRange{Start: nextFamilyTime, End: nextFamilyTime + 30*timeutil.OneMinute}))
+	}
+	check(tombstones)
+	// reload tombstones from file
+	tombstones, err = newSeriesTombstones(testPath)
+	assert.NoError(t, err)
+	tombstone, ok = tombstones.getTombstone(1)
+	assert.True(t, ok)
+	assert.Equal(t, metric.ID(1), tombstone.MetricID)
+	check(tombstones)
+	// backup tombstones, then load from backup
+	backupPath := t.TempDir()
+	assert.NoError(t, tombstones.backup(backupPath))
+	tombstones, err = newSeriesTombstones(backupPath)
+	assert.NoError(t, err)
+	check(tombstones)
+}
+
+func TestSeriesTombstones_removeTombstones(t *testing.T) {
+	testPath := t.TempDir()
+	tombstones, err := newSeriesTombstones(testPath)
+	assert.NoError(t, err)
+	assert.NoError(t, tombstones.addTombstone(&Tombstone{
+		ID:        1,
+		TimeRange: timeutil.TimeRange{Start: 10, End: 100},
+		SeriesIDs: roaring.BitmapOf(1),
+	}))
+	// drop metric, deleted data before created time
+	assert.NoError(t, tombstones.addTombstone(&Tombstone{
+		ID:        2,
+		TimeRange: timeutil.TimeRange{Start: 0, End: math.MaxInt64},
+		CreatedAt: 200,
+		SeriesIDs: roaring.BitmapOf(2),
+	}))
+	// no tombstone expired
+	removed, err := tombstones.removeTombstones(100)
+	assert.NoError(t, err)
+	assert.Zero(t, removed)
+	removed, err = tombstones.removeTombstones(101)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	_, ok := tombstones.getTombstone(1)
+	assert.False(t, ok)
+	// reload tombstones from file
+	tombstones, err = newSeriesTombstones(testPath)
+	assert.NoError(t, err)
+	_, ok = tombstones.getTombstone(1)
+	assert.False(t, ok)
+	_, ok = tombstones.getTombstone(2)
+	assert.True(t, ok)
+	// remove all tombstones
+	removed, err = tombstones.removeTombstones(200)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	tombstones, err = newSeriesTombstones(testPath)
+	assert.NoError(t, err)
+	_, ok = tombstones.getTombstone(2)
+	assert.False(t, ok)
+}
+
+func TestTombstone_deletedSlotRange(t *testing.T) {
+	familyTime := int64(3600 * 1000)
+	familyEndTime := familyTime + timeutil.OneHour - 1
+	interval := int64(10 * 1000)
+	cases := []struct {
+		name      string
+		tombstone Tombstone
+		slotRange timeutil.SlotRange
+		deleted   bool
+	}{
+		{
+			name:      "before family",
+			tombstone: Tombstone{TimeRange: timeutil.TimeRange{Start: 0, End: familyTime - 1}},
+		},
+		{
+			name:      "after family",
+			tombstone: Tombstone{TimeRange: timeutil.TimeRange{Start: familyEndTime + 1, End: math.MaxInt64}},
+		},
+		{
+			name:      "whole family",
+			tombstone: Tombstone{TimeRange: timeutil.TimeRange{Start: 0, End: math.MaxInt64}},
+			slotRange: timeutil.SlotRange{Start: 0, End: 359},
+			deleted:   true,
+		},
+		{
+			name:      "slot start time in time range",
+			tombstone: Tombstone{TimeRange: timeutil.TimeRange{Start: familyTime + 1, End: familyTime + 29*1000}},
+			slotRange: timeutil.SlotRange{Start: 1, End: 2},
+			deleted:   true,
+		},
+		{
+			name:      "no slot start time in time range",
+			tombstone: Tombstone{TimeRange: timeutil.TimeRange{Start: familyTime + 1, End: familyTime + 9*1000}},
+		},
+		{
+			name: "created in family",
+			tombstone: Tombstone{
+				TimeRange: timeutil.TimeRange{Start: 0, End: math.MaxInt64},
+				CreatedAt: familyTime + 5*1000,
+			},
+		},
+		{
+			name: "created after time range",
+			tombstone: Tombstone{
+				TimeRange: timeutil.TimeRange{Start: 0, End: familyTime + 20*1000},
+				CreatedAt: familyTime + 35*1000,
+			},
+			slotRange: timeutil.SlotRange{Start: 0, End: 2},
+			deleted:   true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			slotRange, ok := tt.tombstone.deletedSlotRange(familyTime, familyEndTime, interval)
+			assert.Equal(t, tt.deleted, ok)
+			assert.Equal(t, tt.slotRange, slotRange)
+		})
+	}
+}
+
+func TestSeriesTombstones_Error(t *testing.T) {
+	testPath := t.TempDir()
+	defer func() {
+		writeTombstoneFn = ltoml.WriteConfig
+		readTombstoneFn = os.ReadFile
+		copyFileFn = fileutil.CopyFile
+	}()
+	tombstones, err := newSeriesTombstones(testPath)
+	assert.NoError(t, err)
+	// tombstone file not exist, no need backup
+	backupPath := t.TempDir()
+	assert.NoError(t, tombstones.backup(backupPath))
+	assert.False(t, fileutil.Exist(filepath.Join(backupPath, tombstoneFile)))
+	// write file failure
+	writeTombstoneFn = func(fileName, content string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, tombstones.addTombstone(&Tombstone{ID: 1, SeriesIDs: roaring.BitmapOf(1)}))
+	_, ok := tombstones.getTombstone(1)
+	assert.False(t, ok)
+	// copy file failure
+	writeTombstoneFn = ltoml.WriteConfig
+	assert.NoError(t, tombstones.addTombstone(&Tombstone{ID: 1, SeriesIDs: roaring.BitmapOf(1)}))
+	copyFileFn = func(src, dst string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, tombstones.backup(backupPath))
+	// remove tombstones failure
+	writeTombstoneFn = func(fileName, content string) error {
+		return fmt.Errorf("err")
+	}
+	removed, err := tombstones.removeTombstones(timeutil.Now())
+	assert.Error(t, err)
+	assert.Zero(t, removed)
+	_, ok = tombstones.getTombstone(1)
+	assert.True(t, ok)
+	writeTombstoneFn = ltoml.WriteConfig
+	// read file failure
+	assert.NoError(t, os.WriteFile(filepath.Join(testPath, tombstoneFile), []byte("err"), 0644))
+	readTombstoneFn = func(name string) ([]byte, error) {
+		return nil, fmt.Errorf("err")
+	}
+	_, err = newSeriesTombstones(testPath)
+	assert.Error(t, err)
+	// unmarshal failure
+	readTombstoneFn = os.ReadFile
+	_, err = newSeriesTombstones(testPath)
+	assert.Error(t, err)
+	// unmarshal series ids failure
+	assert.NoError(t, os.WriteFile(filepath.Join(testPath, tombstoneFile), []byte(`[{"id":1,"seriesIDs":"AQI="}]`), 0644))
+	_, err = newSeriesTombstones(testPath)
+	assert.Error(t, err)
+}
@@ -20,8 +20,10 @@ package tsdb
 import (
 	"fmt"
 	"path"
+	"path/filepath"
 	"sync"
 
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
@@ -41,6 +43,9 @@ type IntervalSegment interface {
 	TTL() error
 	// EvictSegment evicts segment which long term no read operation.
 	EvictSegment()
+	// Backup backups the persisted data of all segments into target path,
+	// returns the replica sequence of data families in backup.
+	Backup(path string) ([]*models.FamilyBackup, error)
 }
 
 // intervalSegment implements IntervalSegment interface
@@ -191,6 +196,40 @@ func (s *intervalSegment) EvictSegment() {
 	}
 }
 
+// Backup backups the persisted data of all segments into target path,
+// returns the replica sequence of data families in backup.
+func (s *intervalSegment) Backup(path string) (families []*models.FamilyBackup, err error) {
+	if err = mkDirIfNotExist(path); err != nil {
+		return nil, err
+	}
+	// hold lock, prevent evicting/dropping segment when backup
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err0 := s.walkSegment(func(segmentName string, _ int64) {
+		if err != nil {
+			return
+		}
+		segment, ok := s.segments[segmentName]
+		if !ok {
+			segment, err = newSegmentFunc(s.shard, segmentName, s.interval.Interval)
+			if err != nil {
+				return
+			}
+			s.segments[segmentName] = segment
+		}
+		var segmentFamilies []*models.FamilyBackup
+		segmentFamilies, err = segment.Backup(filepath.Join(path, segmentName))
+		families = append(families, segmentFamilies...)
+	}); err0 != nil {
+		return nil, err0
+	}
+	if err != nil {
+		return nil, err
+	}
+	return families, nil
+}
+
 // walkSegment lists all segment under current interval segment dir.
 func (s *intervalSegment) walkSegment(fn func(segmentName string, segmentTime int64)) error {
 	segmentNames, err := listDir(s.dir)
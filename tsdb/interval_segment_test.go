@@ -19,6 +19,7 @@ package tsdb
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -408,3 +409,108 @@ func TestIntervalSegment_EvictSegment(t *testing.T) {
 	s.EvictSegment()
 	assert.Len(t, s.segments, 0)
 }
+
+func TestIntervalSegment_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		mkDirIfNotExist = fileutil.MkDirIfNotExist
+		listDir = fileutil.GetDirectoryList
+		newSegmentFunc = newSegment
+		ctrl.Finish()
+	}()
+	segment := NewMockSegment(ctrl)
+	s := &intervalSegment{
+		interval: option.Interval{
+			Interval:  timeutil.Interval(10 * timeutil.OneSecond),
+			Retention: timeutil.Interval(30 * timeutil.OneDay),
+		},
+		segments: map[string]Segment{
+			"20190904": segment,
+		},
+		logger: logger.GetLogger("TSDB", "Segment"),
+	}
+	families := []*models.FamilyBackup{{FamilyTime: 10, Sequences: map[int32]int64{1: 10}}}
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "create backup path failure",
+			prepare: func() {
+				mkDirIfNotExist = func(path string) error {
+					return fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "list segment failure",
+			prepare: func() {
+				listDir = func(path string) ([]string, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "load segment failure",
+			prepare: func() {
+				listDir = func(path string) ([]string, error) {
+					return []string{"20190905"}, nil
+				}
+				newSegmentFunc = func(shard Shard, segmentName string, interval timeutil.Interval) (Segment, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "backup segment failure",
+			prepare: func() {
+				listDir = func(path string) ([]string, error) {
+					return []string{"20190904"}, nil
+				}
+				segment.EXPECT().Backup(filepath.Join("backup", "20190904")).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "backup successfully",
+			prepare: func() {
+				listDir = func(path string) ([]string, error) {
+					return []string{"20190904", "20190905", "abc"}, nil
+				}
+				newSegmentFunc = func(shard Shard, segmentName string, interval timeutil.Interval) (Segment, error) {
+					return segment, nil
+				}
+				segment.EXPECT().Backup(filepath.Join("backup", "20190904")).Return(families, nil)
+				segment.EXPECT().Backup(filepath.Join("backup", "20190905")).Return(families, nil)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				mkDirIfNotExist = func(path string) error {
+					return nil
+				}
+				listDir = fileutil.GetDirectoryList
+				newSegmentFunc = newSegment
+			}()
+			mkDirIfNotExist = func(path string) error {
+				return nil
+			}
+			tt.prepare()
+			rs, err := s.Backup("backup")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Backup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.Len(t, rs, 2)
+				assert.Len(t, s.segments, 2)
+			}
+		})
+	}
+}
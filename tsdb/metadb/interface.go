@@ -86,6 +86,11 @@ type MetadataDatabase interface {
 
 	// SuggestNamespace suggests the namespace by namespace's prefix
 	SuggestNamespace(prefix string, limit int) (namespaces []string, err error)
+	// DropMetric drops the metric metadata(metric id/tag keys/fields) by namespace and metric name, returns the dropped metric id,
+	// new metric id will be generated when the metric is written again.
+	DropMetric(namespace, metricName string) (metricID metric.ID, err error)
 	// Sync syncs the pending metadata update event
 	Sync() error
+	// Backup creates a consistent checkpoint of metadata storage into target path.
+	Backup(path string) error
 }
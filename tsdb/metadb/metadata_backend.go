@@ -78,6 +78,9 @@ type MetadataBackend interface {
 	// getMetricID gets the metric id by namespace and metric name,
 	// if not exist return constants.ErrMetricIDNotFound.
 	getMetricID(namespace string, metricName string) (metricID metric.ID, err error)
+	// dropMetric drops the metric id and tag keys/fields of metric by namespace and metric name,
+	// if not exist return constants.ErrMetricIDNotFound.
+	dropMetric(namespace string, metricName string) (metricID metric.ID, err error)
 	// saveTagKey saves the tag meta for given metric id.
 	saveTagKey(metricID metric.ID, tagKey string) (tag.KeyID, error)
 	// getAllTagKeys returns the all tag keys by metric id,
@@ -97,6 +100,8 @@ type MetadataBackend interface {
 
 	// sync the backend memory data into persist storage.
 	sync() error
+	// backup persists sequences, then creates checkpoint of all backend storage into target path.
+	backup(path string) error
 }
 
 // metadataBackend implements the MetadataBackend interface.
@@ -289,6 +294,42 @@ func (mb *metadataBackend) getMetricID(namespace, metricName string) (metricID m
 	return
 }
 
+// dropMetric drops the metric id and tag keys/fields of metric by namespace and metric name,
+// if not exist return constants.ErrMetricIDNotFound.
+func (mb *metadataBackend) dropMetric(namespace, metricName string) (metricID metric.ID, err error) {
+	namespaceVal, exist, err := mb.namespace.Get([]byte(namespace))
+	if err != nil {
+		return
+	}
+	if !exist {
+		err = fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, metricName)
+		return
+	}
+	var key []byte
+	key = append(key, namespaceVal...)
+	key = append(key, metricName...)
+	metricVal, exist, err := mb.metric.Get(key)
+	if err != nil {
+		return
+	}
+	if !exist {
+		err = fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, metricName)
+		return
+	}
+	metricID = metric.ID(binary.LittleEndian.Uint32(metricVal))
+	// remove metric name => metric id mapping, new metric id will be generated when write again
+	if err = mb.metric.Delete(key); err != nil {
+		return
+	}
+	if err = mb.tagKey.Delete(metricID.MarshalBinary()); err != nil {
+		return
+	}
+	if err = mb.field.Delete(metricID.MarshalBinary()); err != nil {
+		return
+	}
+	return metricID, nil
+}
+
 // saveTagKey saves the tag meta for given metric id.
 func (mb *metadataBackend) saveTagKey(metricID metric.ID, tagKey string) (tag.KeyID, error) {
 	tagKeyID, err := nextSequence(mb.tagKeyIDSequence, mb.tagKey, tagKeyIDSequenceKey, false, 0)
@@ -441,6 +482,22 @@ func (mb *metadataBackend) sync() error {
 	return result
 }
 
+// backup persists sequences, then creates checkpoint of all backend storage into target path.
+func (mb *metadataBackend) backup(parent string) error {
+	if err := mb.saveSequences(); err != nil {
+		return err
+	}
+	if err := mkDirFn(parent); err != nil {
+		return err
+	}
+	for _, name := range storageDBNames {
+		if err := mb.dbs[name].Checkpoint(path.Join(parent, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Close closes the backend storage.
 func (mb *metadataBackend) Close() error {
 	var result error
@@ -422,6 +422,113 @@ func TestMetadataBackend_getMetricID(t *testing.T) {
 	}
 }
 
+func TestMetadataBackend_dropMetric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cases := []struct {
+		name    string
+		prepare func(ns, metric, tagKey, field *unique.MockIDStore)
+		wantErr bool
+	}{
+		{
+			name: "get ns id failure",
+			prepare: func(ns, _, _, _ *unique.MockIDStore) {
+				ns.EXPECT().Get(gomock.Any()).Return(nil, false, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "ns id not found",
+			prepare: func(ns, _, _, _ *unique.MockIDStore) {
+				ns.EXPECT().Get(gomock.Any()).Return(nil, false, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "get metric id failure",
+			prepare: func(ns, metric, _, _ *unique.MockIDStore) {
+				ns.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3, 4}, true, nil)
+				metric.EXPECT().Get(gomock.Any()).Return(nil, false, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "metric id not found",
+			prepare: func(ns, metric, _, _ *unique.MockIDStore) {
+				ns.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3, 4}, true, nil)
+				metric.EXPECT().Get(gomock.Any()).Return(nil, false, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete metric id failure",
+			prepare: func(ns, metric, _, _ *unique.MockIDStore) {
+				ns.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3, 4}, true, nil)
+				metric.EXPECT().Get(gomock.Any()).Return([]byte{2, 0, 0, 0}, true, nil)
+				metric.EXPECT().Delete(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete tag keys failure",
+			prepare: func(ns, metric, tagKey, _ *unique.MockIDStore) {
+				ns.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3, 4}, true, nil)
+				metric.EXPECT().Get(gomock.Any()).Return([]byte{2, 0, 0, 0}, true, nil)
+				metric.EXPECT().Delete(gomock.Any()).Return(nil)
+				tagKey.EXPECT().Delete(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete fields failure",
+			prepare: func(ns, metric, tagKey, field *unique.MockIDStore) {
+				ns.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3, 4}, true, nil)
+				metric.EXPECT().Get(gomock.Any()).Return([]byte{2, 0, 0, 0}, true, nil)
+				metric.EXPECT().Delete(gomock.Any()).Return(nil)
+				tagKey.EXPECT().Delete(gomock.Any()).Return(nil)
+				field.EXPECT().Delete(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "drop metric successfully",
+			prepare: func(ns, metric, tagKey, field *unique.MockIDStore) {
+				ns.EXPECT().Get(gomock.Any()).Return([]byte{1, 2, 3, 4}, true, nil)
+				metric.EXPECT().Get(gomock.Any()).Return([]byte{2, 0, 0, 0}, true, nil)
+				metric.EXPECT().Delete([]byte{1, 2, 3, 4, 'n', 'a', 'm', 'e'}).Return(nil)
+				tagKey.EXPECT().Delete([]byte{2, 0, 0, 0}).Return(nil)
+				field.EXPECT().Delete([]byte{2, 0, 0, 0}).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			nsStore := unique.NewMockIDStore(ctrl)
+			metricStore := unique.NewMockIDStore(ctrl)
+			tagKeyStore := unique.NewMockIDStore(ctrl)
+			fieldStore := unique.NewMockIDStore(ctrl)
+			backend := &metadataBackend{
+				namespace: nsStore,
+				metric:    metricStore,
+				tagKey:    tagKeyStore,
+				field:     fieldStore,
+			}
+			tt.prepare(nsStore, metricStore, tagKeyStore, fieldStore)
+
+			metricID, err := backend.dropMetric("ns", "name")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("dropMetric() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.Equal(t, metric.ID(2), metricID)
+			}
+		})
+	}
+}
+
 func TestMetadataBackend_saveTagKey(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1024,3 +1131,65 @@ func TestMetadataBackend_Close(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataBackend_backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		mkDirFn = fileutil.MkDirIfNotExist
+		ctrl.Finish()
+	}()
+	sequence := unique.NewMockSequence(ctrl)
+	store := unique.NewMockIDStore(ctrl)
+	backend := &metadataBackend{
+		sequences: []sequenceItem{{
+			sequence: sequence,
+			store:    store,
+			key:      metricIDSequenceKey,
+		}},
+		dbs: map[string]unique.IDStore{
+			NamespaceDB: store,
+			MetricDB:    store,
+			TagKeyDB:    store,
+			FieldDB:     store,
+		},
+	}
+	// save sequence failure
+	sequence.EXPECT().Current().Return(uint32(10))
+	store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, backend.backup(t.TempDir()))
+	// create dir failure
+	sequence.EXPECT().Current().Return(uint32(10)).AnyTimes()
+	store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mkDirFn = func(path string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, backend.backup(t.TempDir()))
+	mkDirFn = fileutil.MkDirIfNotExist
+	// checkpoint failure
+	store.EXPECT().Checkpoint(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, backend.backup(t.TempDir()))
+
+	// backup successfully, then open backup as metadata backend
+	db, err := newMetadataBackend(t.TempDir())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, db.Close())
+	}()
+	meta, err := db.getOrCreateMetricMetadata("ns", "metric", models.NewDefaultLimits())
+	assert.NoError(t, err)
+	backupPath := t.TempDir()
+	assert.NoError(t, db.backup(backupPath))
+
+	restored, err := newMetadataBackend(backupPath)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, restored.Close())
+	}()
+	metricID, err := restored.getMetricID("ns", "metric")
+	assert.NoError(t, err)
+	assert.Equal(t, meta.getMetricID(), metricID)
+	// new metric id must be greater than ids in backup
+	meta2, err := restored.getOrCreateMetricMetadata("ns", "metric2", models.NewDefaultLimits())
+	assert.NoError(t, err)
+	assert.True(t, meta2.getMetricID() > metricID)
+}
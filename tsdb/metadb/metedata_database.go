@@ -208,7 +208,11 @@ func (mdb *metadataDatabase) GenFieldID(
 	if fieldType == field.Unknown {
 		return field.EmptyFieldID, series.ErrFieldTypeUnspecified
 	}
-	metricMetadata, _ := mdb.getMetricMetadataFromCache(namespace, metricName)
+	metricMetadata, ok := mdb.getMetricMetadataFromCache(namespace, metricName)
+	if !ok {
+		// metric maybe dropped after metric id generated
+		return field.EmptyFieldID, fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, metricName)
+	}
 
 	mdb.rwMux.Lock()
 	defer mdb.rwMux.Unlock()
@@ -240,7 +244,11 @@ func (mdb *metadataDatabase) GenFieldID(
 // GenTagKeyID generates the tag key id in the memory
 // !!!!! NOTICE: metric metadata must be existed in memory, because gen metric has been saved
 func (mdb *metadataDatabase) GenTagKeyID(namespace, metricName, tagKey string, limits *models.Limits) (tagKeyID tag.KeyID, err error) {
-	metricMetadata, _ := mdb.getMetricMetadataFromCache(namespace, metricName)
+	metricMetadata, ok := mdb.getMetricMetadataFromCache(namespace, metricName)
+	if !ok {
+		// metric maybe dropped after metric id generated
+		return tag.EmptyTagKeyID, fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, metricName)
+	}
 
 	mdb.rwMux.Lock()
 	defer mdb.rwMux.Unlock()
@@ -265,6 +273,20 @@ func (mdb *metadataDatabase) GenTagKeyID(namespace, metricName, tagKey string, l
 	return tagKeyID, nil
 }
 
+// DropMetric drops the metric metadata(metric id/tag keys/fields) by namespace and metric name, returns the dropped metric id,
+// new metric id will be generated when the metric is written again.
+func (mdb *metadataDatabase) DropMetric(namespace, metricName string) (metricID metric.ID, err error) {
+	mdb.rwMux.Lock()
+	defer mdb.rwMux.Unlock()
+
+	metricID, err = mdb.backend.dropMetric(namespace, metricName)
+	if err != nil {
+		return metric.EmptyMetricID, err
+	}
+	delete(mdb.metrics, commonseries.JoinNamespaceMetric(namespace, metricName))
+	return metricID, nil
+}
+
 // Sync syncs the backend storage.
 func (mdb *metadataDatabase) Sync() error {
 	mdb.rwMux.Lock()
@@ -273,6 +295,14 @@ func (mdb *metadataDatabase) Sync() error {
 	return mdb.backend.sync()
 }
 
+// Backup creates a consistent checkpoint of metadata storage into target path.
+func (mdb *metadataDatabase) Backup(path string) error {
+	mdb.rwMux.Lock()
+	defer mdb.rwMux.Unlock()
+
+	return mdb.backend.backup(path)
+}
+
 // Close closes the resources
 func (mdb *metadataDatabase) Close() error {
 	mdb.rwMux.Lock()
@@ -581,6 +581,38 @@ func TestMetadataDatabase_GenMetricID(t *testing.T) {
 	}
 }
 
+func TestMetadataDatabase_DropMetric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createMetadataBackendFn = newMetadataBackend
+		ctrl.Finish()
+	}()
+	backend := NewMockMetadataBackend(ctrl)
+	createMetadataBackendFn = func(parent string) (MetadataBackend, error) {
+		return backend, nil
+	}
+
+	db := newMockMetadataDatabase(t, t.TempDir())
+	db2 := db.(*metadataDatabase)
+	db2.rwMux.Lock()
+	db2.metrics[commonseries.JoinNamespaceMetric("ns-1", "name")] = newMetricMetadata(metric.ID(2))
+	db2.rwMux.Unlock()
+
+	backend.EXPECT().dropMetric("ns-1", "name").Return(metric.EmptyMetricID, fmt.Errorf("err"))
+	metricID, err := db.DropMetric("ns-1", "name")
+	assert.Error(t, err)
+	assert.Equal(t, metric.EmptyMetricID, metricID)
+	_, ok := db2.getMetricMetadataFromCache("ns-1", "name")
+	assert.True(t, ok)
+
+	backend.EXPECT().dropMetric("ns-1", "name").Return(metric.ID(2), nil)
+	metricID, err = db.DropMetric("ns-1", "name")
+	assert.NoError(t, err)
+	assert.Equal(t, metric.ID(2), metricID)
+	_, ok = db2.getMetricMetadataFromCache("ns-1", "name")
+	assert.False(t, ok)
+}
+
 func TestMetadataDatabase_GenFieldID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -615,6 +647,15 @@ func TestMetadataDatabase_GenFieldID(t *testing.T) {
 				err error
 			}{id: field.EmptyFieldID, err: series.ErrFieldTypeUnspecified},
 		},
+		{
+			name:       "metric not in cache",
+			metricName: "dropped",
+			f:          field.Meta{Name: "sum", Type: field.SumField},
+			out: struct {
+				id  field.ID
+				err error
+			}{id: field.EmptyFieldID, err: fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, "dropped")},
+		},
 		{
 			name:       "wrong field type",
 			metricName: "cache",
@@ -724,6 +765,14 @@ func TestMetadataDatabase_GenTagKeyID(t *testing.T) {
 			err error
 		}
 	}{
+		{
+			name:       "metric not in cache",
+			metricName: "dropped",
+			out: struct {
+				id  tag.KeyID
+				err error
+			}{id: tag.EmptyTagKeyID, err: fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, "dropped")},
+		},
 		{
 			name:       "get tag memory cache",
 			metricName: "cache",
@@ -817,3 +866,19 @@ func newMockMetadataDatabase(t *testing.T, dir string) MetadataDatabase {
 
 	return db
 }
+
+func TestMetadataDatabase_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createMetadataBackendFn = newMetadataBackend
+
+		ctrl.Finish()
+	}()
+	mockBackend := NewMockMetadataBackend(ctrl)
+	createMetadataBackendFn = func(parent string) (backend MetadataBackend, err error) {
+		return mockBackend, nil
+	}
+	mockBackend.EXPECT().backup("backup").Return(nil)
+	db := newMockMetadataDatabase(t, t.TempDir())
+	assert.NoError(t, db.Backup("backup"))
+}
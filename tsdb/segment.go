@@ -25,6 +25,7 @@ import (
 
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
@@ -45,6 +46,9 @@ type Segment interface {
 	NeedEvict() bool
 	// EvictFamily evicts data family.
 	EvictFamily(familyTime int64)
+	// Backup backups the persisted data of segment into target path,
+	// returns the replica sequence of data families in backup.
+	Backup(path string) ([]*models.FamilyBackup, error)
 	// Close closes segment, include kv store.
 	Close()
 }
@@ -187,6 +191,35 @@ func (s *segment) GetOrCreateDataFamily(timestamp int64) (DataFamily, error) {
 	return dataFamily, nil
 }
 
+// Backup backups the persisted data of segment into target path,
+// returns the replica sequence of data families in backup.
+func (s *segment) Backup(path string) ([]*models.FamilyBackup, error) {
+	// hold read lock, prevent closing kv store when backup
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	sequences, err := s.kvStore.Backup(path)
+	if err != nil {
+		return nil, err
+	}
+	calc := s.interval.Calculator()
+	var families []*models.FamilyBackup
+	for familyName, familySequences := range sequences {
+		familyTime, err := strconv.Atoi(familyName)
+		if err != nil {
+			continue
+		}
+		families = append(families, &models.FamilyBackup{
+			FamilyTime: calc.CalcFamilyStartTime(s.baseTime, familyTime),
+			Sequences:  familySequences,
+		})
+	}
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].FamilyTime < families[j].FamilyTime
+	})
+	return families, nil
+}
+
 // Close closes segment, include kv store.
 func (s *segment) Close() {
 	s.mutex.Lock()
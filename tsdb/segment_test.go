@@ -358,3 +358,30 @@ func TestSegment_NeedEvict(t *testing.T) {
 	assert.True(t, s.NeedEvict())
 	s.EvictFamily(timeutil.Now())
 }
+
+func TestSegment_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := kv.NewMockStore(ctrl)
+	interval := timeutil.Interval(10 * timeutil.OneSecond)
+	baseTime, _ := interval.Calculator().ParseSegmentTime("20190904")
+	s := &segment{interval: interval, baseTime: baseTime, kvStore: store}
+
+	store.EXPECT().Backup("backup").Return(nil, fmt.Errorf("err"))
+	families, err := s.Backup("backup")
+	assert.Error(t, err)
+	assert.Nil(t, families)
+
+	store.EXPECT().Backup("backup").Return(map[string]map[int32]int64{
+		"12":  {1: 100},
+		"2":   {1: 10, 2: 20},
+		"abc": {1: 1},
+	}, nil)
+	families, err = s.Backup("backup")
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.FamilyBackup{
+		{FamilyTime: baseTime + 2*timeutil.OneHour, Sequences: map[int32]int64{1: 10, 2: 20}},
+		{FamilyTime: baseTime + 12*timeutil.OneHour, Sequences: map[int32]int64{1: 100}},
+	}, families)
+}
@@ -0,0 +1,159 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+)
+
+// filterDeletedSeries removes deleted series from filter result sets, drops the result set if all series deleted.
+// the series which partial data deleted is kept, its deleted data is skipped when loading.
+func filterDeletedSeries(resultSet []flow.FilterResultSet, deletedSeries *indexdb.DeletedSeries) []flow.FilterResultSet {
+	var rs []flow.FilterResultSet
+	for _, r := range resultSet {
+		if r == nil {
+			rs = append(rs, r)
+			continue
+		}
+		seriesIDs := roaring.AndNot(r.SeriesIDs(), deletedSeries.FullSeriesIDs())
+		if seriesIDs.IsEmpty() {
+			r.Close()
+			continue
+		}
+		rs = append(rs, &seriesDeletionResultSet{
+			FilterResultSet: r,
+			seriesIDs:       seriesIDs,
+			deletedSeries:   deletedSeries,
+		})
+	}
+	return rs
+}
+
+// seriesDeletionResultSet represents the filter result set which hides the deleted series.
+type seriesDeletionResultSet struct {
+	flow.FilterResultSet
+	seriesIDs     *roaring.Bitmap
+	deletedSeries *indexdb.DeletedSeries
+}
+
+// SeriesIDs returns the series ids which not deleted.
+func (rs *seriesDeletionResultSet) SeriesIDs() *roaring.Bitmap {
+	return rs.seriesIDs
+}
+
+// Load loads the data from storage, skips the deleted series data when loading.
+func (rs *seriesDeletionResultSet) Load(ctx *flow.DataLoadContext) flow.DataLoader {
+	loader := rs.FilterResultSet.Load(ctx)
+	if loader == nil {
+		return nil
+	}
+	return &seriesDeletionLoader{
+		loader:        loader,
+		deletedSeries: rs.deletedSeries,
+	}
+}
+
+// seriesDeletionLoader represents the data loader which skips the deleted series data.
+type seriesDeletionLoader struct {
+	loader        flow.DataLoader
+	deletedSeries *indexdb.DeletedSeries
+}
+
+// Load loads the metric data by given low series id, deleted series data will not do down sampling.
+func (l *seriesDeletionLoader) Load(ctx *flow.DataLoadContext) {
+	downSampling := ctx.DownSampling
+	defer func() {
+		ctx.DownSampling = downSampling
+	}()
+	highKey := uint32(ctx.SeriesIDHighKey) << 16
+	deletedSeriesIDs := l.deletedSeries.SeriesIDs()
+	fullSeriesIDs := l.deletedSeries.FullSeriesIDs()
+	getter := &seriesDeletionValueGetter{deletedSeries: l.deletedSeries}
+	ctx.DownSampling = func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, valueGetter encoding.TSDValueGetter) {
+		seriesID := highKey | uint32(ctx.MinSeriesID+seriesIdx)
+		if !deletedSeriesIDs.Contains(seriesID) {
+			downSampling(slotRange, seriesIdx, fieldIdx, valueGetter)
+			return
+		}
+		if fullSeriesIDs.Contains(seriesID) {
+			return
+		}
+		getter.seriesID = seriesID
+		getter.getter = valueGetter
+		downSampling(slotRange, seriesIdx, fieldIdx, getter)
+	}
+	l.loader.Load(ctx)
+}
+
+// seriesDeletionValueGetter represents the value getter which hides the deleted data of series.
+type seriesDeletionValueGetter struct {
+	deletedSeries *indexdb.DeletedSeries
+	seriesID      uint32
+	getter        encoding.TSDValueGetter
+}
+
+// GetValue returns value by time slot, returns false if data of slot deleted.
+func (g *seriesDeletionValueGetter) GetValue(slot uint16) (float64, bool) {
+	if g.deletedSeries.IsDeleted(g.seriesID, slot) {
+		return 0, false
+	}
+	return g.getter.GetValue(slot)
+}
+
+// familyTombstone implements metricsdata.SeriesTombstone, finds the deleted series of family from shard's index database.
+type familyTombstone struct {
+	shard      Shard
+	familyTime int64
+	interval   timeutil.Interval
+	timeRange  timeutil.TimeRange
+}
+
+// newFamilyTombstone creates the tombstone of data family.
+func newFamilyTombstone(
+	shard Shard,
+	familyTime int64,
+	interval timeutil.Interval,
+	timeRange timeutil.TimeRange,
+) metricsdata.SeriesTombstone {
+	return &familyTombstone{
+		shard:      shard,
+		familyTime: familyTime,
+		interval:   interval,
+		timeRange:  timeRange,
+	}
+}
+
+// IsEmpty returns if family has not any deleted series.
+func (t *familyTombstone) IsEmpty() bool {
+	return !t.shard.IndexDatabase().HasTombstone(t.timeRange)
+}
+
+// GetDeletedSeries returns the deleted series of metric, returns nil if not found.
+func (t *familyTombstone) GetDeletedSeries(metricID uint32) metricsdata.DeletedSeries {
+	if deletedSeries := t.shard.IndexDatabase().GetDeletedSeries(metric.ID(metricID), t.familyTime, t.interval); deletedSeries != nil {
+		return deletedSeries
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+// seriesDeletionFilter finds the series ids of shard matched by the condition of delete series statement.
+type seriesDeletionFilter struct {
+	namespace  string
+	metricName string
+	metadata   metadb.Metadata
+	indexDB    indexdb.IndexDatabase
+}
+
+// newSeriesDeletionFilter creates a series deletion filter for shard.
+func newSeriesDeletionFilter(namespace, metricName string, metadata metadb.Metadata, indexDB indexdb.IndexDatabase) *seriesDeletionFilter {
+	return &seriesDeletionFilter{
+		namespace:  namespace,
+		metricName: metricName,
+		metadata:   metadata,
+		indexDB:    indexDB,
+	}
+}
+
+// findSeriesIDs finds series ids by condition, if tag key not exist, the tag filter matches nothing.
+func (f *seriesDeletionFilter) findSeriesIDs(condition stmt.Expr) (*roaring.Bitmap, error) {
+	_, seriesIDs, err := f.findSeriesIDsByExpr(condition)
+	return seriesIDs, err
+}
+
+// findSeriesIDsByExpr finds series ids by expr, recursion filter for expr.
+func (f *seriesDeletionFilter) findSeriesIDsByExpr(condition stmt.Expr) (tag.KeyID, *roaring.Bitmap, error) {
+	switch expr := condition.(type) {
+	case stmt.TagFilter:
+		tagKeyID, err := f.metadata.MetadataDatabase().GetTagKeyID(f.namespace, f.metricName, expr.TagKey())
+		if errors.Is(err, constants.ErrNotFound) {
+			return tag.EmptyTagKeyID, roaring.New(), nil
+		}
+		if err != nil {
+			return tag.EmptyTagKeyID, nil, err
+		}
+		tagValueIDs, err := f.metadata.TagMetadata().FindTagValueDsByExpr(tagKeyID, expr)
+		if err != nil {
+			return tagKeyID, nil, err
+		}
+		if tagValueIDs == nil || tagValueIDs.IsEmpty() {
+			return tagKeyID, roaring.New(), nil
+		}
+		seriesIDs, err := f.indexDB.GetSeriesIDsByTagValueIDs(tagKeyID, tagValueIDs)
+		if err != nil {
+			return tagKeyID, nil, err
+		}
+		return tagKeyID, seriesIDs, nil
+	case *stmt.ParenExpr:
+		return f.findSeriesIDsByExpr(expr.Expr)
+	case *stmt.NotExpr:
+		tagKeyID, matchResult, err := f.findSeriesIDsByExpr(expr.Expr)
+		if err != nil {
+			return tag.EmptyTagKeyID, nil, err
+		}
+		if tagKeyID == tag.EmptyTagKeyID {
+			return tag.EmptyTagKeyID, roaring.New(), nil
+		}
+		// get all series ids for tag key, then remove matched series ids
+		all, err := f.indexDB.GetSeriesIDsForTag(tagKeyID)
+		if err != nil {
+			return tag.EmptyTagKeyID, nil, err
+		}
+		return tag.EmptyTagKeyID, roaring.AndNot(all, matchResult), nil
+	case *stmt.BinaryExpr:
+		if expr.Operator != stmt.AND && expr.Operator != stmt.OR {
+			return tag.EmptyTagKeyID, nil, fmt.Errorf("wrong binary operator in tag filter: %s", stmt.BinaryOPString(expr.Operator))
+		}
+		_, left, err := f.findSeriesIDsByExpr(expr.Left)
+		if err != nil {
+			return tag.EmptyTagKeyID, nil, err
+		}
+		_, right, err := f.findSeriesIDsByExpr(expr.Right)
+		if err != nil {
+			return tag.EmptyTagKeyID, nil, err
+		}
+		if expr.Operator == stmt.AND {
+			return tag.EmptyTagKeyID, roaring.FastAnd(left, right), nil
+		}
+		return tag.EmptyTagKeyID, roaring.FastOr(left, right), nil
+	}
+	return tag.EmptyTagKeyID, roaring.New(), nil
+}
@@ -0,0 +1,166 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+func TestSeriesDeletionFilter_findSeriesIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	tagMeta := metadb.NewMockTagMetadata(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	metadata.EXPECT().TagMetadata().Return(tagMeta).AnyTimes()
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	filter := newSeriesDeletionFilter("ns", "cpu", metadata, indexDB)
+
+	host := &stmt.EqualsExpr{Key: "host", Value: "1.1.1.1"}
+	zone := &stmt.EqualsExpr{Key: "zone", Value: "sh"}
+	mockTagFilter := func() {
+		metadataDB.EXPECT().GetTagKeyID("ns", "cpu", "host").Return(tag.KeyID(1), nil).AnyTimes()
+		metadataDB.EXPECT().GetTagKeyID("ns", "cpu", "zone").Return(tag.KeyID(2), nil).AnyTimes()
+		tagMeta.EXPECT().FindTagValueDsByExpr(tag.KeyID(1), gomock.Any()).Return(roaring.BitmapOf(1), nil).AnyTimes()
+		tagMeta.EXPECT().FindTagValueDsByExpr(tag.KeyID(2), gomock.Any()).Return(roaring.BitmapOf(2), nil).AnyTimes()
+		indexDB.EXPECT().GetSeriesIDsByTagValueIDs(tag.KeyID(1), gomock.Any()).Return(roaring.BitmapOf(1, 2, 3), nil).AnyTimes()
+		indexDB.EXPECT().GetSeriesIDsByTagValueIDs(tag.KeyID(2), gomock.Any()).Return(roaring.BitmapOf(3, 4), nil).AnyTimes()
+	}
+
+	cases := []struct {
+		name      string
+		condition stmt.Expr
+		prepare   func()
+		seriesIDs []uint32
+		wantErr   bool
+	}{
+		{
+			name:      "tag key not found",
+			condition: host,
+			prepare: func() {
+				metadataDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), gomock.Any()).Return(tag.EmptyTagKeyID, constants.ErrNotFound)
+			},
+		},
+		{
+			name:      "get tag key failure",
+			condition: host,
+			prepare: func() {
+				metadataDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), gomock.Any()).Return(tag.EmptyTagKeyID, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "find tag value ids failure",
+			condition: host,
+			prepare: func() {
+				metadataDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), gomock.Any()).Return(tag.KeyID(1), nil)
+				tagMeta.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "tag value not found",
+			condition: host,
+			prepare: func() {
+				metadataDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), gomock.Any()).Return(tag.KeyID(1), nil)
+				tagMeta.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name:      "get series ids failure",
+			condition: host,
+			prepare: func() {
+				metadataDB.EXPECT().GetTagKeyID(gomock.Any(), gomock.Any(), gomock.Any()).Return(tag.KeyID(1), nil)
+				tagMeta.EXPECT().FindTagValueDsByExpr(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1), nil)
+				indexDB.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "not expr, get series ids for tag failure",
+			condition: &stmt.NotExpr{Expr: host},
+			prepare: func() {
+				mockTagFilter()
+				indexDB.EXPECT().GetSeriesIDsForTag(tag.KeyID(1)).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "not expr",
+			condition: &stmt.NotExpr{Expr: &stmt.ParenExpr{Expr: host}},
+			prepare: func() {
+				mockTagFilter()
+				indexDB.EXPECT().GetSeriesIDsForTag(tag.KeyID(1)).Return(roaring.BitmapOf(1, 2, 3, 5, 6), nil)
+			},
+			seriesIDs: []uint32{5, 6},
+		},
+		{
+			name:      "and expr",
+			condition: &stmt.BinaryExpr{Left: host, Operator: stmt.AND, Right: zone},
+			prepare:   mockTagFilter,
+			seriesIDs: []uint32{3},
+		},
+		{
+			name:      "or expr",
+			condition: &stmt.BinaryExpr{Left: host, Operator: stmt.OR, Right: zone},
+			prepare:   mockTagFilter,
+			seriesIDs: []uint32{1, 2, 3, 4},
+		},
+		{
+			name:      "wrong binary operator",
+			condition: &stmt.BinaryExpr{Left: host, Operator: stmt.ADD, Right: zone},
+			wantErr:   true,
+		},
+		{
+			name:      "unknown expr",
+			condition: &stmt.FieldExpr{Name: "f"},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			seriesIDs, err := filter.findSeriesIDs(tt.condition)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if len(tt.seriesIDs) == 0 {
+				assert.True(t, seriesIDs.IsEmpty())
+			} else {
+				assert.Equal(t, tt.seriesIDs, seriesIDs.ToArray())
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb/indexdb"
+)
+
+func TestSeriesDeletionResultSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rs := flow.NewMockFilterResultSet(ctrl)
+	rs.EXPECT().SeriesIDs().Return(roaring.BitmapOf(1, 2, 3, 65537))
+	deletedSeries := indexdb.NewDeletedSeries(359)
+	deletedSeries.Add(timeutil.SlotRange{Start: 0, End: 359}, roaring.BitmapOf(2, 65537))
+	deletedSeries.Add(timeutil.SlotRange{Start: 5, End: 10}, roaring.BitmapOf(3))
+	resultSet := filterDeletedSeries([]flow.FilterResultSet{nil, rs}, deletedSeries)
+	assert.Len(t, resultSet, 2)
+	assert.Nil(t, resultSet[0])
+	deletionRS := resultSet[1]
+	// series 3 partial deleted
+	assert.Equal(t, []uint32{1, 3}, deletionRS.SeriesIDs().ToArray())
+
+	// loader not found
+	rs.EXPECT().Load(gomock.Any()).Return(nil)
+	assert.Nil(t, deletionRS.Load(&flow.DataLoadContext{}))
+
+	loader := flow.NewMockDataLoader(ctrl)
+	rs.EXPECT().Load(gomock.Any()).Return(loader)
+	var loaded []uint16
+	var slots []uint16
+	ctx := &flow.DataLoadContext{
+		MinSeriesID:     1,
+		SeriesIDHighKey: 0,
+		DownSampling: func(slotRange timeutil.SlotRange, seriesIdx uint16, _ int, getter encoding.TSDValueGetter) {
+			loaded = append(loaded, seriesIdx)
+			for slot := slotRange.Start; slot <= slotRange.End; slot++ {
+				if _, ok := getter.GetValue(slot); ok {
+					slots = append(slots, slot)
+				}
+			}
+		},
+	}
+	valueGetter := encoding.NewMockTSDValueGetter(ctrl)
+	valueGetter.EXPECT().GetValue(gomock.Any()).Return(1.0, true).AnyTimes()
+	loader.EXPECT().Load(gomock.Any()).DoAndReturn(func(ctx *flow.DataLoadContext) {
+		ctx.DownSampling(timeutil.SlotRange{Start: 4, End: 4}, 0, 0, valueGetter)  // series id: 1
+		ctx.DownSampling(timeutil.SlotRange{Start: 4, End: 11}, 1, 0, valueGetter) // series id: 2, deleted
+		ctx.DownSampling(timeutil.SlotRange{Start: 4, End: 11}, 2, 0, valueGetter) // series id: 3, slot[5,10] deleted
+	})
+	deletionRS.Load(ctx).Load(ctx)
+	assert.Equal(t, []uint16{0, 2}, loaded)
+	assert.Equal(t, []uint16{4, 4, 11}, slots)
+	assert.NotNil(t, ctx.DownSampling)
+}
+
+func TestFamilyTombstone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	timeRange := timeutil.TimeRange{Start: 10, End: 100}
+	interval := timeutil.Interval(10 * timeutil.OneSecond)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	tombstone := newFamilyTombstone(shard, 10, interval, timeRange)
+	indexDB.EXPECT().HasTombstone(timeRange).Return(false)
+	assert.True(t, tombstone.IsEmpty())
+	deletedSeries := indexdb.NewDeletedSeries(359)
+	deletedSeries.Add(timeutil.SlotRange{Start: 1, End: 2}, roaring.BitmapOf(1))
+	indexDB.EXPECT().GetDeletedSeries(metric.ID(10), int64(10), interval).Return(deletedSeries)
+	assert.Equal(t, []uint32{1}, tombstone.GetDeletedSeries(10).SeriesIDs().ToArray())
+	indexDB.EXPECT().GetDeletedSeries(metric.ID(10), int64(10), interval).Return(nil)
+	assert.Nil(t, tombstone.GetDeletedSeries(10))
+}
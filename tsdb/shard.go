@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"sync"
@@ -78,6 +79,19 @@ type Shard interface {
 	EvictSegment()
 	// notifyLimitsChange notifies the limits changed.
 	notifyLimitsChange()
+	// Backup backups the persisted data/index of shard into target path,
+	// returns the replica sequence of data families for writable interval.
+	Backup(path string) (*models.ShardBackup, error)
+	// Export exports the persisted data of writable interval as rows by data family,
+	// returns the replica sequence of data families which exported.
+	Export(fn ExportFunc) ([]*models.FamilyBackup, error)
+	// ExportFamily exports the persisted data of data family as rows, returns the replica sequence of data family.
+	ExportFamily(family DataFamily, fn ExportFunc) (*models.FamilyBackup, error)
+	// Import writes the rows block exported by other replica into data family.
+	Import(familyTime int64, rowsBlock []byte) (int, error)
+	// DigestFamily computes the digest of persisted data in data family by metric,
+	// which is used for comparing the data between replicas.
+	DigestFamily(family DataFamily) (*models.FamilyDigest, error)
 	// Closer releases shard's resource, such as flush data, spawned goroutines etc.
 	io.Closer
 }
@@ -422,7 +436,8 @@ func (s *shard) WaitFlushIndexCompleted() {
 	s.flushCondition.L.Unlock()
 }
 
-// TTL expires the data of each segment base on time to live.
+// TTL expires the data of each segment base on time to live,
+// then removes the tombstones which all deleted data expired.
 func (s *shard) TTL() {
 	for interval, rollupSegment := range s.rollupTargets {
 		if err := rollupSegment.TTL(); err != nil {
@@ -434,6 +449,23 @@ func (s *shard) TTL() {
 			)
 		}
 	}
+	expireTime := models.GetSeriesDeletionExpireTime(s.option.MaxRetention(), timeutil.Now())
+	removed, err := s.indexDB.RemoveTombstones(expireTime)
+	if err != nil {
+		s.logger.Warn("remove expired tombstones failure",
+			logger.String("database", s.db.Name()),
+			logger.Any("shardID", s.id),
+			logger.Error(err),
+		)
+		return
+	}
+	if removed > 0 {
+		s.logger.Info("remove expired tombstones successfully",
+			logger.String("database", s.db.Name()),
+			logger.Any("shardID", s.id),
+			logger.Int("tombstones", removed),
+		)
+	}
 }
 
 // EvictSegment evicts segment which long term no read operation.
@@ -443,6 +475,41 @@ func (s *shard) EvictSegment() {
 	}
 }
 
+// Backup backups the persisted data/index of shard into target path,
+// returns the replica sequence of data families for writable interval.
+// NOTICE: backup data before index, make sure index includes all series of data in backup.
+func (s *shard) Backup(path string) (*models.ShardBackup, error) {
+	backup := &models.ShardBackup{ShardID: s.id}
+	// 1. backup data of all interval segments(include rollup segments)
+	for interval, segment := range s.rollupTargets {
+		families, err := segment.Backup(filepath.Join(path, segmentDir, interval.Type().String()))
+		if err != nil {
+			return nil, err
+		}
+		if interval == s.interval {
+			// only writable interval's families have replica sequence
+			backup.Families = families
+		}
+	}
+	// 2. flush index, make sure index which generated before data backup persisted
+	s.WaitFlushIndexCompleted()
+	if err := s.FlushIndex(); err != nil {
+		return nil, err
+	}
+	// 3. backup index store and series id mapping/tombstones
+	if _, err := s.indexStore.Backup(filepath.Join(path, indexParentDir)); err != nil {
+		return nil, err
+	}
+	if err := s.indexDB.Backup(filepath.Join(path, metaDir)); err != nil {
+		return nil, err
+	}
+	s.logger.Info("backup shard successfully",
+		logger.String("database", s.db.Name()),
+		logger.Any("shardID", s.id),
+		logger.String("path", path))
+	return backup, nil
+}
+
 // initIndexDatabase initializes the index database
 func (s *shard) initIndexDatabase() error {
 	var err error
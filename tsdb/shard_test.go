@@ -22,6 +22,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -733,14 +734,27 @@ func TestShard_TTL(t *testing.T) {
 	db := NewMockDatabase(ctrl)
 	db.EXPECT().Name().Return("test").AnyTimes()
 	segment := NewMockIntervalSegment(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
 	s := &shard{
 		rollupTargets: map[timeutil.Interval]IntervalSegment{
 			10: segment,
 		},
-		db:     db,
+		db:      db,
+		indexDB: indexDB,
+		option: &option.DatabaseOption{Intervals: option.Intervals{
+			{Interval: 10, Retention: timeutil.Interval(timeutil.OneDay)},
+		}},
 		logger: logger.GetLogger("TSDB", "Test"),
 	}
-	segment.EXPECT().TTL().Return(fmt.Errorf("err"))
+	segment.EXPECT().TTL().Return(fmt.Errorf("err")).Times(3)
+	indexDB.EXPECT().RemoveTombstones(gomock.Any()).DoAndReturn(func(expireTime int64) (int, error) {
+		assert.True(t, expireTime <= timeutil.Now()-timeutil.OneDay-2*timeutil.OneHour)
+		return 0, fmt.Errorf("err")
+	})
+	s.TTL()
+	indexDB.EXPECT().RemoveTombstones(gomock.Any()).Return(0, nil)
+	s.TTL()
+	indexDB.EXPECT().RemoveTombstones(gomock.Any()).Return(1, nil)
 	s.TTL()
 }
 
@@ -771,3 +785,107 @@ func mockBatchRows(m *protoMetricsV1.Metric) []metric.StorageRow {
 	br.UnmarshalRows(buf.Bytes())
 	return br.Rows()
 }
+
+func TestShard_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	index := indexdb.NewMockIndexDatabase(ctrl)
+	indexStore := kv.NewMockStore(ctrl)
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	segment := NewMockIntervalSegment(ctrl)
+	rollupSegment := NewMockIntervalSegment(ctrl)
+	interval := timeutil.Interval(10 * timeutil.OneSecond)
+	rollupInterval := timeutil.Interval(5 * timeutil.OneMinute)
+	s := &shard{
+		id:       1,
+		interval: interval,
+		rollupTargets: map[timeutil.Interval]IntervalSegment{
+			interval:       segment,
+			rollupInterval: rollupSegment,
+		},
+		indexDB:        index,
+		indexStore:     indexStore,
+		db:             db,
+		flushCondition: sync.NewCond(&sync.Mutex{}),
+		statistics:     metrics.NewShardStatistics("data", "1"),
+		logger:         logger.GetLogger("TSDB", "Test"),
+	}
+	families := []*models.FamilyBackup{{FamilyTime: 10, Sequences: map[int32]int64{1: 10}}}
+	segmentPath := filepath.Join("backup", segmentDir, interval.Type().String())
+	rollupSegmentPath := filepath.Join("backup", segmentDir, rollupInterval.Type().String())
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "backup segment failure",
+			prepare: func() {
+				s.rollupTargets = map[timeutil.Interval]IntervalSegment{interval: segment}
+				segment.EXPECT().Backup(segmentPath).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "flush index failure",
+			prepare: func() {
+				segment.EXPECT().Backup(segmentPath).Return(families, nil)
+				rollupSegment.EXPECT().Backup(rollupSegmentPath).Return(nil, nil)
+				index.EXPECT().Flush().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "backup index store failure",
+			prepare: func() {
+				segment.EXPECT().Backup(segmentPath).Return(families, nil)
+				rollupSegment.EXPECT().Backup(rollupSegmentPath).Return(nil, nil)
+				index.EXPECT().Flush().Return(nil)
+				indexStore.EXPECT().Backup(filepath.Join("backup", indexParentDir)).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "backup index database failure",
+			prepare: func() {
+				segment.EXPECT().Backup(segmentPath).Return(families, nil)
+				rollupSegment.EXPECT().Backup(rollupSegmentPath).Return(nil, nil)
+				index.EXPECT().Flush().Return(nil)
+				indexStore.EXPECT().Backup(filepath.Join("backup", indexParentDir)).Return(nil, nil)
+				index.EXPECT().Backup(filepath.Join("backup", metaDir)).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "backup successfully",
+			prepare: func() {
+				segment.EXPECT().Backup(segmentPath).Return(families, nil)
+				rollupSegment.EXPECT().Backup(rollupSegmentPath).Return(nil, nil)
+				index.EXPECT().Flush().Return(nil)
+				indexStore.EXPECT().Backup(filepath.Join("backup", indexParentDir)).Return(nil, nil)
+				index.EXPECT().Backup(filepath.Join("backup", metaDir)).Return(nil)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				s.rollupTargets = map[timeutil.Interval]IntervalSegment{
+					interval:       segment,
+					rollupInterval: rollupSegment,
+				}
+			}()
+			tt.prepare()
+			backup, err := s.Backup("backup")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Backup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.Equal(t, &models.ShardBackup{ShardID: 1, Families: families}, backup)
+			}
+		})
+	}
+}
@@ -35,6 +35,23 @@ func init() {
 	kv.RegisterMerger(MetricDataMerger, NewMerger)
 }
 
+// SeriesTombstone represents the deleted series of family, merger purges the deleted series data when doing compaction.
+type SeriesTombstone interface {
+	kv.Tombstone
+	// GetDeletedSeries returns the deleted series of metric, returns nil if not found.
+	GetDeletedSeries(metricID uint32) DeletedSeries
+}
+
+// DeletedSeries represents the deleted series data of family.
+type DeletedSeries interface {
+	// SeriesIDs returns the series ids which has deleted data.
+	SeriesIDs() *roaring.Bitmap
+	// FullSeriesIDs returns the series ids which all data deleted.
+	FullSeriesIDs() *roaring.Bitmap
+	// IsDeleted returns if the data of series at slot is deleted.
+	IsDeleted(seriesID uint32, slot uint16) bool
+}
+
 type mergerContext struct {
 	scanners     []*dataScanner
 	seriesIDs    *roaring.Bitmap // target series ids
@@ -43,6 +60,14 @@ type mergerContext struct {
 	targetRange, sourceRange timeutil.SlotRange
 	ratio                    uint16
 	baseSlot                 uint16
+
+	deletedSeries DeletedSeries // deleted series data of family, purges it when merging
+	seriesID      uint32        // current merging series id
+}
+
+// isDeleted returns if the data of current merging series at target slot is deleted.
+func (ctx *mergerContext) isDeleted(slot uint16) bool {
+	return ctx.deletedSeries.IsDeleted(ctx.seriesID, slot)
 }
 
 // merger implements kv.Merger for merging series data for each metric
@@ -50,6 +75,7 @@ type merger struct {
 	dataFlusher  Flusher
 	seriesMerger SeriesMerger
 	rollup       kv.Rollup
+	tombstone    SeriesTombstone
 }
 
 // NewMerger creates a metric data merger
@@ -64,11 +90,17 @@ func NewMerger(flusher kv.Flusher) (kv.Merger, error) {
 	}, nil
 }
 
-// Init initializes metric data merger, if rollup context exist do rollup job, else do compact job
+// Init initializes metric data merger, if rollup context exist do rollup job, else do compact job,
+// if tombstone context exist, purges the deleted series.
 func (m *merger) Init(params map[string]interface{}) {
 	if rollupCtx, ok := params[kv.RollupContext]; ok {
 		m.rollup = rollupCtx.(kv.Rollup)
 	}
+	if tombstoneCtx, ok := params[kv.TombstoneContext]; ok {
+		if tombstone, ok := tombstoneCtx.(SeriesTombstone); ok {
+			m.tombstone = tombstone
+		}
+	}
 }
 
 // Merge merges the multi metric data into one target metric data for same metric id
@@ -79,6 +111,17 @@ func (m *merger) Merge(key uint32, metricBlocks [][]byte) error {
 	if err != nil {
 		return err
 	}
+	// purge deleted series, the series which partial data deleted is purged when merging series
+	if m.tombstone != nil {
+		if deletedSeries := m.tombstone.GetDeletedSeries(key); deletedSeries != nil {
+			mergeCtx.seriesIDs.AndNot(deletedSeries.FullSeriesIDs())
+			mergeCtx.deletedSeries = deletedSeries
+		}
+		if mergeCtx.seriesIDs.IsEmpty() {
+			// all series deleted, drop metric data
+			return nil
+		}
+	}
 	// 2. Prepare metric
 	m.dataFlusher.PrepareMetric(key, mergeCtx.targetFields)
 	// 3. merge series data by roaring container
@@ -95,6 +138,7 @@ func (m *merger) Merge(key uint32, metricBlocks [][]byte) error {
 		it := container.PeekableIterator()
 		for it.HasNext() {
 			lowSeriesID := it.Next()
+			mergeCtx.seriesID = encoding.ValueWithHighLowBits(uint32(highKey)<<16, lowSeriesID)
 			// maybe series id not exist in some values block
 			for blockIdx, scanner := range mergeCtx.scanners {
 				seriesEntry := scanner.scan(highKey, lowSeriesID)
@@ -112,7 +156,7 @@ func (m *merger) Merge(key uint32, metricBlocks [][]byte) error {
 				return err
 			}
 			// flush series id
-			if err := m.dataFlusher.FlushSeries(encoding.ValueWithHighLowBits(uint32(highKey)<<16, lowSeriesID)); err != nil {
+			if err := m.dataFlusher.FlushSeries(mergeCtx.seriesID); err != nil {
 				return err
 			}
 		}
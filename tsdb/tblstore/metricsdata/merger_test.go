@@ -192,6 +192,97 @@ func TestMerger_Compact_Merge(t *testing.T) {
 	assert.Nil(t, nopFlusher.Bytes())
 }
 
+type mockSeriesTombstone struct {
+	deletedSeries map[uint32]*mockDeletedSeries
+}
+
+func (t *mockSeriesTombstone) IsEmpty() bool {
+	return len(t.deletedSeries) == 0
+}
+
+func (t *mockSeriesTombstone) GetDeletedSeries(metricID uint32) DeletedSeries {
+	if deletedSeries, ok := t.deletedSeries[metricID]; ok {
+		return deletedSeries
+	}
+	return nil
+}
+
+type mockDeletedSeries struct {
+	fullSeriesIDs *roaring.Bitmap
+	deletedSlots  map[uint32]timeutil.SlotRange
+}
+
+func (ds *mockDeletedSeries) SeriesIDs() *roaring.Bitmap {
+	seriesIDs := ds.fullSeriesIDs.Clone()
+	for seriesID := range ds.deletedSlots {
+		seriesIDs.Add(seriesID)
+	}
+	return seriesIDs
+}
+
+func (ds *mockDeletedSeries) FullSeriesIDs() *roaring.Bitmap {
+	return ds.fullSeriesIDs
+}
+
+func (ds *mockDeletedSeries) IsDeleted(seriesID uint32, slot uint16) bool {
+	if ds.fullSeriesIDs.Contains(seriesID) {
+		return true
+	}
+	slotRange, ok := ds.deletedSlots[seriesID]
+	return ok && slot >= slotRange.Start && slot <= slotRange.End
+}
+
+func TestMerger_Compact_Tombstone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	flusher := NewMockFlusher(ctrl)
+	seriesMerger := NewMockSeriesMerger(ctrl)
+	merge, _ := NewMerger(kv.NewNopFlusher())
+	m := merge.(*merger)
+	m.dataFlusher = flusher
+	m.seriesMerger = seriesMerger
+	merge.Init(map[string]interface{}{kv.TombstoneContext: &mockSeriesTombstone{
+		deletedSeries: map[uint32]*mockDeletedSeries{
+			1: {fullSeriesIDs: roaring.BitmapOf(2, 20), deletedSlots: map[uint32]timeutil.SlotRange{4: {Start: 10, End: 10}}},
+			2: {fullSeriesIDs: roaring.BitmapOf(1, 2, 4)},
+		},
+	}})
+	assert.NotNil(t, m.tombstone)
+	// case 1: purge deleted series, series 4 partial deleted when merging series
+	flusher.EXPECT().PrepareMetric(uint32(1), gomock.Any())
+	var mergedSeries []uint32
+	seriesMerger.EXPECT().merge(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(mergeCtx *mergerContext, _ []*encoding.TSDDecoder, _ []FieldReader) error {
+			mergedSeries = append(mergedSeries, mergeCtx.seriesID)
+			assert.NotNil(t, mergeCtx.deletedSeries)
+			return nil
+		}).Times(2)
+	gomock.InOrder(
+		flusher.EXPECT().FlushSeries(uint32(1)),
+		flusher.EXPECT().FlushSeries(uint32(4)),
+		flusher.EXPECT().CommitMetric(timeutil.SlotRange{Start: 10, End: 15}).Return(nil),
+	)
+	err := merge.Merge(
+		1,
+		[][]byte{
+			mockMetricMergeBlock([]uint32{1, 2, 4}, 10, 10),
+			mockMetricMergeBlock([]uint32{2, 20}, 15, 15),
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 4}, mergedSeries)
+	// case 2: all series deleted, drop metric
+	err = merge.Merge(
+		2,
+		[][]byte{
+			mockMetricMergeBlock([]uint32{1, 2, 4}, 10, 10),
+		})
+	assert.NoError(t, err)
+	// case 3: tombstone not match
+	m.tombstone = nil
+	merge.Init(map[string]interface{}{kv.TombstoneContext: "tombstone"})
+	assert.Nil(t, m.tombstone)
+}
+
 func TestMerger_Rollup_Merge(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,107 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricsdata
+
+import (
+	"math"
+
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+)
+
+// SeriesData represents the decoded data of series in metric block,
+// Values[fieldIdx][slot-SlotRange.Start] is the value of field, NaN if value not exist.
+type SeriesData struct {
+	SeriesID  uint32
+	SlotRange timeutil.SlotRange
+	Fields    field.Metas
+	Values    [][]float64
+}
+
+// ScanSeriesData scans the data of all series in metric block, invokes fn for each series,
+// which is used for exporting data from data family(such as replica migration).
+// NOTICE: SeriesData will be reused after fn returns.
+func ScanSeriesData(metricBlock []byte, fn func(data *SeriesData) error) error {
+	reader, err := NewReader("scan_operation", metricBlock)
+	if err != nil {
+		return err
+	}
+	seriesIDs := reader.GetSeriesIDs()
+	if seriesIDs.IsEmpty() {
+		return nil
+	}
+	scanner, err := newDataScanner(reader)
+	if err != nil {
+		return err
+	}
+	slotRange := reader.GetTimeRange()
+	fields := reader.GetFields()
+	data := &SeriesData{
+		SlotRange: slotRange,
+		Fields:    fields,
+		Values:    make([][]float64, len(fields)),
+	}
+	numOfSlots := int(slotRange.End-slotRange.Start) + 1
+	for idx := range data.Values {
+		data.Values[idx] = make([]float64, numOfSlots)
+	}
+	decoder := encoding.GetTSDDecoder()
+	defer encoding.ReleaseTSDDecoder(decoder)
+
+	var fieldReader FieldReader
+	highKeys := seriesIDs.GetHighKeys()
+	for idx, highKey := range highKeys {
+		container := seriesIDs.GetContainerAtIndex(idx)
+		it := container.PeekableIterator()
+		for it.HasNext() {
+			lowSeriesID := it.Next()
+			seriesEntry := scanner.scan(highKey, lowSeriesID)
+			if len(seriesEntry) == 0 {
+				continue
+			}
+			if fieldReader == nil {
+				fieldReader = newFieldReader(scanner.fieldIndexes(), seriesEntry, slotRange)
+			} else {
+				fieldReader.Reset(seriesEntry, slotRange)
+			}
+			for fieldIdx, f := range fields {
+				values := data.Values[fieldIdx]
+				for i := range values {
+					values[i] = math.NaN()
+				}
+				fieldData := fieldReader.GetFieldData(f.ID)
+				if len(fieldData) == 0 {
+					continue
+				}
+				decoder.ResetWithTimeRange(fieldData, slotRange.Start, slotRange.End)
+				for i := range values {
+					if value, ok := decoder.GetValue(slotRange.Start + uint16(i)); ok {
+						values[i] = value
+					}
+				}
+			}
+			fieldReader.Close()
+			data.SeriesID = encoding.ValueWithHighLowBits(uint32(highKey)<<16, lowSeriesID)
+			if err := fn(data); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
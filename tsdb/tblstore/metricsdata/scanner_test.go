@@ -0,0 +1,65 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricsdata
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/series/field"
+)
+
+func TestScanSeriesData(t *testing.T) {
+	// case 1: bad block
+	err := ScanSeriesData([]byte{1, 2, 3}, func(_ *SeriesData) error { return nil })
+	assert.Error(t, err)
+	// case 2: scan all series
+	var seriesIDs []uint32
+	err = ScanSeriesData(mockRealMetricBlock([]uint32{1, 2, 65536 + 10}, 5, 10), func(data *SeriesData) error {
+		seriesIDs = append(seriesIDs, data.SeriesID)
+		assert.Equal(t, field.Metas{{ID: 2, Type: field.SumField}, {ID: 10, Type: field.MinField}}, data.Fields)
+		assert.Len(t, data.Values, 2)
+		for _, values := range data.Values {
+			assert.Len(t, values, 6)
+			for idx, value := range values {
+				assert.Equal(t, float64(5+idx), value)
+			}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 2, 65536 + 10}, seriesIDs)
+	// case 3: fn err
+	err = ScanSeriesData(mockRealMetricBlock([]uint32{1, 2}, 5, 10), func(_ *SeriesData) error {
+		return fmt.Errorf("err")
+	})
+	assert.Error(t, err)
+	// case 4: field data not exist
+	err = ScanSeriesData(mockMetricBlockForOneField(), func(data *SeriesData) error {
+		for _, values := range data.Values {
+			for _, value := range values {
+				assert.True(t, math.IsNaN(value) || value >= 0)
+			}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+}
@@ -51,6 +51,11 @@ func (sm *seriesMerger) merge(
 	streams []*encoding.TSDDecoder,
 	fieldReaders []FieldReader,
 ) error {
+	var skip func(slot uint16) bool
+	if mergeCtx.deletedSeries != nil && mergeCtx.deletedSeries.SeriesIDs().Contains(mergeCtx.seriesID) {
+		// skip the deleted data of series
+		skip = mergeCtx.isDeleted
+	}
 	for idx, f := range mergeCtx.targetFields {
 		fieldID := f.ID
 		encodeStream := sm.flusher.GetEncoder(idx)
@@ -77,7 +82,7 @@ func (sm *seriesMerger) merge(
 		// rollup merge: source range[5,182]=>target range[0,6], ratio:30, source interval:10s, target interval:5min
 		aggregation.DownSamplingMultiSeriesInto(
 			mergeCtx.targetRange, mergeCtx.ratio, mergeCtx.baseSlot,
-			f.Type, streams,
+			f.Type, streams, skip,
 			encodeStream.EmitDownSamplingValue,
 		)
 
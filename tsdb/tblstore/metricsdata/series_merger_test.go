@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/lindb/roaring"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/pkg/bit"
@@ -98,6 +99,36 @@ func TestSeriesMerger_compact_merge(t *testing.T) {
 		}
 	}
 	assert.Equal(t, 2, c)
+	// case 3: skip deleted data of series
+	reader1.EXPECT().GetFieldData(gomock.Any()).Return(mockField(10))
+	reader1.EXPECT().SlotRange().Return(timeutil.SlotRange{Start: 10, End: 10})
+	reader2.EXPECT().GetFieldData(gomock.Any()).Return(mockField(12))
+	reader2.EXPECT().SlotRange().Return(timeutil.SlotRange{Start: 12, End: 12})
+	flusher.EXPECT().FlushField(gomock.Any()).DoAndReturn(func(data []byte) error {
+		result = data
+		return nil
+	})
+	err = merger.merge(
+		&mergerContext{
+			targetFields: field.Metas{{ID: 1, Type: field.SumField}},
+			sourceRange:  timeutil.SlotRange{Start: 5, End: 15},
+			targetRange:  timeutil.SlotRange{Start: 5, End: 15},
+			ratio:        1,
+			seriesID:     1,
+			deletedSeries: &mockDeletedSeries{
+				fullSeriesIDs: roaring.New(),
+				deletedSlots:  map[uint32]timeutil.SlotRange{1: {Start: 5, End: 11}},
+			},
+		}, decodeStreams, readers)
+	assert.NoError(t, err)
+	tsd.ResetWithTimeRange(result, 5, 15)
+	var slots []uint16
+	for i := uint16(5); i <= 15; i++ {
+		if tsd.HasValueWithSlot(i) && (i == 10 || i == 12) {
+			slots = append(slots, i)
+		}
+	}
+	assert.Equal(t, []uint16{12}, slots)
 }
 
 func TestSeriesMerger_rollup_merge(t *testing.T) {
@@ -203,6 +203,85 @@ export const BrokerWriteDashboard: Dashboard = {
         },
       ],
     },
+    {
+      panels: [
+        {
+          chart: {
+            title: "Spill To Disk",
+            config: { type: "line", options: chartOptions },
+            targets: [
+              {
+                db: MonitoringDB,
+                sql: "select 'spill' from 'lindb.broker.family.write' group by db,node",
+                watch: ["node", "db"],
+              },
+            ],
+            unit: Unit.Short,
+          },
+          span: 8,
+        },
+        {
+          chart: {
+            title: "Spill To Disk Failure",
+            config: { type: "line", options: chartOptions },
+            targets: [
+              {
+                db: MonitoringDB,
+                sql: "select 'spill_failures' from 'lindb.broker.family.write' group by db,node",
+                watch: ["node", "db"],
+              },
+            ],
+            unit: Unit.Short,
+          },
+          span: 8,
+        },
+        {
+          chart: {
+            title: "Replay Spilled Message",
+            config: { type: "line", options: chartOptions },
+            targets: [
+              {
+                db: MonitoringDB,
+                sql: "select 'spill_replay' from 'lindb.broker.family.write' group by db,node",
+                watch: ["node", "db"],
+              },
+            ],
+            unit: Unit.Short,
+          },
+          span: 8,
+        },
+        {
+          chart: {
+            title: "Pending Spilled Message",
+            config: { type: "line", options: chartOptions },
+            targets: [
+              {
+                db: MonitoringDB,
+                sql: "select 'spill_pending' from 'lindb.broker.family.write' group by db,node",
+                watch: ["node", "db"],
+              },
+            ],
+            unit: Unit.Short,
+          },
+          span: 8,
+        },
+        {
+          chart: {
+            title: "Pending Spilled Size",
+            config: { type: "line", options: chartOptions },
+            targets: [
+              {
+                db: MonitoringDB,
+                sql: "select 'spill_size' from 'lindb.broker.family.write' group by db,node",
+                watch: ["node", "db"],
+              },
+            ],
+            unit: Unit.Bytes,
+          },
+          span: 8,
+        },
+      ],
+    },
     {
       panels: [
         {
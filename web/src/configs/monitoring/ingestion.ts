@@ -290,5 +290,54 @@ export const IngestionDashboard: Dashboard = {
         },
       ],
     },
+    {
+      panels: [
+        {
+          chart: {
+            title: "Throttled Requests(Rate Limit)",
+            config: { type: "line", options: chartOptions },
+            targets: [
+              {
+                db: MonitoringDB,
+                sql: "select 'throttled_requests' from 'lindb.ingestion.rate_limit' group by db,ns,node",
+                watch: ["node", "db"],
+              },
+            ],
+            unit: Unit.Short,
+          },
+          span: 8,
+        },
+        {
+          chart: {
+            title: "Write Rows(Rate Limit)",
+            config: { type: "line", options: chartOptions },
+            targets: [
+              {
+                db: MonitoringDB,
+                sql: "select 'rows' from 'lindb.ingestion.rate_limit' group by db,node",
+                watch: ["node", "db"],
+              },
+            ],
+            unit: Unit.Short,
+          },
+          span: 8,
+        },
+        {
+          chart: {
+            title: "Write Bytes(Rate Limit)",
+            config: { type: "line", options: chartOptions },
+            targets: [
+              {
+                db: MonitoringDB,
+                sql: "select 'bytes' from 'lindb.ingestion.rate_limit' group by db,node",
+                watch: ["node", "db"],
+              },
+            ],
+            unit: Unit.Bytes,
+          },
+          span: 8,
+        },
+      ],
+    },
   ],
 };
@@ -158,6 +158,40 @@ export const MasterCoordinatorDashboard: Dashboard = {
         },
       ],
     },
+    {
+      panels: [
+        {
+          chart: {
+            title: "Shard Leader Transfer",
+            config: { type: "line", options: chartOptions },
+            targets: [
+              {
+                db: MonitoringDB,
+                sql: "select 'transfers' from 'lindb.master.shard.leader' group by node",
+                watch: ["node"],
+              },
+            ],
+            unit: Unit.Short,
+          },
+          span: 12,
+        },
+        {
+          chart: {
+            title: "Shard Leader Transfer Failure",
+            config: { type: "line", options: chartOptions },
+            targets: [
+              {
+                db: MonitoringDB,
+                sql: "select 'transfer_failures' from 'lindb.master.shard.leader' group by node",
+                watch: ["node"],
+              },
+            ],
+            unit: Unit.Short,
+          },
+          span: 12,
+        },
+      ],
+    },
     {
       panels: [
         {
@@ -64,6 +64,18 @@ export type StorageState = {
       };
     };
   }; // database's nmae => shard state
+  decommissions?: { [nodeId: string]: NodeDecommission }; // node id=>decommission
+};
+
+export type NodeDecommission = {
+  storage: string;
+  nodeId: number;
+  state: string;
+  leaders: number; // shard leaders remaining on node
+  replicas: number; // shard replicas remaining on node
+  errMsg?: string;
+  createTime: number;
+  updateTime: number;
 };
 
 export type StateMetric = {